@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/abhikaboy/Kindred/internal/config"
+	Post "github.com/abhikaboy/Kindred/internal/handlers/post"
+	"github.com/abhikaboy/Kindred/internal/storage/xmongo"
+	"github.com/abhikaboy/Kindred/internal/xslog"
+	"github.com/joho/godotenv"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+/*
+Backfills every user's materialized home feed timeline from the live feed
+sources (friends' posts, ring closures and recently completed public tasks)
+and marks it warm, so GET /v1/user/feed serves it instead of aggregating.
+
+Users whose timeline is already warm are skipped. Re-running is safe: entries
+upsert on (owner, kind, ref).
+
+Usage:
+
+	go run cmd/db/backfill_timeline/main.go          # cold timelines only
+	go run cmd/db/backfill_timeline/main.go --force  # rebuild every timeline
+*/
+func main() {
+	ctx := context.Background()
+
+	if err := godotenv.Load(); err != nil {
+		fatal(ctx, "Failed to load .env", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fatal(ctx, "Failed to load config", err)
+	}
+
+	db, err := xmongo.New(ctx, cfg.Atlas)
+	if err != nil {
+		fatal(ctx, "Failed to connect to MongoDB", err)
+	}
+
+	force := len(os.Args) > 1 && os.Args[1] == "--force"
+	service := Post.NewService(db.Collections)
+
+	cursor, err := db.DB.Collection("users").Find(ctx, bson.M{}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		fatal(ctx, "Failed to list users", err)
+	}
+	defer cursor.Close(ctx)
+
+	backfilled, skipped, failed := 0, 0, 0
+	for cursor.Next(ctx) {
+		var user struct {
+			ID primitive.ObjectID `bson:"_id"`
+		}
+		if err := cursor.Decode(&user); err != nil {
+			slog.LogAttrs(ctx, slog.LevelWarn, "Failed to decode user", xslog.Error(err))
+			continue
+		}
+		if !force && service.Timeline.Warm(ctx, user.ID) {
+			skipped++
+			continue
+		}
+		if err := service.BackfillTimeline(ctx, user.ID); err != nil {
+			slog.LogAttrs(ctx, slog.LevelError, "Failed to backfill timeline",
+				slog.String("userID", user.ID.Hex()), xslog.Error(err))
+			failed++
+			continue
+		}
+		backfilled++
+	}
+	if err := cursor.Err(); err != nil {
+		fatal(ctx, "Failed while iterating users", err)
+	}
+
+	slog.LogAttrs(ctx, slog.LevelInfo, "Timeline backfill complete",
+		slog.Int("backfilled", backfilled),
+		slog.Int("skipped", skipped),
+		slog.Int("failed", failed))
+}
+
+func fatal(ctx context.Context, msg string, err error) {
+	slog.LogAttrs(
+		ctx,
+		slog.LevelError,
+		msg,
+		xslog.Error(err),
+	)
+	os.Exit(1)
+}
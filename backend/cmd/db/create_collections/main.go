@@ -36,7 +36,7 @@ func main() {
 	}
 
 	// Collections to create
-	collections := []string{"encouragements", "congratulations", "notifications", "workspaces", "reports", "for_you_exposures"}
+	collections := []string{"encouragements", "congratulations", "notifications", "workspaces", "reports", "for_you_exposures", "timelines", "timeline_state", "feed_impressions", "comments", "post_drafts", "friend_lists", "group_challenges"}
 
 	for _, collectionName := range collections {
 		if err := createCollectionIfNotExists(ctx, db.DB, collectionName); err != nil {
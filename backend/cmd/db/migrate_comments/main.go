@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/abhikaboy/Kindred/internal/config"
+	Post "github.com/abhikaboy/Kindred/internal/handlers/post"
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/internal/storage/xmongo"
+	"github.com/abhikaboy/Kindred/internal/xslog"
+	"github.com/joho/godotenv"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+/*
+Moves comments embedded in posts into the comments collection, leaving each
+post with a preview of its latest comments and a commentCount.
+
+Only posts without a commentCount are touched, and comments upsert by _id, so
+re-running is safe. Posts missed here are migrated lazily the first time
+someone comments on, lists or edits their comments.
+
+Usage:
+
+	go run cmd/db/migrate_comments/main.go            # migrate
+	go run cmd/db/migrate_comments/main.go --dry-run  # count what would move
+*/
+func main() {
+	ctx := context.Background()
+
+	if err := godotenv.Load(); err != nil {
+		fatal(ctx, "Failed to load .env", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fatal(ctx, "Failed to load config", err)
+	}
+
+	db, err := xmongo.New(ctx, cfg.Atlas)
+	if err != nil {
+		fatal(ctx, "Failed to connect to MongoDB", err)
+	}
+
+	dryRun := len(os.Args) > 1 && os.Args[1] == "--dry-run"
+	service := Post.NewService(db.Collections)
+
+	cursor, err := db.DB.Collection("posts").Find(ctx, bson.M{
+		"comments.0":   bson.M{"$exists": true},
+		"commentCount": bson.M{"$in": bson.A{nil, 0}},
+	})
+	if err != nil {
+		fatal(ctx, "Failed to list posts", err)
+	}
+	defer cursor.Close(ctx)
+
+	posts, comments, failed := 0, 0, 0
+	for cursor.Next(ctx) {
+		var post types.PostDocument
+		if err := cursor.Decode(&post); err != nil {
+			slog.LogAttrs(ctx, slog.LevelWarn, "Failed to decode post", xslog.Error(err))
+			continue
+		}
+		if dryRun {
+			posts++
+			comments += len(post.Comments)
+			continue
+		}
+		moved, err := service.MigratePostComments(ctx, &post)
+		if err != nil {
+			slog.LogAttrs(ctx, slog.LevelError, "Failed to migrate comments",
+				slog.String("postID", post.ID.Hex()), xslog.Error(err))
+			failed++
+			continue
+		}
+		posts++
+		comments += moved
+	}
+	if err := cursor.Err(); err != nil {
+		fatal(ctx, "Failed while iterating posts", err)
+	}
+
+	slog.LogAttrs(ctx, slog.LevelInfo, "Comment migration complete",
+		slog.Bool("dryRun", dryRun),
+		slog.Int("posts", posts),
+		slog.Int("comments", comments),
+		slog.Int("failed", failed))
+}
+
+func fatal(ctx context.Context, msg string, err error) {
+	slog.LogAttrs(
+		ctx,
+		slog.LevelError,
+		msg,
+		xslog.Error(err),
+	)
+	os.Exit(1)
+}
@@ -0,0 +1,140 @@
+// Command nlpeval scores the natural-language flows against the eval corpus.
+//
+// By default it replays recorded outputs, so it runs offline and for free:
+//
+//	go run ./cmd/nlpeval
+//
+// With -live it calls the backend configured by the LLM_* environment
+// variables (see config.LLM) against the corpus fixtures at the corpus's
+// frozen clock; add -record to refresh the recordings from that run.
+// -baseline compares against a previous run and exits non-zero on regressions.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/abhikaboy/Kindred/internal/config"
+	"github.com/abhikaboy/Kindred/internal/gemini"
+	"github.com/abhikaboy/Kindred/internal/nlpeval"
+	"github.com/caarlos0/env/v11"
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	corpusPath := flag.String("corpus", "internal/nlpeval/testdata/corpus.json", "corpus file")
+	recordingsPath := flag.String("recordings", "internal/nlpeval/testdata/recordings.json", "recorded outputs file")
+	live := flag.Bool("live", false, "call the configured LLM backend instead of replaying recordings")
+	record := flag.Bool("record", false, "with -live, write outputs to the recordings file")
+	baselinePath := flag.String("baseline", "", "baseline file to report regressions against")
+	updateBaseline := flag.Bool("update-baseline", false, "write this run's results to the baseline file")
+	flow := flag.String("flow", "", "only run cases for this flow")
+	strict := flag.Bool("strict", false, "fail when replaying recordings made with an older prompt")
+	flag.Parse()
+
+	if *record && !*live {
+		log.Fatal("-record requires -live")
+	}
+
+	corpus, err := nlpeval.LoadCorpus(*corpusPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	recording, err := nlpeval.LoadRecording(*recordingsPath, corpus)
+	if err != nil && !*record {
+		log.Fatal(err)
+	}
+	if err != nil {
+		// Recording for an older corpus version; -record replaces it.
+		recording = nlpeval.NewRecording(corpus)
+	}
+
+	opts := nlpeval.Options{Recording: recording, Record: *record, Flow: *flow}
+	if *live {
+		_ = godotenv.Load()
+		cfg, err := env.ParseAsWithOptions[config.LLM](env.Options{Prefix: "LLM_"})
+		if err != nil {
+			log.Fatalf("Failed to load LLM config: %v", err)
+		}
+		backend, err := gemini.NewBackendFromSources(cfg, corpus.Sources())
+		if err != nil {
+			log.Fatalf("Failed to initialize LLM backend: %v", err)
+		}
+		opts.Backend = backend
+		opts.Label = cfg.Backend
+		if cfg.Model != "" {
+			opts.Label += "/" + cfg.Model
+		}
+	}
+
+	report, err := nlpeval.Run(context.Background(), corpus, opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, c := range report.Cases {
+		status := "PASS"
+		if !c.Pass {
+			status = "FAIL"
+		}
+		if c.Stale {
+			status += " (stale)"
+		}
+		fmt.Printf("%-12s %-14s %s\n", status, c.Flow, c.ID)
+		if !c.Pass {
+			fmt.Printf("             %s\n", c.Failure())
+		}
+	}
+
+	flows := make([]string, 0, len(report.Flows))
+	for f := range report.Flows {
+		flows = append(flows, f)
+	}
+	sort.Strings(flows)
+	fmt.Println()
+	for _, f := range flows {
+		s := report.Flows[f]
+		fmt.Printf("%-14s %d/%d\n", f, s.Passed, s.Total)
+	}
+
+	failed := false
+	if stale := report.Stale(); len(stale) > 0 {
+		fmt.Printf("\n%d recordings predate the current prompts; re-record with -live -record: %v\n", len(stale), stale)
+		failed = failed || *strict
+	}
+
+	if *baselinePath != "" {
+		baseline, err := nlpeval.LoadBaseline(*baselinePath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		regressions, fixes := report.Compare(baseline)
+		if len(fixes) > 0 {
+			fmt.Printf("\nfixed since baseline: %v\n", fixes)
+		}
+		if len(regressions) > 0 {
+			fmt.Printf("\nREGRESSIONS since baseline: %v\n", regressions)
+			failed = true
+		}
+		if *updateBaseline {
+			if err := report.Baseline().Save(*baselinePath); err != nil {
+				log.Fatalf("Failed to save baseline: %v", err)
+			}
+		}
+	}
+
+	if *record {
+		if err := recording.Save(*recordingsPath); err != nil {
+			log.Fatalf("Failed to save recordings: %v", err)
+		}
+		fmt.Printf("\nrecorded %d outputs to %s\n", len(report.Cases), *recordingsPath)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/abhikaboy/Kindred/internal/config"
 	"github.com/abhikaboy/Kindred/internal/gemini"
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
 	xotel "github.com/abhikaboy/Kindred/internal/otel"
 	"github.com/abhikaboy/Kindred/internal/posthog"
 	"github.com/abhikaboy/Kindred/internal/server"
@@ -150,12 +151,23 @@ func run(stderr io.Writer, args []string) {
 		fmt.Printf("⚠️  Unsplash API key not configured - banner generation will be disabled\n")
 	}
 
-	// Gemini Setup (before server setup so it can be passed to routes)
-	geminiService := gemini.InitGenkit(db.Collections, unsplashClient)
-	fmt.Printf("Gemini service initialized\n")
+	// LLM Setup (before server setup so it can be passed to routes)
+	llmBackend, err := gemini.NewBackend(config.LLM, db.Collections, unsplashClient)
+	if err != nil {
+		fatal(ctx, "Failed to initialize LLM backend", err)
+	}
+	llmBackend = gemini.NewGuardedBackend(llmBackend)
+	llmBackend = gemini.NewMeteredBackend(llmBackend, gemini.NewUsageRecorder(db.DB.Collection(types.AIUsageCollection)))
+	fmt.Printf("LLM backend initialized (%s)\n", config.LLM.Backend)
+
+	tokenBudgets, err := types.ParseTokenBudgets(config.LLM.TokenBudgets)
+	if err != nil {
+		fatal(ctx, "Invalid LLM token budgets", err)
+	}
+	types.SetTokenBudgets(tokenBudgets)
 
 	// API Server Setup
-	_, fiberApp := server.New(db.Collections, db.Stream, geminiService, config)
+	_, fiberApp := server.New(db.Collections, db.Stream, llmBackend, config)
 	fmt.Printf("Server initialized\n")
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Hour)
@@ -0,0 +1,651 @@
+// Package audience decides which posts a viewer may see. Every read path
+// builds its post query from a Viewer, so public, friends, close friends,
+// group, specific-user and only-me posts are enforced the same way whether
+// they are read from the feed, a profile, a blueprint or by ID. It also
+// resolves friend lists, which double as audiences and as pools other
+// features draw friends from.
+package audience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrListNotFound is returned for a list that doesn't exist or isn't the
+// caller's.
+var ErrListNotFound = errors.New("friend list not found")
+
+// ErrNotGroupMember is returned for a group the caller doesn't belong to.
+var ErrNotGroupMember = errors.New("not a member of the group")
+
+// Viewer is everything about one user that post visibility depends on.
+type Viewer struct {
+	ID            primitive.ObjectID
+	friends       map[primitive.ObjectID]bool
+	groups        map[primitive.ObjectID]bool
+	closeFriendOf map[primitive.ObjectID]bool
+	lists         map[primitive.ObjectID]bool
+	blocked       map[primitive.ObjectID]bool
+	muted         map[primitive.ObjectID]bool // users the viewer muted
+	hiddenBy      map[primitive.ObjectID]bool // users hiding their activity from the viewer
+}
+
+// NewViewer builds a viewer from their friends, the groups they belong to,
+// the authors who count them as a close friend, the friend lists they are on,
+// and the users on either side of a block with them.
+func NewViewer(id primitive.ObjectID, friends, groups, closeFriendOf, lists, blocked []primitive.ObjectID) *Viewer {
+	return &Viewer{
+		ID:            id,
+		friends:       set(friends),
+		groups:        set(groups),
+		closeFriendOf: set(closeFriendOf),
+		lists:         set(lists),
+		blocked:       set(blocked),
+	}
+}
+
+func set(ids []primitive.ObjectID) map[primitive.ObjectID]bool {
+	out := make(map[primitive.ObjectID]bool, len(ids))
+	for _, id := range ids {
+		out[id] = true
+	}
+	return out
+}
+
+// ids lists a set as a bson array, so an empty set still matches nothing
+// rather than serializing as null.
+func ids(m map[primitive.ObjectID]bool) bson.A {
+	out := bson.A{}
+	for id := range m {
+		out = append(out, id)
+	}
+	return out
+}
+
+// IsFriend reports whether id is one of the viewer's friends.
+func (v *Viewer) IsFriend(id primitive.ObjectID) bool {
+	return v.friends[id]
+}
+
+// Blocked reports whether the viewer and id have blocked each other.
+func (v *Viewer) Blocked(id primitive.ObjectID) bool {
+	return v.blocked[id]
+}
+
+// HiddenBy reports whether id hides their activity from the viewer. Like a
+// block, it hides id's posts, ring closures and public tasks everywhere.
+func (v *Viewer) HiddenBy(id primitive.ObjectID) bool {
+	return v.hiddenBy[id]
+}
+
+// Muted reports whether the viewer muted id. Muting only keeps id out of the
+// viewer's feed; their posts can still be opened.
+func (v *Viewer) Muted(id primitive.ObjectID) bool {
+	return v.muted[id]
+}
+
+// FeedHidden returns the authors kept out of the viewer's feed: blocked,
+// muted, or hiding their activity from the viewer.
+func (v *Viewer) FeedHidden() []primitive.ObjectID {
+	out := make([]primitive.ObjectID, 0, len(v.blocked)+len(v.muted)+len(v.hiddenBy))
+	for _, m := range []map[primitive.ObjectID]bool{v.blocked, v.muted, v.hiddenBy} {
+		for id := range m {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// unseen lists the authors whose posts the viewer may never see.
+func (v *Viewer) unseen() bson.A {
+	out := ids(v.blocked)
+	for id := range v.hiddenBy {
+		if !v.blocked[id] {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// Friends returns the viewer's friend IDs.
+func (v *Viewer) Friends() []primitive.ObjectID {
+	out := make([]primitive.ObjectID, 0, len(v.friends))
+	for id := range v.friends {
+		out = append(out, id)
+	}
+	return out
+}
+
+// CanSee reports whether the viewer may see p. It is the in-memory twin of
+// Filter and must stay in step with it.
+func (v *Viewer) CanSee(p *types.PostDocument) bool {
+	author := p.User.ID
+	if author == v.ID {
+		return true
+	}
+	if v.blocked[author] || v.hiddenBy[author] {
+		return false
+	}
+	a := p.EffectiveAudience()
+	switch a.Type {
+	case types.AudiencePublic:
+		return true
+	case types.AudienceFriends:
+		return v.friends[author]
+	case types.AudienceCloseFriends:
+		return v.friends[author] && v.closeFriendOf[author]
+	case types.AudienceGroups:
+		for _, g := range a.Groups {
+			if v.groups[g] {
+				return true
+			}
+		}
+	case types.AudienceUsers:
+		for _, u := range a.Users {
+			if u == v.ID {
+				return true
+			}
+		}
+	case types.AudienceLists:
+		if !v.friends[author] {
+			return false
+		}
+		for _, l := range a.Lists {
+			if v.lists[l] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Filter matches the posts the viewer may see. Combine it with other
+// conditions under $and; it uses $or and user._id itself.
+func (v *Viewer) Filter() bson.M {
+	friends := ids(v.friends)
+	groups := ids(v.groups)
+
+	closeFriends := bson.A{}
+	for id := range v.closeFriendOf {
+		if v.friends[id] {
+			closeFriends = append(closeFriends, id)
+		}
+	}
+
+	noAudience := bson.M{"$exists": false}
+	return bson.M{
+		"user._id": bson.M{"$nin": v.unseen()},
+		"$or": bson.A{
+			bson.M{"user._id": v.ID},
+			bson.M{"audience.type": types.AudiencePublic},
+			bson.M{"audience.type": types.AudienceFriends, "user._id": bson.M{"$in": friends}},
+			bson.M{"audience.type": types.AudienceCloseFriends, "user._id": bson.M{"$in": closeFriends}},
+			bson.M{"audience.type": types.AudienceGroups, "audience.groups": bson.M{"$in": groups}},
+			bson.M{"audience.type": types.AudienceUsers, "audience.users": v.ID},
+			bson.M{"audience.type": types.AudienceLists, "audience.lists": bson.M{"$in": ids(v.lists)}, "user._id": bson.M{"$in": friends}},
+			// Posts from before audiences, read as EffectiveAudience does.
+			bson.M{"audience": noAudience, "groups": bson.M{"$in": groups}},
+			bson.M{"audience": noAudience, "groups.0": noAudience, "metadata.isPublic": true},
+			bson.M{"audience": noAudience, "groups.0": noAudience, "user._id": bson.M{"$in": friends}},
+		},
+	}
+}
+
+// FeedFilter narrows Filter to what belongs in the viewer's home feed: their
+// own posts, their friends' posts and posts addressed to them, minus anyone
+// the viewer muted. Public posts by strangers can be opened but don't fill
+// the feed.
+func (v *Viewer) FeedFilter() bson.M {
+	authors := ids(v.friends)
+	authors = append(authors, v.ID)
+	return bson.M{"$and": bson.A{
+		v.Filter(),
+		bson.M{"user._id": bson.M{"$nin": ids(v.muted)}},
+		bson.M{"$or": bson.A{
+			bson.M{"user._id": bson.M{"$in": authors}},
+			bson.M{"audience.type": bson.M{"$in": bson.A{types.AudienceGroups, types.AudienceUsers}}},
+			bson.M{"audience": bson.M{"$exists": false}, "groups.0": bson.M{"$exists": true}},
+		}},
+	}}
+}
+
+type Service struct {
+	Users       *mongo.Collection
+	Groups      *mongo.Collection
+	Connections *mongo.Collection
+	Lists       *mongo.Collection
+}
+
+func New(collections map[string]*mongo.Collection) *Service {
+	if users := collections["users"]; users != nil {
+		return NewWithDatabase(users.Database())
+	}
+	return &Service{}
+}
+
+// NewWithDatabase is for services that hold a single collection rather than
+// the collections map.
+func NewWithDatabase(db *mongo.Database) *Service {
+	if db == nil {
+		return &Service{}
+	}
+	return &Service{
+		Users:       db.Collection("users"),
+		Groups:      db.Collection("groups"),
+		Connections: db.Collection("friend-requests"),
+		Lists:       db.Collection(types.FriendListCollection),
+	}
+}
+
+// Viewer loads what id's visibility depends on.
+func (s *Service) Viewer(ctx context.Context, id primitive.ObjectID) (*Viewer, error) {
+	var user struct {
+		Friends []primitive.ObjectID `bson:"friends"`
+		Muted   []primitive.ObjectID `bson:"muted"`
+	}
+	err := s.Users.FindOne(ctx, bson.M{"_id": id}, options.FindOne().SetProjection(bson.M{"friends": 1, "muted": 1})).Decode(&user)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("failed to load viewer friends: %w", err)
+	}
+
+	closeFriendOf, err := s.findIDs(ctx, s.Users, bson.M{"closeFriends": id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load close friend lists: %w", err)
+	}
+	hiddenBy, err := s.findIDs(ctx, s.Users, bson.M{"hiddenFrom": id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load hidden activity: %w", err)
+	}
+
+	var lists []primitive.ObjectID
+	if s.Lists != nil {
+		lists, err = s.findIDs(ctx, s.Lists, bson.M{"members": id})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load friend lists: %w", err)
+		}
+	}
+
+	var groups []primitive.ObjectID
+	if s.Groups != nil {
+		groups, err = s.findIDs(ctx, s.Groups, bson.M{
+			"$or":                bson.A{bson.M{"creator": id}, bson.M{"members._id": id}},
+			"metadata.isDeleted": false,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load viewer groups: %w", err)
+		}
+	}
+
+	var blocked []primitive.ObjectID
+	if s.Connections != nil {
+		cursor, err := s.Connections.Find(ctx, bson.M{"users": id, "status": "blocked"},
+			options.Find().SetProjection(bson.M{"users": 1}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load blocks: %w", err)
+		}
+		var rels []struct {
+			Users []primitive.ObjectID `bson:"users"`
+		}
+		if err := cursor.All(ctx, &rels); err != nil {
+			return nil, fmt.Errorf("failed to decode blocks: %w", err)
+		}
+		for _, rel := range rels {
+			for _, u := range rel.Users {
+				if u != id {
+					blocked = append(blocked, u)
+				}
+			}
+		}
+	}
+
+	v := NewViewer(id, user.Friends, groups, closeFriendOf, lists, blocked)
+	v.muted = set(user.Muted)
+	v.hiddenBy = set(hiddenBy)
+	return v, nil
+}
+
+// Controls are the safety settings lighter than a block that one user has
+// applied to another.
+type Controls struct {
+	Muted      bool // kept out of the owner's feed and activity notifications
+	Restricted bool // comments on the owner's posts wait for approval
+	HiddenFrom bool // can't see the owner's activity or send kudos on it
+}
+
+// ControlsOn returns what owner has applied to other.
+func (s *Service) ControlsOn(ctx context.Context, owner, other primitive.ObjectID) (Controls, error) {
+	if s == nil || s.Users == nil {
+		return Controls{}, nil
+	}
+	var user struct {
+		Muted      []primitive.ObjectID `bson:"muted"`
+		Restricted []primitive.ObjectID `bson:"restricted"`
+		HiddenFrom []primitive.ObjectID `bson:"hiddenFrom"`
+	}
+	err := s.Users.FindOne(ctx, bson.M{"_id": owner},
+		options.FindOne().SetProjection(bson.M{"muted": 1, "restricted": 1, "hiddenFrom": 1})).Decode(&user)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return Controls{}, err
+	}
+	return Controls{
+		Muted:      set(user.Muted)[other],
+		Restricted: set(user.Restricted)[other],
+		HiddenFrom: set(user.HiddenFrom)[other],
+	}, nil
+}
+
+// hiddenFrom returns the users author hides their activity from.
+func (s *Service) hiddenFrom(ctx context.Context, author primitive.ObjectID) (map[primitive.ObjectID]bool, error) {
+	if s.Users == nil {
+		return map[primitive.ObjectID]bool{}, nil
+	}
+	var user struct {
+		HiddenFrom []primitive.ObjectID `bson:"hiddenFrom"`
+	}
+	err := s.Users.FindOne(ctx, bson.M{"_id": author},
+		options.FindOne().SetProjection(bson.M{"hiddenFrom": 1})).Decode(&user)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return nil, err
+	}
+	return set(user.HiddenFrom), nil
+}
+
+// Withheld returns who doesn't hear about author's activity: the users author
+// hides it from and the users who muted author.
+func (s *Service) Withheld(ctx context.Context, author primitive.ObjectID) (map[primitive.ObjectID]bool, error) {
+	out, err := s.hiddenFrom(ctx, author)
+	if err != nil || s.Users == nil {
+		return out, err
+	}
+	muters, err := s.findIDs(ctx, s.Users, bson.M{"muted": author})
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range muters {
+		out[id] = true
+	}
+	return out, nil
+}
+
+// drop removes every key of drop from ids.
+func drop(ids, drop map[primitive.ObjectID]bool) {
+	for id := range drop {
+		delete(ids, id)
+	}
+}
+
+func (s *Service) findIDs(ctx context.Context, coll *mongo.Collection, filter bson.M) ([]primitive.ObjectID, error) {
+	cursor, err := coll.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	var docs []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	out := make([]primitive.ObjectID, len(docs))
+	for i, d := range docs {
+		out[i] = d.ID
+	}
+	return out, nil
+}
+
+// Recipients resolves who an audience reaches, given the author's friends
+// and close friends. Public and friends posts reach friends: the home feed
+// only carries friends' and addressed posts. The author, and anyone they
+// hide their activity from, is never included. Muting is applied when the
+// feed is read, so unmuting brings an author's posts back.
+func (s *Service) Recipients(ctx context.Context, author primitive.ObjectID, a types.PostAudience) (map[primitive.ObjectID]bool, error) {
+	out := map[primitive.ObjectID]bool{}
+	switch a.Type {
+	case types.AudienceOnlyMe:
+		return out, nil
+	case types.AudienceGroups:
+		if s.Groups == nil || len(a.Groups) == 0 {
+			return out, nil
+		}
+		var groups []types.GroupDocument
+		cursor, err := s.Groups.Find(ctx, bson.M{"_id": bson.M{"$in": a.Groups}, "metadata.isDeleted": false},
+			options.Find().SetProjection(bson.M{"creator": 1, "members._id": 1}))
+		if err != nil {
+			return nil, err
+		}
+		if err := cursor.All(ctx, &groups); err != nil {
+			return nil, err
+		}
+		for _, g := range groups {
+			out[g.Creator] = true
+			for _, m := range g.Members {
+				out[m.ID] = true
+			}
+		}
+	case types.AudienceUsers:
+		for _, u := range a.Users {
+			out[u] = true
+		}
+	case types.AudienceLists:
+		members, err := s.listMembers(ctx, author, a.Lists)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range members {
+			out[id] = true
+		}
+	default:
+		var user struct {
+			Friends      []primitive.ObjectID `bson:"friends"`
+			CloseFriends []primitive.ObjectID `bson:"closeFriends"`
+		}
+		err := s.Users.FindOne(ctx, bson.M{"_id": author},
+			options.FindOne().SetProjection(bson.M{"friends": 1, "closeFriends": 1})).Decode(&user)
+		if err != nil && err != mongo.ErrNoDocuments {
+			return nil, err
+		}
+		closeFriends := set(user.CloseFriends)
+		for _, id := range user.Friends {
+			if a.Type != types.AudienceCloseFriends || closeFriends[id] {
+				out[id] = true
+			}
+		}
+	}
+	hidden, err := s.hiddenFrom(ctx, author)
+	if err != nil {
+		return nil, err
+	}
+	drop(out, hidden)
+	delete(out, author)
+	return out, nil
+}
+
+// ListMembers resolves one of owner's lists, by ID or as CloseFriendsList,
+// to the members who are still owner's friends. An empty ref means all of
+// owner's friends.
+func (s *Service) ListMembers(ctx context.Context, owner primitive.ObjectID, ref string) ([]primitive.ObjectID, error) {
+	var user struct {
+		Friends      []primitive.ObjectID `bson:"friends"`
+		CloseFriends []primitive.ObjectID `bson:"closeFriends"`
+	}
+	err := s.Users.FindOne(ctx, bson.M{"_id": owner},
+		options.FindOne().SetProjection(bson.M{"friends": 1, "closeFriends": 1})).Decode(&user)
+	if err != nil {
+		return nil, err
+	}
+	switch ref {
+	case "":
+		return user.Friends, nil
+	case types.CloseFriendsList:
+		return Intersect(user.CloseFriends, user.Friends), nil
+	}
+	id, err := primitive.ObjectIDFromHex(ref)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrListNotFound, ref)
+	}
+	members, err := s.listMembers(ctx, owner, []primitive.ObjectID{id})
+	if err != nil {
+		return nil, err
+	}
+	if members == nil {
+		return nil, ErrListNotFound
+	}
+	return Intersect(members, user.Friends), nil
+}
+
+// listMembers unions the members of owner's lists among ids. Lists that
+// aren't owner's are ignored; nil means none of them were.
+func (s *Service) listMembers(ctx context.Context, owner primitive.ObjectID, ids []primitive.ObjectID) ([]primitive.ObjectID, error) {
+	if s.Lists == nil || len(ids) == 0 {
+		return nil, nil
+	}
+	cursor, err := s.Lists.Find(ctx, bson.M{"_id": bson.M{"$in": ids}, "owner": owner},
+		options.Find().SetProjection(bson.M{"members": 1}))
+	if err != nil {
+		return nil, err
+	}
+	var lists []types.FriendList
+	if err := cursor.All(ctx, &lists); err != nil {
+		return nil, err
+	}
+	if len(lists) == 0 {
+		return nil, nil
+	}
+	seen := map[primitive.ObjectID]bool{}
+	members := []primitive.ObjectID{}
+	for _, l := range lists {
+		for _, id := range l.Members {
+			if !seen[id] {
+				seen[id] = true
+				members = append(members, id)
+			}
+		}
+	}
+	return members, nil
+}
+
+// OwnsLists reports whether every list in ids belongs to owner.
+func (s *Service) OwnsLists(ctx context.Context, owner primitive.ObjectID, ids []primitive.ObjectID) (bool, error) {
+	if s.Lists == nil {
+		return false, nil
+	}
+	n, err := s.Lists.CountDocuments(ctx, bson.M{"_id": bson.M{"$in": ids}, "owner": owner})
+	if err != nil {
+		return false, err
+	}
+	return int(n) == len(set(ids)), nil
+}
+
+// MemberOfGroups reports whether member owns or belongs to every group in
+// ids.
+func (s *Service) MemberOfGroups(ctx context.Context, member primitive.ObjectID, ids []primitive.ObjectID) (bool, error) {
+	if s.Groups == nil {
+		return false, nil
+	}
+	n, err := s.Groups.CountDocuments(ctx, bson.M{
+		"_id":                bson.M{"$in": ids},
+		"$or":                bson.A{bson.M{"creator": member}, bson.M{"members._id": member}},
+		"metadata.isDeleted": false,
+	})
+	if err != nil {
+		return false, err
+	}
+	return int(n) == len(set(ids)), nil
+}
+
+// ListsContaining returns which of the lists in ids have member on them.
+func (s *Service) ListsContaining(ctx context.Context, member primitive.ObjectID, ids []primitive.ObjectID) (map[primitive.ObjectID]bool, error) {
+	if s.Lists == nil || len(ids) == 0 {
+		return map[primitive.ObjectID]bool{}, nil
+	}
+	found, err := s.findIDs(ctx, s.Lists, bson.M{"_id": bson.M{"$in": ids}, "members": member})
+	if err != nil {
+		return nil, err
+	}
+	return set(found), nil
+}
+
+// GroupSubscribers returns the owners and members of groups whose settings
+// for that group pass wants, so muting one group doesn't mute the others.
+// author, and anyone their activity is withheld from, is left out.
+func (s *Service) GroupSubscribers(ctx context.Context, author primitive.ObjectID, groups []primitive.ObjectID, wants func(types.GroupNotificationSettings) bool) (map[primitive.ObjectID]bool, error) {
+	if len(groups) == 0 {
+		return map[primitive.ObjectID]bool{}, nil
+	}
+	out, err := s.groupSubscribers(ctx, bson.M{"_id": bson.M{"$in": groups}}, wants)
+	if err != nil {
+		return nil, err
+	}
+	withheld, err := s.Withheld(ctx, author)
+	if err != nil {
+		return nil, err
+	}
+	drop(out, withheld)
+	delete(out, author)
+	return out, nil
+}
+
+// GroupActivitySubscribers returns everyone who shares a group with member
+// and wants to hear about its members' activity. member, and anyone their
+// activity is withheld from, is left out.
+func (s *Service) GroupActivitySubscribers(ctx context.Context, member primitive.ObjectID) (map[primitive.ObjectID]bool, error) {
+	out, err := s.groupSubscribers(ctx,
+		bson.M{"$or": bson.A{bson.M{"creator": member}, bson.M{"members._id": member}}},
+		func(n types.GroupNotificationSettings) bool { return n.Activity },
+	)
+	if err != nil {
+		return nil, err
+	}
+	withheld, err := s.Withheld(ctx, member)
+	if err != nil {
+		return nil, err
+	}
+	drop(out, withheld)
+	delete(out, member)
+	return out, nil
+}
+
+func (s *Service) groupSubscribers(ctx context.Context, filter bson.M, wants func(types.GroupNotificationSettings) bool) (map[primitive.ObjectID]bool, error) {
+	out := map[primitive.ObjectID]bool{}
+	if s.Groups == nil {
+		return out, nil
+	}
+	filter["metadata.isDeleted"] = false
+	cursor, err := s.Groups.Find(ctx, filter,
+		options.Find().SetProjection(bson.M{"creator": 1, "members._id": 1, "notifications": 1}))
+	if err != nil {
+		return nil, err
+	}
+	var groups []types.GroupDocument
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+	for _, g := range groups {
+		for _, id := range g.MemberIDs() {
+			if wants(g.NotificationSettingsFor(id)) {
+				out[id] = true
+			}
+		}
+	}
+	return out, nil
+}
+
+// Intersect keeps the ids that are also in within, in order and without
+// duplicates.
+func Intersect(ids, within []primitive.ObjectID) []primitive.ObjectID {
+	keep := set(within)
+	out := []primitive.ObjectID{}
+	for _, id := range ids {
+		if keep[id] {
+			out = append(out, id)
+			delete(keep, id)
+		}
+	}
+	return out
+}
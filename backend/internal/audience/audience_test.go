@@ -0,0 +1,137 @@
+package audience
+
+import (
+	"testing"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCanSee(t *testing.T) {
+	viewer := primitive.NewObjectID()
+	friend := primitive.NewObjectID()
+	closeFriend := primitive.NewObjectID()
+	stranger := primitive.NewObjectID()
+	blocked := primitive.NewObjectID()
+	group := primitive.NewObjectID()
+	otherGroup := primitive.NewObjectID()
+	list := primitive.NewObjectID()
+	otherList := primitive.NewObjectID()
+
+	v := NewViewer(viewer,
+		[]primitive.ObjectID{friend, closeFriend, blocked},
+		[]primitive.ObjectID{group},
+		[]primitive.ObjectID{closeFriend, stranger},
+		[]primitive.ObjectID{list},
+		[]primitive.ObjectID{blocked},
+	)
+
+	post := func(author primitive.ObjectID, a *types.PostAudience) *types.PostDocument {
+		p := &types.PostDocument{Audience: a}
+		p.User.ID = author
+		return p
+	}
+	of := func(typ string) *types.PostAudience { return &types.PostAudience{Type: typ} }
+
+	cases := []struct {
+		name string
+		post *types.PostDocument
+		want bool
+	}{
+		{"own only-me post", post(viewer, of(types.AudienceOnlyMe)), true},
+		{"stranger's public post", post(stranger, of(types.AudiencePublic)), true},
+		{"blocked author's public post", post(blocked, of(types.AudiencePublic)), false},
+		{"friend's friends post", post(friend, of(types.AudienceFriends)), true},
+		{"stranger's friends post", post(stranger, of(types.AudienceFriends)), false},
+		{"close friend's close-friends post", post(closeFriend, of(types.AudienceCloseFriends)), true},
+		{"friend's close-friends post without the viewer on the list", post(friend, of(types.AudienceCloseFriends)), false},
+		{"close-friends post from a non-friend who listed the viewer", post(stranger, of(types.AudienceCloseFriends)), false},
+		{"friend's only-me post", post(friend, of(types.AudienceOnlyMe)), false},
+		{"post to the viewer's group", post(stranger, &types.PostAudience{Type: types.AudienceGroups, Groups: []primitive.ObjectID{otherGroup, group}}), true},
+		{"post to another group", post(friend, &types.PostAudience{Type: types.AudienceGroups, Groups: []primitive.ObjectID{otherGroup}}), false},
+		{"post addressed to the viewer", post(friend, &types.PostAudience{Type: types.AudienceUsers, Users: []primitive.ObjectID{viewer}}), true},
+		{"post addressed to someone else", post(friend, &types.PostAudience{Type: types.AudienceUsers, Users: []primitive.ObjectID{stranger}}), false},
+		{"post to a list the viewer is on", post(friend, &types.PostAudience{Type: types.AudienceLists, Lists: []primitive.ObjectID{otherList, list}}), true},
+		{"post to another list", post(friend, &types.PostAudience{Type: types.AudienceLists, Lists: []primitive.ObjectID{otherList}}), false},
+		{"list post from someone no longer a friend", post(stranger, &types.PostAudience{Type: types.AudienceLists, Lists: []primitive.ObjectID{list}}), false},
+		{"legacy friend post", post(friend, nil), true},
+		{"legacy stranger post", post(stranger, nil), false},
+	}
+	for _, c := range cases {
+		if got := v.CanSee(c.post); got != c.want {
+			t.Errorf("%s: CanSee = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestEffectiveAudienceLegacy(t *testing.T) {
+	group := primitive.NewObjectID()
+
+	var p types.PostDocument
+	if got := p.EffectiveAudience().Type; got != types.AudienceFriends {
+		t.Errorf("plain legacy post = %q, want friends", got)
+	}
+	p.Metadata.IsPublic = true
+	if got := p.EffectiveAudience().Type; got != types.AudiencePublic {
+		t.Errorf("public legacy post = %q, want public", got)
+	}
+	p.Groups = []primitive.ObjectID{group}
+	if got := p.EffectiveAudience(); got.Type != types.AudienceGroups || len(got.Groups) != 1 || got.Groups[0] != group {
+		t.Errorf("group legacy post = %+v, want its groups", got)
+	}
+	p.Audience = &types.PostAudience{Type: types.AudienceOnlyMe}
+	if got := p.EffectiveAudience().Type; got != types.AudienceOnlyMe {
+		t.Errorf("post with an audience = %q, want it to win", got)
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	a, b, c := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+	got := Intersect([]primitive.ObjectID{c, a, c, b}, []primitive.ObjectID{a, c})
+	if len(got) != 2 || got[0] != c || got[1] != a {
+		t.Errorf("Intersect = %v, want [c a]", got)
+	}
+	if got := Intersect(nil, []primitive.ObjectID{a}); got == nil || len(got) != 0 {
+		t.Errorf("Intersect(nil) = %v, want an empty slice", got)
+	}
+}
+
+func TestViewerControls(t *testing.T) {
+	viewer := primitive.NewObjectID()
+	muted := primitive.NewObjectID()
+	hider := primitive.NewObjectID()
+	blocked := primitive.NewObjectID()
+	friend := primitive.NewObjectID()
+
+	v := NewViewer(viewer, []primitive.ObjectID{muted, hider, friend}, nil, nil, nil, []primitive.ObjectID{blocked})
+	v.muted = set([]primitive.ObjectID{muted})
+	v.hiddenBy = set([]primitive.ObjectID{hider})
+
+	post := func(author primitive.ObjectID) *types.PostDocument {
+		p := &types.PostDocument{Audience: &types.PostAudience{Type: types.AudienceFriends}}
+		p.User.ID = author
+		return p
+	}
+	if !v.CanSee(post(muted)) {
+		t.Error("a muted friend's post should still open")
+	}
+	if v.CanSee(post(hider)) {
+		t.Error("a post by someone hiding their activity from the viewer should not open")
+	}
+	if !v.CanSee(post(friend)) {
+		t.Error("a friend's post should open")
+	}
+
+	hidden := set(v.FeedHidden())
+	for _, id := range []primitive.ObjectID{muted, hider, blocked} {
+		if !hidden[id] {
+			t.Errorf("FeedHidden is missing %s", id.Hex())
+		}
+	}
+	if hidden[friend] || len(hidden) != 3 {
+		t.Errorf("FeedHidden = %v, want only the muted, hiding and blocked users", v.FeedHidden())
+	}
+	if !v.Muted(muted) || v.Muted(hider) || !v.HiddenBy(hider) || v.HiddenBy(muted) {
+		t.Error("Muted and HiddenBy should report only their own lists")
+	}
+}
@@ -15,6 +15,9 @@ type Config struct {
 	GoogleCalendar `envPrefix:"GOOGLE_CALENDAR_"`
 	RevenueCat     `envPrefix:"REVENUECAT_"`
 	OAuth          `envPrefix:"OAUTH_"`
+	LLM            `envPrefix:"LLM_"`
+	STT            `envPrefix:"STT_"`
+	Feed           `envPrefix:"FEED_"`
 }
 
 func Load() (Config, error) {
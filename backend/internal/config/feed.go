@@ -0,0 +1,17 @@
+package config
+
+// Feed tunes the ranked home feed. Each signal is normalized to 0..1 and
+// multiplied by its weight; SeenPenalty is subtracted from items the viewer
+// has already scrolled past, so zeroing a weight switches its signal off.
+type Feed struct {
+	RecencyWeight        float64 `env:"RECENCY_WEIGHT" envDefault:"3"`
+	RecencyHalfLifeHours float64 `env:"RECENCY_HALF_LIFE_HOURS" envDefault:"12"`
+	FriendshipWeight     float64 `env:"FRIENDSHIP_WEIGHT" envDefault:"2"`
+	EngagementWeight     float64 `env:"ENGAGEMENT_WEIGHT" envDefault:"1.5"`
+	KudosWeight          float64 `env:"KUDOS_WEIGHT" envDefault:"1"`
+	AffinityWeight       float64 `env:"AFFINITY_WEIGHT" envDefault:"1"`
+	SeenPenalty          float64 `env:"SEEN_PENALTY" envDefault:"2.5"`
+	// RankedPoolSize is how many of the newest items a ranked session orders;
+	// scrolling past them continues chronologically.
+	RankedPoolSize int `env:"RANKED_POOL_SIZE" envDefault:"150"`
+}
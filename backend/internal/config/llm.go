@@ -0,0 +1,24 @@
+package config
+
+// LLM selects the backend behind the natural-language features. "gemini" uses
+// Genkit with Google AI; "openai" talks to any OpenAI-compatible chat
+// completions server (llama.cpp, Ollama, vLLM, OpenAI itself).
+type LLM struct {
+	Backend string `env:"BACKEND" envDefault:"gemini"`
+	Model   string `env:"MODEL"` // default model; empty uses the backend's default
+	// FlowModels overrides the model per flow, e.g.
+	// "intentRouter=qwen2.5:14b,suggestTaskFields=qwen2.5:3b".
+	FlowModels     string `env:"FLOW_MODELS"`
+	BaseURL        string `env:"BASE_URL" envDefault:"http://localhost:11434/v1"` // openai backend only
+	APIKey         string `env:"API_KEY"`                                         // openai backend only; optional for local servers
+	TimeoutSeconds int    `env:"TIMEOUT_SECONDS" envDefault:"120"`
+	// EmbeddingModel powers semantic matching such as duplicate detection.
+	// Empty uses text-embedding-004 on gemini and disables embeddings on
+	// openai, where callers fall back to lexical matching.
+	EmbeddingModel string `env:"EMBEDDING_MODEL"`
+	// TokenBudgets gives subscription tiers a monthly token budget for the
+	// AI features in place of the per-type credit counters, e.g.
+	// "free=50000,basic=250000,premium=2000000". Tiers left out keep the
+	// counters.
+	TokenBudgets string `env:"TOKEN_BUDGETS"`
+}
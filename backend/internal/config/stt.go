@@ -0,0 +1,16 @@
+package config
+
+// STT selects the speech-to-text backend for voice task creation. "openai"
+// talks to any server implementing the OpenAI /audio/transcriptions API: the
+// hosted service or a local Whisper-compatible server (whisper.cpp,
+// faster-whisper-server, speaches). Empty disables server-side transcription.
+type STT struct {
+	Backend        string `env:"BACKEND"`
+	BaseURL        string `env:"BASE_URL" envDefault:"https://api.openai.com/v1"`
+	APIKey         string `env:"API_KEY"` // optional for local servers
+	Model          string `env:"MODEL" envDefault:"whisper-1"`
+	TimeoutSeconds int    `env:"TIMEOUT_SECONDS" envDefault:"120"`
+	// MaxAudioMB caps an uploaded recording; the hosted API rejects files
+	// over 25 MB.
+	MaxAudioMB int `env:"MAX_AUDIO_MB" envDefault:"25"`
+}
@@ -84,3 +84,42 @@ func (s *Service) Bump(ctx context.Context, a, b primitive.ObjectID, delta int)
 		LeveledUp: LevelFor(updated.Score) > LevelFor(updated.Score-delta),
 	}
 }
+
+// Closeness maps a score onto 0..1 by level, so ranking sees the same steps
+// the client shows rather than raw points that grow without bound.
+func Closeness(score int) float64 {
+	return float64(LevelFor(score)-1) / float64(len(levelFloors)-1)
+}
+
+// Scores returns the viewer's pair score with every friend, keyed by the
+// friend's ID. Best-effort like Bump: failures log and return what was read.
+func (s *Service) Scores(ctx context.Context, viewer primitive.ObjectID) map[primitive.ObjectID]int {
+	scores := map[primitive.ObjectID]int{}
+	if s == nil || s.Connections == nil {
+		return scores
+	}
+	cursor, err := s.Connections.Find(ctx,
+		bson.M{"users": viewer, "status": Connection.StatusFriends},
+		options.Find().SetProjection(bson.M{"users": 1, "score": 1}),
+	)
+	if err != nil {
+		slog.Error("Failed to load friendship scores", "error", err, "user_id", viewer.Hex())
+		return scores
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var pair struct {
+			Users []primitive.ObjectID `bson:"users"`
+			Score int                  `bson:"score"`
+		}
+		if err := cursor.Decode(&pair); err != nil {
+			continue
+		}
+		for _, id := range pair.Users {
+			if id != viewer {
+				scores[id] = pair.Score
+			}
+		}
+	}
+	return scores
+}
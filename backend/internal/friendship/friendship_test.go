@@ -53,3 +53,15 @@ func TestLeveledUpBoundary(t *testing.T) {
 		}
 	}
 }
+
+func TestCloseness(t *testing.T) {
+	if got := Closeness(0); got != 0 {
+		t.Errorf("Closeness(0) = %v, want 0", got)
+	}
+	if got := Closeness(300); got != 1 {
+		t.Errorf("Closeness(300) = %v, want 1", got)
+	}
+	if a, b := Closeness(PointsKudos), Closeness(25); a <= 0 || a >= b {
+		t.Errorf("Closeness should rise by level: level 2 = %v, level 3 = %v", a, b)
+	}
+}
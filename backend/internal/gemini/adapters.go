@@ -0,0 +1,175 @@
+package gemini
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	Blueprint "github.com/abhikaboy/Kindred/internal/handlers/blueprint"
+	"github.com/abhikaboy/Kindred/internal/handlers/task"
+	"github.com/firebase/genkit/go/ai"
+	"github.com/firebase/genkit/go/core"
+)
+
+// NewTaskNLP adapts a Backend to the task handlers' NLPService. It returns nil
+// for a nil backend so the handlers report the service as unavailable.
+func NewTaskNLP(backend Backend) task.NLPService {
+	if backend == nil {
+		return nil
+	}
+	return taskNLP{backend: backend}
+}
+
+// NewBlueprintGenerator adapts a Backend to the blueprint handlers' Generator,
+// returning nil for a nil backend.
+func NewBlueprintGenerator(backend Backend) Blueprint.Generator {
+	if backend == nil {
+		return nil
+	}
+	return blueprintGenerator{backend: backend}
+}
+
+type taskNLP struct {
+	backend Backend
+}
+
+func (t taskNLP) ParseTasks(ctx context.Context, userID, text, timezone string) (*task.MultiTaskOutputLocal, error) {
+	out, err := t.backend.MultiTaskFromText(ctx, MultiTaskFromTextInputWithUser{UserID: userID, Text: text, Timezone: timezone})
+	if err != nil {
+		return nil, err
+	}
+	return convertOutput[task.MultiTaskOutputLocal](out)
+}
+
+func (t taskNLP) ParseTasksFromImage(ctx context.Context, userID, image, mimeType, timezone string) (*task.MultiTaskOutputLocal, error) {
+	out, err := t.backend.TaskFromImage(ctx, GenerateTaskFromImageParams{UserID: userID, Image: image, MimeType: mimeType, Timezone: timezone})
+	if err != nil {
+		return nil, err
+	}
+	return convertOutput[task.MultiTaskOutputLocal](out)
+}
+
+func (t taskNLP) QueryTasks(ctx context.Context, userID, text, timezone string) (*task.TaskQueryFiltersOutputLocal, error) {
+	out, err := t.backend.QueryTasks(ctx, QueryTasksFlowInput{UserID: userID, Text: text, Timezone: timezone})
+	if err != nil {
+		return nil, err
+	}
+	return convertOutput[task.TaskQueryFiltersOutputLocal](out)
+}
+
+func (t taskNLP) EditTasks(ctx context.Context, userID, text, timezone string) (*task.EditTasksFlowOutputLocal, error) {
+	out, err := t.backend.EditTasks(ctx, EditTasksFlowInput{UserID: userID, Text: text, Timezone: timezone})
+	if err != nil {
+		return nil, err
+	}
+	return convertOutput[task.EditTasksFlowOutputLocal](out)
+}
+
+func (t taskNLP) RouteIntent(ctx context.Context, userID, text, timezone string) (*task.IntentRouterOutputLocal, error) {
+	out, err := t.backend.RouteIntent(ctx, IntentRouterInput{UserID: userID, Text: text, Timezone: timezone})
+	if err != nil {
+		return nil, err
+	}
+	return convertOutput[task.IntentRouterOutputLocal](out)
+}
+
+func (t taskNLP) SuggestTaskFields(ctx context.Context, userID, text, timezone string) (*task.TaskFieldSuggestionLocal, error) {
+	out, err := t.backend.SuggestTaskFields(ctx, SuggestTaskFieldsFlowInput{UserID: userID, Text: text, Timezone: timezone})
+	if err != nil {
+		return nil, err
+	}
+	return convertOutput[task.TaskFieldSuggestionLocal](out)
+}
+
+func (t taskNLP) BreakdownTask(ctx context.Context, req task.TaskBreakdownRequest) (*task.TaskBreakdownLocal, error) {
+	out, err := t.backend.BreakdownTask(ctx, BreakdownTaskInput{
+		UserID:    req.UserID,
+		Timezone:  req.Timezone,
+		Content:   req.Content,
+		Notes:     req.Notes,
+		Deadline:  req.Deadline,
+		Priority:  req.Priority,
+		Value:     req.Value,
+		Checklist: req.Checklist,
+		Guidance:  req.Guidance,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertOutput[task.TaskBreakdownLocal](out)
+}
+
+func (t taskNLP) Embed(ctx context.Context, userID string, texts []string) (*task.EmbeddingsLocal, error) {
+	out, err := t.backend.Embed(ctx, EmbedInput{UserID: userID, Texts: texts})
+	if errors.Is(err, ErrEmbeddingsUnavailable) {
+		return nil, task.ErrEmbeddingsUnavailable
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Vectors) != len(texts) {
+		return nil, fmt.Errorf("embedder returned %d vectors for %d texts", len(out.Vectors), len(texts))
+	}
+	return &task.EmbeddingsLocal{Model: out.Model, Vectors: out.Vectors}, nil
+}
+
+func (t taskNLP) AssistantTurn(ctx context.Context, req task.AssistantTurnRequest) (*task.AssistantReplyLocal, error) {
+	input := AssistantTurnInput{UserID: req.UserID, Timezone: req.Timezone}
+	for _, m := range req.Messages {
+		input.Messages = append(input.Messages, AssistantMessage{Role: m.Role, Content: m.Content})
+	}
+	for _, tool := range req.Tools {
+		input.Tools = append(input.Tools, assistantTool(tool))
+	}
+	out, err := t.backend.AssistantTurn(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return convertOutput[task.AssistantReplyLocal](out)
+}
+
+// assistantTool wraps a task action as a dynamic tool. A failed action is
+// reported to the model as the tool result so it can correct itself or tell
+// the user, instead of aborting a turn that may already have made changes.
+func assistantTool(t task.AssistantTool) ai.Tool {
+	return ai.NewToolWithInputSchema(t.Name, t.Description, core.InferSchemaMap(t.Input),
+		func(tc *ai.ToolContext, in any) (any, error) {
+			raw, err := json.Marshal(in)
+			if err != nil {
+				return nil, err
+			}
+			out, err := t.Run(tc, raw)
+			if err != nil {
+				return map[string]string{"error": err.Error()}, nil
+			}
+			return out, nil
+		})
+}
+
+type blueprintGenerator struct {
+	backend Backend
+}
+
+func (b blueprintGenerator) GenerateBlueprint(ctx context.Context, userID, description string) (*Blueprint.GeneratedBlueprintData, error) {
+	out, err := b.backend.GenerateBlueprint(ctx, GenerateBlueprintInput{UserID: userID, Description: description})
+	if err != nil {
+		return nil, err
+	}
+	return convertOutput[Blueprint.GeneratedBlueprintData](out.Blueprint)
+}
+
+// convertOutput maps a flow output onto the consuming package's mirror type.
+// The mirrors share JSON tags with the flow types, so a JSON round trip is the
+// conversion.
+func convertOutput[T any](v any) (*T, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse AI response: %w", err)
+	}
+	var out T
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, fmt.Errorf("failed to parse AI response structure: %w", err)
+	}
+	return &out, nil
+}
@@ -0,0 +1,147 @@
+package gemini
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/abhikaboy/Kindred/internal/config"
+	"github.com/abhikaboy/Kindred/internal/unsplash"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Backend runs the natural-language flows. GeminiService (Genkit + Google AI)
+// and OpenAIBackend (any OpenAI-compatible chat completions server) implement it.
+type Backend interface {
+	RouteIntent(ctx context.Context, input IntentRouterInput) (IntentRouterOutput, error)
+	MultiTaskFromText(ctx context.Context, input MultiTaskFromTextInputWithUser) (MultiTaskFromTextOutput, error)
+	TaskFromImage(ctx context.Context, input GenerateTaskFromImageParams) (MultiTaskFromTextOutput, error)
+	QueryTasks(ctx context.Context, input QueryTasksFlowInput) (TaskQueryFiltersOutput, error)
+	EditTasks(ctx context.Context, input EditTasksFlowInput) (EditTasksFlowOutput, error)
+	SuggestTaskFields(ctx context.Context, input SuggestTaskFieldsFlowInput) (SuggestTaskFieldsFlowOutput, error)
+	GenerateBlueprint(ctx context.Context, input GenerateBlueprintInput) (GenerateBlueprintOutput, error)
+	AnalyticsReport(ctx context.Context, input AnalyticsReportInput) (AnalyticsReportOutput, error)
+	AssistantTurn(ctx context.Context, input AssistantTurnInput) (AssistantTurnOutput, error)
+	PlanMyDay(ctx context.Context, input PlanMyDayInput) (PlanMyDayOutput, error)
+	BreakdownTask(ctx context.Context, input BreakdownTaskInput) (BreakdownTaskOutput, error)
+	Embed(ctx context.Context, input EmbedInput) (EmbedOutput, error)
+}
+
+// ErrEmbeddingsUnavailable is returned by Embed when the backend has no
+// embedding model configured.
+var ErrEmbeddingsUnavailable = errors.New("no embedding model configured")
+
+// Flow names, used as keys for per-flow model overrides (LLM_FLOW_MODELS).
+const (
+	FlowIntentRouter      = "intentRouter"
+	FlowMultiTask         = "multiTask"
+	FlowTaskFromImage     = "taskFromImage"
+	FlowQueryTasks        = "queryTasks"
+	FlowEditTasks         = "editTasks"
+	FlowSuggestTaskFields = "suggestTaskFields"
+	FlowBlueprint         = "blueprint"
+	FlowAnalyticsReport   = "analyticsReport"
+	FlowAssistant         = "assistant"
+	FlowPlanMyDay         = "planMyDay"
+	FlowBreakdownTask     = "breakdownTask"
+)
+
+var flowNames = []string{
+	FlowIntentRouter, FlowMultiTask, FlowTaskFromImage, FlowQueryTasks,
+	FlowEditTasks, FlowSuggestTaskFields, FlowBlueprint, FlowAnalyticsReport,
+	FlowAssistant, FlowPlanMyDay, FlowBreakdownTask,
+}
+
+const (
+	BackendGemini = "gemini"
+	BackendOpenAI = "openai"
+
+	defaultGeminiModel     = "googleai/gemini-2.5-flash"
+	defaultGeminiEmbedding = "googleai/text-embedding-004"
+)
+
+// ModelSet maps flows to model names, falling back to Default. Embedding is
+// the embedding model; empty means the backend has none.
+type ModelSet struct {
+	Default   string
+	PerFlow   map[string]string
+	Embedding string
+}
+
+// For returns the model configured for flow.
+func (m ModelSet) For(flow string) string {
+	if model := m.PerFlow[flow]; model != "" {
+		return model
+	}
+	return m.Default
+}
+
+// ParseModelSet builds a ModelSet from a default model and a comma-separated
+// list of flow=model overrides. Unknown flow names are rejected so a typo
+// doesn't silently fall back to the default model.
+func ParseModelSet(defaultModel, spec string) (ModelSet, error) {
+	models := ModelSet{Default: defaultModel, PerFlow: map[string]string{}}
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		flow, model, ok := strings.Cut(pair, "=")
+		flow, model = strings.TrimSpace(flow), strings.TrimSpace(model)
+		if !ok || flow == "" || model == "" {
+			return ModelSet{}, fmt.Errorf("invalid flow model override %q, expected flow=model", pair)
+		}
+		known := false
+		for _, name := range flowNames {
+			if name == flow {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return ModelSet{}, fmt.Errorf("unknown flow %q in flow model overrides", flow)
+		}
+		models.PerFlow[flow] = model
+	}
+	return models, nil
+}
+
+// NewBackend builds the backend selected by cfg.Backend, reading user data
+// from the database.
+func NewBackend(cfg config.LLM, collections map[string]*mongo.Collection, unsplashClient *unsplash.Client) (Backend, error) {
+	return NewBackendFromSources(cfg, SourcesFromCollections(collections, unsplashClient))
+}
+
+// NewBackendFromSources builds the backend selected by cfg.Backend over the
+// given data sources.
+func NewBackendFromSources(cfg config.LLM, sources Sources) (Backend, error) {
+	switch strings.ToLower(cfg.Backend) {
+	case "", BackendGemini:
+		defaultModel := cfg.Model
+		if defaultModel == "" {
+			defaultModel = defaultGeminiModel
+		}
+		models, err := ParseModelSet(defaultModel, cfg.FlowModels)
+		if err != nil {
+			return nil, err
+		}
+		models.Embedding = cfg.EmbeddingModel
+		if models.Embedding == "" {
+			models.Embedding = defaultGeminiEmbedding
+		}
+		return NewGeminiService(sources, models), nil
+	case BackendOpenAI:
+		if cfg.Model == "" {
+			return nil, fmt.Errorf("LLM_MODEL is required for the %s backend", BackendOpenAI)
+		}
+		models, err := ParseModelSet(cfg.Model, cfg.FlowModels)
+		if err != nil {
+			return nil, err
+		}
+		models.Embedding = cfg.EmbeddingModel
+		return NewOpenAIBackend(cfg, models, sources), nil
+	default:
+		return nil, fmt.Errorf("unknown LLM backend %q (expected %q or %q)", cfg.Backend, BackendGemini, BackendOpenAI)
+	}
+}
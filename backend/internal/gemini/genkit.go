@@ -2,8 +2,8 @@ package gemini
 
 import (
 	"context"
+	"strings"
 
-	Category "github.com/abhikaboy/Kindred/internal/handlers/category"
 	"github.com/abhikaboy/Kindred/internal/unsplash"
 	"github.com/firebase/genkit/go/genkit"
 	"github.com/firebase/genkit/go/plugins/googlegenai"
@@ -11,21 +11,26 @@ import (
 )
 
 // InitGenkit initializes the Genkit service with all tools and flows
-func InitGenkit(collections map[string]*mongo.Collection, unsplashClient *unsplash.Client) *GeminiService {
+func InitGenkit(collections map[string]*mongo.Collection, unsplashClient *unsplash.Client, models ModelSet) *GeminiService {
+	return NewGeminiService(SourcesFromCollections(collections, unsplashClient), models)
+}
+
+// NewGeminiService initializes Genkit with the Google AI plugin over the given
+// data sources.
+func NewGeminiService(sources Sources, models ModelSet) *GeminiService {
+	models = qualifyGoogleAIModels(models)
+
 	// Initialize Genkit with the Google AI plugin
 	g := genkit.Init(context.Background(),
 		genkit.WithPlugins(&googlegenai.GoogleAI{}),
-		genkit.WithDefaultModel("googleai/gemini-2.5-flash"),
+		genkit.WithDefaultModel(models.Default),
 	)
 
 	// Initialize tools
-	tools := InitTools(g, collections, unsplashClient)
+	tools := InitTools(g, sources)
 
-	// Initialize category service for prompt injection
-	categoryService := Category.NewService(collections)
-
-	// Initialize flows with tools and category service
-	flows := InitFlows(g, tools, categoryService)
+	// Initialize flows with tools and the category source for prompt injection
+	flows := InitFlows(g, tools, sources.Categories, models)
 
 	return &GeminiService{
 		Genkit:                           g,
@@ -38,6 +43,34 @@ func InitGenkit(collections map[string]*mongo.Collection, unsplashClient *unspla
 		QueryTasksFlow:                   flows.QueryTasksFlow,
 		EditTasksFlow:                    flows.EditTasksFlow,
 		IntentRouterFlow:                 flows.IntentRouterFlow,
+		SuggestTaskFieldsFlow:            flows.SuggestTaskFieldsFlow,
+		PlanMyDayFlow:                    flows.PlanMyDayFlow,
+		BreakdownTaskFlow:                flows.BreakdownTaskFlow,
 		Tools:                            tools,
+		models:                           models,
+		categories:                       sources.Categories,
+	}
+}
+
+// qualifyGoogleAIModels prefixes bare model names ("gemini-2.5-pro") with the
+// googleai provider so config can use either form.
+func qualifyGoogleAIModels(models ModelSet) ModelSet {
+	qualify := func(name string) string {
+		if name == "" || strings.Contains(name, "/") {
+			return name
+		}
+		return "googleai/" + name
+	}
+	out := ModelSet{
+		Default:   qualify(models.Default),
+		PerFlow:   make(map[string]string, len(models.PerFlow)),
+		Embedding: qualify(models.Embedding),
+	}
+	if out.Default == "" {
+		out.Default = defaultGeminiModel
+	}
+	for flow, model := range models.PerFlow {
+		out.PerFlow[flow] = qualify(model)
 	}
+	return out
 }
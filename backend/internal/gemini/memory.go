@@ -34,6 +34,13 @@ const UserMemoryCollection = "user_memory"
 // arrive silently.
 const MinFactConfidence = 0.35
 
+// liveFact matches rows that haven't expired. Derived rows carry an
+// expiresAt the TTL monitor only sweeps about once a minute, and stated rows
+// carry none and never expire.
+func liveFact(now time.Time) bson.M {
+	return bson.M{"$not": bson.M{"$lte": now}}
+}
+
 // Fact keys this codebase reads by name. The full list lives in the contract;
 // these are the ones Go branches on rather than passes through as prose.
 const (
@@ -70,7 +77,7 @@ func LoadUserFacts(ctx context.Context, coll *mongo.Collection, userID primitive
 	}
 
 	cur, err := coll.Find(ctx,
-		bson.M{"userId": userID, "confidence": bson.M{"$gte": MinFactConfidence}},
+		bson.M{"userId": userID, "confidence": bson.M{"$gte": MinFactConfidence}, "expiresAt": liveFact(time.Now())},
 		options.Find().SetSort(bson.D{{Key: "confidence", Value: -1}}).SetLimit(limit),
 	)
 	if err != nil {
@@ -98,6 +105,7 @@ func LoadUserFact(ctx context.Context, coll *mongo.Collection, userID primitive.
 		"userId":     userID,
 		"key":        key,
 		"confidence": bson.M{"$gte": MinFactConfidence},
+		"expiresAt":  liveFact(time.Now()),
 	}).Decode(&fact)
 	if errors.Is(err, mongo.ErrNoDocuments) {
 		return nil, nil
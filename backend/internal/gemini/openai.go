@@ -0,0 +1,478 @@
+package gemini
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/config"
+	"github.com/firebase/genkit/go/ai"
+	"github.com/firebase/genkit/go/core"
+	"github.com/firebase/genkit/go/genkit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// maxToolRounds bounds the tool-call loop so a model that keeps asking for
+// tools can't hold a request open indefinitely.
+const maxToolRounds = 6
+
+// OpenAIBackend runs the flows against an OpenAI-compatible chat completions
+// endpoint (llama.cpp server, Ollama, vLLM, OpenAI). Prompts and tools are
+// shared with the Genkit backend; structured output is requested through a
+// JSON schema response format and parsed leniently, since local models don't
+// always honor it exactly.
+type OpenAIBackend struct {
+	baseURL    string
+	apiKey     string
+	models     ModelSet
+	client     *http.Client
+	tools      *ToolSet
+	categories CategorySource
+}
+
+var _ Backend = (*OpenAIBackend)(nil)
+
+func NewOpenAIBackend(cfg config.LLM, models ModelSet, sources Sources) *OpenAIBackend {
+	// Genkit is only the registry for the shared tools here; no model plugin
+	// is loaded, so no Google credentials are needed.
+	g := genkit.Init(context.Background())
+
+	timeout := time.Duration(cfg.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 120 * time.Second
+	}
+	return &OpenAIBackend{
+		baseURL:    strings.TrimRight(cfg.BaseURL, "/"),
+		apiKey:     cfg.APIKey,
+		models:     models,
+		client:     &http.Client{Timeout: timeout},
+		tools:      InitTools(g, sources),
+		categories: sources.Categories,
+	}
+}
+
+func (b *OpenAIBackend) RouteIntent(ctx context.Context, input IntentRouterInput) (IntentRouterOutput, error) {
+	summary, err := b.categorySummary(input.UserID)
+	if err != nil {
+		return IntentRouterOutput{}, err
+	}
+	prompt := intentRouterPrompt(summary, input.UserID, nowRFC3339(ctx), input.Timezone, input.Text)
+	out, err := generateJSON[IntentRouterOutput](ctx, b, FlowIntentRouter, textMessage(prompt), b.tools.GetUserActiveTasks)
+	if err != nil {
+		return IntentRouterOutput{}, err
+	}
+	if out.Ops == nil {
+		out.Ops = []IntentOp{}
+	}
+	return out, nil
+}
+
+func (b *OpenAIBackend) MultiTaskFromText(ctx context.Context, input MultiTaskFromTextInputWithUser) (MultiTaskFromTextOutput, error) {
+	summary, err := b.categorySummary(input.UserID)
+	if err != nil {
+		return MultiTaskFromTextOutput{}, err
+	}
+	prompt := multiTaskWithContextPrompt(summary, nowRFC3339(ctx), input.Text)
+	return generateJSON[MultiTaskFromTextOutput](ctx, b, FlowMultiTask, textMessage(prompt))
+}
+
+func (b *OpenAIBackend) TaskFromImage(ctx context.Context, input GenerateTaskFromImageParams) (MultiTaskFromTextOutput, error) {
+	summary, err := b.categorySummary(input.UserID)
+	if err != nil {
+		return MultiTaskFromTextOutput{}, err
+	}
+	mimeType := input.MimeType
+	if mimeType == "" {
+		mimeType = "image/jpeg"
+	}
+	msg := chatMessage{
+		Role: "user",
+		Content: []chatContentPart{
+			{Type: "image_url", ImageURL: &chatImageURL{URL: fmt.Sprintf("data:%s;base64,%s", mimeType, input.Image)}},
+			{Type: "text", Text: taskFromImagePrompt(summary, nowRFC3339(ctx))},
+		},
+	}
+	return generateJSON[MultiTaskFromTextOutput](ctx, b, FlowTaskFromImage, msg)
+}
+
+func (b *OpenAIBackend) QueryTasks(ctx context.Context, input QueryTasksFlowInput) (TaskQueryFiltersOutput, error) {
+	summary, err := b.categorySummary(input.UserID)
+	if err != nil {
+		return TaskQueryFiltersOutput{}, err
+	}
+	prompt := queryTasksPrompt(summary, nowRFC3339(ctx), input.Timezone, input.Text)
+	return generateJSON[TaskQueryFiltersOutput](ctx, b, FlowQueryTasks, textMessage(prompt))
+}
+
+func (b *OpenAIBackend) EditTasks(ctx context.Context, input EditTasksFlowInput) (EditTasksFlowOutput, error) {
+	summary, err := b.categorySummary(input.UserID)
+	if err != nil {
+		return EditTasksFlowOutput{}, err
+	}
+	prompt := editTasksPrompt(summary, input.UserID, nowRFC3339(ctx), input.Timezone, input.Text)
+	return generateJSON[EditTasksFlowOutput](ctx, b, FlowEditTasks, textMessage(prompt), b.tools.GetUserActiveTasks)
+}
+
+func (b *OpenAIBackend) SuggestTaskFields(ctx context.Context, input SuggestTaskFieldsFlowInput) (SuggestTaskFieldsFlowOutput, error) {
+	summary, err := b.categorySummary(input.UserID)
+	if err != nil {
+		return SuggestTaskFieldsFlowOutput{}, err
+	}
+	prompt := suggestTaskFieldsPrompt(summary, nowRFC3339(ctx), input.Timezone, input.Text)
+	return generateJSON[SuggestTaskFieldsFlowOutput](ctx, b, FlowSuggestTaskFields, textMessage(prompt))
+}
+
+func (b *OpenAIBackend) GenerateBlueprint(ctx context.Context, input GenerateBlueprintInput) (GenerateBlueprintOutput, error) {
+	summary, err := b.categorySummary(input.UserID)
+	if err != nil {
+		return GenerateBlueprintOutput{}, err
+	}
+	prompt := blueprintPrompt(input.Description, nowRFC3339(ctx), summary)
+	return generateJSON[GenerateBlueprintOutput](ctx, b, FlowBlueprint, textMessage(prompt), b.tools.FetchUnsplashImage)
+}
+
+func (b *OpenAIBackend) AnalyticsReport(ctx context.Context, input AnalyticsReportInput) (AnalyticsReportOutput, error) {
+	prompt := analyticsReportPrompt(input.UserID, input.Limit, nowRFC3339(ctx))
+	return generateJSON[AnalyticsReportOutput](ctx, b, FlowAnalyticsReport, textMessage(prompt),
+		b.tools.GetCompletedTasks, b.tools.GetUserCategories)
+}
+
+func (b *OpenAIBackend) AssistantTurn(ctx context.Context, input AssistantTurnInput) (AssistantTurnOutput, error) {
+	summary, err := b.categorySummary(input.UserID)
+	if err != nil {
+		return AssistantTurnOutput{}, err
+	}
+	messages := []chatMessage{{Role: "system", Content: assistantPrompt(summary, input.UserID, nowRFC3339(ctx), input.Timezone)}}
+	for _, m := range input.Messages {
+		role := "user"
+		if m.Role == "assistant" {
+			role = "assistant"
+		}
+		messages = append(messages, chatMessage{Role: role, Content: m.Content})
+	}
+	tools := append([]ai.Tool{b.tools.GetUserActiveTasks}, input.Tools...)
+	return generateJSONFromMessages[AssistantTurnOutput](ctx, b, FlowAssistant, messages, tools...)
+}
+
+func (b *OpenAIBackend) PlanMyDay(ctx context.Context, input PlanMyDayInput) (PlanMyDayOutput, error) {
+	prompt, err := planMyDayPromptFor(ctx, input)
+	if err != nil {
+		return PlanMyDayOutput{}, err
+	}
+	return generateJSON[PlanMyDayOutput](ctx, b, FlowPlanMyDay, textMessage(prompt))
+}
+
+func (b *OpenAIBackend) BreakdownTask(ctx context.Context, input BreakdownTaskInput) (BreakdownTaskOutput, error) {
+	prompt, err := breakdownTaskPromptFor(ctx, input)
+	if err != nil {
+		return BreakdownTaskOutput{}, err
+	}
+	return generateJSON[BreakdownTaskOutput](ctx, b, FlowBreakdownTask, textMessage(prompt),
+		b.tools.GetUserCategories, b.tools.GetCompletedTasks)
+}
+
+// Embed calls the /embeddings endpoint with the configured embedding model.
+func (b *OpenAIBackend) Embed(ctx context.Context, input EmbedInput) (EmbedOutput, error) {
+	if b.models.Embedding == "" {
+		return EmbedOutput{}, ErrEmbeddingsUnavailable
+	}
+	ctx, span := otel.Tracer("kindred").Start(ctx, "llm.openai.embed")
+	defer span.End()
+
+	var resp embeddingsResponse
+	err := b.post(ctx, "/embeddings", embeddingsRequest{Model: b.models.Embedding, Input: input.Texts}, &resp)
+	meterTokens(ctx, b.models.Embedding, resp.Usage.PromptTokens, 0)
+	if err == nil && len(resp.Data) != len(input.Texts) {
+		err = fmt.Errorf("embeddings returned %d vectors for %d texts", len(resp.Data), len(input.Texts))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return EmbedOutput{}, err
+	}
+	out := EmbedOutput{Model: b.models.Embedding, Vectors: make([][]float32, len(resp.Data))}
+	for _, d := range resp.Data {
+		if d.Index < 0 || d.Index >= len(out.Vectors) {
+			return EmbedOutput{}, fmt.Errorf("embeddings returned out-of-range index %d", d.Index)
+		}
+		out.Vectors[d.Index] = d.Embedding
+	}
+	return out, nil
+}
+
+func (b *OpenAIBackend) categorySummary(userIDHex string) (string, error) {
+	userID, err := primitive.ObjectIDFromHex(userIDHex)
+	if err != nil {
+		return "", fmt.Errorf("invalid user ID: %w", err)
+	}
+	summary, err := b.categories.GetCategoryNamesSummary(userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get category summary: %w", err)
+	}
+	return summary, nil
+}
+
+// --- OpenAI chat completions wire types ---
+
+type chatMessage struct {
+	Role       string         `json:"role"`
+	Content    any            `json:"content,omitempty"` // string or []chatContentPart
+	ToolCalls  []chatToolCall `json:"tool_calls,omitempty"`
+	ToolCallID string         `json:"tool_call_id,omitempty"`
+}
+
+type chatContentPart struct {
+	Type     string        `json:"type"`
+	Text     string        `json:"text,omitempty"`
+	ImageURL *chatImageURL `json:"image_url,omitempty"`
+}
+
+type chatImageURL struct {
+	URL string `json:"url"`
+}
+
+type chatToolCall struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Function struct {
+		Name      string `json:"name"`
+		Arguments string `json:"arguments"`
+	} `json:"function"`
+}
+
+type chatTool struct {
+	Type     string           `json:"type"`
+	Function chatToolFunction `json:"function"`
+}
+
+type chatToolFunction struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Parameters  map[string]any `json:"parameters,omitempty"`
+}
+
+type chatResponseFormat struct {
+	Type       string `json:"type"`
+	JSONSchema struct {
+		Name   string         `json:"name"`
+		Schema map[string]any `json:"schema"`
+	} `json:"json_schema"`
+}
+
+type chatRequest struct {
+	Model          string              `json:"model"`
+	Messages       []chatMessage       `json:"messages"`
+	Tools          []chatTool          `json:"tools,omitempty"`
+	ResponseFormat *chatResponseFormat `json:"response_format,omitempty"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message struct {
+			Content   string         `json:"content"`
+			ToolCalls []chatToolCall `json:"tool_calls"`
+		} `json:"message"`
+	} `json:"choices"`
+	Usage chatUsage `json:"usage"`
+}
+
+// chatUsage is the token count a server reports per request; servers that
+// don't report it leave it zero.
+type chatUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+}
+
+// chatError is the error body OpenAI-compatible servers return on failure.
+type chatError struct {
+	Message string `json:"message"`
+}
+
+type embeddingsRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type embeddingsResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+	Usage chatUsage `json:"usage"`
+}
+
+func textMessage(prompt string) chatMessage {
+	return chatMessage{Role: "user", Content: prompt}
+}
+
+// generateJSON runs one flow: it sends the message with the tools and the
+// output schema, executes any tool calls the model makes, and decodes the
+// final answer into T.
+func generateJSON[T any](ctx context.Context, b *OpenAIBackend, flow string, msg chatMessage, tools ...ai.Tool) (T, error) {
+	return generateJSONFromMessages[T](ctx, b, flow, []chatMessage{msg}, tools...)
+}
+
+// generateJSONFromMessages is generateJSON over a whole conversation.
+func generateJSONFromMessages[T any](ctx context.Context, b *OpenAIBackend, flow string, messages []chatMessage, tools ...ai.Tool) (T, error) {
+	var zero T
+	ctx, span := otel.Tracer("kindred").Start(ctx, "llm.openai."+flow)
+	defer span.End()
+
+	req := chatRequest{
+		Model:    b.models.For(flow),
+		Messages: messages,
+	}
+	req.ResponseFormat = &chatResponseFormat{Type: "json_schema"}
+	req.ResponseFormat.JSONSchema.Name = flow
+	req.ResponseFormat.JSONSchema.Schema = core.InferSchemaMap(zero)
+
+	toolsByName := make(map[string]ai.Tool, len(tools))
+	for _, tool := range tools {
+		def := tool.Definition()
+		toolsByName[def.Name] = tool
+		req.Tools = append(req.Tools, chatTool{
+			Type: "function",
+			Function: chatToolFunction{
+				Name:        def.Name,
+				Description: def.Description,
+				Parameters:  def.InputSchema,
+			},
+		})
+	}
+
+	for round := 0; round <= maxToolRounds; round++ {
+		resp, err := b.complete(ctx, req)
+		if err != nil {
+			meterTokens(ctx, req.Model, 0, 0)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return zero, err
+		}
+		meterTokens(ctx, req.Model, resp.Usage.PromptTokens, resp.Usage.CompletionTokens)
+		reply := resp.Choices[0].Message
+		if len(reply.ToolCalls) == 0 {
+			out, err := decodeModelJSON[T](reply.Content)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				return zero, err
+			}
+			return out, nil
+		}
+
+		req.Messages = append(req.Messages, chatMessage{Role: "assistant", ToolCalls: reply.ToolCalls})
+		for _, call := range reply.ToolCalls {
+			req.Messages = append(req.Messages, chatMessage{
+				Role:       "tool",
+				ToolCallID: call.ID,
+				Content:    runToolCall(ctx, toolsByName, call),
+			})
+		}
+	}
+
+	err := fmt.Errorf("%s: model did not produce an answer after %d tool rounds", flow, maxToolRounds)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	return zero, err
+}
+
+// runToolCall executes one tool call and returns its JSON result. Failures are
+// reported back to the model as the tool result rather than aborting the
+// flow, matching how Genkit surfaces tool errors.
+func runToolCall(ctx context.Context, tools map[string]ai.Tool, call chatToolCall) string {
+	tool, ok := tools[call.Function.Name]
+	if !ok {
+		return fmt.Sprintf(`{"error":"unknown tool %q"}`, call.Function.Name)
+	}
+	args := call.Function.Arguments
+	if strings.TrimSpace(args) == "" {
+		args = "{}"
+	}
+	result, err := tool.RunRaw(ctx, json.RawMessage(args))
+	if err != nil {
+		errJSON, _ := json.Marshal(map[string]string{"error": err.Error()})
+		return string(errJSON)
+	}
+	out, err := json.Marshal(result)
+	if err != nil {
+		return `{"error":"tool result could not be encoded"}`
+	}
+	return string(out)
+}
+
+func (b *OpenAIBackend) complete(ctx context.Context, req chatRequest) (*chatResponse, error) {
+	var resp chatResponse
+	if err := b.post(ctx, "/chat/completions", req, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Choices) == 0 {
+		return nil, fmt.Errorf("chat completions returned no choices")
+	}
+	return &resp, nil
+}
+
+// post sends a JSON request to path and decodes the response into out,
+// turning non-200 replies into errors carrying the server's message.
+func (b *OpenAIBackend) post(ctx context.Context, path string, req, out any) error {
+	name := strings.TrimPrefix(strings.ReplaceAll(path, "/", " "), " ")
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s request: %w", name, err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, b.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if b.apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+b.apiKey)
+	}
+
+	httpResp, err := b.client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("%s request failed: %w", name, err)
+	}
+	defer httpResp.Body.Close()
+
+	raw, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read %s response: %w", name, err)
+	}
+	var apiErr struct {
+		Error *chatError `json:"error"`
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		msg := strings.TrimSpace(string(raw))
+		if json.Unmarshal(raw, &apiErr) == nil && apiErr.Error != nil {
+			msg = apiErr.Error.Message
+		}
+		return fmt.Errorf("%s returned %d: %s", name, httpResp.StatusCode, msg)
+	}
+	if err := json.Unmarshal(raw, out); err != nil {
+		return fmt.Errorf("%s returned %d with an unreadable body: %w", name, httpResp.StatusCode, err)
+	}
+	return nil
+}
+
+// decodeModelJSON parses a model's final answer. Local models often wrap JSON
+// in markdown fences or add a sentence around it, so the outermost object is
+// extracted before decoding.
+func decodeModelJSON[T any](content string) (T, error) {
+	var out T
+	start := strings.Index(content, "{")
+	end := strings.LastIndex(content, "}")
+	if start < 0 || end < start {
+		return out, fmt.Errorf("model response contained no JSON object")
+	}
+	if err := json.Unmarshal([]byte(content[start:end+1]), &out); err != nil {
+		return out, fmt.Errorf("failed to parse AI response structure: %w", err)
+	}
+	return out, nil
+}
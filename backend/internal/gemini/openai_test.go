@@ -0,0 +1,173 @@
+package gemini
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/firebase/genkit/go/ai"
+	"github.com/firebase/genkit/go/genkit"
+)
+
+func TestParseModelSet(t *testing.T) {
+	models, err := ParseModelSet("llama3.1:8b", " intentRouter=qwen2.5:14b , suggestTaskFields=qwen2.5:3b,")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := models.For(FlowIntentRouter); got != "qwen2.5:14b" {
+		t.Errorf("intentRouter model = %q", got)
+	}
+	if got := models.For(FlowSuggestTaskFields); got != "qwen2.5:3b" {
+		t.Errorf("suggestTaskFields model = %q", got)
+	}
+	if got := models.For(FlowBlueprint); got != "llama3.1:8b" {
+		t.Errorf("blueprint should fall back to the default, got %q", got)
+	}
+
+	for _, spec := range []string{"intentRouter", "intentRouter=", "nope=model"} {
+		if _, err := ParseModelSet("m", spec); err == nil {
+			t.Errorf("expected an error for %q", spec)
+		}
+	}
+}
+
+func TestQualifyGoogleAIModels(t *testing.T) {
+	models := qualifyGoogleAIModels(ModelSet{
+		Default: "",
+		PerFlow: map[string]string{FlowBlueprint: "gemini-2.5-pro", FlowQueryTasks: "vertexai/gemini-2.5-flash"},
+	})
+	if models.Default != defaultGeminiModel {
+		t.Errorf("default = %q", models.Default)
+	}
+	if got := models.For(FlowBlueprint); got != "googleai/gemini-2.5-pro" {
+		t.Errorf("bare model not qualified: %q", got)
+	}
+	if got := models.For(FlowQueryTasks); got != "vertexai/gemini-2.5-flash" {
+		t.Errorf("qualified model changed: %q", got)
+	}
+}
+
+func TestDecodeModelJSON(t *testing.T) {
+	fenced := "Here you go:\n```json\n{\"priority\": 3}\n```"
+	out, err := decodeModelJSON[SuggestTaskFieldsFlowOutput](fenced)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Priority == nil || *out.Priority != 3 {
+		t.Errorf("priority = %v", out.Priority)
+	}
+
+	if _, err := decodeModelJSON[SuggestTaskFieldsFlowOutput]("I can't help with that."); err == nil {
+		t.Error("expected an error for a reply without JSON")
+	}
+}
+
+func TestGenerateJSONRunsToolCalls(t *testing.T) {
+	type lookupInput struct {
+		Query string `json:"query"`
+	}
+	type lookupOutput struct {
+		Match string `json:"match"`
+	}
+	g := genkit.Init(context.Background())
+	var toolInput string
+	lookup := genkit.DefineTool(g, "lookup", "Looks something up",
+		func(ctx *ai.ToolContext, input lookupInput) (lookupOutput, error) {
+			toolInput = input.Query
+			return lookupOutput{Match: "Buy groceries"}, nil
+		})
+
+	var requests []chatRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chat/completions" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+			t.Errorf("authorization header = %q", got)
+		}
+		var req chatRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		requests = append(requests, req)
+
+		if len(requests) == 1 {
+			w.Write([]byte(`{"choices":[{"message":{"tool_calls":[{"id":"call_1","type":"function","function":{"name":"lookup","arguments":"{\"query\":\"groceries\"}"}}]}}]}`))
+			return
+		}
+		w.Write([]byte(`{"choices":[{"message":{"content":"{\"priority\": 2, \"value\": 1}"}}]}`))
+	}))
+	defer srv.Close()
+
+	b := &OpenAIBackend{
+		baseURL: srv.URL,
+		apiKey:  "secret",
+		models:  ModelSet{Default: "local", PerFlow: map[string]string{FlowSuggestTaskFields: "small"}},
+		client:  srv.Client(),
+	}
+	out, err := generateJSON[SuggestTaskFieldsFlowOutput](context.Background(), b, FlowSuggestTaskFields, textMessage("prompt"), lookup)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if out.Priority == nil || *out.Priority != 2 || out.Value == nil || *out.Value != 1 {
+		t.Errorf("unexpected output %+v", out)
+	}
+	if toolInput != "groceries" {
+		t.Errorf("tool received %q", toolInput)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+	if requests[0].Model != "small" {
+		t.Errorf("per-flow model not used: %q", requests[0].Model)
+	}
+	if requests[0].ResponseFormat == nil || requests[0].ResponseFormat.JSONSchema.Schema == nil {
+		t.Error("expected a JSON schema response format")
+	}
+	if len(requests[0].Tools) != 1 || requests[0].Tools[0].Function.Name != "lookup" {
+		t.Errorf("tools not advertised: %+v", requests[0].Tools)
+	}
+	second := requests[1].Messages
+	if len(second) != 3 || second[1].Role != "assistant" || second[2].Role != "tool" || second[2].ToolCallID != "call_1" {
+		t.Fatalf("unexpected follow-up messages: %+v", second)
+	}
+	if content, _ := second[2].Content.(string); content != `{"match":"Buy groceries"}` {
+		t.Errorf("tool result = %v", second[2].Content)
+	}
+}
+
+func TestOpenAIEmbed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/embeddings" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		var req embeddingsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if req.Model != "nomic-embed-text" || len(req.Input) != 2 {
+			t.Errorf("unexpected request %+v", req)
+		}
+		// Out of order on purpose: vectors are placed by index.
+		w.Write([]byte(`{"data":[{"index":1,"embedding":[0,1]},{"index":0,"embedding":[1,0]}]}`))
+	}))
+	defer srv.Close()
+
+	b := &OpenAIBackend{baseURL: srv.URL, models: ModelSet{Embedding: "nomic-embed-text"}, client: srv.Client()}
+	out, err := b.Embed(context.Background(), EmbedInput{Texts: []string{"call mom", "buy milk"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Model != "nomic-embed-text" || len(out.Vectors) != 2 || out.Vectors[0][0] != 1 || out.Vectors[1][1] != 1 {
+		t.Errorf("unexpected output %+v", out)
+	}
+
+	b.models.Embedding = ""
+	if _, err := b.Embed(context.Background(), EmbedInput{Texts: []string{"x"}}); !errors.Is(err, ErrEmbeddingsUnavailable) {
+		t.Errorf("err = %v, want ErrEmbeddingsUnavailable", err)
+	}
+}
@@ -1,9 +1,16 @@
 package gemini
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/abhikaboy/Kindred/internal/handlers/task"
+	"github.com/firebase/genkit/go/ai"
 	"github.com/firebase/genkit/go/core"
 	"github.com/firebase/genkit/go/genkit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
 )
 
 type GeminiService struct {
@@ -17,5 +24,126 @@ type GeminiService struct {
 	QueryTasksFlow                   *core.Flow[QueryTasksFlowInput, TaskQueryFiltersOutput, struct{}]
 	EditTasksFlow                    *core.Flow[EditTasksFlowInput, EditTasksFlowOutput, struct{}]
 	IntentRouterFlow                 *core.Flow[IntentRouterInput, IntentRouterOutput, struct{}]
+	SuggestTaskFieldsFlow            *core.Flow[SuggestTaskFieldsFlowInput, SuggestTaskFieldsFlowOutput, struct{}]
+	PlanMyDayFlow                    *core.Flow[PlanMyDayInput, PlanMyDayOutput, struct{}]
+	BreakdownTaskFlow                *core.Flow[BreakdownTaskInput, BreakdownTaskOutput, struct{}]
 	Tools                            *ToolSet
+
+	models     ModelSet
+	categories CategorySource
+}
+
+var _ Backend = (*GeminiService)(nil)
+
+func (s *GeminiService) RouteIntent(ctx context.Context, input IntentRouterInput) (IntentRouterOutput, error) {
+	return s.IntentRouterFlow.Run(ctx, input)
+}
+
+func (s *GeminiService) MultiTaskFromText(ctx context.Context, input MultiTaskFromTextInputWithUser) (MultiTaskFromTextOutput, error) {
+	return s.MultiTaskFromTextFlowWithContext.Run(ctx, input)
+}
+
+func (s *GeminiService) TaskFromImage(ctx context.Context, input GenerateTaskFromImageParams) (MultiTaskFromTextOutput, error) {
+	return s.TaskFromImageFlow.Run(ctx, input)
+}
+
+func (s *GeminiService) QueryTasks(ctx context.Context, input QueryTasksFlowInput) (TaskQueryFiltersOutput, error) {
+	return s.QueryTasksFlow.Run(ctx, input)
+}
+
+func (s *GeminiService) EditTasks(ctx context.Context, input EditTasksFlowInput) (EditTasksFlowOutput, error) {
+	return s.EditTasksFlow.Run(ctx, input)
+}
+
+func (s *GeminiService) SuggestTaskFields(ctx context.Context, input SuggestTaskFieldsFlowInput) (SuggestTaskFieldsFlowOutput, error) {
+	return s.SuggestTaskFieldsFlow.Run(ctx, input)
+}
+
+func (s *GeminiService) GenerateBlueprint(ctx context.Context, input GenerateBlueprintInput) (GenerateBlueprintOutput, error) {
+	return s.GenerateBlueprintFlow.Run(ctx, input)
+}
+
+func (s *GeminiService) AnalyticsReport(ctx context.Context, input AnalyticsReportInput) (AnalyticsReportOutput, error) {
+	return s.AnalyticsReportFlow.Run(ctx, input)
+}
+
+func (s *GeminiService) PlanMyDay(ctx context.Context, input PlanMyDayInput) (PlanMyDayOutput, error) {
+	return s.PlanMyDayFlow.Run(ctx, input)
+}
+
+func (s *GeminiService) BreakdownTask(ctx context.Context, input BreakdownTaskInput) (BreakdownTaskOutput, error) {
+	return s.BreakdownTaskFlow.Run(ctx, input)
+}
+
+func (s *GeminiService) Embed(ctx context.Context, input EmbedInput) (EmbedOutput, error) {
+	if s.models.Embedding == "" {
+		return EmbedOutput{}, ErrEmbeddingsUnavailable
+	}
+	ctx, span := otel.Tracer("kindred").Start(ctx, "gemini.Embed")
+	defer span.End()
+
+	resp, err := genkit.Embed(ctx, s.Genkit,
+		ai.WithEmbedderName(s.models.Embedding),
+		ai.WithTextDocs(input.Texts...),
+	)
+	// Embedders report no token counts through Genkit.
+	meterTokens(ctx, s.models.Embedding, 0, 0)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return EmbedOutput{}, err
+	}
+	if len(resp.Embeddings) != len(input.Texts) {
+		return EmbedOutput{}, fmt.Errorf("embedder returned %d vectors for %d texts", len(resp.Embeddings), len(input.Texts))
+	}
+	out := EmbedOutput{Model: s.models.Embedding, Vectors: make([][]float32, len(resp.Embeddings))}
+	for i, e := range resp.Embeddings {
+		out.Vectors[i] = e.Embedding
+	}
+	return out, nil
+}
+
+// AssistantTurn runs one conversation turn. It isn't a registered flow: the
+// action tools are built per request around the caller's changeset, so they
+// are passed to Generate as dynamic tools.
+func (s *GeminiService) AssistantTurn(ctx context.Context, input AssistantTurnInput) (AssistantTurnOutput, error) {
+	ctx, span := otel.Tracer("kindred").Start(ctx, "gemini.AssistantTurn")
+	defer span.End()
+
+	userID, err := primitive.ObjectIDFromHex(input.UserID)
+	if err != nil {
+		return AssistantTurnOutput{}, fmt.Errorf("invalid user ID: %w", err)
+	}
+	categorySummary, err := s.categories.GetCategoryNamesSummary(userID)
+	if err != nil {
+		return AssistantTurnOutput{}, fmt.Errorf("failed to get category summary: %w", err)
+	}
+
+	messages := make([]*ai.Message, 0, len(input.Messages))
+	for _, m := range input.Messages {
+		if m.Role == "assistant" {
+			messages = append(messages, ai.NewModelTextMessage(m.Content))
+		} else {
+			messages = append(messages, ai.NewUserTextMessage(m.Content))
+		}
+	}
+	tools := []ai.ToolRef{s.Tools.GetUserActiveTasks}
+	for _, tool := range input.Tools {
+		tools = append(tools, tool)
+	}
+
+	out, modelResp, err := genkit.GenerateData[AssistantTurnOutput](ctx, s.Genkit,
+		ai.WithModelName(s.models.For(FlowAssistant)),
+		ai.WithSystem(assistantPrompt(categorySummary, input.UserID, nowRFC3339(ctx), input.Timezone)),
+		ai.WithMessages(messages...),
+		ai.WithTools(tools...),
+		ai.WithMaxTurns(maxToolRounds),
+	)
+	meterResponse(ctx, s.models.For(FlowAssistant), modelResp)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return AssistantTurnOutput{}, err
+	}
+	return *out, nil
 }
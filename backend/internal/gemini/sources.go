@@ -0,0 +1,61 @@
+package gemini
+
+import (
+	"context"
+	"time"
+
+	Category "github.com/abhikaboy/Kindred/internal/handlers/category"
+	"github.com/abhikaboy/Kindred/internal/handlers/task"
+	"github.com/abhikaboy/Kindred/internal/unsplash"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// CategorySource is the category data the flows and tools read.
+// *Category.Service implements it; the NLP eval harness substitutes fixtures.
+type CategorySource interface {
+	GetCategoriesByUser(userID primitive.ObjectID) ([]Category.WorkspaceResult, error)
+	GetCategoryNamesSummary(userID primitive.ObjectID) (string, error)
+}
+
+// TaskSource is the task data the tools read. *task.Service implements it.
+type TaskSource interface {
+	GetCompletedTasks(userID primitive.ObjectID, page int, limit int) ([]task.TaskDocument, int64, error)
+	GetTemplatesByUserWithCategory(userID primitive.ObjectID) ([]task.TemplateWithCategory, error)
+}
+
+// Sources are the data dependencies of a Backend.
+type Sources struct {
+	Categories CategorySource
+	Tasks      TaskSource
+	Unsplash   *unsplash.Client // optional; nil makes fetchUnsplashImage fail
+}
+
+// SourcesFromCollections backs the flows and tools with the database.
+func SourcesFromCollections(collections map[string]*mongo.Collection, unsplashClient *unsplash.Client) Sources {
+	return Sources{
+		Categories: Category.NewService(collections),
+		Tasks:      task.NewService(collections),
+		Unsplash:   unsplashClient,
+	}
+}
+
+type nowKey struct{}
+
+// WithNow freezes the time the flows see as "now", so relative dates in the
+// prompts ("by friday") resolve deterministically.
+func WithNow(ctx context.Context, now time.Time) context.Context {
+	return context.WithValue(ctx, nowKey{}, now)
+}
+
+// Now returns the time frozen with WithNow, or the current time.
+func Now(ctx context.Context) time.Time {
+	if now, ok := ctx.Value(nowKey{}).(time.Time); ok {
+		return now
+	}
+	return time.Now()
+}
+
+func nowRFC3339(ctx context.Context) string {
+	return Now(ctx).UTC().Format(time.RFC3339)
+}
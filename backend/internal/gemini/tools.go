@@ -6,13 +6,9 @@ import (
 	"strings"
 	"time"
 
-	Category "github.com/abhikaboy/Kindred/internal/handlers/category"
-	Task "github.com/abhikaboy/Kindred/internal/handlers/task"
-	"github.com/abhikaboy/Kindred/internal/unsplash"
 	"github.com/firebase/genkit/go/ai"
 	"github.com/firebase/genkit/go/genkit"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo"
 )
 
 // ToolSet contains all Genkit tools
@@ -24,10 +20,10 @@ type ToolSet struct {
 }
 
 // InitTools initializes and registers all Genkit tools
-func InitTools(g *genkit.Genkit, collections map[string]*mongo.Collection, unsplashClient *unsplash.Client) *ToolSet {
-	// Create services for database access
-	categoryService := Category.NewService(collections)
-	taskService := Task.NewService(collections)
+func InitTools(g *genkit.Genkit, sources Sources) *ToolSet {
+	categoryService := sources.Categories
+	taskService := sources.Tasks
+	unsplashClient := sources.Unsplash
 
 	// Define tool to fetch user categories from database
 	getUserCategoriesTool := genkit.DefineTool(
@@ -35,10 +31,9 @@ func InitTools(g *genkit.Genkit, collections map[string]*mongo.Collection, unspl
 		"getUserCategories",
 		"Fetches all categories for a specific user from the database, grouped by workspace. Use this to understand the user's existing organizational structure before creating new tasks or categories.",
 		func(ctx *ai.ToolContext, input GetUserCategoriesInput) (GetUserCategoriesOutput, error) {
-			// Convert user ID string to ObjectID
-			userID, err := primitive.ObjectIDFromHex(input.UserID)
+			userID, err := toolCaller(ctx, "getUserCategories", input.UserID)
 			if err != nil {
-				return GetUserCategoriesOutput{}, fmt.Errorf("invalid user ID: %w", err)
+				return GetUserCategoriesOutput{}, err
 			}
 
 			// Fetch categories from database
@@ -85,10 +80,9 @@ func InitTools(g *genkit.Genkit, collections map[string]*mongo.Collection, unspl
 		"getCompletedTasks",
 		"Fetches the most recently completed tasks for a specific user from the database. Use this to understand what tasks the user has accomplished recently, which can help with context about their work patterns and history.",
 		func(ctx *ai.ToolContext, input GetCompletedTasksInput) (GetCompletedTasksOutput, error) {
-			// Convert user ID string to ObjectID
-			userID, err := primitive.ObjectIDFromHex(input.UserID)
+			userID, err := toolCaller(ctx, "getCompletedTasks", input.UserID)
 			if err != nil {
-				return GetCompletedTasksOutput{}, fmt.Errorf("invalid user ID: %w", err)
+				return GetCompletedTasksOutput{}, err
 			}
 
 			// Default limit to 20 if not specified
@@ -198,9 +192,9 @@ func InitTools(g *genkit.Genkit, collections map[string]*mongo.Collection, unspl
 		"getUserActiveTasks",
 		"Fetches all active (non-completed) tasks for a specific user from the database. Use this to identify which tasks to edit when the user refers to them by name or description.",
 		func(ctx *ai.ToolContext, input GetUserActiveTasksInput) (GetUserActiveTasksOutput, error) {
-			userID, err := primitive.ObjectIDFromHex(input.UserID)
+			userID, err := toolCaller(ctx, "getUserActiveTasks", input.UserID)
 			if err != nil {
-				return GetUserActiveTasksOutput{}, fmt.Errorf("invalid user ID: %w", err)
+				return GetUserActiveTasksOutput{}, err
 			}
 
 			workspaces, err := categoryService.GetCategoriesByUser(userID)
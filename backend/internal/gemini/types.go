@@ -2,6 +2,7 @@ package gemini
 
 import (
 	"github.com/abhikaboy/Kindred/internal/handlers/task"
+	"github.com/firebase/genkit/go/ai"
 )
 
 type GenerateTaskParams struct {
@@ -367,3 +368,149 @@ type FetchUnsplashImageOutput struct {
 	Width                int    `json:"width" jsonschema_description:"Image width in pixels"`
 	Height               int    `json:"height" jsonschema_description:"Image height in pixels"`
 }
+
+// --- Assistant conversation types ---
+
+// AssistantMessage is one prior turn of an assistant conversation.
+type AssistantMessage struct {
+	Role    string `json:"role"` // "user" or "assistant"
+	Content string `json:"content"`
+}
+
+// AssistantTurnInput is one conversation turn. Messages holds the history
+// ending with the user's new message. Tools are the caller's action tools
+// (create, edit, complete, ...), offered alongside the read-only task tools.
+type AssistantTurnInput struct {
+	UserID   string
+	Timezone string
+	Messages []AssistantMessage
+	Tools    []ai.Tool
+}
+
+// AssistantClarificationOutput is a question the assistant needs answered
+// before it can act.
+type AssistantClarificationOutput struct {
+	Question string   `json:"question" jsonschema_description:"The question to ask the user"`
+	Options  []string `json:"options,omitempty" jsonschema_description:"Short candidate answers the user can pick from, e.g. the names of matching tasks"`
+}
+
+// AssistantTurnOutput is the assistant's reply once it has finished calling tools.
+type AssistantTurnOutput struct {
+	Reply         string                        `json:"reply" jsonschema_description:"Short reply to the user summarizing what was done, or the clarifying question"`
+	Clarification *AssistantClarificationOutput `json:"clarification,omitempty" jsonschema_description:"Set only when the request is ambiguous and no action was taken for the ambiguous part"`
+}
+
+// PlanMyDayInput is everything the day planner knows about today. The caller
+// gathers it, so the flow needs no tools and a regenerate costs one call.
+type PlanMyDayInput struct {
+	UserID       string                `json:"userId"`
+	Timezone     string                `json:"timezone"`
+	Date         string                `json:"date" jsonschema_description:"The day being planned, YYYY-MM-DD"`
+	WindowStart  string                `json:"windowStart" jsonschema_description:"Earliest start for any item, ISO8601"`
+	WindowEnd    string                `json:"windowEnd" jsonschema_description:"Latest end for any item, ISO8601"`
+	Tasks        []PlanMyDayTask       `json:"tasks"`
+	Busy         []PlanMyDayBusy       `json:"busy"`
+	Rings        PlanMyDayRings        `json:"rings"`
+	FlexQuotas   []PlanMyDayFlexQuota  `json:"flexQuotas,omitempty"`
+	PeakStart    string                `json:"peakStart,omitempty" jsonschema_description:"Start of the user's peak focus hours today, ISO8601"`
+	PeakEnd      string                `json:"peakEnd,omitempty"`
+	Profile      []string              `json:"profile,omitempty" jsonschema_description:"Observed facts about how the user works"`
+	ReduceLoad   bool                  `json:"reduceLoad,omitempty" jsonschema_description:"The user tends to ignore suggestions; keep the plan short"`
+	PreviousPlan []PlanMyDayItemOutput `json:"previousPlan,omitempty"`
+	Feedback     string                `json:"feedback,omitempty" jsonschema_description:"What the user wants changed from the previous plan"`
+}
+
+// PlanMyDayTask is an open task that can be placed today.
+type PlanMyDayTask struct {
+	ID               string  `json:"id"`
+	Content          string  `json:"content"`
+	Priority         int     `json:"priority"`
+	Value            float64 `json:"value"`
+	Deadline         string  `json:"deadline,omitempty"`
+	EstimatedMinutes int     `json:"estimatedMinutes"`
+	Flex             bool    `json:"flex,omitempty" jsonschema_description:"An instance of a flexible habit with a quota below"`
+}
+
+// PlanMyDayBusy is time the plan must work around: calendar events, focus
+// blocks and tasks already scheduled today.
+type PlanMyDayBusy struct {
+	Title string `json:"title"`
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// PlanMyDayRings is today's ring progress.
+type PlanMyDayRings struct {
+	PlanCurrent int `json:"planCurrent"`
+	PlanTarget  int `json:"planTarget"`
+	DoCurrent   int `json:"doCurrent"`
+	DoTarget    int `json:"doTarget"`
+}
+
+// PlanMyDayFlexQuota is a flexible habit with completions still owed this period.
+type PlanMyDayFlexQuota struct {
+	Content   string `json:"content"`
+	Period    string `json:"period" jsonschema_description:"daily, weekly or monthly"`
+	Target    int    `json:"target"`
+	Remaining int    `json:"remaining"`
+}
+
+// PlanMyDayItemOutput is one time-boxed task in the plan.
+type PlanMyDayItemOutput struct {
+	TaskID    string `json:"taskId" jsonschema_description:"ID of a task from the input"`
+	Start     string `json:"start" jsonschema_description:"ISO8601 start with the user's timezone offset"`
+	End       string `json:"end" jsonschema_description:"ISO8601 end with the user's timezone offset"`
+	Rationale string `json:"rationale" jsonschema_description:"One short sentence on why this task goes here"`
+}
+
+// PlanMyDayDeferredOutput is a task deliberately left out of today.
+type PlanMyDayDeferredOutput struct {
+	TaskID string `json:"taskId"`
+	Reason string `json:"reason"`
+}
+
+// BreakdownTaskInput is one task to split into concrete steps.
+type BreakdownTaskInput struct {
+	UserID    string   `json:"userId"`
+	Timezone  string   `json:"timezone"`
+	Content   string   `json:"content"`
+	Notes     string   `json:"notes,omitempty"`
+	Deadline  string   `json:"deadline,omitempty" jsonschema_description:"ISO8601 deadline, if any"`
+	Priority  int      `json:"priority"`
+	Value     float64  `json:"value"`
+	Checklist []string `json:"checklist,omitempty" jsonschema_description:"Steps the task already has; don't repeat them"`
+	Guidance  string   `json:"guidance,omitempty" jsonschema_description:"What the user wants from the breakdown"`
+}
+
+// BreakdownStepOutput is one step of a breakdown.
+type BreakdownStepOutput struct {
+	Content          string `json:"content" jsonschema_description:"A concrete action starting with a verb, under 80 characters"`
+	EstimatedMinutes int    `json:"estimatedMinutes" jsonschema_description:"Realistic minutes to finish this step"`
+}
+
+// BreakdownTaskOutput is a task broken into ordered steps.
+type BreakdownTaskOutput struct {
+	Steps   []BreakdownStepOutput `json:"steps" jsonschema_description:"Steps in the order they should be done"`
+	Summary string                `json:"summary" jsonschema_description:"One sentence on how the breakdown is organised"`
+}
+
+// EmbedInput is a batch of texts to embed. UserID attributes the call's usage
+// and may be empty for work not done on a user's behalf.
+type EmbedInput struct {
+	UserID string   `json:"userId"`
+	Texts  []string `json:"texts"`
+}
+
+// EmbedOutput holds one vector per input text, in order. Model names the
+// embedding model so cached vectors can be invalidated when it changes.
+type EmbedOutput struct {
+	Model   string      `json:"model"`
+	Vectors [][]float32 `json:"vectors"`
+}
+
+// PlanMyDayOutput is an ordered plan for today.
+type PlanMyDayOutput struct {
+	Items    []PlanMyDayItemOutput     `json:"items" jsonschema_description:"Planned tasks in chronological order"`
+	Deferred []PlanMyDayDeferredOutput `json:"deferred,omitempty" jsonschema_description:"Notable tasks left for another day, with why"`
+	Summary  string                    `json:"summary" jsonschema_description:"Two or three sentences on the shape of the day"`
+}
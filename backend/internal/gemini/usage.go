@@ -0,0 +1,189 @@
+package gemini
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/firebase/genkit/go/ai"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// FlowEmbed names Embed calls in usage records. It is not a flow and takes
+// no model override.
+const FlowEmbed = "embed"
+
+// UsageRecorder stores the usage record of one backend call.
+type UsageRecorder interface {
+	RecordUsage(ctx context.Context, usage types.AIUsage)
+}
+
+// NewUsageRecorder records usage into coll, normally types.AIUsageCollection.
+func NewUsageRecorder(coll *mongo.Collection) UsageRecorder {
+	return mongoUsageRecorder{coll: coll}
+}
+
+type mongoUsageRecorder struct {
+	coll *mongo.Collection
+}
+
+// RecordUsage inserts the record. It outlives the request that made the call
+// so a cancelled request still pays for the tokens it spent, and failures
+// are logged rather than returned: metering never fails a user's request.
+func (r mongoUsageRecorder) RecordUsage(ctx context.Context, usage types.AIUsage) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if _, err := r.coll.InsertOne(ctx, usage); err != nil {
+		slog.Warn("Failed to record AI usage", "flow", usage.Flow, "userId", usage.UserID.Hex(), "error", err)
+	}
+}
+
+// tokenMeter accumulates what the model calls inside one backend call
+// reported. Tool loops make several model calls, so it sums them.
+type tokenMeter struct {
+	mu           sync.Mutex
+	model        string
+	inputTokens  int
+	outputTokens int
+}
+
+type tokenMeterKey struct{}
+
+func withTokenMeter(ctx context.Context) (context.Context, *tokenMeter) {
+	m := &tokenMeter{}
+	return context.WithValue(ctx, tokenMeterKey{}, m), m
+}
+
+// meterTokens adds a model call's token counts to the call being metered, if
+// any.
+func meterTokens(ctx context.Context, model string, inputTokens, outputTokens int) {
+	m, ok := ctx.Value(tokenMeterKey{}).(*tokenMeter)
+	if !ok {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if model != "" {
+		m.model = model
+	}
+	m.inputTokens += inputTokens
+	m.outputTokens += outputTokens
+}
+
+// meterResponse meters a Genkit response; plugins that report no usage
+// still record the model.
+func meterResponse(ctx context.Context, model string, resp *ai.ModelResponse) {
+	if resp == nil || resp.Usage == nil {
+		meterTokens(ctx, model, 0, 0)
+		return
+	}
+	meterTokens(ctx, model, resp.Usage.InputTokens, resp.Usage.OutputTokens)
+}
+
+// MeteredBackend wraps a Backend and records flow, model, token counts,
+// latency and outcome of every call.
+type MeteredBackend struct {
+	next     Backend
+	recorder UsageRecorder
+	now      func() time.Time
+}
+
+var _ Backend = (*MeteredBackend)(nil)
+
+func NewMeteredBackend(next Backend, recorder UsageRecorder) *MeteredBackend {
+	return &MeteredBackend{next: next, recorder: recorder, now: time.Now}
+}
+
+func metered[In, Out any](ctx context.Context, m *MeteredBackend, flow, userID string, input In, call func(context.Context, In) (Out, error)) (Out, error) {
+	ctx, meter := withTokenMeter(ctx)
+	start := m.now()
+	out, err := call(ctx, input)
+	latency := m.now().Sub(start)
+	if errors.Is(err, ErrEmbeddingsUnavailable) {
+		// No model was called, so there is nothing to record.
+		return out, err
+	}
+
+	meter.mu.Lock()
+	usage := types.AIUsage{
+		ID:           primitive.NewObjectID(),
+		Flow:         flow,
+		Model:        meter.model,
+		InputTokens:  meter.inputTokens,
+		OutputTokens: meter.outputTokens,
+		LatencyMs:    latency.Milliseconds(),
+		Outcome:      usageOutcome(err),
+		CreatedAt:    start.UTC(),
+	}
+	meter.mu.Unlock()
+	// Calls made for no particular user are recorded under the zero ID.
+	usage.UserID, _ = primitive.ObjectIDFromHex(userID)
+
+	m.recorder.RecordUsage(ctx, usage)
+	return out, err
+}
+
+func usageOutcome(err error) string {
+	switch {
+	case err == nil:
+		return types.UsageOutcomeOK
+	case errors.Is(err, context.Canceled):
+		return types.UsageOutcomeCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return types.UsageOutcomeTimeout
+	default:
+		return types.UsageOutcomeError
+	}
+}
+
+func (m *MeteredBackend) RouteIntent(ctx context.Context, input IntentRouterInput) (IntentRouterOutput, error) {
+	return metered(ctx, m, FlowIntentRouter, input.UserID, input, m.next.RouteIntent)
+}
+
+func (m *MeteredBackend) MultiTaskFromText(ctx context.Context, input MultiTaskFromTextInputWithUser) (MultiTaskFromTextOutput, error) {
+	return metered(ctx, m, FlowMultiTask, input.UserID, input, m.next.MultiTaskFromText)
+}
+
+func (m *MeteredBackend) TaskFromImage(ctx context.Context, input GenerateTaskFromImageParams) (MultiTaskFromTextOutput, error) {
+	return metered(ctx, m, FlowTaskFromImage, input.UserID, input, m.next.TaskFromImage)
+}
+
+func (m *MeteredBackend) QueryTasks(ctx context.Context, input QueryTasksFlowInput) (TaskQueryFiltersOutput, error) {
+	return metered(ctx, m, FlowQueryTasks, input.UserID, input, m.next.QueryTasks)
+}
+
+func (m *MeteredBackend) EditTasks(ctx context.Context, input EditTasksFlowInput) (EditTasksFlowOutput, error) {
+	return metered(ctx, m, FlowEditTasks, input.UserID, input, m.next.EditTasks)
+}
+
+func (m *MeteredBackend) SuggestTaskFields(ctx context.Context, input SuggestTaskFieldsFlowInput) (SuggestTaskFieldsFlowOutput, error) {
+	return metered(ctx, m, FlowSuggestTaskFields, input.UserID, input, m.next.SuggestTaskFields)
+}
+
+func (m *MeteredBackend) GenerateBlueprint(ctx context.Context, input GenerateBlueprintInput) (GenerateBlueprintOutput, error) {
+	return metered(ctx, m, FlowBlueprint, input.UserID, input, m.next.GenerateBlueprint)
+}
+
+func (m *MeteredBackend) AnalyticsReport(ctx context.Context, input AnalyticsReportInput) (AnalyticsReportOutput, error) {
+	return metered(ctx, m, FlowAnalyticsReport, input.UserID, input, m.next.AnalyticsReport)
+}
+
+func (m *MeteredBackend) AssistantTurn(ctx context.Context, input AssistantTurnInput) (AssistantTurnOutput, error) {
+	return metered(ctx, m, FlowAssistant, input.UserID, input, m.next.AssistantTurn)
+}
+
+func (m *MeteredBackend) PlanMyDay(ctx context.Context, input PlanMyDayInput) (PlanMyDayOutput, error) {
+	return metered(ctx, m, FlowPlanMyDay, input.UserID, input, m.next.PlanMyDay)
+}
+
+func (m *MeteredBackend) BreakdownTask(ctx context.Context, input BreakdownTaskInput) (BreakdownTaskOutput, error) {
+	return metered(ctx, m, FlowBreakdownTask, input.UserID, input, m.next.BreakdownTask)
+}
+
+func (m *MeteredBackend) Embed(ctx context.Context, input EmbedInput) (EmbedOutput, error) {
+	return metered(ctx, m, FlowEmbed, input.UserID, input, m.next.Embed)
+}
@@ -0,0 +1,95 @@
+package gemini
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type recordedUsage []types.AIUsage
+
+func (r *recordedUsage) RecordUsage(_ context.Context, usage types.AIUsage) {
+	*r = append(*r, usage)
+}
+
+func TestMeteredSumsToolRounds(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Write([]byte(`{"choices":[{"message":{"tool_calls":[{"id":"call_1","type":"function","function":{"name":"missing","arguments":"{}"}}]}}],"usage":{"prompt_tokens":100,"completion_tokens":10}}`))
+			return
+		}
+		w.Write([]byte(`{"choices":[{"message":{"content":"{\"priority\": 2}"}}],"usage":{"prompt_tokens":130,"completion_tokens":20}}`))
+	}))
+	defer srv.Close()
+
+	b := &OpenAIBackend{baseURL: srv.URL, models: ModelSet{Default: "local"}, client: srv.Client()}
+	var recorded recordedUsage
+	m := NewMeteredBackend(b, &recorded)
+	userID := primitive.NewObjectID()
+
+	_, err := metered(context.Background(), m, FlowSuggestTaskFields, userID.Hex(), textMessage("prompt"),
+		func(ctx context.Context, msg chatMessage) (SuggestTaskFieldsFlowOutput, error) {
+			return generateJSON[SuggestTaskFieldsFlowOutput](ctx, b, FlowSuggestTaskFields, msg)
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(recorded) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(recorded))
+	}
+	got := recorded[0]
+	if got.UserID != userID || got.Flow != FlowSuggestTaskFields || got.Model != "local" || got.Outcome != types.UsageOutcomeOK {
+		t.Errorf("unexpected record %+v", got)
+	}
+	if got.InputTokens != 230 || got.OutputTokens != 30 {
+		t.Errorf("tokens = %d in, %d out; want 230 in, 30 out", got.InputTokens, got.OutputTokens)
+	}
+}
+
+func TestMeteredBackendRecordsFailuresAndEmbeddings(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[{"index":0,"embedding":[1,0]}],"usage":{"prompt_tokens":4}}`))
+	}))
+	defer srv.Close()
+
+	b := &OpenAIBackend{baseURL: srv.URL, models: ModelSet{Embedding: "nomic-embed-text"}, client: srv.Client()}
+	var recorded recordedUsage
+	m := NewMeteredBackend(b, &recorded)
+
+	if _, err := m.Embed(context.Background(), EmbedInput{Texts: []string{"call mom"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := m.Embed(context.Background(), EmbedInput{Texts: []string{"call mom", "buy milk"}}); err == nil {
+		t.Fatal("expected an error for a short vector list")
+	}
+	b.models.Embedding = ""
+	m.Embed(context.Background(), EmbedInput{Texts: []string{"x"}})
+
+	if len(recorded) != 2 {
+		t.Fatalf("expected 2 records (unavailable embeddings are not usage), got %d", len(recorded))
+	}
+	if r := recorded[0]; r.Flow != FlowEmbed || r.Model != "nomic-embed-text" || r.InputTokens != 4 || r.Outcome != types.UsageOutcomeOK {
+		t.Errorf("unexpected record %+v", r)
+	}
+	if r := recorded[1]; r.Outcome != types.UsageOutcomeError || !r.UserID.IsZero() {
+		t.Errorf("unexpected failure record %+v", r)
+	}
+}
+
+func TestUsageOutcome(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if got := usageOutcome(ctx.Err()); got != types.UsageOutcomeCanceled {
+		t.Errorf("canceled outcome = %q", got)
+	}
+	if got := usageOutcome(context.DeadlineExceeded); got != types.UsageOutcomeTimeout {
+		t.Errorf("deadline outcome = %q", got)
+	}
+}
@@ -15,8 +15,8 @@ import (
 )
 
 type Handler struct {
-	service       *Service
-	geminiService any // Gemini service interface - using any to avoid circular import
+	service   *Service
+	generator Generator // nil disables AI generation
 }
 
 func (h *Handler) CreateBlueprintHuma(ctx context.Context, input *CreateBlueprintInput) (*CreateBlueprintOutput, error) {
@@ -298,8 +298,8 @@ func (h *Handler) GenerateAndCreateBlueprintHuma(ctx context.Context, input *Gen
 		return nil, huma.Error500InternalServerError("Unable to process credit. Please try again.", err)
 	}
 
-	// Call Genkit flow to generate blueprint
-	generatedBlueprint, err := h.callGeminiGenerateBlueprintFlow(ctx, userID, input.Body.Description)
+	// Call the LLM backend to generate the blueprint
+	generatedBlueprint, err := h.generateBlueprint(ctx, userID, input.Body.Description)
 	if err != nil {
 		slog.Error("failed to generate blueprint with AI", "userId", userID, "error", err)
 		return nil, huma.Error500InternalServerError("Unable to generate blueprint with AI. Please try again.", err)
@@ -2,14 +2,12 @@ package Blueprint
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"reflect"
+	"errors"
 
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
 )
 
-// GeneratedBlueprintData matches the structure returned by Genkit flow
+// GeneratedBlueprintData matches the structure returned by the blueprint flow
 type GeneratedBlueprintData struct {
 	Name        string                   `json:"name"`
 	Description string                   `json:"description"`
@@ -20,67 +18,18 @@ type GeneratedBlueprintData struct {
 	Categories  []types.CategoryDocument `json:"categories"`
 }
 
-// callGeminiGenerateBlueprintFlow uses reflection to call the Genkit flow without circular import
-func (h *Handler) callGeminiGenerateBlueprintFlow(ctx context.Context, userID, description string) (*GeneratedBlueprintData, error) {
-	if h.geminiService == nil {
-		return nil, fmt.Errorf("gemini service not available")
-	}
-
-	// Use reflection to access the flow
-	svcValue := reflect.ValueOf(h.geminiService)
-	flowField := svcValue.Elem().FieldByName("GenerateBlueprintFlow")
-	if !flowField.IsValid() {
-		return nil, fmt.Errorf("gemini blueprint flow not configured")
-	}
-
-	// Get the Run method
-	runMethod := flowField.MethodByName("Run")
-	if !runMethod.IsValid() {
-		return nil, fmt.Errorf("gemini blueprint flow Run method not available")
-	}
-
-	// Create input value with the correct type
-	inputType := runMethod.Type().In(1)
-	inputValue := reflect.New(inputType).Elem()
-	inputValue.FieldByName("UserID").SetString(userID)
-	inputValue.FieldByName("Description").SetString(description)
-
-	// Call the Run method
-	callResults := runMethod.Call([]reflect.Value{
-		reflect.ValueOf(ctx),
-		inputValue,
-	})
-
-	if len(callResults) != 2 {
-		return nil, fmt.Errorf("unexpected gemini flow response structure")
-	}
-
-	// Check for error
-	if !callResults[1].IsNil() {
-		if err, ok := callResults[1].Interface().(error); ok {
-			return nil, err
-		}
-		return nil, fmt.Errorf("unexpected error type from gemini flow")
-	}
-
-	// Extract the blueprint from the response
-	// The result is GenerateBlueprintOutput which has a Blueprint field
-	resultValue := callResults[0]
-	blueprintField := resultValue.FieldByName("Blueprint")
-	if !blueprintField.IsValid() {
-		return nil, fmt.Errorf("invalid blueprint response structure")
-	}
+// Generator drafts a blueprint from a description. gemini.NewBlueprintGenerator
+// adapts an LLM backend to it; the interface lives here to avoid importing gemini.
+type Generator interface {
+	GenerateBlueprint(ctx context.Context, userID, description string) (*GeneratedBlueprintData, error)
+}
 
-	// Convert the blueprint to our response type
-	var response GeneratedBlueprintData
-	blueprintBytes, err := json.Marshal(blueprintField.Interface())
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse AI blueprint response: %w", err)
-	}
+var errGeneratorUnavailable = errors.New("blueprint generation not available")
 
-	if err := json.Unmarshal(blueprintBytes, &response); err != nil {
-		return nil, fmt.Errorf("failed to parse AI blueprint response structure: %w", err)
+// generateBlueprint runs the configured Generator.
+func (h *Handler) generateBlueprint(ctx context.Context, userID, description string) (*GeneratedBlueprintData, error) {
+	if h.generator == nil {
+		return nil, errGeneratorUnavailable
 	}
-
-	return &response, nil
+	return h.generator.GenerateBlueprint(ctx, userID, description)
 }
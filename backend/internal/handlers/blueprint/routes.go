@@ -8,11 +8,11 @@ import (
 /*
 Router maps endpoints to handlers
 */
-func Routes(api huma.API, collections map[string]*mongo.Collection, geminiService any) {
+func Routes(api huma.API, collections map[string]*mongo.Collection, generator Generator) {
 	service := newService(collections)
 	handler := Handler{
-		service:       service,
-		geminiService: geminiService,
+		service:   service,
+		generator: generator,
 	}
 
 	RegisterBlueprintOperations(api, &handler)
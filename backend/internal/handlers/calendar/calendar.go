@@ -2,6 +2,7 @@ package calendar
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -10,6 +11,7 @@ import (
 	"github.com/abhikaboy/Kindred/internal/handlers/auth"
 	"github.com/danielgtaylor/huma/v2"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type Handler struct {
@@ -313,7 +315,9 @@ func (h *Handler) SyncEvents(ctx context.Context, input *SyncEventsInput) (*Sync
 	}
 
 	// Sync events to tasks
+	syncStart := time.Now()
 	result, err := h.service.SyncEventsToTasks(ctx, connectionID, userObjID, startTime, endTime)
+	h.service.RecordSync(ctx, connectionID, userObjID, SyncTriggerManual, syncStart, result, err)
 	if err != nil {
 		slog.Error("Failed to sync calendar events to tasks", "userId", userID, "connectionId", input.ConnectionID, "start", startTime, "end", endTime, "error", err)
 		return nil, huma.Error500InternalServerError("Unable to sync calendar events. Please try again.", err)
@@ -324,9 +328,217 @@ func (h *Handler) SyncEvents(ctx context.Context, input *SyncEventsInput) (*Sync
 	resp.Body.TasksSkipped = result.TasksSkipped
 	resp.Body.TasksDeleted = result.TasksDeleted
 	resp.Body.EventsTotal = result.EventsTotal
+	resp.Body.DriftsDetected = result.DriftsDetected
 	resp.Body.CategoriesSynced = result.CategoriesSynced
 	resp.Body.WorkspaceName = result.WorkspaceName
 
 	slog.Info("Sync completed", "connection_id", input.ConnectionID, "tasks_created", result.TasksCreated, "tasks_skipped", result.TasksSkipped, "tasks_deleted", result.TasksDeleted)
 	return resp, nil
 }
+
+// SetDriftPolicy sets how calendar-side edits to pushed events are resolved for a category
+func (h *Handler) SetDriftPolicy(ctx context.Context, input *SetDriftPolicyInput) (*SetDriftPolicyOutput, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, huma.Error401Unauthorized("Please log in to update calendar settings")
+	}
+
+	categoryID, err := primitive.ObjectIDFromHex(input.CategoryID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid category ID format")
+	}
+
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format")
+	}
+
+	if !IsValidDriftPolicy(input.Body.Policy) {
+		return nil, huma.Error400BadRequest("Invalid drift policy")
+	}
+
+	if err := h.service.SetCategoryDriftPolicy(ctx, userObjID, categoryID, input.Body.Policy); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, huma.Error404NotFound("Calendar category not found")
+		}
+		slog.Error("Failed to set drift policy", "userId", userID, "categoryId", input.CategoryID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to update calendar settings. Please try again.", err)
+	}
+
+	resp := &SetDriftPolicyOutput{}
+	resp.Body.Success = true
+	resp.Body.Policy = input.Body.Policy
+	return resp, nil
+}
+
+// GetConflicts lists unresolved drift conflicts recorded under last_writer_wins
+func (h *Handler) GetConflicts(ctx context.Context, input *GetConflictsInput) (*GetConflictsOutput, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, huma.Error401Unauthorized("Please log in to view calendar conflicts")
+	}
+
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format")
+	}
+
+	conflicts, err := h.service.ListConflicts(ctx, userObjID)
+	if err != nil {
+		slog.Error("Failed to list calendar conflicts", "userId", userID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to load calendar conflicts. Please try again.", err)
+	}
+
+	resp := &GetConflictsOutput{}
+	resp.Body.Conflicts = conflicts
+	return resp, nil
+}
+
+// DismissConflict acknowledges a drift conflict so it stops being surfaced
+func (h *Handler) DismissConflict(ctx context.Context, input *DismissConflictInput) (*DismissConflictOutput, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, huma.Error401Unauthorized("Please log in to dismiss calendar conflicts")
+	}
+
+	conflictID, err := primitive.ObjectIDFromHex(input.ConflictID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid conflict ID format")
+	}
+
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format")
+	}
+
+	if err := h.service.DismissConflict(ctx, userObjID, conflictID); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, huma.Error404NotFound("Conflict not found")
+		}
+		slog.Error("Failed to dismiss calendar conflict", "userId", userID, "conflictId", input.ConflictID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to dismiss conflict. Please try again.", err)
+	}
+
+	resp := &DismissConflictOutput{}
+	resp.Body.Success = true
+	return resp, nil
+}
+
+// GetSyncStatus returns connection health, recent sync activity and push queue state
+func (h *Handler) GetSyncStatus(ctx context.Context, input *GetSyncStatusInput) (*GetSyncStatusOutput, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, huma.Error401Unauthorized("Please log in to view calendar sync status")
+	}
+
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format")
+	}
+
+	status, err := h.service.GetSyncStatus(ctx, userObjID)
+	if err != nil {
+		slog.Error("Failed to load calendar sync status", "userId", userID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to load calendar sync status. Please try again.", err)
+	}
+
+	return &GetSyncStatusOutput{Body: *status}, nil
+}
+
+// GetSyncLog returns the recent sync, renewal and heartbeat runs for a connection
+func (h *Handler) GetSyncLog(ctx context.Context, input *GetSyncLogInput) (*GetSyncLogOutput, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, huma.Error401Unauthorized("Please log in to view calendar sync history")
+	}
+
+	connectionID, err := primitive.ObjectIDFromHex(input.ConnectionID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid connection ID format")
+	}
+
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format")
+	}
+
+	if _, err := h.service.GetConnectionForUser(ctx, connectionID, userObjID); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, huma.Error404NotFound("Calendar connection not found")
+		}
+		slog.Error("Failed to load calendar connection", "userId", userID, "connectionId", input.ConnectionID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to load calendar sync history. Please try again.", err)
+	}
+
+	entries, err := h.service.ListSyncLog(ctx, userObjID, connectionID, input.Limit)
+	if err != nil {
+		slog.Error("Failed to list calendar sync log", "userId", userID, "connectionId", input.ConnectionID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to load calendar sync history. Please try again.", err)
+	}
+
+	resp := &GetSyncLogOutput{}
+	resp.Body.Entries = entries
+	return resp, nil
+}
+
+// GetDeadLetters lists calendar pushes that failed after all retries
+func (h *Handler) GetDeadLetters(ctx context.Context, input *GetDeadLettersInput) (*GetDeadLettersOutput, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, huma.Error401Unauthorized("Please log in to view failed calendar pushes")
+	}
+
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format")
+	}
+
+	deadLetters, err := h.service.ListDeadLetters(ctx, userObjID)
+	if err != nil {
+		slog.Error("Failed to list calendar push dead letters", "userId", userID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to load failed calendar pushes. Please try again.", err)
+	}
+
+	resp := &GetDeadLettersOutput{}
+	resp.Body.DeadLetters = deadLetters
+	return resp, nil
+}
+
+// ReplayDeadLetter re-queues a failed calendar push with a fresh retry budget
+func (h *Handler) ReplayDeadLetter(ctx context.Context, input *DeadLetterActionInput) (*DeadLetterActionOutput, error) {
+	return h.deadLetterAction(ctx, input, "replay", h.service.PushOutbox().ReplayDeadLetter)
+}
+
+// DiscardDeadLetter drops a failed calendar push
+func (h *Handler) DiscardDeadLetter(ctx context.Context, input *DeadLetterActionInput) (*DeadLetterActionOutput, error) {
+	return h.deadLetterAction(ctx, input, "discard", h.service.PushOutbox().DiscardDeadLetter)
+}
+
+func (h *Handler) deadLetterAction(ctx context.Context, input *DeadLetterActionInput, action string, apply func(context.Context, primitive.ObjectID, primitive.ObjectID) error) (*DeadLetterActionOutput, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, huma.Error401Unauthorized("Please log in to manage failed calendar pushes")
+	}
+
+	rowID, err := primitive.ObjectIDFromHex(input.RowID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid row ID format")
+	}
+
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format")
+	}
+
+	if err := apply(ctx, userObjID, rowID); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, huma.Error404NotFound("Failed calendar push not found")
+		}
+		slog.Error("Failed to "+action+" calendar push dead letter", "userId", userID, "rowId", input.RowID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to "+action+" failed calendar push. Please try again.", err)
+	}
+
+	resp := &DeadLetterActionOutput{}
+	resp.Body.Success = true
+	return resp, nil
+}
@@ -0,0 +1,288 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrEventDrifted is returned by Provider.UpdateEvent when the event's ETag no
+// longer matches the one we last wrote, i.e. someone edited it on the calendar.
+var ErrEventDrifted = errors.New("calendar event was modified since it was last pushed")
+
+const (
+	driftWinnerKindred  = "kindred"
+	driftWinnerCalendar = "calendar"
+)
+
+// normalizeDriftPolicy maps an unset or unknown stored value to the default.
+// kindred_wins is the default because it matches the pre-drift-detection
+// behavior: the next push overwrites whatever is on the calendar.
+func normalizeDriftPolicy(p DriftPolicy) DriftPolicy {
+	switch p {
+	case DriftPolicyCalendarWins, DriftPolicyLastWriterWins:
+		return p
+	default:
+		return DriftPolicyKindredWins
+	}
+}
+
+// IsValidDriftPolicy reports whether p is one of the known policies.
+func IsValidDriftPolicy(p DriftPolicy) bool {
+	switch p {
+	case DriftPolicyKindredWins, DriftPolicyCalendarWins, DriftPolicyLastWriterWins:
+		return true
+	}
+	return false
+}
+
+// hasDrifted reports whether the fetched event differs from the version we last
+// pushed for this task. Tasks pushed before ETags were stored never drift.
+func hasDrifted(task *types.TaskDocument, ev ProviderEvent) bool {
+	if task.PushedEventID == "" || task.PushedEventID != ev.ID {
+		return false
+	}
+	if task.PushedEventEtag == "" || ev.Etag == "" {
+		return false
+	}
+	return task.PushedEventEtag != ev.Etag
+}
+
+// resolveDrift picks the winning side for a drifted event under `policy`.
+// For last_writer_wins, a tie or a missing event timestamp favors Kindred.
+func resolveDrift(policy DriftPolicy, taskEdited, eventUpdated time.Time) string {
+	switch normalizeDriftPolicy(policy) {
+	case DriftPolicyCalendarWins:
+		return driftWinnerCalendar
+	case DriftPolicyLastWriterWins:
+		if !eventUpdated.IsZero() && eventUpdated.After(taskEdited) {
+			return driftWinnerCalendar
+		}
+		return driftWinnerKindred
+	default:
+		return driftWinnerKindred
+	}
+}
+
+// taskUpdateFromEvent is the inverse of BuildProviderEventFromTask: it returns
+// the $set document (positional tasks.$ paths) that applies a calendar-side
+// edit back onto the task while keeping the task's date shape.
+func taskUpdateFromEvent(task *types.TaskDocument, ev ProviderEvent, now time.Time) bson.M {
+	set := bson.M{
+		"tasks.$.content":           stripPushPrefixes(ev.Summary),
+		"tasks.$.pushed_event_etag": ev.Etag,
+		"tasks.$.lastEdited":        now,
+	}
+
+	start := ev.StartTime
+	end := ev.EndTime
+	switch {
+	case !ev.IsAllDay:
+		set["tasks.$.startTime"] = start
+		set["tasks.$.startDate"] = start
+		// A timed task without a deadline was pushed as a 30-minute block;
+		// don't invent a deadline from that default.
+		if task.Deadline != nil {
+			set["tasks.$.deadline"] = end
+		}
+	case task.StartTime == nil && task.StartDate == nil:
+		// Deadline-only task, pushed as an all-day event on the deadline date.
+		set["tasks.$.deadline"] = start
+	default:
+		set["tasks.$.startDate"] = start
+		if task.Deadline != nil {
+			set["tasks.$.deadline"] = end
+		}
+	}
+	return set
+}
+
+// stripPushPrefixes removes the decorations BuildProviderEventFromTask adds so
+// a calendar-side title edit round-trips without accumulating prefixes.
+func stripPushPrefixes(summary string) string {
+	summary = strings.TrimPrefix(summary, "✓ ")
+	summary = strings.TrimPrefix(summary, "Due: ")
+	return strings.TrimSpace(summary)
+}
+
+func snapshotFromTask(task *types.TaskDocument) ConflictSnapshot {
+	snap := ConflictSnapshot{
+		Title:      task.Content,
+		End:        task.Deadline,
+		LastEdited: task.LastEdited,
+	}
+	switch {
+	case task.StartTime != nil:
+		snap.Start = task.StartTime
+	case task.StartDate != nil:
+		snap.Start = task.StartDate
+		snap.IsAllDay = true
+	default:
+		snap.IsAllDay = true
+	}
+	return snap
+}
+
+func snapshotFromEvent(ev ProviderEvent) ConflictSnapshot {
+	start, end := ev.StartTime, ev.EndTime
+	return ConflictSnapshot{
+		Title:      stripPushPrefixes(ev.Summary),
+		Start:      &start,
+		End:        &end,
+		IsAllDay:   ev.IsAllDay,
+		LastEdited: ev.Updated,
+	}
+}
+
+// reconcilePushedEvent checks a push-origin event seen during sync against the
+// task it was pushed from and, if the calendar copy drifted, applies the
+// category's drift policy. Returns the winning side when drift was detected,
+// or "" when the event is unchanged.
+func (s *Service) reconcilePushedEvent(ctx context.Context, userID primitive.ObjectID, ev ProviderEvent) (string, error) {
+	taskID, err := primitive.ObjectIDFromHex(ev.ExtendedProperties["kindred_task_id"])
+	if err != nil {
+		return "", nil
+	}
+
+	var doc struct {
+		ID          primitive.ObjectID   `bson:"_id"`
+		DriftPolicy DriftPolicy          `bson:"drift_policy"`
+		Tasks       []types.TaskDocument `bson:"tasks"`
+	}
+	err = s.categories.FindOne(ctx,
+		bson.M{"user": userID, "tasks._id": taskID},
+		options.FindOne().SetProjection(bson.M{
+			"_id":          1,
+			"drift_policy": 1,
+			"tasks":        bson.M{"$elemMatch": bson.M{"_id": taskID}},
+		}),
+	).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return "", nil
+		}
+		return "", fmt.Errorf("load pushed task: %w", err)
+	}
+	if len(doc.Tasks) == 0 {
+		return "", nil
+	}
+	task := &doc.Tasks[0]
+	if !hasDrifted(task, ev) {
+		return "", nil
+	}
+
+	policy := normalizeDriftPolicy(doc.DriftPolicy)
+	winner := resolveDrift(policy, task.LastEdited, ev.Updated)
+	slog.Info("Sync: pushed event drifted on calendar",
+		"task_id", task.ID,
+		"event_id", ev.ID,
+		"policy", policy,
+		"winner", winner)
+
+	switch winner {
+	case driftWinnerCalendar:
+		_, err = s.categories.UpdateOne(ctx,
+			bson.M{"_id": doc.ID, "tasks._id": task.ID},
+			bson.M{"$set": taskUpdateFromEvent(task, ev, time.Now())},
+		)
+		if err != nil {
+			return winner, fmt.Errorf("apply calendar edit to task: %w", err)
+		}
+	default:
+		// Adopt the calendar's ETag so the push's If-Match succeeds and
+		// overwrites the calendar edit with the task's current state.
+		_, err = s.categories.UpdateOne(ctx,
+			bson.M{"_id": doc.ID, "tasks._id": task.ID},
+			bson.M{"$set": bson.M{"tasks.$.pushed_event_etag": ev.Etag}},
+		)
+		if err != nil {
+			return winner, fmt.Errorf("adopt calendar etag: %w", err)
+		}
+		if s.pushOutbox != nil {
+			if err := s.pushOutbox.EnqueueUpsert(ctx, task.ID, doc.ID, userID); err != nil {
+				return winner, fmt.Errorf("enqueue re-push: %w", err)
+			}
+		}
+	}
+
+	if policy == DriftPolicyLastWriterWins && s.conflicts != nil {
+		_, err = s.conflicts.InsertOne(ctx, CalendarConflict{
+			UserID:     userID,
+			TaskID:     task.ID,
+			CategoryID: doc.ID,
+			EventID:    ev.ID,
+			CalendarID: ev.CalendarID,
+			Policy:     policy,
+			Winner:     winner,
+			Task:       snapshotFromTask(task),
+			Event:      snapshotFromEvent(ev),
+			CreatedAt:  time.Now(),
+		})
+		if err != nil {
+			slog.Warn("Sync: failed to record calendar conflict", "task_id", task.ID, "error", err)
+		}
+	}
+	return winner, nil
+}
+
+// SetCategoryDriftPolicy updates the drift policy on one of the user's
+// calendar-linked categories.
+func (s *Service) SetCategoryDriftPolicy(ctx context.Context, userID, categoryID primitive.ObjectID, policy DriftPolicy) error {
+	res, err := s.categories.UpdateOne(ctx,
+		bson.M{
+			"_id":         categoryID,
+			"user":        userID,
+			"integration": bson.M{"$regex": "^gcal:"},
+		},
+		bson.M{"$set": bson.M{"drift_policy": policy}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// ListConflicts returns the user's undismissed drift conflicts, newest first.
+func (s *Service) ListConflicts(ctx context.Context, userID primitive.ObjectID) ([]CalendarConflict, error) {
+	cursor, err := s.conflicts.Find(ctx,
+		bson.M{"user_id": userID, "dismissed": false},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(100),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	conflicts := []CalendarConflict{}
+	if err := cursor.All(ctx, &conflicts); err != nil {
+		return nil, err
+	}
+	return conflicts, nil
+}
+
+// DismissConflict marks a conflict as acknowledged by the user.
+func (s *Service) DismissConflict(ctx context.Context, userID, conflictID primitive.ObjectID) error {
+	res, err := s.conflicts.UpdateOne(ctx,
+		bson.M{"_id": conflictID, "user_id": userID},
+		bson.M{"$set": bson.M{"dismissed": true}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestHasDrifted(t *testing.T) {
+	task := &types.TaskDocument{
+		ID:              primitive.NewObjectID(),
+		PushedEventID:   "evt-1",
+		PushedEventEtag: `"111"`,
+	}
+
+	if hasDrifted(task, ProviderEvent{ID: "evt-1", Etag: `"111"`}) {
+		t.Fatalf("matching etag should not be drift")
+	}
+	if !hasDrifted(task, ProviderEvent{ID: "evt-1", Etag: `"222"`}) {
+		t.Fatalf("changed etag should be drift")
+	}
+	if hasDrifted(task, ProviderEvent{ID: "evt-2", Etag: `"222"`}) {
+		t.Fatalf("different event id should not be drift")
+	}
+
+	legacy := &types.TaskDocument{PushedEventID: "evt-1"}
+	if hasDrifted(legacy, ProviderEvent{ID: "evt-1", Etag: `"222"`}) {
+		t.Fatalf("task pushed before etags were stored should not be drift")
+	}
+}
+
+func TestResolveDrift(t *testing.T) {
+	older := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+
+	cases := []struct {
+		name         string
+		policy       DriftPolicy
+		taskEdited   time.Time
+		eventUpdated time.Time
+		want         string
+	}{
+		{"default is kindred", "", newer, older, driftWinnerKindred},
+		{"unknown is kindred", "bogus", older, newer, driftWinnerKindred},
+		{"kindred wins", DriftPolicyKindredWins, older, newer, driftWinnerKindred},
+		{"calendar wins", DriftPolicyCalendarWins, newer, older, driftWinnerCalendar},
+		{"lww calendar newer", DriftPolicyLastWriterWins, older, newer, driftWinnerCalendar},
+		{"lww task newer", DriftPolicyLastWriterWins, newer, older, driftWinnerKindred},
+		{"lww tie favors kindred", DriftPolicyLastWriterWins, older, older, driftWinnerKindred},
+		{"lww missing event time", DriftPolicyLastWriterWins, older, time.Time{}, driftWinnerKindred},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := resolveDrift(tc.policy, tc.taskEdited, tc.eventUpdated); got != tc.want {
+				t.Fatalf("got %q want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTaskUpdateFromEvent_TimedMove(t *testing.T) {
+	start := time.Date(2026, 6, 1, 10, 0, 0, 0, time.UTC)
+	task := &types.TaskDocument{
+		Content:   "Standup",
+		StartTime: ptrTime(start),
+		Deadline:  ptrTime(start.Add(30 * time.Minute)),
+	}
+	movedStart := start.Add(2 * time.Hour)
+	ev := ProviderEvent{
+		Summary:   "Standup (moved)",
+		StartTime: movedStart,
+		EndTime:   movedStart.Add(45 * time.Minute),
+		Etag:      `"333"`,
+	}
+
+	set := taskUpdateFromEvent(task, ev, time.Now())
+	if set["tasks.$.content"] != "Standup (moved)" {
+		t.Fatalf("content: got %v", set["tasks.$.content"])
+	}
+	if got := set["tasks.$.startTime"].(time.Time); !got.Equal(movedStart) {
+		t.Fatalf("startTime: got %v", got)
+	}
+	if got := set["tasks.$.deadline"].(time.Time); !got.Equal(movedStart.Add(45 * time.Minute)) {
+		t.Fatalf("deadline: got %v", got)
+	}
+	if set["tasks.$.pushed_event_etag"] != `"333"` {
+		t.Fatalf("etag not adopted")
+	}
+}
+
+func TestTaskUpdateFromEvent_TimedWithoutDeadlineKeepsNoDeadline(t *testing.T) {
+	start := time.Date(2026, 6, 1, 10, 0, 0, 0, time.UTC)
+	task := &types.TaskDocument{Content: "Call", StartTime: ptrTime(start)}
+	ev := ProviderEvent{Summary: "Call", StartTime: start.Add(time.Hour), EndTime: start.Add(90 * time.Minute)}
+
+	set := taskUpdateFromEvent(task, ev, time.Now())
+	if _, ok := set["tasks.$.deadline"]; ok {
+		t.Fatalf("should not invent a deadline from the default 30-minute block")
+	}
+}
+
+func TestTaskUpdateFromEvent_DeadlineOnlyStripsPrefixes(t *testing.T) {
+	due := time.Date(2026, 6, 5, 0, 0, 0, 0, time.UTC)
+	task := &types.TaskDocument{Content: "Taxes", Deadline: ptrTime(due)}
+	newDue := due.AddDate(0, 0, 2)
+	ev := ProviderEvent{Summary: "✓ Due: Taxes", IsAllDay: true, StartTime: newDue, EndTime: newDue}
+
+	set := taskUpdateFromEvent(task, ev, time.Now())
+	if set["tasks.$.content"] != "Taxes" {
+		t.Fatalf("content: got %v", set["tasks.$.content"])
+	}
+	if got := set["tasks.$.deadline"].(time.Time); !got.Equal(newDue) {
+		t.Fatalf("deadline: got %v", got)
+	}
+	if _, ok := set["tasks.$.startDate"]; ok {
+		t.Fatalf("deadline-only task should not gain a startDate")
+	}
+}
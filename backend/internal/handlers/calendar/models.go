@@ -66,3 +66,39 @@ type ProcessedEvents struct {
 	UpdatedAt    time.Time          `bson:"updated_at" json:"updated_at"`
 	CreatedAt    time.Time          `bson:"created_at" json:"created_at"`
 }
+
+// DriftPolicy decides which side wins when a pushed event was edited directly
+// on the calendar (detected via an ETag mismatch on sync).
+type DriftPolicy string
+
+const (
+	DriftPolicyKindredWins    DriftPolicy = "kindred_wins"     // re-push the task, overwriting the calendar edit
+	DriftPolicyCalendarWins   DriftPolicy = "calendar_wins"    // apply the calendar edit back onto the task
+	DriftPolicyLastWriterWins DriftPolicy = "last_writer_wins" // newer edit wins; a CalendarConflict is recorded
+)
+
+// CalendarConflict records a drift resolved under last_writer_wins so the user
+// can see what was overwritten and on which side.
+type CalendarConflict struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID     primitive.ObjectID `bson:"user_id" json:"user_id"`
+	TaskID     primitive.ObjectID `bson:"task_id" json:"task_id"`
+	CategoryID primitive.ObjectID `bson:"category_id" json:"category_id"`
+	EventID    string             `bson:"event_id" json:"event_id"`
+	CalendarID string             `bson:"calendar_id" json:"calendar_id"`
+	Policy     DriftPolicy        `bson:"policy" json:"policy"`
+	Winner     string             `bson:"winner" json:"winner"` // "kindred" | "calendar"
+	Task       ConflictSnapshot   `bson:"task" json:"task"`
+	Event      ConflictSnapshot   `bson:"event" json:"event"`
+	Dismissed  bool               `bson:"dismissed" json:"dismissed"`
+	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
+}
+
+// ConflictSnapshot is the subset of task/event fields shown side by side.
+type ConflictSnapshot struct {
+	Title      string     `bson:"title" json:"title"`
+	Start      *time.Time `bson:"start,omitempty" json:"start,omitempty"`
+	End        *time.Time `bson:"end,omitempty" json:"end,omitempty"`
+	IsAllDay   bool       `bson:"is_all_day" json:"is_all_day"`
+	LastEdited time.Time  `bson:"last_edited" json:"last_edited"`
+}
@@ -104,3 +104,94 @@ func RegisterWebhookOperation(api huma.API, handler *Handler) {
 		Security:    []map[string][]string{}, // No auth - Google calls this
 	}, handler.HandleWebhook)
 }
+
+func RegisterSetDriftPolicyOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID:   "set-calendar-drift-policy",
+		Method:        "PATCH",
+		Path:          "/v1/user/calendar/categories/{categoryId}/drift-policy",
+		Summary:       "Set calendar drift policy for a category",
+		Description:   "Controls what happens when a pushed event is edited on the calendar side: kindred_wins re-pushes the task, calendar_wins applies the edit to the task, last_writer_wins keeps the newer edit and records a conflict.",
+		Tags:          []string{"Calendar"},
+		DefaultStatus: 200,
+	}, handler.SetDriftPolicy)
+}
+
+func RegisterGetConflictsOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-calendar-conflicts",
+		Method:      "GET",
+		Path:        "/v1/user/calendar/conflicts",
+		Summary:     "List calendar drift conflicts",
+		Description: "Returns undismissed conflicts recorded when a pushed event and its task were both edited (last_writer_wins policy).",
+		Tags:        []string{"Calendar"},
+	}, handler.GetConflicts)
+}
+
+func RegisterDismissConflictOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID:   "dismiss-calendar-conflict",
+		Method:        "POST",
+		Path:          "/v1/user/calendar/conflicts/{conflictId}/dismiss",
+		Summary:       "Dismiss a calendar drift conflict",
+		Description:   "Marks a conflict as seen so it is no longer returned by the conflicts list.",
+		Tags:          []string{"Calendar"},
+		DefaultStatus: 200,
+	}, handler.DismissConflict)
+}
+
+func RegisterGetSyncStatusOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-calendar-sync-status",
+		Method:      "GET",
+		Path:        "/v1/user/calendar/sync-status",
+		Summary:     "Get calendar sync status",
+		Description: "Returns health, last sync and recent sync log entries for each of the user's connections, plus the number of queued calendar pushes and any pushes that failed permanently",
+		Tags:        []string{"Calendar"},
+	}, handler.GetSyncStatus)
+}
+
+func RegisterGetSyncLogOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-calendar-sync-log",
+		Method:      "GET",
+		Path:        "/v1/user/calendar/connections/{connectionId}/sync-log",
+		Summary:     "Get calendar sync log",
+		Description: "Returns the most recent manual, webhook, watch-renewal and heartbeat runs for a connection, newest first",
+		Tags:        []string{"Calendar"},
+	}, handler.GetSyncLog)
+}
+
+func RegisterGetDeadLettersOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-calendar-push-dead-letters",
+		Method:      "GET",
+		Path:        "/v1/user/calendar/push/dead-letters",
+		Summary:     "List failed calendar pushes",
+		Description: "Returns the user's calendar pushes that were dead-lettered after exhausting their retries, with the last error from the provider",
+		Tags:        []string{"Calendar"},
+	}, handler.GetDeadLetters)
+}
+
+func RegisterReplayDeadLetterOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "replay-calendar-push-dead-letter",
+		Method:      "POST",
+		Path:        "/v1/user/calendar/push/dead-letters/{rowId}/replay",
+		Summary:     "Retry a failed calendar push",
+		Description: "Puts a dead-lettered push back in the queue with a fresh retry budget",
+		Tags:        []string{"Calendar"},
+	}, handler.ReplayDeadLetter)
+}
+
+func RegisterDiscardDeadLetterOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID:   "discard-calendar-push-dead-letter",
+		Method:        "DELETE",
+		Path:          "/v1/user/calendar/push/dead-letters/{rowId}",
+		Summary:       "Discard a failed calendar push",
+		Description:   "Deletes a dead-lettered push without retrying it",
+		Tags:          []string{"Calendar"},
+		DefaultStatus: 200,
+	}, handler.DiscardDeadLetter)
+}
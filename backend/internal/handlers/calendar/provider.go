@@ -67,8 +67,14 @@ type ProviderEvent struct {
 	ExtendedProperties map[string]string
 
 	// Etag is the provider's opaque version identifier for this event, used
-	// later for drift detection. Populated by Create/Update/Fetch; ignored on input.
+	// for drift detection. Populated by Create/Update/Fetch. On UpdateEvent input,
+	// a non-empty Etag is sent as If-Match so a calendar-side edit is not
+	// silently overwritten (the update fails with ErrEventDrifted instead).
 	Etag string
+
+	// Updated is the provider's last-modified time. Used by the
+	// last_writer_wins drift policy; zero when the provider doesn't report it.
+	Updated time.Time
 }
 
 // WatchResponse represents the response from creating a watch channel
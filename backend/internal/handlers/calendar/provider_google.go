@@ -257,8 +257,18 @@ func (p *GoogleProvider) UpdateEvent(ctx context.Context, token *oauth2.Token, e
 		calendarID = "primary"
 	}
 
-	updatedEvent, err := calendarService.Events.Update(calendarID, eventID, googleEvent).Do()
+	call := calendarService.Events.Update(calendarID, eventID, googleEvent)
+	if event.Etag != "" {
+		call.Header().Set("If-Match", event.Etag)
+	}
+	updatedEvent, err := call.Do()
 	if err != nil {
+		var apiErr *googleapi.Error
+		if errors.As(err, &apiErr) && apiErr.Code == 412 {
+			slog.Info("Google: event changed since last push, refusing to overwrite",
+				"calendar_id", calendarID, "event_id", eventID)
+			return ProviderEvent{}, ErrEventDrifted
+		}
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		slog.Error("Google: Failed to update event", "event_id", eventID, "error", err)
@@ -323,6 +333,9 @@ func (p *GoogleProvider) convertGoogleEvent(googleEvent *calendar.Event, calenda
 		Status:       googleEvent.Status,
 		Etag:         googleEvent.Etag,
 	}
+	if googleEvent.Updated != "" {
+		event.Updated, _ = time.Parse(time.RFC3339, googleEvent.Updated)
+	}
 
 	// Handle all-day events vs timed events
 	if googleEvent.Start.Date != "" {
@@ -0,0 +1,223 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// BlockEventItem is one task line in a focus block's event description.
+type BlockEventItem struct {
+	Content string
+	Done    bool
+}
+
+// BuildProviderEventFromBlock converts a focus block to a single timed event on
+// `calendarID`. The contained tasks are listed in the description, checked off
+// as they are completed; an ended block gets the same "✓ " prefix as a
+// completed task.
+func BuildProviderEventFromBlock(block *types.TimeBlockDocument, items []BlockEventItem, calendarID string) ProviderEvent {
+	summary := block.Title
+	if block.Status == types.TimeBlockEnded {
+		summary = "✓ " + summary
+	}
+
+	var desc strings.Builder
+	for i, item := range items {
+		if i > 0 {
+			desc.WriteString("\n")
+		}
+		if item.Done {
+			desc.WriteString("✓ ")
+		} else {
+			desc.WriteString("• ")
+		}
+		desc.WriteString(item.Content)
+	}
+
+	return ProviderEvent{
+		CalendarID:  calendarID,
+		Summary:     summary,
+		Description: desc.String(),
+		StartTime:   block.Start,
+		EndTime:     block.End,
+		ExtendedProperties: map[string]string{
+			"kindred_block_id": block.ID.Hex(),
+			"kindred_origin":   "push",
+		},
+	}
+}
+
+func (s *Service) processBlockUpsert(ctx context.Context, row PushOutboxRow) error {
+	var block types.TimeBlockDocument
+	if err := s.timeBlocks.FindOne(ctx, bson.M{"_id": row.BlockID}).Decode(&block); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			slog.Info("Push block upsert: block gone, dropping", "block_id", row.BlockID)
+			return nil
+		}
+		return fmt.Errorf("load block: %w", err)
+	}
+	if block.Status == types.TimeBlockCancelled || block.ConnectionID == nil || block.CalendarID == "" {
+		slog.Info("Push block upsert: block cancelled or not linked to a calendar, dropping", "block_id", block.ID)
+		return nil
+	}
+
+	conn, err := s.connectionByID(ctx, *block.ConnectionID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			slog.Info("Push block upsert: connection gone, dropping row", "connection_id", block.ConnectionID, "block_id", block.ID)
+			return nil
+		}
+		return fmt.Errorf("load connection: %w", err)
+	}
+	provider, ok := s.providers[conn.Provider]
+	if !ok {
+		return fmt.Errorf("unsupported provider %s", conn.Provider)
+	}
+	token, err := s.getValidToken(ctx, conn)
+	if err != nil {
+		return fmt.Errorf("refresh token: %w", err)
+	}
+
+	items, err := s.blockEventItems(ctx, block.UserID, block.TaskIDs)
+	if err != nil {
+		return fmt.Errorf("load block tasks: %w", err)
+	}
+	ev := BuildProviderEventFromBlock(&block, items, block.CalendarID)
+
+	var written ProviderEvent
+	if block.PushedEventID == "" {
+		written, err = provider.CreateEvent(ctx, token, ev)
+		if err != nil {
+			if isCalendarWriteForbidden(err) {
+				slog.Warn("Push block upsert: calendar rejected write, dropping", "block_id", block.ID, "calendar_id", block.CalendarID, "error", err)
+				return nil
+			}
+			return fmt.Errorf("provider create: %w", err)
+		}
+	} else {
+		// Blocks are owned by Kindred: a calendar-side edit is overwritten, so
+		// no If-Match is sent.
+		written, err = provider.UpdateEvent(ctx, token, block.PushedEventID, ev)
+		if err != nil {
+			if isCalendarWriteForbidden(err) {
+				slog.Warn("Push block upsert: calendar rejected write, dropping", "block_id", block.ID, "calendar_id", block.CalendarID, "error", err)
+				return nil
+			}
+			return fmt.Errorf("provider update: %w", err)
+		}
+	}
+
+	_, err = s.timeBlocks.UpdateOne(ctx,
+		bson.M{"_id": block.ID},
+		bson.M{"$set": bson.M{
+			"pushed_event_id":    written.ID,
+			"pushed_calendar_id": written.CalendarID,
+			"pushed_event_etag":  written.Etag,
+		}},
+	)
+	if err != nil {
+		return fmt.Errorf("persist block pushed_event_id: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) processBlockDelete(ctx context.Context, row PushOutboxRow) error {
+	if row.TargetEventID == "" || row.TargetCalendarID == "" || row.TargetConnectionID.IsZero() {
+		slog.Warn("Push block delete missing target snapshot; nothing to do", "row_id", row.ID)
+		return nil
+	}
+	conn, err := s.connectionByID(ctx, row.TargetConnectionID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			slog.Info("Push block delete: connection gone, treating as success", "connection_id", row.TargetConnectionID)
+			return nil
+		}
+		return fmt.Errorf("load connection: %w", err)
+	}
+	provider, ok := s.providers[conn.Provider]
+	if !ok {
+		return fmt.Errorf("unsupported provider %s", conn.Provider)
+	}
+	token, err := s.getValidToken(ctx, conn)
+	if err != nil {
+		return fmt.Errorf("refresh token: %w", err)
+	}
+	if err := provider.DeleteEvent(ctx, token, row.TargetCalendarID, row.TargetEventID); err != nil {
+		return fmt.Errorf("provider delete: %w", err)
+	}
+
+	_, _ = s.timeBlocks.UpdateOne(ctx,
+		bson.M{"_id": row.BlockID, "pushed_event_id": row.TargetEventID},
+		bson.M{"$unset": bson.M{
+			"pushed_event_id":    "",
+			"pushed_calendar_id": "",
+			"pushed_event_etag":  "",
+		}},
+	)
+	return nil
+}
+
+// blockEventItems resolves task IDs to description lines, in block order.
+// Open tasks come from categories; completed ones from completed-tasks.
+// Tasks found in neither (deleted) are left out.
+func (s *Service) blockEventItems(ctx context.Context, userID primitive.ObjectID, taskIDs []primitive.ObjectID) ([]BlockEventItem, error) {
+	if len(taskIDs) == 0 {
+		return nil, nil
+	}
+	contents := make(map[primitive.ObjectID]BlockEventItem, len(taskIDs))
+
+	cursor, err := s.categories.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user": userID, "tasks._id": bson.M{"$in": taskIDs}}}},
+		{{Key: "$unwind", Value: "$tasks"}},
+		{{Key: "$match", Value: bson.M{"tasks._id": bson.M{"$in": taskIDs}}}},
+		{{Key: "$project", Value: bson.M{"_id": "$tasks._id", "content": "$tasks.content"}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var open []struct {
+		ID      primitive.ObjectID `bson:"_id"`
+		Content string             `bson:"content"`
+	}
+	if err := cursor.All(ctx, &open); err != nil {
+		return nil, err
+	}
+	for _, t := range open {
+		contents[t.ID] = BlockEventItem{Content: t.Content}
+	}
+
+	cursor, err = s.completedTasks.Find(ctx,
+		bson.M{"_id": bson.M{"$in": taskIDs}, "user": userID},
+		options.Find().SetProjection(bson.M{"_id": 1, "content": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var done []struct {
+		ID      primitive.ObjectID `bson:"_id"`
+		Content string             `bson:"content"`
+	}
+	if err := cursor.All(ctx, &done); err != nil {
+		return nil, err
+	}
+	for _, t := range done {
+		contents[t.ID] = BlockEventItem{Content: t.Content, Done: true}
+	}
+
+	items := make([]BlockEventItem, 0, len(taskIDs))
+	for _, id := range taskIDs {
+		if item, ok := contents[id]; ok {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,60 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestBuildProviderEventFromBlock(t *testing.T) {
+	start := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	block := &types.TimeBlockDocument{
+		ID:     primitive.NewObjectID(),
+		Title:  "Deep work",
+		Start:  start,
+		End:    start.Add(2 * time.Hour),
+		Status: types.TimeBlockScheduled,
+	}
+	items := []BlockEventItem{
+		{Content: "Write spec"},
+		{Content: "Review PR", Done: true},
+	}
+
+	ev := BuildProviderEventFromBlock(block, items, "primary")
+
+	if ev.Summary != "Deep work" {
+		t.Errorf("summary = %q", ev.Summary)
+	}
+	if ev.IsAllDay || !ev.StartTime.Equal(block.Start) || !ev.EndTime.Equal(block.End) {
+		t.Errorf("expected a timed event spanning the block, got %+v", ev)
+	}
+	if ev.Description != "• Write spec\n✓ Review PR" {
+		t.Errorf("description = %q", ev.Description)
+	}
+	if ev.CalendarID != "primary" {
+		t.Errorf("calendar = %q", ev.CalendarID)
+	}
+	if ev.ExtendedProperties["kindred_block_id"] != block.ID.Hex() {
+		t.Errorf("missing kindred_block_id")
+	}
+	if !IsPushOriginEvent(ev) {
+		t.Errorf("block events must be marked push-origin so sync skips them")
+	}
+}
+
+func TestBuildProviderEventFromBlock_Ended(t *testing.T) {
+	block := &types.TimeBlockDocument{
+		ID:     primitive.NewObjectID(),
+		Title:  "Deep work",
+		Status: types.TimeBlockEnded,
+	}
+	ev := BuildProviderEventFromBlock(block, nil, "primary")
+	if ev.Summary != "✓ Deep work" {
+		t.Errorf("summary = %q", ev.Summary)
+	}
+	if ev.Description != "" {
+		t.Errorf("description = %q, want empty", ev.Description)
+	}
+}
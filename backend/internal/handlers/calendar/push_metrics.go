@@ -0,0 +1,39 @@
+package calendar
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Push outbox counters. Instruments come from the global meter provider, which
+// forwards to the real provider once otel.Init installs it, so they are safe
+// to create at package init.
+var (
+	pushMeter = otel.Meter("kindred")
+
+	pushEnqueuedCounter     = mustCounter("calendar.push.enqueued", "Rows added to the calendar push outbox")
+	pushCoalescedCounter    = mustCounter("calendar.push.coalesced", "Redundant pending upserts dropped when a batch was claimed")
+	pushSucceededCounter    = mustCounter("calendar.push.succeeded", "Outbox rows written to the calendar")
+	pushRetriedCounter      = mustCounter("calendar.push.retried", "Outbox rows that failed and were rescheduled with backoff")
+	pushDeadLetteredCounter = mustCounter("calendar.push.dead_lettered", "Outbox rows moved to dead-letter after exhausting retries")
+	pushReplayedCounter     = mustCounter("calendar.push.replayed", "Dead-lettered rows put back in the queue by the user")
+)
+
+func mustCounter(name, desc string) metric.Int64Counter {
+	c, err := pushMeter.Int64Counter(name, metric.WithDescription(desc))
+	if err != nil {
+		// Only returned for an invalid instrument name; fail loudly in dev.
+		panic(err)
+	}
+	return c
+}
+
+func countPush(ctx context.Context, c metric.Int64Counter, op PushOp, n int64) {
+	if n == 0 {
+		return
+	}
+	c.Add(ctx, n, metric.WithAttributes(attribute.String("op", string(op))))
+}
@@ -16,6 +16,10 @@ type PushOp string
 const (
 	PushOpUpsert PushOp = "upsert"
 	PushOpDelete PushOp = "delete"
+
+	// Focus-block ops carry BlockID instead of TaskID/CategoryID.
+	PushOpBlockUpsert PushOp = "block_upsert"
+	PushOpBlockDelete PushOp = "block_delete"
 )
 
 // PushOutboxRow is a queued push action.
@@ -25,25 +29,34 @@ type PushOutboxRow struct {
 	CategoryID primitive.ObjectID `bson:"category_id"`
 	UserID     primitive.ObjectID `bson:"user_id"`
 	Op         PushOp             `bson:"op"`
+	BlockID    primitive.ObjectID `bson:"block_id,omitempty"`
 
-	// Snapshot fields (set only for delete ops).
+	// Snapshot fields (set for delete ops; block upserts set only the connection
+	// so DeletePendingForConnection can find them).
 	TargetEventID      string             `bson:"target_event_id,omitempty"`
 	TargetCalendarID   string             `bson:"target_calendar_id,omitempty"`
 	TargetConnectionID primitive.ObjectID `bson:"target_connection_id,omitempty"`
 
-	EnqueuedAt    time.Time `bson:"enqueued_at"`
-	AttemptCount  int       `bson:"attempt_count"`
-	NextAttemptAt time.Time `bson:"next_attempt_at"`
-	LastError     string    `bson:"last_error,omitempty"`
-	Status        string    `bson:"status"` // "pending" | "failed_permanent"
+	EnqueuedAt     time.Time  `bson:"enqueued_at"`
+	AttemptCount   int        `bson:"attempt_count"`
+	NextAttemptAt  time.Time  `bson:"next_attempt_at"`
+	LastError      string     `bson:"last_error,omitempty"`
+	Status         string     `bson:"status"` // "pending" | "dead_letter"
+	DeadLetteredAt *time.Time `bson:"dead_lettered_at,omitempty"`
 }
 
 const (
-	pushStatusPending         = "pending"
+	pushStatusPending    = "pending"
+	pushStatusDeadLetter = "dead_letter"
+	// pushStatusFailedPermanent is the terminal status rows got before
+	// dead-lettering existed. Such rows are listed and replayable as dead letters.
 	pushStatusFailedPermanent = "failed_permanent"
 	pushMaxAttempts           = 10
 )
 
+// deadLetterStatuses matches terminal rows old and new.
+var deadLetterStatuses = bson.M{"$in": bson.A{pushStatusDeadLetter, pushStatusFailedPermanent}}
+
 // PushOutbox handles enqueue, coalescing, and drain queries.
 type PushOutbox struct {
 	col *mongo.Collection
@@ -64,7 +77,7 @@ func NewPushOutbox(col *mongo.Collection) *PushOutbox {
 // deployment-time follow-up; not added here.
 func (o *PushOutbox) EnqueueUpsert(ctx context.Context, taskID, categoryID, userID primitive.ObjectID) error {
 	now := time.Now()
-	_, err := o.col.UpdateOne(ctx,
+	res, err := o.col.UpdateOne(ctx,
 		bson.M{
 			"task_id": taskID,
 			"op":      PushOpUpsert,
@@ -87,6 +100,10 @@ func (o *PushOutbox) EnqueueUpsert(ctx context.Context, taskID, categoryID, user
 	if err != nil {
 		return err
 	}
+	// A call that collapsed onto a pending row enqueued nothing new.
+	if res.UpsertedCount > 0 {
+		countPush(ctx, pushEnqueuedCounter, PushOpUpsert, 1)
+	}
 	return nil
 }
 
@@ -116,7 +133,76 @@ func (o *PushOutbox) EnqueueDelete(ctx context.Context, taskID, categoryID, user
 		NextAttemptAt:      now,
 		Status:             pushStatusPending,
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	countPush(ctx, pushEnqueuedCounter, PushOpDelete, 1)
+	return nil
+}
+
+// EnqueueBlockUpsert queues a create-or-update of a focus block's event,
+// collapsing onto an already-pending row for the same block.
+func (o *PushOutbox) EnqueueBlockUpsert(ctx context.Context, blockID, userID, connectionID primitive.ObjectID) error {
+	now := time.Now()
+	res, err := o.col.UpdateOne(ctx,
+		bson.M{
+			"block_id": blockID,
+			"op":       PushOpBlockUpsert,
+			"status":   pushStatusPending,
+		},
+		bson.M{
+			"$setOnInsert": bson.M{
+				"block_id":             blockID,
+				"user_id":              userID,
+				"op":                   PushOpBlockUpsert,
+				"target_connection_id": connectionID,
+				"enqueued_at":          now,
+				"next_attempt_at":      now,
+				"attempt_count":        0,
+				"status":               pushStatusPending,
+			},
+		},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return err
+	}
+	// A call that collapsed onto a pending row enqueued nothing new.
+	if res.UpsertedCount > 0 {
+		countPush(ctx, pushEnqueuedCounter, PushOpBlockUpsert, 1)
+	}
+	return nil
+}
+
+// EnqueueBlockDelete queues deletion of a focus block's event and drops any
+// pending upsert for the block.
+func (o *PushOutbox) EnqueueBlockDelete(ctx context.Context, blockID, userID, connectionID primitive.ObjectID, eventID, calendarID string) error {
+	_, err := o.col.DeleteMany(ctx, bson.M{
+		"block_id": blockID,
+		"op":       PushOpBlockUpsert,
+		"status":   pushStatusPending,
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	_, err = o.col.InsertOne(ctx, PushOutboxRow{
+		BlockID:            blockID,
+		UserID:             userID,
+		Op:                 PushOpBlockDelete,
+		TargetEventID:      eventID,
+		TargetCalendarID:   calendarID,
+		TargetConnectionID: connectionID,
+		EnqueuedAt:         now,
+		NextAttemptAt:      now,
+		Status:             pushStatusPending,
+	})
+	if err != nil {
+		return err
+	}
+	countPush(ctx, pushEnqueuedCounter, PushOpBlockDelete, 1)
+	return nil
 }
 
 // ClaimBatch atomically claims up to `limit` pending rows whose next_attempt_at
@@ -125,6 +211,10 @@ func (o *PushOutbox) EnqueueDelete(ctx context.Context, taskID, categoryID, user
 // it. On success/failure the worker still deletes (MarkSuccess) or resets
 // next_attempt_at via backoff (MarkFailure). If the worker crashes mid-process,
 // the row reappears after the TTL and another worker can pick it up.
+//
+// Upserts are coalesced: an upsert reads the task's current state when it is
+// processed, so once one upsert row for a task is claimed every other pending
+// upsert row for that task (or block) is redundant and is deleted.
 func (o *PushOutbox) ClaimBatch(ctx context.Context, limit int) ([]PushOutboxRow, error) {
 	const claimTTL = 5 * time.Minute
 	now := time.Now()
@@ -174,9 +264,75 @@ func (o *PushOutbox) ClaimBatch(ctx context.Context, limit int) ([]PushOutboxRow
 	if err := cursor2.All(ctx, &rows); err != nil {
 		return nil, err
 	}
+
+	// Step 4: coalesce redundant upserts, inside the batch and outside it.
+	rows, dropped := coalesceUpserts(rows)
+	var keepIDs, taskIDs, blockIDs []primitive.ObjectID
+	for _, r := range rows {
+		switch r.Op {
+		case PushOpUpsert:
+			keepIDs = append(keepIDs, r.ID)
+			taskIDs = append(taskIDs, r.TaskID)
+		case PushOpBlockUpsert:
+			keepIDs = append(keepIDs, r.ID)
+			blockIDs = append(blockIDs, r.BlockID)
+		}
+	}
+	var redundant []bson.M
+	if len(taskIDs) > 0 {
+		redundant = append(redundant, bson.M{"op": PushOpUpsert, "task_id": bson.M{"$in": taskIDs}})
+	}
+	if len(blockIDs) > 0 {
+		redundant = append(redundant, bson.M{"op": PushOpBlockUpsert, "block_id": bson.M{"$in": blockIDs}})
+	}
+	if len(redundant) > 0 {
+		res, err := o.col.DeleteMany(ctx, bson.M{
+			"_id":    bson.M{"$nin": keepIDs},
+			"status": pushStatusPending,
+			"$or":    redundant,
+		})
+		if err != nil {
+			// Not fatal: the duplicates just cost an extra API call each.
+			return rows, nil
+		}
+		dropped += int(res.DeletedCount)
+	}
+	if dropped > 0 {
+		countPush(ctx, pushCoalescedCounter, PushOpUpsert, int64(dropped))
+	}
 	return rows, nil
 }
 
+// coalesceUpserts keeps the first upsert row per task (and per block) and
+// returns how many later duplicates were removed from the batch. The removed
+// rows are still in the collection; ClaimBatch deletes them with the rest.
+// Order of the remaining rows is preserved, so a delete queued between two
+// upserts still runs before the kept upsert is processed.
+func coalesceUpserts(rows []PushOutboxRow) ([]PushOutboxRow, int) {
+	seenTask := map[primitive.ObjectID]bool{}
+	seenBlock := map[primitive.ObjectID]bool{}
+	kept := rows[:0]
+	dropped := 0
+	for _, r := range rows {
+		switch r.Op {
+		case PushOpUpsert:
+			if seenTask[r.TaskID] {
+				dropped++
+				continue
+			}
+			seenTask[r.TaskID] = true
+		case PushOpBlockUpsert:
+			if seenBlock[r.BlockID] {
+				dropped++
+				continue
+			}
+			seenBlock[r.BlockID] = true
+		}
+		kept = append(kept, r)
+	}
+	return kept, dropped
+}
+
 // DeletePendingForConnection removes pending outbox rows tied to a disconnected
 // connection — both upserts (matched by category_id, since they look the
 // connection up via the category's integration field at process time) and
@@ -200,29 +356,133 @@ func (o *PushOutbox) DeletePendingForConnection(ctx context.Context, connectionI
 	return res.DeletedCount, nil
 }
 
+// CountPendingForUser returns how many of the user's push rows are waiting
+// to be drained (including rows backing off after a failure).
+func (o *PushOutbox) CountPendingForUser(ctx context.Context, userID primitive.ObjectID) (int64, error) {
+	return o.col.CountDocuments(ctx, bson.M{"user_id": userID, "status": pushStatusPending})
+}
+
 // MarkSuccess deletes the row after a successful drain.
-func (o *PushOutbox) MarkSuccess(ctx context.Context, id primitive.ObjectID) error {
-	_, err := o.col.DeleteOne(ctx, bson.M{"_id": id})
-	return err
+func (o *PushOutbox) MarkSuccess(ctx context.Context, row PushOutboxRow) error {
+	_, err := o.col.DeleteOne(ctx, bson.M{"_id": row.ID})
+	if err != nil {
+		return err
+	}
+	countPush(ctx, pushSucceededCounter, row.Op, 1)
+	return nil
 }
 
 // MarkFailure increments attempt_count, sets next_attempt_at with backoff,
-// stores last_error, and flips to failed_permanent after pushMaxAttempts.
-func (o *PushOutbox) MarkFailure(ctx context.Context, id primitive.ObjectID, attempt int, errMsg string) error {
-	backoff := backoffFor(attempt)
-	status := pushStatusPending
-	if attempt+1 >= pushMaxAttempts {
-		status = pushStatusFailedPermanent
-	}
-	_, err := o.col.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
-		"$set": bson.M{
-			"attempt_count":   attempt + 1,
-			"next_attempt_at": time.Now().Add(backoff),
-			"last_error":      errMsg,
-			"status":          status,
+// stores last_error, and moves the row to dead-letter after pushMaxAttempts.
+// Dead-lettered rows are never claimed again until replayed.
+func (o *PushOutbox) MarkFailure(ctx context.Context, row PushOutboxRow, errMsg string) error {
+	attempt := row.AttemptCount
+	now := time.Now()
+	set := bson.M{
+		"attempt_count":   attempt + 1,
+		"next_attempt_at": now.Add(backoffFor(attempt)),
+		"last_error":      errMsg,
+		"status":          pushStatusPending,
+	}
+	deadLetter := attempt+1 >= pushMaxAttempts
+	if deadLetter {
+		set["status"] = pushStatusDeadLetter
+		set["dead_lettered_at"] = now
+	}
+	_, err := o.col.UpdateOne(ctx, bson.M{"_id": row.ID}, bson.M{"$set": set})
+	if err != nil {
+		return err
+	}
+	if deadLetter {
+		countPush(ctx, pushDeadLetteredCounter, row.Op, 1)
+	} else {
+		countPush(ctx, pushRetriedCounter, row.Op, 1)
+	}
+	return nil
+}
+
+// ListDeadLettersForUser returns the user's dead-lettered rows, newest first.
+func (o *PushOutbox) ListDeadLettersForUser(ctx context.Context, userID primitive.ObjectID, limit int) ([]PushOutboxRow, error) {
+	cursor, err := o.col.Find(ctx,
+		bson.M{"user_id": userID, "status": deadLetterStatuses},
+		options.Find().SetSort(bson.D{{Key: "enqueued_at", Value: -1}}).SetLimit(int64(limit)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	rows := []PushOutboxRow{}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// ReplayDeadLetter puts a dead-lettered row back in the queue with a fresh
+// retry budget. If an upsert for the same task or block is already pending,
+// the dead row is dropped instead since that upsert covers it. Returns
+// mongo.ErrNoDocuments if the row isn't the user's or isn't dead-lettered.
+func (o *PushOutbox) ReplayDeadLetter(ctx context.Context, userID, rowID primitive.ObjectID) error {
+	var row PushOutboxRow
+	err := o.col.FindOne(ctx, bson.M{"_id": rowID, "user_id": userID, "status": deadLetterStatuses}).Decode(&row)
+	if err != nil {
+		return err
+	}
+
+	var pending bson.M
+	switch row.Op {
+	case PushOpUpsert:
+		pending = bson.M{"task_id": row.TaskID, "op": PushOpUpsert, "status": pushStatusPending}
+	case PushOpBlockUpsert:
+		pending = bson.M{"block_id": row.BlockID, "op": PushOpBlockUpsert, "status": pushStatusPending}
+	}
+	if pending != nil {
+		n, err := o.col.CountDocuments(ctx, pending)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			_, err := o.col.DeleteOne(ctx, bson.M{"_id": row.ID})
+			if err == nil {
+				countPush(ctx, pushCoalescedCounter, row.Op, 1)
+			}
+			return err
+		}
+	}
+
+	res, err := o.col.UpdateOne(ctx,
+		bson.M{"_id": row.ID, "status": deadLetterStatuses},
+		bson.M{
+			"$set": bson.M{
+				"status":          pushStatusPending,
+				"attempt_count":   0,
+				"next_attempt_at": time.Now(),
+			},
+			"$unset": bson.M{"dead_lettered_at": ""},
 		},
-	})
-	return err
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	countPush(ctx, pushReplayedCounter, row.Op, 1)
+	return nil
+}
+
+// DiscardDeadLetter deletes a dead-lettered row. Returns mongo.ErrNoDocuments
+// if the row isn't the user's or isn't dead-lettered.
+func (o *PushOutbox) DiscardDeadLetter(ctx context.Context, userID, rowID primitive.ObjectID) error {
+	res, err := o.col.DeleteOne(ctx, bson.M{"_id": rowID, "user_id": userID, "status": deadLetterStatuses})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
 }
 
 // backoffFor returns the wait before retrying attempt n (0-indexed).
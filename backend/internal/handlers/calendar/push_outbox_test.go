@@ -0,0 +1,51 @@
+package calendar
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCoalesceUpserts(t *testing.T) {
+	taskA, taskB, block := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+	row := func(op PushOp, taskID, blockID primitive.ObjectID) PushOutboxRow {
+		return PushOutboxRow{ID: primitive.NewObjectID(), Op: op, TaskID: taskID, BlockID: blockID}
+	}
+	rows := []PushOutboxRow{
+		row(PushOpUpsert, taskA, primitive.NilObjectID),
+		row(PushOpDelete, taskA, primitive.NilObjectID),
+		row(PushOpUpsert, taskA, primitive.NilObjectID),
+		row(PushOpUpsert, taskB, primitive.NilObjectID),
+		row(PushOpBlockUpsert, primitive.NilObjectID, block),
+		row(PushOpBlockUpsert, primitive.NilObjectID, block),
+	}
+	want := []primitive.ObjectID{rows[0].ID, rows[1].ID, rows[3].ID, rows[4].ID}
+
+	kept, dropped := coalesceUpserts(rows)
+
+	if dropped != 2 {
+		t.Errorf("dropped = %d, want 2", dropped)
+	}
+	if len(kept) != len(want) {
+		t.Fatalf("kept %d rows, want %d", len(kept), len(want))
+	}
+	for i, id := range want {
+		if kept[i].ID != id {
+			t.Errorf("kept[%d] = %s (%s), want %s", i, kept[i].ID.Hex(), kept[i].Op, id.Hex())
+		}
+	}
+}
+
+func TestBackoffFor(t *testing.T) {
+	if backoffFor(-1) != backoffFor(0) {
+		t.Errorf("negative attempt should use the first step")
+	}
+	if backoffFor(pushMaxAttempts) != backoffFor(100) {
+		t.Errorf("attempts past the schedule should plateau")
+	}
+	for i := 1; i < 6; i++ {
+		if backoffFor(i) <= backoffFor(i-1) {
+			t.Errorf("backoff should grow: attempt %d = %v, attempt %d = %v", i-1, backoffFor(i-1), i, backoffFor(i))
+		}
+	}
+}
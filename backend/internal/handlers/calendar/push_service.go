@@ -51,6 +51,10 @@ func (s *Service) ProcessPushRow(ctx context.Context, row PushOutboxRow) error {
 		return s.processPushDelete(ctx, row)
 	case PushOpUpsert:
 		return s.processPushUpsert(ctx, row)
+	case PushOpBlockUpsert:
+		return s.processBlockUpsert(ctx, row)
+	case PushOpBlockDelete:
+		return s.processBlockDelete(ctx, row)
 	default:
 		return fmt.Errorf("unknown push op: %s", row.Op)
 	}
@@ -118,6 +122,16 @@ func (s *Service) processPushUpsert(ctx context.Context, row PushOutboxRow) erro
 		return nil
 	}
 
+	if task.TimeBlockID != nil {
+		// The task's focus block is pushed as one event in its place; remove
+		// any event pushed for the task before it joined the block.
+		if task.PushedEventID != "" {
+			slog.Info("Push upsert: task is in a focus block, deleting its own event", "task_id", task.ID, "block_id", task.TimeBlockID)
+			return s.deleteEventForTask(ctx, task, row.CategoryID)
+		}
+		return nil
+	}
+
 	ev, err := BuildProviderEventFromTask(task, calendarID)
 	if err != nil {
 		if errors.Is(err, ErrTaskNotPushable) {
@@ -160,7 +174,21 @@ func (s *Service) processPushUpsert(ctx context.Context, row PushOutboxRow) erro
 			return fmt.Errorf("provider create: %w", err)
 		}
 	} else {
+		// Guard against overwriting a calendar-side edit: the update carries the
+		// ETag of our last write and fails with ErrEventDrifted if it changed.
+		ev.Etag = task.PushedEventEtag
 		written, err = provider.UpdateEvent(ctx, token, task.PushedEventID, ev)
+		if errors.Is(err, ErrEventDrifted) {
+			if normalizeDriftPolicy(category.DriftPolicy) != DriftPolicyKindredWins {
+				// Leave the calendar edit in place; the next sync sees the ETag
+				// mismatch and resolves it per the category's drift policy.
+				slog.Info("Push upsert: event drifted on calendar, deferring to sync",
+					"task_id", task.ID, "event_id", task.PushedEventID, "policy", category.DriftPolicy)
+				return nil
+			}
+			ev.Etag = ""
+			written, err = provider.UpdateEvent(ctx, token, task.PushedEventID, ev)
+		}
 		if err != nil {
 			if isCalendarWriteForbidden(err) {
 				return s.disablePushForUnwritableCategory(ctx, row.CategoryID, calendarID, err)
@@ -244,6 +272,7 @@ type categoryRow struct {
 	ID          primitive.ObjectID `bson:"_id"`
 	Integration string             `bson:"integration"`
 	PushEnabled bool               `bson:"push_enabled"`
+	DriftPolicy DriftPolicy        `bson:"drift_policy"`
 }
 
 // loadTaskWithCategory finds the embedded task in its category.
@@ -252,12 +281,14 @@ func (s *Service) loadTaskWithCategory(ctx context.Context, taskID, categoryID p
 		ID          primitive.ObjectID   `bson:"_id"`
 		Integration string               `bson:"integration"`
 		PushEnabled bool                 `bson:"push_enabled"`
+		DriftPolicy DriftPolicy          `bson:"drift_policy"`
 		Tasks       []types.TaskDocument `bson:"tasks"`
 	}
 	projection := bson.M{
 		"_id":          1,
 		"integration":  1,
 		"push_enabled": 1,
+		"drift_policy": 1,
 		"tasks":        bson.M{"$elemMatch": bson.M{"_id": taskID}},
 	}
 	err := s.categories.FindOne(ctx, bson.M{
@@ -274,6 +305,7 @@ func (s *Service) loadTaskWithCategory(ctx context.Context, taskID, categoryID p
 		ID:          doc.ID,
 		Integration: doc.Integration,
 		PushEnabled: doc.PushEnabled,
+		DriftPolicy: doc.DriftPolicy,
 	}, nil
 }
 
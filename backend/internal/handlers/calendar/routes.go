@@ -53,6 +53,20 @@ func Routes(api huma.API, collections map[string]*mongo.Collection, cfg config.C
 	RegisterListCalendarsOperation(api, handler)
 	RegisterSetupWorkspacesOperation(api, handler)
 
+	// Drift policy and conflict endpoints
+	RegisterSetDriftPolicyOperation(api, handler)
+	RegisterGetConflictsOperation(api, handler)
+	RegisterDismissConflictOperation(api, handler)
+
+	// Sync status and log endpoints
+	RegisterGetSyncStatusOperation(api, handler)
+	RegisterGetSyncLogOperation(api, handler)
+
+	// Push dead-letter endpoints
+	RegisterGetDeadLettersOperation(api, handler)
+	RegisterReplayDeadLetterOperation(api, handler)
+	RegisterDiscardDeadLetterOperation(api, handler)
+
 	// Webhook endpoints
 	RegisterWebhookOperation(api, handler)
 
@@ -22,6 +22,10 @@ type Service struct {
 	categories      *mongo.Collection
 	workspaces      *mongo.Collection
 	processedEvents *mongo.Collection
+	conflicts       *mongo.Collection
+	syncLog         *mongo.Collection
+	timeBlocks      *mongo.Collection
+	completedTasks  *mongo.Collection
 	pushOutbox      *PushOutbox
 	providers       map[CalendarProvider]Provider
 	config          config.Config
@@ -67,13 +71,21 @@ func NewService(connections *mongo.Collection, categories *mongo.Collection, cfg
 	// Get processed events collection from the same database
 	processedEvents := connections.Database().Collection("calendar_processed_events")
 	pushOutboxCol := connections.Database().Collection("calendar_push_outbox")
+	conflicts := connections.Database().Collection("calendar_conflicts")
 	workspaces := connections.Database().Collection("workspaces")
+	syncLog := connections.Database().Collection("calendar_sync_log")
+	timeBlocks := connections.Database().Collection("time_blocks")
+	completedTasks := connections.Database().Collection("completed-tasks")
 
 	return &Service{
 		connections:     connections,
 		categories:      categories,
 		workspaces:      workspaces,
 		processedEvents: processedEvents,
+		conflicts:       conflicts,
+		syncLog:         syncLog,
+		timeBlocks:      timeBlocks,
+		completedTasks:  completedTasks,
 		pushOutbox:      NewPushOutbox(pushOutboxCol),
 		providers:       providers,
 		config:          cfg,
@@ -756,9 +768,11 @@ func (s *Service) FetchEvents(ctx context.Context, connectionID primitive.Object
 // SyncResult contains statistics about a sync operation
 type SyncResult struct {
 	TasksCreated     int
+	TasksUpdated     int // tasks changed to match a calendar-side edit (drift resolved calendar_wins)
 	TasksSkipped     int
 	TasksDeleted     int
 	EventsTotal      int
+	DriftsDetected   int            // pushed events edited on the calendar side
 	CategoriesSynced map[string]int // category_name -> task_count
 	WorkspaceName    string
 }
@@ -843,9 +857,20 @@ func (s *Service) SyncEventsToTasks(ctx context.Context, connectionID, userID pr
 		tasksSkipped := 0
 
 		for _, event := range calEvents {
-			// Loop prevention: skip events Kindred itself wrote.
+			// Loop prevention: skip events Kindred itself wrote, but first check
+			// whether they were edited on the calendar since our last push.
 			if IsPushOriginEvent(event) {
 				slog.Debug("Sync: skipping push-origin event", "event_id", event.ID, "task_id_hint", event.ExtendedProperties["kindred_task_id"])
+				winner, driftErr := s.reconcilePushedEvent(ctx, userID, event)
+				if driftErr != nil {
+					slog.Error("Sync: failed to reconcile drifted event", "event_id", event.ID, "error", driftErr)
+				}
+				if winner != "" {
+					result.DriftsDetected++
+				}
+				if winner == driftWinnerCalendar && driftErr == nil {
+					result.TasksUpdated++
+				}
 				tasksSkipped++
 				continue
 			}
@@ -978,6 +1003,7 @@ func (s *Service) SyncEventsToTasks(ctx context.Context, connectionID, userID pr
 		"tasks_skipped", result.TasksSkipped,
 		"tasks_deleted", result.TasksDeleted,
 		"events_total", result.EventsTotal,
+		"drifts_detected", result.DriftsDetected,
 		"duration_ms", syncDuration.Milliseconds())
 
 	// Track sync completion in PostHog for product analytics
@@ -987,12 +1013,13 @@ func (s *Service) SyncEventsToTasks(ctx context.Context, connectionID, userID pr
 			EventName: "calendar_sync_completed",
 			Category:  "calendar",
 			Properties: map[string]interface{}{
-				"connection_id": connectionID.Hex(),
-				"tasks_created": result.TasksCreated,
-				"tasks_skipped": result.TasksSkipped,
-				"tasks_deleted": result.TasksDeleted,
-				"events_total":  result.EventsTotal,
-				"duration_ms":   syncDuration.Milliseconds(),
+				"connection_id":   connectionID.Hex(),
+				"tasks_created":   result.TasksCreated,
+				"tasks_skipped":   result.TasksSkipped,
+				"tasks_deleted":   result.TasksDeleted,
+				"events_total":    result.EventsTotal,
+				"drifts_detected": result.DriftsDetected,
+				"duration_ms":     syncDuration.Milliseconds(),
 			},
 		})
 	}
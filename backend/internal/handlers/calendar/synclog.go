@@ -0,0 +1,297 @@
+package calendar
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SyncTrigger records what started a sync-log entry.
+type SyncTrigger string
+
+const (
+	SyncTriggerManual    SyncTrigger = "manual"
+	SyncTriggerWebhook   SyncTrigger = "webhook"
+	SyncTriggerRenewal   SyncTrigger = "renewal"
+	SyncTriggerHeartbeat SyncTrigger = "heartbeat"
+)
+
+const (
+	syncLogStatusOK       = "ok"
+	syncLogStatusDegraded = "degraded"
+	syncLogStatusError    = "error"
+
+	// maxSyncLogEntries bounds the log per connection; older entries are
+	// trimmed on write (a TTL index also expires anything past 30 days).
+	maxSyncLogEntries = 50
+)
+
+// SyncLogEntry is one sync, watch renewal or heartbeat run against a connection.
+type SyncLogEntry struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	ConnectionID    primitive.ObjectID `bson:"connection_id" json:"connection_id"`
+	UserID          primitive.ObjectID `bson:"user_id" json:"-"`
+	Trigger         SyncTrigger        `bson:"trigger" json:"trigger"`
+	Status          string             `bson:"status" json:"status" doc:"ok, degraded or error"`
+	EventsSeen      int                `bson:"events_seen" json:"events_seen"`
+	TasksCreated    int                `bson:"tasks_created" json:"tasks_created"`
+	TasksUpdated    int                `bson:"tasks_updated" json:"tasks_updated" doc:"Tasks changed to match a calendar-side edit"`
+	TasksDeleted    int                `bson:"tasks_deleted" json:"tasks_deleted"`
+	TasksSkipped    int                `bson:"tasks_skipped" json:"tasks_skipped"`
+	DriftsDetected  int                `bson:"drifts_detected" json:"drifts_detected"`
+	ChannelsRenewed int                `bson:"channels_renewed,omitempty" json:"channels_renewed,omitempty"`
+	Message         string             `bson:"message,omitempty" json:"message,omitempty"`
+	Error           string             `bson:"error,omitempty" json:"error,omitempty"`
+	DurationMs      int64              `bson:"duration_ms" json:"duration_ms"`
+	StartedAt       time.Time          `bson:"started_at" json:"started_at"`
+}
+
+// newSyncLogEntry builds the log entry for a SyncEventsToTasks run.
+func newSyncLogEntry(connectionID, userID primitive.ObjectID, trigger SyncTrigger, started time.Time, result *SyncResult, err error) SyncLogEntry {
+	entry := SyncLogEntry{
+		ConnectionID: connectionID,
+		UserID:       userID,
+		Trigger:      trigger,
+		Status:       syncLogStatusOK,
+		DurationMs:   time.Since(started).Milliseconds(),
+		StartedAt:    started,
+	}
+	if err != nil {
+		entry.Status = syncLogStatusError
+		entry.Error = err.Error()
+	}
+	if result != nil {
+		entry.EventsSeen = result.EventsTotal
+		entry.TasksCreated = result.TasksCreated
+		entry.TasksUpdated = result.TasksUpdated
+		entry.TasksDeleted = result.TasksDeleted
+		entry.TasksSkipped = result.TasksSkipped
+		entry.DriftsDetected = result.DriftsDetected
+	}
+	return entry
+}
+
+// RecordSync persists the outcome of a SyncEventsToTasks run.
+func (s *Service) RecordSync(ctx context.Context, connectionID, userID primitive.ObjectID, trigger SyncTrigger, started time.Time, result *SyncResult, err error) {
+	s.appendSyncLog(ctx, newSyncLogEntry(connectionID, userID, trigger, started, result, err))
+}
+
+// RecordHeartbeat persists a connection health check.
+func (s *Service) RecordHeartbeat(ctx context.Context, result *HealthCheckResult) {
+	status := syncLogStatusOK
+	switch result.Status {
+	case HealthStatusDegraded:
+		status = syncLogStatusDegraded
+	case HealthStatusBroken:
+		status = syncLogStatusError
+	}
+	s.appendSyncLog(ctx, SyncLogEntry{
+		ConnectionID: result.ConnectionID,
+		UserID:       result.UserID,
+		Trigger:      SyncTriggerHeartbeat,
+		Status:       status,
+		Message:      result.Message,
+		DurationMs:   result.Duration.Milliseconds(),
+		StartedAt:    time.Now().Add(-result.Duration),
+	})
+}
+
+// RecordRenewal persists one watch-renewal pass over a connection. errs holds
+// one message per channel that failed to renew.
+func (s *Service) RecordRenewal(ctx context.Context, connection *CalendarConnection, started time.Time, renewed int, errs []string) {
+	entry := SyncLogEntry{
+		ConnectionID:    connection.ID,
+		UserID:          connection.UserID,
+		Trigger:         SyncTriggerRenewal,
+		Status:          syncLogStatusOK,
+		ChannelsRenewed: renewed,
+		DurationMs:      time.Since(started).Milliseconds(),
+		StartedAt:       started,
+	}
+	if len(errs) > 0 {
+		entry.Status = syncLogStatusError
+		entry.Error = errs[0]
+		if len(errs) > 1 {
+			entry.Message = "additional renewal failures: " + strings.Join(errs[1:], "; ")
+		}
+	}
+	s.appendSyncLog(ctx, entry)
+}
+
+// appendSyncLog inserts the entry and trims the connection's log to the most
+// recent maxSyncLogEntries. Failures are logged; the log is diagnostic and must
+// never fail the operation it describes.
+func (s *Service) appendSyncLog(ctx context.Context, entry SyncLogEntry) {
+	if s.syncLog == nil {
+		return
+	}
+	if _, err := s.syncLog.InsertOne(ctx, entry); err != nil {
+		slog.Warn("Failed to record calendar sync log entry", "connection_id", entry.ConnectionID, "trigger", entry.Trigger, "error", err)
+		return
+	}
+
+	var cutoff struct {
+		StartedAt time.Time `bson:"started_at"`
+	}
+	err := s.syncLog.FindOne(ctx,
+		bson.M{"connection_id": entry.ConnectionID},
+		options.FindOne().
+			SetSort(bson.D{{Key: "started_at", Value: -1}}).
+			SetSkip(maxSyncLogEntries-1).
+			SetProjection(bson.M{"started_at": 1}),
+	).Decode(&cutoff)
+	if err != nil {
+		// ErrNoDocuments: fewer than the cap, nothing to trim.
+		return
+	}
+	if _, err := s.syncLog.DeleteMany(ctx, bson.M{
+		"connection_id": entry.ConnectionID,
+		"started_at":    bson.M{"$lt": cutoff.StartedAt},
+	}); err != nil {
+		slog.Warn("Failed to trim calendar sync log", "connection_id", entry.ConnectionID, "error", err)
+	}
+}
+
+// ListSyncLog returns a connection's most recent log entries, newest first.
+func (s *Service) ListSyncLog(ctx context.Context, userID, connectionID primitive.ObjectID, limit int) ([]SyncLogEntry, error) {
+	if limit <= 0 || limit > maxSyncLogEntries {
+		limit = maxSyncLogEntries
+	}
+	cursor, err := s.syncLog.Find(ctx,
+		bson.M{"connection_id": connectionID, "user_id": userID},
+		options.Find().SetSort(bson.D{{Key: "started_at", Value: -1}}).SetLimit(int64(limit)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	entries := []SyncLogEntry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// recentSyncLogEntries is how many log entries GetSyncStatus inlines per connection.
+const recentSyncLogEntries = 5
+
+// maxFailedPushRows bounds the failed outbox rows returned by GetSyncStatus.
+const maxFailedPushRows = 50
+
+// ConnectionSyncStatus summarizes one connection for the sync status view.
+type ConnectionSyncStatus struct {
+	ConnectionID  primitive.ObjectID `json:"connection_id"`
+	Provider      CalendarProvider   `json:"provider"`
+	Account       string             `json:"account"`
+	HealthStatus  HealthStatus       `json:"health_status"`
+	HealthMessage string             `json:"health_message,omitempty"`
+	LastSync      time.Time          `json:"last_sync"`
+	LastHeartbeat time.Time          `json:"last_heartbeat"`
+	WatchActive   bool               `json:"watch_active" doc:"Whether at least one unexpired watch channel exists (webhook-triggered syncs)"`
+	Recent        []SyncLogEntry     `json:"recent"`
+}
+
+// FailedPush is a dead-lettered outbox row: one that exhausted its retries.
+type FailedPush struct {
+	ID             primitive.ObjectID `json:"id"`
+	Op             PushOp             `json:"op"`
+	TaskID         primitive.ObjectID `json:"task_id,omitempty"`
+	CategoryID     primitive.ObjectID `json:"category_id,omitempty"`
+	BlockID        primitive.ObjectID `json:"block_id,omitempty"`
+	AttemptCount   int                `json:"attempt_count"`
+	LastError      string             `json:"last_error"`
+	EnqueuedAt     time.Time          `json:"enqueued_at"`
+	DeadLetteredAt *time.Time         `json:"dead_lettered_at,omitempty"`
+}
+
+// SyncStatus is the user's calendar sync health at a glance.
+type SyncStatus struct {
+	Connections   []ConnectionSyncStatus `json:"connections"`
+	PendingPushes int64                  `json:"pending_pushes" doc:"Kindred changes queued to be written to calendars"`
+	FailedPushes  []FailedPush           `json:"failed_pushes" doc:"Changes that could not be written after all retries"`
+}
+
+// GetSyncStatus gathers connection health, recent sync log entries, and the
+// push outbox depth and failures for the user.
+func (s *Service) GetSyncStatus(ctx context.Context, userID primitive.ObjectID) (*SyncStatus, error) {
+	connections, err := s.GetConnections(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &SyncStatus{
+		Connections:  make([]ConnectionSyncStatus, 0, len(connections)),
+		FailedPushes: []FailedPush{},
+	}
+	now := time.Now()
+	for _, conn := range connections {
+		recent, err := s.ListSyncLog(ctx, userID, conn.ID, recentSyncLogEntries)
+		if err != nil {
+			return nil, err
+		}
+		watchActive := false
+		for _, w := range conn.WatchChannels {
+			if w.Expiration.After(now) {
+				watchActive = true
+				break
+			}
+		}
+		status.Connections = append(status.Connections, ConnectionSyncStatus{
+			ConnectionID:  conn.ID,
+			Provider:      conn.Provider,
+			Account:       conn.ProviderAccountID,
+			HealthStatus:  conn.HealthStatus,
+			HealthMessage: conn.HealthMessage,
+			LastSync:      conn.LastSync,
+			LastHeartbeat: conn.LastHeartbeat,
+			WatchActive:   watchActive,
+			Recent:        recent,
+		})
+	}
+
+	status.PendingPushes, err = s.pushOutbox.CountPendingForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	failed, err := s.pushOutbox.ListDeadLettersForUser(ctx, userID, maxFailedPushRows)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range failed {
+		status.FailedPushes = append(status.FailedPushes, failedPushFromRow(row))
+	}
+	return status, nil
+}
+
+func failedPushFromRow(row PushOutboxRow) FailedPush {
+	return FailedPush{
+		ID:             row.ID,
+		Op:             row.Op,
+		TaskID:         row.TaskID,
+		CategoryID:     row.CategoryID,
+		BlockID:        row.BlockID,
+		AttemptCount:   row.AttemptCount,
+		LastError:      row.LastError,
+		EnqueuedAt:     row.EnqueuedAt,
+		DeadLetteredAt: row.DeadLetteredAt,
+	}
+}
+
+// ListDeadLetters returns the user's dead-lettered push rows, newest first.
+func (s *Service) ListDeadLetters(ctx context.Context, userID primitive.ObjectID) ([]FailedPush, error) {
+	rows, err := s.pushOutbox.ListDeadLettersForUser(ctx, userID, maxFailedPushRows)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]FailedPush, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, failedPushFromRow(row))
+	}
+	return out, nil
+}
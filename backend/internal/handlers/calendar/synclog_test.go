@@ -0,0 +1,52 @@
+package calendar
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestNewSyncLogEntry_Success(t *testing.T) {
+	connID, userID := primitive.NewObjectID(), primitive.NewObjectID()
+	started := time.Now().Add(-2 * time.Second)
+	result := &SyncResult{
+		EventsTotal:    12,
+		TasksCreated:   3,
+		TasksUpdated:   1,
+		TasksDeleted:   2,
+		TasksSkipped:   6,
+		DriftsDetected: 1,
+	}
+
+	entry := newSyncLogEntry(connID, userID, SyncTriggerWebhook, started, result, nil)
+
+	if entry.Status != syncLogStatusOK || entry.Error != "" {
+		t.Errorf("status = %q, error = %q", entry.Status, entry.Error)
+	}
+	if entry.Trigger != SyncTriggerWebhook || entry.ConnectionID != connID || entry.UserID != userID {
+		t.Errorf("identity fields not copied: %+v", entry)
+	}
+	if entry.EventsSeen != 12 || entry.TasksCreated != 3 || entry.TasksUpdated != 1 ||
+		entry.TasksDeleted != 2 || entry.TasksSkipped != 6 || entry.DriftsDetected != 1 {
+		t.Errorf("counters not copied: %+v", entry)
+	}
+	if entry.DurationMs < 2000 {
+		t.Errorf("duration_ms = %d, want >= 2000", entry.DurationMs)
+	}
+}
+
+func TestNewSyncLogEntry_Error(t *testing.T) {
+	entry := newSyncLogEntry(primitive.NewObjectID(), primitive.NewObjectID(), SyncTriggerManual, time.Now(), nil, errors.New("failed to fetch events: 401"))
+
+	if entry.Status != syncLogStatusError {
+		t.Errorf("status = %q, want error", entry.Status)
+	}
+	if entry.Error != "failed to fetch events: 401" {
+		t.Errorf("error = %q", entry.Error)
+	}
+	if entry.EventsSeen != 0 || entry.TasksCreated != 0 {
+		t.Errorf("expected zero counters without a result: %+v", entry)
+	}
+}
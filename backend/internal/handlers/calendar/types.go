@@ -112,7 +112,79 @@ type SyncEventsOutput struct {
 		TasksSkipped     int            `json:"tasks_skipped"`
 		TasksDeleted     int            `json:"tasks_deleted"`
 		EventsTotal      int            `json:"events_total"`
+		DriftsDetected   int            `json:"drifts_detected"`
 		CategoriesSynced map[string]int `json:"categories_synced"` // category_name -> task_count
 		WorkspaceName    string         `json:"workspace_name"`
 	}
 }
+
+// Drift policy types
+type SetDriftPolicyInput struct {
+	CategoryID string `path:"categoryId" required:"true"`
+	Body       struct {
+		Policy DriftPolicy `json:"policy" enum:"kindred_wins,calendar_wins,last_writer_wins" doc:"Who wins when a pushed event is edited on the calendar side"`
+	}
+}
+
+type SetDriftPolicyOutput struct {
+	Body struct {
+		Success bool        `json:"success"`
+		Policy  DriftPolicy `json:"policy"`
+	}
+}
+
+// Conflict types
+type GetConflictsInput struct{}
+
+type GetConflictsOutput struct {
+	Body struct {
+		Conflicts []CalendarConflict `json:"conflicts"`
+	}
+}
+
+type DismissConflictInput struct {
+	ConflictID string `path:"conflictId" required:"true"`
+}
+
+type DismissConflictOutput struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
+
+// Sync status types
+type GetSyncStatusInput struct{}
+
+type GetSyncStatusOutput struct {
+	Body SyncStatus
+}
+
+type GetSyncLogInput struct {
+	ConnectionID string `path:"connectionId" required:"true"`
+	Limit        int    `query:"limit" minimum:"0" maximum:"50" doc:"Number of entries to return (default and max 50)"`
+}
+
+type GetSyncLogOutput struct {
+	Body struct {
+		Entries []SyncLogEntry `json:"entries"`
+	}
+}
+
+// Push dead-letter types
+type GetDeadLettersInput struct{}
+
+type GetDeadLettersOutput struct {
+	Body struct {
+		DeadLetters []FailedPush `json:"dead_letters"`
+	}
+}
+
+type DeadLetterActionInput struct {
+	RowID string `path:"rowId" required:"true"`
+}
+
+type DeadLetterActionOutput struct {
+	Body struct {
+		Success bool `json:"success"`
+	}
+}
@@ -111,6 +111,7 @@ func (h *Handler) HandleWebhook(ctx context.Context, input *WebhookInput) (*Webh
 				"time_range_end", endTime)
 
 			result, err := h.service.SyncEventsToTasks(bgCtx, connection.ID, connection.UserID, startTime, endTime)
+			h.service.RecordSync(bgCtx, connection.ID, connection.UserID, SyncTriggerWebhook, syncStart, result, err)
 			if err != nil {
 				slog.Error("Webhook-triggered sync failed",
 					"connection_id", connection.ID,
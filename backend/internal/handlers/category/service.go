@@ -34,10 +34,15 @@ func normalizeTags(tags []string) []string {
 
 // newService receives the map of collections and picks out Jobs
 func newService(collections map[string]*mongo.Collection) *Service {
+	taskEmbeddings := collections["task_embeddings"]
+	if taskEmbeddings == nil && collections["categories"] != nil {
+		taskEmbeddings = collections["categories"].Database().Collection("task_embeddings")
+	}
 	return &Service{
-		Categories:    collections["categories"],
-		TemplateTasks: collections["template-tasks"],
-		Workspaces:    collections["workspaces"],
+		Categories:     collections["categories"],
+		TemplateTasks:  collections["template-tasks"],
+		Workspaces:     collections["workspaces"],
+		TaskEmbeddings: taskEmbeddings,
 	}
 }
 
@@ -221,11 +226,48 @@ func (s *Service) DeleteCategory(userId primitive.ObjectID, id primitive.ObjectI
 			slog.Int64("templatesDeleted", templateResult.DeletedCount))
 	}
 
+	s.deleteTaskEmbeddings(ctx, bson.M{"_id": id})
+
 	// Delete the category
 	_, err = s.Categories.DeleteOne(ctx, bson.M{"_id": id})
 	return err
 }
 
+// deleteTaskEmbeddings drops the cached duplicate-detection vectors of the
+// tasks in the categories matching filter. Best-effort: a leftover row is
+// never matched again since its task is gone.
+func (s *Service) deleteTaskEmbeddings(ctx context.Context, filter bson.M) {
+	if s.TaskEmbeddings == nil {
+		return
+	}
+	cursor, err := s.Categories.Find(ctx, filter, options.Find().SetProjection(bson.M{"tasks._id": 1}))
+	if err != nil {
+		slog.Warn("Failed to load tasks for embedding cleanup", "error", err)
+		return
+	}
+	var categories []struct {
+		Tasks []struct {
+			ID primitive.ObjectID `bson:"_id"`
+		} `bson:"tasks"`
+	}
+	if err := cursor.All(ctx, &categories); err != nil {
+		slog.Warn("Failed to load tasks for embedding cleanup", "error", err)
+		return
+	}
+	var taskIDs []primitive.ObjectID
+	for _, c := range categories {
+		for _, t := range c.Tasks {
+			taskIDs = append(taskIDs, t.ID)
+		}
+	}
+	if len(taskIDs) == 0 {
+		return
+	}
+	if _, err := s.TaskEmbeddings.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": taskIDs}}); err != nil {
+		slog.Warn("Failed to delete task embeddings", "error", err)
+	}
+}
+
 func (s *Service) DeleteWorkspace(workspaceName string, user primitive.ObjectID) error {
 	ctx := context.Background()
 
@@ -268,6 +310,8 @@ func (s *Service) DeleteWorkspace(workspaceName string, user primitive.ObjectID)
 		}
 	}
 
+	s.deleteTaskEmbeddings(ctx, filter)
+
 	// Delete all categories in the workspace
 	categoryResult, err := s.Categories.DeleteMany(ctx, filter)
 	if err != nil {
@@ -431,9 +475,14 @@ func (s *Service) GetCategoryNamesSummary(userID primitive.ObjectID) (string, er
 	if err != nil {
 		return "", fmt.Errorf("failed to fetch categories: %w", err)
 	}
+	return FormatCategoryNamesSummary(workspaces), nil
+}
 
+// FormatCategoryNamesSummary renders workspaces the way GetCategoryNamesSummary
+// does, for callers that already hold them.
+func FormatCategoryNamesSummary(workspaces []WorkspaceResult) string {
 	if len(workspaces) == 0 {
-		return "No workspaces or categories found.", nil
+		return "No workspaces or categories found."
 	}
 
 	var b strings.Builder
@@ -446,7 +495,7 @@ func (s *Service) GetCategoryNamesSummary(userID primitive.ObjectID) (string, er
 			fmt.Fprintf(&b, "  - %s (id: %s)\n", cat.Name, cat.ID.Hex())
 		}
 	}
-	return b.String(), nil
+	return b.String()
 }
 
 // SetWorkspacePushEnabled sets push_enabled on every calendar-integrated
@@ -44,4 +44,7 @@ type Service struct {
 	Categories    *mongo.Collection
 	TemplateTasks *mongo.Collection
 	Workspaces    *mongo.Collection
+	// TaskEmbeddings holds the task service's duplicate-detection vectors;
+	// rows for a deleted category's tasks are dropped with it.
+	TaskEmbeddings *mongo.Collection
 }
@@ -2,6 +2,7 @@ package congratulation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -91,6 +92,9 @@ func (h *Handler) CreateCongratulationHuma(ctx context.Context, input *CreateCon
 		if strings.Contains(err.Error(), "insufficient congratulation balance") {
 			return nil, huma.Error400BadRequest("Insufficient congratulation balance", err)
 		}
+		if errors.Is(err, errActivityHidden) {
+			return nil, huma.Error404NotFound("Post not found", err)
+		}
 		slog.Error("failed to create congratulation", "userId", user_id, "receiver", input.Body.Receiver, "error", err)
 		return nil, huma.Error500InternalServerError("Unable to create congratulation. Please try again.", err)
 	}
@@ -2,10 +2,12 @@ package congratulation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
+	"github.com/abhikaboy/Kindred/internal/audience"
 	"github.com/abhikaboy/Kindred/internal/friendship"
 	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
 	"github.com/abhikaboy/Kindred/internal/handlers/rings"
@@ -16,6 +18,10 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
+// errActivityHidden is returned for kudos on a post or task the receiver
+// hides from the sender.
+var errActivityHidden = errors.New("receiver hides their activity from the sender")
+
 // newService receives the map of collections and picks out congratulations
 func newService(collections map[string]*mongo.Collection, ringService *rings.RingService) *Service {
 	congratulations := collections["congratulations"]
@@ -40,6 +46,7 @@ func newService(collections map[string]*mongo.Collection, ringService *rings.Rin
 		NotificationService: notifications.NewNotificationService(collections),
 		RingService:         ringService,
 		Friendship:          friendship.New(collections),
+		Audiences:           audience.New(collections),
 	}
 }
 
@@ -108,6 +115,17 @@ func (s *Service) CreateCongratulation(r *CongratulationDocumentInternal) (*Cong
 
 	ctx := context.Background()
 
+	// Congratulations are always on something the receiver did, which
+	// someone they hide their activity from can't see. Muted and restricted
+	// senders still land in the inbox, just without a push.
+	controls, err := s.Audiences.ControlsOn(ctx, r.Receiver, r.Sender.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check receiver controls: %w", err)
+	}
+	if controls.HiddenFrom {
+		return nil, errActivityHidden
+	}
+
 	// Check if sender has enough congratulations
 	balance, err := s.GetUserBalance(r.Sender.ID)
 	if err != nil {
@@ -160,10 +178,12 @@ func (s *Service) CreateCongratulation(r *CongratulationDocumentInternal) (*Cong
 	if r.Type == "video" && r.ThumbnailURL != nil {
 		videoThumb = *r.ThumbnailURL
 	}
-	err = s.sendCongratulationNotification(r.Receiver, r.Sender.Name, r.TaskName, r.Message, r.Type, r.PostID, videoThumb)
-	if err != nil {
-		// Log error but don't fail the operation since congratulation was already created
-		slog.Error("Failed to send congratulation notification", "error", err, "receiver_id", r.Receiver)
+	if !controls.Muted && !controls.Restricted {
+		err = s.sendCongratulationNotification(r.Receiver, r.Sender.Name, r.TaskName, r.Message, r.Type, r.PostID, videoThumb)
+		if err != nil {
+			// Log error but don't fail the operation since congratulation was already created
+			slog.Error("Failed to send congratulation notification", "error", err, "receiver_id", r.Receiver)
+		}
 	}
 
 	// Create notification in the database with thumbnail (first image from post if available)
@@ -3,6 +3,7 @@ package congratulation
 import (
 	"time"
 
+	"github.com/abhikaboy/Kindred/internal/audience"
 	"github.com/abhikaboy/Kindred/internal/friendship"
 	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
 	"github.com/abhikaboy/Kindred/internal/handlers/rings"
@@ -256,4 +257,5 @@ type Service struct {
 	NotificationService *notifications.Service
 	RingService         *rings.RingService
 	Friendship          *friendship.Service
+	Audiences           *audience.Service
 }
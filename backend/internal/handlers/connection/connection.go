@@ -2,6 +2,7 @@ package Connection
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -344,3 +345,254 @@ func (h *Handler) GetBlockedUsersHuma(ctx context.Context, input *GetBlockedUser
 
 	return &GetBlockedUsersOutput{Body: blockedUsers}, nil
 }
+
+// controlError maps control service errors onto HTTP errors.
+func controlError(err error, action string, userID primitive.ObjectID) error {
+	switch {
+	case errors.Is(err, errControlSelf):
+		return huma.Error400BadRequest("Cannot "+action+" yourself", err)
+	case errors.Is(err, errUserNotFound):
+		return huma.Error404NotFound("User not found", err)
+	case errors.Is(err, errControlNotSet):
+		return huma.Error404NotFound("User not found in this list", err)
+	}
+	slog.Error("Failed to "+action+" user", "userId", userID.Hex(), "error", err)
+	return huma.Error500InternalServerError("Unable to "+action+" user. Please try again.", err)
+}
+
+// setControl applies or lifts control for the authenticated user.
+func (h *Handler) setControl(ctx context.Context, input *ControlUserInput, control Control, on bool, action, message string) (*ControlUserOutput, error) {
+	user_id, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
+	}
+
+	userOID, err := primitive.ObjectIDFromHex(user_id)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	targetID, err := primitive.ObjectIDFromHex(input.UserID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid target user ID format", err)
+	}
+
+	if on {
+		err = h.service.SetControl(ctx, userOID, targetID, control)
+	} else {
+		err = h.service.ClearControl(ctx, userOID, targetID, control)
+	}
+	if err != nil {
+		return nil, controlError(err, action, userOID)
+	}
+
+	resp := &ControlUserOutput{}
+	resp.Body.Message = message
+	return resp, nil
+}
+
+// controlled lists who the authenticated user has applied control to.
+func (h *Handler) controlled(ctx context.Context, control Control) (*GetControlledUsersOutput, error) {
+	user_id, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
+	}
+
+	userOID, err := primitive.ObjectIDFromHex(user_id)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	users, err := h.service.GetControlled(ctx, userOID, control)
+	if errors.Is(err, errUserNotFound) {
+		return nil, huma.Error404NotFound("User not found", err)
+	}
+	if err != nil {
+		slog.Error("Failed to fetch "+string(control)+" users", "userId", userOID.Hex(), "error", err)
+		return nil, huma.Error500InternalServerError("Unable to load users list. Please try again.", err)
+	}
+	return &GetControlledUsersOutput{Body: users}, nil
+}
+
+func (h *Handler) MuteUserHuma(ctx context.Context, input *ControlUserInput) (*ControlUserOutput, error) {
+	return h.setControl(ctx, input, ControlMute, true, "mute", "User muted successfully")
+}
+
+func (h *Handler) UnmuteUserHuma(ctx context.Context, input *ControlUserInput) (*ControlUserOutput, error) {
+	return h.setControl(ctx, input, ControlMute, false, "unmute", "User unmuted successfully")
+}
+
+func (h *Handler) GetMutedUsersHuma(ctx context.Context, input *GetControlledUsersInput) (*GetControlledUsersOutput, error) {
+	return h.controlled(ctx, ControlMute)
+}
+
+func (h *Handler) RestrictUserHuma(ctx context.Context, input *ControlUserInput) (*ControlUserOutput, error) {
+	return h.setControl(ctx, input, ControlRestrict, true, "restrict", "User restricted successfully")
+}
+
+func (h *Handler) UnrestrictUserHuma(ctx context.Context, input *ControlUserInput) (*ControlUserOutput, error) {
+	return h.setControl(ctx, input, ControlRestrict, false, "unrestrict", "User unrestricted successfully")
+}
+
+func (h *Handler) GetRestrictedUsersHuma(ctx context.Context, input *GetControlledUsersInput) (*GetControlledUsersOutput, error) {
+	return h.controlled(ctx, ControlRestrict)
+}
+
+func (h *Handler) HideActivityFromUserHuma(ctx context.Context, input *ControlUserInput) (*ControlUserOutput, error) {
+	return h.setControl(ctx, input, ControlHide, true, "hide your activity from", "Activity hidden from user successfully")
+}
+
+func (h *Handler) UnhideActivityFromUserHuma(ctx context.Context, input *ControlUserInput) (*ControlUserOutput, error) {
+	return h.setControl(ctx, input, ControlHide, false, "unhide your activity from", "Activity no longer hidden from user")
+}
+
+func (h *Handler) GetHiddenFromUsersHuma(ctx context.Context, input *GetControlledUsersInput) (*GetControlledUsersOutput, error) {
+	return h.controlled(ctx, ControlHide)
+}
+
+func (h *Handler) GetCloseFriendsHuma(ctx context.Context, input *GetCloseFriendsInput) (*CloseFriendsOutput, error) {
+	user_id, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
+	}
+
+	userOID, err := primitive.ObjectIDFromHex(user_id)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	closeFriends, err := h.service.GetCloseFriends(ctx, userOID)
+	if err != nil {
+		slog.Error("Failed to fetch close friends", "userId", userOID.Hex(), "error", err)
+		return nil, huma.Error500InternalServerError("Unable to load close friends. Please try again.", err)
+	}
+
+	return &CloseFriendsOutput{Body: closeFriends}, nil
+}
+
+func (h *Handler) UpdateCloseFriendsHuma(ctx context.Context, input *UpdateCloseFriendsInput) (*CloseFriendsOutput, error) {
+	user_id, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
+	}
+
+	userOID, err := primitive.ObjectIDFromHex(user_id)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(input.Body.Users))
+	for _, raw := range input.Body.Users {
+		id, err := primitive.ObjectIDFromHex(raw)
+		if err != nil {
+			return nil, huma.Error400BadRequest("Invalid user ID format", err)
+		}
+		ids = append(ids, id)
+	}
+
+	closeFriends, err := h.service.SetCloseFriends(ctx, userOID, ids)
+	if err != nil {
+		slog.Error("Failed to update close friends", "userId", userOID.Hex(), "error", err)
+		return nil, huma.Error500InternalServerError("Unable to update close friends. Please try again.", err)
+	}
+
+	return &CloseFriendsOutput{Body: closeFriends}, nil
+}
+
+// friendListError maps friend list service errors onto HTTP errors.
+func friendListError(err error, action string, userID primitive.ObjectID) error {
+	switch {
+	case errors.Is(err, errListNotFound):
+		return huma.Error404NotFound("Friend list not found", err)
+	case errors.Is(err, errBuiltInList):
+		return huma.Error400BadRequest("The close friends list can't be renamed or deleted", err)
+	case errors.Is(err, errTooManyLists):
+		return huma.Error422UnprocessableEntity(fmt.Sprintf("You can have at most %d friend lists", maxFriendLists), err)
+	case errors.Is(err, errInvalidUserID):
+		return huma.Error400BadRequest("Invalid user ID format", err)
+	}
+	slog.Error("Failed to "+action, "userId", userID.Hex(), "error", err)
+	return huma.Error500InternalServerError("Unable to "+action+". Please try again.", err)
+}
+
+func (h *Handler) GetFriendListsHuma(ctx context.Context, input *GetFriendListsInput) (*GetFriendListsOutput, error) {
+	user_id, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
+	}
+
+	userOID, err := primitive.ObjectIDFromHex(user_id)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	lists, err := h.service.GetFriendLists(ctx, userOID)
+	if err != nil {
+		return nil, friendListError(err, "load friend lists", userOID)
+	}
+
+	return &GetFriendListsOutput{Body: lists}, nil
+}
+
+func (h *Handler) CreateFriendListHuma(ctx context.Context, input *CreateFriendListInput) (*FriendListOutput, error) {
+	user_id, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
+	}
+
+	userOID, err := primitive.ObjectIDFromHex(user_id)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	members, err := parseUserIDs(input.Body.Members)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	list, err := h.service.CreateFriendList(ctx, userOID, input.Body.Name, members)
+	if err != nil {
+		return nil, friendListError(err, "create friend list", userOID)
+	}
+
+	return &FriendListOutput{Body: *list}, nil
+}
+
+func (h *Handler) UpdateFriendListHuma(ctx context.Context, input *UpdateFriendListInput) (*FriendListOutput, error) {
+	user_id, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
+	}
+
+	userOID, err := primitive.ObjectIDFromHex(user_id)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	list, err := h.service.UpdateFriendList(ctx, userOID, input.ListID, input.Body)
+	if err != nil {
+		return nil, friendListError(err, "update friend list", userOID)
+	}
+
+	return &FriendListOutput{Body: *list}, nil
+}
+
+func (h *Handler) DeleteFriendListHuma(ctx context.Context, input *DeleteFriendListInput) (*DeleteFriendListOutput, error) {
+	user_id, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
+	}
+
+	userOID, err := primitive.ObjectIDFromHex(user_id)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	if err := h.service.DeleteFriendList(ctx, userOID, input.ListID); err != nil {
+		return nil, friendListError(err, "delete friend list", userOID)
+	}
+
+	resp := &DeleteFriendListOutput{}
+	resp.Body.Message = "Friend list deleted successfully"
+	return resp, nil
+}
@@ -0,0 +1,84 @@
+package Connection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Control is a safety setting lighter than a block, stored as a list of
+// user IDs on the user who applies it.
+type Control string
+
+const (
+	// ControlMute keeps someone's posts, tasks and ring closures out of your
+	// feed and their activity out of your notifications.
+	ControlMute Control = "muted"
+	// ControlRestrict holds someone's comments on your posts for approval;
+	// until then only they and you see them.
+	ControlRestrict Control = "restricted"
+	// ControlHide keeps your posts, public tasks and ring closures from
+	// someone without unfriending them.
+	ControlHide Control = "hiddenFrom"
+)
+
+var (
+	errControlSelf   = errors.New("can't apply a control to yourself")
+	errUserNotFound  = errors.New("user not found")
+	errControlNotSet = errors.New("control not set for this user")
+)
+
+// SetControl applies control to target on behalf of userID. Applying it
+// twice is a no-op.
+func (s *Service) SetControl(ctx context.Context, userID, target primitive.ObjectID, control Control) error {
+	if userID == target {
+		return errControlSelf
+	}
+	n, err := s.Users.CountDocuments(ctx, bson.M{"_id": target})
+	if err != nil {
+		return fmt.Errorf("failed to load user: %w", err)
+	}
+	if n == 0 {
+		return errUserNotFound
+	}
+	res, err := s.Users.UpdateOne(ctx, bson.M{"_id": userID}, bson.M{"$addToSet": bson.M{string(control): target}})
+	if err != nil {
+		return fmt.Errorf("failed to update %s: %w", control, err)
+	}
+	if res.MatchedCount == 0 {
+		return errUserNotFound
+	}
+	return nil
+}
+
+// ClearControl lifts control from target.
+func (s *Service) ClearControl(ctx context.Context, userID, target primitive.ObjectID, control Control) error {
+	res, err := s.Users.UpdateOne(ctx, bson.M{"_id": userID}, bson.M{"$pull": bson.M{string(control): target}})
+	if err != nil {
+		return fmt.Errorf("failed to update %s: %w", control, err)
+	}
+	if res.ModifiedCount == 0 {
+		return errControlNotSet
+	}
+	return nil
+}
+
+// GetControlled returns the users userID has applied control to.
+func (s *Service) GetControlled(ctx context.Context, userID primitive.ObjectID, control Control) ([]ConnectionUser, error) {
+	var user map[string][]primitive.ObjectID
+	err := s.Users.FindOne(ctx, bson.M{"_id": userID},
+		options.FindOne().SetProjection(bson.M{"_id": 0, string(control): 1})).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		return nil, errUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", control, err)
+	}
+	ids := user[string(control)]
+	return s.connectionUsers(ctx, ids)
+}
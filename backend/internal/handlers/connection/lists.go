@@ -0,0 +1,224 @@
+package Connection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// maxFriendLists caps custom lists per user; the built-in list doesn't count.
+const maxFriendLists = 20
+
+var (
+	errListNotFound  = errors.New("friend list not found")
+	errBuiltInList   = errors.New("the close friends list can't be renamed or deleted")
+	errTooManyLists  = errors.New("too many friend lists")
+	errInvalidUserID = errors.New("invalid user ID")
+)
+
+// GetFriendLists returns the built-in close friends list followed by
+// userID's own lists, oldest first.
+func (s *Service) GetFriendLists(ctx context.Context, userID primitive.ObjectID) ([]FriendListDocument, error) {
+	var user struct {
+		Friends      []primitive.ObjectID `bson:"friends"`
+		CloseFriends []primitive.ObjectID `bson:"closeFriends"`
+	}
+	if err := s.Users.FindOne(ctx, bson.M{"_id": userID}).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to load user: %w", err)
+	}
+
+	closeFriends, err := s.connectionUsers(ctx, keepFriends(user.CloseFriends, user.Friends))
+	if err != nil {
+		return nil, err
+	}
+	out := []FriendListDocument{{ID: types.CloseFriendsList, Name: "Close friends", BuiltIn: true, Members: closeFriends}}
+
+	cursor, err := s.Lists.Find(ctx, bson.M{"owner": userID}, options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load friend lists: %w", err)
+	}
+	var lists []types.FriendList
+	if err := cursor.All(ctx, &lists); err != nil {
+		return nil, fmt.Errorf("failed to decode friend lists: %w", err)
+	}
+	for _, l := range lists {
+		members, err := s.connectionUsers(ctx, keepFriends(l.Members, user.Friends))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, FriendListDocument{ID: l.ID.Hex(), Name: l.Name, Members: members})
+	}
+	return out, nil
+}
+
+// CreateFriendList saves a new list of userID's friends. Members who aren't
+// friends are dropped.
+func (s *Service) CreateFriendList(ctx context.Context, userID primitive.ObjectID, name string, members []primitive.ObjectID) (*FriendListDocument, error) {
+	n, err := s.Lists.CountDocuments(ctx, bson.M{"owner": userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count friend lists: %w", err)
+	}
+	if n >= maxFriendLists {
+		return nil, errTooManyLists
+	}
+
+	friends, err := s.friendIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	list := types.FriendList{
+		ID:        primitive.NewObjectID(),
+		Owner:     userID,
+		Name:      name,
+		Members:   keepFriends(members, friends),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if _, err := s.Lists.InsertOne(ctx, list); err != nil {
+		return nil, fmt.Errorf("failed to create friend list: %w", err)
+	}
+	return s.friendListDocument(ctx, list)
+}
+
+// UpdateFriendList renames a list or replaces its members. ref is a list ID
+// or close_friends, whose members can change but whose name can't.
+func (s *Service) UpdateFriendList(ctx context.Context, userID primitive.ObjectID, ref string, params UpdateFriendListParams) (*FriendListDocument, error) {
+	var members []primitive.ObjectID
+	if params.Members != nil {
+		ids, err := parseUserIDs(*params.Members)
+		if err != nil {
+			return nil, err
+		}
+		members = ids
+	}
+
+	if ref == types.CloseFriendsList {
+		if params.Name != nil {
+			return nil, errBuiltInList
+		}
+		var closeFriends []ConnectionUser
+		var err error
+		if params.Members != nil {
+			closeFriends, err = s.SetCloseFriends(ctx, userID, members)
+		} else {
+			closeFriends, err = s.GetCloseFriends(ctx, userID)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &FriendListDocument{ID: types.CloseFriendsList, Name: "Close friends", BuiltIn: true, Members: closeFriends}, nil
+	}
+
+	id, err := primitive.ObjectIDFromHex(ref)
+	if err != nil {
+		return nil, errListNotFound
+	}
+	set := bson.M{"updatedAt": time.Now()}
+	if params.Name != nil {
+		set["name"] = *params.Name
+	}
+	if params.Members != nil {
+		friends, err := s.friendIDs(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		set["members"] = keepFriends(members, friends)
+	}
+
+	var list types.FriendList
+	err = s.Lists.FindOneAndUpdate(ctx,
+		bson.M{"_id": id, "owner": userID},
+		bson.M{"$set": set},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&list)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, errListNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update friend list: %w", err)
+	}
+	return s.friendListDocument(ctx, list)
+}
+
+// DeleteFriendList removes one of userID's lists and points any setting that
+// used it back at all friends. Posts shared with the list stay visible only
+// to their author.
+func (s *Service) DeleteFriendList(ctx context.Context, userID primitive.ObjectID, ref string) error {
+	if ref == types.CloseFriendsList {
+		return errBuiltInList
+	}
+	id, err := primitive.ObjectIDFromHex(ref)
+	if err != nil {
+		return errListNotFound
+	}
+	res, err := s.Lists.DeleteOne(ctx, bson.M{"_id": id, "owner": userID})
+	if err != nil {
+		return fmt.Errorf("failed to delete friend list: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return errListNotFound
+	}
+
+	for _, field := range []string{"settings.lists.kudosPool", "settings.lists.postNotifications"} {
+		if _, err := s.Users.UpdateOne(ctx,
+			bson.M{"_id": userID, field: ref},
+			bson.M{"$unset": bson.M{field: ""}},
+		); err != nil {
+			slog.Warn("Failed to clear setting for deleted friend list", "userId", userID.Hex(), "field", field, "error", err)
+		}
+	}
+	return nil
+}
+
+// dropFromLists takes each user off the other's friend lists once they stop
+// being friends.
+func (s *Service) dropFromLists(ctx context.Context, a, b primitive.ObjectID) {
+	if s.Lists == nil {
+		return
+	}
+	for _, pair := range [][2]primitive.ObjectID{{a, b}, {b, a}} {
+		if _, err := s.Lists.UpdateMany(ctx, bson.M{"owner": pair[0]}, bson.M{"$pull": bson.M{"members": pair[1]}}); err != nil {
+			slog.Warn("Failed to remove former friend from friend lists", "owner", pair[0].Hex(), "error", err)
+		}
+	}
+}
+
+func (s *Service) friendIDs(ctx context.Context, userID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	var user struct {
+		Friends []primitive.ObjectID `bson:"friends"`
+	}
+	if err := s.Users.FindOne(ctx, bson.M{"_id": userID}).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to load user: %w", err)
+	}
+	return user.Friends, nil
+}
+
+func (s *Service) friendListDocument(ctx context.Context, list types.FriendList) (*FriendListDocument, error) {
+	members, err := s.connectionUsers(ctx, list.Members)
+	if err != nil {
+		return nil, err
+	}
+	return &FriendListDocument{ID: list.ID.Hex(), Name: list.Name, Members: members}, nil
+}
+
+// parseUserIDs parses a request's user IDs, rejecting the first bad one.
+func parseUserIDs(raw []string) ([]primitive.ObjectID, error) {
+	ids := make([]primitive.ObjectID, 0, len(raw))
+	for _, r := range raw {
+		id, err := primitive.ObjectIDFromHex(r)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", errInvalidUserID, r)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -150,6 +150,90 @@ type GetBlockedUsersOutput struct {
 	Body []ConnectionUser `json:"body"`
 }
 
+// Mute, restrict or hide activity from a user, or undo it
+type ControlUserInput struct {
+	Authorization string `header:"Authorization" required:"true" doc:"Bearer token for authentication"`
+	RefreshToken  string `header:"refresh_token" required:"true" doc:"Refresh token for authentication"`
+	UserID        string `path:"userId" example:"507f1f77bcf86cd799439011" doc:"User ID to apply the setting to"`
+}
+
+type ControlUserOutput struct {
+	Body struct {
+		Message string `json:"message" example:"User muted successfully"`
+	}
+}
+
+// Get muted, restricted or hidden-from users
+type GetControlledUsersInput struct {
+	Authorization string `header:"Authorization" required:"true" doc:"Bearer token for authentication"`
+	RefreshToken  string `header:"refresh_token" required:"true" doc:"Refresh token for authentication"`
+}
+
+type GetControlledUsersOutput struct {
+	Body []ConnectionUser `json:"body"`
+}
+
+// Get Close Friends
+type GetCloseFriendsInput struct {
+	Authorization string `header:"Authorization" required:"true" doc:"Bearer token for authentication"`
+	RefreshToken  string `header:"refresh_token" required:"true" doc:"Refresh token for authentication"`
+}
+
+type CloseFriendsOutput struct {
+	Body []ConnectionUser `json:"body"`
+}
+
+// Update Close Friends
+type UpdateCloseFriendsInput struct {
+	Authorization string `header:"Authorization" required:"true" doc:"Bearer token for authentication"`
+	RefreshToken  string `header:"refresh_token" required:"true" doc:"Refresh token for authentication"`
+	Body          struct {
+		Users []string `json:"users" doc:"IDs of the friends on the list; anyone who isn't a friend is dropped"`
+	}
+}
+
+// Get Friend Lists
+type GetFriendListsInput struct {
+	Authorization string `header:"Authorization" required:"true" doc:"Bearer token for authentication"`
+	RefreshToken  string `header:"refresh_token" required:"true" doc:"Refresh token for authentication"`
+}
+
+type GetFriendListsOutput struct {
+	Body []FriendListDocument `json:"body"`
+}
+
+// Create Friend List
+type CreateFriendListInput struct {
+	Authorization string                 `header:"Authorization" required:"true" doc:"Bearer token for authentication"`
+	RefreshToken  string                 `header:"refresh_token" required:"true" doc:"Refresh token for authentication"`
+	Body          CreateFriendListParams `json:"body"`
+}
+
+type FriendListOutput struct {
+	Body FriendListDocument `json:"body"`
+}
+
+// Update Friend List
+type UpdateFriendListInput struct {
+	Authorization string                 `header:"Authorization" required:"true" doc:"Bearer token for authentication"`
+	RefreshToken  string                 `header:"refresh_token" required:"true" doc:"Refresh token for authentication"`
+	ListID        string                 `path:"listId" example:"close_friends" doc:"List ID, or close_friends for the built-in list"`
+	Body          UpdateFriendListParams `json:"body"`
+}
+
+// Delete Friend List
+type DeleteFriendListInput struct {
+	Authorization string `header:"Authorization" required:"true" doc:"Bearer token for authentication"`
+	RefreshToken  string `header:"refresh_token" required:"true" doc:"Refresh token for authentication"`
+	ListID        string `path:"listId" example:"507f1f77bcf86cd799439011" doc:"List ID"`
+}
+
+type DeleteFriendListOutput struct {
+	Body struct {
+		Message string `json:"message" example:"Friend list deleted successfully"`
+	}
+}
+
 // Operation registrations
 
 func RegisterCreateConnectionOperation(api huma.API, handler *Handler) {
@@ -298,6 +382,171 @@ func RegisterGetBlockedUsersOperation(api huma.API, handler *Handler) {
 	}, handler.GetBlockedUsersHuma)
 }
 
+func RegisterMuteUserOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "mute-user",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/connections/mute/{userId}",
+		Summary:     "Mute a user",
+		Description: "Keep a user's posts, tasks and ring closures out of your feed and their activity out of your notifications, without unfriending them",
+		Tags:        []string{"connections"},
+	}, handler.MuteUserHuma)
+}
+
+func RegisterUnmuteUserOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "unmute-user",
+		Method:      http.MethodDelete,
+		Path:        "/v1/user/connections/mute/{userId}",
+		Summary:     "Unmute a user",
+		Description: "Bring a muted user's activity back into your feed",
+		Tags:        []string{"connections"},
+	}, handler.UnmuteUserHuma)
+}
+
+func RegisterGetMutedUsersOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-muted-users",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/connections/muted",
+		Summary:     "Get muted users",
+		Description: "Retrieve list of users you have muted",
+		Tags:        []string{"connections"},
+	}, handler.GetMutedUsersHuma)
+}
+
+func RegisterRestrictUserOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "restrict-user",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/connections/restrict/{userId}",
+		Summary:     "Restrict a user",
+		Description: "Hold a user's comments on your posts for your approval; until approved only they and you see them",
+		Tags:        []string{"connections"},
+	}, handler.RestrictUserHuma)
+}
+
+func RegisterUnrestrictUserOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "unrestrict-user",
+		Method:      http.MethodDelete,
+		Path:        "/v1/user/connections/restrict/{userId}",
+		Summary:     "Unrestrict a user",
+		Description: "Let a restricted user's comments on your posts publish straight away again",
+		Tags:        []string{"connections"},
+	}, handler.UnrestrictUserHuma)
+}
+
+func RegisterGetRestrictedUsersOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-restricted-users",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/connections/restricted",
+		Summary:     "Get restricted users",
+		Description: "Retrieve list of users you have restricted",
+		Tags:        []string{"connections"},
+	}, handler.GetRestrictedUsersHuma)
+}
+
+func RegisterHideActivityFromUserOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "hide-activity-from-user",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/connections/hide/{userId}",
+		Summary:     "Hide your activity from a user",
+		Description: "Keep your posts, public tasks and ring closures from a user without unfriending them",
+		Tags:        []string{"connections"},
+	}, handler.HideActivityFromUserHuma)
+}
+
+func RegisterUnhideActivityFromUserOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "unhide-activity-from-user",
+		Method:      http.MethodDelete,
+		Path:        "/v1/user/connections/hide/{userId}",
+		Summary:     "Stop hiding your activity from a user",
+		Description: "Let a user see your posts, public tasks and ring closures again",
+		Tags:        []string{"connections"},
+	}, handler.UnhideActivityFromUserHuma)
+}
+
+func RegisterGetHiddenFromUsersOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-hidden-from-users",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/connections/hidden",
+		Summary:     "Get users you hide your activity from",
+		Description: "Retrieve list of users you hide your activity from",
+		Tags:        []string{"connections"},
+	}, handler.GetHiddenFromUsersHuma)
+}
+
+func RegisterGetCloseFriendsOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-close-friends",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/connections/close-friends",
+		Summary:     "Get close friends",
+		Description: "Retrieve the friends who see your close-friends posts",
+		Tags:        []string{"connections"},
+	}, handler.GetCloseFriendsHuma)
+}
+
+func RegisterUpdateCloseFriendsOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "update-close-friends",
+		Method:      http.MethodPut,
+		Path:        "/v1/user/connections/close-friends",
+		Summary:     "Update close friends",
+		Description: "Replace the list of friends who see your close-friends posts",
+		Tags:        []string{"connections"},
+	}, handler.UpdateCloseFriendsHuma)
+}
+
+func RegisterGetFriendListsOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-friend-lists",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/connections/lists",
+		Summary:     "Get friend lists",
+		Description: "Retrieve your close friends list and your custom friend lists",
+		Tags:        []string{"connections"},
+	}, handler.GetFriendListsHuma)
+}
+
+func RegisterCreateFriendListOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-friend-list",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/connections/lists",
+		Summary:     "Create a friend list",
+		Description: "Create a named list of friends to share posts with, tag on tasks, or filter notifications by",
+		Tags:        []string{"connections"},
+	}, handler.CreateFriendListHuma)
+}
+
+func RegisterUpdateFriendListOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "update-friend-list",
+		Method:      http.MethodPatch,
+		Path:        "/v1/user/connections/lists/{listId}",
+		Summary:     "Update a friend list",
+		Description: "Rename a friend list or replace its members",
+		Tags:        []string{"connections"},
+	}, handler.UpdateFriendListHuma)
+}
+
+func RegisterDeleteFriendListOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-friend-list",
+		Method:      http.MethodDelete,
+		Path:        "/v1/user/connections/lists/{listId}",
+		Summary:     "Delete a friend list",
+		Description: "Delete one of your custom friend lists",
+		Tags:        []string{"connections"},
+	}, handler.DeleteFriendListHuma)
+}
+
 // Register all connection operations
 func RegisterConnectionOperations(api huma.API, handler *Handler) {
 	RegisterGetFriendsOperation(api, handler)
@@ -309,6 +558,21 @@ func RegisterConnectionOperations(api huma.API, handler *Handler) {
 	RegisterBlockUserOperation(api, handler)
 	RegisterUnblockUserOperation(api, handler)
 	RegisterGetBlockedUsersOperation(api, handler)
+	RegisterMuteUserOperation(api, handler)
+	RegisterUnmuteUserOperation(api, handler)
+	RegisterGetMutedUsersOperation(api, handler)
+	RegisterRestrictUserOperation(api, handler)
+	RegisterUnrestrictUserOperation(api, handler)
+	RegisterGetRestrictedUsersOperation(api, handler)
+	RegisterHideActivityFromUserOperation(api, handler)
+	RegisterUnhideActivityFromUserOperation(api, handler)
+	RegisterGetHiddenFromUsersOperation(api, handler)
+	RegisterGetCloseFriendsOperation(api, handler)
+	RegisterUpdateCloseFriendsOperation(api, handler)
+	RegisterGetFriendListsOperation(api, handler)
+	RegisterCreateFriendListOperation(api, handler)
+	RegisterUpdateFriendListOperation(api, handler)
+	RegisterDeleteFriendListOperation(api, handler)
 	RegisterGetConnectionOperation(api, handler)
 	RegisterUpdateConnectionOperation(api, handler)
 	RegisterDeleteConnectionOperation(api, handler)
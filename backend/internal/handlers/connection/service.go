@@ -8,6 +8,7 @@ import (
 
 	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/internal/timeline"
 	"github.com/abhikaboy/Kindred/xutils"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -16,11 +17,18 @@ import (
 
 // newService receives the map of collections and picks out Jobs
 func newService(collections map[string]*mongo.Collection) *Service {
-	return &Service{
+	s := &Service{
 		Connections:         collections["friend-requests"],
 		Users:               collections["users"],
 		NotificationService: notifications.NewNotificationService(collections),
+		Timeline:            timeline.New(collections),
 	}
+	// friend_lists is created on first write, so derive it rather than rely
+	// on the map.
+	if s.Users != nil {
+		s.Lists = s.Users.Database().Collection(types.FriendListCollection)
+	}
+	return s
 }
 
 // NewService is the exported version for testing
@@ -167,14 +175,34 @@ func (s *Service) UpdatePartialConnection(id primitive.ObjectID, updated UpdateC
 	return err
 }
 
-// DeleteConnection removes a Connection document by ObjectID.
+// DeleteConnection removes a Connection document by ObjectID. Deleting a
+// friendship also drops each user from the other's friends and friend lists
+// and prunes what the friendship put in their timelines.
 func (s *Service) DeleteConnection(id primitive.ObjectID) error {
 	ctx := context.Background()
 
 	filter := bson.M{"_id": id}
 
-	_, err := s.Connections.DeleteOne(ctx, filter)
-	return err
+	var existing ConnectionDocumentInternal
+	if err := s.Connections.FindOneAndDelete(ctx, filter).Decode(&existing); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil
+		}
+		return err
+	}
+
+	if existing.Status == StatusFriends && len(existing.Users) == 2 {
+		a, b := existing.Users[0], existing.Users[1]
+		if _, err := s.Users.UpdateOne(ctx, bson.M{"_id": a}, bson.M{"$pull": bson.M{"friends": b, "closeFriends": b}}); err != nil {
+			slog.Warn("Failed to remove unfriended user from friends list", "error", err)
+		}
+		if _, err := s.Users.UpdateOne(ctx, bson.M{"_id": b}, bson.M{"$pull": bson.M{"friends": a, "closeFriends": a}}); err != nil {
+			slog.Warn("Failed to remove unfriended user from friends list", "error", err)
+		}
+		s.dropFromLists(ctx, a, b)
+		s.Timeline.Unfriend(ctx, a, b)
+	}
+	return nil
 }
 
 // AcceptConnection accepts a connection request and updates the relationship status
@@ -269,6 +297,7 @@ func (s *Service) AcceptConnection(connectionID, userID primitive.ObjectID) erro
 	if err != nil {
 		return fmt.Errorf("failed to add user to friend's friends list: %v", err)
 	}
+	s.Timeline.Cool(ctx, userID, otherUserID)
 
 	// Get accepter's details for the notification
 	var accepterUser struct {
@@ -606,7 +635,7 @@ func (s *Service) BlockUser(ctx context.Context, blockerID, blockedID primitive.
 	// Remove each user from the other's friends list
 	_, err = s.Users.UpdateOne(ctx,
 		bson.M{"_id": blockerID},
-		bson.M{"$pull": bson.M{"friends": blockedID}},
+		bson.M{"$pull": bson.M{"friends": blockedID, "closeFriends": blockedID}},
 	)
 	if err != nil {
 		slog.Warn("Failed to remove blocked user from blocker's friends list", "error", err)
@@ -614,12 +643,15 @@ func (s *Service) BlockUser(ctx context.Context, blockerID, blockedID primitive.
 
 	_, err = s.Users.UpdateOne(ctx,
 		bson.M{"_id": blockedID},
-		bson.M{"$pull": bson.M{"friends": blockerID}},
+		bson.M{"$pull": bson.M{"friends": blockerID, "closeFriends": blockerID}},
 	)
 	if err != nil {
 		slog.Warn("Failed to remove blocker from blocked user's friends list", "error", err)
 	}
 
+	s.dropFromLists(ctx, blockerID, blockedID)
+	s.Timeline.Block(ctx, blockerID, blockedID)
+
 	slog.LogAttrs(ctx, slog.LevelInfo, "User blocked",
 		slog.String("blockerId", blockerID.Hex()),
 		slog.String("blockedId", blockedID.Hex()))
@@ -727,3 +759,78 @@ func (s *Service) IsBlocked(ctx context.Context, userA, userB primitive.ObjectID
 
 	return true, nil
 }
+
+// GetCloseFriends returns the friends userID has put on their close friends
+// list, the audience of their close-friends posts.
+func (s *Service) GetCloseFriends(ctx context.Context, userID primitive.ObjectID) ([]ConnectionUser, error) {
+	var user struct {
+		Friends      []primitive.ObjectID `bson:"friends"`
+		CloseFriends []primitive.ObjectID `bson:"closeFriends"`
+	}
+	if err := s.Users.FindOne(ctx, bson.M{"_id": userID}).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to load user: %w", err)
+	}
+	return s.connectionUsers(ctx, keepFriends(user.CloseFriends, user.Friends))
+}
+
+// SetCloseFriends replaces userID's close friends list. IDs that aren't
+// current friends are dropped.
+func (s *Service) SetCloseFriends(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) ([]ConnectionUser, error) {
+	var user struct {
+		Friends []primitive.ObjectID `bson:"friends"`
+	}
+	if err := s.Users.FindOne(ctx, bson.M{"_id": userID}).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to load user: %w", err)
+	}
+	closeFriends := keepFriends(ids, user.Friends)
+	if _, err := s.Users.UpdateOne(ctx, bson.M{"_id": userID}, bson.M{"$set": bson.M{"closeFriends": closeFriends}}); err != nil {
+		return nil, fmt.Errorf("failed to update close friends: %w", err)
+	}
+	return s.connectionUsers(ctx, closeFriends)
+}
+
+// keepFriends filters ids down to friends, without duplicates.
+func keepFriends(ids, friends []primitive.ObjectID) []primitive.ObjectID {
+	isFriend := make(map[primitive.ObjectID]bool, len(friends))
+	for _, id := range friends {
+		isFriend[id] = true
+	}
+	kept := []primitive.ObjectID{}
+	for _, id := range ids {
+		if isFriend[id] {
+			kept = append(kept, id)
+			delete(isFriend, id)
+		}
+	}
+	return kept
+}
+
+// connectionUsers loads the public profile of each user in ids.
+func (s *Service) connectionUsers(ctx context.Context, ids []primitive.ObjectID) ([]ConnectionUser, error) {
+	out := []ConnectionUser{}
+	if len(ids) == 0 {
+		return out, nil
+	}
+	cursor, err := s.Users.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load users: %w", err)
+	}
+	var users []struct {
+		ID             primitive.ObjectID `bson:"_id"`
+		DisplayName    string             `bson:"display_name"`
+		Handle         string             `bson:"handle"`
+		ProfilePicture *string            `bson:"profile_picture"`
+	}
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, fmt.Errorf("failed to decode users: %w", err)
+	}
+	for _, u := range users {
+		out = append(out, ConnectionUser{
+			ID:      u.ID.Hex(),
+			Name:    u.DisplayName,
+			Handle:  u.Handle,
+			Picture: u.ProfilePicture,
+		})
+	}
+	return out, nil
+}
@@ -3,6 +3,7 @@ package Connection_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	Connection "github.com/abhikaboy/Kindred/internal/handlers/connection"
 	testpkg "github.com/abhikaboy/Kindred/internal/testing"
@@ -240,6 +241,28 @@ func (s *ConnectionServiceTestSuite) TestAcceptConnection_Success() {
 	s.Equal("friend_request_accepted", notifications[0].Data["type"])
 }
 
+func (s *ConnectionServiceTestSuite) TestAcceptConnection_ResetsWarmTimelines() {
+	requester := s.GetUser(0)
+	receiver := s.GetUser(1)
+
+	s.Collections["friend-requests"].DeleteMany(s.Ctx, bson.M{
+		"users": bson.M{"$all": []primitive.ObjectID{requester.ID, receiver.ID}},
+	})
+	connection, err := s.service.CreateConnectionRequest(requester.ID, receiver.ID)
+	s.Require().NoError(err)
+	connectionID, err := primitive.ObjectIDFromHex(connection.ID)
+	s.Require().NoError(err)
+
+	for _, id := range []primitive.ObjectID{requester.ID, receiver.ID} {
+		s.Require().NoError(s.service.Timeline.MarkWarm(s.Ctx, id, time.Now()))
+	}
+
+	s.Require().NoError(s.service.AcceptConnection(connectionID, receiver.ID))
+
+	s.False(s.service.Timeline.Warm(s.Ctx, requester.ID), "the requester's timeline lacks the new friend's history")
+	s.False(s.service.Timeline.Warm(s.Ctx, receiver.ID), "the receiver's timeline lacks the new friend's history")
+}
+
 func (s *ConnectionServiceTestSuite) TestAcceptConnection_NotReceiver() {
 
 	requester := s.GetUser(0)
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
+	"github.com/abhikaboy/Kindred/internal/timeline"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -158,5 +159,28 @@ Database layer of the application
 type Service struct {
 	Connections         *mongo.Collection
 	Users               *mongo.Collection
+	Lists               *mongo.Collection
 	NotificationService *notifications.Service
+	Timeline            *timeline.Service
+}
+
+// FriendListDocument is a friend list as its owner sees it. The built-in
+// close friends list has the ID close_friends and can't be renamed or
+// deleted.
+type FriendListDocument struct {
+	ID      string           `json:"id" example:"close_friends" doc:"List ID, or close_friends for the built-in list"`
+	Name    string           `json:"name" example:"Close friends"`
+	BuiltIn bool             `json:"builtIn" doc:"Whether this is the built-in close friends list"`
+	Members []ConnectionUser `json:"members" doc:"Friends on the list"`
+}
+
+type CreateFriendListParams struct {
+	Name    string   `json:"name" minLength:"1" maxLength:"40" example:"Gym crew"`
+	Members []string `json:"members,omitempty" doc:"Friend IDs; anyone who isn't a friend is dropped"`
+}
+
+// UpdateFriendListParams is a partial update: an omitted field is left alone.
+type UpdateFriendListParams struct {
+	Name    *string   `json:"name,omitempty" minLength:"1" maxLength:"40" doc:"New name; the built-in list can't be renamed"`
+	Members *[]string `json:"members,omitempty" doc:"Replacement member list; anyone who isn't a friend is dropped"`
 }
@@ -2,6 +2,7 @@ package encouragement
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -109,6 +110,9 @@ func (h *Handler) CreateEncouragementHuma(ctx context.Context, input *CreateEnco
 		if strings.Contains(err.Error(), "insufficient encouragement balance") {
 			return nil, huma.Error400BadRequest("Insufficient encouragement balance", err)
 		}
+		if errors.Is(err, errActivityHidden) {
+			return nil, huma.Error404NotFound("Task not found", err)
+		}
 		slog.Error("failed to create encouragement", "userId", user_id, "receiver", input.Body.Receiver, "error", err)
 		return nil, huma.Error500InternalServerError("Unable to create encouragement. Please try again.", err)
 	}
@@ -2,10 +2,12 @@ package encouragement
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
+	"github.com/abhikaboy/Kindred/internal/audience"
 	"github.com/abhikaboy/Kindred/internal/friendship"
 	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
 	"github.com/abhikaboy/Kindred/internal/handlers/rings"
@@ -17,6 +19,10 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// errActivityHidden is returned for kudos on a task the receiver hides from
+// the sender.
+var errActivityHidden = errors.New("receiver hides their activity from the sender")
+
 // newService receives the map of collections and picks out encouragements
 func newService(collections map[string]*mongo.Collection, ringService *rings.RingService) *Service {
 	encouragements := collections["encouragements"]
@@ -41,6 +47,7 @@ func newService(collections map[string]*mongo.Collection, ringService *rings.Rin
 		NotificationService: notifications.NewNotificationService(collections),
 		RingService:         ringService,
 		Friendship:          friendship.New(collections),
+		Audiences:           audience.New(collections),
 	}
 }
 
@@ -150,6 +157,17 @@ func (s *Service) CreateEncouragement(r *EncouragementDocumentInternal) (*Encour
 
 	ctx := context.Background()
 
+	// Someone the receiver hides their activity from can't see their tasks,
+	// so can't cheer them on. Muted and restricted senders still land in
+	// the inbox, just without a push.
+	controls, err := s.Audiences.ControlsOn(ctx, r.Receiver, r.Sender.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check receiver controls: %w", err)
+	}
+	if controls.HiddenFrom && r.Scope == "task" {
+		return nil, errActivityHidden
+	}
+
 	encouragement := EncouragementDocumentInternal{
 		ID:           primitive.NewObjectID(),
 		Sender:       r.Sender,
@@ -252,8 +270,10 @@ func (s *Service) CreateEncouragement(r *EncouragementDocumentInternal) (*Encour
 	if r.Type == "video" && r.ThumbnailURL != nil {
 		videoThumb = *r.ThumbnailURL
 	}
-	if err := s.sendEncouragementNotification(r.Receiver, r.Sender.Name, r.TaskName, r.Message, r.Type, r.Scope, r.TaskID, videoThumb); err != nil {
-		slog.Error("Failed to send encouragement notification", "error", err, "receiver_id", r.Receiver)
+	if !controls.Muted && !controls.Restricted {
+		if err := s.sendEncouragementNotification(r.Receiver, r.Sender.Name, r.TaskName, r.Message, r.Type, r.Scope, r.TaskID, videoThumb); err != nil {
+			slog.Error("Failed to send encouragement notification", "error", err, "receiver_id", r.Receiver)
+		}
 	}
 
 	var notificationContent string
@@ -3,6 +3,7 @@ package encouragement
 import (
 	"time"
 
+	"github.com/abhikaboy/Kindred/internal/audience"
 	"github.com/abhikaboy/Kindred/internal/friendship"
 	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
 	"github.com/abhikaboy/Kindred/internal/handlers/rings"
@@ -247,4 +248,5 @@ type Service struct {
 	NotificationService *notifications.Service
 	RingService         *rings.RingService
 	Friendship          *friendship.Service
+	Audiences           *audience.Service
 }
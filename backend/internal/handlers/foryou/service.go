@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/abhikaboy/Kindred/internal/audience"
 	"github.com/abhikaboy/Kindred/internal/handlers/rings"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -33,6 +34,7 @@ type Service struct {
 	RingStates     *mongo.Collection
 	Exposures      *mongo.Collection
 	RingService    *rings.RingService
+	Audiences      *audience.Service
 }
 
 func newService(collections map[string]*mongo.Collection, ringService *rings.RingService) *Service {
@@ -48,6 +50,7 @@ func newService(collections map[string]*mongo.Collection, ringService *rings.Rin
 		RingStates:     collections["ring_states"],
 		Exposures:      collections["for_you_exposures"],
 		RingService:    ringService,
+		Audiences:      audience.New(collections),
 	}
 }
 
@@ -347,9 +350,18 @@ func (s *Service) buildCommentReplyCards(ctx context.Context, userID primitive.O
 		return nil
 	}
 
+	postIDs := make([]primitive.ObjectID, len(docs))
+	for i, d := range docs {
+		postIDs[i] = d.ReferenceID
+	}
+	visible := s.visiblePosts(ctx, userID, postIDs)
+
 	mode := displayModeFor(exposures, CardCommentReply)
 	cards := make([]ForYouCard, 0, len(docs))
 	for i, d := range docs {
+		if !visible[d.ReferenceID] {
+			continue
+		}
 		postRoute := fmt.Sprintf("/(logged-in)/posting/%s", d.ReferenceID.Hex())
 		card := ForYouCard{
 			ID:          fmt.Sprintf("comment-%s", d.ID.Hex()),
@@ -380,6 +392,40 @@ func (s *Service) buildCommentReplyCards(ctx context.Context, userID primitive.O
 	return cards
 }
 
+// visiblePosts returns which of the posts the docs point at the viewer may
+// still open, so a card never deep-links to a post outside its audience.
+// Lookup failures hide every post rather than leak one.
+func (s *Service) visiblePosts(ctx context.Context, userID primitive.ObjectID, postIDs []primitive.ObjectID) map[primitive.ObjectID]bool {
+	visible := map[primitive.ObjectID]bool{}
+	if s.Posts == nil || s.Audiences == nil || len(postIDs) == 0 {
+		return visible
+	}
+	viewer, err := s.Audiences.Viewer(ctx, userID)
+	if err != nil {
+		slog.Warn("failed to load viewer for For You", "userId", userID.Hex(), "error", err)
+		return visible
+	}
+	cursor, err := s.Posts.Find(ctx, bson.M{"$and": bson.A{
+		bson.M{"_id": bson.M{"$in": postIDs}, "metadata.isDeleted": false},
+		viewer.Filter(),
+	}}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		slog.Warn("failed to check post visibility for For You", "userId", userID.Hex(), "error", err)
+		return visible
+	}
+	var posts []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &posts); err != nil {
+		slog.Warn("failed to decode visible posts for For You", "userId", userID.Hex(), "error", err)
+		return visible
+	}
+	for _, p := range posts {
+		visible[p.ID] = true
+	}
+	return visible
+}
+
 func (s *Service) buildFriendRequestsCountCard(ctx context.Context, userID primitive.ObjectID, exposures map[string]ExposureDoc) *ForYouCard {
 	if s.Connections == nil {
 		return nil
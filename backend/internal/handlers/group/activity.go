@@ -0,0 +1,215 @@
+package Group
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Activity item types.
+const (
+	activityPost          = "post"
+	activityRingsClosed   = "rings_closed"
+	activityTaskCompleted = "task_completed"
+)
+
+// GetActivity returns up to limit things that happened in the group before
+// before, newest first: posts shared with it, current members closing all
+// their rings, and current members finishing public tasks. Anyone the viewer
+// has blocked, who blocked them, or who hides their activity from them, is
+// left out.
+func (s *Service) GetActivity(ctx context.Context, groupID, viewerID primitive.ObjectID, before time.Time, limit int) ([]GroupActivityItem, error) {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.RoleOf(viewerID) == "" {
+		return nil, errNotGroupMember
+	}
+	viewer, err := s.Audiences.Viewer(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := s.memberRefs(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]primitive.ObjectID, 0, len(refs))
+	for id := range refs {
+		if !viewer.Blocked(id) && !viewer.HiddenBy(id) {
+			members = append(members, id)
+		}
+	}
+
+	posts, err := s.groupPosts(ctx, groupID, members, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	visible := posts[:0]
+	for _, p := range posts {
+		if viewer.CanSee(&p) {
+			visible = append(visible, p)
+		}
+	}
+	rings, err := s.ringClosures(ctx, members, refs, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.taskCompletions(ctx, members, refs, before, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeActivity(limit, postItems(visible), rings, tasks), nil
+}
+
+// memberRefs returns each member's display details. The owner isn't always
+// listed in members, so they're loaded when missing.
+func (s *Service) memberRefs(ctx context.Context, group *types.GroupDocument) (map[primitive.ObjectID]types.UserExtendedReferenceInternal, error) {
+	refs := make(map[primitive.ObjectID]types.UserExtendedReferenceInternal, len(group.Members)+1)
+	for _, m := range group.Members {
+		refs[m.ID] = m
+	}
+	if _, ok := refs[group.Creator]; !ok {
+		ref, err := s.userRef(ctx, group.Creator)
+		if err != nil {
+			return nil, err
+		}
+		refs[group.Creator] = ref
+	}
+	return refs, nil
+}
+
+// groupPosts returns posts shared with the group by authors, its current
+// members; posts by people who left, or who never belonged, stay out.
+func (s *Service) groupPosts(ctx context.Context, groupID primitive.ObjectID, authors []primitive.ObjectID, before time.Time, limit int) ([]types.PostDocument, error) {
+	if len(authors) == 0 {
+		return nil, nil
+	}
+	cursor, err := s.Posts.Find(ctx, bson.M{
+		"user._id":           bson.M{"$in": authors},
+		"metadata.isDeleted": false,
+		"metadata.createdAt": bson.M{"$lt": before},
+		"$or": bson.A{
+			bson.M{"audience.groups": groupID},
+			bson.M{"audience": bson.M{"$exists": false}, "groups": groupID},
+		},
+	}, options.Find().SetSort(bson.D{{Key: "metadata.createdAt", Value: -1}}).SetLimit(int64(limit)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load group posts: %w", err)
+	}
+	var posts []types.PostDocument
+	if err := cursor.All(ctx, &posts); err != nil {
+		return nil, fmt.Errorf("failed to decode group posts: %w", err)
+	}
+	return posts, nil
+}
+
+func postItems(posts []types.PostDocument) []GroupActivityItem {
+	items := make([]GroupActivityItem, 0, len(posts))
+	for _, p := range posts {
+		items = append(items, GroupActivityItem{
+			ID:      p.ID.Hex(),
+			Type:    activityPost,
+			At:      p.Metadata.CreatedAt,
+			User:    *p.User.ToAPI(),
+			Content: p.Caption,
+			Images:  p.Images,
+		})
+	}
+	return items
+}
+
+// ringClosures reads ring states rather than notifications, so closures from
+// before someone joined show up too. States from before closures were
+// timestamped have no time to sort by and are skipped.
+func (s *Service) ringClosures(ctx context.Context, members []primitive.ObjectID, refs map[primitive.ObjectID]types.UserExtendedReferenceInternal, before time.Time, limit int) ([]GroupActivityItem, error) {
+	if s.RingStates == nil || len(members) == 0 {
+		return nil, nil
+	}
+	cursor, err := s.RingStates.Find(ctx, bson.M{
+		"user_id":       bson.M{"$in": members},
+		"all_closed_at": bson.M{"$lt": before},
+	}, options.Find().SetSort(bson.D{{Key: "all_closed_at", Value: -1}}).SetLimit(int64(limit)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ring closures: %w", err)
+	}
+	var states []struct {
+		ID          primitive.ObjectID `bson:"_id"`
+		UserID      primitive.ObjectID `bson:"user_id"`
+		AllClosedAt time.Time          `bson:"all_closed_at"`
+	}
+	if err := cursor.All(ctx, &states); err != nil {
+		return nil, fmt.Errorf("failed to decode ring closures: %w", err)
+	}
+	items := make([]GroupActivityItem, 0, len(states))
+	for _, st := range states {
+		user := refs[st.UserID]
+		items = append(items, GroupActivityItem{
+			ID:      st.ID.Hex(),
+			Type:    activityRingsClosed,
+			At:      st.AllClosedAt,
+			User:    *user.ToAPI(),
+			Content: fmt.Sprintf("%s closed all their rings", user.DisplayName),
+		})
+	}
+	return items, nil
+}
+
+func (s *Service) taskCompletions(ctx context.Context, members []primitive.ObjectID, refs map[primitive.ObjectID]types.UserExtendedReferenceInternal, before time.Time, limit int) ([]GroupActivityItem, error) {
+	if s.CompletedTasks == nil || len(members) == 0 {
+		return nil, nil
+	}
+	cursor, err := s.CompletedTasks.Find(ctx, bson.M{
+		"user":          bson.M{"$in": members},
+		"public":        true,
+		"timeCompleted": bson.M{"$lt": before},
+	}, options.Find().
+		SetSort(bson.D{{Key: "timeCompleted", Value: -1}}).
+		SetLimit(int64(limit)).
+		SetProjection(bson.M{"content": 1, "user": 1, "timeCompleted": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load task completions: %w", err)
+	}
+	var tasks []struct {
+		ID            primitive.ObjectID `bson:"_id"`
+		Content       string             `bson:"content"`
+		User          primitive.ObjectID `bson:"user"`
+		TimeCompleted time.Time          `bson:"timeCompleted"`
+	}
+	if err := cursor.All(ctx, &tasks); err != nil {
+		return nil, fmt.Errorf("failed to decode task completions: %w", err)
+	}
+	items := make([]GroupActivityItem, 0, len(tasks))
+	for _, t := range tasks {
+		user := refs[t.User]
+		items = append(items, GroupActivityItem{
+			ID:      t.ID.Hex(),
+			Type:    activityTaskCompleted,
+			At:      t.TimeCompleted,
+			User:    *user.ToAPI(),
+			Content: t.Content,
+		})
+	}
+	return items, nil
+}
+
+// mergeActivity interleaves newest-first sources and keeps the newest limit.
+func mergeActivity(limit int, sources ...[]GroupActivityItem) []GroupActivityItem {
+	out := []GroupActivityItem{}
+	for _, src := range sources {
+		out = append(out, src...)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].At.After(out[j].At) })
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
@@ -0,0 +1,54 @@
+package Group
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+)
+
+func TestMergeActivity(t *testing.T) {
+	now := time.Now()
+	posts := []GroupActivityItem{{ID: "p1", At: now.Add(-time.Minute)}, {ID: "p2", At: now.Add(-time.Hour)}}
+	rings := []GroupActivityItem{{ID: "r1", At: now.Add(-30 * time.Minute)}}
+	tasks := []GroupActivityItem{{ID: "t1", At: now}}
+
+	got := mergeActivity(3, posts, rings, tasks)
+	want := []string{"t1", "p1", "r1"}
+	if len(got) != len(want) {
+		t.Fatalf("got %d items, want %d", len(got), len(want))
+	}
+	for i, id := range want {
+		if got[i].ID != id {
+			t.Errorf("item %d = %s, want %s", i, got[i].ID, id)
+		}
+	}
+
+	if got := mergeActivity(5); len(got) != 0 {
+		t.Errorf("expected no items from no sources, got %d", len(got))
+	}
+}
+
+func TestActiveInvites(t *testing.T) {
+	now := time.Now()
+	invites := []types.GroupInvite{
+		{Code: "EXPIRED1", ExpiresAt: now.Add(-time.Minute)},
+		{Code: "LIVE0001", ExpiresAt: now.Add(time.Hour)},
+	}
+
+	got := activeInvites(invites, now)
+	if len(got) != 1 || got[0].Code != "LIVE0001" {
+		t.Errorf("expected only the live invite, got %+v", got)
+	}
+}
+
+func TestNewInviteCode(t *testing.T) {
+	a, err := newInviteCode()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, _ := newInviteCode()
+	if len(a) != 8 || a == b {
+		t.Errorf("expected distinct 8-character codes, got %q and %q", a, b)
+	}
+}
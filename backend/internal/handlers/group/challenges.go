@@ -0,0 +1,557 @@
+package Group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
+	"github.com/abhikaboy/Kindred/internal/handlers/task"
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/xutils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	errInvalidChallenge = errors.New("invalid challenge")
+	errChallengeEnded   = errors.New("this challenge has ended")
+	errAlreadyJoined    = errors.New("you're already taking part in this challenge")
+	errNotParticipant   = errors.New("you aren't taking part in this challenge")
+)
+
+// validateChallenge checks the goal and dates of a new challenge.
+func validateChallenge(goal types.ChallengeGoal, start, end, now time.Time) error {
+	switch {
+	case goal.Flex == nil && goal.Target < 1:
+		return fmt.Errorf("%w: set a target or a flex goal", errInvalidChallenge)
+	case goal.Flex != nil && goal.Target != 0:
+		return fmt.Errorf("%w: set either a target or a flex goal, not both", errInvalidChallenge)
+	case !end.After(start):
+		return fmt.Errorf("%w: the end date must be after the start date", errInvalidChallenge)
+	case !end.After(now):
+		return fmt.Errorf("%w: the end date must be in the future", errInvalidChallenge)
+	}
+	if goal.Flex != nil {
+		if goal.Flex.Target < 1 {
+			return fmt.Errorf("%w: the flex target must be at least 1", errInvalidChallenge)
+		}
+		if _, err := task.FlexPeriodFor(goal.Flex.Period); err != nil {
+			return fmt.Errorf("%w: %v", errInvalidChallenge, err)
+		}
+	}
+	return nil
+}
+
+// challengePeriods counts the flex periods a challenge spans, partial ones
+// included. Periods run on UTC so every member shares the same boundaries.
+func challengePeriods(c *types.GroupChallengeDocument) int {
+	if c.Goal.Flex == nil {
+		return 0
+	}
+	strategy, err := task.FlexPeriodFor(c.Goal.Flex.Period)
+	if err != nil {
+		return 0
+	}
+	n := 0
+	for p := strategy.PeriodStart(c.StartDate, time.UTC); p.Before(c.EndDate); p = strategy.NextPeriodStart(p, time.UTC) {
+		n++
+	}
+	return n
+}
+
+// goalSize is how much of the score finishes the challenge: completions for
+// a total, periods met for a flex goal.
+func goalSize(c *types.GroupChallengeDocument) int {
+	if c.Goal.Flex != nil {
+		return challengePeriods(c)
+	}
+	return c.Goal.Target
+}
+
+func challengeScore(c *types.GroupChallengeDocument, p *types.ChallengeParticipant) int {
+	if c.Goal.Flex != nil {
+		return p.PeriodsMet
+	}
+	return p.Completions
+}
+
+// challengeCadence is the flex task a participant gets. Flex goals use theirs
+// as is; a total is spread over the days left, so "30 workouts in March"
+// becomes one a day.
+func challengeCadence(c *types.GroupChallengeDocument, from time.Time) types.FlexDetails {
+	if c.Goal.Flex != nil {
+		return *c.Goal.Flex
+	}
+	if from.Before(c.StartDate) {
+		from = c.StartDate
+	}
+	days := int(math.Ceil(c.EndDate.Sub(from).Hours() / 24))
+	if days < 1 {
+		days = 1
+	}
+	return types.FlexDetails{
+		Target: int(math.Ceil(float64(c.Goal.Target) / float64(days))),
+		Period: "daily",
+	}
+}
+
+// recordCompletion counts one completion at `at` toward p's progress and
+// reports whether it just finished the challenge.
+func recordCompletion(c *types.GroupChallengeDocument, p *types.ChallengeParticipant, at time.Time) bool {
+	p.Completions++
+	p.LastCompletedAt = &at
+
+	if c.Goal.Flex != nil {
+		if strategy, err := task.FlexPeriodFor(c.Goal.Flex.Period); err == nil {
+			start := strategy.PeriodStart(at, time.UTC)
+			if p.PeriodStart == nil || start.After(*p.PeriodStart) {
+				p.PeriodStart = &start
+				p.CompletedInPeriod = 0
+			}
+			p.CompletedInPeriod++
+			if p.CompletedInPeriod == c.Goal.Flex.Target {
+				p.PeriodsMet++
+			}
+		}
+	}
+
+	if p.FinishedAt == nil && challengeScore(c, p) >= goalSize(c) {
+		p.FinishedAt = &at
+		return true
+	}
+	return false
+}
+
+// leaderboard ranks participants by score. Ties go to whoever finished, then
+// got there, first; tied scores share a rank.
+func leaderboard(c *types.GroupChallengeDocument) []ChallengeStanding {
+	participants := append([]types.ChallengeParticipant(nil), c.Participants...)
+	earlier := func(a, b *time.Time) bool {
+		switch {
+		case a == nil:
+			return false
+		case b == nil:
+			return true
+		}
+		return a.Before(*b)
+	}
+	sort.SliceStable(participants, func(i, j int) bool {
+		a, b := &participants[i], &participants[j]
+		if sa, sb := challengeScore(c, a), challengeScore(c, b); sa != sb {
+			return sa > sb
+		}
+		if a.Completions != b.Completions {
+			return a.Completions > b.Completions
+		}
+		if earlier(a.FinishedAt, b.FinishedAt) != earlier(b.FinishedAt, a.FinishedAt) {
+			return earlier(a.FinishedAt, b.FinishedAt)
+		}
+		return earlier(a.LastCompletedAt, b.LastCompletedAt)
+	})
+
+	size := goalSize(c)
+	out := make([]ChallengeStanding, 0, len(participants))
+	for i := range participants {
+		p := &participants[i]
+		score := challengeScore(c, p)
+		rank := i + 1
+		if i > 0 && score == challengeScore(c, &participants[i-1]) {
+			rank = out[i-1].Rank
+		}
+		progress := 1.0
+		if size > 0 {
+			progress = math.Min(1, float64(score)/float64(size))
+		}
+		out = append(out, ChallengeStanding{
+			Rank:        rank,
+			User:        *p.User.ToAPI(),
+			Completions: p.Completions,
+			PeriodsMet:  p.PeriodsMet,
+			Progress:    progress,
+			FinishedAt:  p.FinishedAt,
+		})
+	}
+	return out
+}
+
+func challengeToAPI(c *types.GroupChallengeDocument, viewerID primitive.ObjectID) GroupChallengeAPI {
+	joined := false
+	for _, p := range c.Participants {
+		if p.User.ID == viewerID {
+			joined = true
+			break
+		}
+	}
+	return GroupChallengeAPI{
+		ID:           c.ID.Hex(),
+		GroupID:      c.GroupID.Hex(),
+		CreatedBy:    c.CreatedBy.Hex(),
+		Title:        c.Title,
+		Description:  c.Description,
+		Goal:         c.Goal,
+		StartDate:    c.StartDate,
+		EndDate:      c.EndDate,
+		TotalPeriods: challengePeriods(c),
+		Joined:       joined,
+		Leaderboard:  leaderboard(c),
+	}
+}
+
+// CreateChallenge sets a new challenge for the group (owner only).
+func (s *Service) CreateChallenge(ctx context.Context, groupID, requesterID primitive.ObjectID, params CreateChallengeParams) (*types.GroupChallengeDocument, error) {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.RoleOf(requesterID) != types.GroupRoleOwner {
+		return nil, fmt.Errorf("%w: only the owner can create challenges", errGroupForbidden)
+	}
+
+	now := xutils.NowUTC()
+	start := now
+	if params.StartDate != nil {
+		start = params.StartDate.UTC()
+	}
+	if err := validateChallenge(params.Goal, start, params.EndDate, now); err != nil {
+		return nil, err
+	}
+
+	challenge := types.GroupChallengeDocument{
+		ID:           primitive.NewObjectID(),
+		GroupID:      groupID,
+		CreatedBy:    requesterID,
+		Title:        params.Title,
+		Description:  params.Description,
+		Goal:         params.Goal,
+		StartDate:    start,
+		EndDate:      params.EndDate.UTC(),
+		Participants: []types.ChallengeParticipant{},
+		Metadata:     types.NewGroupMetadata(),
+	}
+	if _, err := s.Challenges.InsertOne(ctx, challenge); err != nil {
+		return nil, err
+	}
+	return &challenge, nil
+}
+
+// GetChallenges lists the group's challenges, newest first (members only).
+func (s *Service) GetChallenges(ctx context.Context, groupID, viewerID primitive.ObjectID) ([]types.GroupChallengeDocument, error) {
+	if _, err := s.memberGroup(groupID, viewerID); err != nil {
+		return nil, err
+	}
+
+	cursor, err := s.Challenges.Find(ctx, bson.M{"groupId": groupID},
+		options.Find().SetSort(bson.D{{Key: "startDate", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	challenges := []types.GroupChallengeDocument{}
+	if err := cursor.All(ctx, &challenges); err != nil {
+		return nil, err
+	}
+	return challenges, nil
+}
+
+// GetChallenge returns one of the group's challenges (members only).
+func (s *Service) GetChallenge(ctx context.Context, groupID, challengeID, viewerID primitive.ObjectID) (*types.GroupChallengeDocument, error) {
+	if _, err := s.memberGroup(groupID, viewerID); err != nil {
+		return nil, err
+	}
+	return s.findChallenge(ctx, groupID, challengeID)
+}
+
+func (s *Service) findChallenge(ctx context.Context, groupID, challengeID primitive.ObjectID) (*types.GroupChallengeDocument, error) {
+	var challenge types.GroupChallengeDocument
+	if err := s.Challenges.FindOne(ctx, bson.M{"_id": challengeID, "groupId": groupID}).Decode(&challenge); err != nil {
+		return nil, err
+	}
+	return &challenge, nil
+}
+
+// memberGroup loads the group, failing unless userID belongs to it.
+func (s *Service) memberGroup(groupID, userID primitive.ObjectID) (*types.GroupDocument, error) {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.RoleOf(userID) == "" {
+		return nil, errNotGroupMember
+	}
+	return group, nil
+}
+
+// JoinChallenge opts the user into the challenge and puts its task in a
+// workspace named after the group.
+func (s *Service) JoinChallenge(ctx context.Context, groupID, challengeID, userID primitive.ObjectID) (*types.GroupChallengeDocument, error) {
+	group, err := s.memberGroup(groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	challenge, err := s.findChallenge(ctx, groupID, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	now := xutils.NowUTC()
+	if !challenge.EndDate.After(now) {
+		return nil, errChallengeEnded
+	}
+
+	ref, err := s.userRef(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	participant := types.ChallengeParticipant{User: ref, JoinedAt: now}
+
+	result, err := s.Challenges.UpdateOne(ctx,
+		bson.M{"_id": challengeID, "participants.user._id": bson.M{"$ne": userID}},
+		bson.M{
+			"$push": bson.M{"participants": participant},
+			"$set":  bson.M{"metadata.updatedAt": now},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if result.MatchedCount == 0 {
+		return nil, errAlreadyJoined
+	}
+
+	if _, err := s.Tasks.MaterializeChallenge(userID, task.ChallengeTask{
+		ChallengeID: challengeID,
+		Workspace:   group.Name,
+		Content:     challenge.Title,
+		Flex:        challengeCadence(challenge, now),
+	}); err != nil {
+		// Without the task there's no way to make progress, so back out.
+		if _, pullErr := s.Challenges.UpdateOne(ctx, bson.M{"_id": challengeID},
+			bson.M{"$pull": bson.M{"participants": bson.M{"user._id": userID}}}); pullErr != nil {
+			slog.Error("Failed to back out of challenge", "challengeId", challengeID.Hex(), "userId", userID.Hex(), "error", pullErr)
+		}
+		return nil, err
+	}
+
+	challenge.Participants = append(challenge.Participants, participant)
+	return challenge, nil
+}
+
+// LeaveChallenge drops the user from the challenge and removes its task from
+// their workspace.
+func (s *Service) LeaveChallenge(ctx context.Context, groupID, challengeID, userID primitive.ObjectID) error {
+	result, err := s.Challenges.UpdateOne(ctx,
+		bson.M{"_id": challengeID, "groupId": groupID},
+		bson.M{"$pull": bson.M{"participants": bson.M{"user._id": userID}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	if result.ModifiedCount == 0 {
+		return errNotParticipant
+	}
+	return s.Tasks.RemoveChallenge(userID, challengeID)
+}
+
+// DeleteChallenge removes the challenge and every participant's task for it
+// (owner only).
+func (s *Service) DeleteChallenge(ctx context.Context, groupID, challengeID, requesterID primitive.ObjectID) error {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return err
+	}
+	if group.RoleOf(requesterID) != types.GroupRoleOwner {
+		return fmt.Errorf("%w: only the owner can delete challenges", errGroupForbidden)
+	}
+
+	var challenge types.GroupChallengeDocument
+	if err := s.Challenges.FindOneAndDelete(ctx, bson.M{"_id": challengeID, "groupId": groupID}).Decode(&challenge); err != nil {
+		return err
+	}
+	for _, p := range challenge.Participants {
+		if err := s.Tasks.RemoveChallenge(p.User.ID, challengeID); err != nil {
+			slog.Error("Failed to remove challenge task", "challengeId", challengeID.Hex(), "userId", p.User.ID.Hex(), "error", err)
+		}
+	}
+	return nil
+}
+
+// dropChallengeParticipants takes users out of the group's challenges and
+// removes their challenge tasks once they've left the group. With no users
+// it drops every participant, for a deleted group. Best-effort: the
+// membership change has already happened.
+func (s *Service) dropChallengeParticipants(ctx context.Context, groupID primitive.ObjectID, userIDs ...primitive.ObjectID) {
+	filter := bson.M{"groupId": groupID, "participants.0": bson.M{"$exists": true}}
+	update := bson.M{"$set": bson.M{"participants": bson.A{}}}
+	if len(userIDs) > 0 {
+		filter = bson.M{"groupId": groupID, "participants.user._id": bson.M{"$in": userIDs}}
+		update = bson.M{"$pull": bson.M{"participants": bson.M{"user._id": bson.M{"$in": userIDs}}}}
+	}
+	leaving := make(map[primitive.ObjectID]bool, len(userIDs))
+	for _, id := range userIDs {
+		leaving[id] = true
+	}
+
+	cursor, err := s.Challenges.Find(ctx, filter, options.Find().SetProjection(bson.M{"participants.user._id": 1}))
+	if err != nil {
+		slog.Error("Failed to load challenges of departing members", "groupId", groupID.Hex(), "error", err)
+		return
+	}
+	var challenges []types.GroupChallengeDocument
+	if err := cursor.All(ctx, &challenges); err != nil {
+		slog.Error("Failed to load challenges of departing members", "groupId", groupID.Hex(), "error", err)
+		return
+	}
+	if len(challenges) == 0 {
+		return
+	}
+
+	if _, err := s.Challenges.UpdateMany(ctx, filter, update); err != nil {
+		slog.Error("Failed to drop departing members from challenges", "groupId", groupID.Hex(), "error", err)
+		return
+	}
+	if s.Tasks == nil {
+		return
+	}
+	for _, c := range challenges {
+		for _, p := range c.Participants {
+			if len(userIDs) > 0 && !leaving[p.User.ID] {
+				continue
+			}
+			if err := s.Tasks.RemoveChallenge(p.User.ID, c.ID); err != nil {
+				slog.Error("Failed to remove challenge task", "challengeId", c.ID.Hex(), "userId", p.User.ID.Hex(), "error", err)
+			}
+		}
+	}
+}
+
+// maxCompletionAttempts bounds how often RecordChallengeCompletion re-reads a
+// participant whose progress moved underneath it.
+const maxCompletionAttempts = 5
+
+// errCompletionContended means every attempt lost a race with another
+// completion for the same participant.
+var errCompletionContended = errors.New("challenge progress changed concurrently")
+
+// RecordChallengeCompletion counts a completion of a challenge task toward
+// the user's progress. Completions outside the challenge's dates, or after
+// the user left it, don't count.
+func (s *Service) RecordChallengeCompletion(ctx context.Context, challengeID, userID primitive.ObjectID, at time.Time) error {
+	for attempt := 0; attempt < maxCompletionAttempts; attempt++ {
+		var challenge types.GroupChallengeDocument
+		if err := s.Challenges.FindOne(ctx, bson.M{"_id": challengeID}).Decode(&challenge); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil
+			}
+			return err
+		}
+		if at.Before(challenge.StartDate) || !at.Before(challenge.EndDate) {
+			return nil
+		}
+
+		var participant *types.ChallengeParticipant
+		for i := range challenge.Participants {
+			if challenge.Participants[i].User.ID == userID {
+				participant = &challenge.Participants[i]
+				break
+			}
+		}
+		if participant == nil {
+			return nil
+		}
+
+		// Completions only ever grow, so the count read here tells whether
+		// another completion landed before this write.
+		read := bson.M{"user._id": userID, "joinedAt": participant.JoinedAt, "completions": participant.Completions}
+		finished := recordCompletion(&challenge, participant, at)
+		res, err := s.Challenges.UpdateOne(ctx,
+			bson.M{"_id": challengeID, "participants": bson.M{"$elemMatch": read}},
+			bson.M{"$set": bson.M{"participants.$": *participant}},
+		)
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount == 0 {
+			continue
+		}
+
+		if finished {
+			go s.notifyChallengeFinished(challenge, participant.User)
+		}
+		return nil
+	}
+	return errCompletionContended
+}
+
+// notifyChallengeFinished tells group members who follow group activity that
+// someone reached the challenge's goal.
+func (s *Service) notifyChallengeFinished(challenge types.GroupChallengeDocument, finisher types.UserExtendedReferenceInternal) {
+	ctx := context.Background()
+
+	group, err := s.GetGroupByID(challenge.GroupID)
+	if err != nil {
+		slog.Error("Failed to load group for challenge notification", "challengeId", challenge.ID.Hex(), "error", err)
+		return
+	}
+
+	withheld, err := s.Audiences.Withheld(ctx, finisher.ID)
+	if err != nil {
+		slog.Error("Failed to load withheld members for challenge notification", "challengeId", challenge.ID.Hex(), "error", err)
+		return
+	}
+	receivers := []primitive.ObjectID{}
+	for _, id := range group.MemberIDs() {
+		if id != finisher.ID && !withheld[id] && group.NotificationSettingsFor(id).Activity {
+			receivers = append(receivers, id)
+		}
+	}
+	if len(receivers) == 0 {
+		return
+	}
+
+	content := fmt.Sprintf("finished the %s challenge in %s", challenge.Title, group.Name)
+	for _, id := range receivers {
+		if err := s.Notifications.CreateNotification(
+			finisher.ID, id, content, notifications.NotificationTypeChallengeCompleted, challenge.ID,
+		); err != nil {
+			slog.Error("Failed to create CHALLENGE_COMPLETED record", "receiver", id, "error", err)
+		}
+	}
+
+	cursor, err := s.Users.Find(ctx, bson.M{"_id": bson.M{"$in": receivers}},
+		options.Find().SetProjection(bson.M{"push_token": 1}))
+	if err != nil {
+		slog.Error("Failed to load group members for challenge push", "challengeId", challenge.ID.Hex(), "error", err)
+		return
+	}
+	var users []types.User
+	if err := cursor.All(ctx, &users); err != nil {
+		slog.Error("Failed to decode group members", "challengeId", challenge.ID.Hex(), "error", err)
+		return
+	}
+	for _, u := range users {
+		if u.PushToken == "" {
+			continue
+		}
+		if err := xutils.SendNotification(xutils.Notification{
+			Token:   u.PushToken,
+			Title:   "Challenge complete",
+			Message: fmt.Sprintf("%s finished the %s challenge", finisher.DisplayName, challenge.Title),
+			Data: map[string]string{
+				"type":         "challenge_completed",
+				"group_id":     group.ID.Hex(),
+				"challenge_id": challenge.ID.Hex(),
+				"user_id":      finisher.ID.Hex(),
+			},
+		}); err != nil {
+			slog.Error("Failed to send challenge_completed push", "receiver", u.ID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package Group
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var march = time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+func TestValidateChallenge(t *testing.T) {
+	end := march.AddDate(0, 1, 0)
+	now := march
+
+	valid := []types.ChallengeGoal{
+		{Target: 30},
+		{Flex: &types.FlexDetails{Target: 3, Period: "weekly"}},
+	}
+	for _, goal := range valid {
+		if err := validateChallenge(goal, march, end, now); err != nil {
+			t.Errorf("expected %+v to be valid, got %v", goal, err)
+		}
+	}
+
+	invalid := []struct {
+		name       string
+		goal       types.ChallengeGoal
+		start, end time.Time
+	}{
+		{"no goal", types.ChallengeGoal{}, march, end},
+		{"both goals", types.ChallengeGoal{Target: 5, Flex: &types.FlexDetails{Target: 1, Period: "daily"}}, march, end},
+		{"bad period", types.ChallengeGoal{Flex: &types.FlexDetails{Target: 1, Period: "yearly"}}, march, end},
+		{"zero flex target", types.ChallengeGoal{Flex: &types.FlexDetails{Target: 0, Period: "daily"}}, march, end},
+		{"ends before it starts", types.ChallengeGoal{Target: 5}, end, march},
+		{"already over", types.ChallengeGoal{Target: 5}, march.AddDate(0, -2, 0), march.AddDate(0, -1, 0)},
+	}
+	for _, c := range invalid {
+		if err := validateChallenge(c.goal, c.start, c.end, now); !errors.Is(err, errInvalidChallenge) {
+			t.Errorf("%s: expected errInvalidChallenge, got %v", c.name, err)
+		}
+	}
+}
+
+func TestChallengePeriods(t *testing.T) {
+	c := &types.GroupChallengeDocument{
+		Goal:      types.ChallengeGoal{Flex: &types.FlexDetails{Target: 1, Period: "daily"}},
+		StartDate: march,
+		EndDate:   march.AddDate(0, 1, 0),
+	}
+	if got := challengePeriods(c); got != 31 {
+		t.Errorf("daily periods in March = %d, want 31", got)
+	}
+
+	// March 1 2026 is a Sunday, so the first week is partial and still counts.
+	c.Goal.Flex.Period = "weekly"
+	if got := challengePeriods(c); got != 6 {
+		t.Errorf("weekly periods in March = %d, want 6", got)
+	}
+
+	c.Goal = types.ChallengeGoal{Target: 30}
+	if got := challengePeriods(c); got != 0 {
+		t.Errorf("total goals have no periods, got %d", got)
+	}
+}
+
+func TestChallengeCadence(t *testing.T) {
+	c := &types.GroupChallengeDocument{
+		Goal:      types.ChallengeGoal{Target: 30},
+		StartDate: march,
+		EndDate:   march.AddDate(0, 0, 30),
+	}
+	if got := challengeCadence(c, march.AddDate(0, 0, -5)); got.Target != 1 || got.Period != "daily" {
+		t.Errorf("30 in 30 days = %+v, want one a day", got)
+	}
+	if got := challengeCadence(c, march.AddDate(0, 0, 20)); got.Target != 3 {
+		t.Errorf("joining with 10 days left = %+v, want three a day", got)
+	}
+
+	flex := types.FlexDetails{Target: 3, Period: "weekly"}
+	c.Goal = types.ChallengeGoal{Flex: &flex}
+	if got := challengeCadence(c, march); got != flex {
+		t.Errorf("flex goals keep their cadence, got %+v", got)
+	}
+}
+
+func TestRecordCompletion_Total(t *testing.T) {
+	c := &types.GroupChallengeDocument{Goal: types.ChallengeGoal{Target: 2}, StartDate: march, EndDate: march.AddDate(0, 1, 0)}
+	p := &types.ChallengeParticipant{}
+
+	if recordCompletion(c, p, march.Add(time.Hour)) {
+		t.Fatal("one of two completions shouldn't finish the challenge")
+	}
+	if !recordCompletion(c, p, march.Add(2*time.Hour)) {
+		t.Fatal("the second completion should finish the challenge")
+	}
+	if recordCompletion(c, p, march.Add(3*time.Hour)) {
+		t.Error("a challenge is only finished once")
+	}
+	if p.Completions != 3 || p.FinishedAt == nil || !p.FinishedAt.Equal(march.Add(2*time.Hour)) {
+		t.Errorf("unexpected progress %+v", p)
+	}
+}
+
+func TestRecordCompletion_Flex(t *testing.T) {
+	c := &types.GroupChallengeDocument{
+		Goal:      types.ChallengeGoal{Flex: &types.FlexDetails{Target: 2, Period: "daily"}},
+		StartDate: march,
+		EndDate:   march.AddDate(0, 0, 2),
+	}
+	p := &types.ChallengeParticipant{}
+
+	recordCompletion(c, p, march.Add(time.Hour))
+	recordCompletion(c, p, march.Add(2*time.Hour))
+	recordCompletion(c, p, march.Add(3*time.Hour))
+	if p.PeriodsMet != 1 || p.CompletedInPeriod != 3 {
+		t.Fatalf("extra completions in a met period don't count twice: %+v", p)
+	}
+
+	day2 := march.AddDate(0, 0, 1)
+	if recordCompletion(c, p, day2.Add(time.Hour)) {
+		t.Fatal("the second day isn't met yet")
+	}
+	if p.CompletedInPeriod != 1 {
+		t.Errorf("a new period starts from zero, got %d", p.CompletedInPeriod)
+	}
+	if !recordCompletion(c, p, day2.Add(2*time.Hour)) {
+		t.Error("meeting every period should finish the challenge")
+	}
+}
+
+func TestLeaderboard(t *testing.T) {
+	at := func(h int) *time.Time { v := march.Add(time.Duration(h) * time.Hour); return &v }
+	user := func(name string) types.UserExtendedReferenceInternal {
+		return types.UserExtendedReferenceInternal{ID: primitive.NewObjectID(), DisplayName: name}
+	}
+	c := &types.GroupChallengeDocument{
+		Goal: types.ChallengeGoal{Target: 10},
+		Participants: []types.ChallengeParticipant{
+			{User: user("slow"), Completions: 4, LastCompletedAt: at(5)},
+			{User: user("done"), Completions: 10, FinishedAt: at(9), LastCompletedAt: at(9)},
+			{User: user("early"), Completions: 4, LastCompletedAt: at(2)},
+			{User: user("new")},
+		},
+	}
+
+	board := leaderboard(c)
+	want := []struct {
+		name string
+		rank int
+	}{{"done", 1}, {"early", 2}, {"slow", 2}, {"new", 4}}
+	for i, w := range want {
+		if board[i].User.DisplayName != w.name || board[i].Rank != w.rank {
+			t.Errorf("place %d = %s (rank %d), want %s (rank %d)", i, board[i].User.DisplayName, board[i].Rank, w.name, w.rank)
+		}
+	}
+	if board[0].Progress != 1 || board[1].Progress != 0.4 {
+		t.Errorf("unexpected progress %v and %v", board[0].Progress, board[1].Progress)
+	}
+}
@@ -2,8 +2,10 @@ package Group
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/abhikaboy/Kindred/internal/handlers/auth"
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
@@ -175,8 +177,7 @@ func (h *Handler) UpdateGroupHuma(ctx context.Context, input *UpdateGroupInput)
 
 	err = h.service.UpdateGroup(id, input.Body, userID)
 	if err != nil {
-		slog.Error("Failed to update group", "groupId", id.Hex(), "userId", userID.Hex(), "error", err)
-		return nil, huma.Error500InternalServerError("Unable to update group. Please try again.", err)
+		return nil, groupError(err, "update group", "groupId", id.Hex(), "userId", userID.Hex())
 	}
 
 	return &UpdateGroupOutput{
@@ -205,8 +206,7 @@ func (h *Handler) DeleteGroupHuma(ctx context.Context, input *DeleteGroupInput)
 
 	err = h.service.DeleteGroup(id, userID)
 	if err != nil {
-		slog.Error("Failed to delete group", "groupId", id.Hex(), "userId", userID.Hex(), "error", err)
-		return nil, huma.Error500InternalServerError("Unable to delete group. You may not have permission or the group may not exist.", err)
+		return nil, groupError(err, "delete group", "groupId", id.Hex(), "userId", userID.Hex())
 	}
 
 	return &DeleteGroupOutput{
@@ -245,8 +245,7 @@ func (h *Handler) AddMemberHuma(ctx context.Context, input *AddMemberInput) (*Ad
 
 	err = h.service.AddMember(groupID, userID, requesterID)
 	if err != nil {
-		slog.Error("Failed to add member to group", "groupId", groupID.Hex(), "memberId", userID.Hex(), "requesterId", requesterID.Hex(), "error", err)
-		return nil, huma.Error500InternalServerError("Unable to add member to group. The user may already be a member or you may not have permission.", err)
+		return nil, groupError(err, "add member to group", "groupId", groupID.Hex(), "memberId", userID.Hex(), "requesterId", requesterID.Hex())
 	}
 
 	return &AddMemberOutput{
@@ -285,8 +284,7 @@ func (h *Handler) RemoveMemberHuma(ctx context.Context, input *RemoveMemberInput
 
 	err = h.service.RemoveMember(groupID, userID, requesterID)
 	if err != nil {
-		slog.Error("Failed to remove member from group", "groupId", groupID.Hex(), "memberId", userID.Hex(), "requesterId", requesterID.Hex(), "error", err)
-		return nil, huma.Error500InternalServerError("Unable to remove member from group. You may not have permission.", err)
+		return nil, groupError(err, "remove member from group", "groupId", groupID.Hex(), "memberId", userID.Hex(), "requesterId", requesterID.Hex())
 	}
 
 	return &RemoveMemberOutput{
@@ -295,3 +293,368 @@ func (h *Handler) RemoveMemberHuma(ctx context.Context, input *RemoveMemberInput
 		}{Message: "Member removed successfully"},
 	}, nil
 }
+
+// groupError maps group service errors onto HTTP errors, logging the ones
+// that aren't the caller's fault.
+func groupError(err error, action string, logArgs ...any) error {
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return huma.Error404NotFound("Group not found", err)
+	case errors.Is(err, errGroupForbidden):
+		return huma.Error403Forbidden("You don't have permission to do that in this group", err)
+	case errors.Is(err, errNotGroupMember):
+		return huma.Error403Forbidden("You do not have access to this group", err)
+	case errors.Is(err, errMemberNotFound):
+		return huma.Error404NotFound("That user isn't a member of this group", err)
+	case errors.Is(err, errAlreadyMember):
+		return huma.Error409Conflict("That user is already a member of this group", err)
+	case errors.Is(err, errOwnerCannotLeave):
+		return huma.Error400BadRequest("Make another member the owner before leaving", err)
+	case errors.Is(err, errInvalidRole):
+		return huma.Error400BadRequest("Invalid role", err)
+	case errors.Is(err, errInviteNotFound):
+		return huma.Error404NotFound("This invite link is invalid or has expired", err)
+	case errors.Is(err, errTooManyInvites):
+		return huma.Error422UnprocessableEntity(fmt.Sprintf("A group can have at most %d active invites", maxInvites), err)
+	case errors.Is(err, errRequestNotFound):
+		return huma.Error404NotFound("Join request not found", err)
+	case errors.Is(err, errInvalidChallenge):
+		return huma.Error400BadRequest(err.Error(), err)
+	case errors.Is(err, errChallengeEnded):
+		return huma.Error409Conflict("This challenge has ended", err)
+	case errors.Is(err, errAlreadyJoined):
+		return huma.Error409Conflict("You're already taking part in this challenge", err)
+	case errors.Is(err, errNotParticipant):
+		return huma.Error404NotFound("You aren't taking part in this challenge", err)
+	}
+	slog.Error("Failed to "+action, append(logArgs, "error", err)...)
+	return huma.Error500InternalServerError("Unable to "+action+". Please try again.", err)
+}
+
+// requireUserAndGroup resolves the caller and the group in the path.
+func requireUserAndGroup(ctx context.Context, groupIDStr string) (primitive.ObjectID, primitive.ObjectID, error) {
+	userIDStr, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, huma.Error401Unauthorized("Authentication required", err)
+	}
+	userID, err := primitive.ObjectIDFromHex(userIDStr)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+	groupID, err := primitive.ObjectIDFromHex(groupIDStr)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, huma.Error400BadRequest("Invalid group ID format", err)
+	}
+	return userID, groupID, nil
+}
+
+func (h *Handler) SetMemberRoleHuma(ctx context.Context, input *SetMemberRoleInput) (*SetMemberRoleOutput, error) {
+	requesterID, groupID, err := requireUserAndGroup(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := primitive.ObjectIDFromHex(input.UserID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	group, err := h.service.SetMemberRole(ctx, groupID, userID, input.Body.Role, requesterID)
+	if err != nil {
+		return nil, groupError(err, "change member role", "groupId", groupID.Hex(), "memberId", userID.Hex(), "requesterId", requesterID.Hex())
+	}
+
+	return &SetMemberRoleOutput{Body: *group.ToAPI()}, nil
+}
+
+func (h *Handler) CreateInviteHuma(ctx context.Context, input *CreateInviteInput) (*CreateInviteOutput, error) {
+	requesterID, groupID, err := requireUserAndGroup(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := time.Duration(input.Body.ExpiresInHours) * time.Hour
+	invite, err := h.service.CreateInvite(ctx, groupID, requesterID, ttl)
+	if err != nil {
+		return nil, groupError(err, "create invite", "groupId", groupID.Hex(), "requesterId", requesterID.Hex())
+	}
+
+	return &CreateInviteOutput{Body: *invite}, nil
+}
+
+func (h *Handler) GetInvitesHuma(ctx context.Context, input *GetInvitesInput) (*GetInvitesOutput, error) {
+	requesterID, groupID, err := requireUserAndGroup(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	invites, err := h.service.GetInvites(groupID, requesterID)
+	if err != nil {
+		return nil, groupError(err, "load invites", "groupId", groupID.Hex(), "requesterId", requesterID.Hex())
+	}
+
+	output := &GetInvitesOutput{}
+	output.Body.Invites = invites
+	return output, nil
+}
+
+func (h *Handler) RevokeInviteHuma(ctx context.Context, input *RevokeInviteInput) (*RevokeInviteOutput, error) {
+	requesterID, groupID, err := requireUserAndGroup(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.RevokeInvite(ctx, groupID, requesterID, input.Code); err != nil {
+		return nil, groupError(err, "revoke invite", "groupId", groupID.Hex(), "requesterId", requesterID.Hex())
+	}
+
+	output := &RevokeInviteOutput{}
+	output.Body.Message = "Invite revoked successfully"
+	return output, nil
+}
+
+func (h *Handler) JoinGroupHuma(ctx context.Context, input *JoinGroupInput) (*JoinGroupOutput, error) {
+	userIDStr, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
+	}
+
+	userID, err := primitive.ObjectIDFromHex(userIDStr)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	status, group, err := h.service.JoinWithInvite(ctx, userID, input.Code)
+	if err != nil {
+		return nil, groupError(err, "join group", "userId", userID.Hex())
+	}
+
+	output := &JoinGroupOutput{}
+	output.Body.Status = status
+	output.Body.GroupID = group.ID.Hex()
+	output.Body.GroupName = group.Name
+	return output, nil
+}
+
+func (h *Handler) GetJoinRequestsHuma(ctx context.Context, input *GetJoinRequestsInput) (*GetJoinRequestsOutput, error) {
+	requesterID, groupID, err := requireUserAndGroup(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := h.service.GetJoinRequests(groupID, requesterID)
+	if err != nil {
+		return nil, groupError(err, "load join requests", "groupId", groupID.Hex(), "requesterId", requesterID.Hex())
+	}
+
+	output := &GetJoinRequestsOutput{}
+	output.Body.Requests = make([]GroupJoinRequestAPI, 0, len(requests))
+	for _, r := range requests {
+		output.Body.Requests = append(output.Body.Requests, GroupJoinRequestAPI{
+			User:        *r.User.ToAPI(),
+			RequestedAt: r.RequestedAt,
+		})
+	}
+	return output, nil
+}
+
+func (h *Handler) ApproveJoinRequestHuma(ctx context.Context, input *JoinRequestInput) (*JoinRequestOutput, error) {
+	requesterID, groupID, err := requireUserAndGroup(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := primitive.ObjectIDFromHex(input.UserID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	if err := h.service.ApproveJoinRequest(ctx, groupID, requesterID, userID); err != nil {
+		return nil, groupError(err, "approve join request", "groupId", groupID.Hex(), "memberId", userID.Hex(), "requesterId", requesterID.Hex())
+	}
+
+	output := &JoinRequestOutput{}
+	output.Body.Message = "Join request approved"
+	return output, nil
+}
+
+func (h *Handler) DenyJoinRequestHuma(ctx context.Context, input *JoinRequestInput) (*JoinRequestOutput, error) {
+	requesterID, groupID, err := requireUserAndGroup(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := primitive.ObjectIDFromHex(input.UserID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	if err := h.service.DenyJoinRequest(ctx, groupID, requesterID, userID); err != nil {
+		return nil, groupError(err, "deny join request", "groupId", groupID.Hex(), "memberId", userID.Hex(), "requesterId", requesterID.Hex())
+	}
+
+	output := &JoinRequestOutput{}
+	output.Body.Message = "Join request denied"
+	return output, nil
+}
+
+func (h *Handler) GetGroupNotificationsHuma(ctx context.Context, input *GetGroupNotificationsInput) (*GroupNotificationsOutput, error) {
+	userID, groupID, err := requireUserAndGroup(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := h.service.GetNotificationSettings(groupID, userID)
+	if err != nil {
+		return nil, groupError(err, "load group notification settings", "groupId", groupID.Hex(), "userId", userID.Hex())
+	}
+
+	return &GroupNotificationsOutput{Body: *settings}, nil
+}
+
+func (h *Handler) UpdateGroupNotificationsHuma(ctx context.Context, input *UpdateGroupNotificationsInput) (*GroupNotificationsOutput, error) {
+	userID, groupID, err := requireUserAndGroup(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := h.service.UpdateNotificationSettings(ctx, groupID, userID, input.Body)
+	if err != nil {
+		return nil, groupError(err, "update group notification settings", "groupId", groupID.Hex(), "userId", userID.Hex())
+	}
+
+	return &GroupNotificationsOutput{Body: *settings}, nil
+}
+
+func (h *Handler) GetGroupActivityHuma(ctx context.Context, input *GetGroupActivityInput) (*GetGroupActivityOutput, error) {
+	userID, groupID, err := requireUserAndGroup(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := time.Now()
+	if input.Before != "" {
+		before, err = time.Parse(time.RFC3339, input.Before)
+		if err != nil {
+			return nil, huma.Error400BadRequest("Invalid before timestamp; use RFC3339", err)
+		}
+	}
+
+	items, err := h.service.GetActivity(ctx, groupID, userID, before, input.Limit)
+	if err != nil {
+		return nil, groupError(err, "load group activity", "groupId", groupID.Hex(), "userId", userID.Hex())
+	}
+
+	output := &GetGroupActivityOutput{}
+	output.Body.Items = items
+	if len(items) == input.Limit {
+		output.Body.NextBefore = items[len(items)-1].At.Format(time.RFC3339Nano)
+	}
+	return output, nil
+}
+
+// requireChallenge resolves the caller, the group and the challenge in the path.
+func requireChallenge(ctx context.Context, input *ChallengeInput) (primitive.ObjectID, primitive.ObjectID, primitive.ObjectID, error) {
+	userID, groupID, err := requireUserAndGroup(ctx, input.ID)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, primitive.NilObjectID, err
+	}
+	challengeID, err := primitive.ObjectIDFromHex(input.ChallengeID)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, primitive.NilObjectID, huma.Error400BadRequest("Invalid challenge ID format", err)
+	}
+	return userID, groupID, challengeID, nil
+}
+
+func (h *Handler) CreateChallengeHuma(ctx context.Context, input *CreateChallengeInput) (*ChallengeOutput, error) {
+	errs := xvalidator.Validator.Validate(input.Body)
+	if len(errs) > 0 {
+		return nil, huma.Error400BadRequest("Please check your challenge details", fmt.Errorf("validation errors: %v", errs))
+	}
+
+	userID, groupID, err := requireUserAndGroup(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, err := h.service.CreateChallenge(ctx, groupID, userID, input.Body)
+	if err != nil {
+		return nil, groupError(err, "create challenge", "groupId", groupID.Hex(), "userId", userID.Hex())
+	}
+
+	return &ChallengeOutput{Body: challengeToAPI(challenge, userID)}, nil
+}
+
+func (h *Handler) GetChallengesHuma(ctx context.Context, input *GetChallengesInput) (*GetChallengesOutput, error) {
+	userID, groupID, err := requireUserAndGroup(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	challenges, err := h.service.GetChallenges(ctx, groupID, userID)
+	if err != nil {
+		return nil, groupError(err, "load challenges", "groupId", groupID.Hex(), "userId", userID.Hex())
+	}
+
+	output := &GetChallengesOutput{}
+	output.Body.Challenges = make([]GroupChallengeAPI, 0, len(challenges))
+	for i := range challenges {
+		output.Body.Challenges = append(output.Body.Challenges, challengeToAPI(&challenges[i], userID))
+	}
+	return output, nil
+}
+
+func (h *Handler) GetChallengeHuma(ctx context.Context, input *ChallengeInput) (*ChallengeOutput, error) {
+	userID, groupID, challengeID, err := requireChallenge(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, err := h.service.GetChallenge(ctx, groupID, challengeID, userID)
+	if err != nil {
+		return nil, groupError(err, "load challenge", "groupId", groupID.Hex(), "challengeId", challengeID.Hex())
+	}
+
+	return &ChallengeOutput{Body: challengeToAPI(challenge, userID)}, nil
+}
+
+func (h *Handler) JoinChallengeHuma(ctx context.Context, input *ChallengeInput) (*ChallengeOutput, error) {
+	userID, groupID, challengeID, err := requireChallenge(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, err := h.service.JoinChallenge(ctx, groupID, challengeID, userID)
+	if err != nil {
+		return nil, groupError(err, "join challenge", "groupId", groupID.Hex(), "challengeId", challengeID.Hex(), "userId", userID.Hex())
+	}
+
+	return &ChallengeOutput{Body: challengeToAPI(challenge, userID)}, nil
+}
+
+func (h *Handler) LeaveChallengeHuma(ctx context.Context, input *ChallengeInput) (*ChallengeMessageOutput, error) {
+	userID, groupID, challengeID, err := requireChallenge(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.LeaveChallenge(ctx, groupID, challengeID, userID); err != nil {
+		return nil, groupError(err, "leave challenge", "groupId", groupID.Hex(), "challengeId", challengeID.Hex(), "userId", userID.Hex())
+	}
+
+	output := &ChallengeMessageOutput{}
+	output.Body.Message = "Left challenge"
+	return output, nil
+}
+
+func (h *Handler) DeleteChallengeHuma(ctx context.Context, input *ChallengeInput) (*ChallengeMessageOutput, error) {
+	userID, groupID, challengeID, err := requireChallenge(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.DeleteChallenge(ctx, groupID, challengeID, userID); err != nil {
+		return nil, groupError(err, "delete challenge", "groupId", groupID.Hex(), "challengeId", challengeID.Hex(), "userId", userID.Hex())
+	}
+
+	output := &ChallengeMessageOutput{}
+	output.Body.Message = "Challenge deleted"
+	return output, nil
+}
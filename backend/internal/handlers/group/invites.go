@@ -0,0 +1,316 @@
+package Group
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/xutils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	defaultInviteTTL = 72 * time.Hour
+	maxInvites       = 20
+)
+
+// Join outcomes.
+const (
+	joinStatusJoined    = "joined"
+	joinStatusRequested = "requested"
+)
+
+var (
+	errInviteNotFound  = errors.New("invite not found or expired")
+	errTooManyInvites  = errors.New("too many active invites")
+	errRequestNotFound = errors.New("join request not found")
+)
+
+// newInviteCode returns a short, human-typeable code.
+func newInviteCode() (string, error) {
+	bytes := make([]byte, 5)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate invite code: %w", err)
+	}
+	return base32.StdEncoding.EncodeToString(bytes)[:8], nil
+}
+
+// activeInvites drops the expired invites.
+func activeInvites(invites []types.GroupInvite, now time.Time) []types.GroupInvite {
+	out := []types.GroupInvite{}
+	for _, inv := range invites {
+		if inv.ExpiresAt.After(now) {
+			out = append(out, inv)
+		}
+	}
+	return out
+}
+
+// CreateInvite makes an invite link for the group that works for ttl, or
+// defaultInviteTTL when ttl is zero. Expired invites are cleared out first.
+func (s *Service) CreateInvite(ctx context.Context, groupID, requesterID primitive.ObjectID, ttl time.Duration) (*types.GroupInvite, error) {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if !group.CanManage(requesterID) {
+		return nil, fmt.Errorf("%w: only owners and admins can create invites", errGroupForbidden)
+	}
+
+	now := time.Now()
+	if len(activeInvites(group.Invites, now)) >= maxInvites {
+		return nil, errTooManyInvites
+	}
+	if ttl <= 0 {
+		ttl = defaultInviteTTL
+	}
+	code, err := newInviteCode()
+	if err != nil {
+		return nil, err
+	}
+	invite := types.GroupInvite{
+		Code:      code,
+		CreatedBy: requesterID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+
+	if _, err := s.Groups.UpdateOne(ctx, bson.M{"_id": groupID},
+		bson.M{"$pull": bson.M{"invites": bson.M{"expiresAt": bson.M{"$lte": now}}}}); err != nil {
+		slog.Warn("Failed to clear expired group invites", "groupId", groupID.Hex(), "error", err)
+	}
+	if _, err := s.Groups.UpdateOne(ctx, bson.M{"_id": groupID},
+		bson.M{"$push": bson.M{"invites": invite}}); err != nil {
+		return nil, err
+	}
+	return &invite, nil
+}
+
+// GetInvites returns the group's invites that haven't expired.
+func (s *Service) GetInvites(groupID, requesterID primitive.ObjectID) ([]types.GroupInvite, error) {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if !group.CanManage(requesterID) {
+		return nil, fmt.Errorf("%w: only owners and admins can see invites", errGroupForbidden)
+	}
+	return activeInvites(group.Invites, time.Now()), nil
+}
+
+// RevokeInvite stops an invite link from working.
+func (s *Service) RevokeInvite(ctx context.Context, groupID, requesterID primitive.ObjectID, code string) error {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return err
+	}
+	if !group.CanManage(requesterID) {
+		return fmt.Errorf("%w: only owners and admins can revoke invites", errGroupForbidden)
+	}
+	result, err := s.Groups.UpdateOne(ctx, bson.M{"_id": groupID},
+		bson.M{"$pull": bson.M{"invites": bson.M{"code": code}}})
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount == 0 {
+		return errInviteNotFound
+	}
+	return nil
+}
+
+// JoinWithInvite adds userID to the group an invite code belongs to, or files
+// a join request when the group approves new members first. It returns which
+// happened.
+func (s *Service) JoinWithInvite(ctx context.Context, userID primitive.ObjectID, code string) (string, *types.GroupDocument, error) {
+	now := time.Now()
+	var group types.GroupDocument
+	err := s.Groups.FindOne(ctx, bson.M{
+		"invites":            bson.M{"$elemMatch": bson.M{"code": code, "expiresAt": bson.M{"$gt": now}}},
+		"metadata.isDeleted": false,
+	}).Decode(&group)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", nil, errInviteNotFound
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	if group.RoleOf(userID) != "" {
+		return "", nil, errAlreadyMember
+	}
+
+	ref, err := s.userRef(ctx, userID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if group.RequireApproval {
+		result, err := s.Groups.UpdateOne(ctx,
+			bson.M{"_id": group.ID, "joinRequests.user._id": bson.M{"$ne": userID}},
+			bson.M{"$push": bson.M{"joinRequests": types.GroupJoinRequest{User: ref, RequestedAt: now}}},
+		)
+		if err != nil {
+			return "", nil, err
+		}
+		if result.ModifiedCount > 0 {
+			go s.notifyJoinRequest(group, ref)
+		}
+		return joinStatusRequested, &group, nil
+	}
+
+	_, err = s.Groups.UpdateOne(ctx,
+		bson.M{"_id": group.ID, "members._id": bson.M{"$ne": userID}},
+		bson.M{
+			"$push": bson.M{"members": ref},
+			"$set":  bson.M{"metadata.updatedAt": now},
+		},
+	)
+	if err != nil {
+		return "", nil, err
+	}
+	s.Timeline.Cool(ctx, userID)
+	return joinStatusJoined, &group, nil
+}
+
+// GetJoinRequests returns the group's pending join requests, oldest first.
+func (s *Service) GetJoinRequests(groupID, requesterID primitive.ObjectID) ([]types.GroupJoinRequest, error) {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if !group.CanManage(requesterID) {
+		return nil, fmt.Errorf("%w: only owners and admins can see join requests", errGroupForbidden)
+	}
+	if group.JoinRequests == nil {
+		return []types.GroupJoinRequest{}, nil
+	}
+	return group.JoinRequests, nil
+}
+
+// ApproveJoinRequest lets a pending requester into the group.
+func (s *Service) ApproveJoinRequest(ctx context.Context, groupID, requesterID, userID primitive.ObjectID) error {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return err
+	}
+	if !group.CanManage(requesterID) {
+		return fmt.Errorf("%w: only owners and admins can approve join requests", errGroupForbidden)
+	}
+	var request *types.GroupJoinRequest
+	for i := range group.JoinRequests {
+		if group.JoinRequests[i].User.ID == userID {
+			request = &group.JoinRequests[i]
+		}
+	}
+	if request == nil {
+		return errRequestNotFound
+	}
+
+	update := bson.M{
+		"$pull": bson.M{"joinRequests": bson.M{"user._id": userID}},
+		"$set":  bson.M{"metadata.updatedAt": time.Now()},
+	}
+	if group.RoleOf(userID) == "" {
+		// Guarded like JoinWithInvite so a concurrent join can't add them twice.
+		withPush := bson.M{"$push": bson.M{"members": request.User}}
+		for k, v := range update {
+			withPush[k] = v
+		}
+		result, err := s.Groups.UpdateOne(ctx,
+			bson.M{"_id": groupID, "members._id": bson.M{"$ne": userID}},
+			withPush,
+		)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount > 0 {
+			s.Timeline.Cool(ctx, userID)
+			return nil
+		}
+		// They joined in the meantime; just clear the request.
+	}
+	_, err = s.Groups.UpdateOne(ctx, bson.M{"_id": groupID}, update)
+	return err
+}
+
+// DenyJoinRequest drops a pending request.
+func (s *Service) DenyJoinRequest(ctx context.Context, groupID, requesterID, userID primitive.ObjectID) error {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return err
+	}
+	if !group.CanManage(requesterID) {
+		return fmt.Errorf("%w: only owners and admins can deny join requests", errGroupForbidden)
+	}
+	result, err := s.Groups.UpdateOne(ctx, bson.M{"_id": groupID},
+		bson.M{"$pull": bson.M{"joinRequests": bson.M{"user._id": userID}}})
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount == 0 {
+		return errRequestNotFound
+	}
+	return nil
+}
+
+// notifyJoinRequest tells the owner and admins who haven't turned join
+// request notifications off. Best-effort: failures are logged.
+func (s *Service) notifyJoinRequest(group types.GroupDocument, requester types.UserExtendedReferenceInternal) {
+	ctx := context.Background()
+
+	managers := []primitive.ObjectID{}
+	for _, id := range append([]primitive.ObjectID{group.Creator}, group.Admins...) {
+		if group.NotificationSettingsFor(id).JoinRequests {
+			managers = append(managers, id)
+		}
+	}
+	if len(managers) == 0 {
+		return
+	}
+
+	content := fmt.Sprintf("asked to join %s", group.Name)
+	for _, id := range managers {
+		if err := s.Notifications.CreateNotification(
+			requester.ID, id, content, notifications.NotificationTypeGroupJoinRequest, group.ID,
+		); err != nil {
+			slog.Error("Failed to create GROUP_JOIN_REQUEST record", "receiver", id, "error", err)
+		}
+	}
+
+	cursor, err := s.Users.Find(ctx, bson.M{"_id": bson.M{"$in": managers}},
+		options.Find().SetProjection(bson.M{"push_token": 1}))
+	if err != nil {
+		slog.Error("Failed to load group managers for join request push", "groupId", group.ID.Hex(), "error", err)
+		return
+	}
+	var receivers []types.User
+	if err := cursor.All(ctx, &receivers); err != nil {
+		slog.Error("Failed to decode group managers", "groupId", group.ID.Hex(), "error", err)
+		return
+	}
+	for _, r := range receivers {
+		if r.PushToken == "" {
+			continue
+		}
+		if err := xutils.SendNotification(xutils.Notification{
+			Token:   r.PushToken,
+			Title:   "Join request",
+			Message: fmt.Sprintf("%s asked to join %s", requester.DisplayName, group.Name),
+			Data: map[string]string{
+				"type":     "group_join_request",
+				"group_id": group.ID.Hex(),
+				"user_id":  requester.ID.Hex(),
+			},
+		}); err != nil {
+			slog.Error("Failed to send group_join_request push", "receiver", r.ID, "error", err)
+		}
+	}
+}
@@ -47,7 +47,7 @@ func RegisterUpdateGroupOperation(api huma.API, handler *Handler) {
 		Method:      http.MethodPatch,
 		Path:        "/v1/user/groups/{id}",
 		Summary:     "Update group",
-		Description: "Update an existing group (creator only)",
+		Description: "Update an existing group (owner or admin)",
 		Tags:        []string{"groups"},
 	}, handler.UpdateGroupHuma)
 }
@@ -58,7 +58,7 @@ func RegisterDeleteGroupOperation(api huma.API, handler *Handler) {
 		Method:      http.MethodDelete,
 		Path:        "/v1/user/groups/{id}",
 		Summary:     "Delete group",
-		Description: "Delete an existing group (creator only)",
+		Description: "Delete an existing group (owner only)",
 		Tags:        []string{"groups"},
 	}, handler.DeleteGroupHuma)
 }
@@ -69,7 +69,7 @@ func RegisterAddMemberOperation(api huma.API, handler *Handler) {
 		Method:      http.MethodPost,
 		Path:        "/v1/user/groups/{id}/members",
 		Summary:     "Add member to group",
-		Description: "Add a member to an existing group (creator only)",
+		Description: "Add a member to an existing group (owner or admin)",
 		Tags:        []string{"groups"},
 	}, handler.AddMemberHuma)
 }
@@ -80,18 +80,223 @@ func RegisterRemoveMemberOperation(api huma.API, handler *Handler) {
 		Method:      http.MethodDelete,
 		Path:        "/v1/user/groups/{id}/members",
 		Summary:     "Remove member from group",
-		Description: "Remove a member from an existing group (creator only or self)",
+		Description: "Remove a member from an existing group (owner or admin, or self). Only the owner can remove admins, and the owner has to hand over ownership before leaving",
 		Tags:        []string{"groups"},
 	}, handler.RemoveMemberHuma)
 }
 
+func RegisterSetMemberRoleOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "set-group-member-role",
+		Method:      http.MethodPut,
+		Path:        "/v1/user/groups/{id}/members/{userId}/role",
+		Summary:     "Set member role",
+		Description: "Make a member an admin or a plain member (owner only). Making someone the owner transfers ownership and leaves the previous owner as an admin",
+		Tags:        []string{"groups"},
+	}, handler.SetMemberRoleHuma)
+}
+
+func RegisterCreateInviteOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-group-invite",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/groups/{id}/invites",
+		Summary:     "Create invite link",
+		Description: "Create an invite code that lets anyone holding it join the group until it expires (owner or admin)",
+		Tags:        []string{"groups"},
+	}, handler.CreateInviteHuma)
+}
+
+func RegisterGetInvitesOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-group-invites",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/groups/{id}/invites",
+		Summary:     "Get invite links",
+		Description: "List the group's unexpired invite codes (owner or admin)",
+		Tags:        []string{"groups"},
+	}, handler.GetInvitesHuma)
+}
+
+func RegisterRevokeInviteOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "revoke-group-invite",
+		Method:      http.MethodDelete,
+		Path:        "/v1/user/groups/{id}/invites/{code}",
+		Summary:     "Revoke invite link",
+		Description: "Revoke an invite code so it can no longer be used (owner or admin)",
+		Tags:        []string{"groups"},
+	}, handler.RevokeInviteHuma)
+}
+
+func RegisterJoinGroupOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "join-group",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/groups/join/{code}",
+		Summary:     "Join group with invite",
+		Description: "Join the group behind an invite code. Groups that require approval file a join request instead",
+		Tags:        []string{"groups"},
+	}, handler.JoinGroupHuma)
+}
+
+func RegisterGetJoinRequestsOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-group-join-requests",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/groups/{id}/requests",
+		Summary:     "Get join requests",
+		Description: "List pending requests to join the group (owner or admin)",
+		Tags:        []string{"groups"},
+	}, handler.GetJoinRequestsHuma)
+}
+
+func RegisterApproveJoinRequestOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "approve-group-join-request",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/groups/{id}/requests/{userId}",
+		Summary:     "Approve join request",
+		Description: "Approve a pending join request, adding the user as a member (owner or admin)",
+		Tags:        []string{"groups"},
+	}, handler.ApproveJoinRequestHuma)
+}
+
+func RegisterDenyJoinRequestOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "deny-group-join-request",
+		Method:      http.MethodDelete,
+		Path:        "/v1/user/groups/{id}/requests/{userId}",
+		Summary:     "Deny join request",
+		Description: "Deny a pending join request (owner or admin)",
+		Tags:        []string{"groups"},
+	}, handler.DenyJoinRequestHuma)
+}
+
+func RegisterGetGroupNotificationsOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-group-notifications",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/groups/{id}/notifications",
+		Summary:     "Get group notification settings",
+		Description: "Retrieve the caller's notification settings for a group",
+		Tags:        []string{"groups"},
+	}, handler.GetGroupNotificationsHuma)
+}
+
+func RegisterUpdateGroupNotificationsOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "update-group-notifications",
+		Method:      http.MethodPatch,
+		Path:        "/v1/user/groups/{id}/notifications",
+		Summary:     "Update group notification settings",
+		Description: "Update the caller's notification settings for a group (partial update supported)",
+		Tags:        []string{"groups"},
+	}, handler.UpdateGroupNotificationsHuma)
+}
+
+func RegisterGetGroupActivityOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-group-activity",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/groups/{id}/activity",
+		Summary:     "Get group activity",
+		Description: "Retrieve the group's activity stream, newest first: posts shared to the group, members closing all their rings, and members' public task completions",
+		Tags:        []string{"groups"},
+	}, handler.GetGroupActivityHuma)
+}
+
+func RegisterCreateChallengeOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-group-challenge",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/groups/{id}/challenges",
+		Summary:     "Create challenge",
+		Description: "Set a goal members can opt into: a total number of completions or a flex-style target per period (owner only)",
+		Tags:        []string{"groups"},
+	}, handler.CreateChallengeHuma)
+}
+
+func RegisterGetChallengesOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-group-challenges",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/groups/{id}/challenges",
+		Summary:     "Get challenges",
+		Description: "List the group's challenges with their leaderboards, newest first",
+		Tags:        []string{"groups"},
+	}, handler.GetChallengesHuma)
+}
+
+func RegisterGetChallengeOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-group-challenge",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/groups/{id}/challenges/{challengeId}",
+		Summary:     "Get challenge",
+		Description: "Retrieve a challenge and its leaderboard",
+		Tags:        []string{"groups"},
+	}, handler.GetChallengeHuma)
+}
+
+func RegisterDeleteChallengeOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-group-challenge",
+		Method:      http.MethodDelete,
+		Path:        "/v1/user/groups/{id}/challenges/{challengeId}",
+		Summary:     "Delete challenge",
+		Description: "Delete a challenge and remove its task from every participant's workspace (owner only)",
+		Tags:        []string{"groups"},
+	}, handler.DeleteChallengeHuma)
+}
+
+func RegisterJoinChallengeOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "join-group-challenge",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/groups/{id}/challenges/{challengeId}/participants",
+		Summary:     "Join challenge",
+		Description: "Opt into a challenge. Its task is added to a workspace named after the group, and completing it counts toward your progress",
+		Tags:        []string{"groups"},
+	}, handler.JoinChallengeHuma)
+}
+
+func RegisterLeaveChallengeOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "leave-group-challenge",
+		Method:      http.MethodDelete,
+		Path:        "/v1/user/groups/{id}/challenges/{challengeId}/participants",
+		Summary:     "Leave challenge",
+		Description: "Leave a challenge and remove its task from your workspace",
+		Tags:        []string{"groups"},
+	}, handler.LeaveChallengeHuma)
+}
+
 // Register all group operations
 func RegisterGroupOperations(api huma.API, handler *Handler) {
 	RegisterCreateGroupOperation(api, handler)
 	RegisterGetGroupsOperation(api, handler)
+	// Registered ahead of the /{id} routes so "join" isn't read as a group ID
+	RegisterJoinGroupOperation(api, handler)
 	RegisterGetGroupOperation(api, handler)
 	RegisterUpdateGroupOperation(api, handler)
 	RegisterDeleteGroupOperation(api, handler)
 	RegisterAddMemberOperation(api, handler)
 	RegisterRemoveMemberOperation(api, handler)
+	RegisterSetMemberRoleOperation(api, handler)
+	RegisterCreateInviteOperation(api, handler)
+	RegisterGetInvitesOperation(api, handler)
+	RegisterRevokeInviteOperation(api, handler)
+	RegisterGetJoinRequestsOperation(api, handler)
+	RegisterApproveJoinRequestOperation(api, handler)
+	RegisterDenyJoinRequestOperation(api, handler)
+	RegisterGetGroupNotificationsOperation(api, handler)
+	RegisterUpdateGroupNotificationsOperation(api, handler)
+	RegisterGetGroupActivityOperation(api, handler)
+	RegisterCreateChallengeOperation(api, handler)
+	RegisterGetChallengesOperation(api, handler)
+	RegisterGetChallengeOperation(api, handler)
+	RegisterDeleteChallengeOperation(api, handler)
+	RegisterJoinChallengeOperation(api, handler)
+	RegisterLeaveChallengeOperation(api, handler)
 }
@@ -1,12 +1,17 @@
 package Group
 
 import (
+	"github.com/abhikaboy/Kindred/internal/handlers/task"
 	"github.com/danielgtaylor/huma/v2"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
-// RegisterRoutes registers all group-related routes
-func RegisterRoutes(api huma.API, collections map[string]*mongo.Collection) {
+// RegisterRoutes registers all group-related routes. The task service puts
+// challenge tasks in participants' workspaces; the returned service records
+// their completions.
+func RegisterRoutes(api huma.API, collections map[string]*mongo.Collection, tasks *task.Service) *Service {
 	handler := NewHandler(collections)
+	handler.service.Tasks = tasks
 	RegisterGroupOperations(api, handler)
+	return handler.service
 }
@@ -2,23 +2,55 @@ package Group
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/abhikaboy/Kindred/internal/audience"
+	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/internal/timeline"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
+var (
+	errGroupForbidden   = errors.New("not allowed in this group")
+	errNotGroupMember   = errors.New("you are not a member of this group")
+	errMemberNotFound   = errors.New("user is not a member of this group")
+	errAlreadyMember    = errors.New("user is already a member of this group")
+	errOwnerCannotLeave = errors.New("the owner can't leave without handing over ownership")
+	errInvalidRole      = errors.New("invalid group role")
+)
+
 // newService receives the map of collections and picks out Groups
 func newService(collections map[string]*mongo.Collection) *Service {
 	return &Service{
-		Groups: collections["groups"],
-		Users:  collections["users"],
+		Groups:         collections["groups"],
+		Users:          collections["users"],
+		Posts:          collectionOrDerive(collections, "posts"),
+		RingStates:     collectionOrDerive(collections, "ring_states"),
+		CompletedTasks: collectionOrDerive(collections, "completed-tasks"),
+		Challenges:     collectionOrDerive(collections, "group_challenges"),
+		Notifications:  notifications.NewNotificationService(collections),
+		Audiences:      audience.New(collections),
+		Timeline:       timeline.New(collections),
 	}
 }
 
+// collectionOrDerive falls back to a handle on the same database when a
+// collection isn't in the map.
+func collectionOrDerive(collections map[string]*mongo.Collection, name string) *mongo.Collection {
+	if c := collections[name]; c != nil {
+		return c
+	}
+	if users := collections["users"]; users != nil {
+		return users.Database().Collection(name)
+	}
+	return nil
+}
+
 // NewService is the exported version for testing
 func NewService(collections map[string]*mongo.Collection) *Service {
 	return newService(collections)
@@ -93,14 +125,13 @@ func (s *Service) GetGroupByID(id primitive.ObjectID) (*types.GroupDocument, err
 func (s *Service) UpdateGroup(id primitive.ObjectID, params UpdateGroupParams, userID primitive.ObjectID) error {
 	ctx := context.Background()
 
-	// First check if user is the creator
 	group, err := s.GetGroupByID(id)
 	if err != nil {
 		return err
 	}
 
-	if group.Creator != userID {
-		return fmt.Errorf("only the creator can update the group")
+	if !group.CanManage(userID) {
+		return fmt.Errorf("%w: only owners and admins can update the group", errGroupForbidden)
 	}
 
 	updateDoc := bson.M{
@@ -109,10 +140,13 @@ func (s *Service) UpdateGroup(id primitive.ObjectID, params UpdateGroupParams, u
 		},
 	}
 
-	if params.Name != nil {
-		if setMap, ok := updateDoc["$set"].(bson.M); ok {
+	if setMap, ok := updateDoc["$set"].(bson.M); ok {
+		if params.Name != nil {
 			setMap["name"] = *params.Name
 		}
+		if params.RequireApproval != nil {
+			setMap["requireApproval"] = *params.RequireApproval
+		}
 	}
 
 	filter := bson.M{"_id": id}
@@ -124,14 +158,13 @@ func (s *Service) UpdateGroup(id primitive.ObjectID, params UpdateGroupParams, u
 func (s *Service) DeleteGroup(id primitive.ObjectID, userID primitive.ObjectID) error {
 	ctx := context.Background()
 
-	// First check if user is the creator
 	group, err := s.GetGroupByID(id)
 	if err != nil {
 		return err
 	}
 
-	if group.Creator != userID {
-		return fmt.Errorf("only the creator can delete the group")
+	if group.RoleOf(userID) != types.GroupRoleOwner {
+		return fmt.Errorf("%w: only the owner can delete the group", errGroupForbidden)
 	}
 
 	filter := bson.M{"_id": id}
@@ -142,76 +175,85 @@ func (s *Service) DeleteGroup(id primitive.ObjectID, userID primitive.ObjectID)
 		},
 	}
 
-	_, err = s.Groups.UpdateOne(ctx, filter, update)
-	return err
+	if _, err := s.Groups.UpdateOne(ctx, filter, update); err != nil {
+		return err
+	}
+	s.dropChallengeParticipants(ctx, id)
+	return nil
 }
 
 // AddMember adds a user to the group
 func (s *Service) AddMember(groupID primitive.ObjectID, userID primitive.ObjectID, requesterID primitive.ObjectID) error {
 	ctx := context.Background()
 
-	// First check if requester is the creator
 	group, err := s.GetGroupByID(groupID)
 	if err != nil {
 		return err
 	}
 
-	if group.Creator != requesterID {
-		return fmt.Errorf("only the creator can add members")
+	if !group.CanManage(requesterID) {
+		return fmt.Errorf("%w: only owners and admins can add members", errGroupForbidden)
 	}
 
-	// Check if user is already a member
-	for _, member := range group.Members {
-		if member.ID == userID {
-			return fmt.Errorf("user is already a member of this group")
-		}
+	if group.RoleOf(userID) != "" {
+		return errAlreadyMember
 	}
 
-	// Get user info
-	var user types.User
-	err = s.Users.FindOne(ctx, bson.M{"_id": userID}).Decode(&user)
+	userRef, err := s.userRef(ctx, userID)
 	if err != nil {
-		return fmt.Errorf("user not found: %w", err)
-	}
-
-	// Create user extended reference
-	userRef := types.UserExtendedReferenceInternal{
-		ID:             user.ID,
-		DisplayName:    user.DisplayName,
-		Handle:         user.Handle,
-		ProfilePicture: user.ProfilePicture,
+		return err
 	}
 
-	// Add user to members array
+	// Add user to members array, settling any request they had pending
 	filter := bson.M{"_id": groupID}
 	update := bson.M{
 		"$push": bson.M{"members": userRef},
+		"$pull": bson.M{"joinRequests": bson.M{"user._id": userID}},
 		"$set":  bson.M{"metadata.updatedAt": time.Now()},
 	}
 
-	_, err = s.Groups.UpdateOne(ctx, filter, update)
-	return err
+	if _, err := s.Groups.UpdateOne(ctx, filter, update); err != nil {
+		return err
+	}
+	s.Timeline.Cool(ctx, userID)
+	return nil
 }
 
 // RemoveMember removes a user from the group
 func (s *Service) RemoveMember(groupID primitive.ObjectID, userID primitive.ObjectID, requesterID primitive.ObjectID) error {
 	ctx := context.Background()
 
-	// First check if requester is the creator or the user themselves
 	group, err := s.GetGroupByID(groupID)
 	if err != nil {
 		return err
 	}
 
-	if group.Creator != requesterID && userID != requesterID {
-		return fmt.Errorf("only the creator or the user themselves can remove members")
+	// Anyone but the owner may leave. Admins remove members; only the owner
+	// removes admins, and nobody removes the owner.
+	switch target := group.RoleOf(userID); {
+	case target == "":
+		return errMemberNotFound
+	case target == types.GroupRoleOwner && userID == requesterID:
+		return errOwnerCannotLeave
+	case target == types.GroupRoleOwner:
+		return fmt.Errorf("%w: the owner can't be removed", errGroupForbidden)
+	case userID == requesterID:
+		// Leaving
+	case target == types.GroupRoleAdmin && group.RoleOf(requesterID) != types.GroupRoleOwner:
+		return fmt.Errorf("%w: only the owner can remove an admin", errGroupForbidden)
+	case !group.CanManage(requesterID):
+		return fmt.Errorf("%w: only owners and admins can remove members", errGroupForbidden)
 	}
 
-	// Remove user from members array
+	// Remove user from members array along with their role and settings
 	filter := bson.M{"_id": groupID}
 	update := bson.M{
-		"$pull": bson.M{"members": bson.M{"_id": userID}},
-		"$set":  bson.M{"metadata.updatedAt": time.Now()},
+		"$pull": bson.M{
+			"members": bson.M{"_id": userID},
+			"admins":  userID,
+		},
+		"$unset": bson.M{"notifications." + userID.Hex(): ""},
+		"$set":   bson.M{"metadata.updatedAt": time.Now()},
 	}
 
 	result, err := s.Groups.UpdateOne(ctx, filter, update)
@@ -220,12 +262,141 @@ func (s *Service) RemoveMember(groupID primitive.ObjectID, userID primitive.Obje
 	}
 
 	if result.ModifiedCount == 0 {
-		return fmt.Errorf("user is not a member of this group")
+		return errMemberNotFound
 	}
 
+	s.dropChallengeParticipants(ctx, groupID, userID)
 	return nil
 }
 
+// SetMemberRole changes a member's role. Only the owner may; making someone
+// else the owner hands the group over and leaves the old owner an admin.
+func (s *Service) SetMemberRole(ctx context.Context, groupID, userID primitive.ObjectID, role string, requesterID primitive.ObjectID) (*types.GroupDocument, error) {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if group.RoleOf(requesterID) != types.GroupRoleOwner {
+		return nil, fmt.Errorf("%w: only the owner can change roles", errGroupForbidden)
+	}
+	if group.RoleOf(userID) == "" {
+		return nil, errMemberNotFound
+	}
+	if userID == requesterID {
+		return nil, fmt.Errorf("%w: make another member the owner instead", errInvalidRole)
+	}
+
+	now := time.Now()
+	switch role {
+	case types.GroupRoleAdmin:
+		_, err = s.Groups.UpdateOne(ctx, bson.M{"_id": groupID}, bson.M{
+			"$addToSet": bson.M{"admins": userID},
+			"$set":      bson.M{"metadata.updatedAt": now},
+		})
+	case types.GroupRoleMember:
+		_, err = s.Groups.UpdateOne(ctx, bson.M{"_id": groupID}, bson.M{
+			"$pull": bson.M{"admins": userID},
+			"$set":  bson.M{"metadata.updatedAt": now},
+		})
+	case types.GroupRoleOwner:
+		err = s.transferOwnership(ctx, group, userID)
+	default:
+		return nil, errInvalidRole
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.GetGroupByID(groupID)
+}
+
+// transferOwnership makes newOwner the owner. The old owner becomes an admin
+// and, if they were only ever the creator, a listed member.
+func (s *Service) transferOwnership(ctx context.Context, group *types.GroupDocument, newOwner primitive.ObjectID) error {
+	oldOwner := group.Creator
+	result, err := s.Groups.UpdateOne(ctx,
+		bson.M{"_id": group.ID, "creator": oldOwner},
+		bson.M{
+			"$set":  bson.M{"creator": newOwner, "metadata.updatedAt": time.Now()},
+			"$pull": bson.M{"admins": newOwner},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount == 0 {
+		return fmt.Errorf("%w: ownership has already changed", errGroupForbidden)
+	}
+
+	update := bson.M{"$addToSet": bson.M{"admins": oldOwner}}
+	listed := false
+	for _, m := range group.Members {
+		listed = listed || m.ID == oldOwner
+	}
+	if !listed {
+		ref, err := s.userRef(ctx, oldOwner)
+		if err != nil {
+			return err
+		}
+		update["$push"] = bson.M{"members": ref}
+	}
+	_, err = s.Groups.UpdateOne(ctx, bson.M{"_id": group.ID}, update)
+	return err
+}
+
+// userRef loads the denormalized member entry for id.
+func (s *Service) userRef(ctx context.Context, id primitive.ObjectID) (types.UserExtendedReferenceInternal, error) {
+	var user types.User
+	if err := s.Users.FindOne(ctx, bson.M{"_id": id}).Decode(&user); err != nil {
+		return types.UserExtendedReferenceInternal{}, fmt.Errorf("user not found: %w", err)
+	}
+	return types.UserExtendedReferenceInternal{
+		ID:             user.ID,
+		DisplayName:    user.DisplayName,
+		Handle:         user.Handle,
+		ProfilePicture: user.ProfilePicture,
+	}, nil
+}
+
+// GetNotificationSettings returns userID's notification settings for a group.
+func (s *Service) GetNotificationSettings(groupID, userID primitive.ObjectID) (*types.GroupNotificationSettings, error) {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.RoleOf(userID) == "" {
+		return nil, errNotGroupMember
+	}
+	settings := group.NotificationSettingsFor(userID)
+	return &settings, nil
+}
+
+// UpdateNotificationSettings changes the fields set in params and returns the
+// result.
+func (s *Service) UpdateNotificationSettings(ctx context.Context, groupID, userID primitive.ObjectID, params UpdateGroupNotificationsParams) (*types.GroupNotificationSettings, error) {
+	settings, err := s.GetNotificationSettings(groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if params.Posts != nil {
+		settings.Posts = *params.Posts
+	}
+	if params.Activity != nil {
+		settings.Activity = *params.Activity
+	}
+	if params.JoinRequests != nil {
+		settings.JoinRequests = *params.JoinRequests
+	}
+
+	_, err = s.Groups.UpdateOne(ctx, bson.M{"_id": groupID}, bson.M{
+		"$set": bson.M{"notifications." + userID.Hex(): settings},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
 // IsUserInGroup checks if a user is a member or creator of a group
 func (s *Service) IsUserInGroup(groupID primitive.ObjectID, userID primitive.ObjectID) (bool, error) {
 	ctx := context.Background()
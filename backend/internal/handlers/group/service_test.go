@@ -1,11 +1,16 @@
 package Group
 
 import (
+	"context"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/abhikaboy/Kindred/internal/handlers/task"
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
 	testpkg "github.com/abhikaboy/Kindred/internal/testing"
 	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -314,3 +319,90 @@ func (s *GroupServiceTestSuite) TestIsUserInGroup_False() {
 	s.NoError(err)
 	s.False(isMember)
 }
+
+func (s *GroupServiceTestSuite) TestRecordChallengeCompletion_Concurrent() {
+	ctx := context.Background()
+	user := s.GetUser(0)
+	start := time.Now().Add(-time.Hour)
+	challenge := types.GroupChallengeDocument{
+		ID:        primitive.NewObjectID(),
+		GroupID:   primitive.NewObjectID(),
+		CreatedBy: user.ID,
+		Title:     "Concurrent completions",
+		Goal:      types.ChallengeGoal{Target: 100},
+		StartDate: start,
+		EndDate:   start.Add(24 * time.Hour),
+		Participants: []types.ChallengeParticipant{
+			{User: types.UserExtendedReferenceInternal{ID: user.ID}, JoinedAt: start},
+		},
+		Metadata: types.NewGroupMetadata(),
+	}
+	_, err := s.service.Challenges.InsertOne(ctx, challenge)
+	s.Require().NoError(err)
+
+	const completions = maxCompletionAttempts
+	var wg sync.WaitGroup
+	errs := make(chan error, completions)
+	for i := 0; i < completions; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- s.service.RecordChallengeCompletion(ctx, challenge.ID, user.ID, time.Now())
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		s.NoError(err)
+	}
+
+	var stored types.GroupChallengeDocument
+	s.Require().NoError(s.service.Challenges.FindOne(ctx, bson.M{"_id": challenge.ID}).Decode(&stored))
+	s.Require().Len(stored.Participants, 1)
+	s.Equal(completions, stored.Participants[0].Completions, "no concurrent completion should be lost")
+}
+
+func (s *GroupServiceTestSuite) TestRemoveMember_DropsChallengeParticipation() {
+	ctx := context.Background()
+	owner, member := s.GetUser(0), s.GetUser(1)
+	s.service.Tasks = task.NewService(s.Collections)
+	group := s.createTestGroup(owner, []*types.User{owner, member})
+
+	now := time.Now()
+	challenge := types.GroupChallengeDocument{
+		ID:        primitive.NewObjectID(),
+		GroupID:   group.ID,
+		CreatedBy: owner.ID,
+		Title:     "Run",
+		Goal:      types.ChallengeGoal{Target: 10},
+		StartDate: now,
+		EndDate:   now.Add(7 * 24 * time.Hour),
+		Participants: []types.ChallengeParticipant{
+			{User: types.UserExtendedReferenceInternal{ID: owner.ID}, JoinedAt: now},
+			{User: types.UserExtendedReferenceInternal{ID: member.ID}, JoinedAt: now},
+		},
+		Metadata: types.NewGroupMetadata(),
+	}
+	_, err := s.service.Challenges.InsertOne(ctx, challenge)
+	s.Require().NoError(err)
+	for _, u := range []*types.User{owner, member} {
+		_, err := s.Collections["categories"].InsertOne(ctx, types.CategoryDocument{
+			ID: primitive.NewObjectID(), Name: "Run", User: u.ID, ChallengeID: &challenge.ID, Tasks: []types.TaskDocument{},
+		})
+		s.Require().NoError(err)
+	}
+
+	s.Require().NoError(s.service.RemoveMember(group.ID, member.ID, member.ID))
+
+	var stored types.GroupChallengeDocument
+	s.Require().NoError(s.service.Challenges.FindOne(ctx, bson.M{"_id": challenge.ID}).Decode(&stored))
+	s.Require().Len(stored.Participants, 1)
+	s.Equal(owner.ID, stored.Participants[0].User.ID)
+
+	left, err := s.Collections["categories"].CountDocuments(ctx, bson.M{"user": member.ID, "challengeId": challenge.ID})
+	s.Require().NoError(err)
+	s.Zero(left, "the leaver's challenge category should be removed")
+	kept, err := s.Collections["categories"].CountDocuments(ctx, bson.M{"user": owner.ID, "challengeId": challenge.ID})
+	s.Require().NoError(err)
+	s.Equal(int64(1), kept)
+}
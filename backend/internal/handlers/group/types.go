@@ -1,7 +1,13 @@
 package Group
 
 import (
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/audience"
+	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
+	"github.com/abhikaboy/Kindred/internal/handlers/task"
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/internal/timeline"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
@@ -55,7 +61,8 @@ type UpdateGroupOutput struct {
 }
 
 type UpdateGroupParams struct {
-	Name *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Name            *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	RequireApproval *bool   `json:"requireApproval,omitempty" doc:"Whether joining through an invite link needs an owner or admin to approve"`
 }
 
 // Delete Group
@@ -104,8 +111,232 @@ type RemoveMemberParams struct {
 	UserID string `json:"userId" validate:"required,len=24"`
 }
 
+// Set Member Role
+type SetMemberRoleInput struct {
+	Authorization string              `header:"Authorization" required:"true"`
+	ID            string              `path:"id" example:"507f1f77bcf86cd799439011"`
+	UserID        string              `path:"userId" example:"507f1f77bcf86cd799439011"`
+	Body          SetMemberRoleParams `json:"body"`
+}
+
+type SetMemberRoleParams struct {
+	Role string `json:"role" enum:"owner,admin,member" doc:"New role; making someone the owner hands over ownership and makes you an admin"`
+}
+
+type SetMemberRoleOutput struct {
+	Body types.GroupDocumentAPI `json:"body"`
+}
+
+// Create Invite
+type CreateInviteInput struct {
+	Authorization string             `header:"Authorization" required:"true"`
+	ID            string             `path:"id" example:"507f1f77bcf86cd799439011"`
+	Body          CreateInviteParams `json:"body"`
+}
+
+type CreateInviteParams struct {
+	ExpiresInHours int `json:"expiresInHours,omitempty" minimum:"1" maximum:"720" example:"72" doc:"How long the link works; defaults to three days"`
+}
+
+type CreateInviteOutput struct {
+	Body types.GroupInvite `json:"body"`
+}
+
+// Get Invites
+type GetInvitesInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	ID            string `path:"id" example:"507f1f77bcf86cd799439011"`
+}
+
+type GetInvitesOutput struct {
+	Body struct {
+		Invites []types.GroupInvite `json:"invites"`
+	} `json:"body"`
+}
+
+// Revoke Invite
+type RevokeInviteInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	ID            string `path:"id" example:"507f1f77bcf86cd799439011"`
+	Code          string `path:"code" example:"K7PQ2M4X"`
+}
+
+type RevokeInviteOutput struct {
+	Body struct {
+		Message string `json:"message" example:"Invite revoked successfully"`
+	} `json:"body"`
+}
+
+// Join Group
+type JoinGroupInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	Code          string `path:"code" example:"K7PQ2M4X"`
+}
+
+type JoinGroupOutput struct {
+	Body struct {
+		Status    string `json:"status" enum:"joined,requested" doc:"requested when the group approves new members first"`
+		GroupID   string `json:"groupId"`
+		GroupName string `json:"groupName"`
+	} `json:"body"`
+}
+
+// Get Join Requests
+type GetJoinRequestsInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	ID            string `path:"id" example:"507f1f77bcf86cd799439011"`
+}
+
+type GetJoinRequestsOutput struct {
+	Body struct {
+		Requests []GroupJoinRequestAPI `json:"requests"`
+	} `json:"body"`
+}
+
+type GroupJoinRequestAPI struct {
+	User        types.UserExtendedReference `json:"user"`
+	RequestedAt time.Time                   `json:"requestedAt"`
+}
+
+// Approve or Deny Join Request
+type JoinRequestInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	ID            string `path:"id" example:"507f1f77bcf86cd799439011"`
+	UserID        string `path:"userId" example:"507f1f77bcf86cd799439011"`
+}
+
+type JoinRequestOutput struct {
+	Body struct {
+		Message string `json:"message" example:"Join request approved"`
+	} `json:"body"`
+}
+
+// Group Notification Settings
+type GetGroupNotificationsInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	ID            string `path:"id" example:"507f1f77bcf86cd799439011"`
+}
+
+type UpdateGroupNotificationsInput struct {
+	Authorization string                         `header:"Authorization" required:"true"`
+	ID            string                         `path:"id" example:"507f1f77bcf86cd799439011"`
+	Body          UpdateGroupNotificationsParams `json:"body"`
+}
+
+// UpdateGroupNotificationsParams is a partial update: an omitted field is left alone.
+type UpdateGroupNotificationsParams struct {
+	Posts        *bool `json:"posts,omitempty"`
+	Activity     *bool `json:"activity,omitempty"`
+	JoinRequests *bool `json:"joinRequests,omitempty"`
+}
+
+type GroupNotificationsOutput struct {
+	Body types.GroupNotificationSettings `json:"body"`
+}
+
+// Group Activity
+type GetGroupActivityInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	ID            string `path:"id" example:"507f1f77bcf86cd799439011"`
+	Before        string `query:"before" doc:"RFC3339 timestamp; only activity before it is returned. Pass the previous page's nextBefore."`
+	Limit         int    `query:"limit" default:"20" minimum:"1" maximum:"50"`
+}
+
+type GetGroupActivityOutput struct {
+	Body struct {
+		Items      []GroupActivityItem `json:"items"`
+		NextBefore string              `json:"nextBefore,omitempty" doc:"Cursor for the next page; empty on the last page"`
+	} `json:"body"`
+}
+
+// GroupActivityItem is one thing that happened in a group: a post shared with
+// it, a member closing all their rings, or a member finishing a shared task.
+type GroupActivityItem struct {
+	ID      string                      `json:"id" doc:"The post, ring state or completed task ID"`
+	Type    string                      `json:"type" enum:"post,rings_closed,task_completed"`
+	At      time.Time                   `json:"at"`
+	User    types.UserExtendedReference `json:"user"`
+	Content string                      `json:"content" doc:"The post's caption or what the member did"`
+	Images  []string                    `json:"images,omitempty"`
+}
+
+// Challenges
+type CreateChallengeInput struct {
+	Authorization string                `header:"Authorization" required:"true"`
+	ID            string                `path:"id" example:"507f1f77bcf86cd799439011"`
+	Body          CreateChallengeParams `json:"body"`
+}
+
+type CreateChallengeParams struct {
+	Title       string              `json:"title" validate:"required,min=1,max=100" example:"30 workouts in March"`
+	Description string              `json:"description,omitempty" validate:"omitempty,max=500"`
+	Goal        types.ChallengeGoal `json:"goal"`
+	StartDate   *time.Time          `json:"startDate,omitempty" doc:"Defaults to now"`
+	EndDate     time.Time           `json:"endDate" validate:"required"`
+}
+
+type ChallengeInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	ID            string `path:"id" example:"507f1f77bcf86cd799439011"`
+	ChallengeID   string `path:"challengeId" example:"507f1f77bcf86cd799439011"`
+}
+
+type ChallengeOutput struct {
+	Body GroupChallengeAPI `json:"body"`
+}
+
+type GetChallengesInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	ID            string `path:"id" example:"507f1f77bcf86cd799439011"`
+}
+
+type GetChallengesOutput struct {
+	Body struct {
+		Challenges []GroupChallengeAPI `json:"challenges"`
+	} `json:"body"`
+}
+
+type ChallengeMessageOutput struct {
+	Body struct {
+		Message string `json:"message" example:"Left challenge"`
+	} `json:"body"`
+}
+
+// GroupChallengeAPI is a challenge with its leaderboard.
+type GroupChallengeAPI struct {
+	ID           string              `json:"id"`
+	GroupID      string              `json:"groupId"`
+	CreatedBy    string              `json:"createdBy"`
+	Title        string              `json:"title"`
+	Description  string              `json:"description,omitempty"`
+	Goal         types.ChallengeGoal `json:"goal"`
+	StartDate    time.Time           `json:"startDate"`
+	EndDate      time.Time           `json:"endDate"`
+	TotalPeriods int                 `json:"totalPeriods,omitempty" doc:"Periods the challenge spans, for flex goals"`
+	Joined       bool                `json:"joined" doc:"Whether the caller is taking part"`
+	Leaderboard  []ChallengeStanding `json:"leaderboard"`
+}
+
+// ChallengeStanding is one participant's place on a challenge's leaderboard.
+type ChallengeStanding struct {
+	Rank        int                         `json:"rank"`
+	User        types.UserExtendedReference `json:"user"`
+	Completions int                         `json:"completions"`
+	PeriodsMet  int                         `json:"periodsMet" doc:"Periods the flex target was met in; always 0 for total goals"`
+	Progress    float64                     `json:"progress" doc:"Share of the goal reached, from 0 to 1"`
+	FinishedAt  *time.Time                  `json:"finishedAt,omitempty"`
+}
+
 // Service
 type Service struct {
-	Groups *mongo.Collection
-	Users  *mongo.Collection
+	Groups         *mongo.Collection
+	Users          *mongo.Collection
+	Posts          *mongo.Collection
+	RingStates     *mongo.Collection
+	CompletedTasks *mongo.Collection
+	Challenges     *mongo.Collection
+	Notifications  *notifications.Service
+	Audiences      *audience.Service
+	Timeline       *timeline.Service
+	Tasks          *task.Service // materializes challenge tasks; set by RegisterRoutes
 }
@@ -37,6 +37,15 @@ const (
 	// send kudos to a friend. The `user` on the document is the friend the kudos
 	// would be for, not the person who did anything to the receiver.
 	NotificationTypeKudosSuggestion NotificationType = "KUDOS_SUGGESTION"
+	// NotificationTypeGroupJoinRequest goes to a group's owner and admins; its
+	// reference is the group.
+	NotificationTypeGroupJoinRequest NotificationType = "GROUP_JOIN_REQUEST"
+	// NotificationTypeGroupActivity tells group members that another member
+	// finished a shared task; its reference is the task.
+	NotificationTypeGroupActivity NotificationType = "GROUP_ACTIVITY"
+	// NotificationTypeChallengeCompleted tells group members that another
+	// member reached a challenge's goal; its reference is the challenge.
+	NotificationTypeChallengeCompleted NotificationType = "CHALLENGE_COMPLETED"
 )
 
 // NotificationDocument represents a notification stored in the database
@@ -0,0 +1,199 @@
+package Post
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/abhikaboy/Kindred/internal/audience"
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/internal/timeline"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var errInvalidAudience = errors.New("invalid audience")
+
+// parse converts an audience request, checking that the lists its type needs
+// are there.
+func (in AudienceInput) parse() (types.PostAudience, error) {
+	a := types.PostAudience{Type: in.Type}
+	switch in.Type {
+	case types.AudiencePublic, types.AudienceFriends, types.AudienceCloseFriends, types.AudienceOnlyMe:
+	case types.AudienceGroups:
+		for _, g := range in.Groups {
+			id, err := primitive.ObjectIDFromHex(g)
+			if err != nil {
+				return a, fmt.Errorf("%w: %w", errInvalidGroupID, err)
+			}
+			a.Groups = append(a.Groups, id)
+		}
+		if len(a.Groups) == 0 {
+			return a, fmt.Errorf("%w: a groups audience needs at least one group", errInvalidAudience)
+		}
+	case types.AudienceUsers:
+		for _, u := range in.Users {
+			id, err := primitive.ObjectIDFromHex(u)
+			if err != nil {
+				return a, fmt.Errorf("%w: invalid user ID %q", errInvalidAudience, u)
+			}
+			a.Users = append(a.Users, id)
+		}
+		if len(a.Users) == 0 {
+			return a, fmt.Errorf("%w: a users audience needs at least one user", errInvalidAudience)
+		}
+	case types.AudienceLists:
+		for _, l := range in.Lists {
+			id, err := primitive.ObjectIDFromHex(l)
+			if err != nil {
+				return a, fmt.Errorf("%w: invalid list ID %q", errInvalidAudience, l)
+			}
+			a.Lists = append(a.Lists, id)
+		}
+		if len(a.Lists) == 0 {
+			return a, fmt.Errorf("%w: a lists audience needs at least one list", errInvalidAudience)
+		}
+	default:
+		return a, fmt.Errorf("%w: unknown type %q", errInvalidAudience, in.Type)
+	}
+	return a, nil
+}
+
+// pickAudience decides a new post's audience: the one asked for, else the
+// legacy groups list, else the author's default, else the public flag.
+func pickAudience(params CreatePostParams, privacy *types.PrivacySettings) (types.PostAudience, error) {
+	switch {
+	case params.Audience != nil:
+		return params.Audience.parse()
+	case len(params.Groups) > 0:
+		return AudienceInput{Type: types.AudienceGroups, Groups: params.Groups}.parse()
+	case privacy != nil && privacy.DefaultAudience.Type != "":
+		return privacy.DefaultAudience, nil
+	case params.IsPublic:
+		return types.PostAudience{Type: types.AudiencePublic}, nil
+	default:
+		return types.PostAudience{Type: types.AudienceFriends}, nil
+	}
+}
+
+// onlyFriends keeps the users in a users audience who are the author's
+// friends, so a post can't be pushed at strangers.
+func onlyFriends(a types.PostAudience, friends []primitive.ObjectID) (types.PostAudience, error) {
+	if a.Type != types.AudienceUsers {
+		return a, nil
+	}
+	isFriend := make(map[primitive.ObjectID]bool, len(friends))
+	for _, id := range friends {
+		isFriend[id] = true
+	}
+	kept := make([]primitive.ObjectID, 0, len(a.Users))
+	for _, id := range a.Users {
+		if isFriend[id] {
+			kept = append(kept, id)
+		}
+	}
+	if len(kept) == 0 {
+		return a, fmt.Errorf("%w: none of the chosen users are your friends", errInvalidAudience)
+	}
+	a.Users = kept
+	return a, nil
+}
+
+// checkAudience narrows a to what author may address: chosen users must be
+// friends, chosen lists must be the author's own and chosen groups ones the
+// author belongs to.
+func (s *Service) checkAudience(ctx context.Context, author primitive.ObjectID, friends []primitive.ObjectID, a types.PostAudience) (types.PostAudience, error) {
+	a, err := onlyFriends(a, friends)
+	if err != nil {
+		return a, err
+	}
+	switch a.Type {
+	case types.AudienceLists:
+		owns, err := s.Audiences.OwnsLists(ctx, author, a.Lists)
+		if err != nil {
+			return a, err
+		}
+		if !owns {
+			return a, fmt.Errorf("%w: %w", errInvalidAudience, audience.ErrListNotFound)
+		}
+	case types.AudienceGroups:
+		member, err := s.Audiences.MemberOfGroups(ctx, author, a.Groups)
+		if err != nil {
+			return a, err
+		}
+		if !member {
+			return a, fmt.Errorf("%w: %w", errInvalidAudience, audience.ErrNotGroupMember)
+		}
+	}
+	return a, nil
+}
+
+// applyAudience sets a post's audience and keeps the fields older clients
+// and queries read, groups and the public flag, in step with it.
+func applyAudience(doc *types.PostDocument, a types.PostAudience) {
+	doc.Audience = &a
+	doc.Groups = nil
+	if a.Type == types.AudienceGroups {
+		doc.Groups = a.Groups
+	}
+	doc.Metadata.IsPublic = a.Type == types.AudiencePublic
+}
+
+// audienceUpdate is applyAudience as an update document.
+func audienceUpdate(a types.PostAudience) bson.M {
+	set := bson.M{
+		"audience":          a,
+		"metadata.isPublic": a.Type == types.AudiencePublic,
+	}
+	if a.Type == types.AudienceGroups {
+		set["groups"] = a.Groups
+		return bson.M{"$set": set}
+	}
+	return bson.M{"$set": set, "$unset": bson.M{"groups": ""}}
+}
+
+// SetPostAudience changes who can see a post and re-files it in timelines so
+// viewers who lost access drop it and new ones receive it.
+func (s *Service) SetPostAudience(ctx context.Context, post *types.PostDocument, a types.PostAudience) error {
+	var author struct {
+		Friends []primitive.ObjectID `bson:"friends"`
+	}
+	if err := s.Users.FindOne(ctx, bson.M{"_id": post.User.ID}).Decode(&author); err != nil {
+		return fmt.Errorf("%w: %w", errPostAuthorNotFound, err)
+	}
+	a, err := s.checkAudience(ctx, post.User.ID, author.Friends, a)
+	if err != nil {
+		return err
+	}
+	if _, err := s.Posts.UpdateOne(ctx, bson.M{"_id": post.ID}, audienceUpdate(a)); err != nil {
+		return err
+	}
+
+	go s.Timeline.Refile(context.Background(), timeline.Event{
+		Kind:          timeline.KindPost,
+		Ref:           post.ID,
+		Author:        post.User.ID,
+		At:            post.Metadata.CreatedAt,
+		Audience:      &a,
+		IncludeAuthor: true,
+	})
+	return nil
+}
+
+// GetVisiblePost returns a live post if viewerID may see it, and
+// mongo.ErrNoDocuments otherwise so a hidden post reads as missing.
+func (s *Service) GetVisiblePost(ctx context.Context, viewerID, id primitive.ObjectID) (*types.PostDocument, error) {
+	viewer, err := s.Audiences.Viewer(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	var post types.PostDocument
+	err = s.Posts.FindOne(ctx, bson.M{"$and": bson.A{
+		bson.M{"_id": id, "metadata.isDeleted": false},
+		viewer.Filter(),
+	}}).Decode(&post)
+	if err != nil {
+		return nil, err
+	}
+	return &post, nil
+}
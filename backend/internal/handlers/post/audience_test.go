@@ -0,0 +1,99 @@
+package Post
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestAudienceInputParse(t *testing.T) {
+	id := "507f1f77bcf86cd799439011"
+	cases := []struct {
+		name string
+		in   AudienceInput
+		err  error
+	}{
+		{"public", AudienceInput{Type: types.AudiencePublic}, nil},
+		{"only me", AudienceInput{Type: types.AudienceOnlyMe}, nil},
+		{"groups", AudienceInput{Type: types.AudienceGroups, Groups: []string{id}}, nil},
+		{"groups without a group", AudienceInput{Type: types.AudienceGroups}, errInvalidAudience},
+		{"bad group ID", AudienceInput{Type: types.AudienceGroups, Groups: []string{"nope"}}, errInvalidGroupID},
+		{"users", AudienceInput{Type: types.AudienceUsers, Users: []string{id}}, nil},
+		{"users without a user", AudienceInput{Type: types.AudienceUsers}, errInvalidAudience},
+		{"bad user ID", AudienceInput{Type: types.AudienceUsers, Users: []string{"nope"}}, errInvalidAudience},
+		{"lists", AudienceInput{Type: types.AudienceLists, Lists: []string{id}}, nil},
+		{"lists without a list", AudienceInput{Type: types.AudienceLists}, errInvalidAudience},
+		{"bad list ID", AudienceInput{Type: types.AudienceLists, Lists: []string{"nope"}}, errInvalidAudience},
+		{"unknown type", AudienceInput{Type: "everyone"}, errInvalidAudience},
+	}
+	for _, c := range cases {
+		_, err := c.in.parse()
+		if c.err == nil && err != nil {
+			t.Errorf("%s: err = %v, want ok", c.name, err)
+		}
+		if c.err != nil && !errors.Is(err, c.err) {
+			t.Errorf("%s: err = %v, want %v", c.name, err, c.err)
+		}
+	}
+}
+
+func TestPickAudience(t *testing.T) {
+	group := "507f1f77bcf86cd799439011"
+	closeFriends := &types.PrivacySettings{DefaultAudience: types.PostAudience{Type: types.AudienceCloseFriends}}
+	cases := []struct {
+		name    string
+		params  CreatePostParams
+		privacy *types.PrivacySettings
+		want    string
+	}{
+		{"explicit audience beats everything", CreatePostParams{Audience: &AudienceInput{Type: types.AudienceOnlyMe}, IsPublic: true, Groups: []string{group}}, closeFriends, types.AudienceOnlyMe},
+		{"legacy groups beat the default", CreatePostParams{Groups: []string{group}}, closeFriends, types.AudienceGroups},
+		{"default beats the public flag", CreatePostParams{IsPublic: true}, closeFriends, types.AudienceCloseFriends},
+		{"public flag without a default", CreatePostParams{IsPublic: true}, nil, types.AudiencePublic},
+		{"friends otherwise", CreatePostParams{}, &types.PrivacySettings{}, types.AudienceFriends},
+	}
+	for _, c := range cases {
+		got, err := pickAudience(c.params, c.privacy)
+		if err != nil || got.Type != c.want {
+			t.Errorf("%s: got %q, %v; want %q", c.name, got.Type, err, c.want)
+		}
+	}
+}
+
+func TestOnlyFriends(t *testing.T) {
+	friend, stranger := primitive.NewObjectID(), primitive.NewObjectID()
+	friends := []primitive.ObjectID{friend}
+
+	a, err := onlyFriends(types.PostAudience{Type: types.AudienceUsers, Users: []primitive.ObjectID{stranger, friend}}, friends)
+	if err != nil || len(a.Users) != 1 || a.Users[0] != friend {
+		t.Errorf("mixed users = %+v, %v; want only the friend", a.Users, err)
+	}
+	if _, err := onlyFriends(types.PostAudience{Type: types.AudienceUsers, Users: []primitive.ObjectID{stranger}}, friends); !errors.Is(err, errInvalidAudience) {
+		t.Errorf("strangers only err = %v, want errInvalidAudience", err)
+	}
+	if _, err := onlyFriends(types.PostAudience{Type: types.AudiencePublic}, nil); err != nil {
+		t.Errorf("public audience err = %v, want it untouched", err)
+	}
+}
+
+func TestApplyAudience(t *testing.T) {
+	group := primitive.NewObjectID()
+	doc := types.PostDocument{Groups: []primitive.ObjectID{primitive.NewObjectID()}}
+
+	applyAudience(&doc, types.PostAudience{Type: types.AudiencePublic})
+	if !doc.Metadata.IsPublic || doc.Groups != nil {
+		t.Errorf("public: isPublic = %v, groups = %v; want true and none", doc.Metadata.IsPublic, doc.Groups)
+	}
+	applyAudience(&doc, types.PostAudience{Type: types.AudienceGroups, Groups: []primitive.ObjectID{group}})
+	if doc.Metadata.IsPublic || len(doc.Groups) != 1 || doc.Groups[0] != group {
+		t.Errorf("groups: isPublic = %v, groups = %v; want false and the group", doc.Metadata.IsPublic, doc.Groups)
+	}
+
+	update := audienceUpdate(types.PostAudience{Type: types.AudienceFriends})
+	if _, ok := update["$unset"].(bson.M)["groups"]; !ok {
+		t.Errorf("friends update = %v, want groups unset", update)
+	}
+}
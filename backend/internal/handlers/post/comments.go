@@ -0,0 +1,627 @@
+package Post
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/friendship"
+	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// commentsCollection holds one document per comment. Posts keep only the
+// latest commentPreviewSize as a preview so feed payloads stay small.
+const (
+	commentsCollection = "comments"
+	commentPreviewSize = 3
+)
+
+var (
+	errCommentNotFound      = errors.New("comment not found")
+	errCommentForbidden     = errors.New("only the author can edit a comment")
+	errCommentNotPending    = errors.New("comment is not awaiting approval")
+	errInvalidCommentCursor = errors.New("invalid comment cursor")
+)
+
+// encodeCommentCursor and decodeCommentCursor wrap the last comment of a page.
+func encodeCommentCursor(pos FeedPosition) string {
+	raw, _ := json.Marshal(pos)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCommentCursor(s string) (*FeedPosition, error) {
+	if s == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errInvalidCommentCursor
+	}
+	var pos FeedPosition
+	if err := json.Unmarshal(raw, &pos); err != nil || pos.ID.IsZero() {
+		return nil, errInvalidCommentCursor
+	}
+	return &pos, nil
+}
+
+// commentThreads assigns each embedded comment its thread root and counts
+// replies per root, for migrating a post's legacy comments array. Replies
+// whose parent is gone become roots.
+func commentThreads(comments []types.CommentDocument) []types.CommentDocument {
+	byID := make(map[primitive.ObjectID]*types.CommentDocument, len(comments))
+	out := make([]types.CommentDocument, len(comments))
+	copy(out, comments)
+	for i := range out {
+		byID[out[i].ID] = &out[i]
+	}
+
+	root := func(c *types.CommentDocument) *types.CommentDocument {
+		seen := map[primitive.ObjectID]bool{}
+		for c.ParentID != nil && !seen[c.ID] {
+			seen[c.ID] = true
+			parent, ok := byID[*c.ParentID]
+			if !ok {
+				break
+			}
+			c = parent
+		}
+		return c
+	}
+
+	for i := range out {
+		out[i].ThreadID = nil
+		out[i].ReplyCount = 0
+	}
+	for i := range out {
+		if out[i].ParentID == nil {
+			continue
+		}
+		r := root(&out[i])
+		if r.ID == out[i].ID {
+			continue
+		}
+		id := r.ID
+		out[i].ThreadID = &id
+		r.ReplyCount++
+	}
+	return out
+}
+
+// commentPreview returns the latest n comments, oldest first, matching the
+// order $push with $slice keeps.
+func commentPreview(comments []types.CommentDocument, n int) []types.CommentDocument {
+	sorted := make([]types.CommentDocument, len(comments))
+	copy(sorted, comments)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Metadata.CreatedAt.Before(sorted[j].Metadata.CreatedAt)
+	})
+	if len(sorted) > n {
+		sorted = sorted[len(sorted)-n:]
+	}
+	return sorted
+}
+
+// MigratePostComments moves a post's embedded comments into the comments
+// collection and shrinks the embedded array to a preview. Safe to repeat:
+// comments upsert by _id and the post is only rewritten while it still has
+// no commentCount. Returns how many comments were moved.
+func (s *Service) MigratePostComments(ctx context.Context, post *types.PostDocument) (int, error) {
+	if len(post.Comments) == 0 {
+		return 0, nil
+	}
+	comments := commentThreads(post.Comments)
+	models := make([]mongo.WriteModel, len(comments))
+	for i := range comments {
+		comments[i].PostID = post.ID
+		models[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": comments[i].ID}).
+			SetUpdate(bson.M{"$setOnInsert": comments[i]}).
+			SetUpsert(true)
+	}
+	if _, err := s.Comments.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		return 0, fmt.Errorf("failed to copy comments: %w", err)
+	}
+
+	preview := commentPreview(comments, commentPreviewSize)
+	_, err := s.Posts.UpdateOne(ctx,
+		bson.M{"_id": post.ID, "commentCount": bson.M{"$in": bson.A{nil, 0}}},
+		bson.M{"$set": bson.M{"comments": preview, "commentCount": len(comments)}},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to shrink embedded comments: %w", err)
+	}
+	post.Comments = preview
+	post.CommentCount = len(comments)
+	return len(comments), nil
+}
+
+// commentablePost loads a live post, migrating its legacy comments first so
+// every comment operation can rely on the collection.
+func (s *Service) commentablePost(ctx context.Context, postID primitive.ObjectID) (*types.PostDocument, error) {
+	var post types.PostDocument
+	err := s.Posts.FindOne(ctx, bson.M{"_id": postID, "metadata.isDeleted": false}).Decode(&post)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("post not found or has been deleted")
+		}
+		return nil, err
+	}
+	if post.CommentCount == 0 && len(post.Comments) > 0 {
+		if _, err := s.MigratePostComments(ctx, &post); err != nil {
+			return nil, err
+		}
+	}
+	return &post, nil
+}
+
+// GetComment returns one live comment on a post.
+func (s *Service) GetComment(ctx context.Context, postID, commentID primitive.ObjectID) (*types.CommentDocument, error) {
+	if _, err := s.commentablePost(ctx, postID); err != nil {
+		return nil, err
+	}
+	var comment types.CommentDocument
+	err := s.Comments.FindOne(ctx, bson.M{"_id": commentID, "postId": postID, "metadata.isDeleted": false}).Decode(&comment)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errCommentNotFound
+		}
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// AddComment stores a comment, or a reply when ParentID is set, and returns
+// it as stored along with the friendship bump for the post owner. A comment
+// from someone the post's author restricted is stored pending: it stays out
+// of the preview and counts and notifies nobody until the author approves it.
+func (s *Service) AddComment(postID primitive.ObjectID, comment types.CommentDocument) (*types.CommentDocument, *friendship.Delta, error) {
+	ctx := context.Background()
+
+	post, err := s.commentablePost(ctx, postID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment.PostID = postID
+	comment.Metadata = types.NewCommentMetadata()
+
+	if comment.User != nil && comment.User.ID != post.User.ID {
+		controls, err := s.Audiences.ControlsOn(ctx, post.User.ID, comment.User.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to check comment restrictions: %w", err)
+		}
+		comment.Pending = controls.Restricted
+	}
+
+	var parent *types.CommentDocument
+	if comment.ParentID != nil {
+		parent, err = s.GetComment(ctx, postID, *comment.ParentID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if parent.Pending && !commentVisible(*parent, comment.User, post.User.ID) {
+			return nil, nil, errCommentNotFound
+		}
+		threadID := parent.ID
+		if parent.ThreadID != nil {
+			threadID = *parent.ThreadID
+		}
+		comment.ThreadID = &threadID
+	}
+
+	if _, err := s.Comments.InsertOne(ctx, comment); err != nil {
+		return nil, nil, fmt.Errorf("failed to add comment: %w", err)
+	}
+	if comment.Pending {
+		return &comment, nil, nil
+	}
+
+	_, err = s.Posts.UpdateOne(ctx, bson.M{"_id": postID}, bson.M{
+		"$push": bson.M{
+			"comments": bson.M{"$each": bson.A{comment}, "$slice": -commentPreviewSize},
+		},
+		"$inc": bson.M{"commentCount": 1},
+		"$set": bson.M{
+			"metadata.updatedAt": time.Now(),
+			"metadata.isEdited":  true,
+		},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to add comment: %w", err)
+	}
+	if comment.ThreadID != nil {
+		if _, err := s.Comments.UpdateOne(ctx, bson.M{"_id": *comment.ThreadID}, bson.M{"$inc": bson.M{"replyCount": 1}}); err != nil {
+			slog.Error("Failed to count reply on thread", "error", err, "thread_id", comment.ThreadID.Hex())
+		}
+	}
+
+	var thumbnail string
+	if len(post.Images) > 0 {
+		thumbnail = post.Images[0]
+	}
+
+	// Each person hears about a comment once: the post owner first, then the
+	// author of the comment being replied to, then anyone mentioned.
+	notified := map[primitive.ObjectID]bool{}
+	if comment.User != nil {
+		notified[comment.User.ID] = true
+	}
+
+	// Send notification to post owner (only if commenter is not the post owner)
+	var fsDelta *friendship.Delta
+	if comment.User != nil && !notified[post.User.ID] {
+		notified[post.User.ID] = true
+		fsDelta = s.Friendship.Bump(ctx, comment.User.ID, post.User.ID, friendship.PointsComment)
+
+		// Send push notification
+		err = s.sendCommentNotification(post.User.ID, post.ID, comment.User.DisplayName, comment.Content)
+		if err != nil {
+			// Log error but don't fail the operation since comment was already created
+			slog.Error("Failed to send comment notification", "error", err, "post_owner_id", post.User.ID)
+		}
+
+		// The in-app card already shows the commenter's avatar + name and a
+		// "commented on your post" header, so the body is just the comment text.
+		// (Push notifications still prefix the name — see sendCommentNotification.)
+		err = s.NotificationService.CreateNotification(comment.User.ID, post.User.ID, comment.Content, notifications.NotificationTypeComment, post.ID, thumbnail)
+		if err != nil {
+			// Log error but don't fail the operation since comment was already created
+			slog.Error("Failed to create comment notification in database", "error", err, "post_owner_id", post.User.ID)
+		}
+	}
+
+	// Notify the author of the comment being replied to
+	if parent != nil && parent.User != nil && comment.User != nil && !notified[parent.User.ID] {
+		notified[parent.User.ID] = true
+
+		notificationContent := fmt.Sprintf("%s replied: \"%s\"", comment.User.DisplayName, comment.Content)
+		err = s.NotificationService.CreateNotification(comment.User.ID, parent.User.ID, notificationContent, notifications.NotificationTypeComment, post.ID, thumbnail)
+		if err != nil {
+			slog.Error("Failed to create reply notification", "error", err, "parent_author_id", parent.User.ID)
+		}
+
+		err = s.sendCommentPush(parent.User.ID, post.ID, "New reply to your comment", comment.User.DisplayName, comment.Content)
+		if err != nil {
+			slog.Error("Failed to send reply push notification", "error", err, "parent_author_id", parent.User.ID)
+		}
+	}
+
+	// Notify mentioned users
+	for _, mention := range comment.Mentions {
+		if notified[mention.ID] {
+			continue
+		}
+		notified[mention.ID] = true
+
+		notificationContent := fmt.Sprintf("%s mentioned you: \"%s\"", comment.User.DisplayName, comment.Content)
+		err = s.NotificationService.CreateNotification(comment.User.ID, mention.ID, notificationContent, notifications.NotificationTypeComment, post.ID, thumbnail)
+		if err != nil {
+			slog.Error("Failed to create mention notification", "error", err, "mentioned_user_id", mention.ID)
+		}
+
+		// Send push notification
+		err = s.sendCommentNotification(mention.ID, post.ID, comment.User.DisplayName, comment.Content)
+		if err != nil {
+			slog.Error("Failed to send mention push notification", "error", err, "mentioned_user_id", mention.ID)
+		}
+	}
+
+	return &comment, fsDelta, nil
+}
+
+// EditComment rewrites a comment's text and mentions. Only its author may
+// edit; the post's preview copy is updated alongside.
+func (s *Service) EditComment(ctx context.Context, postID, commentID, editorID primitive.ObjectID, content string, mentions []types.MentionReference) (*types.CommentDocument, error) {
+	comment, err := s.GetComment(ctx, postID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.User == nil || comment.User.ID != editorID {
+		return nil, errCommentForbidden
+	}
+
+	now := time.Now()
+	var updated types.CommentDocument
+	err = s.Comments.FindOneAndUpdate(ctx,
+		bson.M{"_id": commentID},
+		bson.M{"$set": bson.M{
+			"content":             content,
+			"mentions":            mentions,
+			"metadata.lastEdited": now,
+		}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit comment: %w", err)
+	}
+
+	_, err = s.Posts.UpdateOne(ctx,
+		bson.M{"_id": postID, "comments._id": commentID},
+		bson.M{"$set": bson.M{
+			"comments.$.content":             content,
+			"comments.$.mentions":            mentions,
+			"comments.$.metadata.lastEdited": now,
+		}},
+	)
+	if err != nil {
+		slog.Error("Failed to update comment preview", "error", err, "post_id", postID.Hex(), "comment_id", commentID.Hex())
+	}
+	return &updated, nil
+}
+
+// ListComments pages one level of a post's comments: root comments newest
+// first, or, given a thread, that thread's replies oldest first. Pending
+// comments are only listed for their commenter and the post's author.
+func (s *Service) ListComments(ctx context.Context, postID, viewerID primitive.ObjectID, threadID *primitive.ObjectID, limit int, after *FeedPosition) ([]types.CommentDocument, bool, error) {
+	post, err := s.commentablePost(ctx, postID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	filter := bson.M{"postId": postID, "metadata.isDeleted": false, "threadId": nil}
+	direction := -1
+	if threadID != nil {
+		filter["threadId"] = *threadID
+		direction = 1
+	}
+	var and bson.A
+	if pending := pendingFilter(viewerID, post.User.ID); pending != nil {
+		and = append(and, pending)
+	}
+	if after != nil {
+		op := "$lt"
+		if direction == 1 {
+			op = "$gt"
+		}
+		and = append(and, bson.M{"$or": bson.A{
+			bson.M{"metadata.createdAt": bson.M{op: after.At}},
+			bson.M{"metadata.createdAt": after.At, "_id": bson.M{op: after.ID}},
+		}})
+	}
+	if len(and) > 0 {
+		filter["$and"] = and
+	}
+
+	cursor, err := s.Comments.Find(ctx, filter, options.Find().
+		SetSort(bson.D{{Key: "metadata.createdAt", Value: direction}, {Key: "_id", Value: direction}}).
+		SetLimit(int64(limit+1)))
+	if err != nil {
+		return nil, false, err
+	}
+	var comments []types.CommentDocument
+	if err := cursor.All(ctx, &comments); err != nil {
+		return nil, false, err
+	}
+	hasMore := len(comments) > limit
+	if hasMore {
+		comments = comments[:limit]
+	}
+	return comments, hasMore, nil
+}
+
+// DeleteComment removes a comment and every reply beneath it.
+func (s *Service) DeleteComment(postID primitive.ObjectID, commentID primitive.ObjectID) error {
+	ctx := context.Background()
+
+	comment, err := s.GetComment(ctx, postID, commentID)
+	if err != nil {
+		if errors.Is(err, errCommentNotFound) {
+			return fmt.Errorf("comment not found or post has been deleted")
+		}
+		return err
+	}
+
+	ids := []primitive.ObjectID{commentID}
+	if comment.ThreadID == nil {
+		replies, err := s.Comments.Distinct(ctx, "_id", bson.M{"threadId": commentID})
+		if err != nil {
+			return fmt.Errorf("failed to find replies: %w", err)
+		}
+		for _, r := range replies {
+			if id, ok := r.(primitive.ObjectID); ok {
+				ids = append(ids, id)
+			}
+		}
+	} else {
+		var thread []types.CommentDocument
+		cursor, err := s.Comments.Find(ctx, bson.M{"threadId": *comment.ThreadID},
+			options.Find().SetProjection(bson.M{"_id": 1, "parentId": 1}))
+		if err != nil {
+			return fmt.Errorf("failed to find replies: %w", err)
+		}
+		if err := cursor.All(ctx, &thread); err != nil {
+			return fmt.Errorf("failed to find replies: %w", err)
+		}
+		ids = append(ids, descendants(commentID, thread)...)
+	}
+
+	// Pending comments were never counted, so they aren't uncounted either.
+	pending, err := s.Comments.CountDocuments(ctx, bson.M{"_id": bson.M{"$in": ids}, "pending": true})
+	if err != nil {
+		return fmt.Errorf("failed to count pending replies: %w", err)
+	}
+	result, err := s.Comments.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return fmt.Errorf("failed to delete comment and replies: %w", err)
+	}
+	removed := int(result.DeletedCount - pending)
+	if removed < 0 {
+		removed = 0
+	}
+
+	_, err = s.Posts.UpdateOne(ctx, bson.M{"_id": postID}, bson.M{
+		"$pull": bson.M{"comments": bson.M{"_id": bson.M{"$in": ids}}},
+		"$inc":  bson.M{"commentCount": -removed},
+		"$set": bson.M{
+			"metadata.updatedAt": time.Now(),
+			"metadata.isEdited":  true,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update post after deleting comment: %w", err)
+	}
+	if comment.ThreadID != nil {
+		if _, err := s.Comments.UpdateOne(ctx, bson.M{"_id": *comment.ThreadID}, bson.M{"$inc": bson.M{"replyCount": -removed}}); err != nil {
+			slog.Error("Failed to uncount replies on thread", "error", err, "thread_id", comment.ThreadID.Hex())
+		}
+	}
+	return nil
+}
+
+// pendingFilter hides other people's pending comments from viewer. The
+// post's author sees them all, so they can approve them; nil means no filter.
+func pendingFilter(viewer, postAuthor primitive.ObjectID) bson.M {
+	if viewer == postAuthor {
+		return nil
+	}
+	return bson.M{"$or": bson.A{
+		bson.M{"pending": bson.M{"$ne": true}},
+		bson.M{"user._id": viewer},
+	}}
+}
+
+// commentVisible reports whether viewer may see c on a post by postAuthor.
+func commentVisible(c types.CommentDocument, viewer *types.UserExtendedReferenceInternal, postAuthor primitive.ObjectID) bool {
+	if !c.Pending {
+		return true
+	}
+	if viewer == nil {
+		return false
+	}
+	return viewer.ID == postAuthor || (c.User != nil && c.User.ID == viewer.ID)
+}
+
+// ApproveComment publishes a pending comment from a restricted user. Only
+// the post's author may approve; once approved the comment is counted and
+// can enter the preview like any other.
+func (s *Service) ApproveComment(ctx context.Context, postID, commentID, approverID primitive.ObjectID) (*types.CommentDocument, error) {
+	post, err := s.commentablePost(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if post.User.ID != approverID {
+		return nil, errCommentForbidden
+	}
+
+	var comment types.CommentDocument
+	err = s.Comments.FindOneAndUpdate(ctx,
+		bson.M{"_id": commentID, "postId": postID, "metadata.isDeleted": false, "pending": true},
+		bson.M{"$unset": bson.M{"pending": ""}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&comment)
+	if err == mongo.ErrNoDocuments {
+		if _, err := s.GetComment(ctx, postID, commentID); err != nil {
+			return nil, err
+		}
+		return nil, errCommentNotPending
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve comment: %w", err)
+	}
+
+	if comment.ThreadID != nil {
+		if _, err := s.Comments.UpdateOne(ctx, bson.M{"_id": *comment.ThreadID}, bson.M{"$inc": bson.M{"replyCount": 1}}); err != nil {
+			slog.Error("Failed to count reply on thread", "error", err, "thread_id", comment.ThreadID.Hex())
+		}
+	}
+
+	// The approved comment may be older than the current preview, so the
+	// preview is rebuilt rather than pushed to.
+	cursor, err := s.Comments.Find(ctx,
+		bson.M{"postId": postID, "metadata.isDeleted": false, "pending": bson.M{"$ne": true}},
+		options.Find().
+			SetSort(bson.D{{Key: "metadata.createdAt", Value: -1}, {Key: "_id", Value: -1}}).
+			SetLimit(commentPreviewSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load comment preview: %w", err)
+	}
+	var latest []types.CommentDocument
+	if err := cursor.All(ctx, &latest); err != nil {
+		return nil, fmt.Errorf("failed to load comment preview: %w", err)
+	}
+
+	_, err = s.Posts.UpdateOne(ctx, bson.M{"_id": postID}, bson.M{
+		"$set": bson.M{
+			"comments":           commentPreview(latest, commentPreviewSize),
+			"metadata.updatedAt": time.Now(),
+			"metadata.isEdited":  true,
+		},
+		"$inc": bson.M{"commentCount": 1},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update post after approving comment: %w", err)
+	}
+	return &comment, nil
+}
+
+// descendants returns every comment in thread that replies, directly or
+// through other replies, to id.
+func descendants(id primitive.ObjectID, thread []types.CommentDocument) []primitive.ObjectID {
+	children := map[primitive.ObjectID][]primitive.ObjectID{}
+	for _, c := range thread {
+		if c.ParentID != nil {
+			children[*c.ParentID] = append(children[*c.ParentID], c.ID)
+		}
+	}
+	var out []primitive.ObjectID
+	queue := []primitive.ObjectID{id}
+	seen := map[primitive.ObjectID]bool{id: true}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		for _, child := range children[next] {
+			if !seen[child] {
+				seen[child] = true
+				out = append(out, child)
+				queue = append(queue, child)
+			}
+		}
+	}
+	return out
+}
+
+// ToggleCommentReaction adds or removes userID's emoji on a comment and
+// reports whether it was added.
+func (s *Service) ToggleCommentReaction(ctx context.Context, postID, commentID, userID primitive.ObjectID, emoji string) (bool, error) {
+	comment, err := s.GetComment(ctx, postID, commentID)
+	if err != nil {
+		return false, err
+	}
+
+	field := "reactions." + emoji
+	userExists := false
+	for _, id := range comment.Reactions[emoji] {
+		if id == userID {
+			userExists = true
+			break
+		}
+	}
+
+	var update bson.M
+	switch {
+	case userExists && len(comment.Reactions[emoji]) == 1:
+		update = bson.M{"$unset": bson.M{field: ""}}
+	case userExists:
+		update = bson.M{"$pull": bson.M{field: userID}}
+	default:
+		update = bson.M{"$addToSet": bson.M{field: userID}}
+	}
+	if _, err := s.Comments.UpdateOne(ctx, bson.M{"_id": commentID}, update); err != nil {
+		return false, err
+	}
+
+	if !userExists && comment.User != nil {
+		s.Friendship.Bump(ctx, userID, comment.User.ID, friendship.PointsReaction)
+	}
+	return !userExists, nil
+}
@@ -0,0 +1,133 @@
+package Post
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func testComment(parent *types.CommentDocument, minutesAgo int) types.CommentDocument {
+	c := types.CommentDocument{
+		ID:       primitive.NewObjectID(),
+		Metadata: types.CommentMetadata{CreatedAt: testNow.Add(-time.Duration(minutesAgo) * time.Minute)},
+	}
+	if parent != nil {
+		id := parent.ID
+		c.ParentID = &id
+	}
+	return c
+}
+
+func TestCommentThreads(t *testing.T) {
+	root := testComment(nil, 10)
+	reply := testComment(&root, 9)
+	nested := testComment(&reply, 8)
+	orphan := testComment(&types.CommentDocument{ID: primitive.NewObjectID()}, 7)
+
+	out := commentThreads([]types.CommentDocument{nested, root, orphan, reply})
+	byID := map[primitive.ObjectID]types.CommentDocument{}
+	for _, c := range out {
+		byID[c.ID] = c
+	}
+
+	if got := byID[root.ID]; got.ThreadID != nil || got.ReplyCount != 2 {
+		t.Errorf("root thread = %v replies = %d, want a root with 2 replies", got.ThreadID, got.ReplyCount)
+	}
+	for _, id := range []primitive.ObjectID{reply.ID, nested.ID} {
+		if got := byID[id].ThreadID; got == nil || *got != root.ID {
+			t.Errorf("reply %s thread = %v, want %s", id.Hex(), got, root.ID.Hex())
+		}
+	}
+	if got := byID[orphan.ID]; got.ThreadID != nil {
+		t.Errorf("orphaned reply thread = %v, want it promoted to a root", got.ThreadID)
+	}
+}
+
+func TestCommentPreview(t *testing.T) {
+	var comments []types.CommentDocument
+	for i := 5; i > 0; i-- {
+		comments = append(comments, testComment(nil, i))
+	}
+	// Shuffle so the preview has to sort.
+	comments[0], comments[4] = comments[4], comments[0]
+
+	preview := commentPreview(comments, commentPreviewSize)
+	if len(preview) != commentPreviewSize {
+		t.Fatalf("preview = %d comments, want %d", len(preview), commentPreviewSize)
+	}
+	for i := 1; i < len(preview); i++ {
+		if preview[i].Metadata.CreatedAt.Before(preview[i-1].Metadata.CreatedAt) {
+			t.Fatalf("preview not oldest first at %d", i)
+		}
+	}
+	if want := testNow.Add(-time.Minute); !preview[len(preview)-1].Metadata.CreatedAt.Equal(want) {
+		t.Errorf("preview ends at %v, want the newest comment", preview[len(preview)-1].Metadata.CreatedAt)
+	}
+}
+
+func TestDescendants(t *testing.T) {
+	root := testComment(nil, 10)
+	a := testComment(&root, 9)
+	b := testComment(&a, 8)
+	c := testComment(&b, 7)
+	sibling := testComment(&root, 6)
+
+	got := descendants(a.ID, []types.CommentDocument{a, b, c, sibling})
+	if len(got) != 2 || got[0] != b.ID || got[1] != c.ID {
+		t.Errorf("descendants of a = %v, want [b c]", got)
+	}
+	if got := descendants(sibling.ID, []types.CommentDocument{a, b, c, sibling}); len(got) != 0 {
+		t.Errorf("descendants of a leaf = %v, want none", got)
+	}
+}
+
+func TestCommentCursor(t *testing.T) {
+	pos := FeedPosition{At: testNow, ID: primitive.NewObjectID()}
+	got, err := decodeCommentCursor(encodeCommentCursor(pos))
+	if err != nil || got == nil || got.ID != pos.ID || !got.At.Equal(pos.At) {
+		t.Fatalf("round trip = %+v, %v", got, err)
+	}
+	if got, err := decodeCommentCursor(""); got != nil || err != nil {
+		t.Errorf("empty cursor = %+v, %v, want the first page", got, err)
+	}
+	for _, bad := range []string{"not base64!", "e30"} {
+		if _, err := decodeCommentCursor(bad); err != errInvalidCommentCursor {
+			t.Errorf("decode(%q) err = %v, want errInvalidCommentCursor", bad, err)
+		}
+	}
+}
+
+func TestCommentVisible(t *testing.T) {
+	author := primitive.NewObjectID()
+	commenter := &types.UserExtendedReferenceInternal{ID: primitive.NewObjectID()}
+	other := &types.UserExtendedReferenceInternal{ID: primitive.NewObjectID()}
+
+	c := testComment(nil, 1)
+	c.User = commenter
+	if !commentVisible(c, other, author) {
+		t.Error("an approved comment should be visible to everyone")
+	}
+
+	c.Pending = true
+	if !commentVisible(c, commenter, author) {
+		t.Error("a pending comment should be visible to its commenter")
+	}
+	if !commentVisible(c, &types.UserExtendedReferenceInternal{ID: author}, author) {
+		t.Error("a pending comment should be visible to the post's author")
+	}
+	if commentVisible(c, other, author) || commentVisible(c, nil, author) {
+		t.Error("a pending comment should be hidden from everyone else")
+	}
+}
+
+func TestPendingFilter(t *testing.T) {
+	author, viewer := primitive.NewObjectID(), primitive.NewObjectID()
+	if got := pendingFilter(author, author); got != nil {
+		t.Errorf("post author filter = %v, want none", got)
+	}
+	if got := pendingFilter(viewer, author); got == nil {
+		t.Error("other viewers should have pending comments filtered")
+	}
+}
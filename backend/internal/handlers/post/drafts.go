@@ -0,0 +1,334 @@
+package Post
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/rings"
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/internal/xvalidator"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// draftsCollection holds unpublished and scheduled posts.
+const draftsCollection = "post_drafts"
+
+// Draft lifecycle. A publish claims the draft (publishing) before creating
+// the post, so the cron and a user tapping "post now" can't both publish it.
+// A failed scheduled publish returns the draft to draft with an Error.
+const (
+	draftStatusDraft      = "draft"
+	draftStatusScheduled  = "scheduled"
+	draftStatusPublishing = "publishing"
+	draftStatusPublished  = "published"
+)
+
+const (
+	// maxScheduleAhead bounds how far out a draft can be scheduled.
+	maxScheduleAhead = 30 * 24 * time.Hour
+	// staleDraftClaim is how long a publishing claim holds before the cron
+	// assumes its publisher died and retries; the preallocated post ID makes
+	// the retry collide rather than post twice.
+	staleDraftClaim = 10 * time.Minute
+	// maxDraftsListed caps GET /v1/user/drafts.
+	maxDraftsListed = 50
+)
+
+var (
+	errDraftNotFound    = errors.New("draft not found")
+	errDraftIncomplete  = errors.New("draft is not ready to publish")
+	errInvalidPublishAt = errors.New("publish time must be in the future and within 30 days")
+)
+
+// editableDraft matches a user's draft that hasn't started publishing.
+func editableDraft(userID, draftID primitive.ObjectID) bson.M {
+	return bson.M{
+		"_id":    draftID,
+		"userId": userID,
+		"status": bson.M{"$in": bson.A{draftStatusDraft, draftStatusScheduled}},
+	}
+}
+
+// params converts a draft into the create request it will publish as.
+func (c DraftContent) params() CreatePostParams {
+	var tagged any
+	if len(c.TaggedUsers) > 0 {
+		tagged = c.TaggedUsers
+	}
+	return CreatePostParams{
+		Images:            c.Images,
+		Media:             c.Media,
+		Dual:              c.Dual,
+		Caption:           c.Caption,
+		Size:              c.Size,
+		Task:              c.Task,
+		BlueprintID:       c.BlueprintID,
+		BlueprintIsPublic: c.BlueprintIsPublic,
+		Groups:            c.Groups,
+		IsPublic:          c.IsPublic,
+		TaggedUsers:       tagged,
+		Song:              c.Song,
+		Audience:          c.Audience,
+	}
+}
+
+// checkPublishable reports whether content could be posted as it stands.
+func checkPublishable(c DraftContent) error {
+	if errs := xvalidator.Validator.Validate(c.params()); len(errs) > 0 {
+		return fmt.Errorf("%w: %v", errDraftIncomplete, errs)
+	}
+	return nil
+}
+
+// checkPublishAt bounds a schedule to (now, now+maxScheduleAhead].
+func checkPublishAt(at, now time.Time) error {
+	if !at.After(now) || at.After(now.Add(maxScheduleAhead)) {
+		return errInvalidPublishAt
+	}
+	return nil
+}
+
+// CreateDraft saves new draft content for userID.
+func (s *Service) CreateDraft(ctx context.Context, userID primitive.ObjectID, content DraftContent) (*PostDraft, error) {
+	now := time.Now()
+	draft := PostDraft{
+		ID:        primitive.NewObjectID(),
+		UserID:    userID,
+		Content:   content,
+		Status:    draftStatusDraft,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if _, err := s.Drafts.InsertOne(ctx, draft); err != nil {
+		return nil, fmt.Errorf("failed to save draft: %w", err)
+	}
+	return &draft, nil
+}
+
+// UpdateDraft replaces a draft's content. A scheduled draft stays scheduled
+// unless its new content can no longer be published.
+func (s *Service) UpdateDraft(ctx context.Context, userID, draftID primitive.ObjectID, content DraftContent) (*PostDraft, error) {
+	set := bson.M{"content": content, "updatedAt": time.Now()}
+	update := bson.M{"$set": set, "$unset": bson.M{"error": ""}}
+	if checkPublishable(content) != nil {
+		set["status"] = draftStatusDraft
+		update["$unset"] = bson.M{"error": "", "publishAt": ""}
+	}
+
+	var draft PostDraft
+	err := s.Drafts.FindOneAndUpdate(ctx, editableDraft(userID, draftID), update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&draft)
+	if err == mongo.ErrNoDocuments {
+		return nil, errDraftNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update draft: %w", err)
+	}
+	return &draft, nil
+}
+
+// GetDraft returns one of userID's drafts.
+func (s *Service) GetDraft(ctx context.Context, userID, draftID primitive.ObjectID) (*PostDraft, error) {
+	var draft PostDraft
+	err := s.Drafts.FindOne(ctx, bson.M{"_id": draftID, "userId": userID}).Decode(&draft)
+	if err == mongo.ErrNoDocuments {
+		return nil, errDraftNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &draft, nil
+}
+
+// ListDrafts returns userID's unpublished drafts, most recently edited first.
+func (s *Service) ListDrafts(ctx context.Context, userID primitive.ObjectID) ([]PostDraft, error) {
+	cursor, err := s.Drafts.Find(ctx,
+		bson.M{"userId": userID, "status": bson.M{"$in": bson.A{draftStatusDraft, draftStatusScheduled, draftStatusPublishing}}},
+		options.Find().SetSort(bson.D{{Key: "updatedAt", Value: -1}}).SetLimit(maxDraftsListed),
+	)
+	if err != nil {
+		return nil, err
+	}
+	drafts := []PostDraft{}
+	if err := cursor.All(ctx, &drafts); err != nil {
+		return nil, err
+	}
+	return drafts, nil
+}
+
+// DeleteDraft discards a draft that hasn't started publishing.
+func (s *Service) DeleteDraft(ctx context.Context, userID, draftID primitive.ObjectID) error {
+	result, err := s.Drafts.DeleteOne(ctx, editableDraft(userID, draftID))
+	if err != nil {
+		return fmt.Errorf("failed to delete draft: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return errDraftNotFound
+	}
+	return nil
+}
+
+// ScheduleDraft sets a draft to publish at publishAt. The Share ring it
+// bumps then closes in tz, the timezone the user scheduled from.
+func (s *Service) ScheduleDraft(ctx context.Context, userID, draftID primitive.ObjectID, publishAt time.Time, tz string) (*PostDraft, error) {
+	if err := checkPublishAt(publishAt, time.Now()); err != nil {
+		return nil, err
+	}
+	draft, err := s.GetDraft(ctx, userID, draftID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPublishable(draft.Content); err != nil {
+		return nil, err
+	}
+
+	var scheduled PostDraft
+	err = s.Drafts.FindOneAndUpdate(ctx, editableDraft(userID, draftID),
+		bson.M{
+			"$set": bson.M{
+				"status":    draftStatusScheduled,
+				"publishAt": publishAt,
+				"timezone":  tz,
+				"updatedAt": time.Now(),
+			},
+			"$unset": bson.M{"error": ""},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&scheduled)
+	if err == mongo.ErrNoDocuments {
+		return nil, errDraftNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule draft: %w", err)
+	}
+	return &scheduled, nil
+}
+
+// UnscheduleDraft turns a scheduled draft back into a plain draft.
+func (s *Service) UnscheduleDraft(ctx context.Context, userID, draftID primitive.ObjectID) (*PostDraft, error) {
+	var draft PostDraft
+	err := s.Drafts.FindOneAndUpdate(ctx, editableDraft(userID, draftID),
+		bson.M{
+			"$set":   bson.M{"status": draftStatusDraft, "updatedAt": time.Now()},
+			"$unset": bson.M{"publishAt": ""},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&draft)
+	if err == mongo.ErrNoDocuments {
+		return nil, errDraftNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to unschedule draft: %w", err)
+	}
+	return &draft, nil
+}
+
+// claimDraft moves the draft matching filter to publishing and gives it a
+// post ID if it doesn't have one yet. Returns nil when nothing matched.
+func (s *Service) claimDraft(ctx context.Context, filter bson.M, now time.Time) (*PostDraft, error) {
+	var draft PostDraft
+	err := s.Drafts.FindOneAndUpdate(ctx, filter,
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			"status":    draftStatusPublishing,
+			"claimedAt": now,
+			"postId":    bson.M{"$ifNull": bson.A{"$postId", primitive.NewObjectID()}},
+		}}}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&draft)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &draft, nil
+}
+
+// publishClaimed publishes a claimed draft. A duplicate post ID means an
+// earlier attempt already created the post, so the draft is just marked
+// published. Any other failure returns the draft to draft with the error.
+func (s *Service) publishClaimed(ctx context.Context, draft *PostDraft) (*types.PostDocument, *UserStatsUpdate, *rings.RingDelta, error) {
+	tz := draft.Timezone
+	if tz == "" {
+		tz = "UTC"
+	}
+	post, stats, ringDelta, err := s.PublishPost(ctx, draft.UserID, draft.Content.params(), *draft.PostID, tz)
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		_, resetErr := s.Drafts.UpdateOne(ctx, bson.M{"_id": draft.ID}, bson.M{
+			"$set":   bson.M{"status": draftStatusDraft, "error": err.Error(), "updatedAt": time.Now()},
+			"$unset": bson.M{"publishAt": "", "claimedAt": ""},
+		})
+		if resetErr != nil {
+			slog.Error("Failed to release draft after publish failure", "draft_id", draft.ID.Hex(), "error", resetErr)
+		}
+		return nil, nil, nil, err
+	}
+
+	now := time.Now()
+	_, markErr := s.Drafts.UpdateOne(ctx, bson.M{"_id": draft.ID}, bson.M{
+		"$set":   bson.M{"status": draftStatusPublished, "publishedAt": now, "updatedAt": now},
+		"$unset": bson.M{"claimedAt": "", "error": ""},
+	})
+	if markErr != nil {
+		slog.Error("Failed to mark draft published", "draft_id", draft.ID.Hex(), "error", markErr)
+	}
+	if err != nil {
+		slog.Info("Draft was already published", "draft_id", draft.ID.Hex(), "post_id", draft.PostID.Hex())
+		return nil, nil, nil, nil
+	}
+	return post, stats, ringDelta, nil
+}
+
+// PublishDraft publishes one of userID's drafts now, whether or not it was
+// scheduled. The ring closes in tz, the timezone of the request.
+func (s *Service) PublishDraft(ctx context.Context, userID, draftID primitive.ObjectID, tz string) (*types.PostDocument, *UserStatsUpdate, *rings.RingDelta, error) {
+	draft, err := s.GetDraft(ctx, userID, draftID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := checkPublishable(draft.Content); err != nil {
+		return nil, nil, nil, err
+	}
+
+	claimed, err := s.claimDraft(ctx, editableDraft(userID, draftID), time.Now())
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to claim draft: %w", err)
+	}
+	if claimed == nil {
+		return nil, nil, nil, errDraftNotFound
+	}
+	claimed.Timezone = tz
+	return s.publishClaimed(ctx, claimed)
+}
+
+// PublishDue publishes every scheduled draft whose time has come, plus any
+// whose publisher stalled mid-publish. Returns how many were published.
+func (s *Service) PublishDue(ctx context.Context, now time.Time) (int, error) {
+	filter := bson.M{"$or": bson.A{
+		bson.M{"status": draftStatusScheduled, "publishAt": bson.M{"$lte": now}},
+		bson.M{"status": draftStatusPublishing, "claimedAt": bson.M{"$lt": now.Add(-staleDraftClaim)}},
+	}}
+
+	published := 0
+	for ctx.Err() == nil {
+		draft, err := s.claimDraft(ctx, filter, now)
+		if err != nil {
+			return published, fmt.Errorf("failed to claim scheduled draft: %w", err)
+		}
+		if draft == nil {
+			break
+		}
+		if _, _, _, err := s.publishClaimed(ctx, draft); err != nil {
+			slog.Error("Failed to publish scheduled draft", "draft_id", draft.ID.Hex(), "user_id", draft.UserID.Hex(), "error", err)
+			continue
+		}
+		published++
+	}
+	return published, nil
+}
@@ -0,0 +1,65 @@
+package Post
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCheckPublishAt(t *testing.T) {
+	cases := []struct {
+		name string
+		at   time.Time
+		ok   bool
+	}{
+		{"in the past", testNow.Add(-time.Minute), false},
+		{"now", testNow, false},
+		{"in an hour", testNow.Add(time.Hour), true},
+		{"at the limit", testNow.Add(maxScheduleAhead), true},
+		{"past the limit", testNow.Add(maxScheduleAhead + time.Second), false},
+	}
+	for _, c := range cases {
+		err := checkPublishAt(c.at, testNow)
+		if c.ok && err != nil {
+			t.Errorf("%s: err = %v, want ok", c.name, err)
+		}
+		if !c.ok && !errors.Is(err, errInvalidPublishAt) {
+			t.Errorf("%s: err = %v, want errInvalidPublishAt", c.name, err)
+		}
+	}
+}
+
+func TestCheckPublishable(t *testing.T) {
+	if err := checkPublishable(DraftContent{}); !errors.Is(err, errDraftIncomplete) {
+		t.Errorf("empty draft err = %v, want errDraftIncomplete", err)
+	}
+	if err := checkPublishable(DraftContent{Caption: "done", Images: []string{"not a url"}}); !errors.Is(err, errDraftIncomplete) {
+		t.Errorf("bad image URL err = %v, want errDraftIncomplete", err)
+	}
+	if err := checkPublishable(DraftContent{Caption: "done", Images: []string{"https://cdn.example.com/a.jpg"}}); err != nil {
+		t.Errorf("complete draft err = %v, want ok", err)
+	}
+}
+
+func TestDraftContentParams(t *testing.T) {
+	blueprint := "507f1f77bcf86cd799439011"
+	content := DraftContent{
+		Caption:     "shipped",
+		BlueprintID: &blueprint,
+		Groups:      []string{"507f1f77bcf86cd799439012"},
+		IsPublic:    true,
+		TaggedUsers: []MentionInput{{ID: "507f1f77bcf86cd799439013", Handle: "@sam"}},
+	}
+
+	params := content.params()
+	if params.Caption != "shipped" || params.BlueprintID != &blueprint || !params.IsPublic || len(params.Groups) != 1 {
+		t.Fatalf("params = %+v, want the draft's fields", params)
+	}
+	tags := coerceMentions(params.TaggedUsers)
+	if len(tags) != 1 || tags[0].Handle != "@sam" {
+		t.Errorf("tags = %+v, want the draft's tag to survive coercion", tags)
+	}
+	if (DraftContent{}).params().TaggedUsers != nil {
+		t.Error("a draft without tags should publish without tags")
+	}
+}
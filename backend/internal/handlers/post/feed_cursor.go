@@ -0,0 +1,167 @@
+package Post
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var errInvalidFeedCursor = errors.New("invalid feed cursor")
+
+// FeedPosition is the last item a client has seen from one feed source.
+// Sources are walked newest-first on (createdAt, _id), so the next page is
+// everything strictly older than this pair.
+type FeedPosition struct {
+	At time.Time          `json:"at"`
+	ID primitive.ObjectID `json:"id"`
+}
+
+// feedCursor is the opaque paging token handed to clients. AsOf pins the
+// snapshot so items created mid-scroll wait for a refresh instead of shifting
+// pages, and Seed+Page make task sampling reproducible across the session.
+type feedCursor struct {
+	AsOf  time.Time     `json:"asOf"`
+	Seed  int64         `json:"seed"`
+	Page  int           `json:"page"`
+	Posts *FeedPosition `json:"posts,omitempty"`
+	Rings *FeedPosition `json:"rings,omitempty"`
+
+	// Source is feedSourceTimeline for sessions read from the materialized
+	// timeline, which page on a single position.
+	Source   string        `json:"source,omitempty"`
+	Timeline *FeedPosition `json:"timeline,omitempty"`
+
+	// Mode is feedModeRanked while a ranked session walks its pool; Ranked
+	// counts the pool items already served.
+	Mode   string `json:"mode,omitempty"`
+	Ranked int    `json:"ranked,omitempty"`
+}
+
+// newFeedCursor starts a session at now. The first page carries no positions.
+func newFeedCursor(now time.Time) feedCursor {
+	return feedCursor{AsOf: now, Seed: now.UnixNano()}
+}
+
+func (c feedCursor) encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeFeedCursor parses a client cursor; an empty string starts a new session.
+func decodeFeedCursor(s string, now time.Time) (feedCursor, error) {
+	if s == "" {
+		return newFeedCursor(now), nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return feedCursor{}, errInvalidFeedCursor
+	}
+	var c feedCursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.AsOf.IsZero() || c.Page < 0 || c.Ranked < 0 {
+		return feedCursor{}, errInvalidFeedCursor
+	}
+	return c, nil
+}
+
+// rng returns the session's sampler. Every page replays the same draw so
+// later pages can skip the picks earlier pages already showed.
+func (c feedCursor) rng() *rand.Rand {
+	return rand.New(rand.NewSource(c.Seed))
+}
+
+// olderThan matches documents on timeField strictly after pos in
+// newest-first order, or at/before asOf when pos is nil (first page).
+func olderThan(timeField string, pos *FeedPosition, asOf time.Time) bson.M {
+	if pos == nil {
+		return bson.M{timeField: bson.M{"$lte": asOf}}
+	}
+	return bson.M{"$or": bson.A{
+		bson.M{timeField: bson.M{"$lt": pos.At}},
+		bson.M{timeField: pos.At, "_id": bson.M{"$lt": pos.ID}},
+	}}
+}
+
+// newestFirst is the sort that matches olderThan.
+func newestFirst(timeField string) bson.D {
+	return bson.D{{Key: timeField, Value: -1}, {Key: "_id", Value: -1}}
+}
+
+// mergeFeedSources merges one page of posts and ring closures newest-first,
+// keeps the first n, and returns the cursor advanced past exactly what was
+// kept — items fetched but cut stay ahead of the cursor for the next page.
+func mergeFeedSources(posts []types.PostDocument, rings []FeedRingsClosedData, n int, viewer primitive.ObjectID, c feedCursor) ([]FeedItem, feedCursor, bool) {
+	type sourced struct {
+		item FeedItem
+		pos  FeedPosition
+	}
+	merged := make([]sourced, 0, len(posts)+len(rings))
+	for i := range posts {
+		merged = append(merged, sourced{
+			item: FeedItem{Type: "post", Post: posts[i].ToAPI(viewer)},
+			pos:  FeedPosition{At: posts[i].Metadata.CreatedAt, ID: posts[i].ID},
+		})
+	}
+	for i := range rings {
+		rc := rings[i]
+		id, _ := primitive.ObjectIDFromHex(rc.ID)
+		merged = append(merged, sourced{
+			item: FeedItem{Type: "rings_closed", RingsClosed: &rc},
+			pos:  FeedPosition{At: rc.createdAt, ID: id},
+		})
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		a, b := merged[i].pos, merged[j].pos
+		if !a.At.Equal(b.At) {
+			return a.At.After(b.At)
+		}
+		return bytes.Compare(a.ID[:], b.ID[:]) > 0
+	})
+
+	cut := len(merged) > n
+	if cut {
+		merged = merged[:n]
+	}
+	next := c
+	next.Page++
+	items := make([]FeedItem, len(merged))
+	for i, m := range merged {
+		items[i] = m.item
+		pos := m.pos
+		if m.item.Type == "post" {
+			next.Posts = &pos
+		} else {
+			next.Rings = &pos
+		}
+	}
+	return items, next, cut
+}
+
+// pageTasks replays the session's draw for every page so far and returns this
+// page's share, so tasks neither repeat nor reshuffle while scrolling.
+func pageTasks(candidates []taskCandidate, perPage int, c feedCursor) []FeedTaskData {
+	if perPage <= 0 {
+		return nil
+	}
+	total := (c.Page + 1) * perPage
+	var drawn []FeedTaskData
+	if c.Page == 0 {
+		drawn = sampleTasks(candidates, total, defaultTaskScoring, c.AsOf, c.rng())
+	} else {
+		// Always the weighted draw past the first page: sampleTasks' best-first
+		// shortcut for small pools would reorder picks earlier pages showed.
+		drawn = drawTasks(candidates, scorePool(candidates, defaultTaskScoring, c.AsOf), total, c.rng())
+	}
+	from := c.Page * perPage
+	if from >= len(drawn) {
+		return nil
+	}
+	return drawn[from:min(len(drawn), from+perPage)]
+}
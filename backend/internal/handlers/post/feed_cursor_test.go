@@ -0,0 +1,120 @@
+package Post
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestFeedCursor_RoundTrip(t *testing.T) {
+	c := newFeedCursor(testNow)
+	c.Page = 2
+	c.Posts = &FeedPosition{At: testNow.Add(-time.Hour), ID: primitive.NewObjectID()}
+
+	got, err := decodeFeedCursor(c.encode(), time.Now())
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if !got.AsOf.Equal(c.AsOf) || got.Seed != c.Seed || got.Page != 2 || got.Rings != nil {
+		t.Fatalf("cursor = %+v, want %+v", got, c)
+	}
+	if got.Posts == nil || got.Posts.ID != c.Posts.ID || !got.Posts.At.Equal(c.Posts.At) {
+		t.Fatalf("posts position = %+v, want %+v", got.Posts, c.Posts)
+	}
+
+	fresh, err := decodeFeedCursor("", testNow)
+	if err != nil || !fresh.AsOf.Equal(testNow) || fresh.Posts != nil {
+		t.Fatalf("empty cursor = %+v, %v; want a new session", fresh, err)
+	}
+
+	for _, bad := range []string{"not base64!", "e30", "eyJwYWdlIjotMX0"} {
+		if _, err := decodeFeedCursor(bad, testNow); err != errInvalidFeedCursor {
+			t.Errorf("decode(%q) err = %v, want invalid", bad, err)
+		}
+	}
+}
+
+func TestMergeFeedSources_AdvancesPastKeptItemsOnly(t *testing.T) {
+	viewer := primitive.NewObjectID()
+	post := func(minsAgo int) types.PostDocument {
+		return types.PostDocument{ID: primitive.NewObjectID(), Metadata: types.PostMetadata{CreatedAt: testNow.Add(-time.Duration(minsAgo) * time.Minute)}}
+	}
+	ring := func(minsAgo int) FeedRingsClosedData {
+		return FeedRingsClosedData{ID: primitive.NewObjectID().Hex(), createdAt: testNow.Add(-time.Duration(minsAgo) * time.Minute)}
+	}
+	posts := []types.PostDocument{post(1), post(3), post(5)}
+	rings := []FeedRingsClosedData{ring(2), ring(6)}
+
+	items, next, cut := mergeFeedSources(posts, rings, 3, viewer, newFeedCursor(testNow))
+	if !cut || len(items) != 3 {
+		t.Fatalf("items = %d, cut = %v; want 3 and cut", len(items), cut)
+	}
+	if items[0].Type != "post" || items[1].Type != "rings_closed" || items[2].Type != "post" {
+		t.Fatalf("order = %s %s %s, want post rings_closed post", items[0].Type, items[1].Type, items[2].Type)
+	}
+	if next.Page != 1 || next.Posts == nil || next.Posts.ID != posts[1].ID {
+		t.Fatalf("posts position = %+v, want the 3-minute post", next.Posts)
+	}
+	// The 6-minute ring was fetched but not shown; the cursor must stop at the 2-minute one.
+	if next.Rings == nil || next.Rings.ID.Hex() != rings[0].ID {
+		t.Fatalf("rings position = %+v, want the 2-minute ring", next.Rings)
+	}
+}
+
+func TestMergeFeedSources_TiesBreakOnID(t *testing.T) {
+	a := types.PostDocument{ID: primitive.NewObjectID(), Metadata: types.PostMetadata{CreatedAt: testNow}}
+	b := types.PostDocument{ID: primitive.NewObjectID(), Metadata: types.PostMetadata{CreatedAt: testNow}}
+
+	items, next, _ := mergeFeedSources([]types.PostDocument{a, b}, nil, 1, primitive.NewObjectID(), newFeedCursor(testNow))
+	if len(items) != 1 || next.Posts.ID != b.ID {
+		t.Fatalf("kept %+v, want the higher _id first to match the $lt _id resume", next.Posts)
+	}
+}
+
+func TestPageTasks_ReproducibleAndDisjoint(t *testing.T) {
+	candidates := makeCandidates(5, 20)
+	c := newFeedCursor(testNow)
+
+	seen := map[string]int{}
+	for page := 0; page < 4; page++ {
+		c.Page = page
+		first := pageTasks(candidates, 3, c)
+		again := pageTasks(candidates, 3, c)
+		if len(first) != 3 {
+			t.Fatalf("page %d: %d tasks, want 3", page, len(first))
+		}
+		for i := range first {
+			if first[i].ID != again[i].ID {
+				t.Fatalf("page %d is not reproducible: %s vs %s", page, first[i].ID, again[i].ID)
+			}
+			if prev, ok := seen[first[i].ID]; ok {
+				t.Fatalf("task %s shown on page %d and again on page %d", first[i].ID, prev, page)
+			}
+			seen[first[i].ID] = page
+		}
+	}
+
+	// The last page drains the pool without repeating earlier picks.
+	small := makeCandidates(2, 5)
+	shown := map[string]bool{}
+	for page := 0; page < 3; page++ {
+		c.Page = page
+		for _, task := range pageTasks(small, 3, c) {
+			if shown[task.ID] {
+				t.Fatalf("task %s repeated on page %d", task.ID, page)
+			}
+			shown[task.ID] = true
+		}
+	}
+	if len(shown) != len(small) {
+		t.Fatalf("showed %d of %d tasks", len(shown), len(small))
+	}
+
+	// Once the pool is spent, later pages get no tasks rather than repeats.
+	c.Page = 10
+	if got := pageTasks(candidates, 3, c); len(got) != 0 {
+		t.Fatalf("exhausted pool returned %d tasks", len(got))
+	}
+}
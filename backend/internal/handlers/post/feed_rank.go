@@ -0,0 +1,256 @@
+package Post
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/config"
+	"github.com/abhikaboy/Kindred/internal/friendship"
+	"github.com/abhikaboy/Kindred/internal/gemini"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Feed modes. A ranked session orders the newest RankedPoolSize items by
+// score, then continues chronologically below them.
+const (
+	feedModeChronological = "chronological"
+	feedModeRanked        = "ranked"
+)
+
+// impressionsCollection records which feed items each viewer has seen, so
+// ranking can demote them on later sessions.
+const impressionsCollection = "feed_impressions"
+
+// Saturation points: the count at which a signal reaches half its weight.
+const (
+	engagementHalfCount = 5
+	kudosHalfCount      = 2
+)
+
+// defaultFeedRanking matches the envDefaults on config.Feed, for services
+// built without config (tests and scripts).
+var defaultFeedRanking = config.Feed{
+	RecencyWeight:        3,
+	RecencyHalfLifeHours: 12,
+	FriendshipWeight:     2,
+	EngagementWeight:     1.5,
+	KudosWeight:          1,
+	AffinityWeight:       1,
+	SeenPenalty:          2.5,
+	RankedPoolSize:       150,
+}
+
+// rankSignals is what ranking knows about the viewer, keyed by hex ID.
+type rankSignals struct {
+	closeness map[string]float64 // author → friendship closeness, 0..1
+	affinity  map[string]float64 // author → viewer's kudos-affinity, 0..1
+	seen      map[string]bool    // item → seen before this session began
+}
+
+// feedItemRef identifies an item, its author and when it happened.
+func feedItemRef(item FeedItem) (id, author string, at time.Time) {
+	switch {
+	case item.Post != nil:
+		return item.Post.ID.Hex(), item.Post.User.ID, item.Post.Metadata.CreatedAt
+	case item.RingsClosed != nil:
+		if item.RingsClosed.User != nil {
+			author = item.RingsClosed.User.ID
+		}
+		return item.RingsClosed.ID, author, item.RingsClosed.createdAt
+	case item.Task != nil:
+		at, _ = time.Parse(time.RFC3339, item.Task.CompletedAt)
+		if at.IsZero() {
+			at, _ = time.Parse(time.RFC3339, item.Task.Timestamp)
+		}
+		if item.Task.User != nil {
+			author = item.Task.User.ID
+		}
+		return item.Task.ID, author, at
+	}
+	return "", "", time.Time{}
+}
+
+// scoreFeedItem returns each signal's weighted contribution. Recency is
+// measured from the session's asOf so scores hold still while scrolling.
+func scoreFeedItem(item FeedItem, sig rankSignals, cfg config.Feed, asOf time.Time) FeedScore {
+	id, author, at := feedItemRef(item)
+	var s FeedScore
+
+	if cfg.RecencyHalfLifeHours > 0 && !at.IsZero() {
+		ageHours := math.Max(0, asOf.Sub(at).Hours())
+		s.Recency = cfg.RecencyWeight * math.Exp2(-ageHours/cfg.RecencyHalfLifeHours)
+	}
+	s.Friendship = cfg.FriendshipWeight * sig.closeness[author]
+	s.Affinity = cfg.AffinityWeight * sig.affinity[author]
+
+	if item.Post != nil {
+		engagement := item.Post.CommentCount
+		for _, users := range item.Post.Reactions {
+			engagement += len(users)
+		}
+		s.Engagement = cfg.EngagementWeight * saturate(engagement, engagementHalfCount)
+		s.Kudos = cfg.KudosWeight * saturate(len(item.Post.Kudos), kudosHalfCount)
+	}
+	if sig.seen[id] {
+		s.Seen = -cfg.SeenPenalty
+	}
+
+	s.Total = s.Recency + s.Friendship + s.Engagement + s.Kudos + s.Affinity + s.Seen
+	return s
+}
+
+// saturate maps a count onto 0..1, reaching 0.5 at half.
+func saturate(n, half int) float64 {
+	if n <= 0 {
+		return 0
+	}
+	return float64(n) / float64(n+half)
+}
+
+// rankFeedItems scores items and orders them best first. Ties break on the
+// higher ID so every page of a session sees the same order.
+func rankFeedItems(items []FeedItem, sig rankSignals, cfg config.Feed, asOf time.Time) []FeedItem {
+	type keyed struct {
+		item FeedItem
+		id   string
+	}
+	scored := make([]keyed, len(items))
+	for i, item := range items {
+		score := scoreFeedItem(item, sig, cfg, asOf)
+		item.Score = &score
+		id, _, _ := feedItemRef(item)
+		scored[i] = keyed{item: item, id: id}
+	}
+	sort.SliceStable(scored, func(i, j int) bool {
+		a, b := scored[i].item.Score.Total, scored[j].item.Score.Total
+		if a != b {
+			return a > b
+		}
+		return scored[i].id > scored[j].id
+	})
+	ranked := make([]FeedItem, len(scored))
+	for i, k := range scored {
+		ranked[i] = k.item
+	}
+	return ranked
+}
+
+// rankedFeedPage serves the ranked part of a session: it rebuilds the same
+// pool from the session snapshot on every page and returns the next slice.
+// Once the pool is drained the cursor switches to chronological paging
+// below the pool.
+func (s *Service) rankedFeedPage(ctx context.Context, viewer primitive.ObjectID, n int, c feedCursor) ([]FeedItem, feedCursor, bool, error) {
+	pool := c
+	pool.Posts, pool.Rings, pool.Timeline = nil, nil, nil
+	items, below, more, err := s.feedPage(ctx, viewer, s.Ranking.RankedPoolSize, pool)
+	if err != nil {
+		return nil, c, false, err
+	}
+
+	ranked := rankFeedItems(items, s.rankSignals(ctx, viewer, items, c.AsOf), s.Ranking, c.AsOf)
+	from := min(c.Ranked, len(ranked))
+	to := min(from+n, len(ranked))
+
+	next := c
+	next.Page++
+	next.Ranked = to
+	if to < len(ranked) {
+		return ranked[from:to], next, true, nil
+	}
+	next.Mode = feedModeChronological
+	next.Ranked = 0
+	next.Posts, next.Rings, next.Timeline = below.Posts, below.Rings, below.Timeline
+	return ranked[from:to], next, more, nil
+}
+
+// feedPage reads one chronological page from the session's source.
+func (s *Service) feedPage(ctx context.Context, viewer primitive.ObjectID, n int, c feedCursor) ([]FeedItem, feedCursor, bool, error) {
+	if c.Source == feedSourceTimeline {
+		return s.timelineFeedPage(ctx, viewer, n, c)
+	}
+	return s.liveFeedPage(viewer, n, c)
+}
+
+// rankSignals loads the viewer-specific signals for items. Each source is
+// best-effort: a failed read drops that signal rather than the feed.
+func (s *Service) rankSignals(ctx context.Context, viewer primitive.ObjectID, items []FeedItem, asOf time.Time) rankSignals {
+	sig := rankSignals{
+		closeness: map[string]float64{},
+		affinity:  map[string]float64{},
+		seen:      map[string]bool{},
+	}
+
+	for friend, score := range s.Friendship.Scores(ctx, viewer) {
+		sig.closeness[friend.Hex()] = friendship.Closeness(score)
+	}
+
+	if s.Memory != nil {
+		affinity, err := gemini.LoadKudosAffinity(ctx, s.Memory, viewer)
+		if err != nil {
+			slog.Warn("Failed to load kudos-affinity for feed ranking", "userId", viewer.Hex(), "error", err)
+		}
+		if affinity != nil {
+			for _, f := range affinity.Friends {
+				sig.affinity[f.FriendID.Hex()] = math.Max(0, math.Min(1, f.Affinity))
+			}
+		}
+	}
+
+	if s.Impressions != nil && len(items) > 0 {
+		ids := make([]string, 0, len(items))
+		for _, item := range items {
+			if id, _, _ := feedItemRef(item); id != "" {
+				ids = append(ids, id)
+			}
+		}
+		// Only impressions from before the session count, so items seen on
+		// page one don't sink and resurface on page two.
+		cursor, err := s.Impressions.Find(ctx,
+			bson.M{"user": viewer, "item": bson.M{"$in": ids}, "firstSeenAt": bson.M{"$lt": asOf}},
+			options.Find().SetProjection(bson.M{"item": 1}),
+		)
+		if err != nil {
+			slog.Warn("Failed to load feed impressions", "userId", viewer.Hex(), "error", err)
+			return sig
+		}
+		var seen []struct {
+			Item string `bson:"item"`
+		}
+		if err := cursor.All(ctx, &seen); err != nil {
+			slog.Warn("Failed to decode feed impressions", "userId", viewer.Hex(), "error", err)
+			return sig
+		}
+		for _, imp := range seen {
+			sig.seen[imp.Item] = true
+		}
+	}
+	return sig
+}
+
+// RecordImpressions marks feed items as seen by viewer. Repeat views bump
+// lastSeenAt and the count; firstSeenAt is what ranking reads.
+func (s *Service) RecordImpressions(ctx context.Context, viewer primitive.ObjectID, items []string) error {
+	if s.Impressions == nil || len(items) == 0 {
+		return nil
+	}
+	now := time.Now()
+	models := make([]mongo.WriteModel, 0, len(items))
+	for _, item := range items {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"user": viewer, "item": item}).
+			SetUpdate(bson.M{
+				"$setOnInsert": bson.M{"firstSeenAt": now},
+				"$set":         bson.M{"lastSeenAt": now},
+				"$inc":         bson.M{"count": 1},
+			}).
+			SetUpsert(true))
+	}
+	_, err := s.Impressions.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return err
+}
@@ -0,0 +1,112 @@
+package Post
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func rankedPost(author string, hoursAgo float64) FeedItem {
+	return FeedItem{Type: "post", Post: &types.PostDocumentAPI{
+		ID:       primitive.NewObjectID(),
+		User:     types.UserExtendedReference{ID: author},
+		Metadata: types.PostMetadata{CreatedAt: testNow.Add(-time.Duration(hoursAgo * float64(time.Hour)))},
+	}}
+}
+
+func emptySignals() rankSignals {
+	return rankSignals{closeness: map[string]float64{}, affinity: map[string]float64{}, seen: map[string]bool{}}
+}
+
+func TestScoreFeedItem_Signals(t *testing.T) {
+	cfg := defaultFeedRanking
+	author := primitive.NewObjectID().Hex()
+	item := rankedPost(author, cfg.RecencyHalfLifeHours)
+	item.Post.Reactions = map[string][]string{"🔥": {"a", "b", "c"}}
+	item.Post.CommentCount = 2
+	item.Post.Kudos = []types.PostKudos{{}, {}}
+
+	sig := emptySignals()
+	sig.closeness[author] = 0.5
+	sig.affinity[author] = 1
+	sig.seen[item.Post.ID.Hex()] = true
+
+	s := scoreFeedItem(item, sig, cfg, testNow)
+	checks := []struct {
+		name      string
+		got, want float64
+	}{
+		{"recency at one half-life", s.Recency, cfg.RecencyWeight / 2},
+		{"friendship", s.Friendship, cfg.FriendshipWeight * 0.5},
+		{"engagement at the half count", s.Engagement, cfg.EngagementWeight / 2},
+		{"kudos at the half count", s.Kudos, cfg.KudosWeight / 2},
+		{"affinity", s.Affinity, cfg.AffinityWeight},
+		{"seen", s.Seen, -cfg.SeenPenalty},
+		{"total", s.Total, s.Recency + s.Friendship + s.Engagement + s.Kudos + s.Affinity + s.Seen},
+	}
+	for _, c := range checks {
+		if diff := c.got - c.want; diff > 1e-9 || diff < -1e-9 {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+
+	// A zero weight switches its signal off.
+	cfg.FriendshipWeight = 0
+	if s := scoreFeedItem(item, sig, cfg, testNow); s.Friendship != 0 {
+		t.Errorf("friendship with zero weight = %v, want 0", s.Friendship)
+	}
+}
+
+func TestRankFeedItems_Order(t *testing.T) {
+	friendID, stranger := primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex()
+	fresh := rankedPost(stranger, 0)
+	friend := rankedPost(friendID, 2)
+	seen := rankedPost(stranger, 0)
+	old := rankedPost(stranger, 72)
+
+	sig := emptySignals()
+	sig.closeness[friendID] = 1
+	sig.seen[seen.Post.ID.Hex()] = true
+
+	ranked := rankFeedItems([]FeedItem{old, seen, fresh, friend}, sig, defaultFeedRanking, testNow)
+	want := []primitive.ObjectID{friend.Post.ID, fresh.Post.ID, seen.Post.ID, old.Post.ID}
+	for i, id := range want {
+		if ranked[i].Post.ID != id {
+			t.Fatalf("rank %d = %s, want %s", i, ranked[i].Post.ID.Hex(), id.Hex())
+		}
+		if ranked[i].Score == nil {
+			t.Fatalf("rank %d has no score", i)
+		}
+	}
+
+	// Equal scores fall back to the ID so every page agrees on the order.
+	a, b := rankedPost(stranger, 1), rankedPost(stranger, 1)
+	a.Post.Metadata.CreatedAt = b.Post.Metadata.CreatedAt
+	first := rankFeedItems([]FeedItem{a, b}, emptySignals(), defaultFeedRanking, testNow)
+	second := rankFeedItems([]FeedItem{b, a}, emptySignals(), defaultFeedRanking, testNow)
+	if first[0].Post.ID != b.Post.ID || second[0].Post.ID != b.Post.ID {
+		t.Fatalf("tie should go to the higher ID %s", b.Post.ID.Hex())
+	}
+}
+
+func TestFeedItemRef_Kinds(t *testing.T) {
+	at := testNow.Add(-time.Hour)
+	user := primitive.NewObjectID().Hex()
+
+	rings := FeedItem{Type: "rings_closed", RingsClosed: &FeedRingsClosedData{ID: "r1", User: &types.UserExtendedReference{ID: user}, createdAt: at}}
+	if id, author, got := feedItemRef(rings); id != "r1" || author != user || !got.Equal(at) {
+		t.Errorf("rings ref = %s %s %v", id, author, got)
+	}
+
+	task := FeedItem{Type: "task", Task: &FeedTaskData{
+		ID:          "t1",
+		Timestamp:   testNow.Add(-48 * time.Hour).Format(time.RFC3339),
+		CompletedAt: at.Format(time.RFC3339),
+		User:        &types.UserExtendedReference{ID: user},
+	}}
+	if id, author, got := feedItemRef(task); id != "t1" || author != user || !got.Equal(at.Truncate(time.Second)) {
+		t.Errorf("completed task ref = %s %s %v, want the completion time", id, author, got)
+	}
+}
@@ -0,0 +1,362 @@
+package Post
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/internal/timeline"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// feedSourceTimeline marks a feed session served from the materialized
+// timeline. The source is fixed on the first page so a session never mixes
+// the two paths.
+const feedSourceTimeline = "timeline"
+
+// Backfill bounds: enough history for a long scroll without copying every
+// friend's archive into every timeline.
+const (
+	backfillPostLimit  = 300
+	backfillPageSize   = 100
+	backfillRingLimit  = 100
+	backfillTaskLimit  = 200
+	backfillTaskWindow = 30 * 24 * time.Hour
+)
+
+// liveFeedPage builds the chronological part of a feed page by aggregating
+// friends' posts and ring closures on the fly.
+func (s *Service) liveFeedPage(userID primitive.ObjectID, n int, c feedCursor) ([]FeedItem, feedCursor, bool, error) {
+	posts, postsMore, err := s.GetFriendsPosts(userID, n, c.AsOf, c.Posts)
+	if err != nil {
+		return nil, c, false, err
+	}
+
+	// Ring closures are decoration; errors degrade to a posts-only feed.
+	ringClosures, ringsMore, err := s.GetFriendsRingClosures(userID, n, c.AsOf, c.Rings)
+	if err != nil {
+		ringClosures = nil
+		ringsMore = false
+	}
+
+	items, next, cut := mergeFeedSources(posts, ringClosures, n, userID, c)
+	return items, next, postsMore || ringsMore || cut, nil
+}
+
+// timelineFeedPage builds the chronological part of a feed page from the
+// viewer's materialized timeline.
+func (s *Service) timelineFeedPage(ctx context.Context, userID primitive.ObjectID, n int, c feedCursor) ([]FeedItem, feedCursor, bool, error) {
+	entries, more, err := s.Timeline.Page(ctx, userID, n, olderThan("at", c.Timeline, c.AsOf))
+	if err != nil {
+		return nil, c, false, fmt.Errorf("failed to read timeline: %w", err)
+	}
+
+	next := c
+	next.Page++
+	if len(entries) == 0 {
+		return nil, next, false, nil
+	}
+	last := entries[len(entries)-1]
+	next.Timeline = &FeedPosition{At: last.At, ID: last.ID}
+
+	var postIDs []primitive.ObjectID
+	for _, e := range entries {
+		if e.Kind == timeline.KindPost {
+			postIDs = append(postIDs, e.Ref)
+		}
+	}
+	// Entries are filed at write time; the audience is checked again here so
+	// a post whose audience has since narrowed, or an author the viewer has
+	// since muted, drops out at once.
+	viewer, err := s.Audiences.Viewer(ctx, userID)
+	if err != nil {
+		return nil, c, false, fmt.Errorf("failed to load viewer: %w", err)
+	}
+	posts := map[primitive.ObjectID]types.PostDocument{}
+	if len(postIDs) > 0 {
+		cursor, err := s.Posts.Find(ctx, bson.M{"$and": bson.A{
+			bson.M{"_id": bson.M{"$in": postIDs}, "metadata.isDeleted": false},
+			viewer.Filter(),
+		}})
+		if err != nil {
+			return nil, c, false, fmt.Errorf("failed to hydrate timeline posts: %w", err)
+		}
+		var docs []types.PostDocument
+		if err := cursor.All(ctx, &docs); err != nil {
+			return nil, c, false, fmt.Errorf("failed to decode timeline posts: %w", err)
+		}
+		for _, p := range docs {
+			posts[p.ID] = p
+		}
+	}
+
+	blockedUserIDs, excludedPostIDs := s.feedExclusions(ctx, userID)
+	hidden := make(map[primitive.ObjectID]bool, len(blockedUserIDs)+len(excludedPostIDs))
+	for _, id := range blockedUserIDs {
+		hidden[id] = true
+	}
+	for _, id := range excludedPostIDs {
+		hidden[id] = true
+	}
+	for _, id := range viewer.FeedHidden() {
+		hidden[id] = true
+	}
+
+	return timelineItems(entries, posts, hidden, userID), next, more, nil
+}
+
+// timelineItems renders entries as feed items in timeline order. Entries
+// whose post is gone, or whose author or post is hidden from the viewer,
+// are skipped; the cursor still moves past them.
+func timelineItems(entries []timeline.Entry, posts map[primitive.ObjectID]types.PostDocument, hidden map[primitive.ObjectID]bool, viewer primitive.ObjectID) []FeedItem {
+	items := make([]FeedItem, 0, len(entries))
+	for _, e := range entries {
+		if hidden[e.Author] || hidden[e.Ref] {
+			continue
+		}
+		switch e.Kind {
+		case timeline.KindPost:
+			p, ok := posts[e.Ref]
+			if !ok {
+				continue
+			}
+			items = append(items, FeedItem{Type: "post", Post: p.ToAPI(viewer)})
+		case timeline.KindRingsClosed:
+			if e.Rings == nil {
+				continue
+			}
+			items = append(items, FeedItem{Type: "rings_closed", RingsClosed: &FeedRingsClosedData{
+				ID:        e.Ref.Hex(),
+				Timestamp: e.At.Format(time.RFC3339),
+				Content:   e.Rings.Content,
+				User:      userReference(e.Rings.User),
+				createdAt: e.At,
+			}})
+		case timeline.KindTask:
+			if e.Task == nil {
+				continue
+			}
+			items = append(items, FeedItem{Type: "task", Task: &FeedTaskData{
+				ID:            e.Ref.Hex(),
+				Content:       e.Task.Content,
+				Priority:      e.Task.Priority,
+				Value:         e.Task.Value,
+				Public:        true,
+				Timestamp:     e.Task.Timestamp.Format(time.RFC3339),
+				CategoryID:    e.Task.CategoryID.Hex(),
+				CategoryName:  e.Task.CategoryName,
+				WorkspaceName: e.Task.WorkspaceName,
+				User:          userReference(e.Task.User),
+				CompletedAt:   e.At.Format(time.RFC3339),
+			}})
+		}
+	}
+	return items
+}
+
+func userReference(u types.UserExtendedReferenceInternal) *types.UserExtendedReference {
+	return &types.UserExtendedReference{
+		ID:             u.ID.Hex(),
+		DisplayName:    u.DisplayName,
+		Handle:         u.Handle,
+		ProfilePicture: u.ProfilePicture,
+	}
+}
+
+// timelineBackfills dedupes in-process warm-ups so a burst of cold feed
+// reads backfills each viewer once.
+var timelineBackfills sync.Map
+
+// warmTimelineAsync backfills a cold viewer's timeline in the background so
+// their next session can read it.
+func (s *Service) warmTimelineAsync(userID primitive.ObjectID) {
+	if s.Timeline == nil || s.Timeline.Entries == nil {
+		return
+	}
+	if _, running := timelineBackfills.LoadOrStore(userID, struct{}{}); running {
+		return
+	}
+	go func() {
+		defer timelineBackfills.Delete(userID)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := s.BackfillTimeline(ctx, userID); err != nil {
+			slog.Error("Failed to backfill timeline", "userId", userID.Hex(), "error", err)
+		}
+	}()
+}
+
+// BackfillTimeline copies a viewer's recent feed into their timeline from
+// the live sources, then marks it warm. Safe to re-run: entries upsert on
+// (owner, kind, ref), and fan-out arriving mid-backfill lands either way.
+func (s *Service) BackfillTimeline(ctx context.Context, owner primitive.ObjectID) error {
+	asOf := time.Now()
+	var entries []timeline.Entry
+
+	var after *FeedPosition
+	for count := 0; count < backfillPostLimit; {
+		posts, more, err := s.GetFriendsPosts(owner, backfillPageSize, asOf, after)
+		if err != nil {
+			return fmt.Errorf("backfill posts: %w", err)
+		}
+		for _, p := range posts {
+			via := timeline.ViaFriend
+			switch {
+			case p.User.ID == owner:
+				via = timeline.ViaSelf
+			case p.EffectiveAudience().Type == types.AudienceGroups:
+				via = timeline.ViaGroup
+			}
+			entries = append(entries, timeline.Entry{
+				Owner: owner, Kind: timeline.KindPost, Ref: p.ID, Author: p.User.ID, Via: via, At: p.Metadata.CreatedAt,
+			})
+		}
+		count += len(posts)
+		if !more || len(posts) == 0 {
+			break
+		}
+		last := posts[len(posts)-1]
+		after = &FeedPosition{At: last.Metadata.CreatedAt, ID: last.ID}
+	}
+
+	rings, _, err := s.GetFriendsRingClosures(owner, backfillRingLimit, asOf, nil)
+	if err != nil {
+		return fmt.Errorf("backfill ring closures: %w", err)
+	}
+	for _, rc := range rings {
+		ref, err := primitive.ObjectIDFromHex(rc.ID)
+		if err != nil || rc.User == nil {
+			continue
+		}
+		author, err := primitive.ObjectIDFromHex(rc.User.ID)
+		if err != nil {
+			continue
+		}
+		// Keyed like the live fan-out so the two can't file the closure twice.
+		if rc.ringDay != nil {
+			ref = timeline.RingsClosedRef(author, *rc.ringDay)
+		}
+		entries = append(entries, timeline.Entry{
+			Owner: owner, Kind: timeline.KindRingsClosed, Ref: ref, Author: author, Via: timeline.ViaFriend, At: rc.createdAt,
+			Rings: &timeline.RingsClosed{
+				Content: rc.Content,
+				User: types.UserExtendedReferenceInternal{
+					ID:             author,
+					DisplayName:    rc.User.DisplayName,
+					Handle:         rc.User.Handle,
+					ProfilePicture: rc.User.ProfilePicture,
+				},
+			},
+		})
+	}
+
+	tasks, err := s.recentFriendCompletions(ctx, owner, asOf)
+	if err != nil {
+		return fmt.Errorf("backfill task completions: %w", err)
+	}
+	entries = append(entries, tasks...)
+
+	if err := s.Timeline.Insert(ctx, entries); err != nil {
+		return fmt.Errorf("write timeline: %w", err)
+	}
+	return s.Timeline.MarkWarm(ctx, owner, asOf)
+}
+
+// recentFriendCompletions builds timeline entries for friends' public tasks
+// completed within the backfill window.
+func (s *Service) recentFriendCompletions(ctx context.Context, owner primitive.ObjectID, asOf time.Time) ([]timeline.Entry, error) {
+	var user struct {
+		Friends []primitive.ObjectID `bson:"friends"`
+	}
+	if err := s.Users.FindOne(ctx, bson.M{"_id": owner}, options.FindOne().SetProjection(bson.M{"friends": 1})).Decode(&user); err != nil {
+		return nil, err
+	}
+	if len(user.Friends) == 0 {
+		return nil, nil
+	}
+
+	completed := s.Posts.Database().Collection("completed-tasks")
+	cursor, err := completed.Find(ctx, bson.M{
+		"user":          bson.M{"$in": user.Friends},
+		"public":        true,
+		"timeCompleted": bson.M{"$gte": asOf.Add(-backfillTaskWindow), "$lte": asOf},
+	}, options.Find().SetSort(bson.D{{Key: "timeCompleted", Value: -1}}).SetLimit(backfillTaskLimit))
+	if err != nil {
+		return nil, err
+	}
+	var docs []struct {
+		ID            primitive.ObjectID `bson:"_id"`
+		Content       string             `bson:"content"`
+		Priority      int                `bson:"priority"`
+		Value         float64            `bson:"value"`
+		Timestamp     time.Time          `bson:"timestamp"`
+		TimeCompleted time.Time          `bson:"timeCompleted"`
+		CategoryID    primitive.ObjectID `bson:"categoryID"`
+		User          primitive.ObjectID `bson:"user"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	if len(docs) == 0 {
+		return nil, nil
+	}
+
+	type categoryNames struct {
+		ID            primitive.ObjectID `bson:"_id"`
+		Name          string             `bson:"name"`
+		WorkspaceName string             `bson:"workspaceName"`
+	}
+	categoryIDs := make([]primitive.ObjectID, 0, len(docs))
+	for _, d := range docs {
+		categoryIDs = append(categoryIDs, d.CategoryID)
+	}
+	categories := map[primitive.ObjectID]categoryNames{}
+	if s.Categories != nil {
+		var cats []categoryNames
+		catCursor, err := s.Categories.Find(ctx, bson.M{"_id": bson.M{"$in": categoryIDs}},
+			options.Find().SetProjection(bson.M{"name": 1, "workspaceName": 1}))
+		if err == nil && catCursor.All(ctx, &cats) == nil {
+			for _, c := range cats {
+				categories[c.ID] = c
+			}
+		}
+	}
+
+	var friends []types.UserExtendedReferenceInternal
+	userCursor, err := s.Users.Find(ctx, bson.M{"_id": bson.M{"$in": user.Friends}},
+		options.Find().SetProjection(bson.M{"display_name": 1, "handle": 1, "profile_picture": 1}))
+	if err != nil {
+		return nil, err
+	}
+	if err := userCursor.All(ctx, &friends); err != nil {
+		return nil, err
+	}
+	refs := make(map[primitive.ObjectID]types.UserExtendedReferenceInternal, len(friends))
+	for _, f := range friends {
+		refs[f.ID] = f
+	}
+
+	entries := make([]timeline.Entry, 0, len(docs))
+	for _, d := range docs {
+		category := categories[d.CategoryID]
+		entries = append(entries, timeline.Entry{
+			Owner: owner, Kind: timeline.KindTask, Ref: d.ID, Author: d.User, Via: timeline.ViaFriend, At: d.TimeCompleted,
+			Task: &timeline.CompletedTask{
+				Content:       d.Content,
+				Priority:      d.Priority,
+				Value:         d.Value,
+				Timestamp:     d.Timestamp,
+				CategoryID:    d.CategoryID,
+				CategoryName:  category.Name,
+				WorkspaceName: category.WorkspaceName,
+				User:          refs[d.User],
+			},
+		})
+	}
+	return entries, nil
+}
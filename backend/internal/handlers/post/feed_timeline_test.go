@@ -0,0 +1,45 @@
+package Post
+
+import (
+	"testing"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/internal/timeline"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestTimelineItems(t *testing.T) {
+	viewer, friend, blocked := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+	live, gone, reported := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+	taskID := primitive.NewObjectID()
+
+	posts := map[primitive.ObjectID]types.PostDocument{
+		live:     {ID: live, Metadata: types.PostMetadata{CreatedAt: testNow}},
+		reported: {ID: reported, Metadata: types.PostMetadata{CreatedAt: testNow}},
+	}
+	entries := []timeline.Entry{
+		{Kind: timeline.KindPost, Ref: live, Author: friend, At: testNow},
+		{Kind: timeline.KindPost, Ref: gone, Author: friend, At: testNow},
+		{Kind: timeline.KindPost, Ref: reported, Author: friend, At: testNow},
+		{Kind: timeline.KindRingsClosed, Ref: primitive.NewObjectID(), Author: blocked, At: testNow,
+			Rings: &timeline.RingsClosed{Content: "closed"}},
+		{Kind: timeline.KindTask, Ref: taskID, Author: friend, At: testNow,
+			Task: &timeline.CompletedTask{Content: "ship it", User: types.UserExtendedReferenceInternal{ID: friend, Handle: "sam"}}},
+	}
+	hidden := map[primitive.ObjectID]bool{blocked: true, reported: true}
+
+	items := timelineItems(entries, posts, hidden, viewer)
+	if len(items) != 2 {
+		t.Fatalf("items = %d, want the live post and the completed task", len(items))
+	}
+	if items[0].Type != "post" || items[0].Post.ID != live {
+		t.Errorf("first item = %+v, want the live post", items[0])
+	}
+	task := items[1].Task
+	if items[1].Type != "task" || task == nil || task.ID != taskID.Hex() || task.User.Handle != "sam" {
+		t.Fatalf("second item = %+v, want the completed task", items[1])
+	}
+	if task.CompletedAt == "" {
+		t.Error("completed task should carry completedAt")
+	}
+}
@@ -52,6 +52,17 @@ func RegisterGetFeedOperation(api huma.API, handler *Handler) {
 	}, handler.GetFeedHuma)
 }
 
+func RegisterRecordFeedImpressionsOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "record-feed-impressions",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/feed/impressions",
+		Summary:     "Record feed impressions",
+		Description: "Mark feed items as seen so the ranked feed can demote them in later sessions",
+		Tags:        []string{"feed"},
+	}, handler.RecordFeedImpressionsHuma)
+}
+
 func RegisterGetUserGroupsOperation(api huma.API, handler *Handler) {
 	huma.Register(api, huma.Operation{
 		OperationID: "get-user-groups",
@@ -129,6 +140,50 @@ func RegisterDeleteCommentOperation(api huma.API, handler *Handler) {
 	}, handler.DeleteCommentHuma)
 }
 
+func RegisterEditCommentOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "edit-comment",
+		Method:      http.MethodPatch,
+		Path:        "/v1/user/posts/{postId}/comment/{commentId}",
+		Summary:     "Edit comment",
+		Description: "Edit the text and mentions of your own comment",
+		Tags:        []string{"posts"},
+	}, handler.EditCommentHuma)
+}
+
+func RegisterApproveCommentOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "approve-comment",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/posts/{postId}/comment/{commentId}/approve",
+		Summary:     "Approve comment",
+		Description: "Publish a pending comment a restricted user left on your post",
+		Tags:        []string{"posts"},
+	}, handler.ApproveCommentHuma)
+}
+
+func RegisterGetCommentsOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-comments",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/posts/{postId}/comments",
+		Summary:     "Get comments",
+		Description: "Page through a post's root comments newest first, or through one thread's replies oldest first",
+		Tags:        []string{"posts"},
+	}, handler.GetCommentsHuma)
+}
+
+func RegisterToggleCommentReactionOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "toggle-comment-reaction",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/posts/{postId}/comment/{commentId}/reaction",
+		Summary:     "React to a comment",
+		Description: "Adds or removes an emoji reaction on a comment",
+		Tags:        []string{"posts"},
+	}, handler.ToggleCommentReactionHuma)
+}
+
 func RegisterGetUserPosts(api huma.API, handler *Handler) {
 	huma.Register(api, huma.Operation{
 		OperationID: "get-user-posts",
@@ -151,12 +206,101 @@ func RegisterToggleReaction(api huma.API, handler *Handler) {
 	}, handler.ToggleReactionHuma)
 }
 
+func RegisterCreateDraftOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-draft",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/drafts",
+		Summary:     "Save draft",
+		Description: "Save an unpublished post to finish later, on any device",
+		Tags:        []string{"drafts"},
+	}, handler.CreateDraftHuma)
+}
+
+func RegisterGetDraftsOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-drafts",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/drafts",
+		Summary:     "Get drafts",
+		Description: "Get your unpublished and scheduled drafts, most recently edited first",
+		Tags:        []string{"drafts"},
+	}, handler.GetDraftsHuma)
+}
+
+func RegisterGetDraftOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-draft",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/drafts/{id}",
+		Summary:     "Get draft",
+		Description: "Get one of your drafts",
+		Tags:        []string{"drafts"},
+	}, handler.GetDraftHuma)
+}
+
+func RegisterUpdateDraftOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "update-draft",
+		Method:      http.MethodPut,
+		Path:        "/v1/user/drafts/{id}",
+		Summary:     "Update draft",
+		Description: "Replace a draft's content. A scheduled draft stays scheduled while its content can still be published",
+		Tags:        []string{"drafts"},
+	}, handler.UpdateDraftHuma)
+}
+
+func RegisterDeleteDraftOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-draft",
+		Method:      http.MethodDelete,
+		Path:        "/v1/user/drafts/{id}",
+		Summary:     "Delete draft",
+		Description: "Discard a draft that hasn't been published",
+		Tags:        []string{"drafts"},
+	}, handler.DeleteDraftHuma)
+}
+
+func RegisterScheduleDraftOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "schedule-draft",
+		Method:      http.MethodPut,
+		Path:        "/v1/user/drafts/{id}/schedule",
+		Summary:     "Schedule draft",
+		Description: "Publish a draft automatically at the given time",
+		Tags:        []string{"drafts"},
+	}, handler.ScheduleDraftHuma)
+}
+
+func RegisterUnscheduleDraftOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "unschedule-draft",
+		Method:      http.MethodDelete,
+		Path:        "/v1/user/drafts/{id}/schedule",
+		Summary:     "Unschedule draft",
+		Description: "Cancel a draft's scheduled publish, keeping the draft",
+		Tags:        []string{"drafts"},
+	}, handler.UnscheduleDraftHuma)
+}
+
+func RegisterPublishDraftOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "publish-draft",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/drafts/{id}/publish",
+		Summary:     "Publish draft",
+		Description: "Publish a draft now, whether or not it was scheduled",
+		Tags:        []string{"drafts"},
+	}, handler.PublishDraftHuma)
+}
+
 // Register all post operations
 func RegisterPostOperations(api huma.API, handler *Handler) {
 	RegisterCreatePostOperation(api, handler)
 	RegisterGetPostsOperation(api, handler)
 	RegisterGetFriendsPostsOperation(api, handler)
 	RegisterGetFeedOperation(api, handler)
+	RegisterRecordFeedImpressionsOperation(api, handler)
 	RegisterGetUserGroupsOperation(api, handler)
 	RegisterGetPostsByBlueprintOperation(api, handler)
 	RegisterGetPostOperation(api, handler)
@@ -166,4 +310,16 @@ func RegisterPostOperations(api huma.API, handler *Handler) {
 	RegisterAddCommentOperation(api, handler)
 	RegisterToggleReaction(api, handler)
 	RegisterDeleteCommentOperation(api, handler)
+	RegisterEditCommentOperation(api, handler)
+	RegisterApproveCommentOperation(api, handler)
+	RegisterGetCommentsOperation(api, handler)
+	RegisterToggleCommentReactionOperation(api, handler)
+	RegisterCreateDraftOperation(api, handler)
+	RegisterGetDraftsOperation(api, handler)
+	RegisterGetDraftOperation(api, handler)
+	RegisterUpdateDraftOperation(api, handler)
+	RegisterDeleteDraftOperation(api, handler)
+	RegisterScheduleDraftOperation(api, handler)
+	RegisterUnscheduleDraftOperation(api, handler)
+	RegisterPublishDraftOperation(api, handler)
 }
@@ -2,20 +2,18 @@ package Post
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
-	"math/rand"
-	"sort"
 	"time"
 
 	"github.com/abhikaboy/Kindred/internal/handlers/auth"
-	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
-	"github.com/abhikaboy/Kindred/internal/handlers/rings"
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
 	"github.com/abhikaboy/Kindred/internal/xvalidator"
 	"github.com/danielgtaylor/huma/v2"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type Handler struct {
@@ -40,156 +38,22 @@ func (h *Handler) CreatePostHuma(ctx context.Context, input *CreatePostInput) (*
 		return nil, huma.Error400BadRequest("Invalid user ID", err)
 	}
 
-	// Get user info to populate the User field
-	var user types.User
-	err = h.service.Users.FindOne(context.Background(), bson.M{"_id": userObjID}).Decode(&user)
-	if err != nil {
+	createdPost, userStats, ringDelta, err := h.service.PublishPost(ctx, userObjID, input.Body, primitive.NilObjectID, auth.GetTimezoneOrDefault(ctx))
+	switch {
+	case errors.Is(err, errInvalidBlueprintID):
+		return nil, huma.Error400BadRequest("Invalid blueprint ID format", err)
+	case errors.Is(err, errInvalidGroupID):
+		return nil, huma.Error400BadRequest("Invalid group ID format", err)
+	case errors.Is(err, errInvalidAudience):
+		return nil, huma.Error400BadRequest("Invalid audience", err)
+	case errors.Is(err, errPostAuthorNotFound):
 		slog.Error("failed to get user info for post creation", "userId", user_id, "error", err)
 		return nil, huma.Error500InternalServerError("Unable to get user info. Please try again.", err)
-	}
-
-	doc := types.PostDocument{
-		ID: primitive.NewObjectID(),
-		User: types.UserExtendedReferenceInternal{
-			ID:             user.ID,
-			DisplayName:    user.DisplayName,
-			Handle:         user.Handle,
-			ProfilePicture: user.ProfilePicture,
-		},
-		Images:    input.Body.Images,
-		Dual:      input.Body.Dual,
-		Caption:   input.Body.Caption,
-		Size:      input.Body.Size,
-		Task:      input.Body.Task,
-		Song:      input.Body.Song,
-		Comments:  []types.CommentDocument{},
-		Reactions: make(map[string][]primitive.ObjectID),
-		Metadata:  types.NewPostMetadata(),
-	}
-
-	// Prefer the unified media[] when provided; keep Images in sync for back-compat.
-	if len(input.Body.Media) > 0 {
-		doc.Media = ToMediaItems(input.Body.Media)
-		doc.Images = DeriveImagesFromMedia(doc.Media)
-		if doc.Size == nil {
-			doc.Size = PrimarySizeFromMedia(doc.Media)
-		}
-	}
-
-	// Handle blueprint with isPublic flag
-	if input.Body.BlueprintID != nil {
-		blueprintID, err := primitive.ObjectIDFromHex(*input.Body.BlueprintID)
-		if err != nil {
-			return nil, huma.Error400BadRequest("Invalid blueprint ID format", err)
-		}
-
-		blueprintIsPublic := false
-		if input.Body.BlueprintIsPublic != nil {
-			blueprintIsPublic = *input.Body.BlueprintIsPublic
-		}
-
-		doc.Blueprint = types.NewEnhancedBlueprintReference(blueprintID, blueprintIsPublic)
-	}
-
-	// Handle groups
-	if len(input.Body.Groups) > 0 {
-		var groupIDs []primitive.ObjectID
-		for _, groupIDStr := range input.Body.Groups {
-			groupID, err := primitive.ObjectIDFromHex(groupIDStr)
-			if err != nil {
-				return nil, huma.Error400BadRequest("Invalid group ID format", err)
-			}
-			groupIDs = append(groupIDs, groupID)
-		}
-		doc.Groups = groupIDs
-	}
-
-	doc.Metadata.IsPublic = input.Body.IsPublic
-
-	// Collect tag candidates: explicit + encourager auto-tag. coerceMentions
-	// drops any malformed tag so a bad "@" can't fail the post.
-	var tagCandidates []primitive.ObjectID
-	for _, m := range coerceMentions(input.Body.TaggedUsers) {
-		if objID, err := primitive.ObjectIDFromHex(m.ID); err == nil {
-			tagCandidates = append(tagCandidates, objID)
-		}
-	}
-	if input.Body.Task != nil && h.service.EncouragementService != nil {
-		taskID := input.Body.Task.ID
-		encs, encErr := h.service.EncouragementService.GetEncouragementsByTaskAndReceiver(taskID, userObjID)
-		if encErr != nil {
-			slog.Warn("Failed to fetch encouragements for auto-tag", "task_id", taskID, "err", encErr)
-		} else {
-			for _, e := range encs {
-				tagCandidates = append(tagCandidates, e.Sender.ID)
-			}
-		}
-	}
-	if len(tagCandidates) > maxTaggedUsers {
-		tagCandidates = tagCandidates[:maxTaggedUsers] // cap fanout; we dropped the validate=max guard
-	}
-	if len(tagCandidates) > 0 {
-		resolved, resolveErr := h.service.ResolveTaggedUsers(ctx, userObjID, tagCandidates)
-		if resolveErr != nil {
-			slog.Warn("Failed to resolve tagged users", "err", resolveErr)
-		} else {
-			doc.TaggedUsers = resolved
-		}
-	}
-
-	createdPost, userStats, err := h.service.CreatePost(&doc)
-	if err != nil {
+	case err != nil:
 		slog.Error("failed to create post", "userId", user_id, "error", err)
 		return nil, huma.Error500InternalServerError("Unable to create post. Please try again.", err)
 	}
 
-	// Increment Share ring synchronously so the response carries the delta.
-	// NotifyAllRingsClosed is already async (2-minute delayed).
-	var ringDelta *rings.RingDelta
-	if h.service.RingService != nil {
-		tz := auth.GetTimezoneOrDefault(ctx)
-		_, delta, err := h.service.RingService.IncrementRing(ctx, userObjID, tz, rings.RingShare)
-		if err != nil {
-			slog.Error("Failed to increment Share ring on post creation", "user_id", userObjID.Hex(), "error", err)
-		} else {
-			ringDelta = delta
-			if delta.JustClosedAll {
-				h.service.RingService.NotifyAllRingsClosed(userObjID)
-			}
-		}
-	}
-
-	// Fan out tag notifications.
-	var thumbnail string
-	if len(createdPost.Images) > 0 {
-		thumbnail = createdPost.Images[0]
-	}
-	for _, tagged := range createdPost.TaggedUsers {
-		if tagged.ID == userObjID {
-			continue
-		}
-		content := fmt.Sprintf("%s tagged you in a post", createdPost.User.DisplayName)
-		if err := h.service.NotificationService.CreateNotification(userObjID, tagged.ID, content, notifications.NotificationTypePostTag, createdPost.ID, thumbnail); err != nil {
-			slog.Error("Failed to create tag notification", "tagged_user_id", tagged.ID, "err", err)
-		}
-		if err := h.service.sendTagPushNotification(tagged.ID, createdPost.ID, createdPost.User.DisplayName); err != nil {
-			slog.Error("Failed to send tag push notification", "tagged_user_id", tagged.ID, "err", err)
-		}
-	}
-
-	// Notify friends of the new post — best-effort, async so it never adds request
-	// latency. Throttled inside: one wave per poster / 48h, 2 per recipient / day.
-	go func(postID, posterID primitive.ObjectID, name, caption string) {
-		defer func() {
-			if r := recover(); r != nil {
-				slog.Error("post-notify wave panicked", "recover", r)
-			}
-		}()
-		if err := h.service.NotifyFriendsOfPost(postID, posterID, name, caption); err != nil {
-			slog.Error("post-notify wave failed", "error", err)
-		}
-	}(createdPost.ID, userObjID, createdPost.User.DisplayName, createdPost.Caption)
-
 	// Prepare response with user stats
 	response := &CreatePostOutput{}
 	response.Body.PostDocumentAPI = *createdPost.ToAPI(userObjID)
@@ -253,12 +117,12 @@ func (h *Handler) GetFriendsPostsHuma(ctx context.Context, input *GetFriendsPost
 	if limit <= 0 {
 		limit = 8
 	}
-	offset := input.Offset
-	if offset < 0 {
-		offset = 0
+	cursor, err := decodeFeedCursor(input.Cursor, time.Now())
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid cursor", err)
 	}
 
-	posts, total, err := h.service.GetFriendsPosts(userID, limit, offset)
+	posts, hasMore, err := h.service.GetFriendsPosts(userID, limit, cursor.AsOf, cursor.Posts)
 	if err != nil {
 		slog.Error("failed to get friends posts", "userId", userIDStr, "error", err)
 		return nil, huma.Error500InternalServerError("Unable to get friends posts. Please try again.", err)
@@ -271,9 +135,13 @@ func (h *Handler) GetFriendsPostsHuma(ctx context.Context, input *GetFriendsPost
 
 	output := &GetFriendsPostsOutput{}
 	output.Body.Posts = apiPosts
-	output.Body.Total = total
-	output.Body.HasMore = offset+len(apiPosts) < total
-	output.Body.NextOffset = offset + len(apiPosts)
+	output.Body.HasMore = hasMore
+	if hasMore {
+		last := posts[len(posts)-1]
+		cursor.Posts = &FeedPosition{At: last.Metadata.CreatedAt, ID: last.ID}
+		cursor.Page++
+		output.Body.NextCursor = cursor.encode()
+	}
 
 	return output, nil
 }
@@ -295,100 +163,96 @@ func (h *Handler) GetFeedHuma(ctx context.Context, input *GetFeedInput) (*GetFee
 	if limit <= 0 {
 		limit = 20
 	}
-	offset := input.Offset
-	if offset < 0 {
-		offset = 0
+	cursor, err := decodeFeedCursor(input.Cursor, time.Now())
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid cursor", err)
 	}
 
-	// Posts fill the page; sampled tasks displace a few at reserved slots.
-	posts, postsTotal, err := h.service.GetFriendsPosts(userID, limit, offset)
+	// A new session reads the materialized timeline when it is warm; a cold
+	// one gets the live aggregation and warms in the background.
+	if input.Cursor == "" {
+		if h.service.Timeline.Warm(ctx, userID) {
+			cursor.Source = feedSourceTimeline
+		} else {
+			h.service.warmTimelineAsync(userID)
+		}
+		if input.Mode == feedModeRanked {
+			cursor.Mode = feedModeRanked
+		}
+	}
+
+	// Tasks take reserved slots; posts, ring closures and completions share the rest.
+	tasksNeeded := limit / taskSlotInterval
+	chronologicalNeeded := limit - tasksNeeded
+
+	var chronological []FeedItem
+	var next feedCursor
+	var hasMore bool
+	if cursor.Mode == feedModeRanked {
+		chronological, next, hasMore, err = h.service.rankedFeedPage(ctx, userID, chronologicalNeeded, cursor)
+	} else {
+		chronological, next, hasMore, err = h.service.feedPage(ctx, userID, chronologicalNeeded, cursor)
+	}
 	if err != nil {
-		slog.Error("failed to get feed posts", "userId", userIDStr, "error", err)
+		slog.Error("failed to get feed posts", "userId", userIDStr, "source", cursor.Source, "mode", cursor.Mode, "error", err)
 		return nil, huma.Error500InternalServerError("Unable to get feed. Please try again.", err)
 	}
 
 	// Fetch the task candidate pool; scoring + sampling happens below.
-	// Errors degrade to a posts-only feed.
-	taskDocs, tasksTotal, err := h.service.GetFriendsPublicTasks(userID, defaultTaskPoolSize)
+	taskDocs, _, err := h.service.GetFriendsPublicTasks(userID, defaultTaskPoolSize, cursor.AsOf)
 	if err != nil {
 		taskDocs = nil
-		tasksTotal = 0
 	}
-
-	// Get friends' ring closure events
-	ringClosures, ringsTotal, err := h.service.GetFriendsRingClosures(userID, 5, offset/4)
-	if err != nil {
-		ringClosures = []FeedRingsClosedData{}
-		ringsTotal = 0
-	}
-
-	// Convert posts to API format
-	var apiPosts []types.PostDocumentAPI
-	for _, post := range posts {
-		apiPosts = append(apiPosts, *post.ToAPI(userID))
-	}
-
-	// Score the candidate pool and sample the page's task slots
 	candidates := make([]taskCandidate, 0, len(taskDocs))
 	for _, doc := range taskDocs {
 		if c, ok := parseTaskCandidate(doc); ok {
 			candidates = append(candidates, *c)
 		}
 	}
-	now := time.Now()
-	rng := rand.New(rand.NewSource(now.UnixNano()))
-	tasksNeeded := limit / taskSlotInterval
-	sampledTasks := sampleTasks(candidates, tasksNeeded, defaultTaskScoring, now, rng)
-
-	// Build timestamped items for chronological merge (posts + ring closures;
-	// tasks are placed by slot, not by timestamp)
-	type timestampedItem struct {
-		feedItem FeedItem
-		time     time.Time
-	}
+	sampledTasks := pageTasks(candidates, tasksNeeded, cursor)
 
-	var allItems []timestampedItem
-
-	for i := range apiPosts {
-		post := apiPosts[i]
-		allItems = append(allItems, timestampedItem{
-			feedItem: FeedItem{Type: "post", Post: &post},
-			time:     post.Metadata.CreatedAt,
-		})
+	feedItems := interleaveFeedItems(chronological, sampledTasks, limit)
+	if !input.Debug {
+		for i := range feedItems {
+			feedItems[i].Score = nil
+		}
 	}
 
-	for i := range ringClosures {
-		rc := ringClosures[i]
-		t, _ := time.Parse(time.RFC3339, rc.Timestamp)
-		allItems = append(allItems, timestampedItem{
-			feedItem: FeedItem{Type: "rings_closed", RingsClosed: &rc},
-			time:     t,
-		})
+	output := &GetFeedOutput{}
+	output.Body.Items = feedItems
+	output.Body.HasMore = hasMore
+	if output.Body.HasMore {
+		output.Body.NextCursor = next.encode()
 	}
 
-	// Sort by time descending (newest first)
-	sort.Slice(allItems, func(i, j int) bool {
-		return allItems[i].time.After(allItems[j].time)
-	})
+	return output, nil
+}
 
-	chronological := make([]FeedItem, len(allItems))
-	for i, item := range allItems {
-		chronological[i] = item.feedItem
+func (h *Handler) RecordFeedImpressionsHuma(ctx context.Context, input *RecordFeedImpressionsInput) (*RecordFeedImpressionsOutput, error) {
+	userIDStr, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
 	}
 
-	feedItems := interleaveFeedItems(chronological, sampledTasks, limit)
+	userID, err := primitive.ObjectIDFromHex(userIDStr)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
 
-	// Calculate total items and pagination
-	totalItems := postsTotal + tasksTotal + ringsTotal
-	hasMore := offset+len(feedItems) < totalItems
+	for _, item := range input.Body.Items {
+		if _, err := primitive.ObjectIDFromHex(item); err != nil {
+			return nil, huma.Error400BadRequest("Invalid feed item ID", err)
+		}
+	}
 
-	output := &GetFeedOutput{}
-	output.Body.Items = feedItems
-	output.Body.Total = totalItems
-	output.Body.HasMore = hasMore
-	output.Body.NextOffset = offset + len(feedItems)
+	if err := h.service.RecordImpressions(ctx, userID, input.Body.Items); err != nil {
+		slog.Error("failed to record feed impressions", "userId", userIDStr, "count", len(input.Body.Items), "error", err)
+		return nil, huma.Error500InternalServerError("Unable to record impressions. Please try again.", err)
+	}
 
-	return output, nil
+	resp := &RecordFeedImpressionsOutput{}
+	resp.Body.Message = "Impressions recorded"
+	return resp, nil
 }
 
 func (h *Handler) GetUserGroupsHuma(ctx context.Context, input *GetUserGroupsInput) (*GetUserGroupsOutput, error) {
@@ -436,7 +300,7 @@ func (h *Handler) GetPostsByBlueprintHuma(ctx context.Context, input *GetPostsBy
 		return nil, huma.Error400BadRequest("Invalid blueprint ID format", err)
 	}
 
-	posts, err := h.service.GetPostsByBlueprint(blueprintID)
+	posts, err := h.service.GetPostsByBlueprint(viewerID, blueprintID)
 	if err != nil {
 		slog.Error("failed to get posts by blueprint", "blueprintId", input.BlueprintID, "error", err)
 		return nil, huma.Error500InternalServerError("Unable to get posts by blueprint. Please try again.", err)
@@ -469,10 +333,14 @@ func (h *Handler) GetPostHuma(ctx context.Context, input *GetPostInput) (*GetPos
 		return nil, huma.Error400BadRequest("Invalid ID format", err)
 	}
 
-	post, err := h.service.GetPostByID(id)
-	if err != nil {
+	post, err := h.service.GetVisiblePost(ctx, viewerID, id)
+	if errors.Is(err, mongo.ErrNoDocuments) {
 		return nil, huma.Error404NotFound("Post not found", err)
 	}
+	if err != nil {
+		slog.Error("failed to get post", "postId", input.ID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to get post. Please try again.", err)
+	}
 
 	return &GetPostOutput{Body: *post.ToAPI(viewerID)}, nil
 }
@@ -496,23 +364,6 @@ func (h *Handler) GetUserPostsHuma(ctx context.Context, input *GetUserPostsInput
 		return nil, huma.Error400BadRequest("Invalid user ID format", err)
 	}
 
-	// Check relationship between viewer and profile user
-	canView, err := h.service.CheckRelationship(viewerID, profileUserID)
-	if err != nil {
-		slog.Error("failed to check relationship", "viewerId", viewerIDStr, "profileUserId", input.ID, "error", err)
-		return nil, huma.Error500InternalServerError("Unable to check relationship. Please try again.", err)
-	}
-
-	// If not authorized to view, return empty paginated response (similar to tasks behavior)
-	if !canView {
-		output := &GetUserPostsOutput{}
-		output.Body.Posts = []types.PostDocumentAPI{}
-		output.Body.Total = 0
-		output.Body.HasMore = false
-		output.Body.NextOffset = 0
-		return output, nil
-	}
-
 	// Set defaults if not provided
 	limit := input.Limit
 	if limit <= 0 {
@@ -523,8 +374,8 @@ func (h *Handler) GetUserPostsHuma(ctx context.Context, input *GetUserPostsInput
 		offset = 0
 	}
 
-	// User is authorized (friend or self), fetch their posts
-	posts, total, err := h.service.GetUserPosts(profileUserID, limit, offset)
+	// Only the posts whose audience includes the viewer come back
+	posts, total, err := h.service.GetUserPosts(viewerID, profileUserID, limit, offset)
 	if err != nil {
 		return nil, huma.Error404NotFound("Posts not found", err)
 	}
@@ -569,7 +420,13 @@ func (h *Handler) UpdatePostHuma(ctx context.Context, input *UpdatePostInput) (*
 		return nil, huma.Error403Forbidden("You can only edit your own posts")
 	}
 
-	if err := h.service.UpdatePartialPost(ctx, id, input.Body); err != nil {
+	err = h.service.UpdatePartialPost(ctx, id, input.Body)
+	switch {
+	case errors.Is(err, errInvalidGroupID):
+		return nil, huma.Error400BadRequest("Invalid group ID format", err)
+	case errors.Is(err, errInvalidAudience):
+		return nil, huma.Error400BadRequest("Invalid audience", err)
+	case err != nil:
 		slog.Error("failed to update post", "userId", user_id, "postId", input.ID, "error", err)
 		return nil, huma.Error500InternalServerError("Unable to update post. Please try again.", err)
 	}
@@ -631,6 +488,10 @@ func (h *Handler) AddCommentHuma(ctx context.Context, input *AddCommentInput) (*
 		return nil, huma.Error400BadRequest("Invalid post ID format", err)
 	}
 
+	if err := h.requireVisible(ctx, userObjID, postID); err != nil {
+		return nil, err
+	}
+
 	var user types.User
 	err = h.service.Users.FindOne(context.Background(), bson.M{"_id": userObjID}).Decode(&user)
 	if err != nil {
@@ -638,20 +499,7 @@ func (h *Handler) AddCommentHuma(ctx context.Context, input *AddCommentInput) (*
 		return nil, huma.Error500InternalServerError("Unable to get user info. Please try again.", err)
 	}
 
-	// Parse mentions
-	var mentions []types.MentionReference
-	if input.Body.Mentions != nil {
-		for _, m := range input.Body.Mentions {
-			mentionID, err := primitive.ObjectIDFromHex(m.ID)
-			if err != nil {
-				continue // Skip invalid IDs
-			}
-			mentions = append(mentions, types.MentionReference{
-				ID:     mentionID,
-				Handle: m.Handle,
-			})
-		}
-	}
+	mentions := parseMentions(input.Body.Mentions)
 
 	doc := types.CommentDocument{
 		ID: primitive.NewObjectID(),
@@ -674,7 +522,10 @@ func (h *Handler) AddCommentHuma(ctx context.Context, input *AddCommentInput) (*
 		doc.ParentID = &parentID
 	}
 
-	fsDelta, err := h.service.AddComment(postID, doc)
+	comment, fsDelta, err := h.service.AddComment(postID, doc)
+	if errors.Is(err, errCommentNotFound) {
+		return nil, huma.Error404NotFound("Parent comment not found", err)
+	}
 	if err != nil {
 		slog.Error("failed to add comment", "userId", user_id, "postId", input.PostID, "error", err)
 		return nil, huma.Error500InternalServerError("Unable to add comment. Please try again.", err)
@@ -682,7 +533,10 @@ func (h *Handler) AddCommentHuma(ctx context.Context, input *AddCommentInput) (*
 
 	output := &AddCommentOutput{}
 	output.Body.Message = "Comment added successfully"
-	output.Body.Comment = *doc.ToAPI()
+	if comment.Pending {
+		output.Body.Message = "Comment awaiting approval"
+	}
+	output.Body.Comment = *comment.ToAPI()
 	output.Body.FriendshipDelta = fsDelta
 	return output, nil
 }
@@ -703,6 +557,10 @@ func (h *Handler) ToggleReactionHuma(ctx context.Context, input *AddReactionInpu
 		return nil, huma.Error400BadRequest("Invalid post ID", err)
 	}
 
+	if err := h.requireVisible(ctx, userObjID, postObjID); err != nil {
+		return nil, err
+	}
+
 	reaction := &types.ReactDocument{
 		UserID: userObjID,
 		PostID: postObjID,
@@ -756,21 +614,16 @@ func (h *Handler) DeleteCommentHuma(ctx context.Context, input *DeleteCommentInp
 		return nil, huma.Error404NotFound("Post not found", err)
 	}
 
-	var canDelete bool
-	var foundComment *types.CommentDocument
-	for _, comment := range post.Comments {
-		if comment.ID == commentID {
-			foundComment = &comment
-
-			canDelete = comment.User.ID == userObjID || post.User.ID == userObjID
-			break
-		}
+	comment, err := h.service.GetComment(ctx, postID, commentID)
+	if errors.Is(err, errCommentNotFound) {
+		return nil, huma.Error404NotFound("Comment not found", err)
 	}
-
-	if foundComment == nil {
-		return nil, huma.Error404NotFound("Comment not found")
+	if err != nil {
+		slog.Error("failed to load comment", "userId", user_id, "postId", input.PostID, "commentId", input.CommentID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to delete comment. Please try again.", err)
 	}
 
+	canDelete := (comment.User != nil && comment.User.ID == userObjID) || post.User.ID == userObjID
 	if !canDelete {
 		return nil, huma.Error403Forbidden("You can only delete your own comments or comments on your posts")
 	}
@@ -784,3 +637,372 @@ func (h *Handler) DeleteCommentHuma(ctx context.Context, input *DeleteCommentInp
 	resp.Body.Message = "Comment deleted successfully"
 	return resp, nil
 }
+
+func (h *Handler) EditCommentHuma(ctx context.Context, input *EditCommentInput) (*EditCommentOutput, error) {
+	user_id, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
+	}
+
+	userObjID, err := primitive.ObjectIDFromHex(user_id)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	postID, err := primitive.ObjectIDFromHex(input.PostID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid post ID format", err)
+	}
+
+	commentID, err := primitive.ObjectIDFromHex(input.CommentID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid comment ID format", err)
+	}
+
+	comment, err := h.service.EditComment(ctx, postID, commentID, userObjID, input.Body.Content, parseMentions(input.Body.Mentions))
+	switch {
+	case errors.Is(err, errCommentNotFound):
+		return nil, huma.Error404NotFound("Comment not found", err)
+	case errors.Is(err, errCommentForbidden):
+		return nil, huma.Error403Forbidden("You can only edit your own comments")
+	case err != nil:
+		slog.Error("failed to edit comment", "userId", user_id, "postId", input.PostID, "commentId", input.CommentID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to edit comment. Please try again.", err)
+	}
+
+	resp := &EditCommentOutput{}
+	resp.Body.Message = "Comment updated successfully"
+	resp.Body.Comment = *comment.ToAPI()
+	return resp, nil
+}
+
+func (h *Handler) ApproveCommentHuma(ctx context.Context, input *ApproveCommentInput) (*ApproveCommentOutput, error) {
+	user_id, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
+	}
+
+	userObjID, err := primitive.ObjectIDFromHex(user_id)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	postID, err := primitive.ObjectIDFromHex(input.PostID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid post ID format", err)
+	}
+
+	commentID, err := primitive.ObjectIDFromHex(input.CommentID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid comment ID format", err)
+	}
+
+	comment, err := h.service.ApproveComment(ctx, postID, commentID, userObjID)
+	switch {
+	case errors.Is(err, errCommentNotFound):
+		return nil, huma.Error404NotFound("Comment not found", err)
+	case errors.Is(err, errCommentForbidden):
+		return nil, huma.Error403Forbidden("You can only approve comments on your own posts")
+	case errors.Is(err, errCommentNotPending):
+		return nil, huma.Error409Conflict("Comment is not awaiting approval", err)
+	case err != nil:
+		slog.Error("failed to approve comment", "userId", user_id, "postId", input.PostID, "commentId", input.CommentID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to approve comment. Please try again.", err)
+	}
+
+	resp := &ApproveCommentOutput{}
+	resp.Body.Message = "Comment approved successfully"
+	resp.Body.Comment = *comment.ToAPI()
+	return resp, nil
+}
+
+func (h *Handler) GetCommentsHuma(ctx context.Context, input *GetCommentsInput) (*GetCommentsOutput, error) {
+	user_id, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
+	}
+
+	userObjID, err := primitive.ObjectIDFromHex(user_id)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	postID, err := primitive.ObjectIDFromHex(input.PostID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid post ID format", err)
+	}
+
+	if err := h.requireVisible(ctx, userObjID, postID); err != nil {
+		return nil, err
+	}
+
+	var threadID *primitive.ObjectID
+	if input.Thread != "" {
+		id, err := primitive.ObjectIDFromHex(input.Thread)
+		if err != nil {
+			return nil, huma.Error400BadRequest("Invalid thread ID format", err)
+		}
+		threadID = &id
+	}
+
+	after, err := decodeCommentCursor(input.Cursor)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid cursor", err)
+	}
+
+	comments, hasMore, err := h.service.ListComments(ctx, postID, userObjID, threadID, input.Limit, after)
+	if err != nil {
+		slog.Error("failed to list comments", "userId", user_id, "postId", input.PostID, "thread", input.Thread, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to load comments. Please try again.", err)
+	}
+
+	resp := &GetCommentsOutput{}
+	resp.Body.Comments = make([]types.CommentDocumentAPI, 0, len(comments))
+	for _, c := range comments {
+		resp.Body.Comments = append(resp.Body.Comments, *c.ToAPI())
+	}
+	resp.Body.HasMore = hasMore
+	if hasMore {
+		last := comments[len(comments)-1]
+		resp.Body.NextCursor = encodeCommentCursor(FeedPosition{At: last.Metadata.CreatedAt, ID: last.ID})
+	}
+	return resp, nil
+}
+
+func (h *Handler) ToggleCommentReactionHuma(ctx context.Context, input *ToggleCommentReactionInput) (*AddReactionOutput, error) {
+	user_id, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
+	}
+
+	userObjID, err := primitive.ObjectIDFromHex(user_id)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID", err)
+	}
+
+	postID, err := primitive.ObjectIDFromHex(input.PostID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid post ID", err)
+	}
+
+	commentID, err := primitive.ObjectIDFromHex(input.CommentID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid comment ID", err)
+	}
+
+	if err := h.requireVisible(ctx, userObjID, postID); err != nil {
+		return nil, err
+	}
+
+	wasAdded, err := h.service.ToggleCommentReaction(ctx, postID, commentID, userObjID, input.Body.Emoji)
+	if errors.Is(err, errCommentNotFound) {
+		return nil, huma.Error404NotFound("Comment not found", err)
+	}
+	if err != nil {
+		slog.Error("failed to process comment reaction", "userId", user_id, "postId", input.PostID, "commentId", input.CommentID, "emoji", input.Body.Emoji, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to process reaction. Please try again.", err)
+	}
+
+	response := &AddReactionOutput{}
+	if wasAdded {
+		response.Body.Message = "Reaction added successfully"
+	} else {
+		response.Body.Message = "Reaction removed successfully"
+	}
+	response.Body.Added = wasAdded
+	return response, nil
+}
+
+// requireVisible rejects interactions with a post outside the viewer's
+// audience as if the post did not exist.
+func (h *Handler) requireVisible(ctx context.Context, viewerID, postID primitive.ObjectID) error {
+	_, err := h.service.GetVisiblePost(ctx, viewerID, postID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return huma.Error404NotFound("Post not found", err)
+	}
+	if err != nil {
+		slog.Error("failed to check post visibility", "userId", viewerID.Hex(), "postId", postID.Hex(), "error", err)
+		return huma.Error500InternalServerError("Unable to load post. Please try again.", err)
+	}
+	return nil
+}
+
+// parseMentions converts mention inputs, skipping invalid IDs.
+func parseMentions(input []MentionInput) []types.MentionReference {
+	var mentions []types.MentionReference
+	for _, m := range input {
+		mentionID, err := primitive.ObjectIDFromHex(m.ID)
+		if err != nil {
+			continue
+		}
+		mentions = append(mentions, types.MentionReference{
+			ID:     mentionID,
+			Handle: m.Handle,
+		})
+	}
+	return mentions
+}
+
+// draftError maps draft service errors onto HTTP errors.
+func draftError(err error, action string, attrs ...any) error {
+	switch {
+	case errors.Is(err, errDraftNotFound):
+		return huma.Error404NotFound("Draft not found", err)
+	case errors.Is(err, errDraftIncomplete):
+		return huma.Error422UnprocessableEntity("Draft is not ready to publish", err)
+	case errors.Is(err, errInvalidPublishAt):
+		return huma.Error400BadRequest("Publish time must be in the future and within 30 days", err)
+	case errors.Is(err, errInvalidBlueprintID):
+		return huma.Error400BadRequest("Invalid blueprint ID format", err)
+	case errors.Is(err, errInvalidGroupID):
+		return huma.Error400BadRequest("Invalid group ID format", err)
+	case errors.Is(err, errInvalidAudience):
+		return huma.Error400BadRequest("Invalid audience", err)
+	}
+	slog.Error("failed to "+action, append(attrs, "error", err)...)
+	return huma.Error500InternalServerError("Unable to "+action+". Please try again.", err)
+}
+
+// draftIDs parses the caller and draft IDs every draft route needs.
+func draftIDs(ctx context.Context, draftID string) (primitive.ObjectID, primitive.ObjectID, error) {
+	user_id, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, huma.Error401Unauthorized("Authentication required", err)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(user_id)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, huma.Error400BadRequest("Invalid user ID", err)
+	}
+	if draftID == "" {
+		return userObjID, primitive.NilObjectID, nil
+	}
+	draftObjID, err := primitive.ObjectIDFromHex(draftID)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, huma.Error400BadRequest("Invalid draft ID format", err)
+	}
+	return userObjID, draftObjID, nil
+}
+
+func (h *Handler) CreateDraftHuma(ctx context.Context, input *CreateDraftInput) (*DraftOutput, error) {
+	if errs := xvalidator.Validator.Validate(input.Body); len(errs) > 0 {
+		return nil, huma.Error400BadRequest("Validation failed", fmt.Errorf("validation errors: %v", errs))
+	}
+	userID, _, err := draftIDs(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := h.service.CreateDraft(ctx, userID, input.Body)
+	if err != nil {
+		return nil, draftError(err, "save draft", "userId", userID.Hex())
+	}
+	return &DraftOutput{Body: *draft}, nil
+}
+
+func (h *Handler) UpdateDraftHuma(ctx context.Context, input *UpdateDraftInput) (*DraftOutput, error) {
+	if errs := xvalidator.Validator.Validate(input.Body); len(errs) > 0 {
+		return nil, huma.Error400BadRequest("Validation failed", fmt.Errorf("validation errors: %v", errs))
+	}
+	userID, draftID, err := draftIDs(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := h.service.UpdateDraft(ctx, userID, draftID, input.Body)
+	if err != nil {
+		return nil, draftError(err, "update draft", "userId", userID.Hex(), "draftId", input.ID)
+	}
+	return &DraftOutput{Body: *draft}, nil
+}
+
+func (h *Handler) GetDraftsHuma(ctx context.Context, input *GetDraftsInput) (*GetDraftsOutput, error) {
+	userID, _, err := draftIDs(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	drafts, err := h.service.ListDrafts(ctx, userID)
+	if err != nil {
+		return nil, draftError(err, "get drafts", "userId", userID.Hex())
+	}
+	resp := &GetDraftsOutput{}
+	resp.Body.Drafts = drafts
+	return resp, nil
+}
+
+func (h *Handler) GetDraftHuma(ctx context.Context, input *DraftIDInput) (*DraftOutput, error) {
+	userID, draftID, err := draftIDs(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := h.service.GetDraft(ctx, userID, draftID)
+	if err != nil {
+		return nil, draftError(err, "get draft", "userId", userID.Hex(), "draftId", input.ID)
+	}
+	return &DraftOutput{Body: *draft}, nil
+}
+
+func (h *Handler) DeleteDraftHuma(ctx context.Context, input *DraftIDInput) (*DeleteDraftOutput, error) {
+	userID, draftID, err := draftIDs(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.DeleteDraft(ctx, userID, draftID); err != nil {
+		return nil, draftError(err, "delete draft", "userId", userID.Hex(), "draftId", input.ID)
+	}
+	resp := &DeleteDraftOutput{}
+	resp.Body.Message = "Draft deleted successfully"
+	return resp, nil
+}
+
+func (h *Handler) ScheduleDraftHuma(ctx context.Context, input *ScheduleDraftInput) (*DraftOutput, error) {
+	userID, draftID, err := draftIDs(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := h.service.ScheduleDraft(ctx, userID, draftID, input.Body.PublishAt, auth.GetTimezoneOrDefault(ctx))
+	if err != nil {
+		return nil, draftError(err, "schedule draft", "userId", userID.Hex(), "draftId", input.ID)
+	}
+	return &DraftOutput{Body: *draft}, nil
+}
+
+func (h *Handler) UnscheduleDraftHuma(ctx context.Context, input *DraftIDInput) (*DraftOutput, error) {
+	userID, draftID, err := draftIDs(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := h.service.UnscheduleDraft(ctx, userID, draftID)
+	if err != nil {
+		return nil, draftError(err, "unschedule draft", "userId", userID.Hex(), "draftId", input.ID)
+	}
+	return &DraftOutput{Body: *draft}, nil
+}
+
+func (h *Handler) PublishDraftHuma(ctx context.Context, input *DraftIDInput) (*CreatePostOutput, error) {
+	userID, draftID, err := draftIDs(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	createdPost, userStats, ringDelta, err := h.service.PublishDraft(ctx, userID, draftID, auth.GetTimezoneOrDefault(ctx))
+	if err != nil {
+		return nil, draftError(err, "publish draft", "userId", userID.Hex(), "draftId", input.ID)
+	}
+	if createdPost == nil {
+		return nil, huma.Error409Conflict("Draft was already published")
+	}
+
+	response := &CreatePostOutput{}
+	response.Body.PostDocumentAPI = *createdPost.ToAPI(userID)
+	response.Body.RingDelta = ringDelta
+	if userStats != nil {
+		response.UserStats.PostsMade = userStats.PostsMade
+		response.UserStats.Points = userStats.Points
+	}
+	return response, nil
+}
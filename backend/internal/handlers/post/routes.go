@@ -1,19 +1,22 @@
 package Post
 
 import (
+	"github.com/abhikaboy/Kindred/internal/config"
 	"github.com/abhikaboy/Kindred/internal/handlers/rings"
 	"github.com/danielgtaylor/huma/v2"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
 /*
-Router maps endpoints to handlers using Huma operations
+Router maps endpoints to handlers using Huma operations. Returns the service
+so the scheduled-post publisher can share it.
 */
-func Routes(api huma.API, collections map[string]*mongo.Collection, ringService *rings.RingService) {
-	service := newService(collections, ringService)
+func Routes(api huma.API, collections map[string]*mongo.Collection, ringService *rings.RingService, ranking config.Feed) *Service {
+	service := newService(collections, ringService, ranking)
 	handler := Handler{service}
 
 	// Register all post operations
 	RegisterPostOperations(api, &handler)
 
+	return service
 }
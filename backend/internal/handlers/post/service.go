@@ -3,16 +3,22 @@ package Post
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math/big"
+	"slices"
 	"time"
 
+	"github.com/abhikaboy/Kindred/internal/audience"
+	"github.com/abhikaboy/Kindred/internal/config"
 	"github.com/abhikaboy/Kindred/internal/friendship"
+	"github.com/abhikaboy/Kindred/internal/gemini"
 	"github.com/abhikaboy/Kindred/internal/handlers/encouragement"
 	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
 	"github.com/abhikaboy/Kindred/internal/handlers/rings"
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/internal/timeline"
 	"github.com/abhikaboy/Kindred/xutils"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -26,7 +32,19 @@ type UserStatsUpdate struct {
 }
 
 // newService receives the map of collections and picks out Jobs
-func newService(collections map[string]*mongo.Collection, ringService *rings.RingService) *Service {
+func newService(collections map[string]*mongo.Collection, ringService *rings.RingService, ranking config.Feed) *Service {
+	var impressions *mongo.Collection
+	comments := collections[commentsCollection]
+	drafts := collections[draftsCollection]
+	if users := collections["users"]; users != nil {
+		impressions = users.Database().Collection(impressionsCollection)
+		if comments == nil {
+			comments = users.Database().Collection(commentsCollection)
+		}
+		if drafts == nil {
+			drafts = users.Database().Collection(draftsCollection)
+		}
+	}
 	return &Service{
 		Posts:                collections["posts"],
 		Users:                collections["users"],
@@ -39,12 +57,19 @@ func newService(collections map[string]*mongo.Collection, ringService *rings.Rin
 		RingService:          ringService,
 		EncouragementService: encouragement.NewEncouragementService(collections),
 		Friendship:           friendship.New(collections),
+		Timeline:             timeline.New(collections),
+		Audiences:            audience.New(collections),
+		Impressions:          impressions,
+		Comments:             comments,
+		Drafts:               drafts,
+		Memory:               collections[gemini.UserMemoryCollection],
+		Ranking:              ranking,
 	}
 }
 
 // NewService is the exported version for testing
 func NewService(collections map[string]*mongo.Collection) *Service {
-	return newService(collections, nil)
+	return newService(collections, nil, defaultFeedRanking)
 }
 
 // GetReportedPostIDs returns IDs of all posts that have been reported by any user with pending or reviewed status
@@ -195,6 +220,16 @@ func (s *Service) CreatePost(r *types.PostDocument) (*types.PostDocument, *UserS
 	}
 	r.ID = id
 
+	// File the post in viewers' timelines off the request path
+	go s.Timeline.FanOut(context.Background(), timeline.Event{
+		Kind:          timeline.KindPost,
+		Ref:           r.ID,
+		Author:        r.User.ID,
+		At:            r.Metadata.CreatedAt,
+		Audience:      r.Audience,
+		IncludeAuthor: true,
+	})
+
 	// If this post is linked to a task, mark the task as posted
 	if r.Task != nil {
 		// Determine which collection the task is in (likely "completed-tasks" but we should check)
@@ -247,6 +282,171 @@ func (s *Service) CreatePost(r *types.PostDocument) (*types.PostDocument, *UserS
 	return r, userStats, nil
 }
 
+var (
+	errPostAuthorNotFound = errors.New("post author not found")
+	errInvalidBlueprintID = errors.New("invalid blueprint ID format")
+	errInvalidGroupID     = errors.New("invalid group ID format")
+)
+
+// PublishPost creates a post from a create request and runs everything that
+// happens at publish time: the Share ring (closed in tz), tag notifications
+// and the friend-posted wave. postID may be preallocated so a retried
+// publish collides instead of posting twice; pass NilObjectID for a new one.
+func (s *Service) PublishPost(ctx context.Context, authorID primitive.ObjectID, params CreatePostParams, postID primitive.ObjectID, tz string) (*types.PostDocument, *UserStatsUpdate, *rings.RingDelta, error) {
+	// Get user info to populate the User field
+	var user types.User
+	err := s.Users.FindOne(ctx, bson.M{"_id": authorID}).Decode(&user)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %w", errPostAuthorNotFound, err)
+	}
+
+	if postID.IsZero() {
+		postID = primitive.NewObjectID()
+	}
+	doc := types.PostDocument{
+		ID: postID,
+		User: types.UserExtendedReferenceInternal{
+			ID:             user.ID,
+			DisplayName:    user.DisplayName,
+			Handle:         user.Handle,
+			ProfilePicture: user.ProfilePicture,
+		},
+		Images:    params.Images,
+		Dual:      params.Dual,
+		Caption:   params.Caption,
+		Size:      params.Size,
+		Task:      params.Task,
+		Song:      params.Song,
+		Comments:  []types.CommentDocument{},
+		Reactions: make(map[string][]primitive.ObjectID),
+		Metadata:  types.NewPostMetadata(),
+	}
+
+	// Prefer the unified media[] when provided; keep Images in sync for back-compat.
+	if len(params.Media) > 0 {
+		doc.Media = ToMediaItems(params.Media)
+		doc.Images = DeriveImagesFromMedia(doc.Media)
+		if doc.Size == nil {
+			doc.Size = PrimarySizeFromMedia(doc.Media)
+		}
+	}
+
+	// Handle blueprint with isPublic flag
+	if params.BlueprintID != nil {
+		blueprintID, err := primitive.ObjectIDFromHex(*params.BlueprintID)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("%w: %w", errInvalidBlueprintID, err)
+		}
+
+		blueprintIsPublic := false
+		if params.BlueprintIsPublic != nil {
+			blueprintIsPublic = *params.BlueprintIsPublic
+		}
+
+		doc.Blueprint = types.NewEnhancedBlueprintReference(blueprintID, blueprintIsPublic)
+	}
+
+	// Who can see it: asked for, legacy groups, the author's default, or isPublic.
+	postAudience, err := pickAudience(params, user.Settings.Privacy)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	postAudience, err = s.checkAudience(ctx, authorID, user.Friends, postAudience)
+	if err != nil {
+		if params.Audience != nil || len(params.Groups) > 0 {
+			return nil, nil, nil, err
+		}
+		// A default naming people who are no longer friends, or a deleted
+		// list, shouldn't block posting.
+		postAudience = types.PostAudience{Type: types.AudienceFriends}
+	}
+	applyAudience(&doc, postAudience)
+
+	// Collect tag candidates: explicit + encourager auto-tag. coerceMentions
+	// drops any malformed tag so a bad "@" can't fail the post.
+	var tagCandidates []primitive.ObjectID
+	for _, m := range coerceMentions(params.TaggedUsers) {
+		if objID, err := primitive.ObjectIDFromHex(m.ID); err == nil {
+			tagCandidates = append(tagCandidates, objID)
+		}
+	}
+	if params.Task != nil && s.EncouragementService != nil {
+		taskID := params.Task.ID
+		encs, encErr := s.EncouragementService.GetEncouragementsByTaskAndReceiver(taskID, authorID)
+		if encErr != nil {
+			slog.Warn("Failed to fetch encouragements for auto-tag", "task_id", taskID, "err", encErr)
+		} else {
+			for _, e := range encs {
+				tagCandidates = append(tagCandidates, e.Sender.ID)
+			}
+		}
+	}
+	if len(tagCandidates) > maxTaggedUsers {
+		tagCandidates = tagCandidates[:maxTaggedUsers] // cap fanout; we dropped the validate=max guard
+	}
+	if len(tagCandidates) > 0 {
+		resolved, resolveErr := s.ResolveTaggedUsers(ctx, authorID, tagCandidates)
+		if resolveErr != nil {
+			slog.Warn("Failed to resolve tagged users", "err", resolveErr)
+		} else {
+			doc.TaggedUsers = resolved
+		}
+	}
+
+	createdPost, userStats, err := s.CreatePost(&doc)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Increment Share ring synchronously so the response carries the delta.
+	// NotifyAllRingsClosed is already async (2-minute delayed).
+	var ringDelta *rings.RingDelta
+	if s.RingService != nil {
+		_, delta, err := s.RingService.IncrementRing(ctx, authorID, tz, rings.RingShare)
+		if err != nil {
+			slog.Error("Failed to increment Share ring on post creation", "user_id", authorID.Hex(), "error", err)
+		} else {
+			ringDelta = delta
+			if delta.JustClosedAll {
+				s.RingService.NotifyAllRingsClosed(authorID)
+			}
+		}
+	}
+
+	// Fan out tag notifications.
+	var thumbnail string
+	if len(createdPost.Images) > 0 {
+		thumbnail = createdPost.Images[0]
+	}
+	for _, tagged := range createdPost.TaggedUsers {
+		if tagged.ID == authorID {
+			continue
+		}
+		content := fmt.Sprintf("%s tagged you in a post", createdPost.User.DisplayName)
+		if err := s.NotificationService.CreateNotification(authorID, tagged.ID, content, notifications.NotificationTypePostTag, createdPost.ID, thumbnail); err != nil {
+			slog.Error("Failed to create tag notification", "tagged_user_id", tagged.ID, "err", err)
+		}
+		if err := s.sendTagPushNotification(tagged.ID, createdPost.ID, createdPost.User.DisplayName); err != nil {
+			slog.Error("Failed to send tag push notification", "tagged_user_id", tagged.ID, "err", err)
+		}
+	}
+
+	// Notify friends of the new post — best-effort, async so it never adds request
+	// latency. Throttled inside: one wave per poster / 48h, 2 per recipient / day.
+	go func(postID, posterID primitive.ObjectID, name, caption string) {
+		defer func() {
+			if r := recover(); r != nil {
+				slog.Error("post-notify wave panicked", "recover", r)
+			}
+		}()
+		if err := s.NotifyFriendsOfPost(postID, posterID, name, caption); err != nil {
+			slog.Error("post-notify wave failed", "error", err)
+		}
+	}(createdPost.ID, authorID, createdPost.User.DisplayName, createdPost.Caption)
+
+	return createdPost, userStats, ringDelta, nil
+}
+
 // updateUserPostStats increments the user's posts made count.
 func (s *Service) updateUserPostStats(ctx context.Context, userID primitive.ObjectID) (*UserStatsUpdate, error) {
 	userFilter := bson.M{"_id": userID}
@@ -292,21 +492,43 @@ func (s *Service) UpdatePartialPost(ctx context.Context, id primitive.ObjectID,
 		setMap["caption"] = *updated.Caption
 	}
 
-	if updated.IsPublic != nil {
-		setMap["metadata.isPublic"] = *updated.IsPublic
-	}
-
 	if updated.Size != nil {
 		setMap["size"] = *updated.Size
 	}
 
-	if updated.TaggedUsers != nil {
-		// Fetch the existing post to diff against.
-		var existing types.PostDocument
+	// Fetch the existing post to diff tags and audience against.
+	var existing types.PostDocument
+	if updated.TaggedUsers != nil || updated.Audience != nil || updated.IsPublic != nil {
 		if err := s.Posts.FindOne(ctx, bson.M{"_id": id, "metadata.isDeleted": false}).Decode(&existing); err != nil {
-			return fmt.Errorf("failed to fetch existing post for tag diff: %w", err)
+			return fmt.Errorf("failed to fetch existing post: %w", err)
+		}
+	}
+
+	var newAudience *types.PostAudience
+	if updated.Audience != nil {
+		a, err := updated.Audience.parse()
+		if err != nil {
+			return err
+		}
+		newAudience = &a
+	} else if updated.IsPublic != nil {
+		// Older clients only flip the public flag. It maps onto the public and
+		// friends audiences and leaves narrower ones alone.
+		if t := existing.EffectiveAudience().Type; t == types.AudiencePublic || t == types.AudienceFriends {
+			a := types.PostAudience{Type: types.AudienceFriends}
+			if *updated.IsPublic {
+				a.Type = types.AudiencePublic
+			}
+			newAudience = &a
+		}
+	}
+	if newAudience != nil {
+		if err := s.SetPostAudience(ctx, &existing, *newAudience); err != nil {
+			return err
 		}
+	}
 
+	if updated.TaggedUsers != nil {
 		var candidates []primitive.ObjectID
 		for _, m := range coerceMentions(*updated.TaggedUsers) {
 			if objID, err := primitive.ObjectIDFromHex(m.ID); err == nil {
@@ -362,17 +584,25 @@ func (s *Service) DeletePost(id primitive.ObjectID) error {
 	filter := bson.M{"_id": id}
 
 	_, err := s.Posts.DeleteOne(ctx, filter)
-	return err
+	if err != nil {
+		return err
+	}
+	s.Timeline.RemoveRef(ctx, timeline.KindPost, id)
+	return nil
 }
 
-// GetUserPosts fetches posts for a specific user with pagination
-func (s *Service) GetUserPosts(userID primitive.ObjectID, limit, offset int) ([]types.PostDocument, int, error) {
+// GetUserPosts returns the posts on userID's profile that viewerID may see.
+func (s *Service) GetUserPosts(viewerID, userID primitive.ObjectID, limit, offset int) ([]types.PostDocument, int, error) {
 	ctx := context.Background()
 
-	filter := bson.M{
-		"user._id":           userID,
-		"metadata.isDeleted": false,
+	viewer, err := s.Audiences.Viewer(ctx, viewerID)
+	if err != nil {
+		return nil, 0, err
 	}
+	filter := bson.M{"$and": bson.A{
+		bson.M{"user._id": userID, "metadata.isDeleted": false},
+		viewer.Filter(),
+	}}
 
 	// Set default limit if not provided
 	if limit <= 0 {
@@ -405,39 +635,22 @@ func (s *Service) GetUserPosts(userID primitive.ObjectID, limit, offset int) ([]
 	return results, int(total), nil
 }
 
-// GetFriendsPosts fetches posts from the user's friends using aggregation pipeline with group visibility, ordered chronologically (newest first) with pagination
-func (s *Service) GetFriendsPosts(userID primitive.ObjectID, limit, offset int) ([]types.PostDocument, int, error) {
-	ctx := context.Background()
-
-	// Set default limit if not provided
-	if limit <= 0 {
-		limit = 8
-	}
-
-	// Get blocked user IDs to filter them out
+// feedExclusions returns the authors a viewer has blocked (or been blocked
+// by) and the posts hidden from them: their own reports always, plus every
+// reported post while the content filter is on. Lookup failures degrade to
+// no filter rather than failing the feed.
+func (s *Service) feedExclusions(ctx context.Context, userID primitive.ObjectID) ([]primitive.ObjectID, []primitive.ObjectID) {
 	blockedUserIDs, err := s.GetBlockedUserIDs(ctx, userID)
 	if err != nil {
 		slog.Warn("Failed to get blocked users, continuing without filter", "error", err)
-		blockedUserIDs = []primitive.ObjectID{}
-	}
-
-	// Ensure blockedUserIDs is never nil for MongoDB aggregation
-	// Convert to bson.A to ensure proper serialization
-	var blockedUserIDsArray bson.A
-	if len(blockedUserIDs) == 0 {
-		blockedUserIDsArray = bson.A{}
-	} else {
-		blockedUserIDsArray = make(bson.A, len(blockedUserIDs))
-		for i, id := range blockedUserIDs {
-			blockedUserIDsArray[i] = id
-		}
+		blockedUserIDs = nil
 	}
 
 	// Get post IDs that the current user has personally reported (always hidden)
 	userReportedIDs, err := s.GetUserReportedPostIDs(ctx, userID)
 	if err != nil {
 		slog.Warn("Failed to get user reported posts, continuing without filter", "error", err)
-		userReportedIDs = []primitive.ObjectID{}
+		userReportedIDs = nil
 	}
 
 	// If content filter is enabled, also get all globally reported post IDs
@@ -452,187 +665,78 @@ func (s *Service) GetFriendsPosts(userID primitive.ObjectID, limit, offset int)
 		allReportedIDs, err = s.GetReportedPostIDs(ctx)
 		if err != nil {
 			slog.Warn("Failed to get reported posts, continuing without filter", "error", err)
-			allReportedIDs = []primitive.ObjectID{}
+			allReportedIDs = nil
 		}
 	}
 
 	// Merge user-reported IDs and content-filter reported IDs into one exclusion set
 	excludedPostIDSet := make(map[primitive.ObjectID]struct{})
-	for _, id := range userReportedIDs {
-		excludedPostIDSet[id] = struct{}{}
-	}
-	for _, id := range allReportedIDs {
-		excludedPostIDSet[id] = struct{}{}
-	}
-	var excludedPostIDsArray bson.A
-	for id := range excludedPostIDSet {
-		excludedPostIDsArray = append(excludedPostIDsArray, id)
+	var excludedPostIDs []primitive.ObjectID
+	for _, ids := range [][]primitive.ObjectID{userReportedIDs, allReportedIDs} {
+		for _, id := range ids {
+			if _, seen := excludedPostIDSet[id]; !seen {
+				excludedPostIDSet[id] = struct{}{}
+				excludedPostIDs = append(excludedPostIDs, id)
+			}
+		}
 	}
-	if excludedPostIDsArray == nil {
-		excludedPostIDsArray = bson.A{}
+	return blockedUserIDs, excludedPostIDs
+}
+
+// GetFriendsPosts fetches the posts for the user's home feed — their own, their friends' and those
+// shared with their groups or with them — filtered by each post's audience, newest first. Pages are
+// keyset-based: only posts created at or before asOf and strictly older than after (when set) are
+// returned, along with whether more remain.
+func (s *Service) GetFriendsPosts(userID primitive.ObjectID, limit int, asOf time.Time, after *FeedPosition) ([]types.PostDocument, bool, error) {
+	ctx := context.Background()
+
+	// Set default limit if not provided
+	if limit <= 0 {
+		limit = 8
 	}
 
-	// Get user's groups to check group membership
-	userGroups, err := s.GetUserGroups(userID)
+	viewer, err := s.Audiences.Viewer(ctx, userID)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get user groups: %w", err)
-	}
-
-	// Extract group IDs
-	var userGroupIDs []primitive.ObjectID
-	for _, group := range userGroups {
-		userGroupIDs = append(userGroupIDs, group.ID)
-	}
-
-	// Build visibility filter
-	// Simple rules:
-	// 1. Post has groups → Show if user is a member of any of those groups
-	// 2. Post has no groups → Show if user is a friend of the creator
-	visibilityConditions := []bson.M{}
-
-	// Rule 1: Post has groups AND user is a member of at least one group
-	if len(userGroupIDs) > 0 {
-		visibilityConditions = append(visibilityConditions, bson.M{
-			"$and": []bson.M{
-				// Post must have groups
-				{"$ne": []interface{}{"$groups", nil}},
-				{"$gt": []interface{}{bson.M{"$size": bson.M{"$ifNull": []interface{}{"$groups", []interface{}{}}}}, 0}},
-				// User must be in at least one of the post's groups
-				{
-					"$gt": []interface{}{
-						bson.M{
-							"$size": bson.M{
-								"$setIntersection": []interface{}{
-									bson.M{"$ifNull": []interface{}{"$groups", []interface{}{}}},
-									userGroupIDs,
-								},
-							},
-						},
-						0,
-					},
-				},
-			},
-		})
+		return nil, false, fmt.Errorf("failed to load feed audience: %w", err)
 	}
 
-	// Rule 2: Post has no groups (or empty groups array) AND user is a friend of the creator
-	visibilityConditions = append(visibilityConditions, bson.M{
-		"$and": []bson.M{
-			// User must be a friend of the post creator
-			{
-				"$in": []interface{}{
-					bson.M{"$toObjectId": "$user._id"},
-					"$$friendIds",
-				},
-			},
-			// Post must have no groups or empty groups array
-			{
-				"$or": []bson.M{
-					{"$eq": []interface{}{"$groups", nil}},
-					{"$eq": []interface{}{bson.M{"$size": bson.M{"$ifNull": []interface{}{"$groups", []interface{}{}}}}, 0}},
-				},
-			},
-		},
-	})
+	// Blocked authors are already out through the audience filter
+	_, excludedPostIDs := s.feedExclusions(ctx, userID)
 
-	// Build the common match conditions
-	feedMatchConditions := []bson.M{
-		{"$eq": []interface{}{"$metadata.isDeleted", false}},
-		{
-			"$or": visibilityConditions,
-		},
-		// Filter out posts from blocked users
-		{
-			"$not": bson.M{
-				"$in": []interface{}{
-					bson.M{"$toObjectId": "$user._id"},
-					blockedUserIDsArray,
-				},
-			},
-		},
+	conditions := bson.A{
+		bson.M{"metadata.isDeleted": false},
+		viewer.FeedFilter(),
+		// Resume after the cursor position within the session snapshot
+		olderThan("metadata.createdAt", after, asOf),
 	}
-
 	// Filter out reported posts (user's own reports + content filter)
-	if len(excludedPostIDsArray) > 0 {
-		feedMatchConditions = append(feedMatchConditions, bson.M{
-			"$not": bson.M{
-				"$in": []interface{}{"$_id", excludedPostIDsArray},
-			},
-		})
+	if len(excludedPostIDs) > 0 {
+		conditions = append(conditions, bson.M{"_id": bson.M{"$nin": excludedPostIDs}})
 	}
 
-	// Fetch limit+1 to determine hasMore without a separate count pipeline.
-	// This avoids loading ALL visible posts into memory just to count them.
-	fetchLimit := limit + 1
-
-	pipeline := mongo.Pipeline{
-		// Stage 1: Match the specific user
-		{{Key: "$match", Value: bson.M{"_id": userID}}},
-
-		// Stage 2: Extract friend IDs for posts lookup (no need to $lookup the full friend docs).
-		// Include the user's own ID so their own posts show in their feed (newest → top).
-		{{Key: "$project", Value: bson.M{
-			"friendIds": bson.M{"$concatArrays": []interface{}{
-				bson.M{"$ifNull": []interface{}{"$friends", bson.A{}}},
-				bson.A{userID},
-			}},
-		}}},
-
-		// Stage 3: Lookup posts with visibility filtering
-		{{Key: "$lookup", Value: bson.M{
-			"from": "posts",
-			"let":  bson.M{"friendIds": "$friendIds"},
-			"pipeline": mongo.Pipeline{
-				// Match posts that are not deleted and meet visibility criteria
-				{{Key: "$match", Value: bson.M{
-					"$expr": bson.M{
-						"$and": feedMatchConditions,
-					},
-				}}},
-				// Sort by creation date (newest first)
-				{{Key: "$sort", Value: bson.M{"metadata.createdAt": -1}}},
-				// Add pagination — fetch one extra to detect hasMore
-				{{Key: "$skip", Value: offset}},
-				{{Key: "$limit", Value: fetchLimit}},
-			},
-			"as": "visiblePosts",
-		}}},
-
-		// Stage 4: Unwind the posts array to get individual posts
-		{{Key: "$unwind", Value: "$visiblePosts"}},
-
-		// Stage 5: Replace root with the post document
-		{{Key: "$replaceRoot", Value: bson.M{
-			"newRoot": "$visiblePosts",
-		}}},
-	}
+	// Fetch limit+1 to determine hasMore without a separate count.
+	opts := options.Find().
+		SetSort(newestFirst("metadata.createdAt")).
+		SetLimit(int64(limit + 1))
 
-	cursor, err := s.Users.Aggregate(ctx, pipeline)
+	cursor, err := s.Posts.Find(ctx, bson.M{"$and": conditions}, opts)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to execute aggregation pipeline: %w", err)
+		return nil, false, fmt.Errorf("failed to query feed posts: %w", err)
 	}
 	defer cursor.Close(ctx)
 
 	var results []types.PostDocument
 	if err := cursor.All(ctx, &results); err != nil {
-		return nil, 0, fmt.Errorf("failed to decode aggregation results: %w", err)
+		return nil, false, fmt.Errorf("failed to decode feed posts: %w", err)
 	}
 
 	// If we got more than limit, there are more pages.
-	// Compute a synthetic total that makes hasMore work: offset + len(results) [+ 1 if more].
 	hasMore := len(results) > limit
 	if hasMore {
 		results = results[:limit] // trim the extra probe row
 	}
 
-	// Callers compute hasMore as: offset+len(results) < total.
-	// Set total so that formula produces the right boolean.
-	total := offset + len(results)
-	if hasMore {
-		total++ // ensures offset+len(results) < total → true
-	}
-
-	return results, total, nil
+	return results, hasMore, nil
 }
 
 // GetUserGroups fetches all groups where the user is a creator or member
@@ -666,23 +770,35 @@ func (s *Service) GetUserGroups(userID primitive.ObjectID) ([]types.GroupDocumen
 const defaultTaskPoolSize = 200
 
 // GetFriendsPublicTasks fetches a pool of friends' public, active, incomplete
-// tasks (newest first) for the feed scorer. The handler scores and samples
-// from this pool, so no offset is taken — pagination of sampled tasks is
-// inherently approximate.
-func (s *Service) GetFriendsPublicTasks(userID primitive.ObjectID, limit int) ([]bson.M, int, error) {
+// tasks created by asOf (newest first) for the feed scorer. The handler
+// replays a seeded sample of this pool on every page, so no offset is taken;
+// pinning the pool to the cursor's asOf keeps pages from repeating or
+// skipping tasks, short of a task completed mid-session.
+func (s *Service) GetFriendsPublicTasks(userID primitive.ObjectID, limit int, asOf time.Time) ([]bson.M, int, error) {
 	ctx := context.Background()
 
 	if limit <= 0 {
 		limit = defaultTaskPoolSize
 	}
 
+	// Friends the viewer muted, or who hide their activity from the viewer,
+	// drop out of the pool.
+	hidden := bson.A{}
+	if viewer, err := s.Audiences.Viewer(ctx, userID); err != nil {
+		slog.Warn("Failed to load viewer for public tasks", "user_id", userID.Hex(), "err", err)
+	} else {
+		for _, id := range viewer.FeedHidden() {
+			hidden = append(hidden, id)
+		}
+	}
+
 	pipeline := mongo.Pipeline{
 		// Stage 1: Match the specific user
 		{{Key: "$match", Value: bson.M{"_id": userID}}},
 
 		// Stage 2: Extract friend IDs (already on the user doc)
 		{{Key: "$project", Value: bson.M{
-			"friendIds": "$friends",
+			"friendIds": bson.M{"$setDifference": bson.A{bson.M{"$ifNull": bson.A{"$friends", bson.A{}}}, hidden}},
 		}}},
 
 		// Stage 3: Lookup categories owned by friends
@@ -700,10 +816,14 @@ func (s *Service) GetFriendsPublicTasks(userID primitive.ObjectID, limit int) ([
 				{{Key: "$unwind", Value: "$tasks"}},
 				// Only public, active, not-yet-completed tasks are worth
 				// encouraging. $ne false tolerates older docs missing "active".
+				// Tasks created after asOf wait for the next session, so the
+				// pool a cursor's pages replay doesn't shift under it; $not
+				// keeps older tasks that have no timestamp.
 				{{Key: "$match", Value: bson.M{
 					"tasks.public":        true,
 					"tasks.active":        bson.M{"$ne": false},
 					"tasks.timeCompleted": bson.M{"$exists": false},
+					"tasks.timestamp":     bson.M{"$not": bson.M{"$gt": asOf}},
 				}}},
 				// Project the fields we need (incl. scoring fields)
 				{{Key: "$project", Value: bson.M{
@@ -735,8 +855,9 @@ func (s *Service) GetFriendsPublicTasks(userID primitive.ObjectID, limit int) ([
 			"newRoot": "$friendsTasks",
 		}}},
 
-		// Stage 6: Newest first so the pool cap keeps recent tasks
-		{{Key: "$sort", Value: bson.M{"timestamp": -1}}},
+		// Stage 6: Newest first so the pool cap keeps recent tasks; _id
+		// breaks ties so every page sees the pool in the same order
+		{{Key: "$sort", Value: bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}}},
 
 		// Stage 7: Count the full stream; cap the pool and attach user data
 		// only for the capped pool (bounds the per-task user lookups).
@@ -807,15 +928,19 @@ func (s *Service) GetFriendsPublicTasks(userID primitive.ObjectID, limit int) ([
 	return tasks, total, nil
 }
 
-// GetPostsByBlueprint fetches all posts associated with a specific blueprint
-func (s *Service) GetPostsByBlueprint(blueprintID primitive.ObjectID) ([]types.PostDocument, error) {
+// GetPostsByBlueprint returns the posts made from a blueprint that viewerID
+// may see.
+func (s *Service) GetPostsByBlueprint(viewerID, blueprintID primitive.ObjectID) ([]types.PostDocument, error) {
 	ctx := context.Background()
 
-	filter := bson.M{
-		"blueprint.id":       blueprintID,
-		"metadata.isDeleted": false,
-		"metadata.isPublic":  true, // Only return public posts
+	viewer, err := s.Audiences.Viewer(ctx, viewerID)
+	if err != nil {
+		return nil, err
 	}
+	filter := bson.M{"$and": bson.A{
+		bson.M{"blueprint.id": blueprintID, "metadata.isDeleted": false},
+		viewer.Filter(),
+	}}
 
 	// Sort by creation date, newest first
 	cursor, err := s.Posts.Find(ctx, filter, &options.FindOptions{
@@ -834,88 +959,6 @@ func (s *Service) GetPostsByBlueprint(blueprintID primitive.ObjectID) ([]types.P
 	return results, nil
 }
 
-func (s *Service) AddComment(postID primitive.ObjectID, comment types.CommentDocument) (*friendship.Delta, error) {
-	ctx := context.Background()
-
-	comment.Metadata = types.NewCommentMetadata()
-
-	filter := bson.M{"_id": postID, "metadata.isDeleted": false}
-	update := bson.M{
-		"$push": bson.M{
-			"comments": comment,
-		},
-		"$set": bson.M{
-			"metadata.updatedAt": time.Now(),
-			"metadata.isEdited":  true,
-		},
-	}
-
-	// Use FindOneAndUpdate to get the post document and update it in one operation
-	var post types.PostDocument
-	err := s.Posts.FindOneAndUpdate(ctx, filter, update).Decode(&post)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return nil, fmt.Errorf("post not found or has been deleted")
-		}
-		return nil, fmt.Errorf("failed to add comment: %w", err)
-	}
-
-	// Send notification to post owner (only if commenter is not the post owner)
-	var fsDelta *friendship.Delta
-	if comment.User != nil && comment.User.ID != post.User.ID {
-		fsDelta = s.Friendship.Bump(ctx, comment.User.ID, post.User.ID, friendship.PointsComment)
-
-		// Send push notification
-		err = s.sendCommentNotification(post.User.ID, post.ID, comment.User.DisplayName, comment.Content)
-		if err != nil {
-			// Log error but don't fail the operation since comment was already created
-			slog.Error("Failed to send comment notification", "error", err, "post_owner_id", post.User.ID)
-		}
-
-		// Create notification in the database with thumbnail (first image from post if available).
-		// The in-app card already shows the commenter's avatar + name and a
-		// "commented on your post" header, so the body is just the comment text.
-		// (Push notifications still prefix the name — see sendCommentNotification.)
-		notificationContent := comment.Content
-		var thumbnail string
-		if len(post.Images) > 0 {
-			thumbnail = post.Images[0]
-		}
-		err = s.NotificationService.CreateNotification(comment.User.ID, post.User.ID, notificationContent, notifications.NotificationTypeComment, post.ID, thumbnail)
-		if err != nil {
-			// Log error but don't fail the operation since comment was already created
-			slog.Error("Failed to create comment notification in database", "error", err, "post_owner_id", post.User.ID)
-		}
-	}
-
-	// Notify mentioned users
-	for _, mention := range comment.Mentions {
-		// Don't notify if mentioning yourself or the post owner (already notified)
-		if mention.ID == comment.User.ID || mention.ID == post.User.ID {
-			continue
-		}
-
-		notificationContent := fmt.Sprintf("%s mentioned you: \"%s\"", comment.User.DisplayName, comment.Content)
-		var thumbnail string
-		if len(post.Images) > 0 {
-			thumbnail = post.Images[0]
-		}
-
-		err = s.NotificationService.CreateNotification(comment.User.ID, mention.ID, notificationContent, notifications.NotificationTypeComment, post.ID, thumbnail)
-		if err != nil {
-			slog.Error("Failed to create mention notification", "error", err, "mentioned_user_id", mention.ID)
-		}
-
-		// Send push notification
-		err = s.sendCommentNotification(mention.ID, post.ID, comment.User.DisplayName, comment.Content)
-		if err != nil {
-			slog.Error("Failed to send mention push notification", "error", err, "mentioned_user_id", mention.ID)
-		}
-	}
-
-	return fsDelta, nil
-}
-
 func (s *Service) ToggleReaction(r *types.ReactDocument) (bool, error) {
 	ctx := context.Background()
 	field := "reactions." + r.Emoji
@@ -981,46 +1024,13 @@ func (s *Service) ToggleReaction(r *types.ReactDocument) (bool, error) {
 	return !userExists, err
 }
 
-// Replace your existing DeleteComment method in service.go with this:
-
-func (s *Service) DeleteComment(postID primitive.ObjectID, commentID primitive.ObjectID) error {
-	ctx := context.Background()
-
-	filter := bson.M{
-		"_id":                postID,
-		"metadata.isDeleted": false,
-	}
-
-	// Delete the comment and all replies that have this comment as parentId
-	update := bson.M{
-		"$pull": bson.M{
-			"comments": bson.M{
-				"$or": []bson.M{
-					{"_id": commentID},      // Delete the comment itself
-					{"parentId": commentID}, // Delete all replies to this comment
-				},
-			},
-		},
-		"$set": bson.M{
-			"metadata.updatedAt": time.Now(),
-			"metadata.isEdited":  true,
-		},
-	}
-
-	result, err := s.Posts.UpdateOne(ctx, filter, update)
-	if err != nil {
-		return fmt.Errorf("failed to delete comment and replies: %w", err)
-	}
-
-	if result.ModifiedCount == 0 {
-		return fmt.Errorf("comment not found or post has been deleted")
-	}
-
-	return nil
-}
-
 // sendCommentNotification sends a push notification when a comment is added to a post
 func (s *Service) sendCommentNotification(postOwnerID, postID primitive.ObjectID, commenterName, commentText string) error {
+	return s.sendCommentPush(postOwnerID, postID, "New comment on your post", commenterName, commentText)
+}
+
+// sendCommentPush sends a comment push notification with the given title.
+func (s *Service) sendCommentPush(postOwnerID, postID primitive.ObjectID, title, commenterName, commentText string) error {
 	if s.Users == nil {
 		return fmt.Errorf("users collection not available")
 	}
@@ -1052,7 +1062,7 @@ func (s *Service) sendCommentNotification(postOwnerID, postID primitive.ObjectID
 
 	notification := xutils.Notification{
 		Token:   postOwner.PushToken,
-		Title:   "New comment on your post",
+		Title:   title,
 		Message: message,
 		Data:    data,
 	}
@@ -1178,19 +1188,121 @@ func (s *Service) GetBlockedUserIDs(ctx context.Context, userID primitive.Object
 	return blockedUserIDs, nil
 }
 
+// postSubscribers keeps the friends whose post notification filter lets
+// poster through: everyone, or only the friends on a chosen list. Friends
+// who muted poster are dropped.
+func (s *Service) postSubscribers(ctx context.Context, poster primitive.ObjectID, friends []primitive.ObjectID) ([]primitive.ObjectID, error) {
+	if len(friends) == 0 {
+		return nil, nil
+	}
+	cursor, err := s.Users.Find(ctx, bson.M{"_id": bson.M{"$in": friends}},
+		options.Find().SetProjection(bson.M{"closeFriends": 1, "muted": 1, "settings.lists": 1}))
+	if err != nil {
+		return nil, err
+	}
+	var users []types.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+
+	var keep []primitive.ObjectID
+	onList := map[primitive.ObjectID]primitive.ObjectID{}
+	for _, u := range users {
+		if slices.Contains(u.Muted, poster) {
+			continue
+		}
+		ref := ""
+		if u.Settings.Lists != nil {
+			ref = u.Settings.Lists.PostNotifications
+		}
+		switch ref {
+		case "":
+			keep = append(keep, u.ID)
+		case types.CloseFriendsList:
+			if slices.Contains(u.CloseFriends, poster) {
+				keep = append(keep, u.ID)
+			}
+		default:
+			if list, err := primitive.ObjectIDFromHex(ref); err == nil {
+				onList[u.ID] = list
+			}
+		}
+	}
+	if len(onList) == 0 {
+		return keep, nil
+	}
+
+	lists := make([]primitive.ObjectID, 0, len(onList))
+	for _, list := range onList {
+		lists = append(lists, list)
+	}
+	containing, err := s.Audiences.ListsContaining(ctx, poster, lists)
+	if err != nil {
+		return nil, err
+	}
+	for id, list := range onList {
+		if containing[list] {
+			keep = append(keep, id)
+		}
+	}
+	return keep, nil
+}
+
 // postNotifyCooldown caps a user to one "friend posted" wave per 48h.
 const postNotifyCooldown = 48 * time.Hour
 
 // postNotifyDailyCap is the max "friend posted" notifications one user receives per day.
 const postNotifyDailyCap = 2
 
-// NotifyFriendsOfPost pushes a "friend posted" nudge to the poster's friends to drive
-// retention, throttled two ways: at most one wave per poster per 48h, and at most
+// NotifyFriendsOfPost pushes a "friend posted" nudge to the poster's friends in the
+// post's audience to drive retention, throttled two ways: at most one wave per poster per 48h, and at most
 // postNotifyDailyCap notifications per recipient per (UTC) day.
 func (s *Service) NotifyFriendsOfPost(postID primitive.ObjectID, posterID primitive.ObjectID, posterName string, postCaption string) error {
 	ctx := context.Background()
 	now := time.Now().UTC()
 
+	post, err := s.GetPostByID(postID)
+	if err != nil {
+		return fmt.Errorf("failed to get post for notification: %w", err)
+	}
+
+	// Audience: the poster's friends the post is addressed to. A post nobody
+	// else can see doesn't spend the poster's wave. Group posts go to every
+	// member who wants the group's posts instead, friends or not.
+	var audience []primitive.ObjectID
+	if a := post.EffectiveAudience(); a.Type == types.AudienceGroups {
+		subscribers, err := s.Audiences.GroupSubscribers(ctx, posterID, a.Groups, func(n types.GroupNotificationSettings) bool { return n.Posts })
+		if err != nil {
+			return fmt.Errorf("failed to resolve group subscribers: %w", err)
+		}
+		for id := range subscribers {
+			audience = append(audience, id)
+		}
+	} else {
+		var posterUser struct {
+			Friends []primitive.ObjectID `bson:"friends"`
+		}
+		if err := s.Users.FindOne(ctx, bson.M{"_id": posterID}).Decode(&posterUser); err != nil {
+			return fmt.Errorf("failed to get poster's friends: %w", err)
+		}
+		reached, err := s.Audiences.Recipients(ctx, posterID, a)
+		if err != nil {
+			return fmt.Errorf("failed to resolve post audience: %w", err)
+		}
+		for _, friendID := range posterUser.Friends {
+			if reached[friendID] {
+				audience = append(audience, friendID)
+			}
+		}
+		audience, err = s.postSubscribers(ctx, posterID, audience)
+		if err != nil {
+			return fmt.Errorf("failed to apply post notification filters: %w", err)
+		}
+	}
+	if len(audience) == 0 {
+		return nil
+	}
+
 	// Sender throttle: atomically claim a wave slot (same pattern as ClaimReminder).
 	// Only proceed if we set lastPostNotifyAt; a no-op means we're still in cooldown.
 	claim, err := s.Users.UpdateOne(ctx,
@@ -1208,24 +1320,13 @@ func (s *Service) NotifyFriendsOfPost(postID primitive.ObjectID, posterID primit
 		return nil
 	}
 
-	// Audience: the poster's friends.
-	var posterUser struct {
-		Friends []primitive.ObjectID `bson:"friends"`
-	}
-	if err := s.Users.FindOne(ctx, bson.M{"_id": posterID}).Decode(&posterUser); err != nil {
-		return fmt.Errorf("failed to get poster's friends: %w", err)
-	}
-	if len(posterUser.Friends) == 0 {
-		return nil
-	}
-
 	// Recipient throttle: drop friends already at the daily cap. One aggregation over
 	// the notifications collection (which doubles as the counter — no separate ledger).
 	// ponytail: mildly racy under simultaneous waves; a nudge, not billing — no lock.
 	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
 	cursor, err := s.NotificationService.Notifications.Aggregate(ctx, mongo.Pipeline{
 		{{Key: "$match", Value: bson.M{
-			"receiver":         bson.M{"$in": posterUser.Friends},
+			"receiver":         bson.M{"$in": audience},
 			"notificationType": notifications.NotificationTypePost,
 			"time":             bson.M{"$gte": startOfDay},
 		}}},
@@ -1247,7 +1348,7 @@ func (s *Service) NotifyFriendsOfPost(postID primitive.ObjectID, posterID primit
 	}
 
 	var eligible []primitive.ObjectID
-	for _, friendID := range posterUser.Friends {
+	for _, friendID := range audience {
 		if todayCount[friendID] < postNotifyDailyCap {
 			eligible = append(eligible, friendID)
 		}
@@ -1258,10 +1359,6 @@ func (s *Service) NotifyFriendsOfPost(postID primitive.ObjectID, posterID primit
 	}
 
 	// Thumbnail + caption for the notification body.
-	post, err := s.GetPostByID(postID)
-	if err != nil {
-		return fmt.Errorf("failed to get post for notification: %w", err)
-	}
 	var thumbnail string
 	if len(post.Images) > 0 {
 		thumbnail = post.Images[0]
@@ -1357,31 +1454,40 @@ func randIndex(n int) int {
 	return int(i.Int64())
 }
 
-// GetFriendsRingClosures returns ring closure notifications from friends, sorted by time descending.
-func (s *Service) GetFriendsRingClosures(userID primitive.ObjectID, limit, offset int) ([]FeedRingsClosedData, int, error) {
+// GetFriendsRingClosures returns ring closure notifications from friends, newest first, keyset-paged
+// the same way as GetFriendsPosts.
+func (s *Service) GetFriendsRingClosures(userID primitive.ObjectID, limit int, asOf time.Time, after *FeedPosition) ([]FeedRingsClosedData, bool, error) {
 	ctx := context.Background()
 
 	notifColl := s.NotificationService.Notifications
 
-	filter := bson.M{
-		"receiver":         userID,
-		"notificationType": "RINGS_CLOSED",
-		"user._id":         bson.M{"$ne": userID},
+	// Closures filed before the viewer muted a friend, or before the friend
+	// hid their activity, are filtered here too.
+	hidden := []primitive.ObjectID{}
+	if viewer, err := s.Audiences.Viewer(ctx, userID); err != nil {
+		slog.Warn("Failed to load viewer for ring closures", "user_id", userID.Hex(), "err", err)
+	} else {
+		hidden = viewer.FeedHidden()
 	}
 
-	total, err := notifColl.CountDocuments(ctx, filter)
-	if err != nil {
-		return nil, 0, err
+	filter := bson.M{
+		"$and": bson.A{
+			bson.M{
+				"receiver":         userID,
+				"notificationType": "RINGS_CLOSED",
+				"user._id":         bson.M{"$ne": userID, "$nin": hidden},
+			},
+			olderThan("time", after, asOf),
+		},
 	}
 
 	opts := options.Find().
-		SetSort(bson.D{{Key: "time", Value: -1}}).
-		SetLimit(int64(limit)).
-		SetSkip(int64(offset))
+		SetSort(newestFirst("time")).
+		SetLimit(int64(limit + 1))
 
 	cursor, err := notifColl.Find(ctx, filter, opts)
 	if err != nil {
-		return nil, 0, err
+		return nil, false, err
 	}
 	defer cursor.Close(ctx)
 
@@ -1390,6 +1496,7 @@ func (s *Service) GetFriendsRingClosures(userID primitive.ObjectID, limit, offse
 		var doc struct {
 			ID      primitive.ObjectID `bson:"_id"`
 			Time    time.Time          `bson:"time"`
+			RingDay *time.Time         `bson:"ringDay"`
 			Content string             `bson:"content"`
 			User    struct {
 				ID             primitive.ObjectID `bson:"_id"`
@@ -1406,6 +1513,8 @@ func (s *Service) GetFriendsRingClosures(userID primitive.ObjectID, limit, offse
 			ID:        doc.ID.Hex(),
 			Timestamp: doc.Time.Format(time.RFC3339),
 			Content:   doc.Content,
+			createdAt: doc.Time,
+			ringDay:   doc.RingDay,
 			User: &types.UserExtendedReference{
 				ID:             doc.User.ID.Hex(),
 				Handle:         doc.User.Handle,
@@ -1415,7 +1524,12 @@ func (s *Service) GetFriendsRingClosures(userID primitive.ObjectID, limit, offse
 		})
 	}
 
-	return results, int(total), nil
+	hasMore := len(results) > limit
+	if hasMore {
+		results = results[:limit]
+	}
+
+	return results, hasMore, nil
 }
 
 // ResolveTaggedUsers validates a candidate set of user IDs against the
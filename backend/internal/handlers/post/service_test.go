@@ -1,13 +1,16 @@
 package Post_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	Post "github.com/abhikaboy/Kindred/internal/handlers/post"
 	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
+	"github.com/abhikaboy/Kindred/internal/handlers/rings"
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
 	testpkg "github.com/abhikaboy/Kindred/internal/testing"
+	"github.com/abhikaboy/Kindred/internal/timeline"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -157,7 +160,7 @@ func (s *PostServiceTestSuite) TestAddComment_Success() {
 		User:    userRef,
 	}
 
-	_, err := s.service.AddComment(post.ID, comment)
+	_, _, err := s.service.AddComment(post.ID, comment)
 
 	s.NoError(err)
 
@@ -430,7 +433,7 @@ func (s *PostServiceTestSuite) TestGetUserPosts_Success() {
 	}
 
 	// Get user posts
-	posts, total, err := s.service.GetUserPosts(user.ID, 50, 0)
+	posts, total, err := s.service.GetUserPosts(user.ID, user.ID, 50, 0)
 
 	s.NoError(err)
 	s.GreaterOrEqual(len(posts), 3)
@@ -447,7 +450,7 @@ func (s *PostServiceTestSuite) TestGetUserPosts_EmptyResult() {
 	// Create a user with no posts
 	fakeUserID := testpkg.GenerateObjectID()
 
-	posts, total, err := s.service.GetUserPosts(fakeUserID, 50, 0)
+	posts, total, err := s.service.GetUserPosts(fakeUserID, fakeUserID, 50, 0)
 
 	s.NoError(err)
 	s.Empty(posts)
@@ -472,7 +475,7 @@ func (s *PostServiceTestSuite) TestGetUserPosts_ExcludesDeleted() {
 	s.NoError(err)
 
 	// Get user posts - should not include deleted
-	posts, _, err := s.service.GetUserPosts(user.ID, 50, 0)
+	posts, _, err := s.service.GetUserPosts(user.ID, user.ID, 50, 0)
 	s.NoError(err)
 
 	for _, post := range posts {
@@ -611,7 +614,7 @@ func (s *PostServiceTestSuite) TestGetPostsByBlueprint_Success() {
 	}
 
 	// Get posts by blueprint
-	posts, err := s.service.GetPostsByBlueprint(blueprintID)
+	posts, err := s.service.GetPostsByBlueprint(user.ID, blueprintID)
 
 	s.NoError(err)
 	s.GreaterOrEqual(len(posts), 2)
@@ -641,8 +644,8 @@ func (s *PostServiceTestSuite) TestGetPostsByBlueprint_OnlyPublic() {
 	_, _, err := s.service.CreatePost(&privatePost)
 	s.NoError(err)
 
-	// Get posts by blueprint - should not include private
-	posts, err := s.service.GetPostsByBlueprint(blueprintID)
+	// Get posts by blueprint as a stranger - should not include private
+	posts, err := s.service.GetPostsByBlueprint(testpkg.GenerateObjectID(), blueprintID)
 
 	s.NoError(err)
 
@@ -655,7 +658,7 @@ func (s *PostServiceTestSuite) TestGetPostsByBlueprint_OnlyPublic() {
 func (s *PostServiceTestSuite) TestGetPostsByBlueprint_EmptyResult() {
 	fakeID := testpkg.GenerateObjectID()
 
-	posts, err := s.service.GetPostsByBlueprint(fakeID)
+	posts, err := s.service.GetPostsByBlueprint(fakeID, fakeID)
 
 	s.NoError(err)
 	s.Empty(posts)
@@ -681,7 +684,7 @@ func (s *PostServiceTestSuite) TestDeleteComment_Success() {
 		},
 	}
 
-	_, err := s.service.AddComment(post.ID, comment)
+	_, _, err := s.service.AddComment(post.ID, comment)
 	s.NoError(err)
 
 	// Delete the comment
@@ -713,7 +716,7 @@ func (s *PostServiceTestSuite) TestDeleteComment_WithReplies() {
 		},
 	}
 
-	_, err := s.service.AddComment(post.ID, parentComment)
+	_, _, err := s.service.AddComment(post.ID, parentComment)
 	s.NoError(err)
 
 	// Add a reply
@@ -729,7 +732,7 @@ func (s *PostServiceTestSuite) TestDeleteComment_WithReplies() {
 		},
 	}
 
-	_, err = s.service.AddComment(post.ID, replyComment)
+	_, _, err = s.service.AddComment(post.ID, replyComment)
 	s.NoError(err)
 
 	// Delete parent comment - should also delete reply
@@ -947,7 +950,7 @@ func (s *PostServiceTestSuite) TestAddComment_WithMentions() {
 		},
 	}
 
-	_, err := s.service.AddComment(post.ID, comment)
+	_, _, err := s.service.AddComment(post.ID, comment)
 
 	s.NoError(err)
 
@@ -986,7 +989,7 @@ func (s *PostServiceTestSuite) TestAddComment_ReplyToComment() {
 		User:    userRef,
 	}
 
-	_, err := s.service.AddComment(post.ID, parentComment)
+	_, _, err := s.service.AddComment(post.ID, parentComment)
 	s.NoError(err)
 
 	// Add reply
@@ -997,7 +1000,7 @@ func (s *PostServiceTestSuite) TestAddComment_ReplyToComment() {
 		ParentID: &parentComment.ID,
 	}
 
-	_, err = s.service.AddComment(post.ID, replyComment)
+	_, _, err = s.service.AddComment(post.ID, replyComment)
 	s.NoError(err)
 
 	// Verify reply was added
@@ -1164,11 +1167,10 @@ func (s *PostServiceTestSuite) TestGetFriendsPosts_WithFriends() {
 	s.NoError(err)
 
 	// Get friends posts for user1
-	posts, total, err := s.service.GetFriendsPosts(user1.ID, 10, 0)
+	posts, _, err := s.service.GetFriendsPosts(user1.ID, 10, time.Now(), nil)
 
 	// Should not error even if complex aggregation
 	s.NoError(err)
-	s.GreaterOrEqual(total, 0)
 	s.NotNil(posts)
 }
 
@@ -1192,7 +1194,7 @@ func (s *PostServiceTestSuite) TestGetFriendsPosts_NoFriends() {
 	_, _, err = s.service.CreatePost(&strangerPost)
 	s.NoError(err)
 
-	posts, _, err := s.service.GetFriendsPosts(user.ID, 10, 0)
+	posts, _, err := s.service.GetFriendsPosts(user.ID, 10, time.Now(), nil)
 	s.NoError(err)
 	for _, p := range posts {
 		s.Equal(user.ID.Hex(), p.User.ID.Hex(), "feed should only contain the user's own posts when they have no friends")
@@ -1219,17 +1221,31 @@ func (s *PostServiceTestSuite) TestGetFriendsPosts_Pagination() {
 		s.NoError(err)
 	}
 
+	asOf := time.Now()
+
 	// Get first page
-	posts1, _, err := s.service.GetFriendsPosts(user1.ID, 2, 0)
+	posts1, more, err := s.service.GetFriendsPosts(user1.ID, 2, asOf, nil)
 	s.NoError(err)
+	s.Require().Len(posts1, 2)
+	s.True(more)
 
-	// Get second page
-	posts2, _, err := s.service.GetFriendsPosts(user1.ID, 2, 2)
+	// A post arriving mid-scroll must not shift the next page
+	late := testpkg.NewPostBuilder(*user2).WithCaption("Late post").Build()
+	late.Metadata.CreatedAt = asOf.Add(time.Minute)
+	_, _, err = s.service.CreatePost(&late)
 	s.NoError(err)
 
-	// Both should succeed
-	s.NotNil(posts1)
-	s.NotNil(posts2)
+	// Get second page from the last post seen
+	last := posts1[len(posts1)-1]
+	posts2, _, err := s.service.GetFriendsPosts(user1.ID, 2, asOf, &Post.FeedPosition{At: last.Metadata.CreatedAt, ID: last.ID})
+	s.NoError(err)
+	s.NotEmpty(posts2)
+
+	seen := map[primitive.ObjectID]bool{posts1[0].ID: true, posts1[1].ID: true}
+	for _, p := range posts2 {
+		s.False(seen[p.ID], "second page should not repeat the first")
+		s.NotEqual(late.ID, p.ID, "posts after the snapshot should wait for a refresh")
+	}
 }
 
 // ========================================
@@ -1245,7 +1261,7 @@ func (s *PostServiceTestSuite) TestGetFriendsPublicTasks_NoFriends() {
 	})
 	s.NoError(err)
 
-	tasks, total, err := s.service.GetFriendsPublicTasks(user.ID, 10)
+	tasks, total, err := s.service.GetFriendsPublicTasks(user.ID, 10, time.Now())
 
 	s.NoError(err)
 	s.Equal(0, total)
@@ -1321,7 +1337,7 @@ func (s *PostServiceTestSuite) TestGetFriendsPublicTasks_FiltersAndProjections()
 	_, err = s.Collections["categories"].InsertOne(s.Ctx, category)
 	s.NoError(err)
 
-	tasks, total, err := s.service.GetFriendsPublicTasks(user1.ID, 50)
+	tasks, total, err := s.service.GetFriendsPublicTasks(user1.ID, 50, time.Now())
 	s.NoError(err)
 	s.GreaterOrEqual(total, 1)
 
@@ -1374,7 +1390,7 @@ func (s *PostServiceTestSuite) TestGetFriendsPublicTasks_MissingActiveTreatedAsA
 	_, err = s.Collections["categories"].InsertOne(s.Ctx, category)
 	s.NoError(err)
 
-	tasks, _, err := s.service.GetFriendsPublicTasks(user1.ID, 50)
+	tasks, _, err := s.service.GetFriendsPublicTasks(user1.ID, 50, time.Now())
 	s.NoError(err)
 
 	found := false
@@ -1386,11 +1402,56 @@ func (s *PostServiceTestSuite) TestGetFriendsPublicTasks_MissingActiveTreatedAsA
 	s.True(found, "task missing the active field should be treated as active")
 }
 
+func (s *PostServiceTestSuite) TestGetFriendsPublicTasks_PinnedToAsOf() {
+	user1 := s.GetUser(0)
+	user2 := s.GetUser(1)
+
+	_, err := s.Collections["users"].UpdateOne(s.Ctx, bson.M{"_id": user1.ID}, bson.M{
+		"$set": bson.M{"friends": []primitive.ObjectID{user2.ID}},
+	})
+	s.NoError(err)
+
+	asOf := time.Now().Add(-time.Hour)
+	task := func(content string, at time.Time) bson.M {
+		return bson.M{
+			"_id":       primitive.NewObjectID(),
+			"content":   content,
+			"priority":  1,
+			"value":     5.0,
+			"public":    true,
+			"active":    true,
+			"timestamp": primitive.NewDateTimeFromTime(at),
+		}
+	}
+	_, err = s.Collections["categories"].InsertOne(s.Ctx, bson.M{
+		"_id":  primitive.NewObjectID(),
+		"name": "Pinned",
+		"user": user2.ID,
+		"tasks": []bson.M{
+			task("before the session", asOf.Add(-time.Minute)),
+			task("during the session", asOf.Add(time.Minute)),
+		},
+	})
+	s.NoError(err)
+
+	tasks, _, err := s.service.GetFriendsPublicTasks(user1.ID, 50, asOf)
+	s.NoError(err)
+
+	var contents []string
+	for _, t := range tasks {
+		if content, ok := t["content"].(string); ok {
+			contents = append(contents, content)
+		}
+	}
+	s.Contains(contents, "before the session")
+	s.NotContains(contents, "during the session", "a task created mid-session would shift the pool later pages replay")
+}
+
 func (s *PostServiceTestSuite) TestGetFriendsPublicTasks_DefaultLimit() {
 	user := s.GetUser(0)
 
 	// Limit 0 should fall back to the default pool size
-	tasks, total, err := s.service.GetFriendsPublicTasks(user.ID, 0)
+	tasks, total, err := s.service.GetFriendsPublicTasks(user.ID, 0, time.Now())
 
 	s.NoError(err)
 	s.GreaterOrEqual(total, 0)
@@ -1594,7 +1655,7 @@ func (s *PostServiceTestSuite) TestAddComment_PostNotFound() {
 		User:    userRef,
 	}
 
-	_, err := s.service.AddComment(fakePostID, comment)
+	_, _, err := s.service.AddComment(fakePostID, comment)
 
 	// Should return error
 	s.Error(err)
@@ -1818,3 +1879,54 @@ func (s *PostServiceTestSuite) TestCreatePost_AutoTagsEncouragers() {
 	s.Len(post.TaggedUsers, 1)
 	s.Equal(encourager.ID, post.TaggedUsers[0].ID)
 }
+
+func (s *PostServiceTestSuite) TestBackfillTimeline_RingClosureAfterFanOut() {
+	ctx := context.Background()
+	users := s.Collections["users"]
+
+	closer := testpkg.NewUserBuilder().WithHandle("closer").Build()
+	friend := testpkg.NewUserBuilder().WithHandle("closerfriend").Build()
+	closer.Friends = []primitive.ObjectID{friend.ID}
+	friend.Friends = []primitive.ObjectID{closer.ID}
+	_, err := users.InsertMany(ctx, []interface{}{closer, friend})
+	s.Require().NoError(err)
+
+	ringService := rings.NewRingServiceFromCollections(s.Collections)
+	ringService.AnnounceRingsClosed(ctx, closer.ID, time.Now())
+
+	s.Require().NoError(s.service.BackfillTimeline(ctx, friend.ID))
+
+	n, err := users.Database().Collection(timeline.Collection).CountDocuments(ctx,
+		bson.M{"owner": friend.ID, "kind": timeline.KindRingsClosed})
+	s.Require().NoError(err)
+	s.Equal(int64(1), n, "fan-out and backfill should file the closure once")
+}
+
+func (s *PostServiceTestSuite) TestPublishPost_RejectsGroupsTheAuthorIsNotIn() {
+	ctx := context.Background()
+	author := s.GetUser(0)
+	group := types.GroupDocument{
+		ID:       primitive.NewObjectID(),
+		Name:     "Someone else's group",
+		Creator:  s.GetUser(1).ID,
+		Members:  []types.UserExtendedReferenceInternal{},
+		Metadata: types.NewGroupMetadata(),
+	}
+	_, err := s.Collections["groups"].InsertOne(ctx, group)
+	s.Require().NoError(err)
+
+	params := Post.CreatePostParams{
+		Caption:  "Hello strangers",
+		Audience: &Post.AudienceInput{Type: types.AudienceGroups, Groups: []string{group.ID.Hex()}},
+	}
+	_, _, _, err = s.service.PublishPost(ctx, author.ID, params, primitive.NilObjectID, "UTC")
+	s.Error(err)
+
+	legacy := Post.CreatePostParams{Caption: "Hello strangers", Groups: []string{group.ID.Hex()}}
+	_, _, _, err = s.service.PublishPost(ctx, author.ID, legacy, primitive.NilObjectID, "UTC")
+	s.Error(err)
+
+	n, err := s.Collections["posts"].CountDocuments(ctx, bson.M{"groups": group.ID})
+	s.Require().NoError(err)
+	s.Zero(n)
+}
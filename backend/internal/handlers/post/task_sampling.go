@@ -15,20 +15,7 @@ func sampleTasks(candidates []taskCandidate, n int, cfg taskScoringConfig, now t
 		return nil
 	}
 
-	type scored struct {
-		idx    int
-		score  float64
-		weight float64
-	}
-	pool := make([]scored, len(candidates))
-	for i := range candidates {
-		s := scoreTask(&candidates[i], cfg, now)
-		pool[i] = scored{
-			idx:    i,
-			score:  s,
-			weight: math.Pow(s+cfg.SamplingEpsilon, cfg.SamplingExponent),
-		}
-	}
+	pool := scorePool(candidates, cfg, now)
 
 	// Fewer candidates than asked: return everything, best first.
 	if n >= len(candidates) {
@@ -39,7 +26,32 @@ func sampleTasks(candidates []taskCandidate, n int, cfg taskScoringConfig, now t
 		}
 		return out
 	}
+	return drawTasks(candidates, pool, n, rng)
+}
+
+type scoredCandidate struct {
+	idx    int
+	score  float64
+	weight float64
+}
+
+func scorePool(candidates []taskCandidate, cfg taskScoringConfig, now time.Time) []scoredCandidate {
+	pool := make([]scoredCandidate, len(candidates))
+	for i := range candidates {
+		s := scoreTask(&candidates[i], cfg, now)
+		pool[i] = scoredCandidate{
+			idx:    i,
+			score:  s,
+			weight: math.Pow(s+cfg.SamplingEpsilon, cfg.SamplingExponent),
+		}
+	}
+	return pool
+}
 
+// drawTasks is the weighted draw behind sampleTasks. A longer draw from the
+// same seed extends a shorter one, which is what lets feed pages replay it.
+func drawTasks(candidates []taskCandidate, pool []scoredCandidate, n int, rng *rand.Rand) []FeedTaskData {
+	n = min(n, len(pool))
 	out := make([]FeedTaskData, 0, n)
 	for len(out) < n {
 		total := 0.0
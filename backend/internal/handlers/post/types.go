@@ -2,12 +2,17 @@ package Post
 
 import (
 	"encoding/json"
+	"time"
 
+	"github.com/abhikaboy/Kindred/internal/audience"
+	"github.com/abhikaboy/Kindred/internal/config"
 	"github.com/abhikaboy/Kindred/internal/friendship"
 	"github.com/abhikaboy/Kindred/internal/handlers/encouragement"
 	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
 	"github.com/abhikaboy/Kindred/internal/handlers/rings"
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/internal/timeline"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
@@ -82,8 +87,105 @@ type CreatePostParams struct {
 	// Permissive on purpose: malformed tags (a bare "@", missing fields, wrong
 	// types, a non-array) are dropped via coerceMentions instead of failing the
 	// post. Shape is [{id, handle}]; the handler ObjectID- and friend-gates.
-	TaggedUsers any         `json:"taggedUsers,omitempty" doc:"Tagged user references as [{id, handle}]; malformed entries are dropped, never rejected"`
-	Song        *types.Song `json:"song,omitempty"`
+	TaggedUsers any            `json:"taggedUsers,omitempty" doc:"Tagged user references as [{id, handle}]; malformed entries are dropped, never rejected"`
+	Song        *types.Song    `json:"song,omitempty"`
+	Audience    *AudienceInput `json:"audience,omitempty" doc:"Who can see the post. Omitted, it comes from groups, then the author's default audience, then isPublic"`
+}
+
+// AudienceInput picks who can see a post. Groups are required for the groups
+// audience, users for the users audience and lists for the lists audience;
+// users who aren't the author's friends are dropped, and the lists must be
+// the author's own.
+type AudienceInput struct {
+	Type   string   `bson:"type" json:"type" enum:"public,friends,close_friends,groups,users,lists,only_me" doc:"Who can see the post"`
+	Groups []string `bson:"groups,omitempty" json:"groups,omitempty" validate:"omitempty,dive,len=24" doc:"Group IDs, for the groups audience"`
+	Users  []string `bson:"users,omitempty" json:"users,omitempty" validate:"omitempty,dive,len=24" doc:"Friend IDs, for the users audience"`
+	Lists  []string `bson:"lists,omitempty" json:"lists,omitempty" validate:"omitempty,dive,len=24" doc:"Friend list IDs, for the lists audience"`
+}
+
+// DraftContent is what a draft will post. It mirrors CreatePostParams, but
+// nothing is required until the draft is scheduled or published, and tags
+// are stored already coerced.
+type DraftContent struct {
+	Images            []string                         `bson:"images,omitempty" json:"images,omitempty" validate:"omitempty,dive,url"`
+	Media             []MediaItemInput                 `bson:"media,omitempty" json:"media,omitempty" validate:"omitempty,max=10,dive"`
+	Dual              *string                          `bson:"dual,omitempty" json:"dual,omitempty" validate:"omitempty,url"`
+	Caption           string                           `bson:"caption" json:"caption"`
+	Size              *types.ImageSize                 `bson:"size,omitempty" json:"size,omitempty"`
+	Task              *types.PostTaskExtendedReference `bson:"task,omitempty" json:"task,omitempty"`
+	BlueprintID       *string                          `bson:"blueprintId,omitempty" json:"blueprintId,omitempty"`
+	BlueprintIsPublic *bool                            `bson:"blueprintIsPublic,omitempty" json:"blueprintIsPublic,omitempty"`
+	Groups            []string                         `bson:"groups,omitempty" json:"groups,omitempty" validate:"omitempty,dive,len=24"`
+	IsPublic          bool                             `bson:"isPublic" json:"isPublic"`
+	TaggedUsers       []MentionInput                   `bson:"taggedUsers,omitempty" json:"taggedUsers,omitempty"`
+	Song              *types.Song                      `bson:"song,omitempty" json:"song,omitempty"`
+	Audience          *AudienceInput                   `bson:"audience,omitempty" json:"audience,omitempty"`
+}
+
+// PostDraft is an unpublished post that can be resumed on any device and
+// optionally scheduled. Published drafts are kept briefly with their PostID
+// so a retried publish can tell it already happened.
+type PostDraft struct {
+	ID          primitive.ObjectID  `bson:"_id" json:"id"`
+	UserID      primitive.ObjectID  `bson:"userId" json:"-"`
+	Content     DraftContent        `bson:"content" json:"content"`
+	Status      string              `bson:"status" json:"status" enum:"draft,scheduled,publishing,published"`
+	PublishAt   *time.Time          `bson:"publishAt,omitempty" json:"publishAt,omitempty" doc:"When a scheduled draft will be published"`
+	Timezone    string              `bson:"timezone,omitempty" json:"-"`
+	PostID      *primitive.ObjectID `bson:"postId,omitempty" json:"postId,omitempty" doc:"The post, once published"`
+	Error       string              `bson:"error,omitempty" json:"error,omitempty" doc:"Why the last scheduled publish failed"`
+	ClaimedAt   *time.Time          `bson:"claimedAt,omitempty" json:"-"`
+	PublishedAt *time.Time          `bson:"publishedAt,omitempty" json:"publishedAt,omitempty"`
+	CreatedAt   time.Time           `bson:"createdAt" json:"createdAt"`
+	UpdatedAt   time.Time           `bson:"updatedAt" json:"updatedAt"`
+}
+
+// Save draft
+type CreateDraftInput struct {
+	Authorization string       `header:"Authorization" required:"true"`
+	Body          DraftContent `json:"body"`
+}
+
+type DraftOutput struct {
+	Body PostDraft `json:"body"`
+}
+
+// Update draft
+type UpdateDraftInput struct {
+	Authorization string       `header:"Authorization" required:"true"`
+	ID            string       `path:"id" doc:"Draft ID"`
+	Body          DraftContent `json:"body"`
+}
+
+// Get, delete, publish and unschedule a draft
+type DraftIDInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	ID            string `path:"id" doc:"Draft ID"`
+}
+
+type GetDraftsInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+}
+
+type GetDraftsOutput struct {
+	Body struct {
+		Drafts []PostDraft `json:"drafts"`
+	} `json:"body"`
+}
+
+type DeleteDraftOutput struct {
+	Body struct {
+		Message string `json:"message" example:"Draft deleted successfully"`
+	}
+}
+
+// Schedule draft
+type ScheduleDraftInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	ID            string `path:"id" doc:"Draft ID"`
+	Body          struct {
+		PublishAt time.Time `json:"publishAt" doc:"When to publish; must be in the future and within 30 days"`
+	} `json:"body"`
 }
 
 // Get Posts (all)
@@ -106,15 +208,14 @@ type GetPostsOutput struct {
 type GetFriendsPostsInput struct {
 	Authorization string `header:"Authorization" required:"true"`
 	Limit         int    `query:"limit" default:"8" minimum:"1" maximum:"50" doc:"Number of posts to return (default: 8)"`
-	Offset        int    `query:"offset" default:"0" minimum:"0" doc:"Number of posts to skip (default: 0)"`
+	Cursor        string `query:"cursor" doc:"nextCursor from the previous page; omit for the first page"`
 }
 
 type GetFriendsPostsOutput struct {
 	Body struct {
 		Posts      []types.PostDocumentAPI `json:"posts"`
-		Total      int                     `json:"total" doc:"Total number of posts available"`
 		HasMore    bool                    `json:"hasMore" doc:"Whether there are more posts to fetch"`
-		NextOffset int                     `json:"nextOffset" doc:"Offset for the next page"`
+		NextCursor string                  `json:"nextCursor,omitempty" doc:"Opaque cursor for the next page; absent when hasMore is false"`
 	} `json:"body"`
 }
 
@@ -122,7 +223,9 @@ type GetFriendsPostsOutput struct {
 type GetFeedInput struct {
 	Authorization string `header:"Authorization" required:"true"`
 	Limit         int    `query:"limit" default:"20" minimum:"1" maximum:"50" doc:"Number of feed items to return (default: 20)"`
-	Offset        int    `query:"offset" default:"0" minimum:"0" doc:"Number of feed items to skip (default: 0)"`
+	Cursor        string `query:"cursor" doc:"nextCursor from the previous page; omit for the first page"`
+	Mode          string `query:"mode" enum:"chronological,ranked" default:"chronological" doc:"Ordering for a new session; later pages keep the cursor's mode"`
+	Debug         bool   `query:"debug" doc:"Include each ranked item's score breakdown"`
 }
 
 type FeedItem struct {
@@ -130,6 +233,19 @@ type FeedItem struct {
 	Post        *types.PostDocumentAPI `json:"post,omitempty" doc:"Post data (only present if type is 'post')"`
 	Task        *FeedTaskData          `json:"task,omitempty" doc:"Task data (only present if type is 'task')"`
 	RingsClosed *FeedRingsClosedData   `json:"ringsClosed,omitempty" doc:"Rings closed data (only present if type is 'rings_closed')"`
+	Score       *FeedScore             `json:"score,omitempty" doc:"Why a ranked item landed where it did (only with debug=true)"`
+}
+
+// FeedScore breaks a ranked item's score into each signal's weighted
+// contribution; Total is their sum.
+type FeedScore struct {
+	Total      float64 `json:"total"`
+	Recency    float64 `json:"recency" doc:"Decays with age from the start of the session"`
+	Friendship float64 `json:"friendship" doc:"Pair friendship level with the author"`
+	Engagement float64 `json:"engagement" doc:"Reactions and comments on the post"`
+	Kudos      float64 `json:"kudos" doc:"Kudos recorded on the post"`
+	Affinity   float64 `json:"affinity" doc:"How much the author's encouragement lands with the viewer"`
+	Seen       float64 `json:"seen" doc:"Penalty for an item the viewer saw in an earlier session; zero or negative"`
 }
 
 type FeedRingsClosedData struct {
@@ -137,6 +253,11 @@ type FeedRingsClosedData struct {
 	Timestamp string                       `json:"timestamp"`
 	Content   string                       `json:"content"`
 	User      *types.UserExtendedReference `json:"user" doc:"User who closed their rings"`
+
+	// createdAt keeps full precision for cursor positions; Timestamp is seconds.
+	createdAt time.Time
+	// ringDay keys the closure's timeline entry; older notifications lack it.
+	ringDay *time.Time
 }
 
 type FeedTaskData struct {
@@ -150,17 +271,30 @@ type FeedTaskData struct {
 	CategoryName  string                       `json:"categoryName"`
 	WorkspaceName string                       `json:"workspaceName"`
 	User          *types.UserExtendedReference `json:"user" doc:"User who created the task"`
+	CompletedAt   string                       `json:"completedAt,omitempty" doc:"When a friend completed the task; absent for open tasks offered for encouragement"`
 }
 
 type GetFeedOutput struct {
 	Body struct {
 		Items      []FeedItem `json:"items" doc:"Mixed feed items containing posts and tasks"`
-		Total      int        `json:"total" doc:"Total number of feed items available"`
 		HasMore    bool       `json:"hasMore" doc:"Whether there are more feed items to fetch"`
-		NextOffset int        `json:"nextOffset" doc:"Offset for the next page"`
+		NextCursor string     `json:"nextCursor,omitempty" doc:"Opaque cursor for the next page; absent when hasMore is false"`
 	} `json:"body"`
 }
 
+type RecordFeedImpressionsInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	Body          struct {
+		Items []string `json:"items" minItems:"1" maxItems:"100" doc:"IDs of feed items the viewer saw: posts, ring closures or tasks"`
+	}
+}
+
+type RecordFeedImpressionsOutput struct {
+	Body struct {
+		Message string `json:"message" example:"Impressions recorded"`
+	}
+}
+
 // Get Post by ID
 type GetPostInput struct {
 	Authorization string `header:"Authorization" required:"true"`
@@ -208,6 +342,8 @@ type UpdatePostParams struct {
 	// Pointer so absent vs. provided is distinguishable; element type is `any`
 	// so malformed tags are dropped (coerceMentions), never rejected.
 	TaggedUsers *[]any `json:"taggedUsers,omitempty" doc:"Tagged user references as [{id, handle}]; malformed entries are dropped, never rejected"`
+	// Changing the audience also re-files the post in viewers' timelines.
+	Audience *AudienceInput `json:"audience,omitempty" doc:"New audience; viewers who lose access stop seeing the post everywhere"`
 }
 
 // Get User Groups (for posts)
@@ -283,6 +419,63 @@ type DeleteCommentOutput struct {
 	}
 }
 
+type EditCommentInput struct {
+	Authorization string            `header:"Authorization" required:"true"`
+	PostID        string            `path:"postId" doc:"Post ID"`
+	CommentID     string            `path:"commentId" doc:"Comment ID"`
+	Body          EditCommentParams `json:"body"`
+}
+
+type EditCommentParams struct {
+	Content  string         `json:"content" validate:"required,min=1"`
+	Mentions []MentionInput `json:"mentions,omitempty"`
+}
+
+type EditCommentOutput struct {
+	Body struct {
+		Message string                   `json:"message" example:"Comment updated successfully"`
+		Comment types.CommentDocumentAPI `json:"comment"`
+	} `json:"body"`
+}
+
+// Approve a pending comment from a restricted user
+type ApproveCommentInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	PostID        string `path:"postId" doc:"Post ID"`
+	CommentID     string `path:"commentId" doc:"Comment ID"`
+}
+
+type ApproveCommentOutput struct {
+	Body struct {
+		Message string                   `json:"message" example:"Comment approved successfully"`
+		Comment types.CommentDocumentAPI `json:"comment"`
+	} `json:"body"`
+}
+
+// Get comments: root comments newest first, or one thread's replies oldest first
+type GetCommentsInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	PostID        string `path:"postId" doc:"Post ID"`
+	Thread        string `query:"thread" required:"false" doc:"Root comment ID; when set, returns that thread's replies instead of root comments"`
+	Limit         int    `query:"limit" default:"20" minimum:"1" maximum:"100" doc:"Maximum number of comments to return"`
+	Cursor        string `query:"cursor" required:"false" doc:"Opaque cursor from the previous page's nextCursor"`
+}
+
+type GetCommentsOutput struct {
+	Body struct {
+		Comments   []types.CommentDocumentAPI `json:"comments"`
+		NextCursor string                     `json:"nextCursor,omitempty" doc:"Pass as cursor to fetch the next page"`
+		HasMore    bool                       `json:"hasMore"`
+	} `json:"body"`
+}
+
+type ToggleCommentReactionInput struct {
+	Authorization string            `header:"Authorization" required:"true"`
+	PostID        string            `path:"postId" doc:"Post ID"`
+	CommentID     string            `path:"commentId" doc:"Comment ID"`
+	Body          AddReactionParams `json:"body"`
+}
+
 type AddReactionInput struct {
 	Authorization string            `header:"Authorization" required:"true"`
 	PostID        string            `path:"postId" example:"507f1f77bcf86cd799439011"`
@@ -317,4 +510,11 @@ type Service struct {
 	RingService          *rings.RingService
 	EncouragementService *encouragement.Service
 	Friendship           *friendship.Service
+	Timeline             *timeline.Service
+	Audiences            *audience.Service
+	Impressions          *mongo.Collection
+	Comments             *mongo.Collection
+	Drafts               *mongo.Collection
+	Memory               *mongo.Collection
+	Ranking              config.Feed
 }
@@ -136,6 +136,7 @@ func (h *Handler) GetProfileHuma(ctx context.Context, input *GetProfileInput) (*
 	authenticatedUserID, isAuthenticated := auth.OptionalAuth(ctx)
 
 	var relationship *RelationshipInfo
+	var viewerID primitive.ObjectID
 	if isAuthenticated {
 		// Convert authenticated user ID to ObjectID
 		authUserID, err := primitive.ObjectIDFromHex(authenticatedUserID)
@@ -145,6 +146,7 @@ func (h *Handler) GetProfileHuma(ctx context.Context, input *GetProfileInput) (*
 				Status: RelationshipNone,
 			}
 		} else {
+			viewerID = authUserID
 			// Check relationship between authenticated user and profile being viewed
 			relationship, err = h.service.CheckRelationship(authUserID, id)
 			if err != nil {
@@ -165,8 +167,17 @@ func (h *Handler) GetProfileHuma(ctx context.Context, input *GetProfileInput) (*
 	// Add relationship information to the profile
 	profile.Relationship = relationship
 
-	// if the profile relationship is friend or self, add tasks to the profile
-	if relationship.Status == RelationshipConnected || relationship.Status == RelationshipSelf {
+	// if the profile relationship is friend or self, add tasks to the profile,
+	// unless the owner hides their activity from this friend
+	showActivity := relationship.Status == RelationshipSelf
+	if relationship.Status == RelationshipConnected {
+		hidden, err := h.service.HidesActivityFrom(id, viewerID)
+		if err != nil {
+			slog.Error("Failed to check hidden activity", "profileId", id.Hex(), "error", err)
+		}
+		showActivity = err == nil && !hidden
+	}
+	if showActivity {
 		tasks, err := h.service.GetProfileTasks(id)
 		if err != nil {
 			slog.Error("Failed to get profile tasks", "profileId", id.Hex(), "error", err)
@@ -187,7 +198,7 @@ func (h *Handler) GetProfileHuma(ctx context.Context, input *GetProfileInput) (*
 	}
 
 	// Embed today's ring state for connected users and self
-	if relationship.Status == RelationshipConnected || relationship.Status == RelationshipSelf {
+	if showActivity {
 		timezone := auth.GetTimezoneOrDefault(ctx)
 		ringState, err := h.ringService.GetOrCreateToday(ctx, id, timezone)
 		if err != nil {
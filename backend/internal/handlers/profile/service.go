@@ -24,6 +24,7 @@ func NewService(collections map[string]*mongo.Collection) *Service {
 		Tasks:          collections["categories"],
 		CompletedTasks: collections["completed-tasks"],
 		Posts:          collections["posts"],
+		Comments:       collections["comments"],
 		Groups:         collections["groups"],
 		Blueprints:     collections["blueprints"],
 		Notifications:  collections["notifications"],
@@ -384,6 +385,12 @@ func (s *Service) propagateUserRefFields(userID primitive.ObjectID, fields bson.
 				}),
 			},
 		},
+		{
+			name:       "comments",
+			collection: s.Comments,
+			filter:     bson.M{"user._id": userID},
+			update:     bson.M{"$set": topLevel},
+		},
 		{
 			name:       "groups",
 			collection: s.Groups,
@@ -454,6 +461,13 @@ func (s *Service) UpdateTimezone(id primitive.ObjectID, timezone string) error {
 	return err
 }
 
+// HidesActivityFrom reports whether owner hides their tasks and rings from
+// viewer.
+func (s *Service) HidesActivityFrom(owner, viewer primitive.ObjectID) (bool, error) {
+	n, err := s.Profiles.CountDocuments(context.Background(), bson.M{"_id": owner, "hiddenFrom": viewer})
+	return n > 0, err
+}
+
 func (s *Service) GetProfileTasks(userID primitive.ObjectID) ([]types.TaskDocument, error) {
 	ctx := context.Background()
 	var pipeline []bson.D = []bson.D{
@@ -74,6 +74,7 @@ type Service struct {
 	Tasks          *mongo.Collection
 	CompletedTasks *mongo.Collection
 	Posts          *mongo.Collection
+	Comments       *mongo.Collection
 	Groups         *mongo.Collection
 	Blueprints     *mongo.Collection
 	Notifications  *mongo.Collection
@@ -82,29 +82,9 @@ func (s *Service) ReportComment(ctx context.Context, reporterID, commentID primi
 		return nil, fmt.Errorf("reports collection not available")
 	}
 
-	// Find the post containing this comment to get comment owner
-	var post struct {
-		ID       primitive.ObjectID      `bson:"_id"`
-		Comments []types.CommentDocument `bson:"comments"`
-	}
-
-	err := s.Posts.FindOne(ctx, bson.M{"comments._id": commentID}).Decode(&post)
+	commentOwnerID, err := s.commentOwner(ctx, commentID)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return nil, fmt.Errorf("comment not found")
-		}
-		return nil, fmt.Errorf("failed to find comment: %w", err)
-	}
-
-	// Find the specific comment to get owner ID
-	var commentOwnerID primitive.ObjectID
-	for _, comment := range post.Comments {
-		if comment.ID == commentID {
-			if comment.User != nil {
-				commentOwnerID = comment.User.ID
-			}
-			break
-		}
+		return nil, err
 	}
 
 	if commentOwnerID.IsZero() {
@@ -190,3 +170,40 @@ func (s *Service) GetReports(ctx context.Context, status string, limit, offset i
 
 	return reports, int(total), nil
 }
+
+// commentOwner finds who wrote a comment. Comments live in their own
+// collection; posts not yet migrated still embed them, so fall back to
+// searching the posts.
+func (s *Service) commentOwner(ctx context.Context, commentID primitive.ObjectID) (primitive.ObjectID, error) {
+	if s.Comments != nil {
+		var comment types.CommentDocument
+		err := s.Comments.FindOne(ctx, bson.M{"_id": commentID}).Decode(&comment)
+		if err == nil {
+			if comment.User == nil {
+				return primitive.NilObjectID, nil
+			}
+			return comment.User.ID, nil
+		}
+		if err != mongo.ErrNoDocuments {
+			return primitive.NilObjectID, fmt.Errorf("failed to find comment: %w", err)
+		}
+	}
+
+	var post struct {
+		ID       primitive.ObjectID      `bson:"_id"`
+		Comments []types.CommentDocument `bson:"comments"`
+	}
+	err := s.Posts.FindOne(ctx, bson.M{"comments._id": commentID}).Decode(&post)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return primitive.NilObjectID, fmt.Errorf("comment not found")
+		}
+		return primitive.NilObjectID, fmt.Errorf("failed to find comment: %w", err)
+	}
+	for _, comment := range post.Comments {
+		if comment.ID == commentID && comment.User != nil {
+			return comment.User.ID, nil
+		}
+	}
+	return primitive.NilObjectID, nil
+}
@@ -6,9 +6,10 @@ import (
 )
 
 type Service struct {
-	Reports *mongo.Collection
-	Posts   *mongo.Collection
-	Users   *mongo.Collection
+	Reports  *mongo.Collection
+	Posts    *mongo.Collection
+	Comments *mongo.Collection
+	Users    *mongo.Collection
 }
 
 type Handler struct {
@@ -18,9 +19,10 @@ type Handler struct {
 // newService creates a new report service
 func newService(collections map[string]*mongo.Collection) *Service {
 	return &Service{
-		Reports: collections["reports"],
-		Posts:   collections["posts"],
-		Users:   collections["users"],
+		Reports:  collections["reports"],
+		Posts:    collections["posts"],
+		Comments: collections["comments"],
+		Users:    collections["users"],
 	}
 }
 
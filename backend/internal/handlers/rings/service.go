@@ -8,7 +8,9 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/abhikaboy/Kindred/internal/audience"
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/internal/timeline"
 	"github.com/abhikaboy/Kindred/xutils"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -28,18 +30,26 @@ type RingService struct {
 	ringStates    *mongo.Collection
 	users         *mongo.Collection
 	notifications *mongo.Collection
+	timeline      *timeline.Service
+	audiences     *audience.Service
 }
 
 // NewRingService creates a new RingService.
 func NewRingService(ringStates, users *mongo.Collection) *RingService {
 	var notifs *mongo.Collection
+	var tl *timeline.Service
+	var aud *audience.Service
 	if users != nil {
 		notifs = users.Database().Collection("notifications")
+		tl = timeline.NewWithDatabase(users.Database())
+		aud = audience.NewWithDatabase(users.Database())
 	}
 	return &RingService{
 		ringStates:    ringStates,
 		users:         users,
 		notifications: notifs,
+		timeline:      tl,
+		audiences:     aud,
 	}
 }
 
@@ -48,12 +58,17 @@ func NewRingService(ringStates, users *mongo.Collection) *RingService {
 // Exported so other packages can key day-boundary checks (e.g. Sessions'
 // once-per-task-per-day ring cap) off the same "today" the rings use.
 func TodayInTimezone(timezone string) time.Time {
+	return DayInTimezone(time.Now(), timezone)
+}
+
+// DayInTimezone is TodayInTimezone for an arbitrary instant.
+func DayInTimezone(t time.Time, timezone string) time.Time {
 	loc, err := time.LoadLocation(timezone)
 	if err != nil {
 		loc = time.UTC
 	}
-	now := time.Now().In(loc)
-	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
 }
 
 // GetOrCreateToday returns today's ring state for the user, creating one with
@@ -161,15 +176,17 @@ func (s *RingService) IncrementRing(ctx context.Context, userID primitive.Object
 	justClosedAll := allClosed && !wasPreviouslyAllClosed
 
 	// Update closure flags on the document.
-	closureUpdate := bson.M{
-		"$set": bson.M{
-			"plan.closed":  planClosed,
-			"do.closed":    doClosed,
-			"share.closed": shareClosed,
-			"all_closed":   allClosed,
-			"updated_at":   now,
-		},
+	closureSet := bson.M{
+		"plan.closed":  planClosed,
+		"do.closed":    doClosed,
+		"share.closed": shareClosed,
+		"all_closed":   allClosed,
+		"updated_at":   now,
 	}
+	if justClosedAll {
+		closureSet["all_closed_at"] = now
+	}
+	closureUpdate := bson.M{"$set": closureSet}
 
 	err = s.ringStates.FindOneAndUpdate(ctx, filter, closureUpdate, opts).Decode(&state)
 	if err != nil {
@@ -399,6 +416,13 @@ func (s *RingService) EnsureIndexes(ctx context.Context) error {
 			},
 			Options: options.Index().SetExpireAfterSeconds(30 * 24 * 60 * 60), // 30 days
 		},
+		{
+			// Group activity streams: members' all-rings-closed moments, newest first.
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "all_closed_at", Value: -1},
+			},
+		},
 	}
 
 	_, err := s.ringStates.Indexes().CreateMany(ctx, indexes)
@@ -427,72 +451,158 @@ func (s *RingService) recalculateScore(ctx context.Context, userID primitive.Obj
 	return nil
 }
 
-// NotifyAllRingsClosed sends delayed notifications (2 minutes) to the user and
-// their friends when all rings are closed for the day.
+// NotifyAllRingsClosed sends delayed notifications (2 minutes) to the user,
+// their friends, and fellow group members who follow group activity when all
+// rings are closed for the day.
 func (s *RingService) NotifyAllRingsClosed(userID primitive.ObjectID) {
+	closedAt := time.Now()
 	time.AfterFunc(2*time.Minute, func() {
-		ctx := context.Background()
+		s.AnnounceRingsClosed(context.Background(), userID, closedAt)
+	})
+}
 
-		var user types.User
-		if err := s.users.FindOne(ctx, bson.M{"_id": userID}).Decode(&user); err != nil {
-			slog.Error("rings closed notify: failed to fetch user", "error", err, "user_id", userID)
-			return
-		}
+// AnnounceRingsClosed sends what NotifyAllRingsClosed schedules, for rings
+// closed at closedAt.
+func (s *RingService) AnnounceRingsClosed(ctx context.Context, userID primitive.ObjectID, closedAt time.Time) {
+	var user types.User
+	if err := s.users.FindOne(ctx, bson.M{"_id": userID}).Decode(&user); err != nil {
+		slog.Error("rings closed notify: failed to fetch user", "error", err, "user_id", userID)
+		return
+	}
+
+	// Push to the user themselves is fine (in-the-moment celebration),
+	// but we deliberately do NOT write an in-app notification doc for
+	// self-rings-closed — the Notifications feed should only surface
+	// friends' wins, not your own.
+	if user.PushToken != "" {
+		_ = xutils.SendNotification(xutils.Notification{
+			Token:   user.PushToken,
+			Title:   "All rings closed!",
+			Message: "You closed all your rings today. Nice work.",
+			Data:    map[string]string{"type": "rings_closed"},
+		})
+	}
+
+	message := fmt.Sprintf("%s closed all their rings today!", user.DisplayName)
+
+	// The ring day keys both the timeline entry and the notifications, so
+	// a backfill from the notifications files the same entry.
+	day := DayInTimezone(closedAt, user.Timezone)
+
+	// Group members who aren't friends hear about it through the group.
+	s.notifyGroupMembers(ctx, user, message, closedAt, day)
+
+	// Notify friends, except those the user hides their activity from
+	// and those who muted them.
+	if len(user.Friends) == 0 {
+		return
+	}
+
+	hidden := append([]primitive.ObjectID{}, user.HiddenFrom...)
+	cursor, err := s.users.Find(ctx, bson.M{
+		"_id":   bson.M{"$in": user.Friends, "$nin": hidden},
+		"muted": bson.M{"$ne": userID},
+	})
+	if err != nil {
+		slog.Error("rings closed notify: failed to fetch friends", "error", err, "user_id", userID)
+		return
+	}
+	defer cursor.Close(ctx)
+
+	s.timeline.FanOut(ctx, timeline.Event{
+		Kind:   timeline.KindRingsClosed,
+		Ref:    timeline.RingsClosedRef(userID, day),
+		Author: userID,
+		At:     closedAt,
+		Rings: &timeline.RingsClosed{
+			Content: message,
+			User: types.UserExtendedReferenceInternal{
+				ID:             userID,
+				DisplayName:    user.DisplayName,
+				Handle:         user.Handle,
+				ProfilePicture: user.ProfilePicture,
+			},
+		},
+	})
+
+	var friends []types.User
+	if err := cursor.All(ctx, &friends); err != nil {
+		slog.Error("rings closed notify: failed to decode friends", "error", err)
+		return
+	}
+
+	for _, friend := range friends {
+		s.createRingNotification(ctx, userID, friend.ID, user, message, closedAt, day)
 
-		// Push to the user themselves is fine (in-the-moment celebration),
-		// but we deliberately do NOT write an in-app notification doc for
-		// self-rings-closed — the Notifications feed should only surface
-		// friends' wins, not your own.
-		if user.PushToken != "" {
+		if friend.PushToken != "" {
 			_ = xutils.SendNotification(xutils.Notification{
-				Token:   user.PushToken,
-				Title:   "All rings closed!",
-				Message: "You closed all your rings today. Nice work.",
-				Data:    map[string]string{"type": "rings_closed"},
+				Token:   friend.PushToken,
+				Title:   "Rings closed",
+				Message: message,
+				Data: map[string]string{
+					"type":    "rings_closed",
+					"user_id": userID.Hex(),
+				},
 			})
 		}
+	}
 
-		// Notify friends
-		if len(user.Friends) == 0 {
-			return
-		}
+	slog.Info("rings closed notifications sent", "user_id", userID, "friends_notified", len(friends))
+}
 
-		cursor, err := s.users.Find(ctx, bson.M{"_id": bson.M{"$in": user.Friends}})
-		if err != nil {
-			slog.Error("rings closed notify: failed to fetch friends", "error", err, "user_id", userID)
-			return
-		}
-		defer cursor.Close(ctx)
+// notifyGroupMembers tells members of the user's groups who want group
+// activity, skipping friends since they get the regular notification.
+func (s *RingService) notifyGroupMembers(ctx context.Context, user types.User, message string, at, day time.Time) {
+	if s.audiences == nil {
+		return
+	}
+
+	subscribers, err := s.audiences.GroupActivitySubscribers(ctx, user.ID)
+	if err != nil {
+		slog.Error("rings closed notify: failed to load group subscribers", "error", err, "user_id", user.ID)
+		return
+	}
+	for _, friend := range user.Friends {
+		delete(subscribers, friend)
+	}
+	if len(subscribers) == 0 {
+		return
+	}
 
-		message := fmt.Sprintf("%s closed all their rings today!", user.DisplayName)
+	ids := make([]primitive.ObjectID, 0, len(subscribers))
+	for id := range subscribers {
+		ids = append(ids, id)
+	}
 
-		var friends []types.User
-		if err := cursor.All(ctx, &friends); err != nil {
-			slog.Error("rings closed notify: failed to decode friends", "error", err)
-			return
-		}
+	cursor, err := s.users.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		slog.Error("rings closed notify: failed to fetch group members", "error", err, "user_id", user.ID)
+		return
+	}
+	var members []types.User
+	if err := cursor.All(ctx, &members); err != nil {
+		slog.Error("rings closed notify: failed to decode group members", "error", err)
+		return
+	}
 
-		for _, friend := range friends {
-			s.createRingNotification(ctx, userID, friend.ID, user, message)
-
-			if friend.PushToken != "" {
-				_ = xutils.SendNotification(xutils.Notification{
-					Token:   friend.PushToken,
-					Title:   "Rings closed",
-					Message: message,
-					Data: map[string]string{
-						"type":    "rings_closed",
-						"user_id": userID.Hex(),
-					},
-				})
-			}
-		}
+	for _, member := range members {
+		s.createRingNotification(ctx, user.ID, member.ID, user, message, at, day)
 
-		slog.Info("rings closed notifications sent", "user_id", userID, "friends_notified", len(friends))
-	})
+		if member.PushToken != "" {
+			_ = xutils.SendNotification(xutils.Notification{
+				Token:   member.PushToken,
+				Title:   "Rings closed",
+				Message: message,
+				Data: map[string]string{
+					"type":    "rings_closed",
+					"user_id": user.ID.Hex(),
+				},
+			})
+		}
+	}
 }
 
-func (s *RingService) createRingNotification(ctx context.Context, senderID, receiverID primitive.ObjectID, sender types.User, content string) {
+func (s *RingService) createRingNotification(ctx context.Context, senderID, receiverID primitive.ObjectID, sender types.User, content string, at, day time.Time) {
 	if s.notifications == nil {
 		return
 	}
@@ -509,7 +619,8 @@ func (s *RingService) createRingNotification(ctx context.Context, senderID, rece
 		"receiver":         receiverID,
 		"content":          content,
 		"user":             userRef{ID: senderID, DisplayName: sender.DisplayName, Handle: sender.Handle, ProfilePicture: sender.ProfilePicture},
-		"time":             time.Now(),
+		"time":             at,
+		"ringDay":          day,
 		"notificationType": "RINGS_CLOSED",
 		"reference_id":     senderID,
 		"read":             false,
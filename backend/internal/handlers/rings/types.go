@@ -49,6 +49,7 @@ type RingState struct {
 	Do            RingProgress       `bson:"do" json:"do"`
 	Share         RingProgress       `bson:"share" json:"share"`
 	AllClosed     bool               `bson:"all_closed" json:"all_closed"`
+	AllClosedAt   *time.Time         `bson:"all_closed_at,omitempty" json:"all_closed_at,omitempty"` // when the last ring closed; drives group activity
 	RewardClaimed bool               `bson:"reward_claimed" json:"reward_claimed"`
 	RewardType    string             `bson:"reward_type,omitempty" json:"reward_type,omitempty"`
 	RewardAmount  int                `bson:"reward_amount,omitempty" json:"reward_amount,omitempty"`
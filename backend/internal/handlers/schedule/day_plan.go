@@ -0,0 +1,484 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/gemini"
+	"github.com/abhikaboy/Kindred/internal/handlers/rings"
+	"github.com/abhikaboy/Kindred/internal/handlers/task"
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	// maxDayPlanCandidates bounds the prompt; candidates are ranked first, so
+	// what is cut is what the greedy planner would have placed last anyway.
+	maxDayPlanCandidates = 30
+
+	// maxDayPlanItem caps how long the model may box a single task.
+	maxDayPlanItem = 4 * time.Hour
+)
+
+var (
+	ErrPlannerUnavailable = errors.New("AI day planning is not available")
+	ErrNoTimeLeft         = errors.New("no working hours left today")
+	ErrDayPlanNotFound    = errors.New("day plan not found")
+	ErrDayPlanClosed      = errors.New("day plan was already accepted or replaced")
+	ErrInvalidPlanEdit    = errors.New("invalid plan edit")
+)
+
+// GenerateDayPlan gathers today's context — open tasks, busy time, rings,
+// flex quotas and the user's rhythm — and asks the planner for an ordered,
+// time-boxed plan. The model's slots are checked against real free time
+// before the plan is stored. With PreviousID set the plan is a revision:
+// the previous plan and Feedback go into the prompt and the previous plan is
+// superseded.
+func (s *Service) GenerateDayPlan(ctx context.Context, userID primitive.ObjectID, opts DayPlanOptions) (*DayPlanDocument, error) {
+	if s.Planner == nil {
+		return nil, ErrPlannerUnavailable
+	}
+
+	var previous *DayPlanDocument
+	if opts.PreviousID != nil {
+		p, err := s.GetDayPlan(ctx, userID, *opts.PreviousID)
+		if err != nil {
+			return nil, err
+		}
+		previous = p
+	}
+
+	day, window, err := planningDay("", opts.Timezone, opts.DayStartHour, opts.DayEndHour)
+	if err != nil {
+		return nil, err
+	}
+	if !window.End.After(window.Start) {
+		return nil, ErrNoTimeLeft
+	}
+	dayEnd := day.AddDate(0, 0, 1)
+
+	busy, err := s.scheduledTaskBlocks(ctx, userID, day, dayEnd)
+	if err != nil {
+		return nil, fmt.Errorf("load scheduled tasks: %w", err)
+	}
+	calendarBusy, warnings := s.calendarBusyBlocks(ctx, userID, window)
+	busy = append(busy, calendarBusy...)
+
+	cands, err := s.unscheduledCandidates(ctx, userID, dayEnd, nil)
+	if err != nil {
+		return nil, fmt.Errorf("load unscheduled tasks: %w", err)
+	}
+	rankCandidates(cands, window.End)
+	if len(cands) > maxDayPlanCandidates {
+		cands = cands[:maxDayPlanCandidates]
+	}
+
+	input := gemini.PlanMyDayInput{
+		UserID:      userID.Hex(),
+		Timezone:    opts.Timezone,
+		Date:        day.Format("2006-01-02"),
+		WindowStart: window.Start.Format(time.RFC3339),
+		WindowEnd:   window.End.Format(time.RFC3339),
+		Tasks:       make([]gemini.PlanMyDayTask, 0, len(cands)),
+		Busy:        busyForPrompt(busy, window),
+		FlexQuotas:  s.flexQuotas(ctx, userID, day.Location()),
+		Feedback:    opts.Feedback,
+	}
+	for _, c := range cands {
+		t := gemini.PlanMyDayTask{
+			ID:               c.Task.ID.Hex(),
+			Content:          c.Task.Content,
+			Priority:         c.Task.Priority,
+			Value:            c.Task.Value,
+			EstimatedMinutes: int(c.Duration.Minutes()),
+			Flex:             c.Task.FlexInfo != nil,
+		}
+		if c.Task.Deadline != nil {
+			t.Deadline = c.Task.Deadline.In(day.Location()).Format(time.RFC3339)
+		}
+		input.Tasks = append(input.Tasks, t)
+	}
+	if s.Tasks != nil && s.Tasks.RingService != nil {
+		if state, err := s.Tasks.RingService.GetOrCreateToday(ctx, userID, opts.Timezone); err == nil {
+			input.Rings = gemini.PlanMyDayRings{
+				PlanCurrent: state.Plan.Current,
+				PlanTarget:  state.Plan.Target,
+				DoCurrent:   state.Do.Current,
+				DoTarget:    state.Do.Target,
+			}
+		} else {
+			slog.Warn("Day plan: failed to load rings", "userId", userID.Hex(), "error", err)
+		}
+	}
+	s.addRhythm(ctx, userID, day, &input)
+	if previous != nil {
+		for _, item := range previous.Items {
+			input.PreviousPlan = append(input.PreviousPlan, gemini.PlanMyDayItemOutput{
+				TaskID:    item.TaskID,
+				Start:     item.Start.Format(time.RFC3339),
+				End:       item.End.Format(time.RFC3339),
+				Rationale: item.Rationale,
+			})
+		}
+	}
+
+	out, err := s.Planner.PlanMyDay(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("plan my day: %w", err)
+	}
+
+	items, deferred, fitWarnings := fitDayPlan(out, cands, window, busy)
+	plan := &DayPlanDocument{
+		ID:          primitive.NewObjectID(),
+		UserID:      userID,
+		Date:        input.Date,
+		Timezone:    opts.Timezone,
+		Status:      DayPlanProposed,
+		Summary:     out.Summary,
+		Items:       items,
+		Deferred:    deferred,
+		WindowStart: window.Start,
+		WindowEnd:   window.End,
+		Warnings:    append(warnings, fitWarnings...),
+		Feedback:    opts.Feedback,
+		PreviousID:  opts.PreviousID,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if _, err := s.DayPlans.InsertOne(ctx, plan); err != nil {
+		return nil, fmt.Errorf("save day plan: %w", err)
+	}
+	if previous != nil && previous.Status == DayPlanProposed {
+		if _, err := s.DayPlans.UpdateOne(ctx,
+			bson.M{"_id": previous.ID, "status": DayPlanProposed},
+			bson.M{"$set": bson.M{"status": DayPlanSuperseded}},
+		); err != nil {
+			slog.Warn("Day plan: failed to supersede previous plan", "planId", previous.ID.Hex(), "error", err)
+		}
+	}
+	return plan, nil
+}
+
+// GetDayPlan returns one of the user's plans, or ErrDayPlanNotFound.
+func (s *Service) GetDayPlan(ctx context.Context, userID, planID primitive.ObjectID) (*DayPlanDocument, error) {
+	var plan DayPlanDocument
+	err := s.DayPlans.FindOne(ctx, bson.M{"_id": planID, "user_id": userID}).Decode(&plan)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrDayPlanNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// AcceptDayPlan writes the plan's start times through Apply and marks it
+// accepted. edits, when non-nil, replace the plan's items; they may only name
+// tasks the plan proposed or deferred. The first plan accepted on a day
+// counts toward the Plan ring; the returned delta is nil otherwise.
+func (s *Service) AcceptDayPlan(ctx context.Context, userID, planID primitive.ObjectID, edits []DayPlanItemEdit) (*DayPlanDocument, int, []string, *rings.RingDelta, error) {
+	plan, err := s.GetDayPlan(ctx, userID, planID)
+	if err != nil {
+		return nil, 0, nil, nil, err
+	}
+	if plan.Status != DayPlanProposed {
+		return nil, 0, nil, nil, ErrDayPlanClosed
+	}
+	if edits != nil {
+		items, err := applyPlanEdits(plan, edits)
+		if err != nil {
+			return nil, 0, nil, nil, err
+		}
+		plan.Items = items
+	}
+
+	now := time.Now().UTC()
+	res, err := s.DayPlans.UpdateOne(ctx,
+		bson.M{"_id": planID, "user_id": userID, "status": DayPlanProposed},
+		bson.M{"$set": bson.M{"status": DayPlanAccepted, "accepted_at": now, "items": plan.Items}},
+	)
+	if err != nil {
+		return nil, 0, nil, nil, fmt.Errorf("accept day plan: %w", err)
+	}
+	if res.ModifiedCount == 0 {
+		return nil, 0, nil, nil, ErrDayPlanClosed
+	}
+	plan.Status = DayPlanAccepted
+	plan.AcceptedAt = &now
+
+	proposals := make([]Proposal, 0, len(plan.Items))
+	for _, item := range plan.Items {
+		proposals = append(proposals, Proposal{
+			TaskID:     item.TaskID,
+			CategoryID: item.CategoryID,
+			Content:    item.Content,
+			Priority:   item.Priority,
+			Start:      item.Start,
+			End:        item.End,
+			Reason:     item.Rationale,
+		})
+	}
+	applied, failed := s.Apply(ctx, userID, proposals)
+
+	return plan, applied, failed, s.creditPlanRing(ctx, userID, plan), nil
+}
+
+// creditPlanRing increments the Plan ring if this is the first plan accepted
+// for today, so regenerating and re-accepting can't farm it.
+func (s *Service) creditPlanRing(ctx context.Context, userID primitive.ObjectID, plan *DayPlanDocument) *rings.RingDelta {
+	if s.Tasks == nil || s.Tasks.RingService == nil {
+		return nil
+	}
+	if rings.TodayInTimezone(plan.Timezone).Format("2006-01-02") != plan.Date {
+		return nil
+	}
+	accepted, err := s.DayPlans.CountDocuments(ctx, bson.M{"user_id": userID, "date": plan.Date, "status": DayPlanAccepted})
+	if err != nil || accepted != 1 {
+		return nil
+	}
+	_, delta, err := s.Tasks.RingService.IncrementRing(ctx, userID, plan.Timezone, rings.RingPlan)
+	if err != nil {
+		slog.Error("Failed to increment Plan ring on day plan accept", "user_id", userID.Hex(), "error", err)
+		return nil
+	}
+	if delta.JustClosedAll {
+		s.Tasks.RingService.NotifyAllRingsClosed(userID)
+	}
+	return delta
+}
+
+// applyPlanEdits validates the client's tweaked plan against the tasks the
+// plan knew about and returns it as plan items, in start order.
+func applyPlanEdits(plan *DayPlanDocument, edits []DayPlanItemEdit) ([]DayPlanItem, error) {
+	known := make(map[string]DayPlanItem, len(plan.Items)+len(plan.Deferred))
+	for _, item := range plan.Items {
+		known[item.TaskID] = item
+	}
+	for _, d := range plan.Deferred {
+		known[d.TaskID] = DayPlanItem{TaskID: d.TaskID, CategoryID: d.CategoryID, Content: d.Content}
+	}
+
+	items := make([]DayPlanItem, 0, len(edits))
+	seen := make(map[string]bool, len(edits))
+	for _, e := range edits {
+		item, ok := known[e.TaskID]
+		if !ok {
+			return nil, fmt.Errorf("%w: task %s is not part of this plan", ErrInvalidPlanEdit, e.TaskID)
+		}
+		if seen[e.TaskID] {
+			return nil, fmt.Errorf("%w: task %s appears twice", ErrInvalidPlanEdit, e.TaskID)
+		}
+		if !e.End.After(e.Start) {
+			return nil, fmt.Errorf("%w: task %s must end after it starts", ErrInvalidPlanEdit, e.TaskID)
+		}
+		seen[e.TaskID] = true
+		item.Start, item.End = e.Start, e.End
+		items = append(items, item)
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Start.Before(items[j].Start) })
+	return items, nil
+}
+
+// fitDayPlan checks the model's plan against real free time. Items for
+// unknown or repeated tasks are dropped; an item whose slot is unreadable,
+// outside the window or overlapping busy time is moved to the earliest free
+// slot that fits, and deferred if none does.
+func fitDayPlan(out gemini.PlanMyDayOutput, cands []candidate, window interval, busy []interval) ([]DayPlanItem, []DayPlanDeferred, []string) {
+	byID := make(map[string]candidate, len(cands))
+	for _, c := range cands {
+		byID[c.Task.ID.Hex()] = c
+	}
+	busy = append([]interval(nil), busy...)
+
+	items := []DayPlanItem{}
+	deferred := []DayPlanDeferred{}
+	var warnings []string
+	planned := map[string]bool{}
+	dropped := 0
+
+	for _, o := range out.Items {
+		c, ok := byID[o.TaskID]
+		if !ok || planned[o.TaskID] {
+			dropped++
+			continue
+		}
+		planned[o.TaskID] = true
+
+		start, startErr := time.Parse(time.RFC3339, o.Start)
+		end, endErr := time.Parse(time.RFC3339, o.End)
+		dur := c.Duration
+		if startErr == nil && endErr == nil && end.After(start) {
+			dur = end.Sub(start)
+		}
+		if dur < slotGranularity {
+			dur = slotGranularity
+		}
+		if dur > maxDayPlanItem {
+			dur = maxDayPlanItem
+		}
+
+		free := freeIntervals(window, busy)
+		adjusted := startErr != nil || !fits(free, start, start.Add(dur))
+		if adjusted {
+			var found bool
+			start, found = findSlot(free, dur, nil, nil)
+			if !found {
+				deferred = append(deferred, DayPlanDeferred{
+					TaskID:     o.TaskID,
+					CategoryID: c.Task.CategoryID.Hex(),
+					Content:    c.Task.Content,
+					Reason:     "no free time left today",
+				})
+				continue
+			}
+		}
+		start = start.In(window.Start.Location())
+
+		items = append(items, DayPlanItem{
+			TaskID:     o.TaskID,
+			CategoryID: c.Task.CategoryID.Hex(),
+			Content:    c.Task.Content,
+			Priority:   c.Task.Priority,
+			Start:      start,
+			End:        start.Add(dur),
+			Rationale:  o.Rationale,
+			Adjusted:   adjusted,
+		})
+		busy = append(busy, interval{Start: start, End: start.Add(dur)})
+	}
+
+	for _, d := range out.Deferred {
+		c, ok := byID[d.TaskID]
+		if !ok || planned[d.TaskID] {
+			continue
+		}
+		planned[d.TaskID] = true
+		deferred = append(deferred, DayPlanDeferred{
+			TaskID:     d.TaskID,
+			CategoryID: c.Task.CategoryID.Hex(),
+			Content:    c.Task.Content,
+			Reason:     d.Reason,
+		})
+	}
+
+	if dropped > 0 {
+		warnings = append(warnings, fmt.Sprintf("ignored %d suggested items for unknown or repeated tasks", dropped))
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Start.Before(items[j].Start) })
+	return items, deferred, warnings
+}
+
+// fits reports whether [start, end) lies inside a single free interval.
+func fits(free []interval, start, end time.Time) bool {
+	for _, f := range free {
+		if !start.Before(f.Start) && !end.After(f.End) {
+			return true
+		}
+	}
+	return false
+}
+
+// busyForPrompt lists the busy intervals overlapping the window, in order,
+// without the duplicates a calendar-imported task and its source event make.
+func busyForPrompt(busy []interval, window interval) []gemini.PlanMyDayBusy {
+	sorted := append([]interval(nil), busy...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+
+	out := []gemini.PlanMyDayBusy{}
+	seen := map[string]bool{}
+	for _, b := range sorted {
+		if !b.End.After(window.Start) || !b.Start.Before(window.End) {
+			continue
+		}
+		start := b.Start.In(window.Start.Location()).Format(time.RFC3339)
+		end := b.End.In(window.Start.Location()).Format(time.RFC3339)
+		key := start + end + b.Label
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		title := b.Label
+		if title == "" {
+			title = "Busy"
+		}
+		out = append(out, gemini.PlanMyDayBusy{Title: title, Start: start, End: end})
+	}
+	return out
+}
+
+// flexQuotas lists the user's flex habits that still owe completions in the
+// current period. A period that has rolled over since the template was last
+// touched counts from zero, as it will once the next completion lands.
+func (s *Service) flexQuotas(ctx context.Context, userID primitive.ObjectID, loc *time.Location) []gemini.PlanMyDayFlexQuota {
+	if s.Tasks == nil || s.Tasks.TemplateTasks == nil {
+		return nil
+	}
+	cursor, err := s.Tasks.TemplateTasks.Find(ctx, bson.M{"userID": userID, "flexState": bson.M{"$ne": nil}})
+	if err != nil {
+		slog.Warn("Day plan: failed to load flex templates", "userId", userID.Hex(), "error", err)
+		return nil
+	}
+	defer cursor.Close(ctx)
+
+	var templates []types.TemplateTaskDocument
+	if err := cursor.All(ctx, &templates); err != nil {
+		slog.Warn("Day plan: failed to decode flex templates", "userId", userID.Hex(), "error", err)
+		return nil
+	}
+
+	now := time.Now()
+	quotas := []gemini.PlanMyDayFlexQuota{}
+	for _, t := range templates {
+		state := t.FlexState
+		if state == nil || state.Target <= 0 {
+			continue
+		}
+		completed := state.CompletedInPeriod
+		if strategy, err := task.FlexPeriodFor(state.Period); err == nil && state.PeriodStart != nil &&
+			state.PeriodStart.Before(strategy.PeriodStart(now, loc)) {
+			completed = 0
+		}
+		if remaining := state.Target - completed; remaining > 0 {
+			quotas = append(quotas, gemini.PlanMyDayFlexQuota{
+				Content:   t.Content,
+				Period:    state.Period,
+				Target:    state.Target,
+				Remaining: remaining,
+			})
+		}
+	}
+	return quotas
+}
+
+// addRhythm adds the `peak-hours` and `nudge-receptivity` facts to the input:
+// the peak window as times, the receptivity verdict as a lighter plan, and
+// both facts' prose as profile. Missing facts leave the input unchanged.
+func (s *Service) addRhythm(ctx context.Context, userID primitive.ObjectID, day time.Time, input *gemini.PlanMyDayInput) {
+	peakFact, err := gemini.LoadUserFact(ctx, s.UserMemory, userID, gemini.FactKeyPeakHours)
+	if err != nil {
+		slog.Warn("Day plan: failed to load peak-hours fact", "userId", userID.Hex(), "error", err)
+	}
+	if peak := peakInterval(peakFact, day); peak != nil {
+		input.PeakStart = peak.Start.Format(time.RFC3339)
+		input.PeakEnd = peak.End.Format(time.RFC3339)
+	}
+
+	nudgeFact, err := gemini.LoadUserFact(ctx, s.UserMemory, userID, gemini.FactKeyNudgeReceptivity)
+	if err != nil {
+		slog.Warn("Day plan: failed to load nudge-receptivity fact", "userId", userID.Hex(), "error", err)
+	}
+	if r := gemini.DecodeNudgeReceptivity(nudgeFact); r != nil {
+		input.ReduceLoad = r.ShouldReduceFrequency
+	}
+
+	for _, f := range []*gemini.UserFact{peakFact, nudgeFact} {
+		if f != nil && f.Content != "" {
+			input.Profile = append(input.Profile, f.Content)
+		}
+	}
+}
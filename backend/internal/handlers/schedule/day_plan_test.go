@@ -0,0 +1,121 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/gemini"
+)
+
+func rfc(t time.Time) string { return t.Format(time.RFC3339) }
+
+func TestFitDayPlan(t *testing.T) {
+	window := interval{Start: at(9, 0), End: at(12, 0)}
+	busy := []interval{{Start: at(10, 0), End: at(11, 0)}}
+	report := newCandidate(3, 5, nil, time.Hour)
+	email := newCandidate(1, 1, nil, 30*time.Minute)
+	gym := newCandidate(2, 3, nil, time.Hour)
+	reading := newCandidate(1, 1, nil, 30*time.Minute)
+	cands := []candidate{report, email, gym, reading}
+
+	out := gemini.PlanMyDayOutput{
+		Items: []gemini.PlanMyDayItemOutput{
+			// Fits as suggested.
+			{TaskID: report.Task.ID.Hex(), Start: rfc(at(9, 0)), End: rfc(at(10, 0)), Rationale: "peak focus"},
+			// Overlaps the meeting: moved to the next free slot.
+			{TaskID: email.Task.ID.Hex(), Start: rfc(at(10, 30)), End: rfc(at(11, 0))},
+			// Repeated and unknown tasks are dropped.
+			{TaskID: report.Task.ID.Hex(), Start: rfc(at(11, 0)), End: rfc(at(12, 0))},
+			{TaskID: "not-a-task", Start: rfc(at(11, 0)), End: rfc(at(12, 0))},
+			// Nothing an hour long is left.
+			{TaskID: gym.Task.ID.Hex(), Start: "after lunch", End: ""},
+		},
+		Deferred: []gemini.PlanMyDayDeferredOutput{
+			{TaskID: reading.Task.ID.Hex(), Reason: "low priority"},
+			{TaskID: report.Task.ID.Hex(), Reason: "already planned"},
+		},
+	}
+
+	items, deferred, warnings := fitDayPlan(out, cands, window, busy)
+
+	if len(items) != 2 {
+		t.Fatalf("got %d items, want 2: %+v", len(items), items)
+	}
+	if items[0].TaskID != report.Task.ID.Hex() || !items[0].Start.Equal(at(9, 0)) || items[0].Adjusted {
+		t.Errorf("items[0] = %+v, want the report unadjusted at 09:00", items[0])
+	}
+	if items[0].Rationale != "peak focus" {
+		t.Errorf("items[0].Rationale = %q, want the model's rationale", items[0].Rationale)
+	}
+	if items[1].TaskID != email.Task.ID.Hex() || !items[1].Start.Equal(at(11, 0)) || !items[1].End.Equal(at(11, 30)) || !items[1].Adjusted {
+		t.Errorf("items[1] = %+v, want the email moved to 11:00-11:30", items[1])
+	}
+
+	if len(deferred) != 2 {
+		t.Fatalf("got %d deferred, want 2: %+v", len(deferred), deferred)
+	}
+	if deferred[0].TaskID != gym.Task.ID.Hex() || deferred[0].Reason != "no free time left today" {
+		t.Errorf("deferred[0] = %+v, want the gym task with no time left", deferred[0])
+	}
+	if deferred[1].TaskID != reading.Task.ID.Hex() || deferred[1].Reason != "low priority" {
+		t.Errorf("deferred[1] = %+v, want the reading task with the model's reason", deferred[1])
+	}
+	if len(warnings) != 1 {
+		t.Errorf("got warnings %v, want one for the dropped items", warnings)
+	}
+}
+
+func TestFitDayPlanClampsDuration(t *testing.T) {
+	window := interval{Start: at(8, 0), End: at(18, 0)}
+	long := newCandidate(2, 2, nil, 30*time.Minute)
+	short := newCandidate(2, 2, nil, 30*time.Minute)
+
+	out := gemini.PlanMyDayOutput{Items: []gemini.PlanMyDayItemOutput{
+		{TaskID: long.Task.ID.Hex(), Start: rfc(at(8, 0)), End: rfc(at(16, 0))},
+		{TaskID: short.Task.ID.Hex(), Start: rfc(at(16, 0)), End: rfc(at(16, 1))},
+	}}
+
+	items, _, _ := fitDayPlan(out, []candidate{long, short}, window, nil)
+	if len(items) != 2 {
+		t.Fatalf("got %d items, want 2", len(items))
+	}
+	if got := items[0].End.Sub(items[0].Start); got != maxDayPlanItem {
+		t.Errorf("long item lasts %v, want %v", got, maxDayPlanItem)
+	}
+	if got := items[1].End.Sub(items[1].Start); got != slotGranularity {
+		t.Errorf("short item lasts %v, want %v", got, slotGranularity)
+	}
+}
+
+func TestApplyPlanEdits(t *testing.T) {
+	plan := &DayPlanDocument{
+		Items:    []DayPlanItem{{TaskID: "a", Content: "Report", Start: at(9, 0), End: at(10, 0), Rationale: "peak focus"}},
+		Deferred: []DayPlanDeferred{{TaskID: "b", Content: "Email"}},
+	}
+
+	items, err := applyPlanEdits(plan, []DayPlanItemEdit{
+		{TaskID: "a", Start: at(14, 0), End: at(15, 0)},
+		{TaskID: "b", Start: at(9, 0), End: at(9, 30)},
+	})
+	if err != nil {
+		t.Fatalf("applyPlanEdits: %v", err)
+	}
+	if len(items) != 2 || items[0].TaskID != "b" || items[1].TaskID != "a" {
+		t.Fatalf("items = %+v, want the deferred task first, in start order", items)
+	}
+	if items[1].Content != "Report" || items[1].Rationale != "peak focus" || !items[1].Start.Equal(at(14, 0)) {
+		t.Errorf("items[1] = %+v, want the report moved to 14:00 with its details kept", items[1])
+	}
+
+	bad := [][]DayPlanItemEdit{
+		{{TaskID: "c", Start: at(9, 0), End: at(10, 0)}},
+		{{TaskID: "a", Start: at(9, 0), End: at(10, 0)}, {TaskID: "a", Start: at(11, 0), End: at(12, 0)}},
+		{{TaskID: "a", Start: at(10, 0), End: at(10, 0)}},
+	}
+	for i, edits := range bad {
+		if _, err := applyPlanEdits(plan, edits); !errors.Is(err, ErrInvalidPlanEdit) {
+			t.Errorf("case %d: err = %v, want ErrInvalidPlanEdit", i, err)
+		}
+	}
+}
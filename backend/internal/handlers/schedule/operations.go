@@ -0,0 +1,51 @@
+package schedule
+
+import (
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+func RegisterPreviewAutoScheduleOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "preview-auto-schedule",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/schedule/auto/preview",
+		Summary:     "Preview auto-scheduled start times",
+		Description: "Dry run: proposes start times for unscheduled tasks around calendar events and already-timed tasks, ordered by priority, deadline and estimated duration, preferring the user's peak hours for demanding tasks. Nothing is written.",
+		Tags:        []string{"schedule"},
+	}, handler.PreviewAutoSchedule)
+}
+
+func RegisterApplyAutoScheduleOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "apply-auto-schedule",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/schedule/auto",
+		Summary:     "Auto-schedule unscheduled tasks",
+		Description: "Computes the same plan as the preview endpoint and writes each proposed start time. Tasks in push-enabled calendar categories are mirrored to the calendar through the push outbox.",
+		Tags:        []string{"schedule"},
+	}, handler.ApplyAutoSchedule)
+}
+
+func RegisterGenerateDayPlanOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "generate-day-plan",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/schedule/plan-my-day",
+		Summary:     "Generate an AI plan for today",
+		Description: "Builds an ordered, time-boxed plan for today with a rationale per task, from open tasks, calendar events, ring targets, flex habit quotas and the user's peak hours. Pass previousPlanId and feedback to regenerate a tweaked plan. Nothing is scheduled until the plan is accepted. Costs one natural language credit.",
+		Tags:        []string{"schedule", "ai"},
+	}, handler.GenerateDayPlan)
+}
+
+func RegisterAcceptDayPlanOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "accept-day-plan",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/schedule/plan-my-day/{planId}/accept",
+		Summary:     "Accept an AI day plan",
+		Description: "Writes each planned start time, optionally from the user's edited version of the plan. The first plan accepted each day counts toward the Plan ring.",
+		Tags:        []string{"schedule", "ai"},
+	}, handler.AcceptDayPlan)
+}
@@ -0,0 +1,227 @@
+package schedule
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+)
+
+// slotGranularity is what proposed start times are rounded up to. Busy blocks
+// from a calendar end on arbitrary minutes; a proposal of 10:07 reads as a bug.
+const slotGranularity = 5 * time.Minute
+
+// interval is a half-open [Start, End) span of wall-clock time. Label names
+// what occupies a busy interval, for the AI day planner; the greedy planner
+// ignores it.
+type interval struct {
+	Start time.Time
+	End   time.Time
+	Label string
+}
+
+// candidate is an unscheduled task plus the duration we plan to give it.
+type candidate struct {
+	Task     types.TaskDocument
+	Duration time.Duration
+}
+
+// placement is a candidate the planner fit into the day.
+type placement struct {
+	Task   types.TaskDocument
+	Start  time.Time
+	End    time.Time
+	Reason string
+}
+
+// estimateDuration guesses how long a task takes. Tasks carry no duration of
+// their own, so this leans on the two signals every task has: its difficulty
+// value (0–10) and the amount of checklist work still open.
+func estimateDuration(task types.TaskDocument) time.Duration {
+	var d time.Duration
+	switch {
+	case task.Value <= 3:
+		d = 15 * time.Minute
+	case task.Value <= 6:
+		d = 30 * time.Minute
+	case task.Value <= 8:
+		d = 45 * time.Minute
+	default:
+		d = 60 * time.Minute
+	}
+	for _, item := range task.Checklist {
+		if !item.Completed {
+			d += 5 * time.Minute
+		}
+	}
+	if d > 2*time.Hour {
+		d = 2 * time.Hour
+	}
+	return d
+}
+
+// isDemanding marks tasks worth spending peak hours on.
+func isDemanding(task types.TaskDocument) bool {
+	return task.Priority >= 3 || task.Value >= 7
+}
+
+// rankCandidates orders candidates most-urgent first: anything due by the end
+// of the planned day, then higher priority, then nearer deadline, then harder
+// tasks (so they land earlier, while there is still room), then oldest.
+func rankCandidates(cands []candidate, dayEnd time.Time) {
+	dueToday := func(t types.TaskDocument) bool {
+		return t.Deadline != nil && !t.Deadline.After(dayEnd)
+	}
+	sort.SliceStable(cands, func(i, j int) bool {
+		a, b := cands[i].Task, cands[j].Task
+		if dueToday(a) != dueToday(b) {
+			return dueToday(a)
+		}
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		switch {
+		case a.Deadline != nil && b.Deadline != nil && !a.Deadline.Equal(*b.Deadline):
+			return a.Deadline.Before(*b.Deadline)
+		case a.Deadline != nil && b.Deadline == nil:
+			return true
+		case a.Deadline == nil && b.Deadline != nil:
+			return false
+		}
+		if a.Value != b.Value {
+			return a.Value > b.Value
+		}
+		return a.Timestamp.Before(b.Timestamp)
+	})
+}
+
+// freeIntervals subtracts busy blocks from the working window and returns
+// what is left, in order. Busy blocks may overlap and may extend past the
+// window on either side.
+func freeIntervals(window interval, busy []interval) []interval {
+	sorted := make([]interval, 0, len(busy))
+	for _, b := range busy {
+		if b.End.After(window.Start) && b.Start.Before(window.End) {
+			sorted = append(sorted, b)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+
+	free := []interval{}
+	cursor := window.Start
+	for _, b := range sorted {
+		if b.Start.After(cursor) {
+			free = append(free, interval{Start: cursor, End: b.Start})
+		}
+		if b.End.After(cursor) {
+			cursor = b.End
+		}
+	}
+	if window.End.After(cursor) {
+		free = append(free, interval{Start: cursor, End: window.End})
+	}
+	return free
+}
+
+// roundUp rounds t up to the next multiple of d past the hour.
+func roundUp(t time.Time, d time.Duration) time.Time {
+	r := t.Truncate(d)
+	if r.Before(t) {
+		r = r.Add(d)
+	}
+	return r
+}
+
+// findSlot returns the earliest start in `free` (optionally restricted to
+// `within`) that fits `dur` and, when `deadline` is set, ends by it.
+func findSlot(free []interval, dur time.Duration, within *interval, deadline *time.Time) (time.Time, bool) {
+	for _, f := range free {
+		start, end := f.Start, f.End
+		if within != nil {
+			if within.Start.After(start) {
+				start = within.Start
+			}
+			if within.End.Before(end) {
+				end = within.End
+			}
+		}
+		start = roundUp(start, slotGranularity)
+		if start.Add(dur).After(end) {
+			continue
+		}
+		if deadline != nil && start.Add(dur).After(*deadline) {
+			continue
+		}
+		return start, true
+	}
+	return time.Time{}, false
+}
+
+// planDay greedily places ranked candidates into the free time of the window.
+// Demanding tasks try the peak-hours window first; every task tries to finish
+// before its deadline first and falls back to the earliest fit otherwise.
+// Candidates that fit nowhere are returned as unplaced, in rank order.
+func planDay(cands []candidate, window interval, busy []interval, peak *interval) ([]placement, []candidate) {
+	rankCandidates(cands, window.End)
+	busy = append([]interval(nil), busy...)
+	free := freeIntervals(window, busy)
+
+	placed := []placement{}
+	unplaced := []candidate{}
+	for _, c := range cands {
+		if len(free) == 0 {
+			unplaced = append(unplaced, c)
+			continue
+		}
+
+		var (
+			start   time.Time
+			ok      bool
+			inPeak  bool
+			late    bool
+			reasons []string
+		)
+		if peak != nil && isDemanding(c.Task) {
+			start, ok = findSlot(free, c.Duration, peak, c.Task.Deadline)
+			inPeak = ok
+		}
+		if !ok {
+			start, ok = findSlot(free, c.Duration, nil, c.Task.Deadline)
+		}
+		if !ok && c.Task.Deadline != nil {
+			start, ok = findSlot(free, c.Duration, nil, nil)
+			late = ok
+		}
+		if !ok {
+			unplaced = append(unplaced, c)
+			continue
+		}
+
+		if c.Task.Priority >= 3 {
+			reasons = append(reasons, "high priority")
+		}
+		if c.Task.Deadline != nil && !c.Task.Deadline.After(window.End) {
+			reasons = append(reasons, "due today")
+		}
+		if inPeak {
+			reasons = append(reasons, "during your peak hours")
+		}
+		if late {
+			reasons = append(reasons, "no free time before its deadline")
+		}
+		if len(reasons) == 0 {
+			reasons = append(reasons, "next free slot")
+		}
+
+		placed = append(placed, placement{
+			Task:   c.Task,
+			Start:  start,
+			End:    start.Add(c.Duration),
+			Reason: strings.Join(reasons, ", "),
+		})
+		busy = append(busy, interval{Start: start, End: start.Add(c.Duration)})
+		free = freeIntervals(window, busy)
+	}
+	return placed, unplaced
+}
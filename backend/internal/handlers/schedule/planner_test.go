@@ -0,0 +1,131 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var testDay = time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+func at(hour, min int) time.Time {
+	return testDay.Add(time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute)
+}
+
+func ptrTime(t time.Time) *time.Time { return &t }
+
+func newCandidate(priority int, value float64, deadline *time.Time, dur time.Duration) candidate {
+	return candidate{
+		Task: types.TaskDocument{
+			ID:       primitive.NewObjectID(),
+			Priority: priority,
+			Value:    value,
+			Deadline: deadline,
+		},
+		Duration: dur,
+	}
+}
+
+func TestFreeIntervals(t *testing.T) {
+	window := interval{Start: at(9, 0), End: at(18, 0)}
+	busy := []interval{
+		{Start: at(8, 0), End: at(9, 30)},    // starts before the window
+		{Start: at(12, 0), End: at(13, 0)},   // lunch
+		{Start: at(12, 30), End: at(13, 30)}, // overlaps lunch
+		{Start: at(17, 30), End: at(19, 0)},  // runs past the window
+	}
+
+	got := freeIntervals(window, busy)
+	want := []interval{
+		{Start: at(9, 30), End: at(12, 0)},
+		{Start: at(13, 30), End: at(17, 30)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d free intervals, want %d: %v", len(got), len(want), got)
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) {
+			t.Errorf("interval %d = [%v, %v), want [%v, %v)", i, got[i].Start, got[i].End, want[i].Start, want[i].End)
+		}
+	}
+}
+
+func TestRankCandidates(t *testing.T) {
+	dayEnd := at(18, 0)
+	low := newCandidate(1, 2, nil, 15*time.Minute)
+	high := newCandidate(3, 2, nil, 15*time.Minute)
+	dueToday := newCandidate(1, 2, ptrTime(at(17, 0)), 15*time.Minute)
+	dueLater := newCandidate(1, 2, ptrTime(testDay.AddDate(0, 0, 3)), 15*time.Minute)
+
+	cands := []candidate{low, dueLater, high, dueToday}
+	rankCandidates(cands, dayEnd)
+
+	want := []primitive.ObjectID{dueToday.Task.ID, high.Task.ID, dueLater.Task.ID, low.Task.ID}
+	for i, id := range want {
+		if cands[i].Task.ID != id {
+			t.Errorf("position %d: got priority=%d deadline=%v", i, cands[i].Task.Priority, cands[i].Task.Deadline)
+		}
+	}
+}
+
+func TestEstimateDuration(t *testing.T) {
+	task := types.TaskDocument{Value: 5}
+	if got := estimateDuration(task); got != 30*time.Minute {
+		t.Errorf("value 5: got %v, want 30m", got)
+	}
+	task.Checklist = []types.ChecklistItem{{Completed: false}, {Completed: true}, {Completed: false}}
+	if got := estimateDuration(task); got != 40*time.Minute {
+		t.Errorf("value 5 with two open items: got %v, want 40m", got)
+	}
+}
+
+func TestPlanDay_PrefersPeakHoursForDemandingTasks(t *testing.T) {
+	window := interval{Start: at(9, 0), End: at(18, 0)}
+	peak := &interval{Start: at(14, 0), End: at(16, 0)}
+	demanding := newCandidate(3, 8, nil, time.Hour)
+	light := newCandidate(1, 2, nil, 15*time.Minute)
+
+	placed, unplaced := planDay([]candidate{light, demanding}, window, nil, peak)
+	if len(unplaced) != 0 || len(placed) != 2 {
+		t.Fatalf("placed=%d unplaced=%d, want 2/0", len(placed), len(unplaced))
+	}
+	if placed[0].Task.ID != demanding.Task.ID || !placed[0].Start.Equal(at(14, 0)) {
+		t.Errorf("demanding task placed at %v, want 14:00", placed[0].Start)
+	}
+	if placed[1].Task.ID != light.Task.ID || !placed[1].Start.Equal(at(9, 0)) {
+		t.Errorf("light task placed at %v, want 09:00", placed[1].Start)
+	}
+}
+
+func TestPlanDay_RoundsAroundBusyBlocksAndFallsBackPastDeadline(t *testing.T) {
+	window := interval{Start: at(9, 0), End: at(12, 0)}
+	busy := []interval{{Start: at(9, 0), End: at(10, 7)}}
+	c := newCandidate(2, 5, ptrTime(at(10, 0)), 30*time.Minute)
+
+	placed, _ := planDay([]candidate{c}, window, busy, nil)
+	if len(placed) != 1 {
+		t.Fatalf("expected the task to be placed late rather than dropped")
+	}
+	if !placed[0].Start.Equal(at(10, 10)) {
+		t.Errorf("start = %v, want 10:10", placed[0].Start)
+	}
+	if placed[0].Reason != "due today, no free time before its deadline" {
+		t.Errorf("reason = %q", placed[0].Reason)
+	}
+}
+
+func TestPlanDay_ReturnsUnplacedWhenDayIsFull(t *testing.T) {
+	window := interval{Start: at(9, 0), End: at(10, 0)}
+	first := newCandidate(3, 5, nil, 45*time.Minute)
+	second := newCandidate(1, 5, nil, 30*time.Minute)
+
+	placed, unplaced := planDay([]candidate{second, first}, window, nil, nil)
+	if len(placed) != 1 || placed[0].Task.ID != first.Task.ID {
+		t.Fatalf("expected only the high-priority task to be placed, got %d", len(placed))
+	}
+	if len(unplaced) != 1 || unplaced[0].Task.ID != second.Task.ID {
+		t.Errorf("expected the low-priority task to be unplaced")
+	}
+}
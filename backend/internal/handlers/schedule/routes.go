@@ -0,0 +1,21 @@
+package schedule
+
+import (
+	"github.com/abhikaboy/Kindred/internal/gemini"
+	"github.com/abhikaboy/Kindred/internal/handlers/calendar"
+	"github.com/abhikaboy/Kindred/internal/handlers/task"
+	"github.com/danielgtaylor/huma/v2"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Routes registers the auto-scheduling and AI day plan endpoints.
+// calendarService and planner may be nil.
+func Routes(api huma.API, collections map[string]*mongo.Collection, taskService *task.Service, calendarService *calendar.Service, planner gemini.Backend) {
+	service := newService(collections, taskService, calendarService, planner)
+	handler := &Handler{service: service}
+
+	RegisterPreviewAutoScheduleOperation(api, handler)
+	RegisterApplyAutoScheduleOperation(api, handler)
+	RegisterGenerateDayPlanOperation(api, handler)
+	RegisterAcceptDayPlanOperation(api, handler)
+}
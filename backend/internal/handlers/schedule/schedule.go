@@ -0,0 +1,188 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/auth"
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/danielgtaylor/huma/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PreviewAutoSchedule returns the proposed plan without writing anything, so
+// the daily planner can show it before the user accepts.
+func (h *Handler) PreviewAutoSchedule(ctx context.Context, input *AutoScheduleInput) (*AutoScheduleOutput, error) {
+	return h.autoSchedule(ctx, input, true)
+}
+
+// ApplyAutoSchedule computes the plan and assigns each proposed start time.
+func (h *Handler) ApplyAutoSchedule(ctx context.Context, input *AutoScheduleInput) (*AutoScheduleOutput, error) {
+	return h.autoSchedule(ctx, input, false)
+}
+
+func (h *Handler) autoSchedule(ctx context.Context, input *AutoScheduleInput, dryRun bool) (*AutoScheduleOutput, error) {
+	userIDStr, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Please log in to continue", err)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userIDStr)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	opts := PlanOptions{
+		Date:         input.Body.Date,
+		Timezone:     input.Timezone,
+		DayStartHour: defaultDayStartHour,
+		DayEndHour:   defaultDayEndHour,
+		MaxTasks:     input.Body.MaxTasks,
+		TaskIDs:      input.Body.TaskIDs,
+	}
+	if opts.Timezone == "" {
+		opts.Timezone = auth.GetTimezoneOrDefault(ctx)
+	}
+	if input.Body.DayStartHour != nil {
+		opts.DayStartHour = *input.Body.DayStartHour
+	}
+	if input.Body.DayEndHour != nil {
+		opts.DayEndHour = *input.Body.DayEndHour
+	}
+	if opts.MaxTasks == 0 {
+		opts.MaxTasks = defaultMaxTasks
+	}
+	if opts.DayEndHour <= opts.DayStartHour {
+		return nil, huma.Error400BadRequest("dayEndHour must be after dayStartHour", nil)
+	}
+	if opts.Date != "" {
+		if _, err := time.ParseInLocation("2006-01-02", opts.Date, time.UTC); err != nil {
+			return nil, huma.Error400BadRequest("date must be in YYYY-MM-DD format", err)
+		}
+	}
+	for _, id := range opts.TaskIDs {
+		if _, err := primitive.ObjectIDFromHex(id); err != nil {
+			return nil, huma.Error400BadRequest("Invalid task ID format", err)
+		}
+	}
+
+	plan, err := h.service.Plan(ctx, userObjID, opts)
+	if err != nil {
+		slog.Error("Failed to compute auto-schedule", "userId", userIDStr, "date", opts.Date, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to plan your day. Please try again.", err)
+	}
+
+	resp := &AutoScheduleOutput{}
+	resp.Body.Plan = *plan
+	if !dryRun {
+		resp.Body.Applied, resp.Body.Failed = h.service.Apply(ctx, userObjID, plan.Proposals)
+	}
+	return resp, nil
+}
+
+// GenerateDayPlan asks the AI planner for a time-boxed plan for today, or a
+// revision of an earlier plan. It costs one natural language credit, which is
+// refunded if no plan comes back.
+func (h *Handler) GenerateDayPlan(ctx context.Context, input *GenerateDayPlanInput) (*GenerateDayPlanOutput, error) {
+	userIDStr, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Please log in to continue", err)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userIDStr)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+	if h.service.Planner == nil {
+		return nil, huma.Error503ServiceUnavailable("AI day planning is not available right now", ErrPlannerUnavailable)
+	}
+
+	opts := DayPlanOptions{
+		Timezone:     input.Timezone,
+		DayStartHour: defaultDayStartHour,
+		DayEndHour:   defaultDayEndHour,
+		Feedback:     input.Body.Feedback,
+	}
+	if opts.Timezone == "" {
+		opts.Timezone = auth.GetTimezoneOrDefault(ctx)
+	}
+	if input.Body.DayStartHour != nil {
+		opts.DayStartHour = *input.Body.DayStartHour
+	}
+	if input.Body.DayEndHour != nil {
+		opts.DayEndHour = *input.Body.DayEndHour
+	}
+	if opts.DayEndHour <= opts.DayStartHour {
+		return nil, huma.Error400BadRequest("dayEndHour must be after dayStartHour", nil)
+	}
+	if input.Body.PreviousID != "" {
+		previousID, err := primitive.ObjectIDFromHex(input.Body.PreviousID)
+		if err != nil {
+			return nil, huma.Error400BadRequest("Invalid previousPlanId format", err)
+		}
+		opts.PreviousID = &previousID
+	}
+
+	if err := h.service.Tasks.Users.ConsumeCredit(ctx, userObjID, types.CreditTypeNaturalLanguage); err != nil {
+		if errors.Is(err, types.ErrInsufficientCredits) {
+			return nil, huma.Error403Forbidden("Insufficient credits. You need at least 1 natural language credit to use this feature.", err)
+		}
+		slog.Error("Failed to consume credit", "userId", userIDStr, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to process your credit. Please try again later.", err)
+	}
+
+	plan, err := h.service.GenerateDayPlan(ctx, userObjID, opts)
+	if err != nil {
+		if refundErr := h.service.Tasks.Users.RefundCredit(ctx, userObjID, types.CreditTypeNaturalLanguage); refundErr != nil {
+			slog.Error("Failed to refund credit after day plan failure", "userId", userIDStr, "error", refundErr)
+		}
+		switch {
+		case errors.Is(err, ErrDayPlanNotFound):
+			return nil, huma.Error404NotFound("Previous plan not found", err)
+		case errors.Is(err, ErrNoTimeLeft):
+			return nil, huma.Error400BadRequest("There are no working hours left today", err)
+		}
+		slog.Error("Failed to generate day plan", "userId", userIDStr, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to plan your day. Please try again.", err)
+	}
+
+	return &GenerateDayPlanOutput{Body: *plan}, nil
+}
+
+// AcceptDayPlan schedules the plan's tasks, optionally as tweaked by the
+// user, and counts the first accepted plan of the day toward the Plan ring.
+func (h *Handler) AcceptDayPlan(ctx context.Context, input *AcceptDayPlanInput) (*AcceptDayPlanOutput, error) {
+	userIDStr, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Please log in to continue", err)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userIDStr)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+	planID, err := primitive.ObjectIDFromHex(input.PlanID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid plan ID format", err)
+	}
+
+	plan, applied, failed, delta, err := h.service.AcceptDayPlan(ctx, userObjID, planID, input.Body.Items)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrDayPlanNotFound):
+			return nil, huma.Error404NotFound("Plan not found", err)
+		case errors.Is(err, ErrDayPlanClosed):
+			return nil, huma.Error409Conflict("This plan was already accepted or replaced", err)
+		case errors.Is(err, ErrInvalidPlanEdit):
+			return nil, huma.Error400BadRequest(err.Error(), err)
+		}
+		slog.Error("Failed to accept day plan", "userId", userIDStr, "planId", input.PlanID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to accept the plan. Please try again.", err)
+	}
+
+	resp := &AcceptDayPlanOutput{}
+	resp.Body.Plan = *plan
+	resp.Body.Applied = applied
+	resp.Body.Failed = failed
+	resp.Body.RingDelta = delta
+	return resp, nil
+}
@@ -0,0 +1,328 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/gemini"
+	"github.com/abhikaboy/Kindred/internal/handlers/calendar"
+	"github.com/abhikaboy/Kindred/internal/handlers/task"
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	defaultDayStartHour = 9
+	defaultDayEndHour   = 18
+	defaultMaxTasks     = 8
+
+	// defaultTimedBlock mirrors the push converter: a timed task without a
+	// deadline occupies 30 minutes.
+	defaultTimedBlock = 30 * time.Minute
+)
+
+func newService(collections map[string]*mongo.Collection, taskService *task.Service, calendarService *calendar.Service, planner gemini.Backend) *Service {
+	return &Service{
+		Categories: collections["categories"],
+		TimeBlocks: collections["categories"].Database().Collection("time_blocks"),
+		UserMemory: collections[gemini.UserMemoryCollection],
+		DayPlans:   collections["categories"].Database().Collection("day_plans"),
+		Tasks:      taskService,
+		Calendar:   calendarService,
+		Planner:    planner,
+	}
+}
+
+// Plan computes proposed start times for the user's unscheduled tasks on one
+// day without writing anything.
+func (s *Service) Plan(ctx context.Context, userID primitive.ObjectID, opts PlanOptions) (*Plan, error) {
+	day, window, err := planningDay(opts.Date, opts.Timezone, opts.DayStartHour, opts.DayEndHour)
+	if err != nil {
+		return nil, err
+	}
+	dayEnd := day.AddDate(0, 0, 1)
+
+	plan := &Plan{
+		Date:        day.Format("2006-01-02"),
+		WindowStart: window.Start,
+		WindowEnd:   window.End,
+		Proposals:   []Proposal{},
+		Unscheduled: []UnscheduledTask{},
+	}
+	if !window.End.After(window.Start) {
+		plan.Warnings = append(plan.Warnings, "no working hours left on this day")
+		return plan, nil
+	}
+
+	busy, err := s.scheduledTaskBlocks(ctx, userID, day, dayEnd)
+	if err != nil {
+		return nil, fmt.Errorf("load scheduled tasks: %w", err)
+	}
+	calendarBusy, warnings := s.calendarBusyBlocks(ctx, userID, window)
+	busy = append(busy, calendarBusy...)
+	plan.BusyBlocks = len(busy)
+	plan.Warnings = append(plan.Warnings, warnings...)
+
+	cands, err := s.unscheduledCandidates(ctx, userID, dayEnd, opts.TaskIDs)
+	if err != nil {
+		return nil, fmt.Errorf("load unscheduled tasks: %w", err)
+	}
+
+	peak := s.peakWindow(ctx, userID, day)
+	plan.UsedPeakHours = peak != nil
+
+	placed, unplaced := planDay(cands, window, busy, peak)
+	if opts.MaxTasks > 0 && len(placed) > opts.MaxTasks {
+		for _, p := range placed[opts.MaxTasks:] {
+			unplaced = append(unplaced, candidate{Task: p.Task, Duration: p.End.Sub(p.Start)})
+		}
+		placed = placed[:opts.MaxTasks]
+	}
+
+	for _, p := range placed {
+		plan.Proposals = append(plan.Proposals, Proposal{
+			TaskID:     p.Task.ID.Hex(),
+			CategoryID: p.Task.CategoryID.Hex(),
+			Content:    p.Task.Content,
+			Priority:   p.Task.Priority,
+			Start:      p.Start,
+			End:        p.End,
+			Reason:     p.Reason,
+		})
+	}
+	for _, c := range unplaced {
+		plan.Unscheduled = append(plan.Unscheduled, UnscheduledTask{
+			TaskID:          c.Task.ID.Hex(),
+			CategoryID:      c.Task.CategoryID.Hex(),
+			Content:         c.Task.Content,
+			DurationMinutes: int(c.Duration.Minutes()),
+		})
+	}
+	return plan, nil
+}
+
+// Apply writes each proposal's start through task.Service.UpdateTaskStart, so
+// reschedule counting and the calendar push outbox behave exactly as they do
+// for a manual edit. Returns the number applied and the IDs that failed.
+func (s *Service) Apply(ctx context.Context, userID primitive.ObjectID, proposals []Proposal) (int, []string) {
+	applied := 0
+	failed := []string{}
+	for _, p := range proposals {
+		taskID, err := primitive.ObjectIDFromHex(p.TaskID)
+		if err != nil {
+			failed = append(failed, p.TaskID)
+			continue
+		}
+		categoryID, err := primitive.ObjectIDFromHex(p.CategoryID)
+		if err != nil {
+			failed = append(failed, p.TaskID)
+			continue
+		}
+		start := p.Start
+		if err := s.Tasks.UpdateTaskStart(taskID, categoryID, userID, task.UpdateTaskStartDocument{
+			StartDate: &start,
+			StartTime: &start,
+		}); err != nil {
+			slog.Error("Auto-schedule: failed to set task start", "taskId", p.TaskID, "userId", userID.Hex(), "error", err)
+			failed = append(failed, p.TaskID)
+			continue
+		}
+		applied++
+	}
+	return applied, failed
+}
+
+// scheduledTaskBlocks returns the time already claimed by the user's own
+// timed tasks and focus blocks on this day. Tasks inside a focus block are
+// covered by the block's span rather than their own.
+func (s *Service) scheduledTaskBlocks(ctx context.Context, userID primitive.ObjectID, dayStart, dayEnd time.Time) ([]interval, error) {
+	cursor, err := s.Categories.Aggregate(ctx, []bson.D{
+		{{Key: "$match", Value: bson.M{"user": userID}}},
+		{{Key: "$unwind", Value: "$tasks"}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$tasks"}}},
+		{{Key: "$match", Value: bson.M{"startTime": bson.M{"$gte": dayStart, "$lt": dayEnd}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var tasks []types.TaskDocument
+	if err := cursor.All(ctx, &tasks); err != nil {
+		return nil, err
+	}
+
+	blocks := make([]interval, 0, len(tasks))
+	for _, t := range tasks {
+		if t.TimeBlockID != nil {
+			continue
+		}
+		end := t.StartTime.Add(defaultTimedBlock)
+		if t.Deadline != nil && t.Deadline.After(*t.StartTime) && t.Deadline.Before(dayEnd) {
+			end = *t.Deadline
+		}
+		blocks = append(blocks, interval{Start: *t.StartTime, End: end, Label: t.Content})
+	}
+
+	focusCursor, err := s.TimeBlocks.Find(ctx, bson.M{
+		"user_id": userID,
+		"status":  types.TimeBlockScheduled,
+		"start":   bson.M{"$lt": dayEnd},
+		"end":     bson.M{"$gt": dayStart},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer focusCursor.Close(ctx)
+
+	var focus []types.TimeBlockDocument
+	if err := focusCursor.All(ctx, &focus); err != nil {
+		return nil, err
+	}
+	for _, b := range focus {
+		blocks = append(blocks, interval{Start: b.Start, End: b.End, Label: "Focus block: " + b.Title})
+	}
+	return blocks, nil
+}
+
+// calendarBusyBlocks reads timed events from every connected calendar. A
+// connection that fails to fetch is reported as a warning rather than failing
+// the plan — a stale token on one account shouldn't block scheduling.
+func (s *Service) calendarBusyBlocks(ctx context.Context, userID primitive.ObjectID, window interval) ([]interval, []string) {
+	if s.Calendar == nil {
+		return nil, nil
+	}
+	connections, err := s.Calendar.GetConnections(ctx, userID)
+	if err != nil {
+		slog.Warn("Auto-schedule: failed to list calendar connections", "userId", userID.Hex(), "error", err)
+		return nil, []string{"calendar connections unavailable; scheduled around tasks only"}
+	}
+
+	var blocks []interval
+	var warnings []string
+	for _, conn := range connections {
+		events, err := s.Calendar.FetchEvents(ctx, conn.ID, window.Start, window.End)
+		if err != nil {
+			slog.Warn("Auto-schedule: failed to fetch calendar events", "connectionId", conn.ID.Hex(), "error", err)
+			warnings = append(warnings, fmt.Sprintf("could not read calendar %s", conn.ProviderAccountID))
+			continue
+		}
+		for _, ev := range events {
+			// All-day events (birthdays, OOO markers) don't block specific hours,
+			// and push-origin events are Kindred tasks already counted above.
+			if ev.IsAllDay || ev.Status == "cancelled" || calendar.IsPushOriginEvent(ev) {
+				continue
+			}
+			blocks = append(blocks, interval{Start: ev.StartTime, End: ev.EndTime, Label: ev.Summary})
+		}
+	}
+	return blocks, warnings
+}
+
+// unscheduledCandidates loads the user's tasks with no start time that are
+// not deferred past the planned day. Blueprint and calendar-imported tasks are
+// excluded: the former are templates and the latter already have a time.
+func (s *Service) unscheduledCandidates(ctx context.Context, userID primitive.ObjectID, dayEnd time.Time, taskIDs []string) ([]candidate, error) {
+	taskMatch := bson.M{
+		"startTime": nil,
+		"$or": bson.A{
+			bson.M{"startDate": nil},
+			bson.M{"startDate": bson.M{"$lt": dayEnd}},
+		},
+		"integration": bson.M{"$in": bson.A{nil, ""}},
+	}
+	if len(taskIDs) > 0 {
+		ids := make([]primitive.ObjectID, 0, len(taskIDs))
+		for _, id := range taskIDs {
+			oid, err := primitive.ObjectIDFromHex(id)
+			if err != nil {
+				return nil, fmt.Errorf("invalid task id %q", id)
+			}
+			ids = append(ids, oid)
+		}
+		taskMatch["_id"] = bson.M{"$in": ids}
+	}
+
+	cursor, err := s.Categories.Aggregate(ctx, []bson.D{
+		{{Key: "$match", Value: bson.M{"user": userID, "isBlueprint": bson.M{"$ne": true}}}},
+		{{Key: "$unwind", Value: "$tasks"}},
+		{{Key: "$set", Value: bson.M{"tasks.userID": "$user", "tasks.categoryID": "$_id"}}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$tasks"}}},
+		{{Key: "$match", Value: taskMatch}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var tasks []types.TaskDocument
+	if err := cursor.All(ctx, &tasks); err != nil {
+		return nil, err
+	}
+
+	cands := make([]candidate, 0, len(tasks))
+	for _, t := range tasks {
+		cands = append(cands, candidate{Task: t, Duration: estimateDuration(t)})
+	}
+	return cands, nil
+}
+
+// planningDay resolves the day to plan (date, or today when empty) in the
+// user's timezone and its working window, which never starts before now.
+func planningDay(date, timezone string, startHour, endHour int) (time.Time, interval, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	now := time.Now().In(loc)
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if date != "" {
+		day, err = time.ParseInLocation("2006-01-02", date, loc)
+		if err != nil {
+			return time.Time{}, interval{}, fmt.Errorf("invalid date %q: %w", date, err)
+		}
+	}
+
+	window := interval{
+		Start: atHour(day, startHour),
+		End:   atHour(day, endHour),
+	}
+	if now.After(window.Start) {
+		window.Start = now
+	}
+	return day, window, nil
+}
+
+// peakWindow turns the `peak-hours` fact into a window on the planned day.
+// Nil when the user has no such fact — the planner then just packs earliest.
+func (s *Service) peakWindow(ctx context.Context, userID primitive.ObjectID, day time.Time) *interval {
+	fact, err := gemini.LoadUserFact(ctx, s.UserMemory, userID, gemini.FactKeyPeakHours)
+	if err != nil {
+		slog.Warn("Auto-schedule: failed to load peak-hours fact", "userId", userID.Hex(), "error", err)
+		return nil
+	}
+	return peakInterval(fact, day)
+}
+
+// peakInterval is the peak-hours window of fact on day, or nil.
+func peakInterval(fact *gemini.UserFact, day time.Time) *interval {
+	peak := gemini.DecodePeakHours(fact)
+	if peak == nil || peak.EndHour <= peak.StartHour {
+		return nil
+	}
+	return &interval{
+		Start: atHour(day, peak.StartHour),
+		End:   atHour(day, peak.EndHour),
+	}
+}
+
+// atHour is the wall-clock hour on day in day's location; 24 is next midnight.
+// Built with time.Date rather than day.Add so DST transition days stay correct.
+func atHour(day time.Time, hour int) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, 0, 0, 0, day.Location())
+}
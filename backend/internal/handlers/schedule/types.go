@@ -0,0 +1,187 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/gemini"
+	"github.com/abhikaboy/Kindred/internal/handlers/calendar"
+	"github.com/abhikaboy/Kindred/internal/handlers/rings"
+	"github.com/abhikaboy/Kindred/internal/handlers/task"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type Service struct {
+	Categories *mongo.Collection
+	TimeBlocks *mongo.Collection
+	UserMemory *mongo.Collection
+	Tasks      *task.Service
+	// Calendar is optional; when nil the planner only works around tasks that
+	// already have a start time.
+	Calendar *calendar.Service
+	DayPlans *mongo.Collection
+	// Planner runs the AI day plan; nil disables PlanMyDay.
+	Planner gemini.Backend
+}
+
+type Handler struct {
+	service *Service
+}
+
+// PlanOptions controls one auto-scheduling run.
+type PlanOptions struct {
+	Date         string // YYYY-MM-DD in the user's timezone; empty means today
+	Timezone     string
+	DayStartHour int
+	DayEndHour   int
+	MaxTasks     int
+	TaskIDs      []string // restrict to these tasks; empty means all unscheduled
+}
+
+// Proposal is one task the planner fit into the day.
+type Proposal struct {
+	TaskID     string    `json:"taskId"`
+	CategoryID string    `json:"categoryId"`
+	Content    string    `json:"content"`
+	Priority   int       `json:"priority"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	Reason     string    `json:"reason" doc:"Short human-readable explanation of why this slot was chosen"`
+}
+
+// UnscheduledTask is a candidate that did not fit in the day's free time.
+type UnscheduledTask struct {
+	TaskID          string `json:"taskId"`
+	CategoryID      string `json:"categoryId"`
+	Content         string `json:"content"`
+	DurationMinutes int    `json:"durationMinutes"`
+}
+
+// Plan is the result of an auto-scheduling run, applied or not.
+type Plan struct {
+	Date          string            `json:"date"`
+	WindowStart   time.Time         `json:"windowStart"`
+	WindowEnd     time.Time         `json:"windowEnd"`
+	BusyBlocks    int               `json:"busyBlocks" doc:"Calendar events and already-scheduled tasks the plan works around"`
+	UsedPeakHours bool              `json:"usedPeakHours"`
+	Proposals     []Proposal        `json:"proposals"`
+	Unscheduled   []UnscheduledTask `json:"unscheduled"`
+	Warnings      []string          `json:"warnings,omitempty"`
+}
+
+type AutoScheduleBody struct {
+	Date         string   `json:"date,omitempty" example:"2026-10-18" doc:"Day to plan (YYYY-MM-DD, user's timezone). Defaults to today."`
+	DayStartHour *int     `json:"dayStartHour,omitempty" minimum:"0" maximum:"23" doc:"Start of working hours (default 9)"`
+	DayEndHour   *int     `json:"dayEndHour,omitempty" minimum:"1" maximum:"24" doc:"End of working hours (default 18)"`
+	MaxTasks     int      `json:"maxTasks,omitempty" minimum:"0" maximum:"30" doc:"Maximum tasks to place (default 8)"`
+	TaskIDs      []string `json:"taskIds,omitempty" doc:"Only schedule these tasks"`
+}
+
+type AutoScheduleInput struct {
+	Authorization string           `header:"Authorization" required:"true"`
+	Timezone      string           `header:"X-Timezone" required:"false" doc:"IANA timezone, e.g. America/New_York"`
+	Body          AutoScheduleBody `json:"body"`
+}
+
+type AutoScheduleOutput struct {
+	Body struct {
+		Plan
+		Applied int      `json:"applied" doc:"Number of tasks whose start time was written (0 for a preview)"`
+		Failed  []string `json:"failed,omitempty" doc:"Task IDs whose start time could not be written"`
+	}
+}
+
+// Day plan statuses. A plan is proposed until the user accepts it or asks
+// for a revision, which supersedes it.
+const (
+	DayPlanProposed   = "proposed"
+	DayPlanAccepted   = "accepted"
+	DayPlanSuperseded = "superseded"
+)
+
+// DayPlanItem is one time-boxed task in an AI day plan.
+type DayPlanItem struct {
+	TaskID     string    `bson:"task_id" json:"taskId"`
+	CategoryID string    `bson:"category_id" json:"categoryId"`
+	Content    string    `bson:"content" json:"content"`
+	Priority   int       `bson:"priority" json:"priority"`
+	Start      time.Time `bson:"start" json:"start"`
+	End        time.Time `bson:"end" json:"end"`
+	Rationale  string    `bson:"rationale" json:"rationale"`
+	Adjusted   bool      `bson:"adjusted,omitempty" json:"adjusted,omitempty" doc:"The suggested slot overlapped busy time and was moved to the next free one"`
+}
+
+// DayPlanDeferred is a task the plan leaves for another day.
+type DayPlanDeferred struct {
+	TaskID     string `bson:"task_id" json:"taskId"`
+	CategoryID string `bson:"category_id" json:"categoryId"`
+	Content    string `bson:"content" json:"content"`
+	Reason     string `bson:"reason" json:"reason"`
+}
+
+// DayPlanDocument is a generated plan, kept so it can be revised or accepted.
+type DayPlanDocument struct {
+	ID          primitive.ObjectID  `bson:"_id" json:"id"`
+	UserID      primitive.ObjectID  `bson:"user_id" json:"userId"`
+	Date        string              `bson:"date" json:"date"`
+	Timezone    string              `bson:"timezone" json:"timezone"`
+	Status      string              `bson:"status" json:"status" enum:"proposed,accepted,superseded"`
+	Summary     string              `bson:"summary" json:"summary"`
+	Items       []DayPlanItem       `bson:"items" json:"items"`
+	Deferred    []DayPlanDeferred   `bson:"deferred" json:"deferred"`
+	WindowStart time.Time           `bson:"window_start" json:"windowStart"`
+	WindowEnd   time.Time           `bson:"window_end" json:"windowEnd"`
+	Warnings    []string            `bson:"warnings,omitempty" json:"warnings,omitempty"`
+	Feedback    string              `bson:"feedback,omitempty" json:"feedback,omitempty"`
+	PreviousID  *primitive.ObjectID `bson:"previous_id,omitempty" json:"previousId,omitempty"`
+	CreatedAt   time.Time           `bson:"created_at" json:"createdAt"`
+	AcceptedAt  *time.Time          `bson:"accepted_at,omitempty" json:"acceptedAt,omitempty"`
+}
+
+// DayPlanOptions controls one PlanMyDay generation.
+type DayPlanOptions struct {
+	Timezone     string
+	DayStartHour int
+	DayEndHour   int
+	PreviousID   *primitive.ObjectID // revise this plan
+	Feedback     string              // what to change about it
+}
+
+// DayPlanItemEdit is a client-side tweak to a plan item before accepting.
+type DayPlanItemEdit struct {
+	TaskID string    `json:"taskId" doc:"A task from the plan's items or deferred list"`
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"`
+}
+
+type GenerateDayPlanInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	Timezone      string `header:"X-Timezone" required:"false" doc:"IANA timezone, e.g. America/New_York"`
+	Body          struct {
+		DayStartHour *int   `json:"dayStartHour,omitempty" minimum:"0" maximum:"23" doc:"Start of working hours (default 9)"`
+		DayEndHour   *int   `json:"dayEndHour,omitempty" minimum:"1" maximum:"24" doc:"End of working hours (default 18)"`
+		PreviousID   string `json:"previousPlanId,omitempty" doc:"Regenerate: the plan being revised"`
+		Feedback     string `json:"feedback,omitempty" maxLength:"1000" doc:"Regenerate: what to change" example:"Keep my mornings free and move the report after lunch"`
+	} `json:"body"`
+}
+
+type GenerateDayPlanOutput struct {
+	Body DayPlanDocument `json:"body"`
+}
+
+type AcceptDayPlanInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	PlanID        string `path:"planId" doc:"Day plan ID"`
+	Body          struct {
+		Items []DayPlanItemEdit `json:"items,omitempty" doc:"The plan as tweaked by the user. Omit to accept it as generated."`
+	} `json:"body" required:"false"`
+}
+
+type AcceptDayPlanOutput struct {
+	Body struct {
+		Plan      DayPlanDocument  `json:"plan"`
+		Applied   int              `json:"applied" doc:"Number of tasks whose start time was written"`
+		Failed    []string         `json:"failed,omitempty" doc:"Task IDs whose start time could not be written"`
+		RingDelta *rings.RingDelta `json:"ringDelta,omitempty" doc:"Plan ring change; set only for the first plan accepted today"`
+	}
+}
@@ -2,6 +2,7 @@ package spaces
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 )
@@ -80,6 +81,19 @@ type ProcessAndUploadImageOutput struct {
 	}
 }
 
+// Generate Voice Upload URL
+type GenerateVoiceUploadURLInput struct {
+	FileType string `query:"file_type" required:"true" example:"audio/mp4" description:"MIME type of the recording"`
+}
+
+type GenerateVoiceUploadURLOutput struct {
+	Body struct {
+		UploadURL string    `json:"upload_url" example:"https://presigned-upload-url..."`
+		Key       string    `json:"key" example:"voice/507f1f77bcf86cd799439011/uuid.m4a" doc:"Pass to POST /v1/user/tasks/voice once the upload finishes"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+}
+
 // Operation registrations
 
 func RegisterGetPresignedUrlOperation(api huma.API, handler *Handler) {
@@ -137,6 +151,17 @@ func RegisterProcessAndUploadImageOperation(api huma.API, handler *Handler) {
 	}, handler.ProcessAndUploadImage)
 }
 
+func RegisterGenerateVoiceUploadURLOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "generate-voice-upload-url",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/voice/upload-url",
+		Summary:     "Generate presigned URL for a voice recording",
+		Description: "Generate a private presigned URL for uploading a voice recording to be transcribed into tasks",
+		Tags:        []string{"uploads", "voice"},
+	}, handler.GenerateVoiceUploadURL)
+}
+
 // Register all s3bucket operations
 func RegisterS3BucketOperations(api huma.API, handler *Handler) {
 	RegisterGetPresignedUrlOperation(api, handler)
@@ -144,4 +169,5 @@ func RegisterS3BucketOperations(api huma.API, handler *Handler) {
 	RegisterGenerateImageUploadURLOperation(api, handler)
 	RegisterConfirmImageUploadOperation(api, handler)
 	RegisterProcessAndUploadImageOperation(api, handler)
+	RegisterGenerateVoiceUploadURLOperation(api, handler)
 }
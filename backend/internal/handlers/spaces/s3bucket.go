@@ -10,7 +10,9 @@ import (
 	"time"
 
 	"github.com/abhikaboy/Kindred/internal/config"
+	"github.com/abhikaboy/Kindred/internal/handlers/auth"
 	Profile "github.com/abhikaboy/Kindred/internal/handlers/profile"
+	"github.com/abhikaboy/Kindred/internal/transcribe"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/danielgtaylor/huma/v2"
@@ -129,6 +131,34 @@ func (h *Handler) GenerateImageUploadURL(ctx context.Context, input *GenerateIma
 	return resp, nil
 }
 
+func (h *Handler) GenerateVoiceUploadURL(ctx context.Context, input *GenerateVoiceUploadURLInput) (*GenerateVoiceUploadURLOutput, error) {
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
+	}
+	if _, ok := transcribe.AudioExtension(input.FileType); !ok {
+		return nil, huma.Error400BadRequest("Invalid file type. Supported: audio/mp4 (m4a), audio/mpeg, audio/wav, audio/webm, audio/ogg, audio/flac", nil)
+	}
+
+	bucketName := h.config.DO.SpacesBucket
+	if bucketName == "" {
+		slog.Error("SPACES_BUCKET environment variable is not set")
+		return nil, huma.Error500InternalServerError("File upload service is not configured. Please contact support.", fmt.Errorf("bucket name not configured"))
+	}
+
+	upload, expiresAt, err := h.service.GenerateVoiceUploadURL(ctx, userID, input.FileType, bucketName)
+	if err != nil {
+		slog.Error("Unable to generate voice upload URL", "userID", userID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to generate upload URL. Please try again.", err)
+	}
+
+	resp := &GenerateVoiceUploadURLOutput{}
+	resp.Body.UploadURL = upload.URL
+	resp.Body.Key = upload.Key
+	resp.Body.ExpiresAt = expiresAt
+	return resp, nil
+}
+
 func (h *Handler) ConfirmImageUpload(ctx context.Context, input *ConfirmImageUploadInput) (*ConfirmImageUploadOutput, error) {
 	switch input.ResourceType {
 	case "profile":
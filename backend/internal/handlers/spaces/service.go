@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/abhikaboy/Kindred/internal/transcribe"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/google/uuid"
@@ -135,6 +136,38 @@ func (s *Service) GenerateImageUploadURL(ctx context.Context, params *ImageUploa
 	}, nil
 }
 
+// voiceUploadExpiry bounds how long a client has to start uploading a
+// recording.
+const voiceUploadExpiry = 15 * time.Minute
+
+// GenerateVoiceUploadURL creates a presigned PUT URL for a voice recording
+// under the user's upload prefix. Recordings stay private: only the server
+// reads them back, to transcribe.
+func (s *Service) GenerateVoiceUploadURL(ctx context.Context, userID, fileType, bucketName string) (*UploadUrl, time.Time, error) {
+	ctx, span := otel.Tracer("kindred").Start(ctx, "spaces.GenerateVoiceUploadURL")
+	defer span.End()
+
+	ext, ok := transcribe.AudioExtension(fileType)
+	if !ok {
+		return nil, time.Time{}, transcribe.ErrUnsupportedAudio
+	}
+	key := transcribe.UploadKeyPrefix(userID) + uuid.New().String() + ext
+
+	req, err := s.Presigner.PresignPutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(key),
+		ContentType: aws.String(fileType),
+	}, func(opts *s3.PresignOptions) {
+		opts.Expires = voiceUploadExpiry
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, time.Time{}, fmt.Errorf("failed to generate presigned URL: %w", err)
+	}
+	return &UploadUrl{URL: req.URL, Key: key}, time.Now().Add(voiceUploadExpiry), nil
+}
+
 // Helper function to get file extension from content type
 func getFileExtension(contentType string) string {
 	switch contentType {
@@ -0,0 +1,310 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/abhikaboy/Kindred/xutils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// The assistant is a multi-turn conversation about the user's tasks. Each turn
+// sends the session history to the model along with action tools that change
+// tasks directly; every change a turn makes is recorded in one changeset so the
+// user can undo the whole turn at once.
+
+// assistantHistoryLimit caps how many prior messages are sent to the model.
+const assistantHistoryLimit = 20
+
+// Change actions recorded in an assistant changeset.
+const (
+	AssistantActionCreated     = "created"
+	AssistantActionEdited      = "edited"
+	AssistantActionRescheduled = "rescheduled"
+	AssistantActionCompleted   = "completed"
+)
+
+var (
+	ErrAssistantSessionNotFound   = errors.New("assistant session not found")
+	ErrAssistantChangesetNotFound = errors.New("changeset not found")
+	ErrAssistantChangesetUndone   = errors.New("changeset was already undone")
+)
+
+// AssistantMessage is one message of a session, as stored and as sent to the model.
+type AssistantMessage struct {
+	Role          string                  `bson:"role" json:"role" enum:"user,assistant"`
+	Content       string                  `bson:"content" json:"content"`
+	Clarification *AssistantClarification `bson:"clarification,omitempty" json:"clarification,omitempty" doc:"Set when the assistant asked a question instead of acting"`
+	ChangesetID   *primitive.ObjectID     `bson:"changesetId,omitempty" json:"changesetId,omitempty" doc:"Changeset recording what this reply changed"`
+	CreatedAt     time.Time               `bson:"createdAt" json:"createdAt"`
+}
+
+type AssistantClarification struct {
+	Question string   `bson:"question" json:"question"`
+	Options  []string `bson:"options,omitempty" json:"options,omitempty"`
+}
+
+// AssistantChange is one mutation made by a tool call. Before is the task as
+// it was beforehand (nil for created tasks) and is what undo restores.
+type AssistantChange struct {
+	Action          string             `bson:"action" json:"action" enum:"created,edited,rescheduled,completed"`
+	TaskID          primitive.ObjectID `bson:"taskId" json:"taskId"`
+	CategoryID      primitive.ObjectID `bson:"categoryId" json:"categoryId"`
+	Content         string             `bson:"content" json:"content"`
+	CategoryCreated bool               `bson:"categoryCreated,omitempty" json:"categoryCreated,omitempty" doc:"The category was created for this task and is removed on undo if empty"`
+	Before          *TaskDocument      `bson:"before,omitempty" json:"-"`
+}
+
+// AssistantChangeset groups the changes made in one turn.
+type AssistantChangeset struct {
+	ID        primitive.ObjectID `bson:"_id" json:"id"`
+	Changes   []AssistantChange  `bson:"changes" json:"changes"`
+	CreatedAt time.Time          `bson:"createdAt" json:"createdAt"`
+	UndoneAt  *time.Time         `bson:"undoneAt,omitempty" json:"undoneAt,omitempty"`
+}
+
+type AssistantSessionDocument struct {
+	ID         primitive.ObjectID   `bson:"_id" json:"id"`
+	UserID     primitive.ObjectID   `bson:"userId" json:"userId"`
+	Timezone   string               `bson:"timezone" json:"timezone"`
+	Messages   []AssistantMessage   `bson:"messages" json:"messages"`
+	Changesets []AssistantChangeset `bson:"changesets" json:"changesets"`
+	CreatedAt  time.Time            `bson:"createdAt" json:"createdAt"`
+	UpdatedAt  time.Time            `bson:"updatedAt" json:"updatedAt"`
+}
+
+// AssistantTool is an action the model may call during a turn. Input is the
+// zero value of the tool's input type, from which the backend derives the
+// JSON schema offered to the model; Run receives the model's arguments.
+type AssistantTool struct {
+	Name        string
+	Description string
+	Input       any
+	Run         func(ctx context.Context, input json.RawMessage) (any, error)
+}
+
+// AssistantTurnRequest is one turn: the history ending with the new user
+// message, plus the action tools bound to this turn's changeset.
+type AssistantTurnRequest struct {
+	UserID   string
+	Timezone string
+	Messages []AssistantMessage
+	Tools    []AssistantTool
+}
+
+// AssistantReplyLocal mirrors gemini.AssistantTurnOutput.
+type AssistantReplyLocal struct {
+	Reply         string                  `json:"reply"`
+	Clarification *AssistantClarification `json:"clarification,omitempty"`
+}
+
+// AssistantUndoResult reports the outcome of reverting one change.
+type AssistantUndoResult struct {
+	Action   string `json:"action"`
+	TaskID   string `json:"taskId"`
+	Content  string `json:"content"`
+	Reverted bool   `json:"reverted"`
+	Error    string `json:"error,omitempty"`
+}
+
+// assistantHistory returns the messages to send for a new turn: the most
+// recent stored messages followed by the new user message.
+func assistantHistory(stored []AssistantMessage, text string) []AssistantMessage {
+	if len(stored) > assistantHistoryLimit {
+		stored = stored[len(stored)-assistantHistoryLimit:]
+	}
+	history := make([]AssistantMessage, 0, len(stored)+1)
+	for _, m := range stored {
+		content := m.Content
+		if m.Clarification != nil && m.Clarification.Question != "" && m.Clarification.Question != content {
+			content += "\n" + m.Clarification.Question
+		}
+		history = append(history, AssistantMessage{Role: m.Role, Content: content})
+	}
+	return append(history, AssistantMessage{Role: "user", Content: text})
+}
+
+func (s *Service) CreateAssistantSession(ctx context.Context, userID primitive.ObjectID, timezone string) (*AssistantSessionDocument, error) {
+	now := xutils.NowUTC()
+	session := &AssistantSessionDocument{
+		ID:         primitive.NewObjectID(),
+		UserID:     userID,
+		Timezone:   timezone,
+		Messages:   []AssistantMessage{},
+		Changesets: []AssistantChangeset{},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if _, err := s.AssistantSessions.InsertOne(ctx, session); err != nil {
+		return nil, handleMongoError(ctx, "create assistant session", err)
+	}
+	return session, nil
+}
+
+// GetAssistantSession returns the user's session, or ErrAssistantSessionNotFound
+// when it doesn't exist or belongs to someone else.
+func (s *Service) GetAssistantSession(ctx context.Context, sessionID, userID primitive.ObjectID) (*AssistantSessionDocument, error) {
+	var session AssistantSessionDocument
+	err := s.AssistantSessions.FindOne(ctx, bson.M{"_id": sessionID, "userId": userID}).Decode(&session)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrAssistantSessionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// AppendAssistantTurn stores a completed turn: the user message, the reply and,
+// when the turn changed anything, its changeset.
+func (s *Service) AppendAssistantTurn(ctx context.Context, sessionID primitive.ObjectID, userMsg, reply AssistantMessage, changeset *AssistantChangeset) error {
+	push := bson.M{"messages": bson.M{"$each": bson.A{userMsg, reply}}}
+	if changeset != nil {
+		push["changesets"] = changeset
+	}
+	_, err := s.AssistantSessions.UpdateByID(ctx, sessionID, bson.M{
+		"$push": push,
+		"$set":  bson.M{"updatedAt": xutils.NowUTC()},
+	})
+	return handleMongoError(ctx, "append assistant turn", err)
+}
+
+// UndoAssistantChangeset reverts every change in a changeset, newest first,
+// and marks it undone. Changes that can no longer be reverted (the task was
+// since deleted, say) are reported rather than failing the rest. Undoing a
+// completion puts the task back and removes the completion record; streak and
+// ring credit earned by it are not taken back.
+func (s *Service) UndoAssistantChangeset(ctx context.Context, sessionID, userID, changesetID primitive.ObjectID) ([]AssistantUndoResult, error) {
+	session, err := s.GetAssistantSession(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+	var changeset *AssistantChangeset
+	for i := range session.Changesets {
+		if session.Changesets[i].ID == changesetID {
+			changeset = &session.Changesets[i]
+			break
+		}
+	}
+	if changeset == nil {
+		return nil, ErrAssistantChangesetNotFound
+	}
+
+	// Claim the changeset first so concurrent undo requests can't both revert it.
+	now := xutils.NowUTC()
+	res, err := s.AssistantSessions.UpdateOne(ctx,
+		bson.M{"_id": sessionID, "changesets": bson.M{"$elemMatch": bson.M{"_id": changesetID, "undoneAt": bson.M{"$exists": false}}}},
+		bson.M{"$set": bson.M{"changesets.$.undoneAt": now, "updatedAt": now}},
+	)
+	if err != nil {
+		return nil, handleMongoError(ctx, "mark changeset undone", err)
+	}
+	if res.ModifiedCount == 0 {
+		return nil, ErrAssistantChangesetUndone
+	}
+
+	results := make([]AssistantUndoResult, 0, len(changeset.Changes))
+	for i := len(changeset.Changes) - 1; i >= 0; i-- {
+		change := changeset.Changes[i]
+		result := AssistantUndoResult{Action: change.Action, TaskID: change.TaskID.Hex(), Content: change.Content}
+		if err := s.revertAssistantChange(ctx, userID, change); err != nil {
+			slog.LogAttrs(ctx, slog.LevelWarn, "Failed to revert assistant change",
+				slog.String("action", change.Action),
+				slog.String("taskID", change.TaskID.Hex()),
+				slog.String("error", err.Error()))
+			result.Error = err.Error()
+		} else {
+			result.Reverted = true
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (s *Service) revertAssistantChange(ctx context.Context, userID primitive.ObjectID, change AssistantChange) error {
+	if err := s.verifyCategoryOwnership(ctx, change.CategoryID, userID); err != nil {
+		return err
+	}
+
+	switch change.Action {
+	case AssistantActionCreated:
+		if err := s.DeleteTask(change.CategoryID, change.TaskID); err != nil {
+			return err
+		}
+		if change.CategoryCreated {
+			_, err := s.Tasks.DeleteOne(ctx, bson.M{"_id": change.CategoryID, "user": userID, "tasks": bson.M{"$size": 0}})
+			return err
+		}
+		return nil
+
+	case AssistantActionEdited, AssistantActionRescheduled:
+		if change.Before == nil {
+			return fmt.Errorf("no snapshot to restore")
+		}
+		res, err := s.Tasks.UpdateOne(ctx,
+			bson.M{"_id": change.CategoryID},
+			bson.M{"$set": bson.M{"tasks.$[t]": change.Before}},
+			getTaskArrayFilterOptions(change.TaskID),
+		)
+		if err != nil {
+			return err
+		}
+		if res.ModifiedCount == 0 && !s.taskExists(ctx, change.CategoryID, change.TaskID) {
+			return fmt.Errorf("task no longer exists")
+		}
+		s.enqueuePushUpsertIfEnabled(context.Background(), change.TaskID, change.CategoryID, userID)
+		return nil
+
+	case AssistantActionCompleted:
+		if change.Before == nil {
+			return fmt.Errorf("no snapshot to restore")
+		}
+		if _, err := s.CompletedTasks.DeleteOne(ctx, bson.M{"_id": change.TaskID, "user": userID}); err != nil {
+			return err
+		}
+		if _, err := s.CreateTask(change.CategoryID, change.Before); err != nil {
+			return err
+		}
+		if user, err := s.Users.GetUserByID(ctx, userID); err == nil && user.TasksComplete > 0 {
+			if err := s.Users.UpdateUser(ctx, userID, bson.M{"tasks_complete": user.TasksComplete - 1}); err != nil {
+				slog.LogAttrs(ctx, slog.LevelWarn, "Failed to decrement tasks_complete on undo", slog.String("error", err.Error()))
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown action %q", change.Action)
+}
+
+func (s *Service) taskExists(ctx context.Context, categoryID, taskID primitive.ObjectID) bool {
+	n, err := s.Tasks.CountDocuments(ctx, bson.M{"_id": categoryID, "tasks._id": taskID}, options.Count().SetLimit(1))
+	return err == nil && n > 0
+}
+
+// locateUserTask finds one of the user's tasks by ID along with its category,
+// without trusting a category ID supplied by the model.
+func (s *Service) locateUserTask(ctx context.Context, userID, taskID primitive.ObjectID) (*TaskDocument, error) {
+	pipeline := []bson.D{{{Key: "$match", Value: bson.M{"user": userID, "tasks._id": taskID}}}}
+	pipeline = append(pipeline, getBaseTaskPipeline()...)
+	pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"_id": taskID}}})
+
+	cursor, err := s.Tasks.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var tasks []TaskDocument
+	if err := cursor.All(ctx, &tasks); err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, mongo.ErrNoDocuments
+	}
+	return &tasks[0], nil
+}
@@ -0,0 +1,222 @@
+package task
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/auth"
+	"github.com/abhikaboy/Kindred/xutils"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CreateAssistantSession handles POST /v1/user/assistant/sessions
+func (h *Handler) CreateAssistantSession(ctx context.Context, input *CreateAssistantSessionInput) (*CreateAssistantSessionOutput, error) {
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Please log in to continue", err)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	timezone := input.Body.Timezone
+	if timezone == "" {
+		timezone = "America/New_York"
+	}
+	session, err := h.service.CreateAssistantSession(ctx, userObjID, timezone)
+	if err != nil {
+		return nil, huma.Error500InternalServerError("Failed to create assistant session", err)
+	}
+	return &CreateAssistantSessionOutput{Body: *session}, nil
+}
+
+// GetAssistantSession handles GET /v1/user/assistant/sessions/{sessionId}
+func (h *Handler) GetAssistantSession(ctx context.Context, input *GetAssistantSessionInput) (*GetAssistantSessionOutput, error) {
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Please log in to continue", err)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+	sessionID, err := primitive.ObjectIDFromHex(input.SessionID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid session ID format", err)
+	}
+
+	session, err := h.service.GetAssistantSession(ctx, sessionID, userObjID)
+	if errors.Is(err, ErrAssistantSessionNotFound) {
+		return nil, huma.Error404NotFound("Assistant session not found", err)
+	}
+	if err != nil {
+		return nil, huma.Error500InternalServerError("Failed to get assistant session", err)
+	}
+	return &GetAssistantSessionOutput{Body: *session}, nil
+}
+
+// UndoAssistantChangeset handles POST /v1/user/assistant/sessions/{sessionId}/changesets/{changesetId}/undo
+func (h *Handler) UndoAssistantChangeset(ctx context.Context, input *UndoAssistantChangesetInput) (*UndoAssistantChangesetOutput, error) {
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Please log in to continue", err)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+	sessionID, err := primitive.ObjectIDFromHex(input.SessionID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid session ID format", err)
+	}
+	changesetID, err := primitive.ObjectIDFromHex(input.ChangesetID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid changeset ID format", err)
+	}
+
+	results, err := h.service.UndoAssistantChangeset(ctx, sessionID, userObjID, changesetID)
+	switch {
+	case errors.Is(err, ErrAssistantSessionNotFound):
+		return nil, huma.Error404NotFound("Assistant session not found", err)
+	case errors.Is(err, ErrAssistantChangesetNotFound):
+		return nil, huma.Error404NotFound("Changeset not found", err)
+	case errors.Is(err, ErrAssistantChangesetUndone):
+		return nil, huma.Error409Conflict("Changeset has already been undone", err)
+	case err != nil:
+		return nil, huma.Error500InternalServerError("Failed to undo changeset", err)
+	}
+
+	reverted := 0
+	for _, r := range results {
+		if r.Reverted {
+			reverted++
+		}
+	}
+	output := &UndoAssistantChangesetOutput{}
+	output.Body.Results = results
+	output.Body.Message = fmt.Sprintf("Undid %d of %d changes", reverted, len(results))
+	return output, nil
+}
+
+// StreamAssistantMessage handles POST /v1/user/assistant/sessions/:sessionId/messages/stream
+//
+// Each message is one turn and costs one NL credit. The turn is not retried
+// on failure: tools may already have changed tasks, and a retry would repeat
+// them. If the model fails before changing anything the credit is refunded;
+// otherwise the partial changeset is kept so the user can undo it.
+func (h *Handler) StreamAssistantMessage(c *fiber.Ctx) error {
+	body, err := parseNLPBody(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	userID, err := auth.RequireAuthFiber(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "Please log in to continue"})
+	}
+
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid user ID format"})
+	}
+
+	sessionID, err := primitive.ObjectIDFromHex(c.Params("sessionId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid session ID format"})
+	}
+
+	ctx := c.UserContext()
+
+	session, err := h.service.GetAssistantSession(ctx, sessionID, userObjID)
+	if errors.Is(err, ErrAssistantSessionNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "Assistant session not found"})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to load assistant session"})
+	}
+	timezone := session.Timezone
+	if timezone == "" {
+		timezone = body.Timezone
+	}
+
+	if err := h.consumeNLCredit(c, ctx, userObjID, userID); err != nil {
+		return nil
+	}
+
+	setSSEHeaders(c)
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		sse := NewSSEWriter(w)
+
+		_ = sse.Send("status", map[string]string{"stage": "starting", "message": "Thinking..."})
+
+		slog.LogAttrs(ctx, slog.LevelInfo, "Starting assistant turn",
+			slog.String("userID", userID),
+			slog.String("sessionID", sessionID.Hex()),
+			slog.String("inputText", body.Text))
+
+		rec := &assistantRecorder{
+			notify: func(change AssistantChange, task *TaskDocument) {
+				_ = sse.Send("change", map[string]interface{}{"action": change.Action, "task": task})
+			},
+		}
+		userMsg := AssistantMessage{Role: "user", Content: body.Text, CreatedAt: xutils.NowUTC()}
+
+		reply, err := h.nlpService().AssistantTurn(ctx, AssistantTurnRequest{
+			UserID:   userID,
+			Timezone: timezone,
+			Messages: assistantHistory(session.Messages, body.Text),
+			Tools:    h.assistantTools(userObjID, timezone, rec),
+		})
+		changeset := rec.changeset()
+		if err != nil {
+			slog.LogAttrs(ctx, slog.LevelError, "Assistant turn failed",
+				slog.String("userID", userID),
+				slog.String("sessionID", sessionID.Hex()),
+				slog.String("error", err.Error()))
+			if changeset == nil {
+				h.refundNLCredit(ctx, userObjID, userID)
+				_ = sse.SendError("The assistant couldn't respond. Your credit has been refunded.")
+				return
+			}
+			// Keep what was done so it can be undone.
+			reply = &AssistantReplyLocal{Reply: "Something went wrong partway through. The changes above were made and can be undone."}
+		}
+
+		assistantMsg := AssistantMessage{
+			Role:          "assistant",
+			Content:       reply.Reply,
+			Clarification: reply.Clarification,
+			CreatedAt:     xutils.NowUTC(),
+		}
+		changeCount := 0
+		if changeset != nil {
+			assistantMsg.ChangesetID = &changeset.ID
+			changeCount = len(changeset.Changes)
+		}
+		if err := h.service.AppendAssistantTurn(ctx, sessionID, userMsg, assistantMsg, changeset); err != nil {
+			slog.LogAttrs(ctx, slog.LevelError, "Failed to save assistant turn",
+				slog.String("sessionID", sessionID.Hex()),
+				slog.String("error", err.Error()))
+		}
+
+		slog.LogAttrs(ctx, slog.LevelInfo, "Assistant turn completed",
+			slog.String("userID", userID),
+			slog.String("sessionID", sessionID.Hex()),
+			slog.Int("changeCount", changeCount))
+
+		_ = sse.Send("result", map[string]interface{}{
+			"sessionId": sessionID.Hex(),
+			"message":   assistantMsg,
+			"changeset": changeset,
+		})
+	})
+
+	return nil
+}
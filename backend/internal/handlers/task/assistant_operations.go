@@ -0,0 +1,80 @@
+package task
+
+import (
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// Input/Output types for the conversational task assistant. Turns themselves
+// stream over SSE (see StreamAssistantMessage); these operations manage the
+// session and undo.
+
+// Create Assistant Session
+type CreateAssistantSessionInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	Body          struct {
+		Timezone string `json:"timezone,omitempty" doc:"User's timezone (IANA format). Defaults to America/New_York if not provided" example:"America/New_York"`
+	} `json:"body"`
+}
+
+type CreateAssistantSessionOutput struct {
+	Body AssistantSessionDocument `json:"body"`
+}
+
+// Get Assistant Session
+type GetAssistantSessionInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	SessionID     string `path:"sessionId" doc:"Assistant session ID"`
+}
+
+type GetAssistantSessionOutput struct {
+	Body AssistantSessionDocument `json:"body"`
+}
+
+// Undo Assistant Changeset
+type UndoAssistantChangesetInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	SessionID     string `path:"sessionId" doc:"Assistant session ID"`
+	ChangesetID   string `path:"changesetId" doc:"Changeset ID from the turn to undo"`
+}
+
+type UndoAssistantChangesetOutput struct {
+	Body struct {
+		Results []AssistantUndoResult `json:"results" doc:"Outcome of reverting each change, newest first"`
+		Message string                `json:"message" example:"Undid 2 of 2 changes"`
+	}
+}
+
+func RegisterCreateAssistantSessionOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-assistant-session",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/assistant/sessions",
+		Summary:     "Start an assistant conversation",
+		Description: "Create a conversation session for the task assistant. Send messages with POST /v1/user/assistant/sessions/{sessionId}/messages/stream.",
+		Tags:        []string{"tasks", "ai"},
+	}, handler.CreateAssistantSession)
+}
+
+func RegisterGetAssistantSessionOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-assistant-session",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/assistant/sessions/{sessionId}",
+		Summary:     "Get an assistant conversation",
+		Description: "Return the session's message history and the changesets its turns made",
+		Tags:        []string{"tasks", "ai"},
+	}, handler.GetAssistantSession)
+}
+
+func RegisterUndoAssistantChangesetOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "undo-assistant-changeset",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/assistant/sessions/{sessionId}/changesets/{changesetId}/undo",
+		Summary:     "Undo an assistant turn",
+		Description: "Revert every task change made by one assistant turn. A changeset can only be undone once.",
+		Tags:        []string{"tasks", "ai"},
+	}, handler.UndoAssistantChangeset)
+}
@@ -0,0 +1,112 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestAssistantHistory_AppendsNewUserMessage(t *testing.T) {
+	stored := []AssistantMessage{
+		{Role: "user", Content: "move gym to friday"},
+		{Role: "assistant", Content: "Done."},
+	}
+
+	history := assistantHistory(stored, "and make it high priority")
+
+	assert.Len(t, history, 3)
+	assert.Equal(t, AssistantMessage{Role: "user", Content: "and make it high priority"}, history[2])
+}
+
+func TestAssistantHistory_KeepsMostRecentMessages(t *testing.T) {
+	var stored []AssistantMessage
+	for i := 0; i < assistantHistoryLimit+5; i++ {
+		stored = append(stored, AssistantMessage{Role: "user", Content: fmt.Sprint(i)})
+	}
+
+	history := assistantHistory(stored, "next")
+
+	assert.Len(t, history, assistantHistoryLimit+1)
+	assert.Equal(t, "5", history[0].Content)
+}
+
+func TestAssistantHistory_IncludesClarificationQuestion(t *testing.T) {
+	stored := []AssistantMessage{{
+		Role:          "assistant",
+		Content:       "I found two gym tasks.",
+		Clarification: &AssistantClarification{Question: "Which 'gym' task?", Options: []string{"Gym (Mon)", "Gym (Wed)"}},
+	}}
+
+	history := assistantHistory(stored, "the wednesday one")
+
+	assert.Equal(t, "I found two gym tasks.\nWhich 'gym' task?", history[0].Content)
+}
+
+func TestAssistantRecorder_ChangesetNilWithoutChanges(t *testing.T) {
+	assert.Nil(t, (&assistantRecorder{}).changeset())
+}
+
+func TestAssistantRecorder_RecordsAndNotifies(t *testing.T) {
+	var notified []string
+	rec := &assistantRecorder{notify: func(change AssistantChange, _ *TaskDocument) {
+		notified = append(notified, change.Action)
+	}}
+
+	rec.record(AssistantChange{Action: AssistantActionCreated, TaskID: primitive.NewObjectID()}, nil)
+	rec.record(AssistantChange{Action: AssistantActionCompleted, TaskID: primitive.NewObjectID()}, nil)
+
+	cs := rec.changeset()
+	assert.Len(t, cs.Changes, 2)
+	assert.False(t, cs.ID.IsZero())
+	assert.Equal(t, []string{AssistantActionCreated, AssistantActionCompleted}, notified)
+}
+
+func TestAssistantRecorder_LimitHoldsUnderConcurrentChanges(t *testing.T) {
+	rec := &assistantRecorder{}
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	var refused atomic.Int32
+	for i := 0; i < maxMutationsPerRequest+10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, err := rec.limited(func() (any, error) {
+				rec.record(AssistantChange{Action: AssistantActionEdited, TaskID: primitive.NewObjectID()}, nil)
+				return nil, nil
+			})
+			if errors.Is(err, errTooManyChanges) {
+				refused.Add(1)
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	assert.Len(t, rec.changeset().Changes, maxMutationsPerRequest)
+	assert.EqualValues(t, 10, refused.Load())
+}
+
+func TestAssistantRecorder_FailedChangeFreesItsSlot(t *testing.T) {
+	rec := &assistantRecorder{claimed: maxMutationsPerRequest - 1}
+
+	_, err := rec.limited(func() (any, error) { return nil, errors.New("task not found") })
+	assert.EqualError(t, err, "task not found")
+
+	_, err = rec.limited(func() (any, error) { return nil, nil })
+	assert.NoError(t, err)
+	_, err = rec.limited(func() (any, error) { return nil, nil })
+	assert.ErrorIs(t, err, errTooManyChanges)
+}
+
+func TestValidateAssistantTime(t *testing.T) {
+	assert.NoError(t, validateAssistantTime(nil))
+	assert.NoError(t, validateAssistantTime(strPtr("")))
+	assert.NoError(t, validateAssistantTime(strPtr("2026-10-23T09:00:00-04:00")))
+	assert.Error(t, validateAssistantTime(strPtr("next friday")))
+}
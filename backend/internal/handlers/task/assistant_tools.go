@@ -0,0 +1,401 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/rings"
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/xutils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// assistantRecorder collects the changes made during one turn. Tool calls may
+// run concurrently, so recording and the change notification share a lock.
+type assistantRecorder struct {
+	mu      sync.Mutex
+	changes []AssistantChange
+	// claimed counts changes started or made this turn, against the limit.
+	claimed int
+	notify  func(change AssistantChange, task *TaskDocument)
+}
+
+func (r *assistantRecorder) record(change AssistantChange, task *TaskDocument) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.changes = append(r.changes, change)
+	if r.notify != nil {
+		r.notify(change, task)
+	}
+}
+
+// errTooManyChanges stops a turn that tries to change more than
+// maxMutationsPerRequest tasks.
+var errTooManyChanges = fmt.Errorf("this turn already changed %d tasks; ask the user to continue in another message", maxMutationsPerRequest)
+
+// limited runs change if the turn has made fewer than
+// maxMutationsPerRequest changes, and reports errTooManyChanges otherwise.
+// The slot is claimed before change runs, so concurrent tool calls can't all
+// pass the check before any of them records; a failed change gives it back.
+func (r *assistantRecorder) limited(change func() (any, error)) (any, error) {
+	r.mu.Lock()
+	if r.claimed >= maxMutationsPerRequest {
+		r.mu.Unlock()
+		return nil, errTooManyChanges
+	}
+	r.claimed++
+	r.mu.Unlock()
+
+	out, err := change()
+	if err != nil {
+		r.mu.Lock()
+		r.claimed--
+		r.mu.Unlock()
+	}
+	return out, err
+}
+
+// changeset returns the recorded changes as a changeset, or nil if the turn
+// changed nothing.
+func (r *assistantRecorder) changeset() *AssistantChangeset {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.changes) == 0 {
+		return nil
+	}
+	return &AssistantChangeset{
+		ID:        primitive.NewObjectID(),
+		Changes:   append([]AssistantChange(nil), r.changes...),
+		CreatedAt: xutils.NowUTC(),
+	}
+}
+
+type assistantCreateTaskInput struct {
+	CategoryID    string `json:"categoryId,omitempty" jsonschema_description:"Hex ID of an existing category from the category list. Omit to use categoryName instead."`
+	CategoryName  string `json:"categoryName,omitempty" jsonschema_description:"Name of a new category to create for this task, used only when no existing category fits"`
+	WorkspaceName string `json:"workspaceName,omitempty" jsonschema_description:"Workspace for a new category (e.g. 'Personal', 'Work')"`
+	Content       string `json:"content" jsonschema_description:"The task name"`
+	Priority      int    `json:"priority,omitempty" jsonschema_description:"1=low, 2=medium, 3=high"`
+	Deadline      string `json:"deadline,omitempty" jsonschema_description:"ISO8601 deadline"`
+	StartDate     string `json:"startDate,omitempty" jsonschema_description:"ISO8601 start date"`
+	Notes         string `json:"notes,omitempty"`
+}
+
+type assistantEditTaskInput struct {
+	TaskID  string               `json:"taskId" jsonschema_description:"Hex ID of the task, from getUserActiveTasks"`
+	Updates EditTaskUpdatesLocal `json:"updates" jsonschema_description:"Only the fields to change. Time fields take ISO8601, or an empty string to clear."`
+}
+
+type assistantRescheduleTaskInput struct {
+	TaskID    string  `json:"taskId" jsonschema_description:"Hex ID of the task, from getUserActiveTasks"`
+	Deadline  *string `json:"deadline,omitempty" jsonschema_description:"New ISO8601 deadline; empty string clears it; omit to keep"`
+	StartDate *string `json:"startDate,omitempty" jsonschema_description:"New ISO8601 start date; empty string clears it; omit to keep"`
+	StartTime *string `json:"startTime,omitempty" jsonschema_description:"New ISO8601 start time; empty string clears it; omit to keep"`
+}
+
+type assistantCompleteTaskInput struct {
+	TaskID string `json:"taskId" jsonschema_description:"Hex ID of the task, from getUserActiveTasks"`
+}
+
+// assistantTaskResult is what an action tool reports back to the model.
+type assistantTaskResult struct {
+	TaskID     string     `json:"taskId"`
+	CategoryID string     `json:"categoryId"`
+	Content    string     `json:"content"`
+	Deadline   *time.Time `json:"deadline,omitempty"`
+	StartDate  *time.Time `json:"startDate,omitempty"`
+}
+
+func toAssistantTaskResult(t *TaskDocument) assistantTaskResult {
+	return assistantTaskResult{
+		TaskID:     t.ID.Hex(),
+		CategoryID: t.CategoryID.Hex(),
+		Content:    t.Content,
+		Deadline:   t.Deadline,
+		StartDate:  t.StartDate,
+	}
+}
+
+// assistantTools builds the action tools for one turn, bound to the user and
+// recording every change into rec.
+func (h *Handler) assistantTools(userObjID primitive.ObjectID, timezone string, rec *assistantRecorder) []AssistantTool {
+	return []AssistantTool{
+		{
+			Name:        "createTask",
+			Description: "Creates a task. Use categoryId for an existing category, or categoryName to create a new one.",
+			Input:       assistantCreateTaskInput{},
+			Run: func(ctx context.Context, raw json.RawMessage) (any, error) {
+				var in assistantCreateTaskInput
+				if err := json.Unmarshal(raw, &in); err != nil {
+					return nil, err
+				}
+				return rec.limited(func() (any, error) {
+					task, categoryCreated, err := h.assistantCreateTask(ctx, userObjID, in)
+					if err != nil {
+						return nil, err
+					}
+					rec.record(AssistantChange{
+						Action:          AssistantActionCreated,
+						TaskID:          task.ID,
+						CategoryID:      task.CategoryID,
+						Content:         task.Content,
+						CategoryCreated: categoryCreated,
+					}, task)
+					return toAssistantTaskResult(task), nil
+				})
+			},
+		},
+		{
+			Name:        "editTask",
+			Description: "Edits a task's content, priority, value, notes or dates.",
+			Input:       assistantEditTaskInput{},
+			Run: func(ctx context.Context, raw json.RawMessage) (any, error) {
+				var in assistantEditTaskInput
+				if err := json.Unmarshal(raw, &in); err != nil {
+					return nil, err
+				}
+				for _, v := range []*string{in.Updates.Deadline, in.Updates.StartDate, in.Updates.StartTime} {
+					if err := validateAssistantTime(v); err != nil {
+						return nil, err
+					}
+				}
+				return rec.limited(func() (any, error) {
+					return h.assistantEditTask(ctx, userObjID, in.TaskID, AssistantActionEdited, in.Updates, rec)
+				})
+			},
+		},
+		{
+			Name:        "rescheduleTask",
+			Description: "Moves a task's deadline, start date or start time.",
+			Input:       assistantRescheduleTaskInput{},
+			Run: func(ctx context.Context, raw json.RawMessage) (any, error) {
+				var in assistantRescheduleTaskInput
+				if err := json.Unmarshal(raw, &in); err != nil {
+					return nil, err
+				}
+				if in.Deadline == nil && in.StartDate == nil && in.StartTime == nil {
+					return nil, errors.New("nothing to reschedule: set deadline, startDate or startTime")
+				}
+				for _, v := range []*string{in.Deadline, in.StartDate, in.StartTime} {
+					if err := validateAssistantTime(v); err != nil {
+						return nil, err
+					}
+				}
+				updates := EditTaskUpdatesLocal{Deadline: in.Deadline, StartDate: in.StartDate, StartTime: in.StartTime}
+				return rec.limited(func() (any, error) {
+					return h.assistantEditTask(ctx, userObjID, in.TaskID, AssistantActionRescheduled, updates, rec)
+				})
+			},
+		},
+		{
+			Name:        "completeTask",
+			Description: "Marks a task as done.",
+			Input:       assistantCompleteTaskInput{},
+			Run: func(ctx context.Context, raw json.RawMessage) (any, error) {
+				var in assistantCompleteTaskInput
+				if err := json.Unmarshal(raw, &in); err != nil {
+					return nil, err
+				}
+				return rec.limited(func() (any, error) {
+					return h.assistantCompleteTask(ctx, userObjID, timezone, in.TaskID, rec)
+				})
+			},
+		},
+	}
+}
+
+func validateAssistantTime(v *string) error {
+	if v == nil || *v == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, *v)
+	if err != nil {
+		return fmt.Errorf("%q is not an ISO8601 datetime", *v)
+	}
+	return checkAssistantTimeBounds(t, *v)
+}
+
+// checkAssistantTimeBounds rejects dates no plan would use; see
+// modelDateInBounds.
+func checkAssistantTimeBounds(t time.Time, v string) error {
+	if !modelDateInBounds(t, time.Now()) {
+		return fmt.Errorf("%q is too far from today", v)
+	}
+	return nil
+}
+
+func parseAssistantTime(v string) (*time.Time, error) {
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not an ISO8601 datetime", v)
+	}
+	if err := checkAssistantTimeBounds(t, v); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// assistantCreateTask creates the task, creating or reusing a category by name
+// when no category ID is given. It reports whether a category was created.
+func (h *Handler) assistantCreateTask(ctx context.Context, userObjID primitive.ObjectID, in assistantCreateTaskInput) (*TaskDocument, bool, error) {
+	if in.Content == "" {
+		return nil, false, errors.New("content is required")
+	}
+	deadline, err := parseAssistantTime(in.Deadline)
+	if err != nil {
+		return nil, false, err
+	}
+	startDate, err := parseAssistantTime(in.StartDate)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var categoryID primitive.ObjectID
+	categoryCreated := false
+	switch {
+	case in.CategoryID != "":
+		categoryID, err = primitive.ObjectIDFromHex(in.CategoryID)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid categoryId %q", in.CategoryID)
+		}
+		if err := h.service.verifyCategoryOwnership(ctx, categoryID, userObjID); err != nil {
+			return nil, false, fmt.Errorf("category %s not found", in.CategoryID)
+		}
+	case in.CategoryName != "":
+		categoryID, categoryCreated, err = h.findOrCreateCategory(ctx, userObjID, in.CategoryName, in.WorkspaceName)
+		if err != nil {
+			return nil, false, err
+		}
+	default:
+		return nil, false, errors.New("either categoryId or categoryName is required")
+	}
+
+	params := CreateTaskParams{
+		Content:   in.Content,
+		Priority:  in.Priority,
+		Deadline:  deadline,
+		StartDate: startDate,
+		Notes:     in.Notes,
+	}
+	validateAndSetTaskDefaults(&params)
+	task := buildTaskDocument(params, userObjID, categoryID)
+	if _, err := h.service.CreateTask(categoryID, &task); err != nil {
+		return nil, false, err
+	}
+	return &task, categoryCreated, nil
+}
+
+func (h *Handler) findOrCreateCategory(ctx context.Context, userObjID primitive.ObjectID, name, workspace string) (primitive.ObjectID, bool, error) {
+	var existing types.CategoryDocument
+	err := h.service.Tasks.FindOne(ctx, bson.M{
+		"user": userObjID,
+		"name": primitive.Regex{Pattern: "^" + regexp.QuoteMeta(name) + "$", Options: "i"},
+	}).Decode(&existing)
+	if err == nil {
+		return existing.ID, false, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return primitive.NilObjectID, false, err
+	}
+
+	if workspace == "" {
+		workspace = "General"
+	}
+	category := types.CategoryDocument{
+		ID:            primitive.NewObjectID(),
+		Name:          name,
+		WorkspaceName: workspace,
+		User:          userObjID,
+		Tasks:         make([]TaskDocument, 0),
+		LastEdited:    time.Now().UTC(),
+	}
+	if _, err := h.service.Tasks.InsertOne(ctx, category); err != nil {
+		return primitive.NilObjectID, false, fmt.Errorf("failed to create category %s: %w", name, err)
+	}
+	return category.ID, true, nil
+}
+
+// assistantEditTask snapshots the task, applies the updates through the same
+// path as the edit flow, and records the change.
+func (h *Handler) assistantEditTask(ctx context.Context, userObjID primitive.ObjectID, taskIDHex, action string, updates EditTaskUpdatesLocal, rec *assistantRecorder) (any, error) {
+	before, err := h.assistantLocateTask(ctx, userObjID, taskIDHex)
+	if err != nil {
+		return nil, err
+	}
+	edited, _, _ := h.applyEditInstructions(ctx, userObjID, userObjID.Hex(), &EditTasksFlowOutputLocal{
+		Instructions: []EditTaskInstructionLocal{{
+			TaskID:     before.ID.Hex(),
+			CategoryID: before.CategoryID.Hex(),
+			Updates:    updates,
+		}},
+	})
+	if len(edited) == 0 {
+		return nil, fmt.Errorf("failed to update task %s", taskIDHex)
+	}
+	after := edited[0]
+	after.CategoryID = before.CategoryID
+
+	rec.record(AssistantChange{
+		Action:     action,
+		TaskID:     before.ID,
+		CategoryID: before.CategoryID,
+		Content:    after.Content,
+		Before:     before,
+	}, &after)
+	return toAssistantTaskResult(&after), nil
+}
+
+// assistantCompleteTask completes the task the way the complete endpoint does:
+// archive it to completed-tasks, remove it from its category and credit the
+// Do ring.
+func (h *Handler) assistantCompleteTask(ctx context.Context, userObjID primitive.ObjectID, timezone, taskIDHex string, rec *assistantRecorder) (any, error) {
+	before, err := h.assistantLocateTask(ctx, userObjID, taskIDHex)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.service.CompleteTask(userObjID, before.ID, before.CategoryID, CompleteTaskDocument{
+		TimeCompleted: xutils.NowUTC().Format(time.RFC3339),
+		TimeTaken:     "PT0S",
+	}); err != nil {
+		return nil, fmt.Errorf("failed to complete task: %w", err)
+	}
+	if err := h.service.DeleteTask(before.CategoryID, before.ID); err != nil {
+		return nil, fmt.Errorf("task was completed but could not be removed: %w", err)
+	}
+	if h.service.RingService != nil {
+		if _, delta, err := h.service.RingService.IncrementRing(ctx, userObjID, timezone, rings.RingDo); err == nil && delta.JustClosedAll {
+			h.service.RingService.NotifyAllRingsClosed(userObjID)
+		}
+	}
+
+	rec.record(AssistantChange{
+		Action:     AssistantActionCompleted,
+		TaskID:     before.ID,
+		CategoryID: before.CategoryID,
+		Content:    before.Content,
+		Before:     before,
+	}, before)
+	return toAssistantTaskResult(before), nil
+}
+
+func (h *Handler) assistantLocateTask(ctx context.Context, userObjID primitive.ObjectID, taskIDHex string) (*TaskDocument, error) {
+	taskID, err := primitive.ObjectIDFromHex(taskIDHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid taskId %q", taskIDHex)
+	}
+	task, err := h.service.locateUserTask(ctx, userObjID, taskID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("task %s not found; look it up with getUserActiveTasks", taskIDHex)
+	}
+	return task, err
+}
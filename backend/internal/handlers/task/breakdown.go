@@ -0,0 +1,142 @@
+package task
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	maxBreakdownSteps       = 12
+	maxBreakdownStepLength  = 200
+	minBreakdownStepMinutes = 5
+	maxBreakdownStepMinutes = 240
+
+	// defaultBreakdownStepMinutes stands in for a step the model gave no
+	// estimate for.
+	defaultBreakdownStepMinutes = 15
+)
+
+// TaskBreakdownRequest is the task to break down, as the model sees it.
+type TaskBreakdownRequest struct {
+	UserID    string
+	Timezone  string
+	Content   string
+	Notes     string
+	Deadline  string
+	Priority  int
+	Value     float64
+	Checklist []string
+	Guidance  string
+}
+
+// BreakdownStepLocal mirrors gemini.BreakdownStepOutput.
+type BreakdownStepLocal struct {
+	Content          string `json:"content"`
+	EstimatedMinutes int    `json:"estimatedMinutes"`
+}
+
+// TaskBreakdownLocal mirrors gemini.BreakdownTaskOutput.
+type TaskBreakdownLocal struct {
+	Steps   []BreakdownStepLocal `json:"steps"`
+	Summary string               `json:"summary"`
+}
+
+// BreakdownStep is one proposed checklist item in a breakdown preview.
+type BreakdownStep struct {
+	Content          string `json:"content" doc:"The step, phrased as an action"`
+	EstimatedMinutes int    `json:"estimatedMinutes" doc:"Suggested minutes for this step"`
+	Order            int    `json:"order" doc:"Position in the proposed sequence, from 0"`
+}
+
+// newBreakdownRequest describes task for the breakdown flow.
+func newBreakdownRequest(userID, timezone, guidance string, task *TaskDocument) TaskBreakdownRequest {
+	req := TaskBreakdownRequest{
+		UserID:   userID,
+		Timezone: timezone,
+		Content:  task.Content,
+		Notes:    task.Notes,
+		Priority: task.Priority,
+		Value:    task.Value,
+		Guidance: guidance,
+	}
+	if task.Deadline != nil {
+		req.Deadline = task.Deadline.UTC().Format(time.RFC3339)
+	}
+	for _, item := range task.Checklist {
+		req.Checklist = append(req.Checklist, item.Content)
+	}
+	return req
+}
+
+// sanitizeBreakdown turns the model's steps into a preview: blank steps,
+// repeats and steps the task's checklist already has are dropped, long
+// steps are truncated, estimates are clamped to a sane range and the list is
+// capped.
+func sanitizeBreakdown(raw TaskBreakdownLocal, existing []ChecklistItem) []BreakdownStep {
+	seen := make(map[string]bool, len(existing)+len(raw.Steps))
+	for _, item := range existing {
+		seen[checklistKey(item.Content)] = true
+	}
+
+	steps := []BreakdownStep{}
+	for _, s := range raw.Steps {
+		content := strings.TrimSpace(s.Content)
+		if runes := []rune(content); len(runes) > maxBreakdownStepLength {
+			content = strings.TrimSpace(string(runes[:maxBreakdownStepLength]))
+		}
+		key := checklistKey(content)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		minutes := s.EstimatedMinutes
+		switch {
+		case minutes <= 0:
+			minutes = defaultBreakdownStepMinutes
+		case minutes < minBreakdownStepMinutes:
+			minutes = minBreakdownStepMinutes
+		case minutes > maxBreakdownStepMinutes:
+			minutes = maxBreakdownStepMinutes
+		}
+
+		steps = append(steps, BreakdownStep{Content: content, EstimatedMinutes: minutes, Order: len(steps)})
+		if len(steps) == maxBreakdownSteps {
+			break
+		}
+	}
+	return steps
+}
+
+// mergeBreakdown builds the checklist to save from the user's edited steps.
+// Unless replace is set the steps go after the existing items, skipping any
+// the checklist already has; orders are renumbered either way.
+func mergeBreakdown(existing []ChecklistItem, steps []string, replace bool) []ChecklistItem {
+	checklist := []ChecklistItem{}
+	seen := map[string]bool{}
+	if !replace {
+		for _, item := range existing {
+			checklist = append(checklist, item)
+			seen[checklistKey(item.Content)] = true
+		}
+	}
+	for _, s := range steps {
+		content := strings.TrimSpace(s)
+		key := checklistKey(content)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		checklist = append(checklist, ChecklistItem{Content: content})
+	}
+	for i := range checklist {
+		checklist[i].Order = i
+	}
+	return checklist
+}
+
+// checklistKey compares checklist items loosely: case and inner spacing
+// don't make two steps different.
+func checklistKey(content string) string {
+	return strings.ToLower(strings.Join(strings.Fields(content), " "))
+}
@@ -0,0 +1,80 @@
+package task
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeBreakdown_DropsRepeatsAndExistingSteps(t *testing.T) {
+	existing := []ChecklistItem{{Content: "Book a moving van", Order: 0}}
+	raw := TaskBreakdownLocal{Steps: []BreakdownStepLocal{
+		{Content: "  book a  Moving van ", EstimatedMinutes: 20},
+		{Content: "Pack the kitchen", EstimatedMinutes: 90},
+		{Content: "pack the kitchen", EstimatedMinutes: 60},
+		{Content: "   ", EstimatedMinutes: 10},
+		{Content: "Forward mail", EstimatedMinutes: 10},
+	}}
+
+	steps := sanitizeBreakdown(raw, existing)
+
+	assert.Equal(t, []BreakdownStep{
+		{Content: "Pack the kitchen", EstimatedMinutes: 90, Order: 0},
+		{Content: "Forward mail", EstimatedMinutes: 10, Order: 1},
+	}, steps)
+}
+
+func TestSanitizeBreakdown_ClampsEstimates(t *testing.T) {
+	raw := TaskBreakdownLocal{Steps: []BreakdownStepLocal{
+		{Content: "Unestimated", EstimatedMinutes: 0},
+		{Content: "Tiny", EstimatedMinutes: 1},
+		{Content: "Huge", EstimatedMinutes: 600},
+	}}
+
+	steps := sanitizeBreakdown(raw, nil)
+
+	assert.Equal(t, defaultBreakdownStepMinutes, steps[0].EstimatedMinutes)
+	assert.Equal(t, minBreakdownStepMinutes, steps[1].EstimatedMinutes)
+	assert.Equal(t, maxBreakdownStepMinutes, steps[2].EstimatedMinutes)
+}
+
+func TestSanitizeBreakdown_CapsAndTruncates(t *testing.T) {
+	raw := TaskBreakdownLocal{}
+	for i := 0; i < maxBreakdownSteps+5; i++ {
+		raw.Steps = append(raw.Steps, BreakdownStepLocal{Content: strings.Repeat("é", maxBreakdownStepLength+10) + string(rune('a'+i))})
+	}
+
+	steps := sanitizeBreakdown(raw, nil)
+
+	assert.Len(t, steps, 1, "truncation makes every step identical")
+	assert.Equal(t, maxBreakdownStepLength, len([]rune(steps[0].Content)))
+
+	raw.Steps = raw.Steps[:0]
+	for i := 0; i < maxBreakdownSteps+5; i++ {
+		raw.Steps = append(raw.Steps, BreakdownStepLocal{Content: "Step " + string(rune('a'+i))})
+	}
+	assert.Len(t, sanitizeBreakdown(raw, nil), maxBreakdownSteps)
+}
+
+func TestMergeBreakdown_AppendsAfterExisting(t *testing.T) {
+	existing := []ChecklistItem{{Content: "Book a moving van", Completed: true, Order: 0}}
+
+	checklist := mergeBreakdown(existing, []string{"Pack the kitchen", "book a moving van", " "}, false)
+
+	assert.Equal(t, []ChecklistItem{
+		{Content: "Book a moving van", Completed: true, Order: 0},
+		{Content: "Pack the kitchen", Order: 1},
+	}, checklist)
+}
+
+func TestMergeBreakdown_Replaces(t *testing.T) {
+	existing := []ChecklistItem{{Content: "Old step", Order: 0}}
+
+	checklist := mergeBreakdown(existing, []string{"Pack the kitchen", "Forward mail"}, true)
+
+	assert.Equal(t, []ChecklistItem{
+		{Content: "Pack the kitchen", Order: 0},
+		{Content: "Forward mail", Order: 1},
+	}, checklist)
+}
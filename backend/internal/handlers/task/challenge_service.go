@@ -0,0 +1,118 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/xutils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ChallengeTask describes what a group challenge puts in a participant's
+// workspace: a category holding one flex task, backed by its template.
+type ChallengeTask struct {
+	ChallengeID primitive.ObjectID
+	Workspace   string
+	Content     string
+	Flex        FlexDetails
+}
+
+// MaterializeChallenge creates the challenge's category, flex template and
+// first task instance for the user, the way SubscribeToBlueprint copies a
+// blueprint's categories. Instances generated later carry the challenge ID
+// over from the template.
+func (s *Service) MaterializeChallenge(userID primitive.ObjectID, c ChallengeTask) (*types.CategoryDocument, error) {
+	category, err := s.materializeChallenge(userID, c)
+	if err != nil {
+		// Don't leave a half-built category or template behind for the
+		// caller to trip over; everything created so far carries the
+		// challenge ID.
+		if cleanupErr := s.RemoveChallenge(userID, c.ChallengeID); cleanupErr != nil {
+			slog.Error("Failed to clean up partial challenge", "challengeId", c.ChallengeID.Hex(), "userId", userID.Hex(), "error", cleanupErr)
+		}
+		return nil, err
+	}
+	return category, nil
+}
+
+func (s *Service) materializeChallenge(userID primitive.ObjectID, c ChallengeTask) (*types.CategoryDocument, error) {
+	ctx := context.Background()
+	now := xutils.NowUTC()
+
+	recurDetails := &RecurDetails{Every: 1, Behavior: "ROLLING", Flex: &c.Flex}
+	if err := ValidateRecurDetails(c.Flex.Period, recurDetails); err != nil {
+		return nil, err
+	}
+
+	category := types.CategoryDocument{
+		ID:            primitive.NewObjectID(),
+		Name:          c.Content,
+		WorkspaceName: c.Workspace,
+		LastEdited:    now,
+		Tasks:         []TaskDocument{},
+		User:          userID,
+		ChallengeID:   &c.ChallengeID,
+	}
+	if _, err := s.Tasks.InsertOne(ctx, category); err != nil {
+		return nil, fmt.Errorf("failed to create challenge category: %w", err)
+	}
+
+	templateID := primitive.NewObjectID()
+	template, err := s.newFlexTemplate(userID, category.ID, templateID, c.Content, 2, 5, true, recurDetails, "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	template.ChallengeID = &c.ChallengeID
+	if _, err := s.CreateTemplateTask(category.ID, template); err != nil {
+		return nil, fmt.Errorf("failed to create challenge template: %w", err)
+	}
+
+	task := TaskDocument{
+		ID:             primitive.NewObjectID(),
+		Priority:       2,
+		Content:        c.Content,
+		Value:          5,
+		Recurring:      true,
+		RecurType:      "FLEX",
+		RecurFrequency: c.Flex.Period,
+		RecurDetails:   recurDetails,
+		Public:         true,
+		UserID:         userID,
+		CategoryID:     category.ID,
+		TemplateID:     &templateID,
+		StartDate:      &now,
+		Timestamp:      now,
+		LastEdited:     now,
+		ChallengeID:    &c.ChallengeID,
+		FlexInfo: &FlexInstanceInfo{
+			InstanceNumber: 1,
+			Target:         c.Flex.Target,
+			Period:         c.Flex.Period,
+		},
+	}
+	if _, err := s.CreateTask(category.ID, &task); err != nil {
+		return nil, fmt.Errorf("failed to create challenge task: %w", err)
+	}
+
+	category.Tasks = append(category.Tasks, task)
+	return &category, nil
+}
+
+// RemoveChallenge deletes what MaterializeChallenge created for the user.
+// Tasks already completed stay in their history.
+func (s *Service) RemoveChallenge(userID, challengeID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := s.TemplateTasks.DeleteMany(ctx, bson.M{"userID": userID, "challengeId": challengeID}); err != nil {
+		return fmt.Errorf("failed to delete challenge templates: %w", err)
+	}
+	if _, err := s.Tasks.DeleteMany(ctx, bson.M{"user": userID, "challengeId": challengeID}); err != nil {
+		return fmt.Errorf("failed to delete challenge categories: %w", err)
+	}
+	return nil
+}
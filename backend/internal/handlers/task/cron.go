@@ -16,8 +16,7 @@ Cron sets up periodic background jobs for tasks, reminders, and checkins.
 func Cron(collections map[string]*mongo.Collection) *cron.Cron {
 	service := newService(collections, nil)
 	handler := Handler{
-		service:       service,
-		geminiService: nil,
+		service: service,
 	}
 
 	c := cron.New()
@@ -0,0 +1,317 @@
+package task
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// DuplicateMethodEmbedding and DuplicateMethodLexical say how a duplicate
+	// check scored its matches.
+	DuplicateMethodEmbedding = "embedding"
+	DuplicateMethodLexical   = "lexical"
+
+	// Minimum scores for a likely duplicate. Embedding cosine similarity of
+	// short task titles runs high, so its bar is higher than the lexical one.
+	embeddingDuplicateThreshold = 0.86
+	lexicalDuplicateThreshold   = 0.6
+
+	maxDuplicateMatches = 3
+	embedBatchSize      = 100
+)
+
+// EmbeddingsLocal mirrors gemini.EmbedOutput.
+type EmbeddingsLocal struct {
+	Model   string      `json:"model"`
+	Vectors [][]float32 `json:"vectors"`
+}
+
+// DuplicateMatch is an existing active task that looks like a candidate.
+type DuplicateMatch struct {
+	TaskID     string  `json:"taskId"`
+	CategoryID string  `json:"categoryId"`
+	Content    string  `json:"content"`
+	Score      float64 `json:"score" doc:"Similarity from 0 to 1"`
+}
+
+// DuplicateCheck lists the likely duplicates of one candidate task, best first.
+type DuplicateCheck struct {
+	Content string           `json:"content" doc:"The candidate task text, as sent"`
+	Matches []DuplicateMatch `json:"matches"`
+}
+
+// taskEmbeddingDocument caches a task's vector. ContentHash and Model are
+// checked on read, so an entry for edited content or an old model is never
+// used even if the edit-time invalidation missed it.
+type taskEmbeddingDocument struct {
+	TaskID      primitive.ObjectID `bson:"_id"`
+	UserID      primitive.ObjectID `bson:"user_id"`
+	Model       string             `bson:"model"`
+	ContentHash string             `bson:"content_hash"`
+	Vector      []float32          `bson:"vector"`
+	UpdatedAt   time.Time          `bson:"updated_at"`
+}
+
+// FindDuplicates compares candidate task texts with the user's active tasks
+// and returns one check per candidate, plus the method used. Embeddings are
+// used when nlp can produce them; otherwise, or if embedding fails, matching
+// falls back to token overlap.
+func (s *Service) FindDuplicates(ctx context.Context, nlp NLPService, userID primitive.ObjectID, contents []string) ([]DuplicateCheck, string, error) {
+	tasks, err := s.GetActiveTasks(userID)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(tasks) == 0 || len(contents) == 0 {
+		return emptyDuplicateChecks(contents), DuplicateMethodLexical, nil
+	}
+
+	checks, err := s.embeddingDuplicates(ctx, nlp, userID, contents, tasks)
+	if err == nil {
+		return checks, DuplicateMethodEmbedding, nil
+	}
+	if !errors.Is(err, ErrNLPUnavailable) && !errors.Is(err, ErrEmbeddingsUnavailable) {
+		slog.Warn("Embedding duplicate check failed, using lexical matching", "userId", userID.Hex(), "error", err)
+	}
+	return rankDuplicates(contents, tasks, lexicalScorer(contents, tasks), lexicalDuplicateThreshold), DuplicateMethodLexical, nil
+}
+
+func (s *Service) embeddingDuplicates(ctx context.Context, nlp NLPService, userID primitive.ObjectID, contents []string, tasks []TaskDocument) ([]DuplicateCheck, error) {
+	candidates, err := nlp.Embed(ctx, userID.Hex(), contents)
+	if err != nil {
+		return nil, err
+	}
+	taskVectors, err := s.taskVectors(ctx, nlp, userID, candidates.Model, tasks)
+	if err != nil {
+		return nil, err
+	}
+	score := func(i, j int) float64 {
+		v, ok := taskVectors[tasks[j].ID]
+		if !ok {
+			return 0
+		}
+		return cosineSimilarity(candidates.Vectors[i], v)
+	}
+	return rankDuplicates(contents, tasks, score, embeddingDuplicateThreshold), nil
+}
+
+// taskVectors returns a vector per task, reading the cache and embedding (and
+// caching) only tasks whose entry is missing or stale.
+func (s *Service) taskVectors(ctx context.Context, nlp NLPService, userID primitive.ObjectID, model string, tasks []TaskDocument) (map[primitive.ObjectID][]float32, error) {
+	vectors := make(map[primitive.ObjectID][]float32, len(tasks))
+	ids := make([]primitive.ObjectID, len(tasks))
+	for i, t := range tasks {
+		ids[i] = t.ID
+	}
+
+	if s.TaskEmbeddings != nil {
+		cursor, err := s.TaskEmbeddings.Find(ctx, bson.M{"_id": bson.M{"$in": ids}, "user_id": userID, "model": model})
+		if err != nil {
+			return nil, err
+		}
+		var cached []taskEmbeddingDocument
+		if err := cursor.All(ctx, &cached); err != nil {
+			return nil, err
+		}
+		hashes := make(map[primitive.ObjectID]string, len(tasks))
+		for _, t := range tasks {
+			hashes[t.ID] = contentHash(t.Content)
+		}
+		for _, c := range cached {
+			if hashes[c.TaskID] == c.ContentHash {
+				vectors[c.TaskID] = c.Vector
+			}
+		}
+	}
+
+	var missing []TaskDocument
+	for _, t := range tasks {
+		if _, ok := vectors[t.ID]; !ok {
+			missing = append(missing, t)
+		}
+	}
+	for start := 0; start < len(missing); start += embedBatchSize {
+		batch := missing[start:min(start+embedBatchSize, len(missing))]
+		texts := make([]string, len(batch))
+		for i, t := range batch {
+			texts[i] = t.Content
+		}
+		out, err := nlp.Embed(ctx, userID.Hex(), texts)
+		if err != nil {
+			return nil, err
+		}
+		now := time.Now().UTC()
+		for i, t := range batch {
+			vectors[t.ID] = out.Vectors[i]
+			s.cacheTaskVector(ctx, taskEmbeddingDocument{
+				TaskID:      t.ID,
+				UserID:      userID,
+				Model:       out.Model,
+				ContentHash: contentHash(t.Content),
+				Vector:      out.Vectors[i],
+				UpdatedAt:   now,
+			})
+		}
+	}
+	return vectors, nil
+}
+
+func (s *Service) cacheTaskVector(ctx context.Context, doc taskEmbeddingDocument) {
+	if s.TaskEmbeddings == nil {
+		return
+	}
+	_, err := s.TaskEmbeddings.ReplaceOne(ctx, bson.M{"_id": doc.TaskID}, doc, options.Replace().SetUpsert(true))
+	if err != nil {
+		slog.Warn("Failed to cache task embedding", "taskId", doc.TaskID.Hex(), "error", err)
+	}
+}
+
+// invalidateTaskEmbedding drops the cached vectors of tasks once they are
+// completed or removed.
+func (s *Service) invalidateTaskEmbedding(ctx context.Context, taskIDs ...primitive.ObjectID) {
+	if len(taskIDs) == 0 {
+		return
+	}
+	s.deleteTaskEmbedding(ctx, bson.M{"_id": bson.M{"$in": taskIDs}})
+}
+
+// invalidateTaskEmbeddingIfEdited drops a task's cached vector if it was
+// computed for content other than content.
+func (s *Service) invalidateTaskEmbeddingIfEdited(ctx context.Context, taskID primitive.ObjectID, content string) {
+	s.deleteTaskEmbedding(ctx, bson.M{"_id": taskID, "content_hash": bson.M{"$ne": contentHash(content)}})
+}
+
+func (s *Service) deleteTaskEmbedding(ctx context.Context, filter bson.M) {
+	if s.TaskEmbeddings == nil {
+		return
+	}
+	if _, err := s.TaskEmbeddings.DeleteMany(ctx, filter); err != nil {
+		slog.Warn("Failed to invalidate task embedding", "filter", filter, "error", err)
+	}
+}
+
+// rankDuplicates keeps, for each candidate, the best-scoring tasks at or above
+// threshold. score(i, j) compares contents[i] with tasks[j].
+func rankDuplicates(contents []string, tasks []TaskDocument, score func(i, j int) float64, threshold float64) []DuplicateCheck {
+	checks := emptyDuplicateChecks(contents)
+	for i := range contents {
+		for j, t := range tasks {
+			sc := score(i, j)
+			if sc < threshold {
+				continue
+			}
+			checks[i].Matches = append(checks[i].Matches, DuplicateMatch{
+				TaskID:     t.ID.Hex(),
+				CategoryID: t.CategoryID.Hex(),
+				Content:    t.Content,
+				Score:      math.Round(sc*1000) / 1000,
+			})
+		}
+		sort.SliceStable(checks[i].Matches, func(a, b int) bool {
+			return checks[i].Matches[a].Score > checks[i].Matches[b].Score
+		})
+		if len(checks[i].Matches) > maxDuplicateMatches {
+			checks[i].Matches = checks[i].Matches[:maxDuplicateMatches]
+		}
+	}
+	return checks
+}
+
+func emptyDuplicateChecks(contents []string) []DuplicateCheck {
+	checks := make([]DuplicateCheck, len(contents))
+	for i, c := range contents {
+		checks[i] = DuplicateCheck{Content: c, Matches: []DuplicateMatch{}}
+	}
+	return checks
+}
+
+// lexicalScorer scores by overlap of meaningful words, tokenizing each text
+// once up front.
+func lexicalScorer(contents []string, tasks []TaskDocument) func(i, j int) float64 {
+	candidateTokens := make([]map[string]bool, len(contents))
+	for i, c := range contents {
+		candidateTokens[i] = duplicateTokens(c)
+	}
+	taskTokens := make([]map[string]bool, len(tasks))
+	for j, t := range tasks {
+		taskTokens[j] = duplicateTokens(t.Content)
+	}
+	return func(i, j int) float64 {
+		return diceCoefficient(candidateTokens[i], taskTokens[j])
+	}
+}
+
+// duplicateStopwords don't distinguish one task from another.
+var duplicateStopwords = map[string]bool{
+	"a": true, "an": true, "the": true, "to": true, "for": true, "of": true,
+	"and": true, "my": true, "on": true, "in": true, "at": true, "with": true,
+	"up": true, "do": true, "get": true, "go": true,
+}
+
+// duplicateTokens lowercases text and splits it into words, dropping
+// punctuation and stopwords and folding simple plurals.
+func duplicateTokens(text string) map[string]bool {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	tokens := make(map[string]bool, len(words))
+	for _, w := range words {
+		if duplicateStopwords[w] {
+			continue
+		}
+		switch {
+		case len(w) > 4 && strings.HasSuffix(w, "ies"):
+			w = strings.TrimSuffix(w, "ies") + "y"
+		case len(w) > 3 && strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "ss"):
+			w = strings.TrimSuffix(w, "s")
+		}
+		tokens[w] = true
+	}
+	return tokens
+}
+
+// diceCoefficient is 2|A∩B| / (|A|+|B|).
+func diceCoefficient(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for w := range a {
+		if b[w] {
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(a)+len(b))
+}
+
+func cosineSimilarity(a, b []float32) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
+
+func contentHash(content string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(content)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,57 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func activeTasks(contents ...string) []TaskDocument {
+	tasks := make([]TaskDocument, len(contents))
+	for i, c := range contents {
+		tasks[i] = TaskDocument{ID: primitive.NewObjectID(), CategoryID: primitive.NewObjectID(), Content: c}
+	}
+	return tasks
+}
+
+func TestLexicalDuplicates_MatchesRewordedTasks(t *testing.T) {
+	tasks := activeTasks("Call Mom!", "Buy groceries", "Call the dentist")
+	contents := []string{"call mom", "buy grocery", "call dad"}
+
+	checks := rankDuplicates(contents, tasks, lexicalScorer(contents, tasks), lexicalDuplicateThreshold)
+
+	assert.Len(t, checks, 3)
+	if assert.Len(t, checks[0].Matches, 1) {
+		assert.Equal(t, tasks[0].ID.Hex(), checks[0].Matches[0].TaskID)
+		assert.Equal(t, 1.0, checks[0].Matches[0].Score)
+	}
+	if assert.Len(t, checks[1].Matches, 1, "plural and singular should match") {
+		assert.Equal(t, tasks[1].ID.Hex(), checks[1].Matches[0].TaskID)
+	}
+	assert.Empty(t, checks[2].Matches, "sharing only the verb is not a duplicate")
+	assert.NotNil(t, checks[2].Matches, "no matches is an empty list, not null")
+}
+
+func TestRankDuplicates_SortsAndCaps(t *testing.T) {
+	tasks := activeTasks("a", "b", "c", "d", "e")
+	scores := []float64{0.7, 0.95, 0.5, 0.9, 0.8}
+
+	checks := rankDuplicates([]string{"x"}, tasks, func(i, j int) float64 { return scores[j] }, 0.6)
+
+	matches := checks[0].Matches
+	assert.Len(t, matches, maxDuplicateMatches)
+	assert.Equal(t, []string{"b", "d", "e"}, []string{matches[0].Content, matches[1].Content, matches[2].Content})
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	assert.InDelta(t, 1.0, cosineSimilarity([]float32{1, 2}, []float32{2, 4}), 1e-9)
+	assert.InDelta(t, 0.0, cosineSimilarity([]float32{1, 0}, []float32{0, 1}), 1e-9)
+	assert.Equal(t, 0.0, cosineSimilarity([]float32{1, 0}, []float32{1, 0, 0}), "mismatched dimensions")
+	assert.Equal(t, 0.0, cosineSimilarity([]float32{0, 0}, []float32{1, 0}), "zero vector")
+}
+
+func TestContentHash_IgnoresSurroundingSpace(t *testing.T) {
+	assert.Equal(t, contentHash("call mom"), contentHash("  call mom\n"))
+	assert.NotEqual(t, contentHash("call mom"), contentHash("call dad"))
+}
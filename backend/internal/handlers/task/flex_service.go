@@ -26,11 +26,39 @@ func (s *Service) createFlexTemplateForTask(
 	checklist []ChecklistItem,
 	taggedUsers []TaggedTaskUser,
 ) error {
+	templateDoc, err := s.newFlexTemplate(userID, categoryID, templateID, content, priority, value, public, recurDetails, notes, checklist, taggedUsers)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.CreateTemplateTask(categoryID, templateDoc)
+	if err != nil {
+		return fmt.Errorf("error creating flex template task: %w", err)
+	}
+
+	return nil
+}
+
+// newFlexTemplate builds, without saving, the template createFlexTemplateForTask
+// inserts, with its first period starting now in the user's timezone.
+func (s *Service) newFlexTemplate(
+	userID primitive.ObjectID,
+	categoryID primitive.ObjectID,
+	templateID primitive.ObjectID,
+	content string,
+	priority int,
+	value float64,
+	public bool,
+	recurDetails *RecurDetails,
+	notes string,
+	checklist []ChecklistItem,
+	taggedUsers []TaggedTaskUser,
+) (*TemplateTaskDocument, error) {
 	flex := recurDetails.Flex
 
 	strategy, err := FlexPeriodFor(flex.Period)
 	if err != nil {
-		return fmt.Errorf("invalid flex period %q: %w", flex.Period, err)
+		return nil, fmt.Errorf("invalid flex period %q: %w", flex.Period, err)
 	}
 
 	ctx := context.Background()
@@ -67,13 +95,7 @@ func (s *Service) createFlexTemplateForTask(
 		HighestStreak:   0,
 		CompletionDates: []time.Time{},
 	}
-
-	_, err = s.CreateTemplateTask(categoryID, &templateDoc)
-	if err != nil {
-		return fmt.Errorf("error creating flex template task: %w", err)
-	}
-
-	return nil
+	return &templateDoc, nil
 }
 
 func (s *Service) createFlexTaskFromTemplate(ctx context.Context, templateDoc *TemplateTaskDocument, task TaskDocument) (*TaskDocument, error) {
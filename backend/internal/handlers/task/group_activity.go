@@ -0,0 +1,82 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/xutils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// groupActivityNotifyCooldown caps a user's completions to one wave of
+// group activity notifications per 12h; the group's activity stream still
+// shows every one.
+const groupActivityNotifyCooldown = 12 * time.Hour
+
+// notifyGroupsOfCompletion tells members of the owner's groups who follow
+// group activity that a public task was completed. Push + GROUP_ACTIVITY
+// record; best-effort.
+func (s *Service) notifyGroupsOfCompletion(task TaskDocument, owner types.User) {
+	if s.Audiences == nil || s.NotificationService == nil {
+		return
+	}
+	ctx := context.Background()
+
+	subscribers, err := s.Audiences.GroupActivitySubscribers(ctx, owner.ID)
+	if err != nil {
+		slog.Error("Failed to load group activity subscribers", "user", owner.ID.Hex(), "error", err)
+		return
+	}
+	if len(subscribers) == 0 {
+		return
+	}
+
+	// Claimed only once there is someone to tell, so a completion nobody
+	// hears about doesn't spend the wave.
+	claimed, err := s.Users.ClaimGroupActivityNotify(ctx, owner.ID, time.Now().UTC(), groupActivityNotifyCooldown)
+	if err != nil {
+		slog.Error("Failed to claim group activity wave", "user", owner.ID.Hex(), "error", err)
+		return
+	}
+	if !claimed {
+		slog.Info("Group activity wave suppressed (cooldown)", "user", owner.ID.Hex())
+		return
+	}
+
+	receivers := make([]primitive.ObjectID, 0, len(subscribers))
+	for id := range subscribers {
+		receivers = append(receivers, id)
+	}
+	tokens, err := s.Users.GetPushTokens(ctx, receivers)
+	if err != nil {
+		slog.Error("Failed to load group activity push tokens", "user", owner.ID.Hex(), "error", err)
+	}
+
+	content := fmt.Sprintf("completed \"%s\"", task.Content)
+	for _, receiverID := range receivers {
+		if err := s.NotificationService.CreateNotification(
+			owner.ID, receiverID, content, notifications.NotificationTypeGroupActivity, task.ID,
+		); err != nil {
+			slog.Error("Failed to create GROUP_ACTIVITY record", "receiver", receiverID, "error", err)
+		}
+
+		token := tokens[receiverID]
+		if token == "" {
+			continue
+		}
+		_ = xutils.SendNotification(xutils.Notification{
+			Token:   token,
+			Title:   "Group activity",
+			Message: fmt.Sprintf("%s completed \"%s\"", owner.DisplayName, task.Content),
+			Data: map[string]string{
+				"type":    "group_activity",
+				"task_id": task.ID.Hex(),
+				"user_id": owner.ID.Hex(),
+			},
+		})
+	}
+}
@@ -0,0 +1,360 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Model output is untrusted: a prompt injection in task text, notes or a
+// calendar event can steer it. Everything a flow returns is checked here
+// before a handler acts on it.
+const (
+	// maxMutationsPerRequest caps the tasks and templates one natural
+	// language request may change.
+	maxMutationsPerRequest = 25
+	// maxCreatedTasksPerRequest caps the tasks one request may create.
+	maxCreatedTasksPerRequest = 50
+	// maxModelContentLength caps a task name written by the model.
+	maxModelContentLength = 500
+)
+
+// Dates a model writes must fall in [now-modelDatePast, now+modelDateFuture].
+// Anything outside is a hallucination or an injected value, not a plan.
+const (
+	modelDatePast   = 2 * 365 * 24 * time.Hour
+	modelDateFuture = 10 * 365 * 24 * time.Hour
+)
+
+func modelDateInBounds(t, now time.Time) bool {
+	return !t.Before(now.Add(-modelDatePast)) && !t.After(now.Add(modelDateFuture))
+}
+
+// outputGuard validates one flow output for one user. It fixes what it can
+// (drops a field, trims a list) and records every rejection for review.
+type outputGuard struct {
+	now      time.Time
+	owned    map[string]bool
+	rejected []string
+	// created counts tasks kept for creation across every create op of the
+	// request, so splitting them over ops can't lift the cap.
+	created int
+}
+
+func newOutputGuard(ownedCategoryIDs []string, now time.Time) *outputGuard {
+	owned := make(map[string]bool, len(ownedCategoryIDs))
+	for _, id := range ownedCategoryIDs {
+		owned[id] = true
+	}
+	return &outputGuard{now: now, owned: owned}
+}
+
+func (g *outputGuard) reject(format string, args ...any) {
+	g.rejected = append(g.rejected, fmt.Sprintf(format, args...))
+}
+
+// category reports whether id is one of the user's categories.
+func (g *outputGuard) category(id string) bool {
+	if g.owned[id] {
+		return true
+	}
+	g.reject("category %q is not the user's", id)
+	return false
+}
+
+// date checks an ISO8601 update. Empty clears the field and is allowed.
+func (g *outputGuard) date(field string, v *string) *string {
+	if v == nil || *v == "" {
+		return v
+	}
+	t, err := time.Parse(time.RFC3339, *v)
+	if err != nil {
+		g.reject("%s %q is not an ISO8601 datetime", field, *v)
+		return nil
+	}
+	if !modelDateInBounds(t, g.now) {
+		g.reject("%s %s is out of bounds", field, *v)
+		return nil
+	}
+	return v
+}
+
+func (g *outputGuard) timeField(field string, t *time.Time) *time.Time {
+	if t == nil || modelDateInBounds(*t, g.now) {
+		return t
+	}
+	g.reject("%s %s is out of bounds", field, t.Format(time.RFC3339))
+	return nil
+}
+
+// createParams checks one task the model wants created and reports whether
+// to keep it.
+func (g *outputGuard) createParams(p *CreateTaskParams) bool {
+	p.Content = strings.TrimSpace(p.Content)
+	if p.Content == "" {
+		return false
+	}
+	if len(p.Content) > maxModelContentLength {
+		g.reject("task content truncated from %d characters", len(p.Content))
+		p.Content = strings.ToValidUTF8(p.Content[:maxModelContentLength], "")
+	}
+	p.Deadline = g.timeField("deadline", p.Deadline)
+	p.StartDate = g.timeField("startDate", p.StartDate)
+	p.StartTime = g.timeField("startTime", p.StartTime)
+	// Integration marks tasks synced from another service; only the server
+	// sets it.
+	p.Integration = ""
+	return true
+}
+
+func (g *outputGuard) multiTask(out *MultiTaskOutputLocal) {
+	if out == nil {
+		return
+	}
+	keepTask := func(p *CreateTaskParams) bool {
+		if !g.createParams(p) {
+			return false
+		}
+		if g.created >= maxCreatedTasksPerRequest {
+			g.reject("more than %d tasks to create", maxCreatedTasksPerRequest)
+			return false
+		}
+		g.created++
+		return true
+	}
+
+	pairs := out.Tasks[:0]
+	for _, pair := range out.Tasks {
+		if !g.category(pair.CategoryID) || !keepTask(&pair.Task) {
+			continue
+		}
+		pairs = append(pairs, pair)
+	}
+	out.Tasks = pairs
+
+	categories := out.Categories[:0]
+	for _, cat := range out.Categories {
+		tasks := cat.Tasks[:0]
+		for _, p := range cat.Tasks {
+			if keepTask(&p) {
+				tasks = append(tasks, p)
+			}
+		}
+		cat.Tasks = tasks
+		categories = append(categories, cat)
+	}
+	out.Categories = categories
+}
+
+// editTasks checks edit instructions and keeps at most budget of them. It
+// returns the budget left. Task and template ownership is checked again
+// when each instruction is applied.
+func (g *outputGuard) editTasks(out *EditTasksFlowOutputLocal, budget int) int {
+	if out == nil {
+		return budget
+	}
+	filter := func(instructions []EditTaskInstructionLocal) []EditTaskInstructionLocal {
+		kept := instructions[:0]
+		for _, in := range instructions {
+			if !primitive.IsValidObjectID(in.TaskID) {
+				g.reject("task ID %q is not an ObjectID", in.TaskID)
+				continue
+			}
+			if !g.category(in.CategoryID) {
+				continue
+			}
+			if budget <= 0 {
+				g.reject("more than %d edits", maxMutationsPerRequest)
+				continue
+			}
+			in.Updates.Deadline = g.date("deadline", in.Updates.Deadline)
+			in.Updates.StartDate = g.date("startDate", in.Updates.StartDate)
+			in.Updates.StartTime = g.date("startTime", in.Updates.StartTime)
+			if in.Updates.Content != nil {
+				if c := strings.TrimSpace(*in.Updates.Content); c == "" || len(c) > maxModelContentLength {
+					g.reject("edit content of %d characters", len(c))
+					in.Updates.Content = nil
+				}
+			}
+			budget--
+			kept = append(kept, in)
+		}
+		return kept
+	}
+	out.Instructions = filter(out.Instructions)
+	out.TemplateInstructions = filter(out.TemplateInstructions)
+	return budget
+}
+
+// capEditInstructions returns the first max task and template instructions,
+// tasks first.
+func capEditInstructions(out *EditTasksFlowOutputLocal, max int) ([]EditTaskInstructionLocal, []EditTaskInstructionLocal) {
+	tasks := out.Instructions
+	if len(tasks) > max {
+		tasks = tasks[:max]
+	}
+	templates := out.TemplateInstructions
+	if len(templates) > max-len(tasks) {
+		templates = templates[:max-len(tasks)]
+	}
+	return tasks, templates
+}
+
+// queryFilters checks query filters. It reports false when every category
+// the filters named was rejected: dropping them would widen the query to all
+// of the user's tasks.
+func (g *outputGuard) queryFilters(out *TaskQueryFiltersOutputLocal) bool {
+	if out == nil {
+		return true
+	}
+	named := len(out.CategoryIds)
+	ids := out.CategoryIds[:0]
+	for _, id := range out.CategoryIds {
+		if g.category(id) {
+			ids = append(ids, id)
+		}
+	}
+	out.CategoryIds = ids
+	for field, v := range map[string]*string{
+		"deadlineFrom":  &out.DeadlineFrom,
+		"deadlineTo":    &out.DeadlineTo,
+		"startTimeFrom": &out.StartTimeFrom,
+		"startTimeTo":   &out.StartTimeTo,
+	} {
+		if g.date(field, v) == nil {
+			*v = ""
+		}
+	}
+	return named == 0 || len(ids) > 0
+}
+
+// intent checks every op. Edit ops share one mutation budget.
+func (g *outputGuard) intent(out *IntentRouterOutputLocal) {
+	if out == nil {
+		return
+	}
+	budget := maxMutationsPerRequest
+	ops := out.Ops[:0]
+	for _, op := range out.Ops {
+		switch op.Type {
+		case "edit":
+			budget = g.editTasks(op.EditPayload, budget)
+		case "delete":
+			if !g.queryFilters(op.DeletePayload) {
+				g.reject("delete op dropped: none of its categories are the user's")
+				continue
+			}
+		case "create":
+			g.multiTask(op.CreatePayload)
+		default:
+			g.reject("unknown op type %q", op.Type)
+			continue
+		}
+		ops = append(ops, op)
+	}
+	out.Ops = ops
+}
+
+// logRejectedOutput records what a guard rejected, with the output as the
+// model returned it, so injections and bad outputs can be reviewed.
+func logRejectedOutput(ctx context.Context, flow, userID string, rejected []string, raw string) {
+	if len(rejected) == 0 {
+		return
+	}
+	slog.LogAttrs(ctx, slog.LevelWarn, "Rejected model output",
+		slog.String("flow", flow),
+		slog.String("userID", userID),
+		slog.Any("reasons", rejected),
+		slog.String("output", raw))
+}
+
+// guardedNLP validates every structured output of next before handlers see
+// it.
+type guardedNLP struct {
+	next       NLPService
+	categories func(ctx context.Context, userID primitive.ObjectID) ([]string, error)
+	now        func() time.Time
+}
+
+// newGuardedNLP wraps nlp so its outputs are checked against the user's
+// categories. A nil nlp stays nil, keeping the endpoints disabled.
+func newGuardedNLP(nlp NLPService, s *Service) NLPService {
+	if nlp == nil {
+		return nil
+	}
+	return &guardedNLP{next: nlp, categories: s.ownedCategoryIDs, now: time.Now}
+}
+
+// guard runs check over out with a guard for userID and logs what it
+// rejected. Outputs for a malformed user ID are rejected outright.
+func guard[T any](ctx context.Context, n *guardedNLP, flow, userID string, out *T, err error, check func(*outputGuard, *T)) (*T, error) {
+	if err != nil || out == nil {
+		return out, err
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	owned, err := n.categories(ctx, userObjID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load categories to check %s output: %w", flow, err)
+	}
+	raw, _ := json.Marshal(out)
+	g := newOutputGuard(owned, n.now())
+	check(g, out)
+	logRejectedOutput(ctx, flow, userID, g.rejected, string(raw))
+	return out, nil
+}
+
+func (n *guardedNLP) ParseTasks(ctx context.Context, userID, text, timezone string) (*MultiTaskOutputLocal, error) {
+	out, err := n.next.ParseTasks(ctx, userID, text, timezone)
+	return guard(ctx, n, "multiTask", userID, out, err, (*outputGuard).multiTask)
+}
+
+func (n *guardedNLP) ParseTasksFromImage(ctx context.Context, userID, image, mimeType, timezone string) (*MultiTaskOutputLocal, error) {
+	out, err := n.next.ParseTasksFromImage(ctx, userID, image, mimeType, timezone)
+	return guard(ctx, n, "taskFromImage", userID, out, err, (*outputGuard).multiTask)
+}
+
+func (n *guardedNLP) QueryTasks(ctx context.Context, userID, text, timezone string) (*TaskQueryFiltersOutputLocal, error) {
+	out, err := n.next.QueryTasks(ctx, userID, text, timezone)
+	return guard(ctx, n, "queryTasks", userID, out, err, func(g *outputGuard, out *TaskQueryFiltersOutputLocal) {
+		g.queryFilters(out)
+	})
+}
+
+func (n *guardedNLP) EditTasks(ctx context.Context, userID, text, timezone string) (*EditTasksFlowOutputLocal, error) {
+	out, err := n.next.EditTasks(ctx, userID, text, timezone)
+	return guard(ctx, n, "editTasks", userID, out, err, func(g *outputGuard, out *EditTasksFlowOutputLocal) {
+		g.editTasks(out, maxMutationsPerRequest)
+	})
+}
+
+func (n *guardedNLP) RouteIntent(ctx context.Context, userID, text, timezone string) (*IntentRouterOutputLocal, error) {
+	out, err := n.next.RouteIntent(ctx, userID, text, timezone)
+	return guard(ctx, n, "intentRouter", userID, out, err, (*outputGuard).intent)
+}
+
+// SuggestTaskFields, AssistantTurn and BreakdownTask outputs are checked
+// where they are used: sanitizeTaskSuggestion, the assistant's tools, and
+// sanitizeBreakdown.
+func (n *guardedNLP) SuggestTaskFields(ctx context.Context, userID, text, timezone string) (*TaskFieldSuggestionLocal, error) {
+	return n.next.SuggestTaskFields(ctx, userID, text, timezone)
+}
+
+func (n *guardedNLP) AssistantTurn(ctx context.Context, req AssistantTurnRequest) (*AssistantReplyLocal, error) {
+	return n.next.AssistantTurn(ctx, req)
+}
+
+func (n *guardedNLP) BreakdownTask(ctx context.Context, req TaskBreakdownRequest) (*TaskBreakdownLocal, error) {
+	return n.next.BreakdownTask(ctx, req)
+}
+
+func (n *guardedNLP) Embed(ctx context.Context, userID string, texts []string) (*EmbeddingsLocal, error) {
+	return n.next.Embed(ctx, userID, texts)
+}
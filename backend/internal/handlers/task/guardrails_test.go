@@ -0,0 +1,133 @@
+package task
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var guardNow = time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+const (
+	mineCategory   = "64b000000000000000000001"
+	theirsCategory = "64b000000000000000000002"
+)
+
+func TestOutputGuard_MultiTaskDropsForeignCategoriesAndBadDates(t *testing.T) {
+	far := guardNow.AddDate(50, 0, 0)
+	soon := guardNow.AddDate(0, 0, 2)
+	out := &MultiTaskOutputLocal{
+		Tasks: []CategoryTaskPairLocal{
+			{CategoryID: mineCategory, Task: CreateTaskParams{Content: " Buy milk ", Deadline: &soon, Integration: "google"}},
+			{CategoryID: theirsCategory, Task: CreateTaskParams{Content: "Read their notes"}},
+			{CategoryID: mineCategory, Task: CreateTaskParams{Content: "Renew passport", Deadline: &far}},
+		},
+		Categories: []NewCategoryWithTasksLocal{
+			{Name: "Errands", Tasks: []CreateTaskParams{{Content: strings.Repeat("é", maxModelContentLength)}, {Content: "  "}}},
+		},
+	}
+
+	g := newOutputGuard([]string{mineCategory}, guardNow)
+	g.multiTask(out)
+
+	assert.Len(t, out.Tasks, 2)
+	assert.Equal(t, "Buy milk", out.Tasks[0].Task.Content)
+	assert.Equal(t, &soon, out.Tasks[0].Task.Deadline)
+	assert.Empty(t, out.Tasks[0].Task.Integration)
+	assert.Equal(t, "Renew passport", out.Tasks[1].Task.Content)
+	assert.Nil(t, out.Tasks[1].Task.Deadline)
+
+	assert.Len(t, out.Categories[0].Tasks, 1)
+	assert.LessOrEqual(t, len(out.Categories[0].Tasks[0].Content), maxModelContentLength)
+	assert.True(t, strings.HasPrefix(strings.Repeat("é", maxModelContentLength), out.Categories[0].Tasks[0].Content))
+	assert.Len(t, g.rejected, 3)
+}
+
+func TestOutputGuard_MultiTaskCapsCreates(t *testing.T) {
+	out := &MultiTaskOutputLocal{Categories: []NewCategoryWithTasksLocal{{Name: "Spam"}}}
+	for i := 0; i < maxCreatedTasksPerRequest+5; i++ {
+		out.Categories[0].Tasks = append(out.Categories[0].Tasks, CreateTaskParams{Content: "Task"})
+	}
+
+	newOutputGuard(nil, guardNow).multiTask(out)
+
+	assert.Len(t, out.Categories[0].Tasks, maxCreatedTasksPerRequest)
+}
+
+func TestOutputGuard_IntentCapsCreatesAcrossOps(t *testing.T) {
+	out := &IntentRouterOutputLocal{}
+	for op := 0; op < 3; op++ {
+		payload := &MultiTaskOutputLocal{Categories: []NewCategoryWithTasksLocal{{Name: "Spam"}}}
+		for i := 0; i < maxCreatedTasksPerRequest/2; i++ {
+			payload.Categories[0].Tasks = append(payload.Categories[0].Tasks, CreateTaskParams{Content: "Task"})
+		}
+		out.Ops = append(out.Ops, IntentOpLocal{Type: "create", CreatePayload: payload})
+	}
+
+	newOutputGuard(nil, guardNow).intent(out)
+
+	kept := 0
+	for _, op := range out.Ops {
+		kept += len(op.CreatePayload.Categories[0].Tasks)
+	}
+	assert.Equal(t, maxCreatedTasksPerRequest, kept)
+}
+
+func TestOutputGuard_EditTasks(t *testing.T) {
+	taskID := primitive.NewObjectID().Hex()
+	bad := "2099-01-01T00:00:00Z"
+	clear := ""
+	out := &EditTasksFlowOutputLocal{
+		Instructions: []EditTaskInstructionLocal{
+			{TaskID: taskID, CategoryID: mineCategory, Updates: EditTaskUpdatesLocal{Deadline: &bad, StartTime: &clear}},
+			{TaskID: "not-an-id", CategoryID: mineCategory},
+			{TaskID: taskID, CategoryID: theirsCategory},
+		},
+		TemplateInstructions: []EditTaskInstructionLocal{
+			{TaskID: taskID, CategoryID: mineCategory},
+			{TaskID: taskID, CategoryID: mineCategory},
+		},
+	}
+
+	left := newOutputGuard([]string{mineCategory}, guardNow).editTasks(out, 2)
+
+	assert.Equal(t, 0, left)
+	assert.Len(t, out.Instructions, 1)
+	assert.Nil(t, out.Instructions[0].Updates.Deadline)
+	assert.Equal(t, &clear, out.Instructions[0].Updates.StartTime)
+	assert.Len(t, out.TemplateInstructions, 1)
+}
+
+func TestOutputGuard_IntentDropsDeletesOutsideTheUsersCategories(t *testing.T) {
+	out := &IntentRouterOutputLocal{Ops: []IntentOpLocal{
+		{Type: "delete", DeletePayload: &TaskQueryFiltersOutputLocal{CategoryIds: []string{theirsCategory}}},
+		{Type: "delete", DeletePayload: &TaskQueryFiltersOutputLocal{CategoryIds: []string{mineCategory, theirsCategory}, DeadlineTo: "yesterday"}},
+		{Type: "delete", DeletePayload: &TaskQueryFiltersOutputLocal{Priorities: []int{1}}},
+		{Type: "transfer"},
+	}}
+
+	newOutputGuard([]string{mineCategory}, guardNow).intent(out)
+
+	assert.Len(t, out.Ops, 2)
+	assert.Equal(t, []string{mineCategory}, out.Ops[0].DeletePayload.CategoryIds)
+	assert.Empty(t, out.Ops[0].DeletePayload.DeadlineTo)
+	assert.Equal(t, []int{1}, out.Ops[1].DeletePayload.Priorities)
+}
+
+func TestCapEditInstructions(t *testing.T) {
+	out := &EditTasksFlowOutputLocal{
+		Instructions:         make([]EditTaskInstructionLocal, 3),
+		TemplateInstructions: make([]EditTaskInstructionLocal, 3),
+	}
+
+	tasks, templates := capEditInstructions(out, 4)
+	assert.Len(t, tasks, 3)
+	assert.Len(t, templates, 1)
+
+	tasks, templates = capEditInstructions(out, 2)
+	assert.Len(t, tasks, 2)
+	assert.Empty(t, templates)
+}
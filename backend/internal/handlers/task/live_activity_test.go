@@ -22,8 +22,7 @@ func (s *LiveActivityTestSuite) SetupTest() {
 	s.BaseSuite.SetupTest()
 	s.service = NewService(s.Collections)
 	s.handler = Handler{
-		service:       s.service,
-		geminiService: nil,
+		service: s.service,
 	}
 }
 
@@ -0,0 +1,76 @@
+package task
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNLPUnavailable is returned by every NLPService call when no LLM backend
+// is configured.
+var ErrNLPUnavailable = errors.New("natural language service not available")
+
+// ErrEmbeddingsUnavailable is returned by NLPService.Embed when the backend
+// has no embedding model; callers fall back to lexical matching.
+var ErrEmbeddingsUnavailable = errors.New("embeddings not available")
+
+// NLPService runs the natural-language flows with results in this package's
+// local types. gemini.NewTaskNLP adapts any LLM backend to it; the interface
+// lives here because the gemini package imports task.
+type NLPService interface {
+	ParseTasks(ctx context.Context, userID, text, timezone string) (*MultiTaskOutputLocal, error)
+	ParseTasksFromImage(ctx context.Context, userID, image, mimeType, timezone string) (*MultiTaskOutputLocal, error)
+	QueryTasks(ctx context.Context, userID, text, timezone string) (*TaskQueryFiltersOutputLocal, error)
+	EditTasks(ctx context.Context, userID, text, timezone string) (*EditTasksFlowOutputLocal, error)
+	RouteIntent(ctx context.Context, userID, text, timezone string) (*IntentRouterOutputLocal, error)
+	SuggestTaskFields(ctx context.Context, userID, text, timezone string) (*TaskFieldSuggestionLocal, error)
+	AssistantTurn(ctx context.Context, req AssistantTurnRequest) (*AssistantReplyLocal, error)
+	BreakdownTask(ctx context.Context, req TaskBreakdownRequest) (*TaskBreakdownLocal, error)
+	Embed(ctx context.Context, userID string, texts []string) (*EmbeddingsLocal, error)
+}
+
+// nlpService returns the configured NLPService, or one that fails every call
+// with ErrNLPUnavailable.
+func (h *Handler) nlpService() NLPService {
+	if h.nlp == nil {
+		return unavailableNLP{}
+	}
+	return h.nlp
+}
+
+type unavailableNLP struct{}
+
+func (unavailableNLP) ParseTasks(context.Context, string, string, string) (*MultiTaskOutputLocal, error) {
+	return nil, ErrNLPUnavailable
+}
+
+func (unavailableNLP) ParseTasksFromImage(context.Context, string, string, string, string) (*MultiTaskOutputLocal, error) {
+	return nil, ErrNLPUnavailable
+}
+
+func (unavailableNLP) QueryTasks(context.Context, string, string, string) (*TaskQueryFiltersOutputLocal, error) {
+	return nil, ErrNLPUnavailable
+}
+
+func (unavailableNLP) EditTasks(context.Context, string, string, string) (*EditTasksFlowOutputLocal, error) {
+	return nil, ErrNLPUnavailable
+}
+
+func (unavailableNLP) RouteIntent(context.Context, string, string, string) (*IntentRouterOutputLocal, error) {
+	return nil, ErrNLPUnavailable
+}
+
+func (unavailableNLP) SuggestTaskFields(context.Context, string, string, string) (*TaskFieldSuggestionLocal, error) {
+	return nil, ErrNLPUnavailable
+}
+
+func (unavailableNLP) AssistantTurn(context.Context, AssistantTurnRequest) (*AssistantReplyLocal, error) {
+	return nil, ErrNLPUnavailable
+}
+
+func (unavailableNLP) BreakdownTask(context.Context, TaskBreakdownRequest) (*TaskBreakdownLocal, error) {
+	return nil, ErrNLPUnavailable
+}
+
+func (unavailableNLP) Embed(context.Context, string, []string) (*EmbeddingsLocal, error) {
+	return nil, ErrNLPUnavailable
+}
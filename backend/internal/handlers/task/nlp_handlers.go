@@ -2,14 +2,17 @@ package task
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
 	"github.com/abhikaboy/Kindred/internal/handlers/auth"
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/abhikaboy/Kindred/internal/transcribe"
 	"github.com/danielgtaylor/huma/v2"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 // QueryTasksNaturalLanguage handles POST /v1/user/tasks/natural-language/query
@@ -51,16 +54,16 @@ func (h *Handler) QueryTasksNaturalLanguage(ctx context.Context, input *QueryTas
 		slog.String("timezone", timezone))
 
 	// Call Gemini flow with retry
-	queryOutput, err := h.callGeminiQueryFlow(ctx, userID, input.Body.Text, timezone)
+	queryOutput, err := h.nlpService().QueryTasks(ctx, userID, input.Body.Text, timezone)
 	if err != nil {
 		slog.LogAttrs(ctx, slog.LevelWarn, "First attempt to call Gemini query flow failed, retrying",
 			slog.String("userID", userID),
 			slog.String("error", err.Error()))
 
-		queryOutput, err = h.callGeminiQueryFlow(ctx, userID, input.Body.Text, timezone)
+		queryOutput, err = h.nlpService().QueryTasks(ctx, userID, input.Body.Text, timezone)
 		if err != nil {
 			// Refund credit on failure
-			refundErr := h.service.Users.AddCredits(ctx, userObjID, types.CreditTypeNaturalLanguage, 1)
+			refundErr := h.service.Users.RefundCredit(ctx, userObjID, types.CreditTypeNaturalLanguage)
 			if refundErr != nil {
 				slog.LogAttrs(ctx, slog.LevelError, "Failed to refund credit after AI failure",
 					slog.String("userID", userID),
@@ -135,14 +138,14 @@ func (h *Handler) CreateTaskNaturalLanguage(ctx context.Context, input *CreateTa
 		slog.String("timezone", timezone))
 
 	// Call Genkit flow to process natural language with retry logic
-	result, err := h.callGeminiFlow(ctx, userID, input.Body.Text, timezone)
+	result, err := h.nlpService().ParseTasks(ctx, userID, input.Body.Text, timezone)
 	if err != nil {
 		slog.LogAttrs(ctx, slog.LevelWarn, "First attempt to call Gemini flow failed, retrying once",
 			slog.String("userID", userID),
 			slog.String("error", err.Error()))
 
 		// Retry once
-		result, err = h.callGeminiFlow(ctx, userID, input.Body.Text, timezone)
+		result, err = h.nlpService().ParseTasks(ctx, userID, input.Body.Text, timezone)
 		if err != nil {
 			// Both attempts failed - refund the credit
 			slog.LogAttrs(ctx, slog.LevelError, "Both attempts to call Gemini flow failed, refunding credit",
@@ -150,7 +153,7 @@ func (h *Handler) CreateTaskNaturalLanguage(ctx context.Context, input *CreateTa
 				slog.String("error", err.Error()))
 
 			// Refund the credit that was consumed earlier
-			refundErr := h.service.Users.AddCredits(ctx, userObjID, types.CreditTypeNaturalLanguage, 1)
+			refundErr := h.service.Users.RefundCredit(ctx, userObjID, types.CreditTypeNaturalLanguage)
 			if refundErr != nil {
 				slog.LogAttrs(ctx, slog.LevelError, "Failed to refund credit after AI failure",
 					slog.String("userID", userID),
@@ -258,16 +261,16 @@ func (h *Handler) EditTasksNaturalLanguage(ctx context.Context, input *EditTasks
 		slog.String("timezone", timezone))
 
 	// Call Gemini flow with one retry
-	editOutput, err := h.callGeminiEditFlow(ctx, userID, input.Body.Text, timezone)
+	editOutput, err := h.nlpService().EditTasks(ctx, userID, input.Body.Text, timezone)
 	if err != nil {
 		slog.LogAttrs(ctx, slog.LevelWarn, "First attempt to call Gemini edit flow failed, retrying",
 			slog.String("userID", userID),
 			slog.String("error", err.Error()))
 
-		editOutput, err = h.callGeminiEditFlow(ctx, userID, input.Body.Text, timezone)
+		editOutput, err = h.nlpService().EditTasks(ctx, userID, input.Body.Text, timezone)
 		if err != nil {
 			// Refund credit on failure
-			refundErr := h.service.Users.AddCredits(ctx, userObjID, types.CreditTypeNaturalLanguage, 1)
+			refundErr := h.service.Users.RefundCredit(ctx, userObjID, types.CreditTypeNaturalLanguage)
 			if refundErr != nil {
 				slog.LogAttrs(ctx, slog.LevelError, "Failed to refund credit after AI failure",
 					slog.String("userID", userID),
@@ -289,191 +292,8 @@ func (h *Handler) EditTasksNaturalLanguage(ctx context.Context, input *EditTasks
 		return output, nil
 	}
 
-	var editedTasks []TaskDocument
-	for _, instruction := range editOutput.Instructions {
-		taskObjID, err := primitive.ObjectIDFromHex(instruction.TaskID)
-		if err != nil {
-			slog.LogAttrs(ctx, slog.LevelWarn, "Invalid task ID from AI", slog.String("taskID", instruction.TaskID))
-			continue
-		}
-
-		categoryObjID, err := primitive.ObjectIDFromHex(instruction.CategoryID)
-		if err != nil {
-			slog.LogAttrs(ctx, slog.LevelWarn, "Invalid category ID from AI", slog.String("categoryID", instruction.CategoryID))
-			continue
-		}
-
-		// Fetch current task to build merge base
-		currentTask, err := h.service.GetTaskByID(taskObjID, userObjID)
-		if err != nil {
-			slog.LogAttrs(ctx, slog.LevelWarn, "Task not found for edit", slog.String("taskID", instruction.TaskID))
-			continue
-		}
-
-		// Apply core field updates via UpdatePartialTask
-		merged := mergeTaskWithEdits(*currentTask, instruction.Updates)
-		if _, err = h.service.UpdatePartialTask(taskObjID, categoryObjID, merged); err != nil {
-			slog.LogAttrs(ctx, slog.LevelError, "Failed to update task core fields",
-				slog.String("taskID", instruction.TaskID),
-				slog.String("error", err.Error()))
-			continue
-		}
-
-		// Handle notes update (UpdatePartialTask skips empty strings)
-		if instruction.Updates.Notes != nil {
-			notesErr := h.service.UpdateTaskNotes(taskObjID, categoryObjID, userObjID, UpdateTaskNotesDocument{Notes: *instruction.Updates.Notes})
-			if notesErr != nil {
-				slog.LogAttrs(ctx, slog.LevelWarn, "Failed to update task notes",
-					slog.String("taskID", instruction.TaskID),
-					slog.String("error", notesErr.Error()))
-			}
-		}
-
-		// Handle deadline update (set, clear, or skip)
-		if instruction.Updates.Deadline != nil {
-			deadlineDoc := UpdateTaskDeadlineDocument{}
-			if *instruction.Updates.Deadline != "" {
-				t, parseErr := time.Parse(time.RFC3339, *instruction.Updates.Deadline)
-				if parseErr != nil {
-					slog.LogAttrs(ctx, slog.LevelWarn, "Invalid deadline ISO8601 from AI",
-						slog.String("deadline", *instruction.Updates.Deadline))
-				} else {
-					deadlineDoc.Deadline = &t
-				}
-			}
-			// deadline == "" leaves deadlineDoc.Deadline as nil → clears it
-			if dlErr := h.service.UpdateTaskDeadline(taskObjID, categoryObjID, userObjID, deadlineDoc); dlErr != nil {
-				slog.LogAttrs(ctx, slog.LevelWarn, "Failed to update task deadline",
-					slog.String("taskID", instruction.TaskID),
-					slog.String("error", dlErr.Error()))
-			}
-		}
-
-		// Handle start date / start time update
-		if instruction.Updates.StartDate != nil || instruction.Updates.StartTime != nil {
-			startDoc := UpdateTaskStartDocument{}
-
-			if instruction.Updates.StartDate != nil && *instruction.Updates.StartDate != "" {
-				t, parseErr := time.Parse(time.RFC3339, *instruction.Updates.StartDate)
-				if parseErr != nil {
-					slog.LogAttrs(ctx, slog.LevelWarn, "Invalid startDate ISO8601 from AI",
-						slog.String("startDate", *instruction.Updates.StartDate))
-				} else {
-					startDoc.StartDate = &t
-				}
-			}
-
-			if instruction.Updates.StartTime != nil && *instruction.Updates.StartTime != "" {
-				t, parseErr := time.Parse(time.RFC3339, *instruction.Updates.StartTime)
-				if parseErr != nil {
-					slog.LogAttrs(ctx, slog.LevelWarn, "Invalid startTime ISO8601 from AI",
-						slog.String("startTime", *instruction.Updates.StartTime))
-				} else {
-					startDoc.StartTime = &t
-				}
-			}
-
-			if startErr := h.service.UpdateTaskStart(taskObjID, categoryObjID, userObjID, startDoc); startErr != nil {
-				slog.LogAttrs(ctx, slog.LevelWarn, "Failed to update task start date/time",
-					slog.String("taskID", instruction.TaskID),
-					slog.String("error", startErr.Error()))
-			}
-		}
-
-		// Fetch updated task to include in response
-		updatedTask, fetchErr := h.service.GetTaskByID(taskObjID, userObjID)
-		if fetchErr != nil {
-			slog.LogAttrs(ctx, slog.LevelWarn, "Failed to fetch updated task",
-				slog.String("taskID", instruction.TaskID),
-				slog.String("error", fetchErr.Error()))
-			continue
-		}
+	editedTasks, editedTemplates, totalEdited := h.applyEditInstructions(ctx, userObjID, userID, editOutput)
 
-		editedTasks = append(editedTasks, *updatedTask)
-	}
-
-	if editedTasks == nil {
-		editedTasks = []TaskDocument{}
-	}
-
-	// Process template edit instructions
-	var editedTemplates []TemplateTaskDocument
-	for _, instruction := range editOutput.TemplateInstructions {
-		templateObjID, err := primitive.ObjectIDFromHex(instruction.TaskID)
-		if err != nil {
-			slog.LogAttrs(ctx, slog.LevelWarn, "Invalid template ID from AI", slog.String("templateID", instruction.TaskID))
-			continue
-		}
-
-		updateDoc := UpdateTemplateDocument{}
-		if instruction.Updates.Content != nil {
-			updateDoc.Content = instruction.Updates.Content
-		}
-		if instruction.Updates.Priority != nil {
-			updateDoc.Priority = instruction.Updates.Priority
-		}
-		if instruction.Updates.Value != nil {
-			updateDoc.Value = instruction.Updates.Value
-		}
-		if instruction.Updates.Notes != nil {
-			updateDoc.Notes = instruction.Updates.Notes
-		}
-		if instruction.Updates.RecurFrequency != nil {
-			updateDoc.RecurFrequency = instruction.Updates.RecurFrequency
-		}
-		if instruction.Updates.RecurType != nil {
-			updateDoc.RecurType = instruction.Updates.RecurType
-		}
-		if instruction.Updates.Deadline != nil && *instruction.Updates.Deadline != "" {
-			t, parseErr := time.Parse(time.RFC3339, *instruction.Updates.Deadline)
-			if parseErr != nil {
-				slog.LogAttrs(ctx, slog.LevelWarn, "Invalid deadline ISO8601 from AI (template)",
-					slog.String("deadline", *instruction.Updates.Deadline))
-			} else {
-				updateDoc.Deadline = &t
-			}
-		}
-		if instruction.Updates.StartDate != nil && *instruction.Updates.StartDate != "" {
-			t, parseErr := time.Parse(time.RFC3339, *instruction.Updates.StartDate)
-			if parseErr != nil {
-				slog.LogAttrs(ctx, slog.LevelWarn, "Invalid startDate ISO8601 from AI (template)",
-					slog.String("startDate", *instruction.Updates.StartDate))
-			} else {
-				updateDoc.StartDate = &t
-			}
-		}
-		if instruction.Updates.StartTime != nil && *instruction.Updates.StartTime != "" {
-			t, parseErr := time.Parse(time.RFC3339, *instruction.Updates.StartTime)
-			if parseErr != nil {
-				slog.LogAttrs(ctx, slog.LevelWarn, "Invalid startTime ISO8601 from AI (template)",
-					slog.String("startTime", *instruction.Updates.StartTime))
-			} else {
-				updateDoc.StartTime = &t
-			}
-		}
-
-		if err := h.service.UpdateTemplateTask(templateObjID, updateDoc); err != nil {
-			slog.LogAttrs(ctx, slog.LevelError, "Failed to update template task",
-				slog.String("templateID", instruction.TaskID),
-				slog.String("error", err.Error()))
-			continue
-		}
-
-		updated, fetchErr := h.service.GetTemplateByID(templateObjID)
-		if fetchErr != nil {
-			slog.LogAttrs(ctx, slog.LevelWarn, "Failed to fetch updated template",
-				slog.String("templateID", instruction.TaskID),
-				slog.String("error", fetchErr.Error()))
-			continue
-		}
-		editedTemplates = append(editedTemplates, *updated)
-	}
-
-	if editedTemplates == nil {
-		editedTemplates = []TemplateTaskDocument{}
-	}
-
-	totalEdited := len(editedTasks) + len(editedTemplates)
 	output := &EditTasksNaturalLanguageOutput{}
 	output.Body.Tasks = editedTasks
 	output.Body.Templates = editedTemplates
@@ -499,26 +319,37 @@ func (h *Handler) EditTasksNaturalLanguage(ctx context.Context, input *EditTasks
 // applyEditInstructions applies a set of edit instructions to tasks and templates,
 // returning the edited tasks, edited templates, and total count.
 // It is shared between EditTasksNaturalLanguage and IntentTaskNaturalLanguage.
+// At most maxMutationsPerRequest instructions are applied, and only to the
+// user's own tasks and templates.
 func (h *Handler) applyEditInstructions(ctx context.Context, userObjID primitive.ObjectID, userID string, editOutput *EditTasksFlowOutputLocal) ([]TaskDocument, []TemplateTaskDocument, int) {
+	instructions, templateInstructions := capEditInstructions(editOutput, maxMutationsPerRequest)
+	if dropped := len(editOutput.Instructions) + len(editOutput.TemplateInstructions) - len(instructions) - len(templateInstructions); dropped > 0 {
+		slog.LogAttrs(ctx, slog.LevelWarn, "Dropped edits over the per-request cap",
+			slog.String("userID", userID),
+			slog.Int("dropped", dropped))
+	}
+
 	var editedTasks []TaskDocument
-	for _, instruction := range editOutput.Instructions {
+	for _, instruction := range instructions {
 		taskObjID, err := primitive.ObjectIDFromHex(instruction.TaskID)
 		if err != nil {
 			slog.LogAttrs(ctx, slog.LevelWarn, "Invalid task ID from AI", slog.String("taskID", instruction.TaskID))
 			continue
 		}
 
-		categoryObjID, err := primitive.ObjectIDFromHex(instruction.CategoryID)
-		if err != nil {
-			slog.LogAttrs(ctx, slog.LevelWarn, "Invalid category ID from AI", slog.String("categoryID", instruction.CategoryID))
-			continue
-		}
-
 		currentTask, err := h.service.GetTaskByID(taskObjID, userObjID)
 		if err != nil {
 			slog.LogAttrs(ctx, slog.LevelWarn, "Task not found for edit", slog.String("taskID", instruction.TaskID))
 			continue
 		}
+		// The stored category, not the model's: the task was looked up by
+		// owner, so this can't point into someone else's category.
+		categoryObjID := currentTask.CategoryID
+		if instruction.CategoryID != categoryObjID.Hex() {
+			slog.LogAttrs(ctx, slog.LevelWarn, "AI edit named the wrong category; using the task's own",
+				slog.String("taskID", instruction.TaskID),
+				slog.String("categoryID", instruction.CategoryID))
+		}
 
 		merged := mergeTaskWithEdits(*currentTask, instruction.Updates)
 		if _, err = h.service.UpdatePartialTask(taskObjID, categoryObjID, merged); err != nil {
@@ -587,13 +418,21 @@ func (h *Handler) applyEditInstructions(ctx context.Context, userObjID primitive
 	}
 
 	var editedTemplates []TemplateTaskDocument
-	for _, instruction := range editOutput.TemplateInstructions {
+	for _, instruction := range templateInstructions {
 		templateObjID, err := primitive.ObjectIDFromHex(instruction.TaskID)
 		if err != nil {
 			slog.LogAttrs(ctx, slog.LevelWarn, "Invalid template ID from AI", slog.String("templateID", instruction.TaskID))
 			continue
 		}
 
+		current, err := h.service.GetTemplateByID(templateObjID)
+		if err != nil || current.UserID != userObjID {
+			slog.LogAttrs(ctx, slog.LevelWarn, "Template not found for edit",
+				slog.String("userID", userID),
+				slog.String("templateID", instruction.TaskID))
+			continue
+		}
+
 		updateDoc := UpdateTemplateDocument{}
 		if instruction.Updates.Content != nil {
 			updateDoc.Content = instruction.Updates.Content
@@ -696,15 +535,15 @@ func (h *Handler) IntentTaskNaturalLanguage(ctx context.Context, input *IntentTa
 		slog.String("inputText", input.Body.Text),
 		slog.String("timezone", timezone))
 
-	intentOutput, err := h.callGeminiIntentFlow(ctx, userID, input.Body.Text, timezone)
+	intentOutput, err := h.nlpService().RouteIntent(ctx, userID, input.Body.Text, timezone)
 	if err != nil {
 		slog.LogAttrs(ctx, slog.LevelWarn, "First attempt to call Gemini intent flow failed, retrying",
 			slog.String("userID", userID),
 			slog.String("error", err.Error()))
 
-		intentOutput, err = h.callGeminiIntentFlow(ctx, userID, input.Body.Text, timezone)
+		intentOutput, err = h.nlpService().RouteIntent(ctx, userID, input.Body.Text, timezone)
 		if err != nil {
-			refundErr := h.service.Users.AddCredits(ctx, userObjID, types.CreditTypeNaturalLanguage, 1)
+			refundErr := h.service.Users.RefundCredit(ctx, userObjID, types.CreditTypeNaturalLanguage)
 			if refundErr != nil {
 				slog.LogAttrs(ctx, slog.LevelError, "Failed to refund credit after AI failure",
 					slog.String("userID", userID),
@@ -717,6 +556,20 @@ func (h *Handler) IntentTaskNaturalLanguage(ctx context.Context, input *IntentTa
 		}
 	}
 
+	responseOps := h.runIntentOps(ctx, userObjID, userID, intentOutput)
+
+	slog.LogAttrs(ctx, slog.LevelInfo, "Natural language intent routing completed",
+		slog.String("userID", userID),
+		slog.Int("opCount", len(responseOps)))
+
+	output := &IntentTaskNaturalLanguageOutput{}
+	output.Body.Ops = responseOps
+	return output, nil
+}
+
+// runIntentOps applies the edit ops of a routed utterance and resolves its
+// delete and create ops into payloads for the client to confirm.
+func (h *Handler) runIntentOps(ctx context.Context, userObjID primitive.ObjectID, userID string, intentOutput *IntentRouterOutputLocal) []IntentOpResponse {
 	var responseOps []IntentOpResponse
 
 	for _, op := range intentOutput.Ops {
@@ -770,13 +623,141 @@ func (h *Handler) IntentTaskNaturalLanguage(ctx context.Context, input *IntentTa
 	if responseOps == nil {
 		responseOps = []IntentOpResponse{}
 	}
+	return responseOps
+}
 
-	slog.LogAttrs(ctx, slog.LevelInfo, "Natural language intent routing completed",
+// CreateTasksFromVoice handles POST /v1/user/tasks/voice. It transcribes a
+// recording uploaded through /v1/user/voice/upload-url and feeds the
+// transcript to the intent router or the multi-task flow, for one voice
+// credit. The transcript is stored and linked to the tasks it produced.
+func (h *Handler) CreateTasksFromVoice(ctx context.Context, input *CreateTasksFromVoiceInput) (*CreateTasksFromVoiceOutput, error) {
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Please log in to continue", err)
+	}
+
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	if h.service.Voice == nil {
+		return nil, huma.Error503ServiceUnavailable("Voice transcription is not available", transcribe.ErrUnavailable)
+	}
+
+	mode := input.Body.Mode
+	if mode == "" {
+		mode = VoiceModeIntent
+	}
+	timezone := input.Body.Timezone
+	if timezone == "" {
+		timezone = "America/New_York"
+	}
+
+	err = h.service.Users.ConsumeCredit(ctx, userObjID, types.CreditTypeVoice)
+	if err != nil {
+		if err == types.ErrInsufficientCredits {
+			return nil, huma.Error403Forbidden("Insufficient credits. You need at least 1 voice credit to use this feature.", err)
+		}
+		slog.LogAttrs(ctx, slog.LevelError, "Failed to consume credit",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return nil, huma.Error500InternalServerError("Unable to process your credit. Please try again later.", err)
+	}
+	refund := func() {
+		if refundErr := h.service.Users.RefundCredit(ctx, userObjID, types.CreditTypeVoice); refundErr != nil {
+			slog.LogAttrs(ctx, slog.LevelError, "Failed to refund voice credit",
+				slog.String("userID", userID),
+				slog.String("refundError", refundErr.Error()))
+		}
+	}
+
+	transcript, err := h.service.Voice.TranscribeUpload(ctx, userID, input.Body.Key, input.Body.Language)
+	if err != nil {
+		refund()
+		slog.LogAttrs(ctx, slog.LevelWarn, "Voice transcription failed",
+			slog.String("userID", userID),
+			slog.String("key", input.Body.Key),
+			slog.String("error", err.Error()))
+		return nil, transcriptionError(err)
+	}
+
+	slog.LogAttrs(ctx, slog.LevelInfo, "Starting voice task processing",
 		slog.String("userID", userID),
-		slog.Int("opCount", len(responseOps)))
+		slog.String("mode", mode),
+		slog.String("transcript", transcript.Text),
+		slog.String("timezone", timezone))
 
-	output := &IntentTaskNaturalLanguageOutput{}
-	output.Body.Ops = responseOps
+	output := &CreateTasksFromVoiceOutput{}
+	output.Body.Mode = mode
+	output.Body.Transcript = transcript.Text
+	output.Body.Language = transcript.Language
+	output.Body.Ops = []IntentOpResponse{}
+	output.Body.NewCategories = []CategoryMetadata{}
+	output.Body.Tasks = []TaskDocument{}
+
+	doc := newVoiceTranscript(userObjID, mode, transcript)
+
+	switch mode {
+	case VoiceModeCreate:
+		result, err := h.nlpService().ParseTasks(ctx, userID, transcript.Text, timezone)
+		if err != nil {
+			result, err = h.nlpService().ParseTasks(ctx, userID, transcript.Text, timezone)
+		}
+		if err != nil {
+			refund()
+			return nil, huma.Error500InternalServerError("Failed to process the recording with AI after retry. Your credit has been refunded.", err)
+		}
+		created, err := h.createPreviewTasks(ctx, userObjID, userID, result.Categories, result.Tasks)
+		if err != nil {
+			refund()
+			return nil, huma.Error500InternalServerError("Failed to create the tasks from the recording. Your credit has been refunded.", err)
+		}
+		output.Body.CategoriesCreated = created.Body.CategoriesCreated
+		output.Body.TasksCreated = created.Body.TasksCreated
+		if created.Body.NewCategories != nil {
+			output.Body.NewCategories = created.Body.NewCategories
+		}
+		if created.Body.Tasks != nil {
+			output.Body.Tasks = created.Body.Tasks
+		}
+
+	default:
+		intentOutput, err := h.nlpService().RouteIntent(ctx, userID, transcript.Text, timezone)
+		if err != nil {
+			intentOutput, err = h.nlpService().RouteIntent(ctx, userID, transcript.Text, timezone)
+		}
+		if err != nil {
+			refund()
+			return nil, huma.Error500InternalServerError("Failed to process the recording with AI after retry. Your credit has been refunded.", err)
+		}
+		output.Body.Ops = h.runIntentOps(ctx, userObjID, userID, intentOutput)
+	}
+
+	// Only now is the recording spent; every failure above kept it so the
+	// client could retry with the same key.
+	h.service.Voice.DeleteUpload(ctx, userID, input.Body.Key)
+
+	// The tasks exist by now, so a failure to store the transcript is logged
+	// rather than failing the request.
+	if err := h.service.saveVoiceTranscript(ctx, doc); err != nil {
+		slog.LogAttrs(ctx, slog.LevelError, "Failed to store voice transcript",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return output, nil
+	}
+	output.Body.TranscriptID = doc.ID.Hex()
+	if err := h.service.linkVoiceTranscript(ctx, userObjID, doc.ID, output.Body.Tasks); err != nil {
+		slog.LogAttrs(ctx, slog.LevelWarn, "Failed to link tasks to voice transcript",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+	}
+
+	slog.LogAttrs(ctx, slog.LevelInfo, "Voice task processing completed",
+		slog.String("userID", userID),
+		slog.String("transcriptID", output.Body.TranscriptID),
+		slog.Int("tasksCreated", output.Body.TasksCreated),
+		slog.Int("opCount", len(output.Body.Ops)))
 	return output, nil
 }
 
@@ -821,14 +802,14 @@ func (h *Handler) PreviewTaskNaturalLanguage(ctx context.Context, input *Preview
 		slog.String("timezone", timezone))
 
 	// Call Genkit flow to process natural language with retry logic (no credit consumption)
-	result, err := h.callGeminiFlow(ctx, userID, input.Body.Text, timezone)
+	result, err := h.nlpService().ParseTasks(ctx, userID, input.Body.Text, timezone)
 	if err != nil {
 		slog.LogAttrs(ctx, slog.LevelWarn, "First attempt to call Gemini flow failed, retrying once",
 			slog.String("userID", userID),
 			slog.String("error", err.Error()))
 
 		// Retry once
-		result, err = h.callGeminiFlow(ctx, userID, input.Body.Text, timezone)
+		result, err = h.nlpService().ParseTasks(ctx, userID, input.Body.Text, timezone)
 		if err != nil {
 			return nil, huma.Error500InternalServerError("Failed to process natural language with AI after retry.", err)
 		}
@@ -837,6 +818,7 @@ func (h *Handler) PreviewTaskNaturalLanguage(ctx context.Context, input *Preview
 	output := &PreviewTaskNaturalLanguageOutput{}
 	output.Body.Categories = result.Categories
 	output.Body.Tasks = result.Tasks
+	output.Body.Duplicates = h.previewDuplicates(ctx, userObjID, result)
 	return output, nil
 }
 
@@ -876,13 +858,13 @@ func (h *Handler) PreviewTaskFromImage(ctx context.Context, input *PreviewTaskFr
 		slog.String("userID", userID),
 		slog.String("timezone", timezone))
 
-	result, err := h.callGeminiImageFlow(ctx, userID, input.Body.Image, input.Body.MimeType, timezone)
+	result, err := h.nlpService().ParseTasksFromImage(ctx, userID, input.Body.Image, input.Body.MimeType, timezone)
 	if err != nil {
 		slog.LogAttrs(ctx, slog.LevelWarn, "First attempt to call Gemini image flow failed, retrying once",
 			slog.String("userID", userID),
 			slog.String("error", err.Error()))
 
-		result, err = h.callGeminiImageFlow(ctx, userID, input.Body.Image, input.Body.MimeType, timezone)
+		result, err = h.nlpService().ParseTasksFromImage(ctx, userID, input.Body.Image, input.Body.MimeType, timezone)
 		if err != nil {
 			return nil, huma.Error500InternalServerError("Failed to process image with AI after retry.", err)
 		}
@@ -891,6 +873,7 @@ func (h *Handler) PreviewTaskFromImage(ctx context.Context, input *PreviewTaskFr
 	output := &PreviewTaskFromImageOutput{}
 	output.Body.Categories = result.Categories
 	output.Body.Tasks = result.Tasks
+	output.Body.Duplicates = h.previewDuplicates(ctx, userObjID, result)
 	return output, nil
 }
 
@@ -918,10 +901,10 @@ func (h *Handler) SuggestTaskFields(ctx context.Context, input *SuggestTaskField
 
 	output := &SuggestTaskFieldsOutput{}
 
-	suggestion, err := h.callGeminiSuggestFlow(ctx, userID, input.Body.Text, timezone)
+	suggestion, err := h.nlpService().SuggestTaskFields(ctx, userID, input.Body.Text, timezone)
 	if err != nil {
 		// Retry once, then give up quietly
-		suggestion, err = h.callGeminiSuggestFlow(ctx, userID, input.Body.Text, timezone)
+		suggestion, err = h.nlpService().SuggestTaskFields(ctx, userID, input.Body.Text, timezone)
 		if err != nil {
 			slog.LogAttrs(ctx, slog.LevelWarn, "Task field suggestion failed after retry",
 				slog.String("userID", userID),
@@ -963,54 +946,296 @@ func (h *Handler) ConfirmTaskNaturalLanguage(ctx context.Context, input *Confirm
 		return nil, huma.Error400BadRequest("Invalid user ID format", err)
 	}
 
+	contents := previewContents(input.Body.Categories, input.Body.Tasks)
+	if err := h.guardDuplicates(ctx, userObjID, contents, input.AllowDuplicates); err != nil {
+		return nil, err
+	}
+
+	// The first confirm of a voice recording's create ops was paid for by
+	// its voice credit.
+	var transcriptID primitive.ObjectID
+	paidByVoice := false
+	if input.Body.VoiceTranscriptID != "" {
+		transcriptID, err = primitive.ObjectIDFromHex(input.Body.VoiceTranscriptID)
+		if err != nil {
+			return nil, huma.Error400BadRequest("Invalid voice transcript ID format", err)
+		}
+		paidByVoice, err = h.service.claimVoiceConfirm(ctx, userObjID, transcriptID)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Unable to process your credit. Please try again later.", err)
+		}
+	}
+
 	// Consume credit atomically
-	err = h.service.Users.ConsumeCredit(ctx, userObjID, types.CreditTypeNaturalLanguage)
+	if !paidByVoice {
+		err = h.service.Users.ConsumeCredit(ctx, userObjID, types.CreditTypeNaturalLanguage)
+		if err != nil {
+			if err == types.ErrInsufficientCredits {
+				return nil, huma.Error403Forbidden("Insufficient credits. You need at least 1 natural language credit to use this feature.", err)
+			}
+			slog.LogAttrs(ctx, slog.LevelError, "Failed to consume credit",
+				slog.String("userID", userID),
+				slog.String("error", err.Error()))
+			return nil, huma.Error500InternalServerError("Unable to process your credit. Please try again later.", err)
+		}
+	}
+
+	output, err := h.createPreviewTasks(ctx, userObjID, userID, input.Body.Categories, input.Body.Tasks)
 	if err != nil {
-		if err == types.ErrInsufficientCredits {
-			return nil, huma.Error403Forbidden("Insufficient credits. You need at least 1 natural language credit to use this feature.", err)
+		return nil, huma.Error500InternalServerError(err.Error(), err)
+	}
+	totalTasks, categoriesCreated := output.Body.TasksCreated, output.Body.CategoriesCreated
+
+	if input.Body.VoiceTranscriptID != "" {
+		if err := h.service.linkVoiceTranscript(ctx, userObjID, transcriptID, output.Body.Tasks); err != nil {
+			slog.LogAttrs(ctx, slog.LevelWarn, "Failed to link tasks to voice transcript",
+				slog.String("userID", userID),
+				slog.String("transcriptID", input.Body.VoiceTranscriptID),
+				slog.String("error", err.Error()))
 		}
-		slog.LogAttrs(ctx, slog.LevelError, "Failed to consume credit",
-			slog.String("userID", userID),
-			slog.String("error", err.Error()))
-		return nil, huma.Error500InternalServerError("Unable to process your credit. Please try again later.", err)
 	}
 
+	if totalTasks == 0 {
+		output.Body.Message = "No valid tasks could be created from the provided preview"
+	} else if categoriesCreated > 0 {
+		output.Body.Message = fmt.Sprintf("Successfully created %d tasks in %d new categories", totalTasks, categoriesCreated)
+	} else {
+		output.Body.Message = fmt.Sprintf("Successfully created %d tasks in existing categories", totalTasks)
+	}
+
+	return output, nil
+}
+
+// createPreviewTasks creates the categories and tasks of a preview payload.
+// The returned output has no message set.
+func (h *Handler) createPreviewTasks(ctx context.Context, userObjID primitive.ObjectID, userID string, categories []NewCategoryWithTasksLocal, tasks []CategoryTaskPairLocal) (*CreateTaskNaturalLanguageOutput, error) {
 	// Process new categories with their tasks
-	newCategoryTasks, newCategoryMetadata, categoriesCreated, newCategoryTaskCount, err := h.processNewCategories(ctx, input.Body.Categories, userObjID)
+	newCategoryTasks, newCategoryMetadata, categoriesCreated, newCategoryTaskCount, err := h.processNewCategories(ctx, categories, userObjID)
 	if err != nil {
 		slog.LogAttrs(ctx, slog.LevelError, "Failed to process new categories",
 			slog.String("userID", userID),
 			slog.String("error", err.Error()))
-		return nil, huma.Error500InternalServerError(err.Error(), err)
+		return nil, err
 	}
 
 	// Process tasks for existing categories
-	existingCategoryTasks, existingCategoryTaskCount, err := h.processExistingCategoryTasks(ctx, input.Body.Tasks, userObjID)
+	existingCategoryTasks, existingCategoryTaskCount, err := h.processExistingCategoryTasks(ctx, tasks, userObjID)
 	if err != nil {
 		slog.LogAttrs(ctx, slog.LevelError, "Failed to process existing category tasks",
 			slog.String("userID", userID),
 			slog.String("error", err.Error()))
-		return nil, huma.Error500InternalServerError(err.Error(), err)
+		return nil, err
 	}
 
-	// Combine all created tasks
-	allTasks := append(newCategoryTasks, existingCategoryTasks...)
-	totalTasks := newCategoryTaskCount + existingCategoryTaskCount
-
-	// Build response
 	output := &CreateTaskNaturalLanguageOutput{}
 	output.Body.CategoriesCreated = categoriesCreated
 	output.Body.NewCategories = newCategoryMetadata
-	output.Body.TasksCreated = totalTasks
-	output.Body.Tasks = allTasks
+	output.Body.TasksCreated = newCategoryTaskCount + existingCategoryTaskCount
+	output.Body.Tasks = append(newCategoryTasks, existingCategoryTasks...)
+	return output, nil
+}
 
-	if totalTasks == 0 {
-		output.Body.Message = "No valid tasks could be created from the provided preview"
-	} else if categoriesCreated > 0 {
-		output.Body.Message = fmt.Sprintf("Successfully created %d tasks in %d new categories", totalTasks, categoriesCreated)
-	} else {
-		output.Body.Message = fmt.Sprintf("Successfully created %d tasks in existing categories", totalTasks)
+// BreakdownTask handles POST /v1/user/tasks/{category}/{id}/breakdown. It returns a preview
+// only; the client edits the steps and saves them through ApplyTaskBreakdown.
+func (h *Handler) BreakdownTask(ctx context.Context, input *BreakdownTaskInput) (*BreakdownTaskOutput, error) {
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Please log in to continue", err)
 	}
 
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	task, err := h.ownedTask(userObjID, input.Category, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.Users.ConsumeCredit(ctx, userObjID, types.CreditTypeNaturalLanguage)
+	if err != nil {
+		if err == types.ErrInsufficientCredits {
+			return nil, huma.Error403Forbidden("Insufficient credits. You need at least 1 natural language credit to use this feature.", err)
+		}
+		slog.LogAttrs(ctx, slog.LevelError, "Failed to consume credit",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return nil, huma.Error500InternalServerError("Unable to process your credit. Please try again later.", err)
+	}
+
+	timezone := input.Body.Timezone
+	if timezone == "" {
+		timezone = "America/New_York"
+	}
+
+	req := newBreakdownRequest(userID, timezone, input.Body.Guidance, task)
+	breakdown, err := h.nlpService().BreakdownTask(ctx, req)
+	if err != nil {
+		slog.LogAttrs(ctx, slog.LevelWarn, "First attempt to call breakdown flow failed, retrying",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+
+		breakdown, err = h.nlpService().BreakdownTask(ctx, req)
+		if err != nil {
+			h.refundNLCredit(ctx, userObjID, userID)
+			slog.LogAttrs(ctx, slog.LevelError, "Breakdown flow failed after retry",
+				slog.String("userID", userID),
+				slog.String("taskID", input.ID),
+				slog.String("error", err.Error()))
+			return nil, huma.Error500InternalServerError("Unable to break down this task. Please try again later.", err)
+		}
+	}
+
+	steps := sanitizeBreakdown(*breakdown, task.Checklist)
+	if len(steps) == 0 {
+		h.refundNLCredit(ctx, userObjID, userID)
+		return nil, huma.Error422UnprocessableEntity("Couldn't find new steps for this task. Try adding some guidance.", nil)
+	}
+
+	output := &BreakdownTaskOutput{}
+	output.Body.Steps = steps
+	output.Body.Summary = breakdown.Summary
+	for _, s := range steps {
+		output.Body.TotalMinutes += s.EstimatedMinutes
+	}
 	return output, nil
 }
+
+// ApplyTaskBreakdown handles POST /v1/user/tasks/{category}/{id}/breakdown/apply, saving the
+// user's edited steps to the task's checklist. The credit was spent on the preview.
+func (h *Handler) ApplyTaskBreakdown(ctx context.Context, input *ApplyTaskBreakdownInput) (*ApplyTaskBreakdownOutput, error) {
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Please log in to continue", err)
+	}
+
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	task, err := h.ownedTask(userObjID, input.Category, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	checklist := mergeBreakdown(task.Checklist, input.Body.Steps, input.Body.Replace)
+	if err := h.service.UpdateTaskChecklist(task.ID, task.CategoryID, userObjID, UpdateTaskChecklistDocument{Checklist: checklist}); err != nil {
+		slog.Error("Failed to apply task breakdown", "taskId", input.ID, "userId", userID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to update task checklist. Please try again.", err)
+	}
+
+	output := &ApplyTaskBreakdownOutput{}
+	output.Body.Checklist = checklist
+	return output, nil
+}
+
+// ownedTask loads a task by its path IDs, answering 404 unless the user owns
+// it and it lives in the given category.
+func (h *Handler) ownedTask(userID primitive.ObjectID, categoryHex, taskHex string) (*TaskDocument, error) {
+	taskID, err := primitive.ObjectIDFromHex(taskHex)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid task ID format", err)
+	}
+	categoryID, err := primitive.ObjectIDFromHex(categoryHex)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid category ID format", err)
+	}
+
+	task, err := h.service.GetTaskByID(taskID, userID)
+	if errors.Is(err, mongo.ErrNoDocuments) || (err == nil && task.CategoryID != categoryID) {
+		return nil, huma.Error404NotFound("Task not found", err)
+	}
+	if err != nil {
+		slog.Error("Failed to load task", "taskId", taskHex, "userId", userID.Hex(), "error", err)
+		return nil, huma.Error500InternalServerError("Unable to load task. Please try again.", err)
+	}
+	return task, nil
+}
+
+// CheckDuplicateTasks handles POST /v1/user/tasks/duplicates.
+func (h *Handler) CheckDuplicateTasks(ctx context.Context, input *CheckDuplicateTasksInput) (*CheckDuplicateTasksOutput, error) {
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Please log in to continue", err)
+	}
+
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+
+	results, method, err := h.service.FindDuplicates(ctx, h.nlpService(), userObjID, input.Body.Texts)
+	if err != nil {
+		slog.Error("Failed to check for duplicate tasks", "userId", userID, "error", err)
+		return nil, huma.Error500InternalServerError("Unable to check for duplicates. Please try again.", err)
+	}
+
+	output := &CheckDuplicateTasksOutput{}
+	output.Body.Method = method
+	output.Body.Results = results
+	return output, nil
+}
+
+// previewDuplicates checks a preview's proposed tasks for duplicates,
+// returning only the ones with matches. It is best effort: a failure leaves
+// the preview without duplicate hints rather than failing it.
+func (h *Handler) previewDuplicates(ctx context.Context, userID primitive.ObjectID, preview *MultiTaskOutputLocal) []DuplicateCheck {
+	return h.likelyDuplicates(ctx, userID, previewContents(preview.Categories, preview.Tasks))
+}
+
+// guardDuplicates refuses a commit with a 409 listing the candidates that
+// look like active tasks, unless the caller chose to keep them. Like the
+// preview check it is best-effort, so a failed check lets the commit through.
+func (h *Handler) guardDuplicates(ctx context.Context, userID primitive.ObjectID, contents []string, allow bool) error {
+	if allow {
+		return nil
+	}
+	found := h.likelyDuplicates(ctx, userID, contents)
+	if len(found) == 0 {
+		return nil
+	}
+	details := make([]error, len(found))
+	for i, c := range found {
+		details[i] = &huma.ErrorDetail{
+			Message:  fmt.Sprintf("%q looks like a task you already have", c.Content),
+			Location: "body",
+			Value:    c,
+		}
+	}
+	return huma.Error409Conflict("Some of these tasks look like ones you already have. Merge or skip them, or retry with allowDuplicates=true to keep them.", details...)
+}
+
+func previewContents(categories []NewCategoryWithTasksLocal, tasks []CategoryTaskPairLocal) []string {
+	var contents []string
+	for _, c := range categories {
+		for _, t := range c.Tasks {
+			contents = append(contents, t.Content)
+		}
+	}
+	for _, t := range tasks {
+		contents = append(contents, t.Task.Content)
+	}
+	return contents
+}
+
+// likelyDuplicates returns only the checks of contents that have matches.
+func (h *Handler) likelyDuplicates(ctx context.Context, userID primitive.ObjectID, contents []string) []DuplicateCheck {
+	if len(contents) == 0 {
+		return nil
+	}
+
+	checks, _, err := h.service.FindDuplicates(ctx, h.nlpService(), userID, contents)
+	if err != nil {
+		slog.Warn("Duplicate check failed", "userId", userID.Hex(), "error", err)
+		return nil
+	}
+	var found []DuplicateCheck
+	for _, c := range checks {
+		if len(c.Matches) > 0 {
+			found = append(found, c)
+		}
+	}
+	return found
+}
@@ -47,6 +47,7 @@ type PreviewTaskNaturalLanguageOutput struct {
 	Body struct {
 		Categories []NewCategoryWithTasksLocal `json:"categories" doc:"New categories and their tasks proposed by AI"`
 		Tasks      []CategoryTaskPairLocal     `json:"tasks" doc:"Tasks proposed for existing categories"`
+		Duplicates []DuplicateCheck            `json:"duplicates,omitempty" doc:"Proposed tasks that look like existing active tasks, so the user can merge, skip or keep them"`
 	}
 }
 
@@ -64,15 +65,20 @@ type PreviewTaskFromImageOutput struct {
 	Body struct {
 		Categories []NewCategoryWithTasksLocal `json:"categories" doc:"New categories and their tasks proposed by AI"`
 		Tasks      []CategoryTaskPairLocal     `json:"tasks" doc:"Tasks proposed for existing categories"`
+		Duplicates []DuplicateCheck            `json:"duplicates,omitempty" doc:"Proposed tasks that look like existing active tasks, so the user can merge, skip or keep them"`
 	}
 }
 
 // Confirm Task from Natural Language (create using preview payload)
 type ConfirmTaskNaturalLanguageInput struct {
-	Authorization string `header:"Authorization" required:"true"`
-	Body          struct {
+	Authorization   string `header:"Authorization" required:"true"`
+	AllowDuplicates bool   `query:"allowDuplicates" doc:"Create the tasks even if some look like existing active tasks"`
+	Body            struct {
 		Categories []NewCategoryWithTasksLocal `json:"categories" doc:"New categories to create with their tasks"`
 		Tasks      []CategoryTaskPairLocal     `json:"tasks" doc:"Tasks to create in existing categories"`
+		// Set when confirming the create ops of a voice recording; the first
+		// such confirm is covered by the voice credit.
+		VoiceTranscriptID string `json:"voiceTranscriptId,omitempty" doc:"transcriptId from /v1/user/tasks/voice; links the created tasks to the recording" example:"507f1f77bcf86cd799439011"`
 	} `json:"body"`
 }
 
@@ -160,6 +166,82 @@ type SuggestTaskFieldsOutput struct {
 	}
 }
 
+// Break a task down into checklist steps (preview; costs one NL credit)
+type BreakdownTaskInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	Category      string `path:"category" example:"507f1f77bcf86cd799439011"`
+	ID            string `path:"id" example:"507f1f77bcf86cd799439011"`
+	Body          struct {
+		Guidance string `json:"guidance,omitempty" maxLength:"500" doc:"Optional direction for the breakdown" example:"I'm moving on the 30th and have no car"`
+		Timezone string `json:"timezone,omitempty" doc:"User's timezone (IANA format). Defaults to America/New_York if not provided" example:"America/New_York"`
+	} `json:"body" required:"false"`
+}
+
+type BreakdownTaskOutput struct {
+	Body struct {
+		Steps        []BreakdownStep `json:"steps" doc:"Proposed checklist steps in order. Edit freely, then send them to /breakdown/apply."`
+		TotalMinutes int             `json:"totalMinutes" doc:"Sum of the steps' estimates"`
+		Summary      string          `json:"summary"`
+	}
+}
+
+// Apply an (edited) breakdown to a task's checklist
+type ApplyTaskBreakdownInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	Category      string `path:"category" example:"507f1f77bcf86cd799439011"`
+	ID            string `path:"id" example:"507f1f77bcf86cd799439011"`
+	Body          struct {
+		Steps   []string `json:"steps" minItems:"1" maxItems:"50" doc:"Checklist steps in order"`
+		Replace bool     `json:"replace,omitempty" doc:"Replace the existing checklist instead of appending to it"`
+	} `json:"body"`
+}
+
+type ApplyTaskBreakdownOutput struct {
+	Body struct {
+		Checklist []ChecklistItem `json:"checklist" doc:"The task's checklist as saved"`
+	}
+}
+
+// Check candidate tasks against the user's active tasks before creating them
+type CheckDuplicateTasksInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	Body          struct {
+		Texts []string `json:"texts" minItems:"1" maxItems:"50" doc:"Candidate task texts, e.g. from a preview or a create form"`
+	} `json:"body"`
+}
+
+type CheckDuplicateTasksOutput struct {
+	Body struct {
+		Method  string           `json:"method" enum:"embedding,lexical" doc:"How similarity was scored"`
+		Results []DuplicateCheck `json:"results" doc:"One entry per text, in order; matches is empty when nothing looks alike"`
+	}
+}
+
+// Create tasks from an uploaded voice recording (costs one voice credit)
+type CreateTasksFromVoiceInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	Body          struct {
+		Key      string `json:"key" minLength:"1" doc:"Object key returned by /v1/user/voice/upload-url, after the upload finished" example:"voice/507f1f77bcf86cd799439011/3f0c8a5e.m4a"`
+		Mode     string `json:"mode,omitempty" enum:"intent,create" doc:"intent (default) routes the recording like /natural-language/intent; create creates its tasks immediately"`
+		Language string `json:"language,omitempty" maxLength:"8" doc:"Optional ISO-639-1 language hint; detected when omitted" example:"en"`
+		Timezone string `json:"timezone,omitempty" doc:"User's timezone (IANA format). Defaults to America/New_York if not provided" example:"America/New_York"`
+	} `json:"body"`
+}
+
+type CreateTasksFromVoiceOutput struct {
+	Body struct {
+		TranscriptID      string             `json:"transcriptId,omitempty" doc:"Stored transcript; pass as voiceTranscriptId to /confirm for create ops"`
+		Transcript        string             `json:"transcript" doc:"What the recording said"`
+		Language          string             `json:"language,omitempty" doc:"Detected language, when the backend reports it"`
+		Mode              string             `json:"mode" enum:"intent,create"`
+		Ops               []IntentOpResponse `json:"ops" doc:"intent mode: decomposed operations, as from /natural-language/intent"`
+		CategoriesCreated int                `json:"categoriesCreated" doc:"create mode: number of new categories created"`
+		NewCategories     []CategoryMetadata `json:"newCategories" doc:"create mode: newly created categories"`
+		TasksCreated      int                `json:"tasksCreated" doc:"create mode: number of tasks created"`
+		Tasks             []TaskDocument     `json:"tasks" doc:"create mode: created tasks"`
+	}
+}
+
 // Operation registrations
 
 func RegisterCreateTaskNaturalLanguageOperation(api huma.API, handler *Handler) {
@@ -249,3 +331,47 @@ func RegisterIntentTaskNaturalLanguageOperation(api huma.API, handler *Handler)
 		Tags:        []string{"tasks", "ai"},
 	}, handler.IntentTaskNaturalLanguage)
 }
+
+func RegisterCreateTasksFromVoiceOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-tasks-from-voice",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/tasks/voice",
+		Summary:     "Create tasks from a voice recording",
+		Description: "Transcribes a recording uploaded through /v1/user/voice/upload-url and runs the transcript through the intent router (default) or the multi-task flow. The transcript is stored with the resulting tasks. Consumes 1 voice credit, refunded if transcription or AI processing fails.",
+		Tags:        []string{"tasks", "ai", "voice"},
+	}, handler.CreateTasksFromVoice)
+}
+
+func RegisterBreakdownTaskOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "breakdown-task",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/tasks/{category}/{id}/breakdown",
+		Summary:     "Preview an AI breakdown of a task",
+		Description: "Proposes ordered checklist steps with suggested durations for a big task, calibrated on the user's categories and completed tasks. Nothing is saved. Consumes 1 natural language credit, refunded if the breakdown fails.",
+		Tags:        []string{"tasks", "ai"},
+	}, handler.BreakdownTask)
+}
+
+func RegisterApplyTaskBreakdownOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "apply-task-breakdown",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/tasks/{category}/{id}/breakdown/apply",
+		Summary:     "Apply a task breakdown",
+		Description: "Saves the (possibly edited) breakdown steps to the task's checklist, after the existing items unless replace is set. Steps the checklist already has are skipped.",
+		Tags:        []string{"tasks", "ai"},
+	}, handler.ApplyTaskBreakdown)
+}
+
+func RegisterCheckDuplicateTasksOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "check-duplicate-tasks",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/tasks/duplicates",
+		Summary:     "Find likely duplicates of tasks about to be created",
+		Description: "Compares candidate task texts with the user's active tasks and returns likely duplicates with similarity scores. Uses embeddings when an embedding model is configured and falls back to word overlap otherwise. Consumes no credits.",
+		Tags:        []string{"tasks", "ai"},
+	}, handler.CheckDuplicateTasks)
+}
@@ -9,11 +9,11 @@ import (
 /*
 Router maps endpoints to handlers
 */
-func Routes(api huma.API, collections map[string]*mongo.Collection, geminiService any, ringService *rings.RingService) *Service {
+func Routes(api huma.API, collections map[string]*mongo.Collection, nlp NLPService, ringService *rings.RingService) *Service {
 	service := newService(collections, ringService)
 	handler := Handler{
-		service:       service,
-		geminiService: geminiService,
+		service: service,
+		nlp:     newGuardedNLP(nlp, service),
 	}
 
 	RegisterTaskOperations(api, &handler)
@@ -29,12 +29,17 @@ func RegisterTaskOperations(api huma.API, handler *Handler) {
 	RegisterQueryTasksNaturalLanguageOperation(api, handler)
 	RegisterEditTasksNaturalLanguageOperation(api, handler)
 	RegisterIntentTaskNaturalLanguageOperation(api, handler)
+	RegisterCreateAssistantSessionOperation(api, handler)
+	RegisterGetAssistantSessionOperation(api, handler)
+	RegisterUndoAssistantChangesetOperation(api, handler)
 	RegisterQueryTasksByUserOperation(api, handler)
 	RegisterGetTasksByUserOperation(api, handler)
 	// Static single-segment POSTs must be registered before /{category}: Fiber
 	// matches in registration order, so /{category} would otherwise shadow /log.
 	RegisterLogTasksOperation(api, handler)
 	RegisterSuggestTaskFieldsOperation(api, handler)
+	RegisterCheckDuplicateTasksOperation(api, handler)
+	RegisterCreateTasksFromVoiceOperation(api, handler)
 	RegisterCreateTaskOperation(api, handler)
 	RegisterGetTasksOperation(api, handler)
 	RegisterGetTaskOperation(api, handler)
@@ -53,6 +58,8 @@ func RegisterTaskOperations(api huma.API, handler *Handler) {
 	RegisterGetRecurringTasksWithPastDeadlinesOperation(api, handler)
 	RegisterUpdateTaskNotesOperation(api, handler)
 	RegisterUpdateTaskChecklistOperation(api, handler)
+	RegisterBreakdownTaskOperation(api, handler)
+	RegisterApplyTaskBreakdownOperation(api, handler)
 	RegisterUpdateTaskDeadlineOperation(api, handler)
 	RegisterUpdateTaskStartOperation(api, handler)
 	RegisterUpdateTaskReminderOperation(api, handler)
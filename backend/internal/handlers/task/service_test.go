@@ -1,6 +1,7 @@
 package task
 
 import (
+	"context"
 	"log/slog"
 	"testing"
 	"time"
@@ -1395,3 +1396,65 @@ func (s *TaskServiceTestSuite) TestBuildFollowUpReminder_NilWhenStale() {
 	reminder := BuildFollowUpReminder(&pastDeadline, nil)
 	s.Nil(reminder)
 }
+
+func (s *TaskServiceTestSuite) TestClaimGroupActivityNotify_Cooldown() {
+	ctx := context.Background()
+	user := s.GetUser(0)
+	now := time.Now().UTC()
+
+	claimed, err := s.service.Users.ClaimGroupActivityNotify(ctx, user.ID, now, groupActivityNotifyCooldown)
+	s.Require().NoError(err)
+	s.True(claimed)
+
+	claimed, err = s.service.Users.ClaimGroupActivityNotify(ctx, user.ID, now.Add(time.Hour), groupActivityNotifyCooldown)
+	s.Require().NoError(err)
+	s.False(claimed, "a second completion inside the cooldown shouldn't notify again")
+
+	claimed, err = s.service.Users.ClaimGroupActivityNotify(ctx, user.ID, now.Add(groupActivityNotifyCooldown), groupActivityNotifyCooldown)
+	s.Require().NoError(err)
+	s.True(claimed)
+}
+
+func (s *TaskServiceTestSuite) TestGetPushTokens_SkipsUsersWithoutOne() {
+	ctx := context.Background()
+	withToken, other := s.GetUser(0), s.GetUser(1)
+	_, err := s.Collections["users"].UpdateOne(ctx, bson.M{"_id": withToken.ID}, bson.M{"$set": bson.M{"push_token": "token-0"}})
+	s.Require().NoError(err)
+	_, err = s.Collections["users"].UpdateOne(ctx, bson.M{"_id": other.ID}, bson.M{"$set": bson.M{"push_token": ""}})
+	s.Require().NoError(err)
+
+	tokens, err := s.service.Users.GetPushTokens(ctx, []primitive.ObjectID{withToken.ID, other.ID})
+	s.Require().NoError(err)
+	s.Equal(map[primitive.ObjectID]string{withToken.ID: "token-0"}, tokens)
+}
+
+func (s *TaskServiceTestSuite) TestBulkDeleteTask_DropsTaskEmbeddings() {
+	ctx := context.Background()
+	user := s.GetUser(0)
+	deleted, kept := primitive.NewObjectID(), primitive.NewObjectID()
+	category := &types.CategoryDocument{
+		ID:            primitive.NewObjectID(),
+		Name:          "Embeddings",
+		User:          user.ID,
+		WorkspaceName: "Test Workspace",
+		Tasks: []TaskDocument{
+			{ID: deleted, Content: "call mom", Priority: 1, Value: 1, UserID: user.ID},
+			{ID: kept, Content: "water plants", Priority: 1, Value: 1, UserID: user.ID},
+		},
+	}
+	_, err := s.Collections["categories"].InsertOne(ctx, category)
+	s.Require().NoError(err)
+	for _, t := range category.Tasks {
+		s.service.cacheTaskVector(ctx, taskEmbeddingDocument{TaskID: t.ID, UserID: user.ID, Model: "test", ContentHash: contentHash(t.Content), Vector: []float32{1}})
+	}
+
+	_, err = s.service.BulkDeleteTask(user.ID, []BulkDeleteTaskItem{{TaskID: deleted.Hex(), CategoryID: category.ID.Hex()}})
+	s.Require().NoError(err)
+
+	n, err := s.service.TaskEmbeddings.CountDocuments(ctx, bson.M{"_id": deleted})
+	s.Require().NoError(err)
+	s.Zero(n, "the deleted task's vector should be dropped")
+	n, err = s.service.TaskEmbeddings.CountDocuments(ctx, bson.M{"_id": kept})
+	s.Require().NoError(err)
+	s.Equal(int64(1), n)
+}
@@ -15,11 +15,11 @@ import (
 )
 
 // NewStreamHandler creates a Handler for SSE streaming routes.
-func NewStreamHandler(collections map[string]*mongo.Collection, geminiService any, ringService *rings.RingService) *Handler {
+func NewStreamHandler(collections map[string]*mongo.Collection, nlp NLPService, ringService *rings.RingService) *Handler {
 	service := newService(collections, ringService)
 	return &Handler{
-		service:       service,
-		geminiService: geminiService,
+		service: service,
+		nlp:     newGuardedNLP(nlp, service),
 	}
 }
 
@@ -66,7 +66,7 @@ func (h *Handler) consumeNLCredit(c *fiber.Ctx, ctx context.Context, userObjID p
 
 // refundNLCredit refunds one NL credit after a flow failure, logging but not surfacing errors.
 func (h *Handler) refundNLCredit(ctx context.Context, userObjID primitive.ObjectID, userID string) {
-	if refundErr := h.service.Users.AddCredits(ctx, userObjID, types.CreditTypeNaturalLanguage, 1); refundErr != nil {
+	if refundErr := h.service.Users.RefundCredit(ctx, userObjID, types.CreditTypeNaturalLanguage); refundErr != nil {
 		slog.LogAttrs(ctx, slog.LevelError, "Failed to refund credit after AI failure",
 			slog.String("userID", userID),
 			slog.String("refundError", refundErr.Error()))
@@ -119,14 +119,14 @@ func (h *Handler) StreamIntentNaturalLanguage(c *fiber.Ctx) error {
 			slog.String("inputText", body.Text),
 			slog.String("timezone", body.Timezone))
 
-		intentOutput, err := h.callGeminiIntentFlow(ctx, userID, body.Text, body.Timezone)
+		intentOutput, err := h.nlpService().RouteIntent(ctx, userID, body.Text, body.Timezone)
 		if err != nil {
 			slog.LogAttrs(ctx, slog.LevelWarn, "First attempt to call Gemini intent flow failed, retrying",
 				slog.String("userID", userID),
 				slog.String("error", err.Error()))
 			_ = sse.Send("status", map[string]string{"stage": "retrying", "message": "Retrying AI request..."})
 
-			intentOutput, err = h.callGeminiIntentFlow(ctx, userID, body.Text, body.Timezone)
+			intentOutput, err = h.nlpService().RouteIntent(ctx, userID, body.Text, body.Timezone)
 			if err != nil {
 				h.refundNLCredit(ctx, userObjID, userID)
 				_ = sse.SendError("Failed to process natural language intent with AI after retry. Your credit has been refunded.")
@@ -235,14 +235,14 @@ func (h *Handler) StreamCreateNaturalLanguage(c *fiber.Ctx) error {
 			slog.String("inputText", body.Text),
 			slog.String("timezone", body.Timezone))
 
-		result, err := h.callGeminiFlow(ctx, userID, body.Text, body.Timezone)
+		result, err := h.nlpService().ParseTasks(ctx, userID, body.Text, body.Timezone)
 		if err != nil {
 			slog.LogAttrs(ctx, slog.LevelWarn, "First attempt to call Gemini flow failed, retrying",
 				slog.String("userID", userID),
 				slog.String("error", err.Error()))
 			_ = sse.Send("status", map[string]string{"stage": "retrying", "message": "Retrying AI request..."})
 
-			result, err = h.callGeminiFlow(ctx, userID, body.Text, body.Timezone)
+			result, err = h.nlpService().ParseTasks(ctx, userID, body.Text, body.Timezone)
 			if err != nil {
 				h.refundNLCredit(ctx, userObjID, userID)
 				_ = sse.SendError("Failed to process natural language with AI after retry. Your credit has been refunded.")
@@ -334,14 +334,14 @@ func (h *Handler) StreamQueryNaturalLanguage(c *fiber.Ctx) error {
 			slog.String("inputText", body.Text),
 			slog.String("timezone", body.Timezone))
 
-		queryOutput, err := h.callGeminiQueryFlow(ctx, userID, body.Text, body.Timezone)
+		queryOutput, err := h.nlpService().QueryTasks(ctx, userID, body.Text, body.Timezone)
 		if err != nil {
 			slog.LogAttrs(ctx, slog.LevelWarn, "First attempt to call Gemini query flow failed, retrying",
 				slog.String("userID", userID),
 				slog.String("error", err.Error()))
 			_ = sse.Send("status", map[string]string{"stage": "retrying", "message": "Retrying AI request..."})
 
-			queryOutput, err = h.callGeminiQueryFlow(ctx, userID, body.Text, body.Timezone)
+			queryOutput, err = h.nlpService().QueryTasks(ctx, userID, body.Text, body.Timezone)
 			if err != nil {
 				h.refundNLCredit(ctx, userObjID, userID)
 				_ = sse.SendError("Failed to process natural language query with AI after retry. Your credit has been refunded.")
@@ -413,14 +413,14 @@ func (h *Handler) StreamEditNaturalLanguage(c *fiber.Ctx) error {
 			slog.String("inputText", body.Text),
 			slog.String("timezone", body.Timezone))
 
-		editOutput, err := h.callGeminiEditFlow(ctx, userID, body.Text, body.Timezone)
+		editOutput, err := h.nlpService().EditTasks(ctx, userID, body.Text, body.Timezone)
 		if err != nil {
 			slog.LogAttrs(ctx, slog.LevelWarn, "First attempt to call Gemini edit flow failed, retrying",
 				slog.String("userID", userID),
 				slog.String("error", err.Error()))
 			_ = sse.Send("status", map[string]string{"stage": "retrying", "message": "Retrying AI request..."})
 
-			editOutput, err = h.callGeminiEditFlow(ctx, userID, body.Text, body.Timezone)
+			editOutput, err = h.nlpService().EditTasks(ctx, userID, body.Text, body.Timezone)
 			if err != nil {
 				h.refundNLCredit(ctx, userObjID, userID)
 				_ = sse.SendError("Failed to process natural language edit with AI after retry. Your credit has been refunded.")
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 
+	"github.com/abhikaboy/Kindred/internal/audience"
 	"github.com/abhikaboy/Kindred/internal/handlers/auth"
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
 	"github.com/danielgtaylor/huma/v2"
@@ -19,6 +20,7 @@ type UpdateTaskTagsInput struct {
 	ID            string `path:"id" example:"507f1f77bcf86cd799439011"`
 	Body          struct {
 		TaggedUserIDs []string `json:"taggedUserIds"`
+		TaggedListIDs []string `json:"taggedListIds,omitempty" doc:"Friend lists whose members to tag; close_friends names the built-in list"`
 	}
 }
 
@@ -46,7 +48,15 @@ func (h *Handler) UpdateTaskTags(ctx context.Context, input *UpdateTaskTagsInput
 		return nil, huma.Error400BadRequest("Invalid user ID format", err)
 	}
 
-	added, err := h.service.UpdateTaskTags(userObjID, categoryID, taskID, input.Body.TaggedUserIDs)
+	taggedIDs, err := h.service.ExpandTagLists(ctx, userObjID, input.Body.TaggedUserIDs, input.Body.TaggedListIDs)
+	if errors.Is(err, audience.ErrListNotFound) {
+		return nil, huma.Error404NotFound("Friend list not found", err)
+	}
+	if err != nil {
+		return nil, huma.Error500InternalServerError("Unable to update tags", err)
+	}
+
+	added, err := h.service.UpdateTaskTags(userObjID, categoryID, taskID, taggedIDs)
 	if err != nil {
 		switch {
 		case errors.Is(err, ErrTaskNotFound):
@@ -21,6 +21,40 @@ type TaggerInfo struct {
 	ProfilePicture string             `bson:"profile_picture" json:"profile_picture"`
 }
 
+// ExpandTagLists adds the members of owner's friend lists to a set of tagged
+// user IDs. A list that isn't owner's yields audience.ErrListNotFound.
+func (s *Service) ExpandTagLists(ctx context.Context, owner primitive.ObjectID, userIDs, listIDs []string) ([]string, error) {
+	if len(listIDs) == 0 || s.Audiences == nil {
+		return userIDs, nil
+	}
+	for _, ref := range listIDs {
+		if ref == "" {
+			continue
+		}
+		members, err := s.Audiences.ListMembers(ctx, owner, ref)
+		if err != nil {
+			return nil, err
+		}
+		userIDs = mergeTagIDs(userIDs, members)
+	}
+	return userIDs, nil
+}
+
+// mergeTagIDs appends the members not already in ids.
+func mergeTagIDs(ids []string, members []primitive.ObjectID) []string {
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		seen[id] = true
+	}
+	for _, m := range members {
+		if hex := m.Hex(); !seen[hex] {
+			seen[hex] = true
+			ids = append(ids, hex)
+		}
+	}
+	return ids
+}
+
 // PendingTaggedTask carries everything the home banner and the Copy prefill
 // need in one payload.
 type PendingTaggedTask struct {
@@ -623,3 +623,11 @@ func (s *TagServiceTestSuite) TestConstructTaskFromTemplate_CopiesTaggedUsers()
 	s.Len(task.TaggedUsers, 1)
 	s.Equal(types.TagStatusWatching, task.TaggedUsers[0].Status)
 }
+
+func TestMergeTagIDs(t *testing.T) {
+	a, b := primitive.NewObjectID(), primitive.NewObjectID()
+	got := mergeTagIDs([]string{a.Hex()}, []primitive.ObjectID{b, a, b})
+	if len(got) != 2 || got[0] != a.Hex() || got[1] != b.Hex() {
+		t.Errorf("mergeTagIDs = %v, want [a b]", got)
+	}
+}
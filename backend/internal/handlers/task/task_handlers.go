@@ -39,8 +39,8 @@ type MultiTaskOutputLocal struct {
 }
 
 type Handler struct {
-	service       *Service
-	geminiService any // Service interface - using any to avoid circular import
+	service *Service
+	nlp     NLPService // nil disables the natural-language endpoints
 }
 
 func (h *Handler) GetTasksByUser(ctx context.Context, input *GetTasksByUserInput) (*GetTasksByUserOutput, error) {
@@ -102,6 +102,10 @@ func (h *Handler) CreateTask(ctx context.Context, input *CreateTaskInput) (*Crea
 
 	taskParams := input.Body
 
+	if err := h.guardDuplicates(ctx, userObjID, []string{taskParams.Content}, input.AllowDuplicates); err != nil {
+		return nil, err
+	}
+
 	// New tasks aren't "in progress" unless the client explicitly says so.
 	isActive := false
 	if taskParams.Active != nil {
@@ -138,9 +142,14 @@ func (h *Handler) CreateTask(ctx context.Context, input *CreateTaskInput) (*Crea
 		task.SessionTrackable = deriveSessionTrackable(task.Checklist, task.Deadline, task.Value)
 	}
 
-	// Resolve tagged friends to denormalized pending entries
-	if len(taskParams.TaggedUserIDs) > 0 {
-		tagged, err := h.service.BuildTaggedUsers(taskParams.TaggedUserIDs)
+	// Resolve tagged friends and friend lists to denormalized pending entries
+	taggedIDs, err := h.service.ExpandTagLists(ctx, userObjID, taskParams.TaggedUserIDs, taskParams.TaggedListIDs)
+	if err != nil {
+		slog.Error("Failed to expand tagged friend lists", "error", err)
+		taggedIDs = taskParams.TaggedUserIDs
+	}
+	if len(taggedIDs) > 0 {
+		tagged, err := h.service.BuildTaggedUsers(taggedIDs)
 		if err != nil {
 			slog.Error("Failed to resolve tagged users", "error", err)
 		} else {
@@ -2,11 +2,9 @@ package task
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log/slog"
 	"math"
-	"reflect"
 	"strings"
 	"sync"
 	"time"
@@ -18,117 +16,6 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-// callGeminiFlow uses reflection to call the Genkit flow without circular import
-func (h *Handler) callGeminiFlow(ctx context.Context, userID, text, timezone string) (*MultiTaskOutputLocal, error) {
-	if h.geminiService == nil {
-		return nil, fmt.Errorf("gemini service not available")
-	}
-
-	// Use reflection to access the flow
-	svcValue := reflect.ValueOf(h.geminiService)
-	flowField := svcValue.Elem().FieldByName("MultiTaskFromTextFlowWithContext")
-	if !flowField.IsValid() {
-		return nil, fmt.Errorf("gemini flow not configured")
-	}
-
-	// Get the Run method
-	runMethod := flowField.MethodByName("Run")
-	if !runMethod.IsValid() {
-		return nil, fmt.Errorf("gemini flow Run method not available")
-	}
-
-	// Create input value with the correct type
-	inputType := runMethod.Type().In(1)
-	inputValue := reflect.New(inputType).Elem()
-	inputValue.FieldByName("UserID").SetString(userID)
-	inputValue.FieldByName("Text").SetString(text)
-	inputValue.FieldByName("Timezone").SetString(timezone)
-
-	// Call the Run method
-	callResults := runMethod.Call([]reflect.Value{
-		reflect.ValueOf(ctx),
-		inputValue,
-	})
-
-	if len(callResults) != 2 {
-		return nil, fmt.Errorf("unexpected gemini flow response structure")
-	}
-
-	// Check for error
-	if !callResults[1].IsNil() {
-		if err, ok := callResults[1].Interface().(error); ok {
-			return nil, err
-		}
-		return nil, fmt.Errorf("unexpected error type from gemini flow")
-	}
-
-	// Convert result to local type
-	var result MultiTaskOutputLocal
-	resultBytes, err := json.Marshal(callResults[0].Interface())
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse AI response: %w", err)
-	}
-
-	if err := json.Unmarshal(resultBytes, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse AI response structure: %w", err)
-	}
-
-	return &result, nil
-}
-
-// callGeminiImageFlow uses reflection to call the Genkit image flow without circular import
-func (h *Handler) callGeminiImageFlow(ctx context.Context, userID, image, mimeType, timezone string) (*MultiTaskOutputLocal, error) {
-	if h.geminiService == nil {
-		return nil, fmt.Errorf("gemini service not available")
-	}
-
-	svcValue := reflect.ValueOf(h.geminiService)
-	flowField := svcValue.Elem().FieldByName("TaskFromImageFlow")
-	if !flowField.IsValid() {
-		return nil, fmt.Errorf("gemini flow not configured")
-	}
-
-	runMethod := flowField.MethodByName("Run")
-	if !runMethod.IsValid() {
-		return nil, fmt.Errorf("gemini flow Run method not available")
-	}
-
-	inputType := runMethod.Type().In(1)
-	inputValue := reflect.New(inputType).Elem()
-	inputValue.FieldByName("UserID").SetString(userID)
-	inputValue.FieldByName("Image").SetString(image)
-	inputValue.FieldByName("MimeType").SetString(mimeType)
-	inputValue.FieldByName("Timezone").SetString(timezone)
-
-	callResults := runMethod.Call([]reflect.Value{
-		reflect.ValueOf(ctx),
-		inputValue,
-	})
-
-	if len(callResults) != 2 {
-		return nil, fmt.Errorf("unexpected gemini flow response structure")
-	}
-
-	if !callResults[1].IsNil() {
-		if err, ok := callResults[1].Interface().(error); ok {
-			return nil, err
-		}
-		return nil, fmt.Errorf("unexpected error type from gemini flow")
-	}
-
-	var result MultiTaskOutputLocal
-	resultBytes, err := json.Marshal(callResults[0].Interface())
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse AI response: %w", err)
-	}
-
-	if err := json.Unmarshal(resultBytes, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse AI response structure: %w", err)
-	}
-
-	return &result, nil
-}
-
 // TaskQueryFiltersOutputLocal is the local version of the Gemini TaskQueryFiltersOutput.
 // Used to avoid circular imports with the gemini package.
 type TaskQueryFiltersOutputLocal struct {
@@ -144,57 +31,6 @@ type TaskQueryFiltersOutputLocal struct {
 	SortDir       int      `json:"sortDir,omitempty"`
 }
 
-// callGeminiQueryFlow calls the Gemini QueryTasksFlow using reflection to avoid circular imports
-func (h *Handler) callGeminiQueryFlow(ctx context.Context, userID, text, timezone string) (*TaskQueryFiltersOutputLocal, error) {
-	if h.geminiService == nil {
-		return nil, fmt.Errorf("gemini service not available")
-	}
-
-	svcValue := reflect.ValueOf(h.geminiService)
-	flowField := svcValue.Elem().FieldByName("QueryTasksFlow")
-	if !flowField.IsValid() {
-		return nil, fmt.Errorf("gemini query tasks flow not configured")
-	}
-
-	runMethod := flowField.MethodByName("Run")
-	if !runMethod.IsValid() {
-		return nil, fmt.Errorf("gemini query tasks flow Run method not available")
-	}
-
-	inputType := runMethod.Type().In(1)
-	inputValue := reflect.New(inputType).Elem()
-	inputValue.FieldByName("UserID").SetString(userID)
-	inputValue.FieldByName("Text").SetString(text)
-	inputValue.FieldByName("Timezone").SetString(timezone)
-
-	callResults := runMethod.Call([]reflect.Value{
-		reflect.ValueOf(ctx),
-		inputValue,
-	})
-
-	if len(callResults) != 2 {
-		return nil, fmt.Errorf("unexpected gemini flow response structure")
-	}
-
-	if !callResults[1].IsNil() {
-		if err, ok := callResults[1].Interface().(error); ok {
-			return nil, err
-		}
-		return nil, fmt.Errorf("unexpected error type from gemini flow")
-	}
-
-	var result TaskQueryFiltersOutputLocal
-	resultBytes, err := json.Marshal(callResults[0].Interface())
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse AI response: %w", err)
-	}
-	if err := json.Unmarshal(resultBytes, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse AI response structure: %w", err)
-	}
-
-	return &result, nil
-}
-
 // convertQueryOutput converts the Gemini output to a TaskQueryFilters for use with QueryTasksByUser
 func convertQueryOutput(output *TaskQueryFiltersOutputLocal) (TaskQueryFilters, error) {
 	filters := TaskQueryFilters{
@@ -268,57 +104,6 @@ type EditTasksFlowOutputLocal struct {
 	TemplateInstructions []EditTaskInstructionLocal `json:"templateInstructions,omitempty"`
 }
 
-// callGeminiEditFlow calls the Gemini EditTasksFlow using reflection to avoid circular imports.
-func (h *Handler) callGeminiEditFlow(ctx context.Context, userID, text, timezone string) (*EditTasksFlowOutputLocal, error) {
-	if h.geminiService == nil {
-		return nil, fmt.Errorf("gemini service not available")
-	}
-
-	svcValue := reflect.ValueOf(h.geminiService)
-	flowField := svcValue.Elem().FieldByName("EditTasksFlow")
-	if !flowField.IsValid() {
-		return nil, fmt.Errorf("gemini edit tasks flow not configured")
-	}
-
-	runMethod := flowField.MethodByName("Run")
-	if !runMethod.IsValid() {
-		return nil, fmt.Errorf("gemini edit tasks flow Run method not available")
-	}
-
-	inputType := runMethod.Type().In(1)
-	inputValue := reflect.New(inputType).Elem()
-	inputValue.FieldByName("UserID").SetString(userID)
-	inputValue.FieldByName("Text").SetString(text)
-	inputValue.FieldByName("Timezone").SetString(timezone)
-
-	callResults := runMethod.Call([]reflect.Value{
-		reflect.ValueOf(ctx),
-		inputValue,
-	})
-
-	if len(callResults) != 2 {
-		return nil, fmt.Errorf("unexpected gemini flow response structure")
-	}
-
-	if !callResults[1].IsNil() {
-		if err, ok := callResults[1].Interface().(error); ok {
-			return nil, err
-		}
-		return nil, fmt.Errorf("unexpected error type from gemini flow")
-	}
-
-	var result EditTasksFlowOutputLocal
-	resultBytes, err := json.Marshal(callResults[0].Interface())
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse AI response: %w", err)
-	}
-	if err := json.Unmarshal(resultBytes, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse AI response structure: %w", err)
-	}
-
-	return &result, nil
-}
-
 // --- Intent router flow local mirror types (avoid circular import with gemini package) ---
 
 // IntentOpLocal mirrors gemini.IntentOp but uses local types.
@@ -334,57 +119,6 @@ type IntentRouterOutputLocal struct {
 	Ops []IntentOpLocal `json:"ops"`
 }
 
-// callGeminiIntentFlow calls the Gemini IntentRouterFlow using reflection to avoid circular imports.
-func (h *Handler) callGeminiIntentFlow(ctx context.Context, userID, text, timezone string) (*IntentRouterOutputLocal, error) {
-	if h.geminiService == nil {
-		return nil, fmt.Errorf("gemini service not available")
-	}
-
-	svcValue := reflect.ValueOf(h.geminiService)
-	flowField := svcValue.Elem().FieldByName("IntentRouterFlow")
-	if !flowField.IsValid() {
-		return nil, fmt.Errorf("gemini intent router flow not configured")
-	}
-
-	runMethod := flowField.MethodByName("Run")
-	if !runMethod.IsValid() {
-		return nil, fmt.Errorf("gemini intent router flow Run method not available")
-	}
-
-	inputType := runMethod.Type().In(1)
-	inputValue := reflect.New(inputType).Elem()
-	inputValue.FieldByName("UserID").SetString(userID)
-	inputValue.FieldByName("Text").SetString(text)
-	inputValue.FieldByName("Timezone").SetString(timezone)
-
-	callResults := runMethod.Call([]reflect.Value{
-		reflect.ValueOf(ctx),
-		inputValue,
-	})
-
-	if len(callResults) != 2 {
-		return nil, fmt.Errorf("unexpected gemini flow response structure")
-	}
-
-	if !callResults[1].IsNil() {
-		if err, ok := callResults[1].Interface().(error); ok {
-			return nil, err
-		}
-		return nil, fmt.Errorf("unexpected error type from gemini flow")
-	}
-
-	var result IntentRouterOutputLocal
-	resultBytes, err := json.Marshal(callResults[0].Interface())
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse AI response: %w", err)
-	}
-	if err := json.Unmarshal(resultBytes, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse AI response structure: %w", err)
-	}
-
-	return &result, nil
-}
-
 // --- Suggest task fields flow local mirror types (avoid circular import with gemini package) ---
 
 // TaskFieldSuggestionLocal mirrors gemini.SuggestTaskFieldsFlowOutput.
@@ -394,60 +128,13 @@ type TaskFieldSuggestionLocal struct {
 	Value      *float64 `json:"value,omitempty"`
 }
 
-// callGeminiSuggestFlow calls the Gemini SuggestTaskFieldsFlow using reflection to avoid circular imports.
-func (h *Handler) callGeminiSuggestFlow(ctx context.Context, userID, text, timezone string) (*TaskFieldSuggestionLocal, error) {
-	if h.geminiService == nil {
-		return nil, fmt.Errorf("gemini service not available")
-	}
-
-	svcValue := reflect.ValueOf(h.geminiService)
-	flowField := svcValue.Elem().FieldByName("SuggestTaskFieldsFlow")
-	if !flowField.IsValid() {
-		return nil, fmt.Errorf("gemini suggest task fields flow not configured")
-	}
-
-	runMethod := flowField.MethodByName("Run")
-	if !runMethod.IsValid() {
-		return nil, fmt.Errorf("gemini suggest task fields flow Run method not available")
-	}
-
-	inputType := runMethod.Type().In(1)
-	inputValue := reflect.New(inputType).Elem()
-	inputValue.FieldByName("UserID").SetString(userID)
-	inputValue.FieldByName("Text").SetString(text)
-	inputValue.FieldByName("Timezone").SetString(timezone)
-
-	callResults := runMethod.Call([]reflect.Value{
-		reflect.ValueOf(ctx),
-		inputValue,
-	})
-
-	if len(callResults) != 2 {
-		return nil, fmt.Errorf("unexpected gemini flow response structure")
-	}
-
-	if !callResults[1].IsNil() {
-		if err, ok := callResults[1].Interface().(error); ok {
-			return nil, err
-		}
-		return nil, fmt.Errorf("unexpected error type from gemini flow")
-	}
-
-	var result TaskFieldSuggestionLocal
-	resultBytes, err := json.Marshal(callResults[0].Interface())
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse AI response: %w", err)
-	}
-	if err := json.Unmarshal(resultBytes, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse AI response structure: %w", err)
-	}
-
-	return &result, nil
-}
-
 // userCategoryIDs returns the hex ids of every category the user owns.
 func (h *Handler) userCategoryIDs(ctx context.Context, userID primitive.ObjectID) ([]string, error) {
-	cursor, err := h.service.Tasks.Find(ctx, bson.M{"user": userID}, options.Find().SetProjection(bson.M{"_id": 1}))
+	return h.service.ownedCategoryIDs(ctx, userID)
+}
+
+func (s *Service) ownedCategoryIDs(ctx context.Context, userID primitive.ObjectID) ([]string, error) {
+	cursor, err := s.Tasks.Find(ctx, bson.M{"user": userID}, options.Find().SetProjection(bson.M{"_id": 1}))
 	if err != nil {
 		return nil, err
 	}
@@ -735,6 +422,17 @@ func (h *Handler) processExistingCategoryTasks(ctx context.Context, taskPairs []
 		err  error
 	}
 
+	// Category IDs come from the model or a client-echoed preview; tasks are
+	// only ever added to the user's own categories.
+	owned, err := h.service.ownedCategoryIDs(ctx, userID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to load categories: %w", err)
+	}
+	ownedSet := make(map[string]bool, len(owned))
+	for _, id := range owned {
+		ownedSet[id] = true
+	}
+
 	results := make(chan taskResult, len(taskPairs))
 	var wg sync.WaitGroup
 
@@ -747,6 +445,13 @@ func (h *Handler) processExistingCategoryTasks(ctx context.Context, taskPairs []
 			continue
 		}
 
+		if !ownedSet[taskPair.CategoryID] {
+			slog.LogAttrs(ctx, slog.LevelWarn, "Skipping task for a category the user doesn't own",
+				slog.String("userID", userID.Hex()),
+				slog.String("categoryID", taskPair.CategoryID))
+			continue
+		}
+
 		// Convert string CategoryID to ObjectID
 		categoryObjID, err := primitive.ObjectIDFromHex(taskPair.CategoryID)
 		if err != nil {
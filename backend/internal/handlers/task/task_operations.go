@@ -31,9 +31,12 @@ type GetTasksByUserOutput struct {
 
 // Create Task
 type CreateTaskInput struct {
-	Authorization string           `header:"Authorization" required:"true"`
-	Category      string           `path:"category" example:"507f1f77bcf86cd799439011"`
-	Body          CreateTaskParams `json:"body"`
+	Authorization string `header:"Authorization" required:"true"`
+	Category      string `path:"category" example:"507f1f77bcf86cd799439011"`
+	// Without AllowDuplicates a task that looks like an active one is
+	// refused with a 409 listing the matches.
+	AllowDuplicates bool             `query:"allowDuplicates" doc:"Create the task even if it looks like an existing active task"`
+	Body            CreateTaskParams `json:"body"`
 }
 
 type CreateTaskOutput struct {
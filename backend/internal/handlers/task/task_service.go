@@ -10,11 +10,13 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/abhikaboy/Kindred/internal/audience"
 	"github.com/abhikaboy/Kindred/internal/handlers/encouragement"
 	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
 	"github.com/abhikaboy/Kindred/internal/handlers/rings"
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
 	mongorepo "github.com/abhikaboy/Kindred/internal/repository/mongo"
+	"github.com/abhikaboy/Kindred/internal/timeline"
 	"github.com/abhikaboy/Kindred/xutils"
 	"github.com/gofiber/fiber/v2"
 	"go.mongodb.org/mongo-driver/bson"
@@ -157,6 +159,18 @@ func (s *Service) rescheduleInc(ctx context.Context, taskId, categoryId primitiv
 // newService receives the map of collections and picks out Jobs
 func newService(collections map[string]*mongo.Collection, ringService *rings.RingService) *Service {
 	users := mongorepo.NewUserRepository(collections["users"])
+	assistantSessions := collections["assistant_sessions"]
+	if assistantSessions == nil && collections["categories"] != nil {
+		assistantSessions = collections["categories"].Database().Collection("assistant_sessions")
+	}
+	taskEmbeddings := collections["task_embeddings"]
+	if taskEmbeddings == nil && collections["categories"] != nil {
+		taskEmbeddings = collections["categories"].Database().Collection("task_embeddings")
+	}
+	voiceTranscripts := collections["voice_transcripts"]
+	if voiceTranscripts == nil && collections["categories"] != nil {
+		voiceTranscripts = collections["categories"].Database().Collection("voice_transcripts")
+	}
 	return &Service{
 		Tasks:               collections["categories"],
 		Users:               users,
@@ -165,6 +179,11 @@ func newService(collections map[string]*mongo.Collection, ringService *rings.Rin
 		EncouragementHelper: encouragement.NewEncouragementService(collections),
 		RingService:         ringService,
 		NotificationService: notifications.NewNotificationService(collections),
+		AssistantSessions:   assistantSessions,
+		TaskEmbeddings:      taskEmbeddings,
+		VoiceTranscripts:    voiceTranscripts,
+		Timeline:            timeline.New(collections),
+		Audiences:           audience.New(collections),
 	}
 }
 
@@ -481,6 +500,7 @@ func (s *Service) UpdatePartialTask(
 	}
 
 	s.enqueuePushUpsertIfEnabled(context.Background(), id, categoryId, ownerUserID)
+	s.invalidateTaskEmbeddingIfEdited(ctx, id, updated.Content)
 
 	return nil, err
 }
@@ -577,6 +597,7 @@ func (s *Service) CompleteTask(
 	if err != nil {
 		return nil, err
 	}
+	s.invalidateTaskEmbedding(ctx, id)
 
 	// Update recurring template stats if this was a recurring task
 	var flexResult *NextFlexTaskInfo
@@ -681,6 +702,20 @@ func (s *Service) CompleteTask(
 		}()
 	}
 
+	if taskToComplete.Public && taskToComplete.ID != primitive.NilObjectID {
+		go s.fanOutCompletion(taskToComplete, categoryId, userBefore, completedNow)
+		go s.notifyGroupsOfCompletion(taskToComplete, userBefore)
+	}
+
+	if s.Challenges != nil && taskToComplete.ChallengeID != nil {
+		challengeID := *taskToComplete.ChallengeID
+		go func() {
+			if err := s.Challenges.RecordChallengeCompletion(context.Background(), challengeID, userId, completedNow); err != nil {
+				slog.Error("Failed to record challenge progress", "challengeID", challengeID.Hex(), "userID", userId.Hex(), "error", err)
+			}
+		}()
+	}
+
 	if len(taskToComplete.TaggedUsers) > 0 {
 		tc := taskToComplete
 		go func() {
@@ -700,6 +735,42 @@ func (s *Service) CompleteTask(
 	}, nil
 }
 
+// fanOutCompletion files a completed public task in friends' timelines.
+func (s *Service) fanOutCompletion(task TaskDocument, categoryID primitive.ObjectID, user types.User, at time.Time) {
+	ctx := context.Background()
+
+	var category struct {
+		Name          string `bson:"name"`
+		WorkspaceName string `bson:"workspaceName"`
+	}
+	if err := s.Tasks.FindOne(ctx, bson.M{"_id": categoryID},
+		options.FindOne().SetProjection(bson.M{"name": 1, "workspaceName": 1})).Decode(&category); err != nil {
+		slog.Warn("Failed to load category for timeline fan-out", "categoryID", categoryID.Hex(), "error", err)
+	}
+
+	s.Timeline.FanOut(ctx, timeline.Event{
+		Kind:   timeline.KindTask,
+		Ref:    task.ID,
+		Author: user.ID,
+		At:     at,
+		Task: &timeline.CompletedTask{
+			Content:       task.Content,
+			Priority:      task.Priority,
+			Value:         task.Value,
+			Timestamp:     task.Timestamp,
+			CategoryID:    categoryID,
+			CategoryName:  category.Name,
+			WorkspaceName: category.WorkspaceName,
+			User: types.UserExtendedReferenceInternal{
+				ID:             user.ID,
+				DisplayName:    user.DisplayName,
+				Handle:         user.Handle,
+				ProfilePicture: user.ProfilePicture,
+			},
+		},
+	})
+}
+
 // highValueThreshold is the Value (0-10 scale) at or above which a task is
 // considered "high value" for auto-enabling session tracking.
 const highValueThreshold = 8.0
@@ -1248,6 +1319,8 @@ func (s *Service) BulkDeleteTask(userId primitive.ObjectID, tasks []BulkDeleteTa
 		}
 	}
 
+	s.invalidateTaskEmbedding(ctx, successfulTaskIDs...)
+
 	// Bulk delete tasks from categories using $pull with $in
 	for categoryID, taskIDsInCategory := range tasksByCategory {
 		// Use $pullAll to remove multiple tasks at once
@@ -1363,6 +1436,7 @@ func (s *Service) DeleteTask(categoryId primitive.ObjectID, id primitive.ObjectI
 	}
 	_ = s.Tasks.FindOne(ctx, bson.M{"_id": categoryId}, options.FindOne().SetProjection(bson.M{"user": 1})).Decode(&ownerDoc)
 	s.snapshotPushTargetForDelete(context.Background(), id, categoryId, ownerDoc.User)
+	s.invalidateTaskEmbedding(ctx, id)
 	result, err := s.Tasks.UpdateOne(
 		ctx, bson.M{
 			"_id": categoryId,
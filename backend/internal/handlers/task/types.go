@@ -4,10 +4,12 @@ import (
 	"context"
 	"time"
 
+	"github.com/abhikaboy/Kindred/internal/audience"
 	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
 	"github.com/abhikaboy/Kindred/internal/handlers/rings"
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
 	"github.com/abhikaboy/Kindred/internal/repository"
+	"github.com/abhikaboy/Kindred/internal/timeline"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -19,6 +21,13 @@ type PushEnqueuer interface {
 	EnqueueDelete(ctx context.Context, taskID, categoryID, userID, connectionID primitive.ObjectID, eventID, calendarID string) error
 }
 
+// ChallengeRecorder is satisfied by the group service; injected via a setter
+// so completions of challenge tasks count toward the member's progress
+// without the task package depending on groups.
+type ChallengeRecorder interface {
+	RecordChallengeCompletion(ctx context.Context, challengeID, userID primitive.ObjectID, at time.Time) error
+}
+
 type CreateTaskParams struct {
 	Priority  int     `validate:"required,min=1,max=3" bson:"priority" json:"priority"`
 	Content   string  `validate:"required" bson:"content" json:"content"`
@@ -44,6 +53,9 @@ type CreateTaskParams struct {
 	SessionTrackable *bool `bson:"sessionTrackable,omitempty" json:"sessionTrackable,omitempty"`
 
 	TaggedUserIDs []string `bson:"-" json:"taggedUserIds,omitempty"`
+	// TaggedListIDs tags everyone on the given friend lists; close_friends
+	// names the built-in list.
+	TaggedListIDs []string `bson:"-" json:"taggedListIds,omitempty"`
 }
 
 type SortParams struct {
@@ -178,6 +190,13 @@ type Service struct {
 	RingService         *rings.RingService
 	PushEnqueuer        PushEnqueuer // optional; nil disables push hooks
 	NotificationService *notifications.Service
+	AssistantSessions   *mongo.Collection
+	TaskEmbeddings      *mongo.Collection // cached vectors for duplicate detection; nil disables caching
+	VoiceTranscripts    *mongo.Collection
+	Voice               VoiceTranscriber // optional; nil disables voice task creation
+	Timeline            *timeline.Service
+	Audiences           *audience.Service
+	Challenges          ChallengeRecorder // optional; nil disables challenge progress
 }
 
 // EncouragementServiceInterface defines the methods we need from the encouragement service
@@ -380,6 +380,7 @@ func constructTaskFromTemplate(templateDoc *TemplateTaskDocument) TaskDocument {
 		Notes:          templateDoc.Notes,
 		Checklist:      checklist,
 		TaggedUsers:    templateDoc.TaggedUsers,
+		ChallengeID:    templateDoc.ChallengeID,
 	}
 
 	if templateDoc.FlexState != nil {
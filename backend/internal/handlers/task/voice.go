@@ -0,0 +1,140 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/transcribe"
+	"github.com/danielgtaylor/huma/v2"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// VoiceModeIntent routes the transcript like /natural-language/intent:
+	// edits are applied, creates and deletes come back for confirmation.
+	VoiceModeIntent = "intent"
+	// VoiceModeCreate creates the tasks in the transcript straight away, like
+	// /natural-language.
+	VoiceModeCreate = "create"
+)
+
+// VoiceTranscriber transcribes a recording the user uploaded through a
+// presigned voice upload URL, and deletes it once its tasks exist.
+// transcribe.UploadTranscriber implements it.
+type VoiceTranscriber interface {
+	TranscribeUpload(ctx context.Context, userID, key, language string) (*transcribe.Transcript, error)
+	DeleteUpload(ctx context.Context, userID, key string)
+}
+
+// voiceTranscriptDocument keeps what a recording said next to the tasks made
+// from it. TaskIDs grows when create ops from an intent-mode recording are
+// confirmed; ConfirmedAt marks the one confirm the voice credit already paid
+// for.
+type voiceTranscriptDocument struct {
+	ID              primitive.ObjectID   `bson:"_id"`
+	UserID          primitive.ObjectID   `bson:"user_id"`
+	Text            string               `bson:"text"`
+	Language        string               `bson:"language,omitempty"`
+	DurationSeconds float64              `bson:"duration_seconds,omitempty"`
+	Model           string               `bson:"model"`
+	Mode            string               `bson:"mode"`
+	TaskIDs         []primitive.ObjectID `bson:"task_ids"`
+	ConfirmedAt     *time.Time           `bson:"confirmed_at,omitempty"`
+	CreatedAt       time.Time            `bson:"created_at"`
+}
+
+func newVoiceTranscript(userID primitive.ObjectID, mode string, t *transcribe.Transcript) voiceTranscriptDocument {
+	return voiceTranscriptDocument{
+		ID:              primitive.NewObjectID(),
+		UserID:          userID,
+		Text:            t.Text,
+		Language:        t.Language,
+		DurationSeconds: t.DurationSeconds,
+		Model:           t.Model,
+		Mode:            mode,
+		TaskIDs:         []primitive.ObjectID{},
+		CreatedAt:       time.Now().UTC(),
+	}
+}
+
+func (s *Service) saveVoiceTranscript(ctx context.Context, doc voiceTranscriptDocument) error {
+	_, err := s.VoiceTranscripts.InsertOne(ctx, doc)
+	return err
+}
+
+// claimVoiceConfirm marks the first confirm of an intent-mode transcript.
+// It reports false if the transcript isn't the user's or was confirmed
+// already, in which case the confirm is billed like any other.
+func (s *Service) claimVoiceConfirm(ctx context.Context, userID, transcriptID primitive.ObjectID) (bool, error) {
+	res, err := s.VoiceTranscripts.UpdateOne(ctx,
+		bson.M{"_id": transcriptID, "user_id": userID, "mode": VoiceModeIntent, "confirmed_at": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"confirmed_at": time.Now().UTC()}},
+	)
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+// linkVoiceTranscript records tasks as made from the user's transcript, on
+// both the tasks and the transcript, and sets the link on the returned
+// documents.
+func (s *Service) linkVoiceTranscript(ctx context.Context, userID, transcriptID primitive.ObjectID, tasks []TaskDocument) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	ids := make([]primitive.ObjectID, len(tasks))
+	for i, t := range tasks {
+		ids[i] = t.ID
+	}
+
+	res, err := s.VoiceTranscripts.UpdateOne(ctx,
+		bson.M{"_id": transcriptID, "user_id": userID},
+		bson.M{"$addToSet": bson.M{"task_ids": bson.M{"$each": ids}}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	_, err = s.Tasks.UpdateMany(ctx,
+		bson.M{"user": userID, "tasks._id": bson.M{"$in": ids}},
+		bson.M{"$set": bson.M{"tasks.$[t].voiceTranscriptId": transcriptID}},
+		options.Update().SetArrayFilters(options.ArrayFilters{Filters: bson.A{bson.M{"t._id": bson.M{"$in": ids}}}}),
+	)
+	if err != nil {
+		return err
+	}
+	for i := range tasks {
+		tasks[i].VoiceTranscriptID = &transcriptID
+	}
+	return nil
+}
+
+// transcriptionError maps a transcription failure to the response the client
+// gets. The voice credit has been refunded by then.
+func transcriptionError(err error) error {
+	switch {
+	case errors.Is(err, transcribe.ErrUnavailable):
+		return huma.Error503ServiceUnavailable("Voice transcription is not available", err)
+	case errors.Is(err, transcribe.ErrForeignUpload):
+		return huma.Error403Forbidden("This recording does not belong to you", err)
+	case errors.Is(err, transcribe.ErrUploadNotFound):
+		return huma.Error404NotFound("Recording not found. Upload it first, then retry.", err)
+	case errors.Is(err, transcribe.ErrUploadTooLarge):
+		return huma.NewError(http.StatusRequestEntityTooLarge, "Recording is too large", err)
+	case errors.Is(err, transcribe.ErrUnsupportedAudio):
+		return huma.Error415UnsupportedMediaType("Unsupported audio format", err)
+	case errors.Is(err, transcribe.ErrNoSpeech):
+		return huma.Error422UnprocessableEntity("No speech was recognised in the recording. Your credit has been refunded.", err)
+	default:
+		return huma.Error500InternalServerError("Failed to transcribe the recording. Your credit has been refunded.", err)
+	}
+}
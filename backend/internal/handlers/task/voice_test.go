@@ -0,0 +1,30 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/abhikaboy/Kindred/internal/transcribe"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranscriptionError_Statuses(t *testing.T) {
+	cases := map[error]int{
+		transcribe.ErrUnavailable:                                   http.StatusServiceUnavailable,
+		transcribe.ErrForeignUpload:                                 http.StatusForbidden,
+		transcribe.ErrUploadNotFound:                                http.StatusNotFound,
+		transcribe.ErrUploadTooLarge:                                http.StatusRequestEntityTooLarge,
+		fmt.Errorf("%w: video/mp4", transcribe.ErrUnsupportedAudio): http.StatusUnsupportedMediaType,
+		transcribe.ErrNoSpeech:                                      http.StatusUnprocessableEntity,
+		errors.New("transcription returned 500: boom"):              http.StatusInternalServerError,
+	}
+	for err, want := range cases {
+		var se huma.StatusError
+		if assert.ErrorAs(t, transcriptionError(err), &se, err.Error()) {
+			assert.Equal(t, want, se.GetStatus(), err.Error())
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package timeblock
+
+import (
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+func RegisterCreateTimeBlockOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-time-block",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/time-blocks",
+		Summary:     "Create a focus block",
+		Description: "Reserve a window for a group of tasks. The tasks are scheduled at the block's start and the block is pushed to the calendar as a single event in place of the tasks' own events.",
+		Tags:        []string{"time-blocks"},
+	}, handler.CreateTimeBlock)
+}
+
+func RegisterListTimeBlocksOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-time-blocks",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/time-blocks",
+		Summary:     "List focus blocks",
+		Description: "List scheduled and ended focus blocks overlapping a time range",
+		Tags:        []string{"time-blocks"},
+	}, handler.ListTimeBlocks)
+}
+
+func RegisterGetTimeBlockOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-time-block",
+		Method:      http.MethodGet,
+		Path:        "/v1/user/time-blocks/{blockId}",
+		Summary:     "Get a focus block",
+		Description: "Get a focus block, including its end-of-block report once it has ended",
+		Tags:        []string{"time-blocks"},
+	}, handler.GetTimeBlock)
+}
+
+func RegisterUpdateTimeBlockOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "update-time-block",
+		Method:      http.MethodPatch,
+		Path:        "/v1/user/time-blocks/{blockId}",
+		Summary:     "Update a focus block",
+		Description: "Rename, move or change the tasks of a scheduled block. Moving the block moves its tasks; tasks removed from the block are released.",
+		Tags:        []string{"time-blocks"},
+	}, handler.UpdateTimeBlock)
+}
+
+func RegisterDeleteTimeBlockOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-time-block",
+		Method:      http.MethodDelete,
+		Path:        "/v1/user/time-blocks/{blockId}",
+		Summary:     "Cancel a focus block",
+		Description: "Cancel a scheduled block and remove its calendar event. The tasks keep their start times.",
+		Tags:        []string{"time-blocks"},
+	}, handler.DeleteTimeBlock)
+}
+
+func RegisterEndTimeBlockOperation(api huma.API, handler *Handler) {
+	huma.Register(api, huma.Operation{
+		OperationID: "end-time-block",
+		Method:      http.MethodPost,
+		Path:        "/v1/user/time-blocks/{blockId}/end",
+		Summary:     "End a focus block",
+		Description: "End a block now instead of waiting for its end time. Unfinished tasks roll over to the next day; the response carries the completed vs. rolled-over report.",
+		Tags:        []string{"time-blocks"},
+	}, handler.EndTimeBlock)
+}
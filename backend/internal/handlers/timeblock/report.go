@@ -0,0 +1,42 @@
+package timeblock
+
+import (
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// buildReport sorts a block's tasks by what became of them: completed if they
+// reached completed-tasks, rolled over if they are still open, removed if
+// they are in neither (deleted). Block order is preserved within each list.
+func buildReport(taskIDs []primitive.ObjectID, open, completed map[primitive.ObjectID]bool, endedAt time.Time) *types.TimeBlockReport {
+	report := &types.TimeBlockReport{
+		CompletedTaskIDs:  []primitive.ObjectID{},
+		RolledOverTaskIDs: []primitive.ObjectID{},
+		EndedAt:           endedAt,
+	}
+	for _, id := range taskIDs {
+		switch {
+		case completed[id]:
+			report.CompletedTaskIDs = append(report.CompletedTaskIDs, id)
+		case open[id]:
+			report.RolledOverTaskIDs = append(report.RolledOverTaskIDs, id)
+		default:
+			report.RemovedTaskIDs = append(report.RemovedTaskIDs, id)
+		}
+	}
+	return report
+}
+
+// rolloverDate is local midnight of the day after the block ended, which is
+// where unfinished tasks land.
+func rolloverDate(blockEnd time.Time, loc *time.Location) time.Time {
+	local := blockEnd.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, loc)
+}
+
+// validWindow reports whether [start, end) is a usable block.
+func validWindow(start, end time.Time) bool {
+	return end.After(start) && end.Sub(start) <= maxBlockLength
+}
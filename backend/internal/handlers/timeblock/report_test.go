@@ -0,0 +1,97 @@
+package timeblock
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestBuildReport(t *testing.T) {
+	done, open, gone := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+	endedAt := time.Date(2026, 3, 10, 11, 0, 0, 0, time.UTC)
+
+	report := buildReport(
+		[]primitive.ObjectID{open, done, gone},
+		map[primitive.ObjectID]bool{open: true},
+		map[primitive.ObjectID]bool{done: true},
+		endedAt,
+	)
+
+	if len(report.CompletedTaskIDs) != 1 || report.CompletedTaskIDs[0] != done {
+		t.Errorf("completed = %v, want [%v]", report.CompletedTaskIDs, done)
+	}
+	if len(report.RolledOverTaskIDs) != 1 || report.RolledOverTaskIDs[0] != open {
+		t.Errorf("rolled over = %v, want [%v]", report.RolledOverTaskIDs, open)
+	}
+	if len(report.RemovedTaskIDs) != 1 || report.RemovedTaskIDs[0] != gone {
+		t.Errorf("removed = %v, want [%v]", report.RemovedTaskIDs, gone)
+	}
+	if !report.EndedAt.Equal(endedAt) {
+		t.Errorf("endedAt = %v, want %v", report.EndedAt, endedAt)
+	}
+}
+
+func TestRolloverDate_UsesBlockTimezone(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+	// 23:30 in New York is already the next day in UTC; the rollover must
+	// still be the day after the *local* date.
+	end := time.Date(2026, 3, 10, 23, 30, 0, 0, ny)
+
+	got := rolloverDate(end.UTC(), ny)
+	want := time.Date(2026, 3, 11, 0, 0, 0, 0, ny)
+	if !got.Equal(want) {
+		t.Errorf("rolloverDate = %v, want %v", got, want)
+	}
+}
+
+func TestValidWindow(t *testing.T) {
+	start := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		end  time.Time
+		want bool
+	}{
+		{"two hours", start.Add(2 * time.Hour), true},
+		{"exactly max", start.Add(maxBlockLength), true},
+		{"too long", start.Add(maxBlockLength + time.Minute), false},
+		{"zero length", start, false},
+		{"ends before start", start.Add(-time.Hour), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validWindow(start, tt.end); got != tt.want {
+				t.Errorf("validWindow = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUniqueTaskIDs(t *testing.T) {
+	a, b := primitive.NewObjectID(), primitive.NewObjectID()
+
+	got := uniqueTaskIDs([]primitive.ObjectID{a, b, a, b, a})
+	if len(got) != 2 || got[0] != a || got[1] != b {
+		t.Errorf("uniqueTaskIDs = %v, want [%v %v]", got, a, b)
+	}
+	if uniqueTaskIDs(nil) != nil {
+		t.Error("uniqueTaskIDs(nil) should stay nil so updates can tell \"unchanged\" from \"empty\"")
+	}
+}
+
+func TestParseIntegration(t *testing.T) {
+	connID := primitive.NewObjectID()
+
+	gotConn, gotCal, ok := parseIntegration("gcal:" + connID.Hex() + ":team@group.calendar.google.com")
+	if !ok || gotConn != connID || gotCal != "team@group.calendar.google.com" {
+		t.Errorf("parseIntegration = (%v, %q, %v)", gotConn, gotCal, ok)
+	}
+	for _, bad := range []string{"", "gcal:", "gcal:nothex:primary", "ical:" + connID.Hex() + ":primary", "gcal:" + connID.Hex() + ":"} {
+		if _, _, ok := parseIntegration(bad); ok {
+			t.Errorf("parseIntegration(%q) should fail", bad)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package timeblock
+
+import (
+	"github.com/danielgtaylor/huma/v2"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Routes registers the focus block endpoints. pushEnqueuer may be nil, in
+// which case blocks are not pushed to calendars.
+func Routes(api huma.API, collections map[string]*mongo.Collection, pushEnqueuer PushEnqueuer) *Service {
+	service := NewService(collections)
+	service.PushEnqueuer = pushEnqueuer
+	handler := &Handler{service: service}
+
+	RegisterCreateTimeBlockOperation(api, handler)
+	RegisterListTimeBlocksOperation(api, handler)
+	RegisterGetTimeBlockOperation(api, handler)
+	RegisterUpdateTimeBlockOperation(api, handler)
+	RegisterDeleteTimeBlockOperation(api, handler)
+	RegisterEndTimeBlockOperation(api, handler)
+
+	return service
+}
@@ -0,0 +1,553 @@
+package timeblock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	ph "github.com/abhikaboy/Kindred/internal/posthog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// NewService wires the collections a time block touches. The push enqueuer is
+// set separately once the calendar service exists.
+func NewService(collections map[string]*mongo.Collection) *Service {
+	db := collections["categories"].Database()
+	connections := collections["calendar_connections"]
+	if connections == nil {
+		connections = db.Collection("calendar_connections")
+	}
+	return &Service{
+		TimeBlocks:     db.Collection("time_blocks"),
+		Categories:     collections["categories"],
+		CompletedTasks: collections["completed-tasks"],
+		Connections:    connections,
+	}
+}
+
+// Create reserves a focus block, marks its tasks as scheduled at the block's
+// start, and queues the block's calendar event.
+func (s *Service) Create(ctx context.Context, userID primitive.ObjectID, params CreateParams) (*types.TimeBlockDocument, error) {
+	if !validWindow(params.Start, params.End) {
+		return nil, ErrInvalidWindow
+	}
+	params.TaskIDs = uniqueTaskIDs(params.TaskIDs)
+	tasks, err := s.resolveTasks(ctx, userID, params.TaskIDs, primitive.NilObjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	block := &types.TimeBlockDocument{
+		ID:        primitive.NewObjectID(),
+		UserID:    userID,
+		Title:     strings.TrimSpace(params.Title),
+		Start:     params.Start,
+		End:       params.End,
+		Timezone:  params.Timezone,
+		TaskIDs:   params.TaskIDs,
+		Status:    types.TimeBlockScheduled,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if params.ConnectionID != nil {
+		count, err := s.Connections.CountDocuments(ctx, bson.M{"_id": *params.ConnectionID, "user_id": userID})
+		if err != nil {
+			return nil, fmt.Errorf("check connection: %w", err)
+		}
+		if count == 0 {
+			return nil, ErrConnectionNotFound
+		}
+		block.ConnectionID = params.ConnectionID
+		block.CalendarID = params.CalendarID
+	} else {
+		// Default to the calendar the first push-enabled task would have
+		// gone to on its own.
+		for _, t := range tasks {
+			if !t.PushEnabled {
+				continue
+			}
+			if connID, calID, ok := parseIntegration(t.Integration); ok {
+				block.ConnectionID = &connID
+				block.CalendarID = calID
+				break
+			}
+		}
+	}
+
+	if _, err := s.TimeBlocks.InsertOne(ctx, block); err != nil {
+		return nil, fmt.Errorf("insert time block: %w", err)
+	}
+	for _, t := range tasks {
+		if err := s.assignTask(ctx, t, block); err != nil {
+			return nil, err
+		}
+	}
+	s.enqueueBlockUpsert(ctx, block)
+
+	if client := ph.GetClient(); client != nil {
+		_ = client.Track(ctx, ph.Event{
+			UserID:    userID.Hex(),
+			EventName: "time_block_created",
+			Category:  "schedule",
+			Properties: map[string]interface{}{
+				"block_id":         block.ID.Hex(),
+				"task_count":       len(block.TaskIDs),
+				"duration_minutes": int(block.End.Sub(block.Start).Minutes()),
+				"pushed":           block.ConnectionID != nil,
+			},
+		})
+	}
+	return block, nil
+}
+
+// List returns the user's scheduled and ended blocks overlapping [from, to).
+func (s *Service) List(ctx context.Context, userID primitive.ObjectID, from, to time.Time) ([]types.TimeBlockDocument, error) {
+	cursor, err := s.TimeBlocks.Find(ctx,
+		bson.M{
+			"user_id": userID,
+			"status":  bson.M{"$ne": types.TimeBlockCancelled},
+			"end":     bson.M{"$gt": from},
+			"start":   bson.M{"$lt": to},
+		},
+		options.Find().SetSort(bson.D{{Key: "start", Value: 1}}).SetLimit(200),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	blocks := []types.TimeBlockDocument{}
+	if err := cursor.All(ctx, &blocks); err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+// Get returns one of the user's blocks.
+func (s *Service) Get(ctx context.Context, userID, blockID primitive.ObjectID) (*types.TimeBlockDocument, error) {
+	var block types.TimeBlockDocument
+	err := s.TimeBlocks.FindOne(ctx, bson.M{"_id": blockID, "user_id": userID}).Decode(&block)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrBlockNotFound
+		}
+		return nil, err
+	}
+	return &block, nil
+}
+
+// Update edits a scheduled block. Moving the block moves its tasks with it;
+// replacing the task list releases tasks that were left out.
+func (s *Service) Update(ctx context.Context, userID, blockID primitive.ObjectID, params UpdateParams) (*types.TimeBlockDocument, error) {
+	block, err := s.Get(ctx, userID, blockID)
+	if err != nil {
+		return nil, err
+	}
+	if block.Status != types.TimeBlockScheduled {
+		return nil, ErrBlockNotScheduled
+	}
+
+	if params.Title != nil {
+		block.Title = strings.TrimSpace(*params.Title)
+	}
+	if params.Start != nil {
+		block.Start = *params.Start
+	}
+	if params.End != nil {
+		block.End = *params.End
+	}
+	if !validWindow(block.Start, block.End) {
+		return nil, ErrInvalidWindow
+	}
+
+	taskIDs := block.TaskIDs
+	if params.TaskIDs != nil {
+		taskIDs = uniqueTaskIDs(params.TaskIDs)
+	}
+	tasks, err := s.resolveTasks(ctx, userID, taskIDs, block.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var released []primitive.ObjectID
+	if params.TaskIDs != nil {
+		kept := make(map[primitive.ObjectID]bool, len(taskIDs))
+		for _, id := range taskIDs {
+			kept[id] = true
+		}
+		for _, id := range block.TaskIDs {
+			if !kept[id] {
+				released = append(released, id)
+			}
+		}
+		block.TaskIDs = taskIDs
+	}
+
+	block.UpdatedAt = time.Now()
+	res, err := s.TimeBlocks.UpdateOne(ctx,
+		bson.M{"_id": block.ID, "user_id": userID, "status": types.TimeBlockScheduled},
+		bson.M{"$set": bson.M{
+			"title":      block.Title,
+			"start":      block.Start,
+			"end":        block.End,
+			"task_ids":   block.TaskIDs,
+			"updated_at": block.UpdatedAt,
+		}},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("update time block: %w", err)
+	}
+	// The block was cancelled, ended or deleted since it was read; leave its
+	// tasks alone.
+	if res.MatchedCount == 0 {
+		return nil, ErrBlockNotFound
+	}
+	if err := s.releaseTasks(ctx, userID, block.ID, released); err != nil {
+		return nil, err
+	}
+	for _, t := range tasks {
+		if err := s.assignTask(ctx, t, block); err != nil {
+			return nil, err
+		}
+	}
+	s.enqueueBlockUpsert(ctx, block)
+	return block, nil
+}
+
+// Cancel deletes a scheduled block: its tasks keep their start times but are
+// released (so they are pushed individually again) and the block's event is
+// removed from the calendar.
+func (s *Service) Cancel(ctx context.Context, userID, blockID primitive.ObjectID) error {
+	block, err := s.Get(ctx, userID, blockID)
+	if err != nil {
+		return err
+	}
+	if block.Status != types.TimeBlockScheduled {
+		return ErrBlockNotScheduled
+	}
+
+	res, err := s.TimeBlocks.UpdateOne(ctx,
+		bson.M{"_id": block.ID, "status": types.TimeBlockScheduled},
+		bson.M{"$set": bson.M{"status": types.TimeBlockCancelled, "updated_at": time.Now()}},
+	)
+	if err != nil {
+		return fmt.Errorf("cancel time block: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return ErrBlockNotScheduled
+	}
+
+	if err := s.releaseTasks(ctx, userID, block.ID, block.TaskIDs); err != nil {
+		return err
+	}
+	s.enqueueBlockDelete(ctx, block)
+	return nil
+}
+
+// End closes a scheduled block now (early, or from the closer job once it is
+// past its end), rolls unfinished tasks over to the next day and records the
+// report. Ending an already-ended block returns it unchanged.
+func (s *Service) End(ctx context.Context, userID, blockID primitive.ObjectID) (*types.TimeBlockDocument, error) {
+	block, err := s.Get(ctx, userID, blockID)
+	if err != nil {
+		return nil, err
+	}
+	switch block.Status {
+	case types.TimeBlockEnded:
+		return block, nil
+	case types.TimeBlockCancelled:
+		return nil, ErrBlockNotScheduled
+	}
+	return s.endBlock(ctx, block, time.Now())
+}
+
+// EndDue ends every scheduled block whose end time has passed. Returns the
+// number of blocks ended.
+func (s *Service) EndDue(ctx context.Context, now time.Time) (int, error) {
+	cursor, err := s.TimeBlocks.Find(ctx,
+		bson.M{"status": types.TimeBlockScheduled, "end": bson.M{"$lte": now}},
+		options.Find().SetLimit(200),
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var due []types.TimeBlockDocument
+	if err := cursor.All(ctx, &due); err != nil {
+		return 0, err
+	}
+
+	ended := 0
+	for i := range due {
+		if _, err := s.endBlock(ctx, &due[i], now); err != nil {
+			slog.Error("Failed to end time block", "block_id", due[i].ID.Hex(), "user_id", due[i].UserID.Hex(), "error", err)
+			continue
+		}
+		ended++
+	}
+	return ended, nil
+}
+
+func (s *Service) endBlock(ctx context.Context, block *types.TimeBlockDocument, now time.Time) (*types.TimeBlockDocument, error) {
+	open, err := s.openTasks(ctx, block.UserID, block.TaskIDs)
+	if err != nil {
+		return nil, fmt.Errorf("load open tasks: %w", err)
+	}
+	completed, err := s.completedTaskIDs(ctx, block.UserID, block.TaskIDs)
+	if err != nil {
+		return nil, fmt.Errorf("load completed tasks: %w", err)
+	}
+	openSet := make(map[primitive.ObjectID]bool, len(open))
+	for _, t := range open {
+		openSet[t.ID] = true
+	}
+	report := buildReport(block.TaskIDs, openSet, completed, now)
+
+	// Claim the transition first so the closer job and a manual end can't
+	// both roll the same tasks over.
+	res, err := s.TimeBlocks.UpdateOne(ctx,
+		bson.M{"_id": block.ID, "status": types.TimeBlockScheduled},
+		bson.M{"$set": bson.M{"status": types.TimeBlockEnded, "report": report, "updated_at": now}},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("end time block: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return s.Get(ctx, block.UserID, block.ID)
+	}
+	block.Status = types.TimeBlockEnded
+	block.Report = report
+	block.UpdatedAt = now
+
+	loc, err := time.LoadLocation(block.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	next := rolloverDate(block.End, loc)
+	for _, t := range open {
+		_, err := s.Categories.UpdateOne(ctx,
+			bson.M{"_id": t.CategoryID, "tasks": bson.M{"$elemMatch": bson.M{"_id": t.ID, "timeBlockId": block.ID}}},
+			bson.M{
+				"$set":   bson.M{"tasks.$.startDate": next, "tasks.$.lastEdited": now},
+				"$unset": bson.M{"tasks.$.timeBlockId": "", "tasks.$.startTime": ""},
+				"$inc":   bson.M{"tasks.$.rescheduleCount": 1},
+			},
+		)
+		if err != nil {
+			slog.Error("Failed to roll over time block task", "block_id", block.ID.Hex(), "task_id", t.ID.Hex(), "error", err)
+			continue
+		}
+		s.enqueueTaskUpsert(ctx, t, block.UserID)
+	}
+	s.enqueueBlockUpsert(ctx, block)
+
+	if client := ph.GetClient(); client != nil {
+		_ = client.Track(ctx, ph.Event{
+			UserID:    block.UserID.Hex(),
+			EventName: "time_block_ended",
+			Category:  "schedule",
+			Properties: map[string]interface{}{
+				"block_id":    block.ID.Hex(),
+				"completed":   len(report.CompletedTaskIDs),
+				"rolled_over": len(report.RolledOverTaskIDs),
+				"removed":     len(report.RemovedTaskIDs),
+			},
+		})
+	}
+	return block, nil
+}
+
+// resolveTasks loads the given open tasks, failing if any is missing or
+// already reserved by a block other than `ownBlock`.
+func (s *Service) resolveTasks(ctx context.Context, userID primitive.ObjectID, taskIDs []primitive.ObjectID, ownBlock primitive.ObjectID) ([]blockTask, error) {
+	tasks, err := s.openTasks(ctx, userID, taskIDs)
+	if err != nil {
+		return nil, fmt.Errorf("load tasks: %w", err)
+	}
+	byID := make(map[primitive.ObjectID]blockTask, len(tasks))
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+
+	ordered := make([]blockTask, 0, len(taskIDs))
+	for _, id := range taskIDs {
+		t, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrTaskNotFound, id.Hex())
+		}
+		if t.TimeBlockID != nil && *t.TimeBlockID != ownBlock {
+			return nil, fmt.Errorf("%w: %s", ErrTaskInOtherBlock, id.Hex())
+		}
+		ordered = append(ordered, t)
+	}
+	return ordered, nil
+}
+
+// uniqueTaskIDs drops repeated IDs, keeping the first occurrence's order, so
+// a task listed twice is neither assigned nor reported twice.
+func uniqueTaskIDs(ids []primitive.ObjectID) []primitive.ObjectID {
+	if ids == nil {
+		return nil
+	}
+	seen := make(map[primitive.ObjectID]bool, len(ids))
+	out := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// openTasks returns the user's not-yet-completed tasks among taskIDs.
+func (s *Service) openTasks(ctx context.Context, userID primitive.ObjectID, taskIDs []primitive.ObjectID) ([]blockTask, error) {
+	if len(taskIDs) == 0 {
+		return nil, nil
+	}
+	cursor, err := s.Categories.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user": userID, "tasks._id": bson.M{"$in": taskIDs}}}},
+		{{Key: "$unwind", Value: "$tasks"}},
+		{{Key: "$match", Value: bson.M{"tasks._id": bson.M{"$in": taskIDs}}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":          "$tasks._id",
+			"categoryId":   "$_id",
+			"integration":  1,
+			"push_enabled": 1,
+			"timeBlockId":  "$tasks.timeBlockId",
+		}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var tasks []blockTask
+	if err := cursor.All(ctx, &tasks); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+func (s *Service) completedTaskIDs(ctx context.Context, userID primitive.ObjectID, taskIDs []primitive.ObjectID) (map[primitive.ObjectID]bool, error) {
+	completed := map[primitive.ObjectID]bool{}
+	if len(taskIDs) == 0 || s.CompletedTasks == nil {
+		return completed, nil
+	}
+	cursor, err := s.CompletedTasks.Find(ctx,
+		bson.M{"_id": bson.M{"$in": taskIDs}, "user": userID},
+		options.Find().SetProjection(bson.M{"_id": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	for _, d := range docs {
+		completed[d.ID] = true
+	}
+	return completed, nil
+}
+
+// assignTask marks a task as reserved by the block and scheduled at its start.
+func (s *Service) assignTask(ctx context.Context, t blockTask, block *types.TimeBlockDocument) error {
+	_, err := s.Categories.UpdateOne(ctx,
+		bson.M{"_id": t.CategoryID, "tasks._id": t.ID},
+		bson.M{"$set": bson.M{
+			"tasks.$.timeBlockId": block.ID,
+			"tasks.$.startTime":   block.Start,
+			"tasks.$.startDate":   block.Start,
+			"tasks.$.lastEdited":  time.Now(),
+		}},
+	)
+	if err != nil {
+		return fmt.Errorf("assign task %s to block: %w", t.ID.Hex(), err)
+	}
+	// Lets the push worker remove the task's own event, if it had one.
+	s.enqueueTaskUpsert(ctx, t, block.UserID)
+	return nil
+}
+
+// releaseTasks detaches tasks from the block, leaving their start times, so
+// they are pushed as individual events again.
+func (s *Service) releaseTasks(ctx context.Context, userID, blockID primitive.ObjectID, taskIDs []primitive.ObjectID) error {
+	tasks, err := s.openTasks(ctx, userID, taskIDs)
+	if err != nil {
+		return fmt.Errorf("load tasks to release: %w", err)
+	}
+	for _, t := range tasks {
+		_, err := s.Categories.UpdateOne(ctx,
+			bson.M{"_id": t.CategoryID, "tasks": bson.M{"$elemMatch": bson.M{"_id": t.ID, "timeBlockId": blockID}}},
+			bson.M{
+				"$unset": bson.M{"tasks.$.timeBlockId": ""},
+				"$set":   bson.M{"tasks.$.lastEdited": time.Now()},
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("release task %s: %w", t.ID.Hex(), err)
+		}
+		s.enqueueTaskUpsert(ctx, t, userID)
+	}
+	return nil
+}
+
+// Push hooks: failures are logged but never fail the block mutation, matching
+// the task service.
+
+func (s *Service) enqueueTaskUpsert(ctx context.Context, t blockTask, userID primitive.ObjectID) {
+	if s.PushEnqueuer == nil || !t.PushEnabled {
+		return
+	}
+	if err := s.PushEnqueuer.EnqueueUpsert(ctx, t.ID, t.CategoryID, userID); err != nil {
+		slog.Warn("Time block push hook: enqueue task upsert failed", "task_id", t.ID, "error", err)
+	}
+}
+
+func (s *Service) enqueueBlockUpsert(ctx context.Context, block *types.TimeBlockDocument) {
+	if s.PushEnqueuer == nil || block.ConnectionID == nil {
+		return
+	}
+	if err := s.PushEnqueuer.EnqueueBlockUpsert(ctx, block.ID, block.UserID, *block.ConnectionID); err != nil {
+		slog.Warn("Time block push hook: enqueue block upsert failed", "block_id", block.ID, "error", err)
+	}
+}
+
+func (s *Service) enqueueBlockDelete(ctx context.Context, block *types.TimeBlockDocument) {
+	if s.PushEnqueuer == nil || block.ConnectionID == nil || block.PushedEventID == "" {
+		return
+	}
+	err := s.PushEnqueuer.EnqueueBlockDelete(ctx, block.ID, block.UserID, *block.ConnectionID, block.PushedEventID, block.PushedCalendarID)
+	if err != nil {
+		slog.Warn("Time block push hook: enqueue block delete failed", "block_id", block.ID, "error", err)
+	}
+}
+
+// parseIntegration splits a category integration of the form
+// "gcal:<connectionID>:<calendarID>".
+func parseIntegration(integration string) (primitive.ObjectID, string, bool) {
+	parts := strings.SplitN(integration, ":", 3)
+	if len(parts) != 3 || parts[0] != "gcal" || parts[2] == "" {
+		return primitive.NilObjectID, "", false
+	}
+	connID, err := primitive.ObjectIDFromHex(parts[1])
+	if err != nil {
+		return primitive.NilObjectID, "", false
+	}
+	return connID, parts[2], true
+}
@@ -0,0 +1,198 @@
+package timeblock
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/auth"
+	"github.com/danielgtaylor/huma/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (h *Handler) CreateTimeBlock(ctx context.Context, input *CreateTimeBlockInput) (*TimeBlockOutput, error) {
+	userObjID, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	taskIDs, err := parseTaskIDs(input.Body.TaskIDs)
+	if err != nil {
+		return nil, err
+	}
+	params := CreateParams{
+		Title:    input.Body.Title,
+		Start:    input.Body.Start,
+		End:      input.Body.End,
+		Timezone: input.Timezone,
+		TaskIDs:  taskIDs,
+	}
+	if params.Timezone == "" {
+		params.Timezone = auth.GetTimezoneOrDefault(ctx)
+	}
+	if input.Body.ConnectionID != "" {
+		connID, err := primitive.ObjectIDFromHex(input.Body.ConnectionID)
+		if err != nil {
+			return nil, huma.Error400BadRequest("Invalid connection ID format", err)
+		}
+		if input.Body.CalendarID == "" {
+			return nil, huma.Error400BadRequest("calendarId is required with connectionId", nil)
+		}
+		params.ConnectionID = &connID
+		params.CalendarID = input.Body.CalendarID
+	}
+
+	block, err := h.service.Create(ctx, userObjID, params)
+	if err != nil {
+		return nil, toHumaError(err, "create", userObjID)
+	}
+	return &TimeBlockOutput{Body: *block}, nil
+}
+
+func (h *Handler) ListTimeBlocks(ctx context.Context, input *ListTimeBlocksInput) (*ListTimeBlocksOutput, error) {
+	userObjID, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := input.From, input.To
+	if from.IsZero() {
+		from = time.Now()
+	}
+	if to.IsZero() {
+		to = from.AddDate(0, 0, 7)
+	}
+	if !to.After(from) {
+		return nil, huma.Error400BadRequest("to must be after from", nil)
+	}
+
+	blocks, err := h.service.List(ctx, userObjID, from, to)
+	if err != nil {
+		return nil, toHumaError(err, "list", userObjID)
+	}
+	resp := &ListTimeBlocksOutput{}
+	resp.Body.Blocks = blocks
+	return resp, nil
+}
+
+func (h *Handler) GetTimeBlock(ctx context.Context, input *GetTimeBlockInput) (*TimeBlockOutput, error) {
+	userObjID, blockID, err := requireUserAndBlock(ctx, input.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	block, err := h.service.Get(ctx, userObjID, blockID)
+	if err != nil {
+		return nil, toHumaError(err, "get", userObjID)
+	}
+	return &TimeBlockOutput{Body: *block}, nil
+}
+
+func (h *Handler) UpdateTimeBlock(ctx context.Context, input *UpdateTimeBlockInput) (*TimeBlockOutput, error) {
+	userObjID, blockID, err := requireUserAndBlock(ctx, input.BlockID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := UpdateParams{
+		Title: input.Body.Title,
+		Start: input.Body.Start,
+		End:   input.Body.End,
+	}
+	if input.Body.TaskIDs != nil {
+		params.TaskIDs, err = parseTaskIDs(input.Body.TaskIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	block, err := h.service.Update(ctx, userObjID, blockID, params)
+	if err != nil {
+		return nil, toHumaError(err, "update", userObjID)
+	}
+	return &TimeBlockOutput{Body: *block}, nil
+}
+
+func (h *Handler) DeleteTimeBlock(ctx context.Context, input *GetTimeBlockInput) (*DeleteTimeBlockOutput, error) {
+	userObjID, blockID, err := requireUserAndBlock(ctx, input.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.service.Cancel(ctx, userObjID, blockID); err != nil {
+		return nil, toHumaError(err, "cancel", userObjID)
+	}
+	resp := &DeleteTimeBlockOutput{}
+	resp.Body.Message = "Time block cancelled"
+	return resp, nil
+}
+
+func (h *Handler) EndTimeBlock(ctx context.Context, input *GetTimeBlockInput) (*TimeBlockOutput, error) {
+	userObjID, blockID, err := requireUserAndBlock(ctx, input.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	block, err := h.service.End(ctx, userObjID, blockID)
+	if err != nil {
+		return nil, toHumaError(err, "end", userObjID)
+	}
+	return &TimeBlockOutput{Body: *block}, nil
+}
+
+func requireUser(ctx context.Context) (primitive.ObjectID, error) {
+	userIDStr, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return primitive.NilObjectID, huma.Error401Unauthorized("Please log in to continue", err)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userIDStr)
+	if err != nil {
+		return primitive.NilObjectID, huma.Error400BadRequest("Invalid user ID format", err)
+	}
+	return userObjID, nil
+}
+
+func requireUserAndBlock(ctx context.Context, blockIDStr string) (primitive.ObjectID, primitive.ObjectID, error) {
+	userObjID, err := requireUser(ctx)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, err
+	}
+	blockID, err := primitive.ObjectIDFromHex(blockIDStr)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, huma.Error400BadRequest("Invalid time block ID format", err)
+	}
+	return userObjID, blockID, nil
+}
+
+func parseTaskIDs(ids []string) ([]primitive.ObjectID, error) {
+	seen := make(map[primitive.ObjectID]bool, len(ids))
+	out := make([]primitive.ObjectID, 0, len(ids))
+	for _, raw := range ids {
+		id, err := primitive.ObjectIDFromHex(raw)
+		if err != nil {
+			return nil, huma.Error400BadRequest("Invalid task ID format", err)
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out, nil
+}
+
+// toHumaError maps service errors to HTTP responses.
+func toHumaError(err error, action string, userID primitive.ObjectID) error {
+	switch {
+	case errors.Is(err, ErrBlockNotFound):
+		return huma.Error404NotFound("Time block not found")
+	case errors.Is(err, ErrTaskNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, ErrConnectionNotFound):
+		return huma.Error404NotFound("Calendar connection not found")
+	case errors.Is(err, ErrBlockNotScheduled), errors.Is(err, ErrTaskInOtherBlock):
+		return huma.Error409Conflict(err.Error())
+	case errors.Is(err, ErrInvalidWindow):
+		return huma.Error400BadRequest(err.Error())
+	}
+	slog.Error("Failed to "+action+" time block", "userId", userID.Hex(), "error", err)
+	return huma.Error500InternalServerError("Unable to "+action+" time block. Please try again.", err)
+}
@@ -0,0 +1,125 @@
+package timeblock
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// PushEnqueuer is satisfied by *calendar.PushOutbox. Block events and the
+// contained tasks' own events both go through the calendar push outbox.
+type PushEnqueuer interface {
+	EnqueueUpsert(ctx context.Context, taskID, categoryID, userID primitive.ObjectID) error
+	EnqueueBlockUpsert(ctx context.Context, blockID, userID, connectionID primitive.ObjectID) error
+	EnqueueBlockDelete(ctx context.Context, blockID, userID, connectionID primitive.ObjectID, eventID, calendarID string) error
+}
+
+var (
+	ErrBlockNotFound      = errors.New("time block not found")
+	ErrBlockNotScheduled  = errors.New("time block has already ended or been cancelled")
+	ErrInvalidWindow      = errors.New("time block must end after it starts and last at most 12 hours")
+	ErrTaskNotFound       = errors.New("task not found")
+	ErrTaskInOtherBlock   = errors.New("task is already in another time block")
+	ErrConnectionNotFound = errors.New("calendar connection not found")
+)
+
+// maxBlockLength bounds a single focus block; anything longer is a day plan,
+// not a block.
+const maxBlockLength = 12 * time.Hour
+
+type Service struct {
+	TimeBlocks     *mongo.Collection
+	Categories     *mongo.Collection
+	CompletedTasks *mongo.Collection
+	Connections    *mongo.Collection
+	PushEnqueuer   PushEnqueuer // optional; nil disables calendar pushes
+}
+
+type Handler struct {
+	service *Service
+}
+
+// CreateParams describes a new focus block.
+type CreateParams struct {
+	Title        string
+	Start        time.Time
+	End          time.Time
+	Timezone     string
+	TaskIDs      []primitive.ObjectID
+	ConnectionID *primitive.ObjectID
+	CalendarID   string
+}
+
+// UpdateParams holds the optional fields of a block edit; nil means unchanged.
+type UpdateParams struct {
+	Title   *string
+	Start   *time.Time
+	End     *time.Time
+	TaskIDs []primitive.ObjectID // nil means unchanged
+}
+
+// blockTask is a task resolved to its category for marking and pushing.
+type blockTask struct {
+	ID          primitive.ObjectID  `bson:"_id"`
+	CategoryID  primitive.ObjectID  `bson:"categoryId"`
+	Integration string              `bson:"integration"`
+	PushEnabled bool                `bson:"push_enabled"`
+	TimeBlockID *primitive.ObjectID `bson:"timeBlockId"`
+}
+
+type TimeBlockBody struct {
+	Title        string    `json:"title" minLength:"1" maxLength:"120" example:"Deep work" doc:"Name of the focus block"`
+	Start        time.Time `json:"start" doc:"Block start (RFC 3339)"`
+	End          time.Time `json:"end" doc:"Block end (RFC 3339)"`
+	TaskIDs      []string  `json:"taskIds" minItems:"1" maxItems:"20" doc:"Tasks to work on during the block"`
+	ConnectionID string    `json:"connectionId,omitempty" doc:"Calendar connection to push the block to. Defaults to the calendar of the first push-enabled task."`
+	CalendarID   string    `json:"calendarId,omitempty" doc:"Calendar to push the block to; required with connectionId"`
+}
+
+type CreateTimeBlockInput struct {
+	Authorization string        `header:"Authorization" required:"true"`
+	Timezone      string        `header:"X-Timezone" required:"false" doc:"IANA timezone, e.g. America/New_York. Used to roll unfinished tasks over to the next day."`
+	Body          TimeBlockBody `json:"body"`
+}
+
+type TimeBlockOutput struct {
+	Body types.TimeBlockDocument
+}
+
+type ListTimeBlocksInput struct {
+	Authorization string    `header:"Authorization" required:"true"`
+	From          time.Time `query:"from" doc:"Only blocks ending after this time (default: now)"`
+	To            time.Time `query:"to" doc:"Only blocks starting before this time (default: 7 days after from)"`
+}
+
+type ListTimeBlocksOutput struct {
+	Body struct {
+		Blocks []types.TimeBlockDocument `json:"blocks"`
+	}
+}
+
+type GetTimeBlockInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	BlockID       string `path:"blockId" doc:"Time block ID"`
+}
+
+type UpdateTimeBlockInput struct {
+	Authorization string `header:"Authorization" required:"true"`
+	BlockID       string `path:"blockId" doc:"Time block ID"`
+	Body          struct {
+		Title   *string    `json:"title,omitempty" maxLength:"120"`
+		Start   *time.Time `json:"start,omitempty"`
+		End     *time.Time `json:"end,omitempty"`
+		TaskIDs []string   `json:"taskIds,omitempty" maxItems:"20" doc:"Replaces the block's tasks; tasks left out are released"`
+	} `json:"body"`
+}
+
+type DeleteTimeBlockOutput struct {
+	Body struct {
+		Message string `json:"message" example:"Time block cancelled"`
+	}
+}
@@ -59,8 +59,21 @@ func GetDefaultCredits() UserCredits {
 }
 
 // ConsumeCredit atomically decrements a credit and returns error if insufficient
-// This ensures thread-safe credit consumption
+// This ensures thread-safe credit consumption. For AI credit types on a tier
+// with a token budget, nothing is decremented: the call is allowed while the
+// period's budget has tokens left, and its cost is metered per call instead.
 func ConsumeCredit(ctx context.Context, collection *mongo.Collection, userID primitive.ObjectID, creditType CreditType) error {
+	remaining, budgeted, err := tokenBudgetRemaining(ctx, collection, userID, creditType)
+	if err != nil {
+		return err
+	}
+	if budgeted {
+		if remaining <= 0 {
+			return ErrInsufficientCredits
+		}
+		return nil
+	}
+
 	if aiCreditTypesBypassed[creditType] {
 		return nil
 	}
@@ -117,6 +130,21 @@ func AddCredits(ctx context.Context, collection *mongo.Collection, userID primit
 	return err
 }
 
+// RefundCredit returns a credit taken by ConsumeCredit for a call that
+// failed. It is a no-op when ConsumeCredit took nothing: for bypassed types,
+// and for token-budgeted ones, where the failed call's real token cost is
+// already metered.
+func RefundCredit(ctx context.Context, collection *mongo.Collection, userID primitive.ObjectID, creditType CreditType) error {
+	_, budgeted, err := tokenBudgetRemaining(ctx, collection, userID, creditType)
+	if err != nil {
+		return err
+	}
+	if budgeted || aiCreditTypesBypassed[creditType] {
+		return nil
+	}
+	return AddCredits(ctx, collection, userID, creditType, 1)
+}
+
 // GetCredits retrieves the current credit balance for a user
 func GetCredits(ctx context.Context, collection *mongo.Collection, userID primitive.ObjectID) (*UserCredits, error) {
 	var user User
@@ -135,6 +163,14 @@ func GetCredits(ctx context.Context, collection *mongo.Collection, userID primit
 
 // CheckCredits checks if user has at least 1 credit of the specified type
 func CheckCredits(ctx context.Context, collection *mongo.Collection, userID primitive.ObjectID, creditType CreditType) (bool, error) {
+	remaining, budgeted, err := tokenBudgetRemaining(ctx, collection, userID, creditType)
+	if err != nil {
+		return false, err
+	}
+	if budgeted {
+		return remaining > 0, nil
+	}
+
 	if aiCreditTypesBypassed[creditType] {
 		return true, nil
 	}
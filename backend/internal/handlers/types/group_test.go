@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestGroupRoles(t *testing.T) {
+	owner, admin, member, stranger := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+	g := &GroupDocument{
+		Creator: owner,
+		Admins:  []primitive.ObjectID{admin},
+		Members: []UserExtendedReferenceInternal{{ID: owner}, {ID: admin}, {ID: member}},
+	}
+
+	cases := []struct {
+		id     primitive.ObjectID
+		role   string
+		manage bool
+	}{
+		{owner, GroupRoleOwner, true},
+		{admin, GroupRoleAdmin, true},
+		{member, GroupRoleMember, false},
+		{stranger, "", false},
+	}
+	for _, c := range cases {
+		if got := g.RoleOf(c.id); got != c.role {
+			t.Errorf("RoleOf = %q, want %q", got, c.role)
+		}
+		if got := g.CanManage(c.id); got != c.manage {
+			t.Errorf("CanManage(%s) = %v, want %v", c.role, got, c.manage)
+		}
+	}
+
+	if ids := g.MemberIDs(); len(ids) != 3 || ids[0] != owner {
+		t.Errorf("MemberIDs = %v, want the owner first and no repeats", ids)
+	}
+}
+
+func TestGroupNotificationSettingsFor(t *testing.T) {
+	quiet := primitive.NewObjectID()
+	g := &GroupDocument{Notifications: map[string]GroupNotificationSettings{
+		quiet.Hex(): {Posts: false, Activity: false, JoinRequests: true},
+	}}
+
+	if got := g.NotificationSettingsFor(primitive.NewObjectID()); got != DefaultGroupNotificationSettings() {
+		t.Errorf("expected defaults for a member without settings, got %+v", got)
+	}
+	if got := g.NotificationSettingsFor(quiet); got.Posts || got.Activity || !got.JoinRequests {
+		t.Errorf("expected stored settings, got %+v", got)
+	}
+}
@@ -146,3 +146,37 @@ func TestDefaultUserSettings_ShareStrugglesOff(t *testing.T) {
 		t.Error("a new account must not disclose struggles")
 	}
 }
+
+// TestMayPersonalize mirrors the worker's consent query: absent means on,
+// and only an explicit opt-out or a live pause says no.
+func TestMayPersonalize(t *testing.T) {
+	now := time.Date(2026, 7, 30, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		settings UserSettings
+		want     bool
+	}{
+		{name: "never answered", settings: UserSettings{}, want: true},
+		{name: "enabled", settings: UserSettings{Personalization: &PersonalizationSettings{Enabled: true}}, want: true},
+		{name: "opted out", settings: UserSettings{Personalization: &PersonalizationSettings{Enabled: false}}, want: false},
+		{
+			name:     "paused",
+			settings: UserSettings{Personalization: &PersonalizationSettings{Enabled: true, PausedUntil: timePtr(now.Add(time.Hour))}},
+			want:     false,
+		},
+		{
+			name:     "pause expired",
+			settings: UserSettings{Personalization: &PersonalizationSettings{Enabled: true, PausedUntil: timePtr(now.Add(-time.Hour))}},
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.settings.MayPersonalize(now); got != tt.want {
+				t.Errorf("MayPersonalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -25,11 +25,54 @@ type CategoryDocument struct {
 	User          primitive.ObjectID  `bson:"user" json:"user"`
 	IsBlueprint   bool                `bson:"isBlueprint,omitempty" json:"isBlueprint,omitempty"`
 	BlueprintID   *primitive.ObjectID `bson:"blueprintId,omitempty" json:"blueprintId,omitempty"`
+	ChallengeID   *primitive.ObjectID `bson:"challengeId,omitempty" json:"challengeId,omitempty"` // set on categories a group challenge created
 	Integration   string              `bson:"integration,omitempty" json:"integration,omitempty"` // Format: "gcal:{connection_id}:{calendar_id}"
 	PushEnabled   bool                `bson:"push_enabled,omitempty" json:"push_enabled,omitempty"`
+	DriftPolicy   string              `bson:"drift_policy,omitempty" json:"drift_policy,omitempty"` // kindred_wins (default) | calendar_wins | last_writer_wins
 	Tags          []string            `bson:"tags,omitempty" json:"tags,omitempty"`
 }
 
+// TimeBlockStatus is the lifecycle state of a focus block.
+type TimeBlockStatus string
+
+const (
+	TimeBlockScheduled TimeBlockStatus = "scheduled" // Upcoming or in progress
+	TimeBlockEnded     TimeBlockStatus = "ended"     // Past its end; Report is populated
+	TimeBlockCancelled TimeBlockStatus = "cancelled" // Deleted by the user before it ended
+)
+
+// TimeBlockDocument is a focus block reserving [Start, End) for a group of
+// tasks, e.g. "Deep work 9–11". Stored in the time_blocks collection.
+type TimeBlockDocument struct {
+	ID       primitive.ObjectID   `bson:"_id" json:"id"`
+	UserID   primitive.ObjectID   `bson:"user_id" json:"userId"`
+	Title    string               `bson:"title" json:"title"`
+	Start    time.Time            `bson:"start" json:"start"`
+	End      time.Time            `bson:"end" json:"end"`
+	Timezone string               `bson:"timezone" json:"timezone"`
+	TaskIDs  []primitive.ObjectID `bson:"task_ids" json:"taskIds"`
+	Status   TimeBlockStatus      `bson:"status" json:"status"`
+
+	// Calendar the block is pushed to; empty when the block is Kindred-only.
+	ConnectionID     *primitive.ObjectID `bson:"connection_id,omitempty" json:"connectionId,omitempty"`
+	CalendarID       string              `bson:"calendar_id,omitempty" json:"calendarId,omitempty"`
+	PushedEventID    string              `bson:"pushed_event_id,omitempty" json:"pushedEventId,omitempty"`
+	PushedCalendarID string              `bson:"pushed_calendar_id,omitempty" json:"pushedCalendarId,omitempty"`
+	PushedEventEtag  string              `bson:"pushed_event_etag,omitempty" json:"-"`
+
+	Report    *TimeBlockReport `bson:"report,omitempty" json:"report,omitempty"`
+	CreatedAt time.Time        `bson:"created_at" json:"createdAt"`
+	UpdatedAt time.Time        `bson:"updated_at" json:"updatedAt"`
+}
+
+// TimeBlockReport summarizes what happened to a block's tasks once it ended.
+type TimeBlockReport struct {
+	CompletedTaskIDs  []primitive.ObjectID `bson:"completed_task_ids" json:"completedTaskIds"`
+	RolledOverTaskIDs []primitive.ObjectID `bson:"rolled_over_task_ids" json:"rolledOverTaskIds" doc:"Unfinished tasks moved to the next day"`
+	RemovedTaskIDs    []primitive.ObjectID `bson:"removed_task_ids,omitempty" json:"removedTaskIds,omitempty" doc:"Tasks deleted while the block was scheduled"`
+	EndedAt           time.Time            `bson:"ended_at" json:"endedAt"`
+}
+
 type WorkspaceDocument struct {
 	ID    primitive.ObjectID `bson:"_id" json:"id"`
 	User  primitive.ObjectID `bson:"user" json:"user"`
@@ -81,12 +124,23 @@ type TaskDocument struct {
 	BlueprintID *primitive.ObjectID `bson:"blueprintId,omitempty" json:"blueprintId,omitempty"`
 	Integration string              `bson:"integration,omitempty" json:"integration,omitempty"`
 
+	// ChallengeID links a task to the group challenge it counts toward.
+	ChallengeID *primitive.ObjectID `bson:"challengeId,omitempty" json:"challengeId,omitempty"`
+
+	// VoiceTranscriptID links a task created from a voice recording to the
+	// stored transcript.
+	VoiceTranscriptID *primitive.ObjectID `bson:"voiceTranscriptId,omitempty" json:"voiceTranscriptId,omitempty"`
+
 	PushedEventID    string `bson:"pushed_event_id,omitempty" json:"pushed_event_id,omitempty"`       // Google event ID for tasks pushed to a calendar
 	PushedCalendarID string `bson:"pushed_calendar_id,omitempty" json:"pushed_calendar_id,omitempty"` // Calendar the event lives on
-	PushedEventEtag  string `bson:"pushed_event_etag,omitempty" json:"pushed_event_etag,omitempty"`   // ETag at last write (for future drift detection)
+	PushedEventEtag  string `bson:"pushed_event_etag,omitempty" json:"pushed_event_etag,omitempty"`   // ETag at last write (compared on sync for drift detection)
 
 	FlexInfo *FlexInstanceInfo `bson:"flexInfo,omitempty" json:"flexInfo,omitempty"`
 
+	// TimeBlockID is set while the task is reserved inside a focus block. The
+	// block is pushed as one calendar event, so the task itself is not.
+	TimeBlockID *primitive.ObjectID `bson:"timeBlockId,omitempty" json:"timeBlockId,omitempty"`
+
 	// Working state — set when user starts working, cleared on complete/stop
 	WorkingOnSince *time.Time `bson:"workingOnSince,omitempty" json:"workingOnSince,omitempty"`
 
@@ -216,6 +270,7 @@ type TemplateTaskDocument struct {
 	Reminders []*Reminder     `bson:"reminders,omitempty" json:"reminders,omitempty"`
 
 	BlueprintID *primitive.ObjectID `bson:"blueprintId,omitempty" json:"blueprintId,omitempty"`
+	ChallengeID *primitive.ObjectID `bson:"challengeId,omitempty" json:"challengeId,omitempty"`
 
 	FlexState *FlexTemplateState `bson:"flexState,omitempty" json:"flexState,omitempty"`
 
@@ -325,11 +380,17 @@ type User struct {
 	Count          float64              `bson:"count" json:"count"`
 	Categories     []CategoryDocument   `bson:"categories" json:"categories"`
 	Friends        []primitive.ObjectID `bson:"friends" json:"friends"`
+	CloseFriends   []primitive.ObjectID `bson:"closeFriends,omitempty" json:"closeFriends,omitempty"`
+	Muted          []primitive.ObjectID `bson:"muted,omitempty" json:"muted,omitempty"`           // Kept out of this user's feed and activity notifications
+	Restricted     []primitive.ObjectID `bson:"restricted,omitempty" json:"restricted,omitempty"` // Their comments on this user's posts wait for approval
+	HiddenFrom     []primitive.ObjectID `bson:"hiddenFrom,omitempty" json:"hiddenFrom,omitempty"` // Can't see this user's posts, public tasks or ring closures
 	TasksComplete  float64              `bson:"tasks_complete" json:"tasks_complete"`
 	RecentActivity []ActivityDocument   `bson:"recent_activity" json:"recent_activity"`
 	PushToken      string               `bson:"push_token" json:"push_token"`
 	// Last time a "friend posted" notification wave fired for this user (48h cooldown).
 	LastPostNotifyAt *time.Time `bson:"lastPostNotifyAt,omitempty" json:"lastPostNotifyAt,omitempty"`
+	// Last time this user's completions pinged their groups (groupActivityNotifyCooldown).
+	LastGroupActivityNotifyAt *time.Time `bson:"lastGroupActivityNotifyAt,omitempty" json:"lastGroupActivityNotifyAt,omitempty"`
 
 	DisplayName           string       `bson:"display_name" json:"display_name"`
 	Handle                string       `bson:"handle" json:"handle"`
@@ -390,6 +451,42 @@ type UserSettings struct {
 	// a client that predates the field — silently opting everyone out. Nil means
 	// "never answered", which reads as enabled.
 	Personalization *PersonalizationSettings `bson:"personalization,omitempty" json:"personalization,omitempty"`
+	// Privacy is a pointer for the same reason: an older client's PATCH must
+	// not reset everyone's default audience.
+	Privacy *PrivacySettings `bson:"privacy,omitempty" json:"privacy,omitempty"`
+	// Lists is a pointer for the same reason again.
+	Lists *FriendListSettings `bson:"lists,omitempty" json:"lists,omitempty"`
+}
+
+// FriendListSettings points features at one of the user's friend lists. Each
+// field holds a list ID, CloseFriendsList, or nothing for all friends.
+type FriendListSettings struct {
+	KudosPool         string `bson:"kudosPool,omitempty" json:"kudosPool,omitempty" doc:"The friends who may be prompted to send you kudos"`
+	PostNotifications string `bson:"postNotifications,omitempty" json:"postNotifications,omitempty" doc:"Only notify me about posts from friends on this list"`
+}
+
+// CloseFriendsList is the ID of the built-in close friends list, which is
+// stored on the user as closeFriends rather than in friend_lists.
+const CloseFriendsList = "close_friends"
+
+// FriendListCollection holds users' custom friend lists.
+const FriendListCollection = "friend_lists"
+
+// FriendList is a private, named subset of its owner's friends. Unlike a
+// group its members don't know they're on it and can't see each other.
+type FriendList struct {
+	ID        primitive.ObjectID   `bson:"_id"`
+	Owner     primitive.ObjectID   `bson:"owner"`
+	Name      string               `bson:"name"`
+	Members   []primitive.ObjectID `bson:"members"`
+	CreatedAt time.Time            `bson:"createdAt"`
+	UpdatedAt time.Time            `bson:"updatedAt"`
+}
+
+// PrivacySettings holds who new posts are shared with when the post itself
+// doesn't say.
+type PrivacySettings struct {
+	DefaultAudience PostAudience `bson:"defaultAudience" json:"defaultAudience"`
 }
 
 // PersonalizationSettings is the consent state the productivity-agent worker
@@ -427,6 +524,18 @@ func (s UserSettings) PersonalizationOrDefault() PersonalizationSettings {
 	return *s.Personalization
 }
 
+// MayPersonalize reports whether anything may be learned from this user's
+// behaviour right now: personalization on (absent counts as on) and not
+// paused. It is the Go twin of the worker's consent query, for writers that
+// run inside Kindred.
+func (s UserSettings) MayPersonalize(now time.Time) bool {
+	p := s.PersonalizationOrDefault()
+	if !p.Enabled {
+		return false
+	}
+	return p.PausedUntil == nil || !p.PausedUntil.After(now)
+}
+
 // MayShareStruggles is the single authority on whether this user's struggles
 // may be disclosed to a friend. Everything that could surface a stalled task or
 // a breaking streak to a third party must route through it.
@@ -591,9 +700,16 @@ type PostDocument struct {
 	Groups      []primitive.ObjectID        `bson:"groups,omitempty" json:"groups,omitempty"`
 	TaggedUsers []MentionReference          `bson:"taggedUsers,omitempty" json:"taggedUsers,omitempty"`
 	Song        *Song                       `bson:"song,omitempty" json:"song,omitempty"`
+	// Audience decides who can see the post. Posts from before audiences have
+	// none; see EffectiveAudience.
+	Audience *PostAudience `bson:"audience,omitempty" json:"audience,omitempty"`
 
 	Reactions map[string][]primitive.ObjectID `bson:"reactions" json:"reactions"`
-	Comments  []CommentDocument               `bson:"comments" json:"comments"`
+	// Comments is a preview of the latest few; the full threads live in the
+	// comments collection. Posts from before the move still embed every
+	// comment and have no commentCount until they are migrated.
+	Comments     []CommentDocument `bson:"comments" json:"comments"`
+	CommentCount int               `bson:"commentCount" json:"commentCount"`
 
 	// Kudos recorded on this post (denormalized when a congratulation is sent).
 	Kudos []PostKudos `bson:"kudos,omitempty" json:"kudos,omitempty"`
@@ -601,6 +717,43 @@ type PostDocument struct {
 	Metadata PostMetadata `bson:"metadata" json:"metadata"`
 }
 
+// Post audience types.
+const (
+	AudiencePublic       = "public"
+	AudienceFriends      = "friends"
+	AudienceCloseFriends = "close_friends"
+	AudienceGroups       = "groups"
+	AudienceUsers        = "users"
+	AudienceLists        = "lists"
+	AudienceOnlyMe       = "only_me"
+)
+
+// PostAudience is who a post is shared with. Groups, Users and Lists are
+// only set for the audience types that use them. Visibility is enforced by
+// internal/audience.
+type PostAudience struct {
+	Type   string               `bson:"type" json:"type" enum:"public,friends,close_friends,groups,users,lists,only_me" doc:"Who can see the post"`
+	Groups []primitive.ObjectID `bson:"groups,omitempty" json:"groups,omitempty" doc:"Groups whose members can see the post, for the groups audience"`
+	Users  []primitive.ObjectID `bson:"users,omitempty" json:"users,omitempty" doc:"Friends who can see the post, for the users audience"`
+	Lists  []primitive.ObjectID `bson:"lists,omitempty" json:"lists,omitempty" doc:"The author's friend lists whose members can see the post, for the lists audience"`
+}
+
+// EffectiveAudience returns the post's audience. Posts made before audiences
+// existed get the one their old fields implied: their groups if they had
+// any, otherwise public or friends by the public flag.
+func (p *PostDocument) EffectiveAudience() PostAudience {
+	switch {
+	case p.Audience != nil:
+		return *p.Audience
+	case len(p.Groups) > 0:
+		return PostAudience{Type: AudienceGroups, Groups: p.Groups}
+	case p.Metadata.IsPublic:
+		return PostAudience{Type: AudiencePublic}
+	default:
+		return PostAudience{Type: AudienceFriends}
+	}
+}
+
 // KudosReactionEmojis is the curated set a kudos receiver can react with.
 // Must stay in sync with KUDOS_REACTION_EMOJIS in frontend/constants/kudos.ts.
 var KudosReactionEmojis = map[string]bool{
@@ -647,37 +800,66 @@ func ValidateVideoKudos(kudosType string, thumbnailURL *string, durationMs *int)
 	return nil
 }
 
+// CommentDocument lives in the comments collection, one document per
+// comment. Posts embed only a short preview of the latest comments, so
+// ReplyCount and Reactions on a preview copy may lag the collection.
 type CommentDocument struct {
 	ID       primitive.ObjectID             `bson:"_id" json:"id"`
+	PostID   primitive.ObjectID             `bson:"postId,omitempty" json:"postId,omitempty"`
 	User     *UserExtendedReferenceInternal `bson:"user" json:"user"`
 	Content  string                         `bson:"content" json:"content"`
 	ParentID *primitive.ObjectID            `bson:"parentId,omitempty" json:"parentId,omitempty"`
-	Mentions []MentionReference             `bson:"mentions,omitempty" json:"mentions,omitempty"`
-	Metadata CommentMetadata                `bson:"metadata" json:"metadata"`
+	// ThreadID is the root comment a reply hangs under, however deep; nil on roots.
+	ThreadID   *primitive.ObjectID             `bson:"threadId,omitempty" json:"threadId,omitempty"`
+	Mentions   []MentionReference              `bson:"mentions,omitempty" json:"mentions,omitempty"`
+	Reactions  map[string][]primitive.ObjectID `bson:"reactions,omitempty" json:"reactions,omitempty"`
+	ReplyCount int                             `bson:"replyCount,omitempty" json:"replyCount,omitempty"`
+	Pending    bool                            `bson:"pending,omitempty" json:"pending,omitempty"` // From a restricted user; waits for the post's author to approve it
+	Metadata   CommentMetadata                 `bson:"metadata" json:"metadata"`
 }
 
 type CommentDocumentAPI struct {
-	ID       primitive.ObjectID     `json:"id"`
-	User     *UserExtendedReference `json:"user"`
-	Content  string                 `json:"content"`
-	ParentID *string                `json:"parentId,omitempty"`
-	Mentions []MentionReference     `json:"mentions,omitempty"`
-	Metadata CommentMetadata        `json:"metadata"`
+	ID         primitive.ObjectID     `json:"id"`
+	User       *UserExtendedReference `json:"user"`
+	Content    string                 `json:"content"`
+	ParentID   *string                `json:"parentId,omitempty"`
+	ThreadID   *string                `json:"threadId,omitempty" doc:"Root comment of the thread this reply belongs to"`
+	Mentions   []MentionReference     `json:"mentions,omitempty"`
+	Reactions  map[string][]string    `json:"reactions,omitempty" doc:"User IDs per emoji"`
+	ReplyCount int                    `json:"replyCount,omitempty" doc:"Replies in this thread (root comments only)"`
+	Pending    bool                   `json:"pending,omitempty" doc:"Waiting for the post's author to approve it; until then only they and the commenter see it"`
+	Metadata   CommentMetadata        `json:"metadata"`
 }
 
 func (c *CommentDocument) ToAPI() *CommentDocumentAPI {
 	api := &CommentDocumentAPI{
-		ID:       c.ID,
-		User:     c.User.ToAPI(),
-		Content:  c.Content,
-		Mentions: c.Mentions,
-		Metadata: c.Metadata,
+		ID:         c.ID,
+		User:       c.User.ToAPI(),
+		Content:    c.Content,
+		Mentions:   c.Mentions,
+		ReplyCount: c.ReplyCount,
+		Pending:    c.Pending,
+		Metadata:   c.Metadata,
 	}
 
 	if c.ParentID != nil {
 		parentIDStr := c.ParentID.Hex()
 		api.ParentID = &parentIDStr
 	}
+	if c.ThreadID != nil {
+		threadIDStr := c.ThreadID.Hex()
+		api.ThreadID = &threadIDStr
+	}
+	if len(c.Reactions) > 0 {
+		api.Reactions = make(map[string][]string, len(c.Reactions))
+		for emoji, userIDs := range c.Reactions {
+			ids := make([]string, len(userIDs))
+			for i, id := range userIDs {
+				ids[i] = id.Hex()
+			}
+			api.Reactions[emoji] = ids
+		}
+	}
 
 	return api
 }
@@ -858,10 +1040,12 @@ type PostDocumentAPI struct {
 	Groups      []string                    `json:"groups,omitempty"`
 	TaggedUsers []MentionReference          `json:"taggedUsers,omitempty"`
 	Song        *Song                       `json:"song,omitempty"`
+	Audience    PostAudience                `json:"audience" doc:"Who can see the post; which groups, users or lists are only sent to the author"`
 
-	Reactions map[string][]string  `json:"reactions"`
-	Comments  []CommentDocumentAPI `json:"comments"`
-	Kudos     []PostKudos          `json:"kudos,omitempty"`
+	Reactions    map[string][]string  `json:"reactions"`
+	Comments     []CommentDocumentAPI `json:"comments" doc:"Latest few comments; page the rest from the comments endpoint"`
+	CommentCount int                  `json:"commentCount" doc:"Total comments on the post, replies included"`
+	Kudos        []PostKudos          `json:"kudos,omitempty"`
 
 	Metadata PostMetadata `json:"metadata"`
 }
@@ -906,6 +1090,12 @@ func (p *PostDocument) ToAPI(viewerID primitive.ObjectID) *PostDocumentAPI {
 		groupStrings = append(groupStrings, groupID.Hex())
 	}
 
+	// Only the author learns exactly who else was picked.
+	audience := p.EffectiveAudience()
+	if !isOwner {
+		audience = PostAudience{Type: audience.Type}
+	}
+
 	// Always return a populated media[] so clients only read `media`.
 	media := p.Media
 	if len(media) == 0 {
@@ -915,41 +1105,54 @@ func (p *PostDocument) ToAPI(viewerID primitive.ObjectID) *PostDocumentAPI {
 	}
 
 	return &PostDocumentAPI{
-		ID:          p.ID,
-		User:        *p.User.ToAPI(),
-		Images:      p.Images,
-		Media:       media,
-		Dual:        p.Dual,
-		Caption:     p.Caption,
-		Size:        p.Size,
-		Category:    p.Category,
-		Task:        p.Task,
-		Blueprint:   p.Blueprint,
-		Groups:      groupStrings,
-		TaggedUsers: p.TaggedUsers,
-		Song:        p.Song,
-		Reactions:   apiReactions,
-		Comments:    apiComments,
-		Kudos:       apiKudos,
-		Metadata:    p.Metadata,
+		ID:           p.ID,
+		User:         *p.User.ToAPI(),
+		Images:       p.Images,
+		Media:        media,
+		Dual:         p.Dual,
+		Caption:      p.Caption,
+		Size:         p.Size,
+		Category:     p.Category,
+		Task:         p.Task,
+		Blueprint:    p.Blueprint,
+		Groups:       groupStrings,
+		TaggedUsers:  p.TaggedUsers,
+		Song:         p.Song,
+		Audience:     audience,
+		Reactions:    apiReactions,
+		Comments:     apiComments,
+		CommentCount: max(p.CommentCount, len(p.Comments)),
+		Kudos:        apiKudos,
+		Metadata:     p.Metadata,
 	}
 }
 
-// Group Document
+// Group Document. Creator is the group's owner; ownership can be handed to
+// another member, after which Creator names them.
 type GroupDocument struct {
 	ID       primitive.ObjectID              `bson:"_id" json:"_id"`
 	Name     string                          `bson:"name" json:"name"`
 	Creator  primitive.ObjectID              `bson:"creator" json:"creator"`
 	Members  []UserExtendedReferenceInternal `bson:"members" json:"members"`
 	Metadata GroupMetadata                   `bson:"metadata" json:"metadata"`
+
+	Admins          []primitive.ObjectID `bson:"admins,omitempty" json:"admins,omitempty"`
+	RequireApproval bool                 `bson:"requireApproval" json:"requireApproval"`
+	Invites         []GroupInvite        `bson:"invites,omitempty" json:"invites,omitempty"`
+	JoinRequests    []GroupJoinRequest   `bson:"joinRequests,omitempty" json:"joinRequests,omitempty"`
+	// Notifications holds members' settings keyed by user ID hex; a member
+	// without an entry gets DefaultGroupNotificationSettings.
+	Notifications map[string]GroupNotificationSettings `bson:"notifications,omitempty" json:"notifications,omitempty"`
 }
 
 type GroupDocumentAPI struct {
-	ID       primitive.ObjectID      `json:"_id"`
-	Name     string                  `json:"name"`
-	Creator  string                  `json:"creator"`
-	Members  []UserExtendedReference `json:"members"`
-	Metadata GroupMetadata           `json:"metadata"`
+	ID              primitive.ObjectID      `json:"_id"`
+	Name            string                  `json:"name"`
+	Creator         string                  `json:"creator" doc:"The group's owner"`
+	Admins          []string                `json:"admins"`
+	Members         []UserExtendedReference `json:"members"`
+	RequireApproval bool                    `json:"requireApproval" doc:"Whether joining through an invite link needs an owner or admin to approve"`
+	Metadata        GroupMetadata           `json:"metadata"`
 }
 
 func (g *GroupDocument) ToAPI() *GroupDocumentAPI {
@@ -958,13 +1161,95 @@ func (g *GroupDocument) ToAPI() *GroupDocumentAPI {
 		apiMembers = append(apiMembers, *member.ToAPI())
 	}
 
+	admins := make([]string, 0, len(g.Admins))
+	for _, id := range g.Admins {
+		admins = append(admins, id.Hex())
+	}
+
 	return &GroupDocumentAPI{
-		ID:       g.ID,
-		Name:     g.Name,
-		Creator:  g.Creator.Hex(),
-		Members:  apiMembers,
-		Metadata: g.Metadata,
+		ID:              g.ID,
+		Name:            g.Name,
+		Creator:         g.Creator.Hex(),
+		Admins:          admins,
+		Members:         apiMembers,
+		RequireApproval: g.RequireApproval,
+		Metadata:        g.Metadata,
+	}
+}
+
+// Group roles, from most to least privileged.
+const (
+	GroupRoleOwner  = "owner"
+	GroupRoleAdmin  = "admin"
+	GroupRoleMember = "member"
+)
+
+// RoleOf returns id's role in the group, or "" if they aren't in it.
+func (g *GroupDocument) RoleOf(id primitive.ObjectID) string {
+	if id == g.Creator {
+		return GroupRoleOwner
+	}
+	for _, a := range g.Admins {
+		if a == id {
+			return GroupRoleAdmin
+		}
+	}
+	for _, m := range g.Members {
+		if m.ID == id {
+			return GroupRoleMember
+		}
+	}
+	return ""
+}
+
+// CanManage reports whether id may manage members, invites and requests.
+func (g *GroupDocument) CanManage(id primitive.ObjectID) bool {
+	role := g.RoleOf(id)
+	return role == GroupRoleOwner || role == GroupRoleAdmin
+}
+
+// MemberIDs returns the owner and every member, without repeats.
+func (g *GroupDocument) MemberIDs() []primitive.ObjectID {
+	out := []primitive.ObjectID{g.Creator}
+	for _, m := range g.Members {
+		if m.ID != g.Creator {
+			out = append(out, m.ID)
+		}
+	}
+	return out
+}
+
+// NotificationSettingsFor returns id's settings for the group.
+func (g *GroupDocument) NotificationSettingsFor(id primitive.ObjectID) GroupNotificationSettings {
+	if settings, ok := g.Notifications[id.Hex()]; ok {
+		return settings
 	}
+	return DefaultGroupNotificationSettings()
+}
+
+// GroupInvite is a link code that lets anyone holding it join until it expires.
+type GroupInvite struct {
+	Code      string             `bson:"code" json:"code" example:"K7PQ2M4X"`
+	CreatedBy primitive.ObjectID `bson:"createdBy" json:"createdBy"`
+	CreatedAt time.Time          `bson:"createdAt" json:"createdAt"`
+	ExpiresAt time.Time          `bson:"expiresAt" json:"expiresAt"`
+}
+
+// GroupJoinRequest is someone waiting for an owner or admin to let them in.
+type GroupJoinRequest struct {
+	User        UserExtendedReferenceInternal `bson:"user" json:"user"`
+	RequestedAt time.Time                     `bson:"requestedAt" json:"requestedAt"`
+}
+
+// GroupNotificationSettings is what one member hears about from one group.
+type GroupNotificationSettings struct {
+	Posts        bool `bson:"posts" json:"posts" doc:"Posts shared with the group"`
+	Activity     bool `bson:"activity" json:"activity" doc:"Members closing their rings or finishing shared tasks"`
+	JoinRequests bool `bson:"joinRequests" json:"joinRequests" doc:"New join requests; only sent to owners and admins"`
+}
+
+func DefaultGroupNotificationSettings() GroupNotificationSettings {
+	return GroupNotificationSettings{Posts: true, Activity: true, JoinRequests: true}
 }
 
 type GroupMetadata struct {
@@ -982,6 +1267,43 @@ func NewGroupMetadata() GroupMetadata {
 	}
 }
 
+// GroupChallengeDocument is a goal a group's owner sets that members opt
+// into. Joining puts a flex task for it in the member's workspace, and every
+// completion of that task counts toward their progress.
+type GroupChallengeDocument struct {
+	ID           primitive.ObjectID     `bson:"_id"`
+	GroupID      primitive.ObjectID     `bson:"groupId"`
+	CreatedBy    primitive.ObjectID     `bson:"createdBy"`
+	Title        string                 `bson:"title"`
+	Description  string                 `bson:"description,omitempty"`
+	Goal         ChallengeGoal          `bson:"goal"`
+	StartDate    time.Time              `bson:"startDate"`
+	EndDate      time.Time              `bson:"endDate"`
+	Participants []ChallengeParticipant `bson:"participants"`
+	Metadata     GroupMetadata          `bson:"metadata"`
+}
+
+// ChallengeGoal is either a total number of completions over the challenge
+// ("30 workouts in March") or a target per period with the same meaning as a
+// flex task's, met every period of the challenge. Exactly one is set.
+type ChallengeGoal struct {
+	Target int          `bson:"target,omitempty" json:"target,omitempty" doc:"Total completions over the challenge"`
+	Flex   *FlexDetails `bson:"flex,omitempty" json:"flex,omitempty" doc:"Completions needed every period instead of a total"`
+}
+
+// ChallengeParticipant is a member who opted into a challenge and their
+// progress. Period fields only move for flex goals.
+type ChallengeParticipant struct {
+	User              UserExtendedReferenceInternal `bson:"user"`
+	JoinedAt          time.Time                     `bson:"joinedAt"`
+	Completions       int                           `bson:"completions"`
+	PeriodsMet        int                           `bson:"periodsMet"`
+	PeriodStart       *time.Time                    `bson:"periodStart,omitempty"`
+	CompletedInPeriod int                           `bson:"completedInPeriod"`
+	LastCompletedAt   *time.Time                    `bson:"lastCompletedAt,omitempty"`
+	FinishedAt        *time.Time                    `bson:"finishedAt,omitempty"`
+}
+
 // Enhanced Blueprint Reference with isPublic
 type EnhancedBlueprintReference struct {
 	ID       primitive.ObjectID `bson:"id" json:"id"`
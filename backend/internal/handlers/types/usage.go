@@ -0,0 +1,157 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// AIUsageCollection holds one AIUsage record per LLM backend call.
+const AIUsageCollection = "ai_usage"
+
+// Outcomes of an LLM backend call.
+const (
+	UsageOutcomeOK       = "ok"
+	UsageOutcomeError    = "error"
+	UsageOutcomeCanceled = "canceled"
+	UsageOutcomeTimeout  = "timeout"
+)
+
+// AIUsage records the cost of one LLM backend call.
+type AIUsage struct {
+	ID           primitive.ObjectID `bson:"_id" json:"id"`
+	UserID       primitive.ObjectID `bson:"user_id" json:"userId"`
+	Flow         string             `bson:"flow" json:"flow"`
+	Model        string             `bson:"model" json:"model"`
+	InputTokens  int                `bson:"input_tokens" json:"inputTokens"`
+	OutputTokens int                `bson:"output_tokens" json:"outputTokens"`
+	LatencyMs    int64              `bson:"latency_ms" json:"latencyMs"`
+	Outcome      string             `bson:"outcome" json:"outcome"`
+	CreatedAt    time.Time          `bson:"created_at" json:"createdAt"`
+}
+
+// TokenBudgets maps a subscription tier to the input plus output tokens its
+// users may spend per usage period. Tiers without an entry keep the per-type
+// credit counters.
+type TokenBudgets map[SubscriptionTier]int64
+
+// tokenBudgets is set once at startup, before requests are served.
+var tokenBudgets TokenBudgets
+
+// SetTokenBudgets installs the per-tier token budgets. An empty set leaves
+// every tier on the credit counters.
+func SetTokenBudgets(budgets TokenBudgets) {
+	tokenBudgets = budgets
+}
+
+// ParseTokenBudgets reads a comma-separated list of tier=tokens pairs, e.g.
+// "free=50000,basic=250000,premium=2000000".
+func ParseTokenBudgets(spec string) (TokenBudgets, error) {
+	budgets := TokenBudgets{}
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		tier, tokens, ok := strings.Cut(pair, "=")
+		tier, tokens = strings.TrimSpace(tier), strings.TrimSpace(tokens)
+		if !ok || tier == "" || tokens == "" {
+			return nil, fmt.Errorf("invalid token budget %q, expected tier=tokens", pair)
+		}
+		switch SubscriptionTier(tier) {
+		case TierFree, TierBasic, TierPremium, TierLifetime:
+		default:
+			return nil, fmt.Errorf("unknown subscription tier %q in token budgets", tier)
+		}
+		n, err := strconv.ParseInt(tokens, 10, 64)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid token count %q for tier %s", tokens, tier)
+		}
+		budgets[SubscriptionTier(tier)] = n
+	}
+	return budgets, nil
+}
+
+// EffectiveTier is the tier a subscription is billed at right now: lapsed or
+// unset subscriptions count as free.
+func (s *Subscription) EffectiveTier() SubscriptionTier {
+	if s.Tier == "" || !s.IsActive() {
+		return TierFree
+	}
+	return s.Tier
+}
+
+// TokenBudgetFor returns the token budget for tier, if it has one.
+func TokenBudgetFor(tier SubscriptionTier) (int64, bool) {
+	budget, ok := tokenBudgets[tier]
+	return budget, ok
+}
+
+// UsagePeriod returns the calendar month (UTC) containing now; token budgets
+// reset at its start.
+func UsagePeriod(now time.Time) (start, end time.Time) {
+	now = now.UTC()
+	start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
+
+// TokensUsed sums the input and output tokens a user spent since since.
+func TokensUsed(ctx context.Context, usage *mongo.Collection, userID primitive.ObjectID, since time.Time) (int64, error) {
+	cursor, err := usage.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user_id": userID, "created_at": bson.M{"$gte": since}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":    nil,
+			"tokens": bson.M{"$sum": bson.M{"$add": bson.A{"$input_tokens", "$output_tokens"}}},
+		}}},
+	})
+	if err != nil {
+		return 0, err
+	}
+	var totals []struct {
+		Tokens int64 `bson:"tokens"`
+	}
+	if err := cursor.All(ctx, &totals); err != nil {
+		return 0, err
+	}
+	if len(totals) == 0 {
+		return 0, nil
+	}
+	return totals[0].Tokens, nil
+}
+
+// tokenMeteredCreditTypes are the credit types a token budget stands in for.
+var tokenMeteredCreditTypes = map[CreditType]bool{
+	CreditTypeVoice:           true,
+	CreditTypeBlueprint:       true,
+	CreditTypeAnalytics:       true,
+	CreditTypeNaturalLanguage: true,
+}
+
+// tokenBudgetRemaining reports whether creditType is governed by a token
+// budget for this user and, if so, how many tokens are left this period. The
+// usage collection is found next to users.
+func tokenBudgetRemaining(ctx context.Context, users *mongo.Collection, userID primitive.ObjectID, creditType CreditType) (int64, bool, error) {
+	if !tokenMeteredCreditTypes[creditType] || len(tokenBudgets) == 0 {
+		return 0, false, nil
+	}
+	sub, err := GetUserSubscription(ctx, users, userID)
+	if err != nil {
+		return 0, false, err
+	}
+	budget, ok := TokenBudgetFor(sub.EffectiveTier())
+	if !ok {
+		return 0, false, nil
+	}
+	start, _ := UsagePeriod(time.Now())
+	used, err := TokensUsed(ctx, users.Database().Collection(AIUsageCollection), userID, start)
+	if err != nil {
+		return 0, false, err
+	}
+	return budget - used, true, nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTokenBudgets(t *testing.T) {
+	budgets, err := ParseTokenBudgets(" free=50000, premium=2000000,")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(budgets) != 2 || budgets[TierFree] != 50000 || budgets[TierPremium] != 2000000 {
+		t.Errorf("unexpected budgets %v", budgets)
+	}
+
+	for _, spec := range []string{"free", "free=", "gold=10", "free=-1", "free=lots"} {
+		if _, err := ParseTokenBudgets(spec); err == nil {
+			t.Errorf("expected an error for %q", spec)
+		}
+	}
+}
+
+func TestEffectiveTier(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	cases := []struct {
+		name string
+		sub  Subscription
+		want SubscriptionTier
+	}{
+		{"active premium", Subscription{Tier: TierPremium, Status: StatusActive}, TierPremium},
+		{"expired premium", Subscription{Tier: TierPremium, Status: StatusExpired}, TierFree},
+		{"ended premium", Subscription{Tier: TierPremium, Status: StatusActive, EndDate: &past}, TierFree},
+		{"unset", Subscription{}, TierFree},
+	}
+	for _, c := range cases {
+		if got := c.sub.EffectiveTier(); got != c.want {
+			t.Errorf("%s: got %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestUsagePeriod(t *testing.T) {
+	now := time.Date(2026, time.December, 31, 23, 30, 0, 0, time.FixedZone("PST", -8*60*60))
+
+	start, end := UsagePeriod(now)
+
+	if want := time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC); !start.Equal(want) {
+		t.Errorf("start = %v, want %v (periods are UTC months)", start, want)
+	}
+	if want := time.Date(2027, time.February, 1, 0, 0, 0, 0, time.UTC); !end.Equal(want) {
+		t.Errorf("end = %v, want %v", end, want)
+	}
+}
@@ -0,0 +1,20 @@
+package usage
+
+import (
+	"github.com/danielgtaylor/huma/v2"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func Routes(api huma.API, collections map[string]*mongo.Collection) {
+	handler := NewHandler(collections)
+
+	// Get the current user's AI usage
+	huma.Register(api, huma.Operation{
+		OperationID: "get-ai-usage",
+		Method:      "GET",
+		Path:        "/v1/user/ai/usage",
+		Summary:     "Get AI usage",
+		Description: "Returns the current user's AI usage this month per flow, with their tier's token budget and what is left of it",
+		Tags:        []string{"credits"},
+	}, handler.GetUsageSummaryHuma)
+}
@@ -0,0 +1,96 @@
+package usage
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func newService(collections map[string]*mongo.Collection) *Service {
+	users := collections["users"]
+	usage := collections[types.AIUsageCollection]
+	if usage == nil && users != nil {
+		usage = users.Database().Collection(types.AIUsageCollection)
+	}
+	return &Service{Usage: usage, Users: users}
+}
+
+// FlowTotals sums a user's usage per flow since since.
+func (s *Service) FlowTotals(ctx context.Context, userID primitive.ObjectID, since time.Time) ([]FlowUsage, error) {
+	cursor, err := s.Usage.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user_id": userID, "created_at": bson.M{"$gte": since}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   "$flow",
+			"calls": bson.M{"$sum": 1},
+			"failed": bson.M{"$sum": bson.M{
+				"$cond": bson.A{bson.M{"$eq": bson.A{"$outcome", types.UsageOutcomeOK}}, 0, 1},
+			}},
+			"input_tokens":   bson.M{"$sum": "$input_tokens"},
+			"output_tokens":  bson.M{"$sum": "$output_tokens"},
+			"avg_latency_ms": bson.M{"$avg": "$latency_ms"},
+		}}},
+		{{Key: "$set", Value: bson.M{"avg_latency_ms": bson.M{"$toLong": bson.M{"$round": bson.A{"$avg_latency_ms", 0}}}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var flows []FlowUsage
+	if err := cursor.All(ctx, &flows); err != nil {
+		return nil, err
+	}
+	return flows, nil
+}
+
+// Summary reports the user's usage in the current period.
+func (s *Service) Summary(ctx context.Context, userID primitive.ObjectID) (*UsageSummary, error) {
+	now := time.Now()
+	start, _ := types.UsagePeriod(now)
+	sub, err := types.GetUserSubscription(ctx, s.Users, userID)
+	if err != nil {
+		return nil, err
+	}
+	flows, err := s.FlowTotals(ctx, userID, start)
+	if err != nil {
+		return nil, err
+	}
+	summary := buildSummary(flows, sub.EffectiveTier(), now)
+	return &summary, nil
+}
+
+// buildSummary totals flows for the period containing now and sets the
+// quota for tier.
+func buildSummary(flows []FlowUsage, tier types.SubscriptionTier, now time.Time) UsageSummary {
+	start, end := types.UsagePeriod(now)
+	summary := UsageSummary{
+		PeriodStart: start,
+		PeriodEnd:   end,
+		Tier:        string(tier),
+		Quota:       QuotaCredits,
+		Flows:       []FlowUsage{},
+	}
+	for _, f := range flows {
+		summary.Calls += f.Calls
+		summary.TokensUsed += f.InputTokens + f.OutputTokens
+		summary.Flows = append(summary.Flows, f)
+	}
+	sort.SliceStable(summary.Flows, func(i, j int) bool {
+		a, b := summary.Flows[i], summary.Flows[j]
+		if at, bt := a.InputTokens+a.OutputTokens, b.InputTokens+b.OutputTokens; at != bt {
+			return at > bt
+		}
+		return a.Flow < b.Flow
+	})
+
+	if budget, ok := types.TokenBudgetFor(tier); ok {
+		remaining := max(budget-summary.TokensUsed, 0)
+		summary.Quota = QuotaTokens
+		summary.TokenBudget = &budget
+		summary.TokensRemaining = &remaining
+	}
+	return summary
+}
@@ -0,0 +1,46 @@
+package usage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildSummary_TokenBudget(t *testing.T) {
+	types.SetTokenBudgets(types.TokenBudgets{types.TierFree: 1000})
+	t.Cleanup(func() { types.SetTokenBudgets(nil) })
+	now := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+	flows := []FlowUsage{
+		{Flow: "intentRouter", Calls: 5, InputTokens: 200, OutputTokens: 20},
+		{Flow: "taskFromImage", Calls: 1, InputTokens: 900, OutputTokens: 100},
+		{Flow: "embed", Calls: 2, InputTokens: 10},
+	}
+
+	summary := buildSummary(flows, types.TierFree, now)
+
+	assert.Equal(t, QuotaTokens, summary.Quota)
+	assert.Equal(t, int64(8), summary.Calls)
+	assert.Equal(t, int64(1230), summary.TokensUsed)
+	if assert.NotNil(t, summary.TokenBudget) && assert.NotNil(t, summary.TokensRemaining) {
+		assert.Equal(t, int64(1000), *summary.TokenBudget)
+		assert.Equal(t, int64(0), *summary.TokensRemaining, "overspend floors at zero")
+	}
+	assert.Equal(t, []string{"taskFromImage", "intentRouter", "embed"},
+		[]string{summary.Flows[0].Flow, summary.Flows[1].Flow, summary.Flows[2].Flow})
+	assert.Equal(t, time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC), summary.PeriodStart)
+	assert.Equal(t, time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC), summary.PeriodEnd)
+}
+
+func TestBuildSummary_CreditsWithoutBudget(t *testing.T) {
+	types.SetTokenBudgets(types.TokenBudgets{types.TierFree: 1000})
+	t.Cleanup(func() { types.SetTokenBudgets(nil) })
+
+	summary := buildSummary(nil, types.TierBasic, time.Now())
+
+	assert.Equal(t, QuotaCredits, summary.Quota)
+	assert.Nil(t, summary.TokenBudget)
+	assert.Nil(t, summary.TokensRemaining)
+	assert.NotNil(t, summary.Flows, "no usage is an empty list, not null")
+}
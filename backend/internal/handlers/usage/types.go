@@ -0,0 +1,56 @@
+package usage
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Quota kinds: how a user's AI features are limited.
+const (
+	QuotaTokens  = "tokens"
+	QuotaCredits = "credits"
+)
+
+type Handler struct {
+	service *Service
+}
+
+type Service struct {
+	Usage *mongo.Collection
+	Users *mongo.Collection
+}
+
+// FlowUsage totals one flow's calls in a period.
+type FlowUsage struct {
+	Flow         string `bson:"_id" json:"flow"`
+	Calls        int64  `bson:"calls" json:"calls"`
+	Failed       int64  `bson:"failed" json:"failed" doc:"Calls that errored, timed out or were cancelled"`
+	InputTokens  int64  `bson:"input_tokens" json:"inputTokens"`
+	OutputTokens int64  `bson:"output_tokens" json:"outputTokens"`
+	AvgLatencyMs int64  `bson:"avg_latency_ms" json:"avgLatencyMs"`
+}
+
+// UsageSummary is a user's AI usage for the current period, against their
+// tier's token budget when it has one.
+type UsageSummary struct {
+	PeriodStart     time.Time   `json:"periodStart"`
+	PeriodEnd       time.Time   `json:"periodEnd"`
+	Tier            string      `json:"tier"`
+	Quota           string      `json:"quota" enum:"tokens,credits" doc:"tokens when the tier has a token budget; credits when AI features draw on the per-type credit counters"`
+	TokenBudget     *int64      `json:"tokenBudget,omitempty"`
+	TokensRemaining *int64      `json:"tokensRemaining,omitempty"`
+	TokensUsed      int64       `json:"tokensUsed"`
+	Calls           int64       `json:"calls"`
+	Flows           []FlowUsage `json:"flows" doc:"Per-flow totals, most tokens first"`
+}
+
+// API Input/Output Types
+
+type GetUsageSummaryInput struct {
+	// User ID from auth context
+}
+
+type GetUsageSummaryOutput struct {
+	Body UsageSummary
+}
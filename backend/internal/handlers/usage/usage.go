@@ -0,0 +1,38 @@
+package usage
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/auth"
+	"github.com/danielgtaylor/huma/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func NewHandler(collections map[string]*mongo.Collection) *Handler {
+	return &Handler{
+		service: newService(collections),
+	}
+}
+
+// GetUsageSummaryHuma returns the current user's AI usage this period
+func (h *Handler) GetUsageSummaryHuma(ctx context.Context, input *GetUsageSummaryInput) (*GetUsageSummaryOutput, error) {
+	userIDStr, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required", err)
+	}
+
+	userID, err := primitive.ObjectIDFromHex(userIDStr)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid user ID", err)
+	}
+
+	summary, err := h.service.Summary(ctx, userID)
+	if err != nil {
+		slog.Error("Failed to get AI usage summary", "error", err, "user_id", userIDStr)
+		return nil, huma.Error500InternalServerError("Unable to get usage. Please try again.", err)
+	}
+
+	return &GetUsageSummaryOutput{Body: *summary}, nil
+}
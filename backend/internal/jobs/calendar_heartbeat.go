@@ -99,6 +99,7 @@ func (j *CalendarHeartbeatJob) Run(ctx context.Context) error {
 				"connection_id", result.ConnectionID,
 				"error", err)
 		}
+		j.service.RecordHeartbeat(ctx, result)
 
 		switch result.Status {
 		case calendar.HealthStatusHealthy:
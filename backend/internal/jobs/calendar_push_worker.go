@@ -67,13 +67,13 @@ func (w *CalendarPushWorker) Run(ctx context.Context) error {
 	for _, row := range rows {
 		if err := w.service.ProcessPushRow(ctx, row); err != nil {
 			slog.Error("Push row failed", "row_id", row.ID, "task_id", row.TaskID, "op", row.Op, "attempt", row.AttemptCount, "error", err)
-			if markErr := outbox.MarkFailure(ctx, row.ID, row.AttemptCount, err.Error()); markErr != nil {
+			if markErr := outbox.MarkFailure(ctx, row, err.Error()); markErr != nil {
 				slog.Error("Failed to mark push row failure", "row_id", row.ID, "error", markErr)
 			}
 			failed++
 			continue
 		}
-		if err := outbox.MarkSuccess(ctx, row.ID); err != nil {
+		if err := outbox.MarkSuccess(ctx, row); err != nil {
 			slog.Error("Failed to delete successful push row", "row_id", row.ID, "error", err)
 		}
 		processed++
@@ -97,6 +97,9 @@ func (j *CalendarWatchRenewalJob) Run(ctx context.Context) error {
 		}
 
 		connectionsChecked++
+		connStart := time.Now()
+		connRenewed := 0
+		var connErrs []string
 
 		for _, watch := range connection.WatchChannels {
 			// Flag already-expired channels
@@ -128,12 +131,19 @@ func (j *CalendarWatchRenewalJob) Run(ctx context.Context) error {
 						"calendar watch renewal failed: connection=%s calendar=%s: %w",
 						connection.ID.Hex(), watch.CalendarID, err))
 					channelsFailed++
+					connErrs = append(connErrs, fmt.Sprintf("%s: %v", watch.CalendarID, err))
 					continue
 				}
 
 				channelsRenewed++
+				connRenewed++
 			}
 		}
+
+		// Only log connections that had something due; most passes renew nothing.
+		if connRenewed > 0 || len(connErrs) > 0 {
+			j.service.RecordRenewal(ctx, &connection, connStart, connRenewed, connErrs)
+		}
 	}
 
 	if err := cursor.Err(); err != nil {
@@ -7,6 +7,7 @@ import (
 	"runtime/debug"
 	"time"
 
+	"github.com/abhikaboy/Kindred/internal/audience"
 	"github.com/abhikaboy/Kindred/internal/gemini"
 	"github.com/abhikaboy/Kindred/internal/handlers/notifications"
 	"github.com/abhikaboy/Kindred/internal/handlers/types"
@@ -44,6 +45,7 @@ type KudosSuggesterJob struct {
 	userMemory     *mongo.Collection
 	dispatches     *mongo.Collection
 	notifier       *notifications.Service
+	audiences      *audience.Service // resolves a recipient's kudos pool; nil means all friends
 	policy         KudosPolicy
 }
 
@@ -83,6 +85,7 @@ func NewKudosSuggesterJob(collections map[string]*mongo.Collection, policy Kudos
 		userMemory:     collectionOrDerive(collections, gemini.UserMemoryCollection),
 		dispatches:     collectionOrDerive(collections, KudosDispatchCollection),
 		notifier:       notifications.NewNotificationService(collections),
+		audiences:      audience.New(collections),
 		policy:         policy,
 	}
 }
@@ -617,7 +620,7 @@ func (j *KudosSuggesterJob) processMoment(ctx context.Context, m KudosMoment, no
 		recipient.PeakStartHour = &hour
 	}
 
-	friends := friendSet(recipientUser.Friends)
+	friends := j.kudosPool(ctx, recipientUser)
 
 	sent := 0
 	for _, candidate := range affinity.TopFriends(maxSenderCandidates) {
@@ -638,6 +641,37 @@ func (j *KudosSuggesterJob) processMoment(ctx context.Context, m KudosMoment, no
 	}
 }
 
+// kudosPool is the set of friends who may be asked to cheer the recipient on:
+// the friend list they picked in settings, or every friend. A pool that can't
+// be resolved, say because the list was deleted, falls back to every friend.
+// Friends the recipient hides their activity from, or who muted the
+// recipient, are never asked.
+func (j *KudosSuggesterJob) kudosPool(ctx context.Context, u *types.User) map[primitive.ObjectID]bool {
+	pool := friendSet(u.Friends)
+	if j.audiences != nil && u.Settings.Lists != nil && u.Settings.Lists.KudosPool != "" {
+		members, err := j.audiences.ListMembers(ctx, u.ID, u.Settings.Lists.KudosPool)
+		if err != nil {
+			slog.Warn("Kudos suggester: could not resolve kudos pool", "user_id", u.ID, "list", u.Settings.Lists.KudosPool, "error", err)
+		} else {
+			pool = friendSet(members)
+		}
+	}
+
+	for _, id := range u.HiddenFrom {
+		delete(pool, id)
+	}
+	if j.audiences != nil {
+		withheld, err := j.audiences.Withheld(ctx, u.ID)
+		if err != nil {
+			slog.Warn("Kudos suggester: could not resolve withheld friends", "user_id", u.ID, "error", err)
+		}
+		for id := range withheld {
+			delete(pool, id)
+		}
+	}
+	return pool
+}
+
 // promptOne evaluates and, if allowed, dispatches a single prompt. Returns
 // whether a push actually went out.
 func (j *KudosSuggesterJob) promptOne(
@@ -0,0 +1,419 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/gemini"
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"github.com/getsentry/sentry-go"
+	"github.com/robfig/cron/v3"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MemoryDistillerJob writes the observed `user_memory` facts Kindred itself
+// reads by name — `peak-hours`, `kudos-affinity`, `encouragement-effect` and
+// `nudge-receptivity` — from completed tasks, kudos and notification
+// interactions.
+//
+// In production those rows come from the productivity-agent worker, and this
+// job must stay off there: two writers on one key would overwrite each other
+// every night. It exists for self-hosted and dev deployments that don't run
+// the worker, so personalization and the kudos suggester have something to
+// read. It follows the worker's rules: only users who consent and are not
+// paused, never a `source: "stated"` row, and derived rows expire on their
+// own.
+type MemoryDistillerJob struct {
+	users           *mongo.Collection
+	categories      *mongo.Collection // live tasks are embedded here
+	completedTasks  *mongo.Collection
+	encouragements  *mongo.Collection
+	congratulations *mongo.Collection
+	notifications   *mongo.Collection
+	exposures       *mongo.Collection
+	userMemory      *mongo.Collection
+}
+
+// derivedFactTTL is how long a derived row lives without being refreshed, the
+// same expiry the worker gives its rows; the TTL index on expiresAt removes
+// it after that.
+const derivedFactTTL = 45 * 24 * time.Hour
+
+// forgetBatchSize caps how many users' derived rows one delete covers.
+const forgetBatchSize = 500
+
+// derivedSource marks rows computed from behaviour rather than stated.
+const derivedSource = "derived"
+
+// NewMemoryDistillerJob wires the job from the collections map. `user_memory`
+// may not exist yet on a fresh database, so its handle is derived like the
+// kudos suggester's.
+func NewMemoryDistillerJob(collections map[string]*mongo.Collection) *MemoryDistillerJob {
+	return &MemoryDistillerJob{
+		users:           collections["users"],
+		categories:      collections["categories"],
+		completedTasks:  collections["completed-tasks"],
+		encouragements:  collections["encouragements"],
+		congratulations: collections["congratulations"],
+		notifications:   collections["notifications"],
+		exposures:       collections["for_you_exposures"],
+		userMemory:      collectionOrDerive(collections, gemini.UserMemoryCollection),
+	}
+}
+
+// Ready reports whether the job has everything it needs. The sources other
+// than users are optional: a missing one just leaves its facts unwritten.
+func (j *MemoryDistillerJob) Ready() bool {
+	return j.users != nil && j.userMemory != nil
+}
+
+// StartCron registers the distiller. Runs nightly at 04:00 UTC, after most
+// of the world's day has been recorded; the facts are slow-moving, so once a
+// day is all they need.
+func (j *MemoryDistillerJob) StartCron(c *cron.Cron) {
+	_, err := c.AddFunc("0 4 * * *", func() {
+		defer func() {
+			if r := recover(); r != nil {
+				stack := string(debug.Stack())
+				slog.Error("Panic recovered in memory distiller", "panic", r, "stack", stack)
+				sentry.CurrentHub().Recover(r)
+				sentry.Flush(2 * time.Second)
+			}
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+		defer cancel()
+		if err := j.Run(ctx); err != nil {
+			slog.Error("Memory distiller job failed", "error", err)
+			sentry.CaptureException(fmt.Errorf("memory distiller job failed: %w", err))
+		}
+	})
+	if err != nil {
+		slog.Error("Error adding memory distiller cron job", "error", err)
+	} else {
+		slog.Info("Memory distiller cron registered (daily at 04:00 UTC)")
+	}
+}
+
+// Run distills facts for every consenting user and forgets the derived facts
+// of everyone who opted out or paused, so withdrawing consent takes effect by
+// the next night rather than when the rows expire.
+func (j *MemoryDistillerJob) Run(ctx context.Context) error {
+	if !j.Ready() {
+		return fmt.Errorf("memory distiller: required collections unavailable")
+	}
+
+	start := time.Now()
+	now := time.Now().UTC()
+
+	// Every user, not the worker's consent query: those who fail
+	// MayPersonalize are the ones whose facts get forgotten.
+	cur, err := j.users.Find(ctx, bson.M{},
+		options.Find().SetProjection(bson.M{"_id": 1, "timezone": 1, "settings.personalization": 1}),
+	)
+	if err != nil {
+		return fmt.Errorf("find users: %w", err)
+	}
+	defer cur.Close(ctx)
+
+	users, written, skipped, forgotten := 0, 0, 0, 0
+	var withdrawn []primitive.ObjectID
+	forget := func() {
+		n, err := j.forgetDerived(ctx, withdrawn)
+		if err != nil {
+			slog.Error("Memory distiller: failed to forget derived facts", "users", len(withdrawn), "error", err)
+		}
+		forgotten += n
+		withdrawn = withdrawn[:0]
+	}
+	for cur.Next(ctx) {
+		var user struct {
+			ID       primitive.ObjectID `bson:"_id"`
+			Timezone string             `bson:"timezone"`
+			Settings types.UserSettings `bson:"settings"`
+		}
+		if err := cur.Decode(&user); err != nil {
+			slog.Warn("Memory distiller: failed to decode user", "error", err)
+			continue
+		}
+		if !user.Settings.MayPersonalize(now) {
+			skipped++
+			if withdrawn = append(withdrawn, user.ID); len(withdrawn) >= forgetBatchSize {
+				forget()
+			}
+			continue
+		}
+		users++
+
+		n, err := j.distillUser(ctx, user.ID, loadLocation(user.Timezone), now)
+		if err != nil {
+			// One user's failure must not cost everyone else their refresh.
+			slog.Error("Memory distiller: user failed", "userId", user.ID.Hex(), "error", err)
+			continue
+		}
+		written += n
+	}
+	if err := cur.Err(); err != nil {
+		return fmt.Errorf("iterate users: %w", err)
+	}
+	if len(withdrawn) > 0 {
+		forget()
+	}
+
+	slog.Info("Memory distiller run complete",
+		"users", users,
+		"skipped_withdrawn", skipped,
+		"facts_written", written,
+		"facts_forgotten", forgotten,
+		"duration_ms", time.Since(start).Milliseconds())
+	return nil
+}
+
+// forgetDerived deletes the derived facts of users who no longer consent.
+// Stated rows are the user's own words and stay until they delete them.
+func (j *MemoryDistillerJob) forgetDerived(ctx context.Context, userIDs []primitive.ObjectID) (int, error) {
+	res, err := j.userMemory.DeleteMany(ctx, bson.M{"userId": bson.M{"$in": userIDs}, "source": derivedSource})
+	if err != nil {
+		return 0, err
+	}
+	return int(res.DeletedCount), nil
+}
+
+// distillUser gathers one user's history, computes their facts and writes
+// them. Returns how many facts were written.
+func (j *MemoryDistillerJob) distillUser(ctx context.Context, userID primitive.ObjectID, loc *time.Location, now time.Time) (int, error) {
+	in, err := j.gather(ctx, userID, loc, now)
+	if err != nil {
+		return 0, err
+	}
+	facts := distillFacts(in)
+	if len(facts) == 0 {
+		return 0, nil
+	}
+	return j.writeFacts(ctx, userID, facts, now)
+}
+
+// gather loads everything distillFacts reads for one user.
+func (j *MemoryDistillerJob) gather(ctx context.Context, userID primitive.ObjectID, loc *time.Location, now time.Time) (distillInput, error) {
+	in := distillInput{Location: loc, CompletedTaskIDs: map[primitive.ObjectID]bool{}}
+	rhythmSince := now.Add(-rhythmWindow)
+	kudosSince := now.Add(-kudosWindow)
+
+	if j.completedTasks != nil {
+		var rows []struct {
+			TimeCompleted *time.Time `bson:"timeCompleted"`
+		}
+		if err := findAll(ctx, j.completedTasks, bson.M{"user": userID, "timeCompleted": bson.M{"$gte": rhythmSince}}, bson.M{"timeCompleted": 1}, &rows); err != nil {
+			return in, fmt.Errorf("load completions: %w", err)
+		}
+		for _, r := range rows {
+			if r.TimeCompleted != nil {
+				in.Completions = append(in.Completions, *r.TimeCompleted)
+			}
+		}
+		n, err := j.completedTasks.CountDocuments(ctx, bson.M{"user": userID, "timeCompleted": bson.M{"$gte": kudosSince}})
+		if err != nil {
+			return in, fmt.Errorf("count completions: %w", err)
+		}
+		in.CompletedInKudosWindow = int(n)
+	}
+
+	if j.categories != nil {
+		open, err := j.countOpenTasks(ctx, userID)
+		if err != nil {
+			return in, err
+		}
+		in.OpenTasks = open
+	}
+
+	if err := j.gatherKudos(ctx, userID, kudosSince, &in); err != nil {
+		return in, err
+	}
+
+	if j.notifications != nil {
+		filter := bson.M{"receiver": userID, "time": bson.M{"$gte": rhythmSince}}
+		total, err := j.notifications.CountDocuments(ctx, filter)
+		if err != nil {
+			return in, fmt.Errorf("count notifications: %w", err)
+		}
+		filter["read"] = true
+		read, err := j.notifications.CountDocuments(ctx, filter)
+		if err != nil {
+			return in, fmt.Errorf("count read notifications: %w", err)
+		}
+		in.Notifications, in.NotificationsRead = int(total), int(read)
+	}
+
+	if j.exposures != nil {
+		var rows []struct {
+			Views        int `bson:"views"`
+			Interactions int `bson:"interactions"`
+		}
+		if err := findAll(ctx, j.exposures, bson.M{"user_id": userID}, bson.M{"views": 1, "interactions": 1}, &rows); err != nil {
+			return in, fmt.Errorf("load card exposures: %w", err)
+		}
+		for _, r := range rows {
+			in.CardViews += r.Views
+			in.CardInteractions += r.Interactions
+		}
+	}
+
+	return in, nil
+}
+
+// gatherKudos loads the kudos the user received and which of the encouraged
+// tasks were completed. Kudos cards are encouragements, so their dismissals
+// are counted here too.
+func (j *MemoryDistillerJob) gatherKudos(ctx context.Context, userID primitive.ObjectID, since time.Time, in *distillInput) error {
+	filter := bson.M{"receiver": userID, "timestamp": bson.M{"$gte": since}}
+	var taskIDs []primitive.ObjectID
+
+	if j.encouragements != nil {
+		var rows []struct {
+			Sender struct {
+				ID     primitive.ObjectID `bson:"id"`
+				Handle string             `bson:"handle"`
+			} `bson:"sender"`
+			Timestamp time.Time          `bson:"timestamp"`
+			TaskID    primitive.ObjectID `bson:"taskId"`
+			Reaction  *string            `bson:"reaction"`
+			Dismissed bool               `bson:"feed_dismissed"`
+		}
+		if err := findAll(ctx, j.encouragements, filter, nil, &rows); err != nil {
+			return fmt.Errorf("load encouragements: %w", err)
+		}
+		for _, r := range rows {
+			in.Kudos = append(in.Kudos, kudosEvent{SenderID: r.Sender.ID, Handle: r.Sender.Handle, At: r.Timestamp, Reacted: r.Reaction != nil, TaskID: r.TaskID})
+			if !r.TaskID.IsZero() {
+				taskIDs = append(taskIDs, r.TaskID)
+			}
+			in.KudosCards++
+			if r.Dismissed {
+				in.KudosCardsDismissed++
+			}
+		}
+	}
+
+	if j.congratulations != nil {
+		var rows []struct {
+			Sender struct {
+				ID primitive.ObjectID `bson:"id"`
+			} `bson:"sender"`
+			Timestamp time.Time `bson:"timestamp"`
+			Reaction  *string   `bson:"reaction"`
+		}
+		if err := findAll(ctx, j.congratulations, filter, nil, &rows); err != nil {
+			return fmt.Errorf("load congratulations: %w", err)
+		}
+		for _, r := range rows {
+			in.Kudos = append(in.Kudos, kudosEvent{SenderID: r.Sender.ID, At: r.Timestamp, Reacted: r.Reaction != nil})
+		}
+	}
+
+	if len(taskIDs) > 0 && j.completedTasks != nil {
+		var done []struct {
+			ID primitive.ObjectID `bson:"_id"`
+		}
+		// Completing a task keeps its _id in completed-tasks.
+		if err := findAll(ctx, j.completedTasks, bson.M{"_id": bson.M{"$in": taskIDs}, "user": userID}, bson.M{"_id": 1}, &done); err != nil {
+			return fmt.Errorf("load encouraged completions: %w", err)
+		}
+		for _, d := range done {
+			in.CompletedTaskIDs[d.ID] = true
+		}
+	}
+	return nil
+}
+
+// countOpenTasks counts the user's live tasks. Completing a task removes it
+// from `categories.tasks`, so everything there is open.
+func (j *MemoryDistillerJob) countOpenTasks(ctx context.Context, userID primitive.ObjectID) (int, error) {
+	cur, err := j.categories.Aggregate(ctx, []bson.M{
+		{"$match": bson.M{"user": userID, "isBlueprint": bson.M{"$ne": true}}},
+		{"$project": bson.M{"n": bson.M{"$size": bson.M{"$ifNull": bson.A{"$tasks", bson.A{}}}}}},
+		{"$group": bson.M{"_id": nil, "n": bson.M{"$sum": "$n"}}},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("count open tasks: %w", err)
+	}
+	defer cur.Close(ctx)
+	var rows []struct {
+		N int `bson:"n"`
+	}
+	if err := cur.All(ctx, &rows); err != nil {
+		return 0, fmt.Errorf("decode open tasks: %w", err)
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	return rows[0].N, nil
+}
+
+// writeFacts upserts derived facts, skipping every key the user has a stated
+// answer for: what the user told us always wins over what we observe.
+func (j *MemoryDistillerJob) writeFacts(ctx context.Context, userID primitive.ObjectID, facts []distilledFact, now time.Time) (int, error) {
+	keys := make([]string, len(facts))
+	for i, f := range facts {
+		keys[i] = f.Key
+	}
+	var stated []struct {
+		Key string `bson:"key"`
+	}
+	if err := findAll(ctx, j.userMemory, bson.M{"userId": userID, "key": bson.M{"$in": keys}, "source": "stated"}, bson.M{"key": 1}, &stated); err != nil {
+		return 0, fmt.Errorf("load stated facts: %w", err)
+	}
+	skip := make(map[string]bool, len(stated))
+	for _, s := range stated {
+		skip[s.Key] = true
+	}
+
+	models := make([]mongo.WriteModel, 0, len(facts))
+	for _, f := range facts {
+		if skip[f.Key] {
+			continue
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			// The source filter is the second lock: a stated row written
+			// since the read above is not matched, so it is never overwritten.
+			SetFilter(bson.M{"userId": userID, "key": f.Key, "source": bson.M{"$ne": "stated"}}).
+			SetUpdate(bson.M{
+				"$set": bson.M{
+					"kind":       f.Kind,
+					"content":    f.Content,
+					"confidence": f.Confidence,
+					"evidence":   f.Evidence,
+					"source":     derivedSource,
+					"updatedAt":  now,
+					"expiresAt":  now.Add(derivedFactTTL),
+				},
+				"$setOnInsert": bson.M{"createdAt": now},
+			}).
+			SetUpsert(true))
+	}
+	if len(models) == 0 {
+		return 0, nil
+	}
+	if _, err := j.userMemory.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		return 0, fmt.Errorf("write facts: %w", err)
+	}
+	return len(models), nil
+}
+
+// findAll runs a find and decodes every document into out.
+func findAll(ctx context.Context, coll *mongo.Collection, filter, projection bson.M, out any) error {
+	opts := options.Find()
+	if projection != nil {
+		opts.SetProjection(projection)
+	}
+	cur, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	return cur.All(ctx, out)
+}
@@ -0,0 +1,391 @@
+package jobs
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/gemini"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// The computing half of the memory distiller, kept free of Mongo and clocks
+// like kudos_policy.go. MemoryDistillerJob gathers one user's history; every
+// number that ends up in `user_memory` is worked out here.
+//
+// The keys, kinds and evidence shapes are the ones the productivity-agent
+// worker writes, because the readers in gemini/memory.go cannot tell the two
+// writers apart and must not have to.
+
+// Look-back windows. Rhythm and receptivity drift, so they use the recent
+// month; kudos are rarer and need a season to say anything.
+const (
+	rhythmWindow = 30 * 24 * time.Hour
+	kudosWindow  = 90 * 24 * time.Hour
+)
+
+// Sample floors below which a fact is not written at all. A fact from three
+// data points would be a guess in a confident voice.
+const (
+	minPeakSample        = 15
+	minKudosSample       = 3
+	minEffectSample      = gemini.MinEffectSample
+	minReceptivitySample = 10
+)
+
+// Sample sizes at which a fact reaches its full confidence.
+const (
+	fullPeakSample        = 60
+	fullKudosSample       = 20
+	fullEffectSample      = 30
+	fullReceptivitySample = 60
+)
+
+const (
+	// peakWindowHours is the width of the peak-hours window.
+	peakWindowHours = 4
+	// maxAffinityFriends caps the ranked list in kudos-affinity.
+	maxAffinityFriends = 10
+	// positiveEffectLift is the lift at which encouragement counts as working.
+	positiveEffectLift = 1.2
+	// Receptivity thresholds on the share of nudges acted on or dismissed.
+	receptiveActedRate    = 0.3
+	passiveActedRate      = 0.1
+	dismissiveDismissRate = 0.5
+)
+
+// kudosEvent is one encouragement or congratulation the user received.
+type kudosEvent struct {
+	SenderID primitive.ObjectID
+	Handle   string
+	At       time.Time
+	Reacted  bool
+	// TaskID is set for task-scoped encouragements, the only kudos a
+	// completion can be attributed to.
+	TaskID primitive.ObjectID
+}
+
+// distillInput is everything known about one user for one pass.
+type distillInput struct {
+	Location *time.Location
+
+	// Completions are the timeCompleted stamps in rhythmWindow.
+	Completions []time.Time
+	// CompletedInKudosWindow and OpenTasks give the baseline completion rate
+	// encouraged tasks are compared to.
+	CompletedInKudosWindow int
+	OpenTasks              int
+
+	Kudos []kudosEvent
+	// CompletedTaskIDs holds the encouraged tasks that were completed.
+	CompletedTaskIDs map[primitive.ObjectID]bool
+
+	Notifications       int
+	NotificationsRead   int
+	CardViews           int
+	CardInteractions    int
+	KudosCards          int
+	KudosCardsDismissed int
+}
+
+// distilledFact is one `user_memory` row on its way to be written.
+type distilledFact struct {
+	Key        string
+	Kind       string
+	Content    string
+	Confidence float64
+	Evidence   bson.M
+}
+
+// distillFacts computes every fact there is enough evidence for. Facts below
+// gemini.MinFactConfidence are left out, as the worker leaves them out.
+func distillFacts(in distillInput) []distilledFact {
+	var facts []distilledFact
+	for _, f := range []*distilledFact{
+		distillPeakHours(in),
+		distillKudosAffinity(in),
+		distillEncouragementEffect(in),
+		distillNudgeReceptivity(in),
+	} {
+		if f != nil && f.Confidence >= gemini.MinFactConfidence {
+			facts = append(facts, *f)
+		}
+	}
+	return facts
+}
+
+// distillPeakHours finds the peakWindowHours-long stretch of the local day
+// where completions concentrate.
+func distillPeakHours(in distillInput) *distilledFact {
+	if len(in.Completions) < minPeakSample {
+		return nil
+	}
+	loc := in.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	var hours [24]int
+	for _, t := range in.Completions {
+		hours[t.In(loc).Hour()]++
+	}
+
+	bestStart, bestCount := 0, -1
+	for start := 0; start+peakWindowHours <= 24; start++ {
+		count := 0
+		for h := start; h < start+peakWindowHours; h++ {
+			count += hours[h]
+		}
+		if count > bestCount {
+			bestStart, bestCount = start, count
+		}
+	}
+	share := float64(bestCount) / float64(len(in.Completions))
+	// A window holding no more than its share of an even spread is no peak.
+	if share <= float64(peakWindowHours)/24*1.5 {
+		return nil
+	}
+	end := bestStart + peakWindowHours
+
+	return &distilledFact{
+		Key:        gemini.FactKeyPeakHours,
+		Kind:       "rhythm",
+		Content:    fmt.Sprintf("Gets most done between %s and %s local time. Favor that window for demanding work and scheduling suggestions.", clockHour(bestStart), clockHour(end)),
+		Confidence: round2(share * sampleWeight(len(in.Completions), fullPeakSample)),
+		Evidence: bson.M{
+			"sampleSize":      len(in.Completions),
+			"windowDays":      windowDays(rhythmWindow),
+			"windowStartHour": bestStart,
+			"windowEndHour":   end,
+			"shareInWindow":   round2(share),
+		},
+	}
+}
+
+// baselineRate is how often the user's tasks get done at all.
+func baselineRate(in distillInput) float64 {
+	total := in.CompletedInKudosWindow + in.OpenTasks
+	if total == 0 {
+		return 0
+	}
+	return float64(in.CompletedInKudosWindow) / float64(total)
+}
+
+// completionLift is the completion rate of encouraged tasks over the
+// baseline. Zero when either side is unknown.
+func completionLift(encouraged, completed int, baseline float64) float64 {
+	if encouraged == 0 || baseline == 0 {
+		return 0
+	}
+	return round2(float64(completed) / float64(encouraged) / baseline)
+}
+
+// distillKudosAffinity ranks the people whose kudos the user receives.
+func distillKudosAffinity(in distillInput) *distilledFact {
+	if len(in.Kudos) < minKudosSample {
+		return nil
+	}
+	type tally struct {
+		friend               primitive.ObjectID
+		handle               string
+		received, reacted    int
+		encouraged, finished int
+		last                 time.Time
+	}
+	byFriend := map[primitive.ObjectID]*tally{}
+	maxReceived := 0
+	for _, k := range in.Kudos {
+		if k.SenderID.IsZero() {
+			continue
+		}
+		t := byFriend[k.SenderID]
+		if t == nil {
+			t = &tally{friend: k.SenderID}
+			byFriend[k.SenderID] = t
+		}
+		if t.handle == "" {
+			t.handle = k.Handle
+		}
+		t.received++
+		if k.Reacted {
+			t.reacted++
+		}
+		if !k.TaskID.IsZero() {
+			t.encouraged++
+			if in.CompletedTaskIDs[k.TaskID] {
+				t.finished++
+			}
+		}
+		if k.At.After(t.last) {
+			t.last = k.At
+		}
+		if t.received > maxReceived {
+			maxReceived = t.received
+		}
+	}
+	if len(byFriend) == 0 {
+		return nil
+	}
+
+	baseline := baselineRate(in)
+	friends := make([]bson.M, 0, len(byFriend))
+	for _, t := range byFriend {
+		reactedRate := round2(float64(t.reacted) / float64(t.received))
+		lift := completionLift(t.encouraged, t.finished, baseline)
+		// Volume and engagement carry the ranking; lift only breaks ties,
+		// because it is observational (see gemini.KudosFriend).
+		affinity := 0.45*float64(t.received)/float64(maxReceived) + 0.4*reactedRate + 0.15*math.Min(lift/2, 1)
+		friends = append(friends, bson.M{
+			"friendId":       t.friend,
+			"handle":         t.handle,
+			"received":       t.received,
+			"reactedRate":    reactedRate,
+			"completionLift": lift,
+			"affinity":       round2(affinity),
+			"lastAt":         t.last.UTC(),
+		})
+	}
+	sort.SliceStable(friends, func(i, j int) bool {
+		ai, aj := friends[i]["affinity"].(float64), friends[j]["affinity"].(float64)
+		if ai != aj {
+			return ai > aj
+		}
+		return friends[i]["lastAt"].(time.Time).After(friends[j]["lastAt"].(time.Time))
+	})
+	if len(friends) > maxAffinityFriends {
+		friends = friends[:maxAffinityFriends]
+	}
+
+	top := friends[0]["handle"].(string)
+	content := "Kudos from a few close friends land best; encouragement from them is the most likely to be noticed."
+	if top != "" {
+		content = fmt.Sprintf("Kudos from a few close friends land best, @%s most of all; encouragement from them is the most likely to be noticed.", top)
+	}
+	return &distilledFact{
+		Key:        gemini.FactKeyKudosAffinity,
+		Kind:       "social",
+		Content:    content,
+		Confidence: round2(0.4 + 0.6*sampleWeight(len(in.Kudos), fullKudosSample)),
+		Evidence: bson.M{
+			"sampleSize": len(in.Kudos),
+			"windowDays": windowDays(kudosWindow),
+			"friends":    friends,
+		},
+	}
+}
+
+// distillEncouragementEffect compares encouraged tasks with everything else.
+// The verdict names gemini.EncouragementEffect.Worthwhile understands.
+func distillEncouragementEffect(in distillInput) *distilledFact {
+	encouraged := map[primitive.ObjectID]bool{}
+	for _, k := range in.Kudos {
+		if !k.TaskID.IsZero() {
+			encouraged[k.TaskID] = true
+		}
+	}
+	baseline := baselineRate(in)
+	if len(encouraged) < minEffectSample || baseline == 0 {
+		return nil
+	}
+	finished := 0
+	for id := range encouraged {
+		if in.CompletedTaskIDs[id] {
+			finished++
+		}
+	}
+	lift := completionLift(len(encouraged), finished, baseline)
+
+	verdict, content := "neutral", "Encouragement on a task makes little visible difference to whether it gets done. Send it when it is warranted, not as a lever."
+	switch {
+	case lift >= positiveEffectLift:
+		verdict, content = "positive", "Tasks a friend encouraged get done more often than the rest. A word of encouragement on a stuck task is worth suggesting."
+	case lift <= 1.0:
+		verdict, content = "none", "Encouraged tasks finish no more often than any others. Don't lean on encouragement to move this user's work along."
+	}
+	return &distilledFact{
+		Key:        gemini.FactKeyEncouragementEffect,
+		Kind:       "social",
+		Content:    content,
+		Confidence: round2(0.35 + 0.5*sampleWeight(len(encouraged), fullEffectSample)),
+		Evidence: bson.M{
+			"sampleSize":     len(encouraged),
+			"windowDays":     windowDays(kudosWindow),
+			"lift":           lift,
+			"encouragedDone": finished,
+			"baselineRate":   round2(baseline),
+			"verdict":        verdict,
+		},
+	}
+}
+
+// distillNudgeReceptivity reads whether proactive messages get acted on,
+// dismissed or ignored. The rates are directional: interactions on For You
+// cards are lifetime counters while notifications and dismissals are
+// windowed, the same caveat the worker's evidence carries.
+func distillNudgeReceptivity(in distillInput) *distilledFact {
+	shown := in.Notifications + in.CardViews
+	if shown < minReceptivitySample {
+		return nil
+	}
+	actedRate := round2(math.Min(float64(in.NotificationsRead+in.CardInteractions)/float64(shown), 1))
+	dismissRate := 0.0
+	if in.KudosCards > 0 {
+		dismissRate = round2(float64(in.KudosCardsDismissed) / float64(in.KudosCards))
+	}
+
+	var verdict, content string
+	reduce := false
+	switch {
+	case dismissRate >= dismissiveDismissRate:
+		verdict, reduce = "dismissive", true
+		content = "Tends to dismiss proactive suggestions. Send fewer, and only ones that clearly matter."
+	case actedRate >= receptiveActedRate:
+		verdict = "receptive"
+		content = "Acts on proactive suggestions and reminders. Timely nudges are welcome."
+	default:
+		verdict, reduce = "passive", actedRate < passiveActedRate
+		content = "Mostly lets proactive suggestions pass without acting on them. Keep nudges brief and infrequent."
+	}
+	return &distilledFact{
+		Key:        gemini.FactKeyNudgeReceptivity,
+		Kind:       "social",
+		Content:    content,
+		Confidence: round2(0.35 + 0.55*sampleWeight(shown, fullReceptivitySample)),
+		Evidence: bson.M{
+			"sampleSize":            shown,
+			"windowDays":            windowDays(rhythmWindow),
+			"actedRate":             actedRate,
+			"dismissRate":           dismissRate,
+			"shouldReduceFrequency": reduce,
+			"verdict":               verdict,
+		},
+	}
+}
+
+// sampleWeight grows linearly to 1 at full samples.
+func sampleWeight(n, full int) float64 {
+	return math.Min(float64(n)/float64(full), 1)
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+func windowDays(d time.Duration) int {
+	return int(d / (24 * time.Hour))
+}
+
+// clockHour renders an hour of the day as "9am", "noon", "5pm" or "midnight".
+func clockHour(h int) string {
+	switch {
+	case h == 0 || h == 24:
+		return "midnight"
+	case h == 12:
+		return "noon"
+	case h < 12:
+		return fmt.Sprintf("%dam", h)
+	default:
+		return fmt.Sprintf("%dpm", h-12)
+	}
+}
@@ -0,0 +1,153 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/gemini"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// decodeAs round-trips a distilled fact through BSON and the gemini reader,
+// which is what proves the writer and the readers agree on the shape.
+func decodeAs(t *testing.T, f *distilledFact) *gemini.UserFact {
+	t.Helper()
+	if f == nil {
+		t.Fatal("expected a fact")
+	}
+	raw, err := bson.Marshal(f.Evidence)
+	if err != nil {
+		t.Fatalf("marshal evidence: %v", err)
+	}
+	return &gemini.UserFact{Key: f.Key, Content: f.Content, Confidence: f.Confidence, Evidence: raw}
+}
+
+func TestDistillPeakHours(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	var completions []time.Time
+	// Twenty completions between 9 and 11am New York time, five scattered.
+	for i := 0; i < 20; i++ {
+		completions = append(completions, time.Date(2026, 7, 1+i, 9+i%3, 0, 0, 0, ny))
+	}
+	for i := 0; i < 5; i++ {
+		completions = append(completions, time.Date(2026, 7, 1+i, 20, 0, 0, 0, ny))
+	}
+
+	f := distillPeakHours(distillInput{Location: ny, Completions: completions})
+	peak := gemini.DecodePeakHours(decodeAs(t, f))
+	if peak == nil || peak.StartHour != 8 || peak.EndHour != 12 {
+		t.Fatalf("peak = %+v, want 8-12", peak)
+	}
+	if peak.ShareInWindow != 0.8 {
+		t.Errorf("share = %v, want 0.8", peak.ShareInWindow)
+	}
+
+	if f := distillPeakHours(distillInput{Completions: completions[:minPeakSample-1]}); f != nil {
+		t.Errorf("thin sample produced %+v", f)
+	}
+
+	var even []time.Time
+	for h := 0; h < 24; h++ {
+		even = append(even, time.Date(2026, 7, 1, h, 0, 0, 0, time.UTC))
+	}
+	if f := distillPeakHours(distillInput{Completions: even}); f != nil {
+		t.Errorf("an even spread has no peak, got %+v", f.Evidence)
+	}
+}
+
+func TestDistillKudosAffinity_RanksFriends(t *testing.T) {
+	closeFriend, casual := primitive.NewObjectID(), primitive.NewObjectID()
+	var kudos []kudosEvent
+	for i := 0; i < 6; i++ {
+		kudos = append(kudos, kudosEvent{SenderID: closeFriend, Handle: "sam", At: testNow.AddDate(0, 0, -i), Reacted: i%2 == 0})
+	}
+	kudos = append(kudos, kudosEvent{SenderID: casual, Handle: "lee", At: testNow})
+
+	affinity, err := gemini.DecodeKudosAffinity(decodeAs(t, distillKudosAffinity(distillInput{Kudos: kudos})))
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(affinity.Friends) != 2 || affinity.Friends[0].FriendID != closeFriend || affinity.Friends[0].Handle != "sam" {
+		t.Fatalf("friends = %+v", affinity.Friends)
+	}
+	if affinity.Friends[0].Received != 6 || affinity.Friends[0].ReactedRate != 0.5 {
+		t.Errorf("top friend = %+v", affinity.Friends[0])
+	}
+	if affinity.SampleSize != 7 || affinity.WindowDays != 90 {
+		t.Errorf("sample = %d over %d days", affinity.SampleSize, affinity.WindowDays)
+	}
+}
+
+func TestDistillEncouragementEffect(t *testing.T) {
+	sender := primitive.NewObjectID()
+	build := func(encouraged, finished int) distillInput {
+		in := distillInput{CompletedInKudosWindow: 50, OpenTasks: 50, CompletedTaskIDs: map[primitive.ObjectID]bool{}}
+		for i := 0; i < encouraged; i++ {
+			id := primitive.NewObjectID()
+			in.Kudos = append(in.Kudos, kudosEvent{SenderID: sender, TaskID: id, At: testNow})
+			if i < finished {
+				in.CompletedTaskIDs[id] = true
+			}
+		}
+		return in
+	}
+
+	// 9 of 10 encouraged tasks done against a 50% baseline.
+	effect := gemini.DecodeEncouragementEffect(decodeAs(t, distillEncouragementEffect(build(10, 9))))
+	if effect.Verdict != "positive" || effect.Lift != 1.8 || !effect.Worthwhile() {
+		t.Errorf("effect = %+v", effect)
+	}
+
+	// 4 of 12 against 50%: encouragement isn't landing.
+	effect = gemini.DecodeEncouragementEffect(decodeAs(t, distillEncouragementEffect(build(12, 4))))
+	if effect.Verdict != "none" || effect.Worthwhile() {
+		t.Errorf("effect = %+v, want a confident none", effect)
+	}
+
+	if f := distillEncouragementEffect(build(minEffectSample-1, 5)); f != nil {
+		t.Errorf("thin sample produced %+v", f.Evidence)
+	}
+}
+
+func TestDistillNudgeReceptivity(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      distillInput
+		verdict string
+		reduce  bool
+	}{
+		{"receptive", distillInput{Notifications: 20, NotificationsRead: 12}, "receptive", false},
+		{"dismissive", distillInput{Notifications: 20, NotificationsRead: 12, KudosCards: 4, KudosCardsDismissed: 3}, "dismissive", true},
+		{"passive", distillInput{Notifications: 10, NotificationsRead: 2, CardViews: 10}, "passive", false},
+		{"ignores everything", distillInput{Notifications: 30, CardViews: 10}, "passive", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := gemini.DecodeNudgeReceptivity(decodeAs(t, distillNudgeReceptivity(tt.in)))
+			if got.Verdict != tt.verdict || got.ShouldReduceFrequency != tt.reduce {
+				t.Errorf("receptivity = %+v, want %s (reduce %v)", got, tt.verdict, tt.reduce)
+			}
+		})
+	}
+
+	if f := distillNudgeReceptivity(distillInput{Notifications: minReceptivitySample - 1}); f != nil {
+		t.Errorf("thin sample produced %+v", f.Evidence)
+	}
+}
+
+func TestDistillFacts_DropsLowConfidence(t *testing.T) {
+	// Just enough completions for a peak, too few for a confident one.
+	var completions []time.Time
+	for i := 0; i < minPeakSample; i++ {
+		completions = append(completions, time.Date(2026, 7, 1+i, 9, 0, 0, 0, time.UTC))
+	}
+	if facts := distillFacts(distillInput{Completions: completions}); len(facts) != 0 {
+		t.Errorf("facts = %+v, want none below the confidence floor", facts)
+	}
+	completions = append(completions, completions...)
+	completions = append(completions, completions...)
+	if facts := distillFacts(distillInput{Completions: completions}); len(facts) != 1 || facts[0].Key != gemini.FactKeyPeakHours {
+		t.Errorf("facts = %+v, want peak-hours", facts)
+	}
+}
@@ -0,0 +1,64 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	Post "github.com/abhikaboy/Kindred/internal/handlers/post"
+	"github.com/getsentry/sentry-go"
+	"github.com/robfig/cron/v3"
+)
+
+// ScheduledPostPublisherJob publishes drafts whose scheduled time has come.
+// Stats, the Share ring and the friend-posted wave (with its 48h cooldown)
+// all happen at publish time, exactly as for a post made on the spot.
+type ScheduledPostPublisherJob struct {
+	service *Post.Service
+}
+
+// NewScheduledPostPublisherJob reuses the route's service so published posts
+// go through the same ring and notification wiring.
+func NewScheduledPostPublisherJob(service *Post.Service) *ScheduledPostPublisherJob {
+	return &ScheduledPostPublisherJob{service: service}
+}
+
+// StartCron registers the publisher on the given cron scheduler. Runs every minute.
+func (j *ScheduledPostPublisherJob) StartCron(c *cron.Cron) {
+	_, err := c.AddFunc("@every 1m", func() {
+		defer func() {
+			if r := recover(); r != nil {
+				stack := string(debug.Stack())
+				slog.Error("Panic recovered in scheduled post publisher", "panic", r, "stack", stack)
+				sentry.CurrentHub().Recover(r)
+				sentry.Flush(2e9)
+			}
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Second)
+		defer cancel()
+		if err := j.Run(ctx); err != nil {
+			slog.Error("Scheduled post publisher failed", "error", err)
+			sentry.CaptureException(fmt.Errorf("scheduled post publisher failed: %w", err))
+		}
+	})
+	if err != nil {
+		slog.Error("Error adding scheduled post publisher cron job", "error", err)
+	} else {
+		slog.Info("Scheduled post publisher cron registered (every 1m)")
+	}
+}
+
+// Run publishes every draft that is due.
+func (j *ScheduledPostPublisherJob) Run(ctx context.Context) error {
+	published, err := j.service.PublishDue(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("publish due drafts: %w", err)
+	}
+	if published > 0 {
+		slog.Info("Scheduled post publisher published drafts", "count", published)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/handlers/timeblock"
+	"github.com/getsentry/sentry-go"
+	"github.com/robfig/cron/v3"
+)
+
+// TimeBlockCloserJob ends focus blocks once their end time passes: it records
+// which tasks were completed and rolls the rest over to the next day.
+type TimeBlockCloserJob struct {
+	service *timeblock.Service
+}
+
+// NewTimeBlockCloserJob reuses the route's service so block pushes go through
+// the same outbox.
+func NewTimeBlockCloserJob(service *timeblock.Service) *TimeBlockCloserJob {
+	return &TimeBlockCloserJob{service: service}
+}
+
+// StartCron registers the closer on the given cron scheduler. Runs every 5 minutes.
+func (j *TimeBlockCloserJob) StartCron(c *cron.Cron) {
+	_, err := c.AddFunc("@every 5m", func() {
+		defer func() {
+			if r := recover(); r != nil {
+				stack := string(debug.Stack())
+				slog.Error("Panic recovered in time block closer", "panic", r, "stack", stack)
+				sentry.CurrentHub().Recover(r)
+				sentry.Flush(2e9)
+			}
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+		if err := j.Run(ctx); err != nil {
+			slog.Error("Time block closer failed", "error", err)
+			sentry.CaptureException(fmt.Errorf("time block closer failed: %w", err))
+		}
+	})
+	if err != nil {
+		slog.Error("Error adding time block closer cron job", "error", err)
+	} else {
+		slog.Info("Time block closer cron registered (every 5m)")
+	}
+}
+
+// Run ends every scheduled block that is past its end time.
+func (j *TimeBlockCloserJob) Run(ctx context.Context) error {
+	ended, err := j.service.EndDue(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("end due blocks: %w", err)
+	}
+	if ended > 0 {
+		slog.Info("Time block closer ended blocks", "count", ended)
+	}
+	return nil
+}
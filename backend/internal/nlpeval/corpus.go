@@ -0,0 +1,254 @@
+// Package nlpeval scores the natural-language flows against a versioned corpus
+// of utterances. Each case runs against user fixtures at a frozen clock, either
+// live through an LLM backend or offline from recorded responses, and its
+// output is checked against the expected structured result.
+package nlpeval
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/gemini"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Corpus is one version of the eval set. Bump Version whenever cases change
+// meaning, so recordings and baselines from an older corpus are not compared
+// against it.
+type Corpus struct {
+	Version  int                `json:"version"`
+	Now      time.Time          `json:"now"` // Frozen clock the flows see; relative expectations are measured from it
+	Fixtures map[string]Fixture `json:"fixtures"`
+	Cases    []Case             `json:"cases"`
+}
+
+// Fixture is a user's workspace context.
+type Fixture struct {
+	UserID     string             `json:"userId"`
+	Timezone   string             `json:"timezone"`
+	Workspaces []FixtureWorkspace `json:"workspaces"`
+}
+
+type FixtureWorkspace struct {
+	Name       string            `json:"name"`
+	Categories []FixtureCategory `json:"categories"`
+}
+
+type FixtureCategory struct {
+	ID    string        `json:"id"`
+	Name  string        `json:"name"`
+	Tasks []FixtureTask `json:"tasks"`
+}
+
+type FixtureTask struct {
+	ID       string     `json:"id"`
+	Content  string     `json:"content"`
+	Priority int        `json:"priority"`
+	Deadline *time.Time `json:"deadline,omitempty"`
+}
+
+// Case is one utterance run through one flow.
+type Case struct {
+	ID      string      `json:"id"`
+	Flow    string      `json:"flow"`
+	Fixture string      `json:"fixture"`
+	Text    string      `json:"text"`
+	Expect  Expectation `json:"expect"`
+}
+
+// Expectation is the expected structured result. Which fields apply depends
+// on the flow: Create for multiTask, Query for queryTasks, Edit for
+// editTasks, and Ops plus any of Create/Edit/Delete for intentRouter.
+type Expectation struct {
+	Ops    []string           `json:"ops,omitempty"`
+	Create *CreateExpectation `json:"create,omitempty"`
+	Edit   *EditExpectation   `json:"edit,omitempty"`
+	Query  *QueryExpectation  `json:"query,omitempty"`
+	Delete *QueryExpectation  `json:"delete,omitempty"`
+}
+
+// CreateExpectation lists every task the flow should create; extra tasks fail.
+type CreateExpectation struct {
+	Tasks []TaskExpectation `json:"tasks"`
+}
+
+type TaskExpectation struct {
+	Content        string `json:"content"`               // Case-insensitive substring of the created task's content
+	Category       string `json:"category"`              // Name of the category the task should land in
+	NewCategory    bool   `json:"newCategory,omitempty"` // The category should be created rather than reused
+	Priority       *int   `json:"priority,omitempty"`
+	DeadlineInDays *int   `json:"deadlineInDays,omitempty"` // Deadline date, in days after the frozen clock in the fixture timezone
+	NoDeadline     bool   `json:"noDeadline,omitempty"`
+}
+
+// EditExpectation lists every edit the flow should produce; extra edits fail.
+type EditExpectation struct {
+	Edits []EditExpect `json:"edits"`
+}
+
+type EditExpect struct {
+	Task           string `json:"task"`              // Content of the fixture task being edited
+	Content        string `json:"content,omitempty"` // Case-insensitive substring of the new content
+	Priority       *int   `json:"priority,omitempty"`
+	DeadlineInDays *int   `json:"deadlineInDays,omitempty"`
+}
+
+// QueryExpectation describes task filters. A nil slice is not checked; an
+// empty one must come back empty.
+type QueryExpectation struct {
+	Categories         []string `json:"categories,omitempty"`
+	Priorities         []int    `json:"priorities,omitempty"`
+	HasDeadline        *bool    `json:"hasDeadline,omitempty"`
+	DeadlineFromInDays *int     `json:"deadlineFromInDays,omitempty"`
+	DeadlineToInDays   *int     `json:"deadlineToInDays,omitempty"`
+	SortBy             string   `json:"sortBy,omitempty"`
+	SortDir            int      `json:"sortDir,omitempty"`
+}
+
+// LoadCorpus reads and validates a corpus file.
+func LoadCorpus(path string) (*Corpus, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c Corpus
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, fmt.Errorf("failed to parse corpus %s: %w", path, err)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid corpus %s: %w", path, err)
+	}
+	return &c, nil
+}
+
+var supportedFlows = map[string]bool{
+	gemini.FlowIntentRouter: true,
+	gemini.FlowMultiTask:    true,
+	gemini.FlowQueryTasks:   true,
+	gemini.FlowEditTasks:    true,
+}
+
+// Validate checks that every case names a supported flow and a fixture, and
+// that the names its expectations reference exist in that fixture.
+func (c *Corpus) Validate() error {
+	if c.Version <= 0 {
+		return fmt.Errorf("version must be positive")
+	}
+	if c.Now.IsZero() {
+		return fmt.Errorf("now is required")
+	}
+	for name, fx := range c.Fixtures {
+		if _, err := primitive.ObjectIDFromHex(fx.UserID); err != nil {
+			return fmt.Errorf("fixture %s: invalid userId", name)
+		}
+		if _, err := time.LoadLocation(fx.Timezone); err != nil {
+			return fmt.Errorf("fixture %s: %w", name, err)
+		}
+		for _, ws := range fx.Workspaces {
+			for _, cat := range ws.Categories {
+				if _, err := primitive.ObjectIDFromHex(cat.ID); err != nil {
+					return fmt.Errorf("fixture %s: category %q has an invalid id", name, cat.Name)
+				}
+				for _, t := range cat.Tasks {
+					if _, err := primitive.ObjectIDFromHex(t.ID); err != nil {
+						return fmt.Errorf("fixture %s: task %q has an invalid id", name, t.Content)
+					}
+				}
+			}
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, tc := range c.Cases {
+		if tc.ID == "" || seen[tc.ID] {
+			return fmt.Errorf("case ids must be unique and non-empty (%q)", tc.ID)
+		}
+		seen[tc.ID] = true
+		if !supportedFlows[tc.Flow] {
+			return fmt.Errorf("case %s: unsupported flow %q", tc.ID, tc.Flow)
+		}
+		fx, ok := c.Fixtures[tc.Fixture]
+		if !ok {
+			return fmt.Errorf("case %s: unknown fixture %q", tc.ID, tc.Fixture)
+		}
+		if err := validateExpectation(fx, tc.Expect); err != nil {
+			return fmt.Errorf("case %s: %w", tc.ID, err)
+		}
+	}
+	return nil
+}
+
+func validateExpectation(fx Fixture, e Expectation) error {
+	for _, q := range []*QueryExpectation{e.Query, e.Delete} {
+		if q == nil {
+			continue
+		}
+		for _, name := range q.Categories {
+			if fx.categoryByName(name) == nil {
+				return fmt.Errorf("unknown category %q", name)
+			}
+		}
+	}
+	if e.Edit != nil {
+		for _, ed := range e.Edit.Edits {
+			if _, _, ok := fx.taskByContent(ed.Task); !ok {
+				return fmt.Errorf("unknown task %q", ed.Task)
+			}
+		}
+	}
+	if e.Create != nil {
+		for _, t := range e.Create.Tasks {
+			if !t.NewCategory && fx.categoryByName(t.Category) == nil {
+				return fmt.Errorf("unknown category %q (set newCategory for categories the flow should create)", t.Category)
+			}
+		}
+	}
+	return nil
+}
+
+func (fx Fixture) location() *time.Location {
+	loc, err := time.LoadLocation(fx.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func (fx Fixture) categoryByName(name string) *FixtureCategory {
+	for _, ws := range fx.Workspaces {
+		for i := range ws.Categories {
+			if strings.EqualFold(ws.Categories[i].Name, name) {
+				return &ws.Categories[i]
+			}
+		}
+	}
+	return nil
+}
+
+func (fx Fixture) categoryByID(id string) *FixtureCategory {
+	for _, ws := range fx.Workspaces {
+		for i := range ws.Categories {
+			if ws.Categories[i].ID == id {
+				return &ws.Categories[i]
+			}
+		}
+	}
+	return nil
+}
+
+// taskByContent returns the fixture task with the given content and its category.
+func (fx Fixture) taskByContent(content string) (FixtureTask, FixtureCategory, bool) {
+	for _, ws := range fx.Workspaces {
+		for _, cat := range ws.Categories {
+			for _, t := range cat.Tasks {
+				if strings.EqualFold(t.Content, content) {
+					return t, cat, true
+				}
+			}
+		}
+	}
+	return FixtureTask{}, FixtureCategory{}, false
+}
@@ -0,0 +1,195 @@
+package nlpeval
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/abhikaboy/Kindred/internal/gemini"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func loadTestCorpus(t *testing.T) *Corpus {
+	t.Helper()
+	c, err := LoadCorpus("testdata/corpus.json")
+	if err != nil {
+		t.Fatalf("failed to load corpus: %v", err)
+	}
+	return c
+}
+
+// TestRecordedCorpus scores the checked-in recordings. A failure here means
+// either an expectation or the scorer changed; re-record with cmd/nlpeval
+// -live -record if the prompts changed on purpose.
+func TestRecordedCorpus(t *testing.T) {
+	c := loadTestCorpus(t)
+	rec, err := LoadRecording("testdata/recordings.json", c)
+	if err != nil {
+		t.Fatalf("failed to load recordings: %v", err)
+	}
+
+	report, err := Run(context.Background(), c, Options{Recording: rec})
+	if err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if len(report.Cases) != len(c.Cases) {
+		t.Fatalf("ran %d cases, corpus has %d", len(report.Cases), len(c.Cases))
+	}
+	for _, failed := range report.Failed() {
+		t.Errorf("case %s failed: %s", failed.ID, failed.Failure())
+	}
+	if stale := report.Stale(); len(stale) > 0 {
+		t.Logf("recordings predate the current prompts for %v; re-record with cmd/nlpeval -live -record", stale)
+	}
+}
+
+func TestScoreDetectsWrongOutputs(t *testing.T) {
+	c := loadTestCorpus(t)
+	rec, err := LoadRecording("testdata/recordings.json", c)
+	if err != nil {
+		t.Fatalf("failed to load recordings: %v", err)
+	}
+
+	tests := []struct {
+		caseID string
+		output string
+		check  string
+	}{
+		{"create-two-existing-categories", `{"tasks":[{"categoryId":"65f0a00000000000000000c1","task":{"content":"Buy groceries","priority":2,"deadline":"2026-03-06T22:00:00Z"}},{"categoryId":"65f0a00000000000000000c2","task":{"content":"Book dentist","priority":2,"deadline":"2026-03-06T22:00:00Z"}}]}`, "task groceries: deadline"},
+		{"create-new-category", `{"tasks":[{"categoryId":"65f0a00000000000000000c1","task":{"content":"Practice guitar scales","priority":1}}]}`, "task guitar: category"},
+		{"query-category-priority", `{"categoryIds":["65f0a00000000000000000c1","65f0a00000000000000000c2"],"priorities":[3]}`, "categories"},
+		{"edit-priority", `{"instructions":[{"taskId":"65f0a00000000000000000d2","categoryId":"65f0a00000000000000000c1","updates":{"priority":3}}]}`, "edit Pick up dry cleaning"},
+		{"route-delete-then-create", `{"ops":[{"type":"create","createPayload":{"tasks":[{"categoryId":"65f0a00000000000000000c1","task":{"content":"Buy batteries","priority":1}}]}}]}`, "ops"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.caseID, func(t *testing.T) {
+			entry := rec.Entries[tt.caseID]
+			entry.Output = []byte(tt.output)
+			rec.Entries[tt.caseID] = entry
+
+			report, err := Run(context.Background(), c, Options{Recording: rec})
+			if err != nil {
+				t.Fatalf("run failed: %v", err)
+			}
+			for _, result := range report.Cases {
+				if result.ID != tt.caseID {
+					continue
+				}
+				if result.Pass {
+					t.Fatalf("expected case to fail")
+				}
+				for _, ch := range result.Checks {
+					if ch.Name == tt.check && !ch.Pass {
+						return
+					}
+				}
+				t.Fatalf("expected check %q to fail, got %s", tt.check, result.Failure())
+			}
+			t.Fatalf("case not run")
+		})
+	}
+}
+
+func TestStaleRecordingAndBaselineCompare(t *testing.T) {
+	c := loadTestCorpus(t)
+	rec, err := LoadRecording("testdata/recordings.json", c)
+	if err != nil {
+		t.Fatalf("failed to load recordings: %v", err)
+	}
+	entry := rec.Entries["edit-rename"]
+	entry.PromptFingerprint = "0000000000000000"
+	entry.Output = []byte(`{"instructions":[]}`)
+	rec.Entries["edit-rename"] = entry
+
+	report, err := Run(context.Background(), c, Options{Recording: rec, Flow: gemini.FlowEditTasks})
+	if err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if len(report.Cases) != 3 || report.Flows[gemini.FlowEditTasks].Total != 3 {
+		t.Fatalf("flow filter not applied: %+v", report.Flows)
+	}
+	if stale := report.Stale(); len(stale) != 1 || stale[0] != "edit-rename" {
+		t.Errorf("stale = %v", stale)
+	}
+
+	baseline := &Baseline{CorpusVersion: c.Version, Passing: map[string]bool{
+		"edit-rename":   true,
+		"edit-priority": false,
+	}}
+	regressions, fixes := report.Compare(baseline)
+	if len(regressions) != 1 || regressions[0] != "edit-rename" {
+		t.Errorf("regressions = %v", regressions)
+	}
+	if len(fixes) != 1 || fixes[0] != "edit-priority" {
+		t.Errorf("fixes = %v", fixes)
+	}
+
+	baseline.CorpusVersion++
+	if regressions, fixes := report.Compare(baseline); regressions != nil || fixes != nil {
+		t.Errorf("baselines from another corpus version should not be compared")
+	}
+}
+
+// replayingBackend answers every flow from the recordings and checks that the
+// runner passes the fixture user and the frozen clock.
+type replayingBackend struct {
+	gemini.Backend
+	t      *testing.T
+	corpus *Corpus
+	rec    *Recording
+	source *fixtureSource
+}
+
+func (b *replayingBackend) QueryTasks(ctx context.Context, input gemini.QueryTasksFlowInput) (gemini.TaskQueryFiltersOutput, error) {
+	if !gemini.Now(ctx).Equal(b.corpus.Now) {
+		b.t.Errorf("flow saw %v, want the frozen clock", gemini.Now(ctx))
+	}
+	userID, _ := primitive.ObjectIDFromHex(input.UserID)
+	summary, err := b.source.GetCategoryNamesSummary(userID)
+	if err != nil || !strings.Contains(summary, "Errands") {
+		b.t.Errorf("fixture summary = %q, %v", summary, err)
+	}
+	var out gemini.TaskQueryFiltersOutput
+	for _, tc := range b.corpus.Cases {
+		if tc.Text == input.Text {
+			return out, json.Unmarshal(b.rec.Entries[tc.ID].Output, &out)
+		}
+	}
+	b.t.Fatalf("unexpected text %q", input.Text)
+	return out, nil
+}
+
+func TestLiveRunRecords(t *testing.T) {
+	c := loadTestCorpus(t)
+	rec, err := LoadRecording("testdata/recordings.json", c)
+	if err != nil {
+		t.Fatalf("failed to load recordings: %v", err)
+	}
+	backend := &replayingBackend{t: t, corpus: c, rec: rec, source: newFixtureSource(c)}
+	fresh := NewRecording(c)
+
+	report, err := Run(context.Background(), c, Options{Backend: backend, Recording: fresh, Record: true, Flow: gemini.FlowQueryTasks, Label: "test"})
+	if err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if failed := report.Failed(); len(failed) > 0 {
+		t.Fatalf("live run failed: %s", failed[0].Failure())
+	}
+	if len(fresh.Entries) != 3 {
+		t.Fatalf("recorded %d entries, want 3", len(fresh.Entries))
+	}
+
+	path := filepath.Join(t.TempDir(), "recordings.json")
+	if err := fresh.Save(path); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+	loaded, err := LoadRecording(path, c)
+	if err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+	if got := loaded.Entries["query-no-deadline"]; got.PromptFingerprint != gemini.PromptFingerprint(gemini.FlowQueryTasks) || got.Backend != "test" {
+		t.Errorf("recorded entry = %+v", got)
+	}
+}
@@ -0,0 +1,91 @@
+package nlpeval
+
+import (
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/gemini"
+	Category "github.com/abhikaboy/Kindred/internal/handlers/category"
+	"github.com/abhikaboy/Kindred/internal/handlers/task"
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// fixtureSource serves the corpus fixtures to the flows and tools in place of
+// the database, implementing gemini.CategorySource and gemini.TaskSource.
+type fixtureSource struct {
+	byUser map[primitive.ObjectID]Fixture
+	now    time.Time
+}
+
+func newFixtureSource(c *Corpus) *fixtureSource {
+	src := &fixtureSource{byUser: map[primitive.ObjectID]Fixture{}, now: c.Now}
+	for _, fx := range c.Fixtures {
+		id, _ := primitive.ObjectIDFromHex(fx.UserID) // validated by LoadCorpus
+		src.byUser[id] = fx
+	}
+	return src
+}
+
+// Sources returns data sources that serve the corpus fixtures, for building a
+// live backend with gemini.NewBackendFromSources.
+func (c *Corpus) Sources() gemini.Sources {
+	src := newFixtureSource(c)
+	return gemini.Sources{Categories: src, Tasks: src}
+}
+
+func (s *fixtureSource) GetCategoriesByUser(userID primitive.ObjectID) ([]Category.WorkspaceResult, error) {
+	fx, ok := s.byUser[userID]
+	if !ok {
+		return []Category.WorkspaceResult{}, nil
+	}
+	workspaces := make([]Category.WorkspaceResult, 0, len(fx.Workspaces))
+	for _, ws := range fx.Workspaces {
+		result := Category.WorkspaceResult{Name: ws.Name}
+		for _, cat := range ws.Categories {
+			catID, _ := primitive.ObjectIDFromHex(cat.ID)
+			doc := types.CategoryDocument{
+				ID:            catID,
+				Name:          cat.Name,
+				WorkspaceName: ws.Name,
+				User:          userID,
+				LastEdited:    s.now,
+				Tasks:         make([]types.TaskDocument, 0, len(cat.Tasks)),
+			}
+			for _, t := range cat.Tasks {
+				taskID, _ := primitive.ObjectIDFromHex(t.ID)
+				doc.Tasks = append(doc.Tasks, types.TaskDocument{
+					ID:         taskID,
+					Content:    t.Content,
+					Priority:   t.Priority,
+					Value:      2,
+					Deadline:   t.Deadline,
+					UserID:     userID,
+					CategoryID: catID,
+					Timestamp:  s.now,
+					LastEdited: s.now,
+				})
+			}
+			result.Categories = append(result.Categories, doc)
+		}
+		workspaces = append(workspaces, result)
+	}
+	return workspaces, nil
+}
+
+func (s *fixtureSource) GetCategoryNamesSummary(userID primitive.ObjectID) (string, error) {
+	workspaces, err := s.GetCategoriesByUser(userID)
+	if err != nil {
+		return "", err
+	}
+	return Category.FormatCategoryNamesSummary(workspaces), nil
+}
+
+// The eval flows don't read completed tasks or templates; fixtures have none.
+
+func (s *fixtureSource) GetCompletedTasks(userID primitive.ObjectID, page int, limit int) ([]task.TaskDocument, int64, error) {
+	return []task.TaskDocument{}, 0, nil
+}
+
+func (s *fixtureSource) GetTemplatesByUserWithCategory(userID primitive.ObjectID) ([]task.TemplateWithCategory, error) {
+	return []task.TemplateWithCategory{}, nil
+}
@@ -0,0 +1,67 @@
+package nlpeval
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Recording holds one backend's raw output per case so the corpus can be
+// scored offline. An entry is stale when the flow's prompt has changed since
+// it was recorded; stale entries still score, but a live run should refresh them.
+type Recording struct {
+	CorpusVersion int                       `json:"corpusVersion"`
+	Entries       map[string]RecordingEntry `json:"entries"`
+}
+
+type RecordingEntry struct {
+	Flow              string          `json:"flow"`
+	PromptFingerprint string          `json:"promptFingerprint"` // gemini.PromptFingerprint of the flow at record time
+	Backend           string          `json:"backend,omitempty"`
+	Model             string          `json:"model,omitempty"`
+	RecordedAt        time.Time       `json:"recordedAt"`
+	Output            json.RawMessage `json:"output"`
+}
+
+// NewRecording returns an empty recording for corpus c.
+func NewRecording(c *Corpus) *Recording {
+	return &Recording{CorpusVersion: c.Version, Entries: map[string]RecordingEntry{}}
+}
+
+// LoadRecording reads a recording file. A missing file yields an empty
+// recording so a first -record run can create it.
+func LoadRecording(path string, c *Corpus) (*Recording, error) {
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return NewRecording(c), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var r Recording
+	if err := json.Unmarshal(raw, &r); err != nil {
+		return nil, fmt.Errorf("failed to parse recording %s: %w", path, err)
+	}
+	if r.CorpusVersion != c.Version {
+		return nil, fmt.Errorf("recording %s is for corpus v%d, corpus is v%d; re-record with -live -record", path, r.CorpusVersion, c.Version)
+	}
+	if r.Entries == nil {
+		r.Entries = map[string]RecordingEntry{}
+	}
+	return &r, nil
+}
+
+// Save writes the recording as indented JSON so diffs stay reviewable.
+func (r *Recording) Save(path string) error {
+	return writeJSON(path, r)
+}
+
+func writeJSON(path string, v any) error {
+	raw, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(raw, '\n'), 0o644)
+}
@@ -0,0 +1,276 @@
+package nlpeval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/gemini"
+)
+
+// Options controls a corpus run. With a nil Backend, cases are scored from
+// Recording; otherwise they run live and, if Record is set, their outputs are
+// written back into Recording.
+type Options struct {
+	Backend   gemini.Backend
+	Recording *Recording
+	Record    bool
+	Flow      string // Only run cases for this flow; empty runs all
+	Label     string // Backend/model label stored on recorded entries
+}
+
+// CaseResult is the outcome of one case.
+type CaseResult struct {
+	ID     string  `json:"id"`
+	Flow   string  `json:"flow"`
+	Pass   bool    `json:"pass"`
+	Stale  bool    `json:"stale,omitempty"` // Replayed from a recording made with a different prompt
+	Err    string  `json:"error,omitempty"`
+	Checks []Check `json:"checks,omitempty"`
+}
+
+// Failure describes why the case failed.
+func (r CaseResult) Failure() string {
+	if r.Err != "" {
+		return r.Err
+	}
+	var failed []string
+	for _, ch := range r.Checks {
+		if !ch.Pass {
+			failed = append(failed, ch.Name+": "+ch.Detail)
+		}
+	}
+	return strings.Join(failed, "; ")
+}
+
+// FlowSummary counts passing cases for one flow.
+type FlowSummary struct {
+	Passed int `json:"passed"`
+	Total  int `json:"total"`
+}
+
+// Report is the result of a corpus run.
+type Report struct {
+	CorpusVersion int                    `json:"corpusVersion"`
+	Cases         []CaseResult           `json:"cases"`
+	Flows         map[string]FlowSummary `json:"flows"`
+}
+
+// Failed returns the cases that did not pass.
+func (r *Report) Failed() []CaseResult {
+	var failed []CaseResult
+	for _, c := range r.Cases {
+		if !c.Pass {
+			failed = append(failed, c)
+		}
+	}
+	return failed
+}
+
+// Stale returns the IDs of cases replayed from stale recordings.
+func (r *Report) Stale() []string {
+	var stale []string
+	for _, c := range r.Cases {
+		if c.Stale {
+			stale = append(stale, c.ID)
+		}
+	}
+	return stale
+}
+
+// Run scores every case in the corpus. Flows see the corpus's frozen clock.
+func Run(ctx context.Context, c *Corpus, opts Options) (*Report, error) {
+	if opts.Backend == nil && opts.Recording == nil {
+		return nil, errors.New("either a backend or a recording is required")
+	}
+	ctx = gemini.WithNow(ctx, c.Now)
+
+	report := &Report{CorpusVersion: c.Version, Flows: map[string]FlowSummary{}}
+	for _, tc := range c.Cases {
+		if opts.Flow != "" && tc.Flow != opts.Flow {
+			continue
+		}
+		result := runCase(ctx, c, tc, opts)
+		report.Cases = append(report.Cases, result)
+
+		summary := report.Flows[tc.Flow]
+		summary.Total++
+		if result.Pass {
+			summary.Passed++
+		}
+		report.Flows[tc.Flow] = summary
+	}
+	return report, nil
+}
+
+func runCase(ctx context.Context, c *Corpus, tc Case, opts Options) CaseResult {
+	result := CaseResult{ID: tc.ID, Flow: tc.Flow}
+	fingerprint := gemini.PromptFingerprint(tc.Flow)
+
+	var raw json.RawMessage
+	if opts.Backend != nil {
+		out, err := invoke(ctx, opts.Backend, tc, c.Fixtures[tc.Fixture])
+		if err != nil {
+			result.Err = err.Error()
+			return result
+		}
+		raw = out
+		if opts.Record {
+			opts.Recording.Entries[tc.ID] = RecordingEntry{
+				Flow:              tc.Flow,
+				PromptFingerprint: fingerprint,
+				Backend:           opts.Label,
+				RecordedAt:        time.Now().UTC(),
+				Output:            out,
+			}
+		}
+	} else {
+		entry, ok := opts.Recording.Entries[tc.ID]
+		if !ok {
+			result.Err = "no recorded output"
+			return result
+		}
+		if entry.Flow != tc.Flow {
+			result.Err = fmt.Sprintf("recorded output is for flow %s", entry.Flow)
+			return result
+		}
+		raw = entry.Output
+		result.Stale = entry.PromptFingerprint != fingerprint
+	}
+
+	checks, err := score(c, tc, raw)
+	if err != nil {
+		result.Err = err.Error()
+		return result
+	}
+	result.Checks = checks
+	result.Pass = true
+	for _, ch := range checks {
+		if !ch.Pass {
+			result.Pass = false
+			break
+		}
+	}
+	return result
+}
+
+// invoke runs a case through the backend and returns its output as JSON.
+func invoke(ctx context.Context, backend gemini.Backend, tc Case, fx Fixture) (json.RawMessage, error) {
+	var (
+		out any
+		err error
+	)
+	switch tc.Flow {
+	case gemini.FlowIntentRouter:
+		out, err = backend.RouteIntent(ctx, gemini.IntentRouterInput{UserID: fx.UserID, Text: tc.Text, Timezone: fx.Timezone})
+	case gemini.FlowMultiTask:
+		out, err = backend.MultiTaskFromText(ctx, gemini.MultiTaskFromTextInputWithUser{UserID: fx.UserID, Text: tc.Text, Timezone: fx.Timezone})
+	case gemini.FlowQueryTasks:
+		out, err = backend.QueryTasks(ctx, gemini.QueryTasksFlowInput{UserID: fx.UserID, Text: tc.Text, Timezone: fx.Timezone})
+	case gemini.FlowEditTasks:
+		out, err = backend.EditTasks(ctx, gemini.EditTasksFlowInput{UserID: fx.UserID, Text: tc.Text, Timezone: fx.Timezone})
+	default:
+		return nil, fmt.Errorf("unsupported flow %q", tc.Flow)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(out)
+}
+
+// score decodes a flow output and checks it against the case's expectation.
+func score(c *Corpus, tc Case, raw json.RawMessage) ([]Check, error) {
+	s := newScorer(c.Fixtures[tc.Fixture], c.Now)
+	switch tc.Flow {
+	case gemini.FlowIntentRouter:
+		var out gemini.IntentRouterOutput
+		if err := json.Unmarshal(raw, &out); err != nil {
+			return nil, fmt.Errorf("failed to decode output: %w", err)
+		}
+		s.scoreIntent(tc.Expect, out)
+	case gemini.FlowMultiTask:
+		var out gemini.MultiTaskFromTextOutput
+		if err := json.Unmarshal(raw, &out); err != nil {
+			return nil, fmt.Errorf("failed to decode output: %w", err)
+		}
+		s.scoreCreate("", tc.Expect.Create, out)
+	case gemini.FlowQueryTasks:
+		var out gemini.TaskQueryFiltersOutput
+		if err := json.Unmarshal(raw, &out); err != nil {
+			return nil, fmt.Errorf("failed to decode output: %w", err)
+		}
+		s.scoreQuery("", tc.Expect.Query, out)
+	case gemini.FlowEditTasks:
+		var out gemini.EditTasksFlowOutput
+		if err := json.Unmarshal(raw, &out); err != nil {
+			return nil, fmt.Errorf("failed to decode output: %w", err)
+		}
+		s.scoreEdit("", tc.Expect.Edit, out)
+	default:
+		return nil, fmt.Errorf("unsupported flow %q", tc.Flow)
+	}
+	return s.checks, nil
+}
+
+// Baseline records which cases passed in a previous run.
+type Baseline struct {
+	CorpusVersion int             `json:"corpusVersion"`
+	Passing       map[string]bool `json:"passing"`
+}
+
+// LoadBaseline reads a baseline file. A missing file yields nil, nil.
+func LoadBaseline(path string) (*Baseline, error) {
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var b Baseline
+	if err := json.Unmarshal(raw, &b); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline %s: %w", path, err)
+	}
+	return &b, nil
+}
+
+// Baseline returns the report's results as a baseline.
+func (r *Report) Baseline() *Baseline {
+	b := &Baseline{CorpusVersion: r.CorpusVersion, Passing: map[string]bool{}}
+	for _, c := range r.Cases {
+		b.Passing[c.ID] = c.Pass
+	}
+	return b
+}
+
+// Save writes the baseline as indented JSON.
+func (b *Baseline) Save(path string) error {
+	return writeJSON(path, b)
+}
+
+// Compare returns the cases that passed in the baseline but fail now, and
+// those that failed in the baseline but pass now. Cases absent from the
+// baseline are neither.
+func (r *Report) Compare(b *Baseline) (regressions, fixes []string) {
+	if b == nil || b.CorpusVersion != r.CorpusVersion {
+		return nil, nil
+	}
+	for _, c := range r.Cases {
+		was, ok := b.Passing[c.ID]
+		switch {
+		case !ok:
+		case was && !c.Pass:
+			regressions = append(regressions, c.ID)
+		case !was && c.Pass:
+			fixes = append(fixes, c.ID)
+		}
+	}
+	sort.Strings(regressions)
+	sort.Strings(fixes)
+	return regressions, fixes
+}
@@ -0,0 +1,263 @@
+package nlpeval
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/gemini"
+)
+
+// Check is one scored property of a case's output.
+type Check struct {
+	Name   string `json:"name"`
+	Pass   bool   `json:"pass"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// scorer accumulates checks for one case.
+type scorer struct {
+	fx     Fixture
+	now    time.Time
+	checks []Check
+}
+
+func newScorer(fx Fixture, now time.Time) *scorer {
+	return &scorer{fx: fx, now: now}
+}
+
+func (s *scorer) check(name string, pass bool, detailf string, args ...any) {
+	c := Check{Name: name, Pass: pass}
+	if !pass {
+		c.Detail = fmt.Sprintf(detailf, args...)
+	}
+	s.checks = append(s.checks, c)
+}
+
+// dayOffset returns how many calendar days after the frozen clock ts falls, in
+// the fixture's timezone.
+func (s *scorer) dayOffset(ts time.Time) int {
+	loc := s.fx.location()
+	now := s.now.In(loc)
+	day := ts.In(loc)
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	end := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	return int(end.Sub(start).Hours() / 24)
+}
+
+func (s *scorer) checkDay(name, value string, wantDays int) {
+	if value == "" {
+		s.check(name, false, "missing; want +%d days", wantDays)
+		return
+	}
+	ts, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		s.check(name, false, "unparseable %q", value)
+		return
+	}
+	got := s.dayOffset(ts)
+	s.check(name, got == wantDays, "got +%d days (%s), want +%d", got, value, wantDays)
+}
+
+// createdTask is a task from a multi-task output with its resolved category.
+type createdTask struct {
+	content     string
+	category    string
+	newCategory bool
+	priority    int
+	deadline    *time.Time
+}
+
+func flattenCreate(fx Fixture, out gemini.MultiTaskFromTextOutput) []createdTask {
+	var tasks []createdTask
+	for _, pair := range out.Tasks {
+		name := "unknown category " + pair.CategoryID
+		if cat := fx.categoryByID(pair.CategoryID); cat != nil {
+			name = cat.Name
+		}
+		tasks = append(tasks, createdTask{
+			content:  pair.Task.Content,
+			category: name,
+			priority: pair.Task.Priority,
+			deadline: pair.Task.Deadline,
+		})
+	}
+	for _, cat := range out.Categories {
+		for _, t := range cat.Tasks {
+			tasks = append(tasks, createdTask{
+				content:     t.Content,
+				category:    cat.Name,
+				newCategory: true,
+				priority:    t.Priority,
+				deadline:    t.Deadline,
+			})
+		}
+	}
+	return tasks
+}
+
+func (s *scorer) scoreCreate(prefix string, want *CreateExpectation, out gemini.MultiTaskFromTextOutput) {
+	got := flattenCreate(s.fx, out)
+	s.check(prefix+"task count", len(got) == len(want.Tasks), "got %d tasks, want %d", len(got), len(want.Tasks))
+
+	used := make([]bool, len(got))
+	for _, w := range want.Tasks {
+		name := prefix + "task " + w.Content
+		idx := -1
+		for i, t := range got {
+			if !used[i] && strings.Contains(strings.ToLower(t.content), strings.ToLower(w.Content)) {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			s.check(name, false, "no created task contains %q", w.Content)
+			continue
+		}
+		used[idx] = true
+		t := got[idx]
+
+		s.check(name+": category", strings.EqualFold(t.category, w.Category) && t.newCategory == w.NewCategory,
+			"got %q (new=%t), want %q (new=%t)", t.category, t.newCategory, w.Category, w.NewCategory)
+		if w.Priority != nil {
+			s.check(name+": priority", t.priority == *w.Priority, "got %d, want %d", t.priority, *w.Priority)
+		}
+		if w.DeadlineInDays != nil {
+			if t.deadline == nil {
+				s.check(name+": deadline", false, "missing; want +%d days", *w.DeadlineInDays)
+			} else {
+				s.checkDay(name+": deadline", t.deadline.Format(time.RFC3339), *w.DeadlineInDays)
+			}
+		}
+		if w.NoDeadline {
+			s.check(name+": no deadline", t.deadline == nil, "got deadline %v", t.deadline)
+		}
+	}
+}
+
+func (s *scorer) scoreQuery(prefix string, want *QueryExpectation, out gemini.TaskQueryFiltersOutput) {
+	if want.Categories != nil {
+		wantIDs := make([]string, 0, len(want.Categories))
+		for _, name := range want.Categories {
+			wantIDs = append(wantIDs, s.fx.categoryByName(name).ID)
+		}
+		s.check(prefix+"categories", sameSet(out.CategoryIds, wantIDs), "got %v, want %v", s.categoryNames(out.CategoryIds), want.Categories)
+	}
+	if want.Priorities != nil {
+		s.check(prefix+"priorities", sameSet(out.Priorities, want.Priorities), "got %v, want %v", out.Priorities, want.Priorities)
+	}
+	if want.HasDeadline != nil {
+		ok := out.HasDeadline != nil && *out.HasDeadline == *want.HasDeadline
+		s.check(prefix+"hasDeadline", ok, "got %v, want %t", derefBool(out.HasDeadline), *want.HasDeadline)
+	}
+	if want.DeadlineFromInDays != nil {
+		s.checkDay(prefix+"deadlineFrom", out.DeadlineFrom, *want.DeadlineFromInDays)
+	}
+	if want.DeadlineToInDays != nil {
+		s.checkDay(prefix+"deadlineTo", out.DeadlineTo, *want.DeadlineToInDays)
+	}
+	if want.SortBy != "" {
+		s.check(prefix+"sortBy", out.SortBy == want.SortBy, "got %q, want %q", out.SortBy, want.SortBy)
+	}
+	if want.SortDir != 0 {
+		s.check(prefix+"sortDir", out.SortDir == want.SortDir, "got %d, want %d", out.SortDir, want.SortDir)
+	}
+}
+
+func (s *scorer) scoreEdit(prefix string, want *EditExpectation, out gemini.EditTasksFlowOutput) {
+	got := append(slices.Clone(out.Instructions), out.TemplateInstructions...)
+	s.check(prefix+"edit count", len(got) == len(want.Edits), "got %d edits, want %d", len(got), len(want.Edits))
+
+	for _, w := range want.Edits {
+		name := prefix + "edit " + w.Task
+		task, cat, _ := s.fx.taskByContent(w.Task)
+		var ins *gemini.EditTaskInstructionOutput
+		for i := range got {
+			if got[i].TaskID == task.ID {
+				ins = &got[i]
+				break
+			}
+		}
+		if ins == nil {
+			s.check(name, false, "no instruction targets task %s", task.ID)
+			continue
+		}
+		s.check(name+": category", ins.CategoryID == cat.ID, "got %s, want %s", ins.CategoryID, cat.ID)
+		u := ins.Updates
+		if w.Content != "" {
+			ok := u.Content != nil && strings.Contains(strings.ToLower(*u.Content), strings.ToLower(w.Content))
+			s.check(name+": content", ok, "got %v, want it to contain %q", derefString(u.Content), w.Content)
+		}
+		if w.Priority != nil {
+			ok := u.Priority != nil && *u.Priority == *w.Priority
+			s.check(name+": priority", ok, "got %v, want %d", derefInt(u.Priority), *w.Priority)
+		}
+		if w.DeadlineInDays != nil {
+			s.checkDay(name+": deadline", derefString(u.Deadline), *w.DeadlineInDays)
+		}
+	}
+}
+
+func (s *scorer) scoreIntent(want Expectation, out gemini.IntentRouterOutput) {
+	types := make([]string, 0, len(out.Ops))
+	for _, op := range out.Ops {
+		types = append(types, op.Type)
+	}
+	s.check("ops", slices.Equal(types, want.Ops), "got %v, want %v", types, want.Ops)
+
+	for _, op := range out.Ops {
+		switch {
+		case op.Type == "create" && want.Create != nil && op.CreatePayload != nil:
+			s.scoreCreate("create ", want.Create, *op.CreatePayload)
+		case op.Type == "edit" && want.Edit != nil && op.EditPayload != nil:
+			s.scoreEdit("edit ", want.Edit, *op.EditPayload)
+		case op.Type == "delete" && want.Delete != nil && op.DeletePayload != nil:
+			s.scoreQuery("delete ", want.Delete, *op.DeletePayload)
+		}
+	}
+}
+
+func (s *scorer) categoryNames(ids []string) []string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if cat := s.fx.categoryByID(id); cat != nil {
+			names = append(names, cat.Name)
+		} else {
+			names = append(names, id)
+		}
+	}
+	return names
+}
+
+func sameSet[T string | int](got, want []T) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	a, b := slices.Clone(got), slices.Clone(want)
+	sort.Slice(a, func(i, j int) bool { return a[i] < a[j] })
+	sort.Slice(b, func(i, j int) bool { return b[i] < b[j] })
+	return slices.Equal(a, b)
+}
+
+func derefBool(b *bool) any {
+	if b == nil {
+		return nil
+	}
+	return *b
+}
+
+func derefInt(i *int) any {
+	if i == nil {
+		return nil
+	}
+	return *i
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -19,6 +19,11 @@ type UserRepository interface {
 	UpdateUser(ctx context.Context, id primitive.ObjectID, update bson.M) error
 	DeleteUser(ctx context.Context, id primitive.ObjectID) error
 	GetUsersWithPushTokens(ctx context.Context) ([]types.User, error)
+	// GetPushTokens returns the push tokens of the users in ids that have one.
+	GetPushTokens(ctx context.Context, ids []primitive.ObjectID) (map[primitive.ObjectID]string, error)
+	// ClaimGroupActivityNotify atomically records a group activity wave for id
+	// unless one went out within cooldown. Returns true if it claimed one.
+	ClaimGroupActivityNotify(ctx context.Context, id primitive.ObjectID, now time.Time, cooldown time.Duration) (bool, error)
 	IncrementUserCount(ctx context.Context, id primitive.ObjectID) error
 	UpdatePushToken(ctx context.Context, id primitive.ObjectID, token string) error
 	CheckTokenCount(ctx context.Context, id primitive.ObjectID) (float64, error)
@@ -34,5 +39,7 @@ type UserRepository interface {
 	ConsumeCredit(ctx context.Context, id primitive.ObjectID, creditType types.CreditType) error
 	AddCredits(ctx context.Context, id primitive.ObjectID, creditType types.CreditType, amount int) error
 	CheckCredits(ctx context.Context, id primitive.ObjectID, creditType types.CreditType) (bool, error)
+	// RefundCredit undoes ConsumeCredit after the call it paid for failed.
+	RefundCredit(ctx context.Context, id primitive.ObjectID, creditType types.CreditType) error
 	LinkGoogleID(ctx context.Context, id primitive.ObjectID, googleID string) error
 }
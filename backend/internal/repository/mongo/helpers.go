@@ -7,6 +7,7 @@ import (
 	"github.com/abhikaboy/Kindred/internal/repository"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // findOneByField is the private generic helper for single-document lookups.
@@ -23,8 +24,8 @@ func findOneByField[T any](ctx context.Context, coll *mongo.Collection, field st
 }
 
 // findMany is the private generic helper for multi-document queries.
-func findMany[T any](ctx context.Context, coll *mongo.Collection, filter bson.M) ([]T, error) {
-	cursor, err := coll.Find(ctx, filter)
+func findMany[T any](ctx context.Context, coll *mongo.Collection, filter bson.M, opts ...*options.FindOptions) ([]T, error) {
+	cursor, err := coll.Find(ctx, filter, opts...)
 	if err != nil {
 		return nil, err
 	}
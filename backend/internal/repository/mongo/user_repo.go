@@ -9,6 +9,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type userRepo struct {
@@ -59,6 +60,38 @@ func (r *userRepo) GetUsersWithPushTokens(ctx context.Context) ([]types.User, er
 	})
 }
 
+func (r *userRepo) GetPushTokens(ctx context.Context, ids []primitive.ObjectID) (map[primitive.ObjectID]string, error) {
+	tokens := make(map[primitive.ObjectID]string, len(ids))
+	if len(ids) == 0 {
+		return tokens, nil
+	}
+	users, err := findMany[types.User](ctx, r.collection, bson.M{
+		"_id":        bson.M{"$in": ids},
+		"push_token": bson.M{"$ne": ""},
+	}, options.Find().SetProjection(bson.M{"_id": 1, "push_token": 1}))
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		tokens[u.ID] = u.PushToken
+	}
+	return tokens, nil
+}
+
+func (r *userRepo) ClaimGroupActivityNotify(ctx context.Context, id primitive.ObjectID, now time.Time, cooldown time.Duration) (bool, error) {
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": id, "$or": []bson.M{
+			{"lastGroupActivityNotifyAt": bson.M{"$exists": false}},
+			{"lastGroupActivityNotifyAt": bson.M{"$lte": now.Add(-cooldown)}},
+		}},
+		bson.M{"$set": bson.M{"lastGroupActivityNotifyAt": now}},
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
 func (r *userRepo) IncrementUserCount(ctx context.Context, id primitive.ObjectID) error {
 	return updateOneByID(ctx, r.collection, id, bson.M{"$inc": bson.M{"count": 1}})
 }
@@ -138,6 +171,10 @@ func (r *userRepo) CheckCredits(ctx context.Context, id primitive.ObjectID, cred
 	return types.CheckCredits(ctx, r.collection, id, creditType)
 }
 
+func (r *userRepo) RefundCredit(ctx context.Context, id primitive.ObjectID, creditType types.CreditType) error {
+	return types.RefundCredit(ctx, r.collection, id, creditType)
+}
+
 func (r *userRepo) LinkGoogleID(ctx context.Context, id primitive.ObjectID, googleID string) error {
 	return updateOneByID(ctx, r.collection, id, bson.M{"$set": bson.M{"google_id": googleID}})
 }
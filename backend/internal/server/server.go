@@ -26,13 +26,17 @@ import (
 	report "github.com/abhikaboy/Kindred/internal/handlers/report"
 	"github.com/abhikaboy/Kindred/internal/handlers/rewards"
 	"github.com/abhikaboy/Kindred/internal/handlers/rings"
+	"github.com/abhikaboy/Kindred/internal/handlers/schedule"
 	"github.com/abhikaboy/Kindred/internal/handlers/settings"
 	spaces "github.com/abhikaboy/Kindred/internal/handlers/spaces"
 	"github.com/abhikaboy/Kindred/internal/handlers/subscription"
 	task "github.com/abhikaboy/Kindred/internal/handlers/task"
+	"github.com/abhikaboy/Kindred/internal/handlers/timeblock"
+	"github.com/abhikaboy/Kindred/internal/handlers/usage"
 	Waitlist "github.com/abhikaboy/Kindred/internal/handlers/waitlist"
 	"github.com/abhikaboy/Kindred/internal/jobs"
 	"github.com/abhikaboy/Kindred/internal/posthog"
+	"github.com/abhikaboy/Kindred/internal/transcribe"
 	"github.com/abhikaboy/Kindred/internal/xlog"
 	"github.com/abhikaboy/Kindred/internal/xsentry"
 
@@ -51,7 +55,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
-func New(collections map[string]*mongo.Collection, stream *mongo.ChangeStream, geminiService *gemini.GeminiService, cfg config.Config) (huma.API, *fiber.App) {
+func New(collections map[string]*mongo.Collection, stream *mongo.ChangeStream, llm gemini.Backend, cfg config.Config) (huma.API, *fiber.App) {
 	app := fiber.New(fiber.Config{
 		ErrorHandler: func(c *fiber.Ctx, err error) error {
 			slog.Error("FIBER ERROR", "error", err.Error(), "method", c.Method(), "path", c.Path())
@@ -147,23 +151,36 @@ func New(collections map[string]*mongo.Collection, stream *mongo.ChangeStream, g
 	activity.Routes(api, collections)
 	analytics.Routes(api, collections)
 	profile.Routes(api, collections, ringService)
-	taskService := task.Routes(api, collections, geminiService, ringService)
+	taskService := task.Routes(api, collections, gemini.NewTaskNLP(llm), ringService)
 
 	// SSE streaming routes for NLP flows (raw Fiber, bypass Huma)
-	taskStreamHandler := task.NewStreamHandler(collections, geminiService, ringService)
+	taskStreamHandler := task.NewStreamHandler(collections, gemini.NewTaskNLP(llm), ringService)
 	app.Post("/v1/user/tasks/natural-language/intent/stream", taskStreamHandler.StreamIntentNaturalLanguage)
 	app.Post("/v1/user/tasks/natural-language/stream", taskStreamHandler.StreamCreateNaturalLanguage)
 	app.Post("/v1/user/tasks/natural-language/query/stream", taskStreamHandler.StreamQueryNaturalLanguage)
 	app.Post("/v1/user/tasks/natural-language/edit/stream", taskStreamHandler.StreamEditNaturalLanguage)
+	app.Post("/v1/user/assistant/sessions/:sessionId/messages/stream", taskStreamHandler.StreamAssistantMessage)
+
+	// Wire server-side speech-to-text into voice task creation; recordings are
+	// read back from the Spaces bucket they were uploaded to.
+	if stt, err := transcribe.New(cfg.STT); err != nil {
+		slog.Error("Voice transcription disabled: invalid STT configuration", "error", err)
+	} else if stt != nil {
+		taskService.Voice = transcribe.NewUploadTranscriber(s3Client, cfg.DO.SpacesBucket, stt, cfg.STT.MaxAudioMB)
+		slog.Info("Voice transcription enabled", "backend", cfg.STT.Backend, "model", cfg.STT.Model)
+	}
 
 	connection.Routes(api, collections)
-	group.RegisterRoutes(api, collections)
-	post.Routes(api, collections, ringService)
+	groupService := group.RegisterRoutes(api, collections, taskService)
+
+	// Completions of challenge tasks count toward group challenge progress.
+	taskService.Challenges = groupService
+	postService := post.Routes(api, collections, ringService, cfg.Feed)
 	spaces.Routes(api, presigner, s3Client, collections)
 
 	// Register waitlist and blueprint routes
 	Waitlist.Routes(api, collections)
-	Blueprint.Routes(api, collections, geminiService)
+	Blueprint.Routes(api, collections, gemini.NewBlueprintGenerator(llm))
 
 	// Register encouragement and congratulation routes
 	encouragement.Routes(api, collections, ringService)
@@ -184,6 +201,9 @@ func New(collections map[string]*mongo.Collection, stream *mongo.ChangeStream, g
 	// Register rewards routes
 	rewards.Routes(api, collections)
 
+	// Register AI usage routes
+	usage.Routes(api, collections)
+
 	// Register settings routes
 	settings.Router(api, collections)
 
@@ -198,6 +218,16 @@ func New(collections map[string]*mongo.Collection, stream *mongo.ChangeStream, g
 		taskService.PushEnqueuer = calendarService.PushOutbox()
 	}
 
+	// Register auto-scheduling routes (reads busy time from connected calendars)
+	schedule.Routes(api, collections, taskService, calendarService, llm)
+
+	// Register focus block routes (blocks are pushed through the calendar outbox)
+	var blockPushEnqueuer timeblock.PushEnqueuer
+	if calendarService != nil {
+		blockPushEnqueuer = calendarService.PushOutbox()
+	}
+	timeBlockService := timeblock.Routes(api, collections, blockPushEnqueuer)
+
 	// Register subscription webhook routes
 	subscription.Routes(api, collections, cfg)
 
@@ -223,6 +253,12 @@ func New(collections map[string]*mongo.Collection, stream *mongo.ChangeStream, g
 		slog.Warn("Calendar jobs disabled: calendar_connections collection not available")
 	}
 
+	// Focus block closer (every 5m)
+	jobs.NewTimeBlockCloserJob(timeBlockService).StartCron(cronScheduler)
+
+	// Scheduled post publisher (every 1m)
+	jobs.NewScheduledPostPublisherJob(postService).StartCron(cronScheduler)
+
 	// Kudos suggester (every 15m) — joins the moments Kindred can see to the
 	// `user_memory` policy the productivity-agent worker writes.
 	//
@@ -242,6 +278,20 @@ func New(collections map[string]*mongo.Collection, stream *mongo.ChangeStream, g
 		slog.Info("Kudos suggester dormant (set KUDOS_SUGGESTER_ENABLED=true to enable)")
 	}
 
+	// Memory distiller (nightly) — writes the observed `user_memory` facts
+	// in-process, for deployments that don't run the productivity-agent
+	// worker. OFF BY DEFAULT and must stay off wherever the worker runs: two
+	// writers on the same keys would overwrite each other every night.
+	if os.Getenv("MEMORY_DISTILLER_ENABLED") == "true" {
+		distiller := jobs.NewMemoryDistillerJob(collections)
+		if distiller.Ready() {
+			distiller.StartCron(cronScheduler)
+			slog.Info("Memory distiller enabled")
+		} else {
+			slog.Warn("Memory distiller disabled: required collections not available")
+		}
+	}
+
 	xlog.ServerLog("All routes registered, Fiber app ready")
 
 	return api, app
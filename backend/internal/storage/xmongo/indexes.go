@@ -229,6 +229,235 @@ var Indexes = []Index{
 			Keys: bson.D{{Key: "event_ids", Value: 1}},
 		},
 	},
+	// Calendar drift conflicts: GetConflicts lists a user's undismissed rows newest-first
+	{
+		Collection: "calendar_conflicts",
+		Model: mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "dismissed", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+		},
+	},
+
+	// Calendar sync log: GetSyncLog reads a connection's newest entries; the
+	// per-connection cap keeps it small and the TTL drops idle connections' history
+	{
+		Collection: "calendar_sync_log",
+		Model: mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "connection_id", Value: 1},
+				{Key: "started_at", Value: -1},
+			},
+		},
+	},
+	{
+		Collection: "calendar_sync_log",
+		Model: mongo.IndexModel{
+			Keys:    bson.D{{Key: "started_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(30 * 24 * 60 * 60),
+		},
+	},
+
+	// Calendar push outbox: sync status counts pending and lists failed rows per user
+	{
+		Collection: "calendar_push_outbox",
+		Model: mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "status", Value: 1},
+				{Key: "enqueued_at", Value: -1},
+			},
+		},
+	},
+
+	// Focus blocks: ListTimeBlocks by user and start; the closer job scans scheduled blocks by end
+	{
+		Collection: "time_blocks",
+		Model: mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "start", Value: 1},
+			},
+		},
+	},
+	{
+		Collection: "time_blocks",
+		Model: mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "end", Value: 1},
+			},
+		},
+	},
+
+	// Assistant sessions are looked up by _id; the TTL drops conversations
+	// (and their undo history) a month after their last turn
+	{
+		Collection: "assistant_sessions",
+		Model: mongo.IndexModel{
+			Keys:    bson.D{{Key: "updatedAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(30 * 24 * 60 * 60),
+		},
+	},
+
+	// Task embeddings are read per user during duplicate checks; entries are
+	// keyed by task ID, so _id covers invalidation
+	{
+		Collection: "task_embeddings",
+		Model: mongo.IndexModel{
+			Keys: bson.D{{Key: "user_id", Value: 1}},
+		},
+	},
+
+	// Voice transcripts: a user's recordings, newest first
+	{
+		Collection: "voice_transcripts",
+		Model: mongo.IndexModel{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+	},
+
+	// Day plans: counting accepted plans per user and date for the Plan ring;
+	// the TTL drops plans a month after they were generated
+	{
+		Collection: "day_plans",
+		Model: mongo.IndexModel{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "date", Value: 1}},
+		},
+	},
+	{
+		Collection: "day_plans",
+		Model: mongo.IndexModel{
+			Keys:    bson.D{{Key: "created_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(30 * 24 * 60 * 60),
+		},
+	},
+
+	// AI usage: per-user totals for the current period (budget checks and
+	// the usage summary); the TTL keeps a year of history for pricing
+	{
+		Collection: "ai_usage",
+		Model: mongo.IndexModel{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: 1}},
+		},
+	},
+	{
+		Collection: "ai_usage",
+		Model: mongo.IndexModel{
+			Keys:    bson.D{{Key: "created_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(365 * 24 * 60 * 60),
+		},
+	},
+
+	// User memory: derived facts carry expiresAt and age out when the
+	// distiller stops refreshing them; stated facts have none and stay
+	{
+		Collection: "user_memory",
+		Model: mongo.IndexModel{
+			Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	},
+
+	// Timelines: a viewer's feed newest first (the cursor sorts on at, _id);
+	// one entry per (owner, kind, ref) keeps fan-out and backfill idempotent;
+	// ref and owner+author serve pruning on delete, unfriend and block
+	{
+		Collection: "timelines",
+		Model: mongo.IndexModel{
+			Keys: bson.D{{Key: "owner", Value: 1}, {Key: "at", Value: -1}, {Key: "_id", Value: -1}},
+		},
+	},
+	{
+		Collection: "timelines",
+		Model: mongo.IndexModel{
+			Keys:    bson.D{{Key: "owner", Value: 1}, {Key: "kind", Value: 1}, {Key: "ref", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	},
+	{
+		Collection: "timelines",
+		Model: mongo.IndexModel{
+			Keys: bson.D{{Key: "kind", Value: 1}, {Key: "ref", Value: 1}},
+		},
+	},
+	{
+		Collection: "timelines",
+		Model: mongo.IndexModel{
+			Keys: bson.D{{Key: "owner", Value: 1}, {Key: "author", Value: 1}},
+		},
+	},
+	// Entries age out after 90 days; older pages are past what a backfill
+	// files anyway
+	{
+		Collection: "timelines",
+		Model: mongo.IndexModel{
+			Keys:    bson.D{{Key: "at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(90 * 24 * 60 * 60),
+		},
+	},
+
+	// Feed impressions: one row per (viewer, item), read by ranked sessions;
+	// the TTL drops views old enough that the item has aged out of ranking
+	{
+		Collection: "feed_impressions",
+		Model: mongo.IndexModel{
+			Keys:    bson.D{{Key: "user", Value: 1}, {Key: "item", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	},
+	{
+		Collection: "feed_impressions",
+		Model: mongo.IndexModel{
+			Keys:    bson.D{{Key: "lastSeenAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(30 * 24 * 60 * 60),
+		},
+	},
+
+	// Comments: ListComments pages roots (threadId null) newest first and a
+	// thread's replies oldest first; profile edits rewrite by author
+	{
+		Collection: "comments",
+		Model: mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "postId", Value: 1},
+				{Key: "threadId", Value: 1},
+				{Key: "metadata.createdAt", Value: 1},
+				{Key: "_id", Value: 1},
+			},
+		},
+	},
+	{
+		Collection: "comments",
+		Model: mongo.IndexModel{
+			Keys: bson.D{{Key: "user._id", Value: 1}},
+		},
+	},
+
+	// Post drafts: ListDrafts reads a user's drafts by last edit; the
+	// scheduled-post publisher claims due drafts by status and publish time;
+	// published drafts are only kept long enough to dedupe a retried publish
+	{
+		Collection: "post_drafts",
+		Model: mongo.IndexModel{
+			Keys: bson.D{{Key: "userId", Value: 1}, {Key: "updatedAt", Value: -1}},
+		},
+	},
+	{
+		Collection: "post_drafts",
+		Model: mongo.IndexModel{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "publishAt", Value: 1}},
+		},
+	},
+	{
+		Collection: "post_drafts",
+		Model: mongo.IndexModel{
+			Keys:    bson.D{{Key: "publishedAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(7 * 24 * 60 * 60),
+		},
+	},
 
 	// Posts collection indexes
 	// Covers GetAllPosts: filter on isDeleted+isPublic, sort by createdAt
@@ -272,6 +501,86 @@ var Indexes = []Index{
 			Keys: bson.D{{Key: "taggedUsers._id", Value: 1}},
 		},
 	},
+	// Covers the group and specific-user branches of the audience filter,
+	// which match on the audience lists rather than the author
+	{
+		Collection: "posts",
+		Model: mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "audience.groups", Value: 1},
+				{Key: "metadata.createdAt", Value: -1},
+			},
+		},
+	},
+	{
+		Collection: "posts",
+		Model: mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "audience.users", Value: 1},
+				{Key: "metadata.createdAt", Value: -1},
+			},
+		},
+	},
+	{
+		Collection: "posts",
+		Model: mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "audience.lists", Value: 1},
+				{Key: "metadata.createdAt", Value: -1},
+			},
+		},
+	},
+	// Covers the group activity stream's fallback for posts from before
+	// audiences, which only carry the legacy groups field
+	{
+		Collection: "posts",
+		Model: mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "groups", Value: 1},
+				{Key: "metadata.createdAt", Value: -1},
+			},
+		},
+	},
+	// Covers loading a viewer: the authors who have them on their close friends list
+	{
+		Collection: "users",
+		Model: mongo.IndexModel{
+			Keys: bson.D{{Key: "closeFriends", Value: 1}},
+		},
+	},
+	// Covers loading a viewer: the users hiding their activity from them
+	{
+		Collection: "users",
+		Model: mongo.IndexModel{
+			Keys: bson.D{{Key: "hiddenFrom", Value: 1}},
+		},
+	},
+	// Covers Withheld: the users who muted an author
+	{
+		Collection: "users",
+		Model: mongo.IndexModel{
+			Keys: bson.D{{Key: "muted", Value: 1}},
+		},
+	},
+
+	// Friend lists collection indexes
+	// Covers GetFriendLists and the per-owner list cap
+	{
+		Collection: "friend_lists",
+		Model: mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "owner", Value: 1},
+				{Key: "createdAt", Value: 1},
+			},
+		},
+	},
+	// Covers loading a viewer: the lists they've been put on
+	{
+		Collection: "friend_lists",
+		Model: mongo.IndexModel{
+			Keys: bson.D{{Key: "members", Value: 1}},
+		},
+	},
 
 	// Friend-requests (connections) collection indexes
 	// Covers GetRelationship, IsBlocked, AcceptConnection lookups by user pair
@@ -382,6 +691,45 @@ var Indexes = []Index{
 			},
 		},
 	},
+	// Covers JoinWithInvite: look up a group by one of its invite codes
+	{
+		Collection: "groups",
+		Model: mongo.IndexModel{
+			Keys: bson.D{{Key: "invites.code", Value: 1}},
+		},
+	},
+
+	// Group-challenges collection indexes
+	// Covers GetChallenges: a group's challenges, newest first
+	{
+		Collection: "group_challenges",
+		Model: mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "groupId", Value: 1},
+				{Key: "startDate", Value: -1},
+			},
+		},
+	},
+
+	// Categories and templates a challenge created, for leaving or deleting it
+	{
+		Collection: "categories",
+		Model: mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "user", Value: 1},
+				{Key: "challengeId", Value: 1},
+			},
+		},
+	},
+	{
+		Collection: "template-tasks",
+		Model: mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "userID", Value: 1},
+				{Key: "challengeId", Value: 1},
+			},
+		},
+	},
 }
 
 var SearchIndexes = []SearchIndex{
@@ -126,24 +126,28 @@ func (td *TestDatabase) CleanDatabase() error {
 // GetCollections returns a map of collection names to collection objects
 func (td *TestDatabase) GetCollections() map[string]*mongo.Collection {
 	return map[string]*mongo.Collection{
-		"users":           td.DB.Collection("users"),
-		"connections":     td.DB.Collection("connections"),
-		"activity":        td.DB.Collection("activity"),
-		"blueprints":      td.DB.Collection("blueprints"),
-		"categories":      td.DB.Collection("categories"),
-		"chats":           td.DB.Collection("chats"),
-		"completed-tasks": td.DB.Collection("completed-tasks"),
-		"congratulations": td.DB.Collection("congratulations"),
-		"encouragements":  td.DB.Collection("encouragements"),
-		"friend-requests": td.DB.Collection("friend-requests"),
-		"groups":          td.DB.Collection("groups"),
-		"notifications":   td.DB.Collection("notifications"),
-		"posts":           td.DB.Collection("posts"),
-		"referrals":       td.DB.Collection("referrals"),
-		"reports":         td.DB.Collection("reports"),
-		"template-tasks":  td.DB.Collection("template-tasks"),
-		"user_memory":     td.DB.Collection("user_memory"),
-		"waitlist":        td.DB.Collection("waitlist"),
+		"users":            td.DB.Collection("users"),
+		"connections":      td.DB.Collection("connections"),
+		"activity":         td.DB.Collection("activity"),
+		"blueprints":       td.DB.Collection("blueprints"),
+		"categories":       td.DB.Collection("categories"),
+		"chats":            td.DB.Collection("chats"),
+		"comments":         td.DB.Collection("comments"),
+		"completed-tasks":  td.DB.Collection("completed-tasks"),
+		"congratulations":  td.DB.Collection("congratulations"),
+		"encouragements":   td.DB.Collection("encouragements"),
+		"friend-requests":  td.DB.Collection("friend-requests"),
+		"friend_lists":     td.DB.Collection("friend_lists"),
+		"group_challenges": td.DB.Collection("group_challenges"),
+		"groups":           td.DB.Collection("groups"),
+		"notifications":    td.DB.Collection("notifications"),
+		"post_drafts":      td.DB.Collection("post_drafts"),
+		"posts":            td.DB.Collection("posts"),
+		"referrals":        td.DB.Collection("referrals"),
+		"reports":          td.DB.Collection("reports"),
+		"template-tasks":   td.DB.Collection("template-tasks"),
+		"user_memory":      td.DB.Collection("user_memory"),
+		"waitlist":         td.DB.Collection("waitlist"),
 	}
 }
 
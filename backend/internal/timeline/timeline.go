@@ -0,0 +1,316 @@
+// Package timeline keeps a materialized home feed per user. Posts, ring
+// closures and completed public tasks are written once per viewer when they
+// happen (fan-out on write), so the feed reads one indexed range instead of
+// aggregating every friend's content per request.
+//
+// Writes are best-effort: callers fire them after the source write succeeds
+// and never fail a request on them. A viewer's timeline only counts as warm
+// once it has been backfilled; until then the feed uses the live aggregation.
+package timeline
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"log/slog"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/audience"
+	"github.com/abhikaboy/Kindred/internal/handlers/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	Collection      = "timelines"
+	StateCollection = "timeline_state"
+)
+
+// Entry kinds, matching the feed item types they render as.
+const (
+	KindPost        = "post"
+	KindRingsClosed = "rings_closed"
+	KindTask        = "task"
+)
+
+// Via records why an owner received an entry, so unfriending removes what
+// came through the friendship but keeps what a shared group delivered.
+const (
+	ViaSelf   = "self"
+	ViaFriend = "friend"
+	ViaGroup  = "group"
+)
+
+// Entry is one item in one owner's timeline. Posts are hydrated at read time
+// so edits, reactions and soft deletes show through; ring closures and task
+// completions are immutable and carry their payload inline.
+type Entry struct {
+	ID     primitive.ObjectID `bson:"_id,omitempty"`
+	Owner  primitive.ObjectID `bson:"owner"`
+	Kind   string             `bson:"kind"`
+	Ref    primitive.ObjectID `bson:"ref"`
+	Author primitive.ObjectID `bson:"author"`
+	Via    string             `bson:"via"`
+	At     time.Time          `bson:"at"`
+
+	Rings *RingsClosed   `bson:"rings,omitempty"`
+	Task  *CompletedTask `bson:"task,omitempty"`
+}
+
+type RingsClosed struct {
+	Content string                              `bson:"content"`
+	User    types.UserExtendedReferenceInternal `bson:"user"`
+}
+
+// RingsClosedRef identifies user closing all their rings on day, the ring
+// day as the rings service keys it. Rings close at most once a day, so the
+// live fan-out and a later backfill file the same entry.
+func RingsClosedRef(user primitive.ObjectID, day time.Time) primitive.ObjectID {
+	var buf [20]byte
+	copy(buf[:12], user[:])
+	binary.BigEndian.PutUint64(buf[12:], uint64(day.Unix()))
+	sum := sha256.Sum256(buf[:])
+	var ref primitive.ObjectID
+	copy(ref[:], sum[:])
+	return ref
+}
+
+type CompletedTask struct {
+	Content       string                              `bson:"content"`
+	Priority      int                                 `bson:"priority"`
+	Value         float64                             `bson:"value"`
+	Timestamp     time.Time                           `bson:"timestamp"`
+	CategoryID    primitive.ObjectID                  `bson:"categoryId"`
+	CategoryName  string                              `bson:"categoryName"`
+	WorkspaceName string                              `bson:"workspaceName"`
+	User          types.UserExtendedReferenceInternal `bson:"user"`
+}
+
+// Event is something that happened, before it is addressed to viewers.
+// Posts go to whoever their audience reaches; everything else, and posts
+// without an audience, goes to the author's friends. IncludeAuthor also
+// files it in the author's own feed.
+type Event struct {
+	Kind          string
+	Ref           primitive.ObjectID
+	Author        primitive.ObjectID
+	At            time.Time
+	Audience      *types.PostAudience
+	IncludeAuthor bool
+	Rings         *RingsClosed
+	Task          *CompletedTask
+}
+
+type Service struct {
+	Entries   *mongo.Collection
+	State     *mongo.Collection
+	Users     *mongo.Collection
+	Audiences *audience.Service
+}
+
+func New(collections map[string]*mongo.Collection) *Service {
+	if users := collections["users"]; users != nil {
+		return NewWithDatabase(users.Database())
+	}
+	return &Service{}
+}
+
+// NewWithDatabase is for services that hold a single collection rather than
+// the collections map; the timeline collections are created lazily.
+func NewWithDatabase(db *mongo.Database) *Service {
+	if db == nil {
+		return &Service{}
+	}
+	return &Service{
+		Entries:   db.Collection(Collection),
+		State:     db.Collection(StateCollection),
+		Users:     db.Collection("users"),
+		Audiences: audience.NewWithDatabase(db),
+	}
+}
+
+func (s *Service) ready() bool {
+	return s != nil && s.Entries != nil && s.Users != nil && s.Audiences != nil
+}
+
+// FanOut files ev in every viewer's timeline. Best-effort: failures are
+// logged, and the live feed covers anything missed.
+func (s *Service) FanOut(ctx context.Context, ev Event) {
+	if !s.ready() {
+		return
+	}
+	audience, err := s.audience(ctx, ev)
+	if err != nil {
+		slog.Error("Failed to resolve timeline audience", "kind", ev.Kind, "ref", ev.Ref.Hex(), "error", err)
+		return
+	}
+	entries := make([]Entry, 0, len(audience))
+	for owner, via := range audience {
+		entries = append(entries, Entry{
+			Owner:  owner,
+			Kind:   ev.Kind,
+			Ref:    ev.Ref,
+			Author: ev.Author,
+			Via:    via,
+			At:     ev.At,
+			Rings:  ev.Rings,
+			Task:   ev.Task,
+		})
+	}
+	if err := s.Insert(ctx, entries); err != nil {
+		slog.Error("Failed to fan out timeline entries", "kind", ev.Kind, "ref", ev.Ref.Hex(), "recipients", len(entries), "error", err)
+	}
+}
+
+// audience maps each viewer to the reason they see ev.
+func (s *Service) audience(ctx context.Context, ev Event) (map[primitive.ObjectID]string, error) {
+	a := types.PostAudience{Type: types.AudienceFriends}
+	if ev.Audience != nil {
+		a = *ev.Audience
+	}
+	recipients, err := s.Audiences.Recipients(ctx, ev.Author, a)
+	if err != nil {
+		return nil, err
+	}
+	via := ViaFriend
+	if a.Type == types.AudienceGroups {
+		via = ViaGroup
+	}
+	out := make(map[primitive.ObjectID]string, len(recipients)+1)
+	for id := range recipients {
+		out[id] = via
+	}
+	if ev.IncludeAuthor {
+		out[ev.Author] = ViaSelf
+	}
+	return out, nil
+}
+
+// Refile re-addresses an item whose audience changed: it is taken out of
+// every timeline and fanned out again, so viewers who lost access drop it
+// and new ones receive it at its original time.
+func (s *Service) Refile(ctx context.Context, ev Event) {
+	s.RemoveRef(ctx, ev.Kind, ev.Ref)
+	s.FanOut(ctx, ev)
+}
+
+// Insert writes entries idempotently: one per (owner, kind, ref), so fan-out
+// racing a backfill never duplicates.
+func (s *Service) Insert(ctx context.Context, entries []Entry) error {
+	if !s.ready() || len(entries) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, len(entries))
+	for i, e := range entries {
+		e.ID = primitive.NilObjectID
+		models[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"owner": e.Owner, "kind": e.Kind, "ref": e.Ref}).
+			SetUpdate(bson.M{"$setOnInsert": e}).
+			SetUpsert(true)
+	}
+	_, err := s.Entries.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// RemoveRef drops an item from every timeline, e.g. when a post is deleted.
+func (s *Service) RemoveRef(ctx context.Context, kind string, ref primitive.ObjectID) {
+	if !s.ready() {
+		return
+	}
+	if _, err := s.Entries.DeleteMany(ctx, bson.M{"kind": kind, "ref": ref}); err != nil {
+		slog.Error("Failed to prune timeline entries", "kind", kind, "ref", ref.Hex(), "error", err)
+	}
+}
+
+// Unfriend removes what each user received through the friendship. Items
+// from shared groups stay.
+func (s *Service) Unfriend(ctx context.Context, a, b primitive.ObjectID) {
+	s.removeBetween(ctx, a, b, bson.M{"via": ViaFriend})
+}
+
+// Block removes everything either user received from the other.
+func (s *Service) Block(ctx context.Context, a, b primitive.ObjectID) {
+	s.removeBetween(ctx, a, b, bson.M{})
+}
+
+func (s *Service) removeBetween(ctx context.Context, a, b primitive.ObjectID, extra bson.M) {
+	if !s.ready() {
+		return
+	}
+	filter := bson.M{"$or": bson.A{
+		bson.M{"owner": a, "author": b},
+		bson.M{"owner": b, "author": a},
+	}}
+	for k, v := range extra {
+		filter[k] = v
+	}
+	if _, err := s.Entries.DeleteMany(ctx, filter); err != nil {
+		slog.Error("Failed to prune timeline entries between users", "user_a", a.Hex(), "user_b", b.Hex(), "error", err)
+	}
+}
+
+// Warm reports whether owner's timeline has been backfilled and can serve
+// the feed on its own.
+func (s *Service) Warm(ctx context.Context, owner primitive.ObjectID) bool {
+	if !s.ready() || s.State == nil {
+		return false
+	}
+	n, err := s.State.CountDocuments(ctx, bson.M{"_id": owner}, options.Count().SetLimit(1))
+	if err != nil {
+		slog.Warn("Failed to check timeline state, using live feed", "owner", owner.Hex(), "error", err)
+		return false
+	}
+	return n > 0
+}
+
+// Cool drops owners' warm marks so their next feed read backfills again. A
+// new friend's or group's earlier items never went through fan-out, so a warm
+// timeline would otherwise never show them.
+func (s *Service) Cool(ctx context.Context, owners ...primitive.ObjectID) {
+	if !s.ready() || s.State == nil || len(owners) == 0 {
+		return
+	}
+	if _, err := s.State.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": owners}}); err != nil {
+		slog.Error("Failed to reset timeline state", "owners", len(owners), "error", err)
+	}
+}
+
+// MarkWarm records that owner's timeline holds everything up to at.
+func (s *Service) MarkWarm(ctx context.Context, owner primitive.ObjectID, at time.Time) error {
+	if !s.ready() || s.State == nil {
+		return nil
+	}
+	_, err := s.State.UpdateOne(ctx,
+		bson.M{"_id": owner},
+		bson.M{"$set": bson.M{"backfilledAt": at}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// Page returns up to limit of owner's entries matching keyset (the cursor
+// range on "at"), newest first, and whether more remain.
+func (s *Service) Page(ctx context.Context, owner primitive.ObjectID, limit int, keyset bson.M) ([]Entry, bool, error) {
+	if !s.ready() {
+		return nil, false, nil
+	}
+	filter := bson.M{"$and": bson.A{bson.M{"owner": owner}, keyset}}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(int64(limit + 1))
+	cursor, err := s.Entries.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, false, err
+	}
+	var entries []Entry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, false, err
+	}
+	hasMore := len(entries) > limit
+	if hasMore {
+		entries = entries[:limit]
+	}
+	return entries, hasMore, nil
+}
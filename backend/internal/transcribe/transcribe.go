@@ -0,0 +1,97 @@
+// Package transcribe turns voice recordings into text for voice task
+// creation. Transcriber is the seam between the handlers and a speech-to-text
+// backend; WhisperClient implements it for the OpenAI transcription API and
+// the local Whisper servers that mirror it.
+package transcribe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/abhikaboy/Kindred/internal/config"
+)
+
+const BackendOpenAI = "openai"
+
+var (
+	// ErrUnavailable is returned when no speech-to-text backend is configured.
+	ErrUnavailable = errors.New("speech-to-text not configured")
+	// ErrNoSpeech is returned when a recording transcribes to nothing.
+	ErrNoSpeech = errors.New("no speech recognised in the recording")
+	// ErrUnsupportedAudio is returned for an audio format the backend can't read.
+	ErrUnsupportedAudio = errors.New("unsupported audio format")
+)
+
+// Audio is one recording to transcribe.
+type Audio struct {
+	Data     []byte
+	MimeType string
+	// Language is an optional ISO-639-1 hint such as "en"; empty lets the
+	// backend detect it.
+	Language string
+}
+
+// Transcript is the text of a recording. Language and DurationSeconds are
+// zero when the backend doesn't report them.
+type Transcript struct {
+	Text            string  `json:"text"`
+	Language        string  `json:"language,omitempty"`
+	DurationSeconds float64 `json:"durationSeconds,omitempty"`
+	Model           string  `json:"model"`
+}
+
+// Transcriber converts speech to text.
+type Transcriber interface {
+	Transcribe(ctx context.Context, audio Audio) (*Transcript, error)
+}
+
+// New builds the transcriber selected by cfg.Backend, or returns nil when
+// server-side transcription is disabled.
+func New(cfg config.STT) (Transcriber, error) {
+	switch strings.ToLower(cfg.Backend) {
+	case "", "none":
+		return nil, nil
+	case BackendOpenAI:
+		if cfg.Model == "" {
+			return nil, fmt.Errorf("STT_MODEL is required for the %s backend", BackendOpenAI)
+		}
+		return NewWhisperClient(cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown STT backend %q (expected %q)", cfg.Backend, BackendOpenAI)
+	}
+}
+
+// audioExtensions lists the formats every supported backend accepts, keyed by
+// MIME type. The extension matters: backends sniff the format from the
+// uploaded file name.
+var audioExtensions = map[string]string{
+	"audio/mp4":   ".m4a",
+	"audio/m4a":   ".m4a",
+	"audio/x-m4a": ".m4a",
+	"audio/mpeg":  ".mp3",
+	"audio/mp3":   ".mp3",
+	"audio/wav":   ".wav",
+	"audio/x-wav": ".wav",
+	"audio/wave":  ".wav",
+	"audio/webm":  ".webm",
+	"audio/ogg":   ".ogg",
+	"audio/flac":  ".flac",
+}
+
+var canonicalAudioTypes = map[string]string{
+	".m4a":  "audio/mp4",
+	".mp3":  "audio/mpeg",
+	".wav":  "audio/wav",
+	".webm": "audio/webm",
+	".ogg":  "audio/ogg",
+	".flac": "audio/flac",
+}
+
+// AudioExtension returns the file extension for a supported audio MIME type.
+func AudioExtension(mimeType string) (string, bool) {
+	mimeType, _, _ = strings.Cut(strings.ToLower(strings.TrimSpace(mimeType)), ";")
+	ext, ok := audioExtensions[strings.TrimSpace(mimeType)]
+	return ext, ok
+}
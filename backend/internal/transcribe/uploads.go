@@ -0,0 +1,119 @@
+package transcribe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+var (
+	// ErrForeignUpload is returned for an object key outside the caller's
+	// voice upload prefix.
+	ErrForeignUpload = errors.New("recording does not belong to this user")
+	// ErrUploadNotFound is returned when nothing was uploaded under the key.
+	ErrUploadNotFound = errors.New("recording not found")
+	// ErrUploadTooLarge is returned for recordings over the size cap.
+	ErrUploadTooLarge = errors.New("recording is too large")
+)
+
+// UploadKeyPrefix is where a user's voice recordings are uploaded. Keys are
+// checked against it before anything is read, so one user can't have
+// another's recording transcribed.
+func UploadKeyPrefix(userID string) string {
+	return "voice/" + userID + "/"
+}
+
+// objectStore is the part of *s3.Client uploads need.
+type objectStore interface {
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+}
+
+// UploadTranscriber transcribes recordings clients uploaded to Spaces
+// through a presigned URL. Recordings are kept until the caller has used the
+// transcript and calls DeleteUpload, so a failure anywhere before then can be
+// retried with the same key.
+type UploadTranscriber struct {
+	store    objectStore
+	bucket   string
+	stt      Transcriber
+	maxBytes int64
+}
+
+func NewUploadTranscriber(s3Client *s3.Client, bucket string, stt Transcriber, maxAudioMB int) *UploadTranscriber {
+	return newUploadTranscriber(s3Client, bucket, stt, maxAudioMB)
+}
+
+func newUploadTranscriber(store objectStore, bucket string, stt Transcriber, maxAudioMB int) *UploadTranscriber {
+	if maxAudioMB <= 0 {
+		maxAudioMB = 25
+	}
+	return &UploadTranscriber{store: store, bucket: bucket, stt: stt, maxBytes: int64(maxAudioMB) << 20}
+}
+
+// TranscribeUpload reads the recording at key, which must sit under the
+// user's upload prefix, and transcribes it.
+func (u *UploadTranscriber) TranscribeUpload(ctx context.Context, userID, key, language string) (*Transcript, error) {
+	if u == nil || u.stt == nil {
+		return nil, ErrUnavailable
+	}
+	if !strings.HasPrefix(key, UploadKeyPrefix(userID)) || strings.Contains(key, "..") {
+		return nil, ErrForeignUpload
+	}
+
+	obj, err := u.store.GetObject(ctx, &s3.GetObjectInput{Bucket: aws.String(u.bucket), Key: aws.String(key)})
+	if err != nil {
+		var noKey *s3types.NoSuchKey
+		if errors.As(err, &noKey) {
+			return nil, ErrUploadNotFound
+		}
+		return nil, fmt.Errorf("failed to read recording: %w", err)
+	}
+	defer obj.Body.Close()
+	if obj.ContentLength != nil && *obj.ContentLength > u.maxBytes {
+		return nil, ErrUploadTooLarge
+	}
+	data, err := io.ReadAll(io.LimitReader(obj.Body, u.maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recording: %w", err)
+	}
+	if int64(len(data)) > u.maxBytes {
+		return nil, ErrUploadTooLarge
+	}
+
+	mimeType := aws.ToString(obj.ContentType)
+	if _, ok := AudioExtension(mimeType); !ok {
+		// Some clients upload without a Content-Type; the key's extension
+		// was chosen from the declared type when the URL was issued.
+		mimeType = mimeFromKey(key)
+	}
+
+	return u.stt.Transcribe(ctx, Audio{Data: data, MimeType: mimeType, Language: language})
+}
+
+// DeleteUpload removes a recording once what it said has been acted on.
+// Best-effort: a leftover recording costs storage, not correctness.
+func (u *UploadTranscriber) DeleteUpload(ctx context.Context, userID, key string) {
+	if u == nil || !strings.HasPrefix(key, UploadKeyPrefix(userID)) || strings.Contains(key, "..") {
+		return
+	}
+	if _, err := u.store.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: aws.String(u.bucket), Key: aws.String(key)}); err != nil {
+		slog.Warn("Failed to delete transcribed recording", "key", key, "error", err)
+	}
+}
+
+// mimeFromKey maps a key's extension back to a canonical MIME type.
+func mimeFromKey(key string) string {
+	dot := strings.LastIndex(key, ".")
+	if dot < 0 {
+		return ""
+	}
+	return canonicalAudioTypes[key[dot:]]
+}
@@ -0,0 +1,124 @@
+package transcribe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+type fakeStore struct {
+	objects map[string]*s3.GetObjectOutput
+	deleted []string
+}
+
+func (f *fakeStore) GetObject(_ context.Context, in *s3.GetObjectInput, _ ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	obj, ok := f.objects[aws.ToString(in.Key)]
+	if !ok {
+		return nil, &s3types.NoSuchKey{}
+	}
+	return obj, nil
+}
+
+func (f *fakeStore) DeleteObject(_ context.Context, in *s3.DeleteObjectInput, _ ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+	f.deleted = append(f.deleted, aws.ToString(in.Key))
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+func object(data, contentType string) *s3.GetObjectOutput {
+	out := &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader([]byte(data))), ContentLength: aws.Int64(int64(len(data)))}
+	if contentType != "" {
+		out.ContentType = aws.String(contentType)
+	}
+	return out
+}
+
+type fakeSTT struct {
+	got Audio
+	err error
+}
+
+func (f *fakeSTT) Transcribe(_ context.Context, audio Audio) (*Transcript, error) {
+	f.got = audio
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &Transcript{Text: "buy milk", Model: "whisper-1"}, nil
+}
+
+const testUser = "507f1f77bcf86cd799439011"
+
+func TestTranscribeUpload_KeepsUntilDeleted(t *testing.T) {
+	key := UploadKeyPrefix(testUser) + "a.m4a"
+	store := &fakeStore{objects: map[string]*s3.GetObjectOutput{key: object("audio", "")}}
+	stt := &fakeSTT{}
+	u := newUploadTranscriber(store, "bucket", stt, 1)
+
+	got, err := u.TranscribeUpload(context.Background(), testUser, key, "en")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Text != "buy milk" {
+		t.Errorf("text = %q", got.Text)
+	}
+	if stt.got.MimeType != "audio/mp4" || stt.got.Language != "en" || string(stt.got.Data) != "audio" {
+		t.Errorf("audio = %+v, want the type taken from the key", stt.got)
+	}
+	if len(store.deleted) != 0 {
+		t.Errorf("the recording should outlive transcription, deleted %v", store.deleted)
+	}
+
+	u.DeleteUpload(context.Background(), testUser, key)
+	u.DeleteUpload(context.Background(), "507f1f77bcf86cd799439012", key)
+	if len(store.deleted) != 1 || store.deleted[0] != key {
+		t.Errorf("deleted = %v, want only the owner's delete", store.deleted)
+	}
+}
+
+func TestTranscribeUpload_KeepsAfterFailure(t *testing.T) {
+	key := UploadKeyPrefix(testUser) + "a.wav"
+	store := &fakeStore{objects: map[string]*s3.GetObjectOutput{key: object("audio", "audio/wav")}}
+	u := newUploadTranscriber(store, "bucket", &fakeSTT{err: ErrNoSpeech}, 1)
+
+	if _, err := u.TranscribeUpload(context.Background(), testUser, key, ""); !errors.Is(err, ErrNoSpeech) {
+		t.Errorf("err = %v", err)
+	}
+	if len(store.deleted) != 0 {
+		t.Errorf("a failed recording should be kept for a retry, deleted %v", store.deleted)
+	}
+}
+
+func TestTranscribeUpload_Rejects(t *testing.T) {
+	mine := UploadKeyPrefix(testUser)
+	big := string(make([]byte, 1<<20+1))
+	store := &fakeStore{objects: map[string]*s3.GetObjectOutput{
+		mine + "big.m4a": object(big, "audio/mp4"),
+		UploadKeyPrefix("someoneelse") + "theirs.m4a": object("audio", "audio/mp4"),
+		"voice/" + testUser + "/../someoneelse/x.m4a": object("audio", "audio/mp4"),
+	}}
+	u := newUploadTranscriber(store, "bucket", &fakeSTT{}, 1)
+
+	cases := map[string]error{
+		UploadKeyPrefix("someoneelse") + "theirs.m4a": ErrForeignUpload,
+		mine + "../someoneelse/x.m4a":                 ErrForeignUpload,
+		mine + "missing.m4a":                          ErrUploadNotFound,
+		mine + "big.m4a":                              ErrUploadTooLarge,
+	}
+	for key, want := range cases {
+		if _, err := u.TranscribeUpload(context.Background(), testUser, key, ""); !errors.Is(err, want) {
+			t.Errorf("%s: err = %v, want %v", key, err, want)
+		}
+	}
+	if len(store.deleted) != 0 {
+		t.Errorf("rejected recordings should not be deleted, deleted %v", store.deleted)
+	}
+
+	if _, err := newUploadTranscriber(store, "bucket", nil, 1).TranscribeUpload(context.Background(), testUser, mine+"a.m4a", ""); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("no backend: err = %v", err)
+	}
+}
@@ -0,0 +1,141 @@
+package transcribe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/abhikaboy/Kindred/internal/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// WhisperClient calls an OpenAI-compatible /audio/transcriptions endpoint:
+// the hosted API, or a local whisper.cpp / faster-whisper server.
+type WhisperClient struct {
+	baseURL string
+	apiKey  string
+	model   string
+	client  *http.Client
+}
+
+var _ Transcriber = (*WhisperClient)(nil)
+
+func NewWhisperClient(cfg config.STT) *WhisperClient {
+	timeout := time.Duration(cfg.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 120 * time.Second
+	}
+	return &WhisperClient{
+		baseURL: strings.TrimRight(cfg.BaseURL, "/"),
+		apiKey:  cfg.APIKey,
+		model:   cfg.Model,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// transcriptionResponse covers both the json and verbose_json formats.
+type transcriptionResponse struct {
+	Text     string  `json:"text"`
+	Language string  `json:"language"`
+	Duration float64 `json:"duration"`
+}
+
+func (c *WhisperClient) Transcribe(ctx context.Context, audio Audio) (*Transcript, error) {
+	ctx, span := otel.Tracer("kindred").Start(ctx, "transcribe.Whisper")
+	defer span.End()
+
+	transcript, err := c.transcribe(ctx, audio)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return transcript, nil
+}
+
+func (c *WhisperClient) transcribe(ctx context.Context, audio Audio) (*Transcript, error) {
+	ext, ok := AudioExtension(audio.MimeType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAudio, audio.MimeType)
+	}
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	file, err := form.CreateFormFile("file", "recording"+ext)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.Write(audio.Data); err != nil {
+		return nil, err
+	}
+	fields := map[string]string{"model": c.model, "response_format": c.responseFormat()}
+	if audio.Language != "" {
+		fields["language"] = audio.Language
+	}
+	for name, value := range fields {
+		if err := form.WriteField(name, value); err != nil {
+			return nil, err
+		}
+	}
+	if err := form.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/audio/transcriptions", &body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("transcription request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transcription response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		msg := strings.TrimSpace(string(raw))
+		var apiErr struct {
+			Error *struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(raw, &apiErr) == nil && apiErr.Error != nil {
+			msg = apiErr.Error.Message
+		}
+		return nil, fmt.Errorf("transcription returned %d: %s", resp.StatusCode, msg)
+	}
+
+	var out transcriptionResponse
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, fmt.Errorf("transcription returned an unreadable body: %w", err)
+	}
+	text := strings.TrimSpace(out.Text)
+	if text == "" {
+		return nil, ErrNoSpeech
+	}
+	return &Transcript{Text: text, Language: out.Language, DurationSeconds: out.Duration, Model: c.model}, nil
+}
+
+// responseFormat asks Whisper models for verbose_json, which adds the
+// detected language and duration; newer transcription models only accept
+// json.
+func (c *WhisperClient) responseFormat() string {
+	if strings.Contains(strings.ToLower(c.model), "whisper") {
+		return "verbose_json"
+	}
+	return "json"
+}
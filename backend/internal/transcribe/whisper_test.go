@@ -0,0 +1,92 @@
+package transcribe
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/abhikaboy/Kindred/internal/config"
+)
+
+func TestWhisperTranscribe(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/audio/transcriptions" {
+			t.Errorf("path = %q", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer key" {
+			t.Errorf("authorization = %q", got)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("parse form: %v", err)
+		}
+		for field, want := range map[string]string{"model": "whisper-1", "response_format": "verbose_json", "language": "en"} {
+			if got := r.FormValue(field); got != want {
+				t.Errorf("%s = %q, want %q", field, got, want)
+			}
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("file: %v", err)
+		}
+		data, _ := io.ReadAll(file)
+		if header.Filename != "recording.m4a" || string(data) != "audio" {
+			t.Errorf("file = %q (%q)", header.Filename, data)
+		}
+		w.Write([]byte(`{"text":" Call mom tomorrow. ","language":"english","duration":2.5}`))
+	}))
+	defer srv.Close()
+
+	c := NewWhisperClient(config.STT{BaseURL: srv.URL + "/v1/", APIKey: "key", Model: "whisper-1"})
+	got, err := c.Transcribe(context.Background(), Audio{Data: []byte("audio"), MimeType: "audio/x-m4a", Language: "en"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Text != "Call mom tomorrow." || got.Language != "english" || got.DurationSeconds != 2.5 || got.Model != "whisper-1" {
+		t.Errorf("transcript = %+v", got)
+	}
+}
+
+func TestWhisperTranscribe_Errors(t *testing.T) {
+	var status int
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.FormValue("response_format"); got != "json" {
+			t.Errorf("response_format = %q for a non-whisper model", got)
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+	c := NewWhisperClient(config.STT{BaseURL: srv.URL, Model: "gpt-4o-mini-transcribe"})
+	audio := Audio{Data: []byte("audio"), MimeType: "audio/wav"}
+
+	status, body = http.StatusBadRequest, `{"error":{"message":"Audio file might be corrupted"}}`
+	_, err := c.Transcribe(context.Background(), audio)
+	if err == nil || err.Error() != "transcription returned 400: Audio file might be corrupted" {
+		t.Errorf("err = %v", err)
+	}
+
+	status, body = http.StatusOK, `{"text":"  "}`
+	if _, err := c.Transcribe(context.Background(), audio); !errors.Is(err, ErrNoSpeech) {
+		t.Errorf("blank text: err = %v, want ErrNoSpeech", err)
+	}
+
+	if _, err := c.Transcribe(context.Background(), Audio{Data: []byte("x"), MimeType: "video/mp4"}); !errors.Is(err, ErrUnsupportedAudio) {
+		t.Errorf("video: err = %v, want ErrUnsupportedAudio", err)
+	}
+}
+
+func TestNew(t *testing.T) {
+	if tr, err := New(config.STT{}); tr != nil || err != nil {
+		t.Errorf("empty backend = (%v, %v), want disabled", tr, err)
+	}
+	if _, err := New(config.STT{Backend: "azure"}); err == nil {
+		t.Error("expected an error for an unknown backend")
+	}
+	if tr, err := New(config.STT{Backend: "OpenAI", Model: "whisper-1"}); tr == nil || err != nil {
+		t.Errorf("openai = (%v, %v)", tr, err)
+	}
+}
@@ -115,6 +115,12 @@ func postsScenario(env *LoadTestEnv) Scenario {
 			URL:    "/v1/user/posts/friends",
 			Header: header,
 		})
+
+		targets = append(targets, vegeta.Target{
+			Method: "GET",
+			URL:    "/v1/user/feed",
+			Header: header,
+		})
 	}
 
 	return Scenario{
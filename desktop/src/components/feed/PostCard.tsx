@@ -51,6 +51,8 @@ export function PostCard({ post }: { post: PostDocumentAPI }): JSX.Element {
     buildReactionGroups(post.reactions, myId)
   );
   const [comments, setComments] = useState<CommentDocumentAPI[]>(post.comments ?? []);
+  // post.comments is only the latest few; commentCount is the real total.
+  const [commentCount, setCommentCount] = useState(post.commentCount ?? 0);
   const [commentsOpen, setCommentsOpen] = useState(true);
   const [kudosOpen, setKudosOpen] = useState(false);
 
@@ -64,6 +66,7 @@ export function PostCard({ post }: { post: PostDocumentAPI }): JSX.Element {
   const onAddComment = (content: string) => {
     addComment(post._id, content).then(({ comment, friendshipDelta }) => {
       setComments((prev) => [...prev, comment]);
+      setCommentCount((n) => n + 1);
       bump(friendshipDelta, post.user.display_name);
     });
   };
@@ -71,7 +74,11 @@ export function PostCard({ post }: { post: PostDocumentAPI }): JSX.Element {
   const onDeleteComment = (commentId: string) => {
     const prev = comments;
     setComments(prev.filter((c) => c.id !== commentId));
-    deleteComment(post._id, commentId).catch(() => setComments(prev));
+    setCommentCount((n) => Math.max(0, n - 1));
+    deleteComment(post._id, commentId).catch(() => {
+      setComments(prev);
+      setCommentCount((n) => n + 1);
+    });
   };
 
   const createdMs = new Date(post.metadata.createdAt).getTime();
@@ -130,7 +137,7 @@ export function PostCard({ post }: { post: PostDocumentAPI }): JSX.Element {
         groups={groups}
         onToggle={onToggle}
         kudos={kudos}
-        commentCount={comments.filter((c) => !c.metadata.isDeleted).length}
+        commentCount={Math.max(commentCount, comments.filter((c) => !c.metadata.isDeleted).length)}
         commentsOpen={commentsOpen}
         onToggleComments={() => setCommentsOpen((o) => !o)}
         canSendKudos={canSendKudos}
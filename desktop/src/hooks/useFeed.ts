@@ -4,8 +4,8 @@ import type { components } from "@/lib/api/types.gen";
 
 export type FeedItem = components["schemas"]["FeedItem"];
 
-// Infinite feed over GET /v1/user/feed. Page param is the `offset` query param;
-// getNextPageParam reads hasMore/nextOffset from the last page.
+// Infinite feed over GET /v1/user/feed. Page param is the opaque `cursor` query
+// param; getNextPageParam reads hasMore/nextCursor from the last page.
 export function useFeed(): {
   items: FeedItem[];
   isLoading: boolean;
@@ -19,9 +19,9 @@ export function useFeed(): {
     "/v1/user/feed",
     { params: { header: { Authorization: "" }, query: { limit: 20 } } },
     {
-      getNextPageParam: (last) => (last.hasMore ? last.nextOffset : undefined),
-      initialPageParam: 0,
-      pageParamName: "offset",
+      getNextPageParam: (last) => (last.hasMore ? last.nextCursor : undefined),
+      initialPageParam: "",
+      pageParamName: "cursor",
     }
   );
 
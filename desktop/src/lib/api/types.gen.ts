@@ -1672,7 +1672,7 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/v1/user/connections/friends": {
+    "/v1/user/connections/close-friends": {
         parameters: {
             query?: never;
             header?: never;
@@ -1680,11 +1680,15 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get friends
-         * @description Get all friends of the authenticated user
+         * Get close friends
+         * @description Retrieve the friends who see your close-friends posts
          */
-        get: operations["get-friends"];
-        put?: never;
+        get: operations["get-close-friends"];
+        /**
+         * Update close friends
+         * @description Replace the list of friends who see your close-friends posts
+         */
+        put: operations["update-close-friends"];
         post?: never;
         delete?: never;
         options?: never;
@@ -1692,7 +1696,7 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/v1/user/connections/friends/{userId}": {
+    "/v1/user/connections/friends": {
         parameters: {
             query?: never;
             header?: never;
@@ -1700,10 +1704,10 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get friends of a user
-         * @description Get all friends of a given user. Requester must be connected with the target user.
+         * Get friends
+         * @description Get all friends of the authenticated user
          */
-        get: operations["get-friends-by-user"];
+        get: operations["get-friends"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1712,7 +1716,7 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/v1/user/connections/received": {
+    "/v1/user/connections/friends/{userId}": {
         parameters: {
             query?: never;
             header?: never;
@@ -1720,10 +1724,10 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get connections by receiver
-         * @description Get all connections where the authenticated user is the receiver
+         * Get friends of a user
+         * @description Get all friends of a given user. Requester must be connected with the target user.
          */
-        get: operations["get-connections-by-receiver"];
+        get: operations["get-friends-by-user"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1732,7 +1736,7 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/v1/user/connections/requested/{id}": {
+    "/v1/user/connections/hidden": {
         parameters: {
             query?: never;
             header?: never;
@@ -1740,10 +1744,10 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get connections by requester
-         * @description Get all connections where the specified user is the requester
+         * Get users you hide your activity from
+         * @description Retrieve list of users you hide your activity from
          */
-        get: operations["get-connections-by-requester"];
+        get: operations["get-hidden-from-users"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1752,27 +1756,31 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/v1/user/credits": {
+    "/v1/user/connections/hide/{userId}": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Get user credits
-         * @description Retrieve the current credit balance for the authenticated user
+         * Hide your activity from a user
+         * @description Keep your posts, public tasks and ring closures from a user without unfriending them
          */
-        get: operations["get-user-credits"];
-        put?: never;
-        post?: never;
-        delete?: never;
+        post: operations["hide-activity-from-user"];
+        /**
+         * Stop hiding your activity from a user
+         * @description Let a user see your posts, public tasks and ring closures again
+         */
+        delete: operations["unhide-activity-from-user"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/encouragements": {
+    "/v1/user/connections/lists": {
         parameters: {
             query?: never;
             header?: never;
@@ -1780,51 +1788,47 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get encouragements
-         * @description Retrieve all encouragements received by the authenticated user
+         * Get friend lists
+         * @description Retrieve your close friends list and your custom friend lists
          */
-        get: operations["get-encouragements"];
+        get: operations["get-friend-lists"];
         put?: never;
         /**
-         * Create a new encouragement
-         * @description Create a new encouragement message for another user
+         * Create a friend list
+         * @description Create a named list of friends to share posts with, tag on tasks, or filter notifications by
          */
-        post: operations["create-encouragement"];
+        post: operations["create-friend-list"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/encouragements/{id}": {
+    "/v1/user/connections/lists/{listId}": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /**
-         * Get encouragement by ID
-         * @description Retrieve a specific encouragement by its ID
-         */
-        get: operations["get-encouragement"];
+        get?: never;
         put?: never;
         post?: never;
         /**
-         * Delete encouragement
-         * @description Delete an encouragement message
+         * Delete a friend list
+         * @description Delete one of your custom friend lists
          */
-        delete: operations["delete-encouragement"];
+        delete: operations["delete-friend-list"];
         options?: never;
         head?: never;
         /**
-         * Update encouragement
-         * @description Update an encouragement message
+         * Update a friend list
+         * @description Rename a friend list or replace its members
          */
-        patch: operations["update-encouragement"];
+        patch: operations["update-friend-list"];
         trace?: never;
     };
-    "/v1/user/encouragements/{id}/reaction": {
+    "/v1/user/connections/mute/{userId}": {
         parameters: {
             query?: never;
             header?: never;
@@ -1834,37 +1838,41 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * React to an encouragement
-         * @description Toggle the receiver's emoji reaction on an encouragement
+         * Mute a user
+         * @description Keep a user's posts, tasks and ring closures out of your feed and their activity out of your notifications, without unfriending them
          */
-        post: operations["react-to-encouragement"];
-        delete?: never;
+        post: operations["mute-user"];
+        /**
+         * Unmute a user
+         * @description Bring a muted user's activity back into your feed
+         */
+        delete: operations["unmute-user"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/encouragements/mark-read": {
+    "/v1/user/connections/muted": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
+        /**
+         * Get muted users
+         * @description Retrieve list of users you have muted
+         */
+        get: operations["get-muted-users"];
         put?: never;
         post?: never;
         delete?: never;
         options?: never;
         head?: never;
-        /**
-         * Mark encouragements as read
-         * @description Mark multiple encouragements as read
-         */
-        patch: operations["mark-encouragements-read"];
+        patch?: never;
         trace?: never;
     };
-    "/v1/user/encouragements/sent": {
+    "/v1/user/connections/received": {
         parameters: {
             query?: never;
             header?: never;
@@ -1872,10 +1880,10 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get sent encouragements
-         * @description Retrieve all encouragements sent by the authenticated user, with reaction state
+         * Get connections by receiver
+         * @description Get all connections where the authenticated user is the receiver
          */
-        get: operations["get-sent-encouragements"];
+        get: operations["get-connections-by-receiver"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1884,7 +1892,7 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/v1/user/feed": {
+    "/v1/user/connections/requested/{id}": {
         parameters: {
             query?: never;
             header?: never;
@@ -1892,10 +1900,10 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get unified feed
-         * @description Retrieve a unified feed with posts and activities from friends
+         * Get connections by requester
+         * @description Get all connections where the specified user is the requester
          */
-        get: operations["get-feed"];
+        get: operations["get-connections-by-requester"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1904,67 +1912,71 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/v1/user/for-you": {
+    "/v1/user/connections/restrict/{userId}": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Get the For You feed
-         * @description Returns the curated For You feed for the authenticated user, organized into Catch up and Suggested for you sections.
+         * Restrict a user
+         * @description Hold a user's comments on your posts for your approval; until approved only they and you see them
          */
-        get: operations["get-for-you"];
-        put?: never;
-        post?: never;
-        delete?: never;
+        post: operations["restrict-user"];
+        /**
+         * Unrestrict a user
+         * @description Let a restricted user's comments on your posts publish straight away again
+         */
+        delete: operations["unrestrict-user"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/for-you/dismiss": {
+    "/v1/user/connections/restricted": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Dismiss a For You card
-         * @description Records that the user dismissed a card so it no longer appears in their For You feed.
+         * Get restricted users
+         * @description Retrieve list of users you have restricted
          */
-        post: operations["dismiss-for-you-card"];
+        get: operations["get-restricted-users"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/for-you/interactions": {
+    "/v1/user/credits": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Record a For You CTA interaction
-         * @description Increments the interaction counter for a card type, counting toward the threshold that switches the card to compact display mode.
+         * Get user credits
+         * @description Retrieve the current credit balance for the authenticated user
          */
-        post: operations["record-for-you-interaction"];
+        get: operations["get-user-credits"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/groups": {
+    "/v1/user/drafts": {
         parameters: {
             query?: never;
             header?: never;
@@ -1972,23 +1984,23 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get all user groups
-         * @description Retrieve all groups where user is creator or member
+         * Get drafts
+         * @description Get your unpublished and scheduled drafts, most recently edited first
          */
-        get: operations["get-groups"];
+        get: operations["get-drafts"];
         put?: never;
         /**
-         * Create a new group
-         * @description Create a new group with the provided details
+         * Save draft
+         * @description Save an unpublished post to finish later, on any device
          */
-        post: operations["create-group"];
+        post: operations["create-draft"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/groups/{id}": {
+    "/v1/user/drafts/{id}": {
         parameters: {
             query?: never;
             header?: never;
@@ -1996,27 +2008,27 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get group by ID
-         * @description Retrieve a specific group by its ID
+         * Get draft
+         * @description Get one of your drafts
          */
-        get: operations["get-group"];
-        put?: never;
+        get: operations["get-draft"];
+        /**
+         * Update draft
+         * @description Replace a draft's content. A scheduled draft stays scheduled while its content can still be published
+         */
+        put: operations["update-draft"];
         post?: never;
         /**
-         * Delete group
-         * @description Delete an existing group (creator only)
+         * Delete draft
+         * @description Discard a draft that hasn't been published
          */
-        delete: operations["delete-group"];
+        delete: operations["delete-draft"];
         options?: never;
         head?: never;
-        /**
-         * Update group
-         * @description Update an existing group (creator only)
-         */
-        patch: operations["update-group"];
+        patch?: never;
         trace?: never;
     };
-    "/v1/user/groups/{id}/members": {
+    "/v1/user/drafts/{id}/publish": {
         parameters: {
             query?: never;
             header?: never;
@@ -2026,21 +2038,17 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * Add member to group
-         * @description Add a member to an existing group (creator only)
-         */
-        post: operations["add-group-member"];
-        /**
-         * Remove member from group
-         * @description Remove a member from an existing group (creator only or self)
+         * Publish draft
+         * @description Publish a draft now, whether or not it was scheduled
          */
-        delete: operations["remove-group-member"];
+        post: operations["publish-draft"];
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/login": {
+    "/v1/user/drafts/{id}/schedule": {
         parameters: {
             query?: never;
             header?: never;
@@ -2048,19 +2056,23 @@ export interface paths {
             cookie?: never;
         };
         get?: never;
-        put?: never;
         /**
-         * Login with token
-         * @description Authenticate user with existing token
+         * Schedule draft
+         * @description Publish a draft automatically at the given time
          */
-        post: operations["login-token"];
-        delete?: never;
+        put: operations["schedule-draft"];
+        post?: never;
+        /**
+         * Unschedule draft
+         * @description Cancel a draft's scheduled publish, keeping the draft
+         */
+        delete: operations["unschedule-draft"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/notifications": {
+    "/v1/user/encouragements": {
         parameters: {
             query?: never;
             header?: never;
@@ -2068,39 +2080,51 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get notifications
-         * @description Retrieve notifications for the authenticated user with pagination
+         * Get encouragements
+         * @description Retrieve all encouragements received by the authenticated user
          */
-        get: operations["get-notifications"];
+        get: operations["get-encouragements"];
         put?: never;
-        post?: never;
+        /**
+         * Create a new encouragement
+         * @description Create a new encouragement message for another user
+         */
+        post: operations["create-encouragement"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/notifications/{id}": {
+    "/v1/user/encouragements/{id}": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
+        /**
+         * Get encouragement by ID
+         * @description Retrieve a specific encouragement by its ID
+         */
+        get: operations["get-encouragement"];
         put?: never;
         post?: never;
         /**
-         * Delete notification
-         * @description Delete a specific notification
+         * Delete encouragement
+         * @description Delete an encouragement message
          */
-        delete: operations["delete-notification"];
+        delete: operations["delete-encouragement"];
         options?: never;
         head?: never;
-        patch?: never;
+        /**
+         * Update encouragement
+         * @description Update an encouragement message
+         */
+        patch: operations["update-encouragement"];
         trace?: never;
     };
-    "/v1/user/notifications/read": {
+    "/v1/user/encouragements/{id}/reaction": {
         parameters: {
             query?: never;
             header?: never;
@@ -2109,18 +2133,18 @@ export interface paths {
         };
         get?: never;
         put?: never;
-        post?: never;
+        /**
+         * React to an encouragement
+         * @description Toggle the receiver's emoji reaction on an encouragement
+         */
+        post: operations["react-to-encouragement"];
         delete?: never;
         options?: never;
         head?: never;
-        /**
-         * Mark notifications as read
-         * @description Mark specific notifications as read
-         */
-        patch: operations["mark-notifications-read"];
+        patch?: never;
         trace?: never;
     };
-    "/v1/user/notifications/read-all": {
+    "/v1/user/encouragements/mark-read": {
         parameters: {
             query?: never;
             header?: never;
@@ -2134,13 +2158,13 @@ export interface paths {
         options?: never;
         head?: never;
         /**
-         * Mark all notifications as read
-         * @description Mark all notifications as read for the authenticated user
+         * Mark encouragements as read
+         * @description Mark multiple encouragements as read
          */
-        patch: operations["mark-all-notifications-read"];
+        patch: operations["mark-encouragements-read"];
         trace?: never;
     };
-    "/v1/user/posts": {
+    "/v1/user/encouragements/sent": {
         parameters: {
             query?: never;
             header?: never;
@@ -2148,23 +2172,19 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get all posts
-         * @description Retrieve all posts
+         * Get sent encouragements
+         * @description Retrieve all encouragements sent by the authenticated user, with reaction state
          */
-        get: operations["get-posts"];
+        get: operations["get-sent-encouragements"];
         put?: never;
-        /**
-         * Create a new post
-         * @description Create a new post with the provided details
-         */
-        post: operations["create-post"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/posts/{id}": {
+    "/v1/user/feed": {
         parameters: {
             query?: never;
             header?: never;
@@ -2172,27 +2192,19 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get post by ID
-         * @description Retrieve a specific post by its ID
+         * Get unified feed
+         * @description Retrieve a unified feed with posts and activities from friends
          */
-        get: operations["get-post"];
+        get: operations["get-feed"];
         put?: never;
         post?: never;
-        /**
-         * Delete post
-         * @description Delete an existing post
-         */
-        delete: operations["delete-post"];
+        delete?: never;
         options?: never;
         head?: never;
-        /**
-         * Update post
-         * @description Update an existing post
-         */
-        patch: operations["update-post"];
+        patch?: never;
         trace?: never;
     };
-    "/v1/user/posts/{postId}/comment": {
+    "/v1/user/feed/impressions": {
         parameters: {
             query?: never;
             header?: never;
@@ -2202,37 +2214,37 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * Add comment to post
-         * @description Add a comment to an existing post
+         * Record feed impressions
+         * @description Mark feed items as seen so the ranked feed can demote them in later sessions
          */
-        post: operations["add-comment"];
+        post: operations["record-feed-impressions"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/posts/{postId}/comment/{commentId}": {
+    "/v1/user/for-you": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
-        post?: never;
         /**
-         * Delete comment from post
-         * @description Delete comment from an existing post
+         * Get the For You feed
+         * @description Returns the curated For You feed for the authenticated user, organized into Catch up and Suggested for you sections.
          */
-        delete: operations["delete-comment"];
+        get: operations["get-for-you"];
+        put?: never;
+        post?: never;
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/posts/{postId}/reaction": {
+    "/v1/user/for-you/dismiss": {
         parameters: {
             query?: never;
             header?: never;
@@ -2242,37 +2254,37 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * React to a post
-         * @description Adds a reaction to a post
+         * Dismiss a For You card
+         * @description Records that the user dismissed a card so it no longer appears in their For You feed.
          */
-        post: operations["add-reaction"];
+        post: operations["dismiss-for-you-card"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/posts/blueprint/{blueprintId}": {
+    "/v1/user/for-you/interactions": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Get posts by blueprint
-         * @description Retrieve all posts associated with a specific blueprint
+         * Record a For You CTA interaction
+         * @description Increments the interaction counter for a card type, counting toward the threshold that switches the card to compact display mode.
          */
-        get: operations["get-posts-by-blueprint"];
-        put?: never;
-        post?: never;
+        post: operations["record-for-you-interaction"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/posts/friends": {
+    "/v1/user/groups": {
         parameters: {
             query?: never;
             header?: never;
@@ -2280,39 +2292,43 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get friends posts
-         * @description Retrieve posts from user's friends, ordered chronologically
+         * Get all user groups
+         * @description Retrieve all groups where user is creator or member
          */
-        get: operations["get-friends-posts"];
+        get: operations["get-groups"];
         put?: never;
-        post?: never;
+        /**
+         * Create a new group
+         * @description Create a new group with the provided details
+         */
+        post: operations["create-group"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/posts/groups": {
+    "/v1/user/groups/join/{code}": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Get user groups
-         * @description Retrieve all groups where user is creator or member
+         * Join group with invite
+         * @description Join the group behind an invite code. Groups that require approval file a join request instead
          */
-        get: operations["get-user-groups"];
-        put?: never;
-        post?: never;
+        post: operations["join-group"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/profiles/{id}": {
+    "/v1/user/groups/{id}": {
         parameters: {
             query?: never;
             header?: never;
@@ -2320,19 +2336,27 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get profile by ID
-         * @description Retrieve a specific user profile by its ID
+         * Get group by ID
+         * @description Retrieve a specific group by its ID
          */
-        get: operations["get-profile"];
+        get: operations["get-group"];
         put?: never;
         post?: never;
-        delete?: never;
+        /**
+         * Delete group
+         * @description Delete an existing group (owner only)
+         */
+        delete: operations["delete-group"];
         options?: never;
         head?: never;
-        patch?: never;
+        /**
+         * Update group
+         * @description Update an existing group (owner or admin)
+         */
+        patch: operations["update-group"];
         trace?: never;
     };
-    "/v1/user/profiles/autocomplete": {
+    "/v1/user/groups/{id}/activity": {
         parameters: {
             query?: never;
             header?: never;
@@ -2340,10 +2364,10 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Autocomplete profiles
-         * @description Get autocomplete suggestions for user profiles
+         * Get group activity
+         * @description Retrieve the group's activity stream, newest first: posts shared to the group, members closing all their rings, and members' public task completions
          */
-        get: operations["autocomplete-profiles"];
+        get: operations["get-group-activity"];
         put?: never;
         post?: never;
         delete?: never;
@@ -2352,27 +2376,31 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/v1/user/profiles/find-by-phone": {
+    "/v1/user/groups/{id}/challenges": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
+        /**
+         * Get challenges
+         * @description List the group's challenges with their leaderboards, newest first
+         */
+        get: operations["get-group-challenges"];
         put?: never;
         /**
-         * Find users by phone numbers
-         * @description Efficiently find users matching any of the provided phone numbers using a single database query
+         * Create challenge
+         * @description Set a goal members can opt into: a total number of completions or a flex-style target per period (owner only)
          */
-        post: operations["find-users-by-phone-numbers"];
+        post: operations["create-group-challenge"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/profiles/search": {
+    "/v1/user/groups/{id}/challenges/{challengeId}": {
         parameters: {
             query?: never;
             header?: never;
@@ -2380,19 +2408,23 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Search profiles
-         * @description Search for user profiles by query string
+         * Get challenge
+         * @description Retrieve a challenge and its leaderboard
          */
-        get: operations["search-profiles"];
+        get: operations["get-group-challenge"];
         put?: never;
         post?: never;
-        delete?: never;
+        /**
+         * Delete challenge
+         * @description Delete a challenge and remove its task from every participant's workspace (owner only)
+         */
+        delete: operations["delete-group-challenge"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/pushtoken": {
+    "/v1/user/groups/{id}/challenges/{challengeId}/participants": {
         parameters: {
             query?: never;
             header?: never;
@@ -2402,17 +2434,21 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * Update push token
-         * @description Update user's push notification token
+         * Join challenge
+         * @description Opt into a challenge. Its task is added to a workspace named after the group, and completing it counts toward your progress
          */
-        post: operations["update-push-token"];
-        delete?: never;
+        post: operations["join-group-challenge"];
+        /**
+         * Leave challenge
+         * @description Leave a challenge and remove its task from your workspace
+         */
+        delete: operations["leave-group-challenge"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/referrals": {
+    "/v1/user/groups/{id}/invites": {
         parameters: {
             query?: never;
             header?: never;
@@ -2420,19 +2456,23 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get referral information
-         * @description Returns the current user's referral code, unlocks, and referred users
+         * Get invite links
+         * @description List the group's unexpired invite codes (owner or admin)
          */
-        get: operations["get-referral-info"];
+        get: operations["get-group-invites"];
         put?: never;
-        post?: never;
+        /**
+         * Create invite link
+         * @description Create an invite code that lets anyone holding it join the group until it expires (owner or admin)
+         */
+        post: operations["create-group-invite"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/referrals/apply": {
+    "/v1/user/groups/{id}/invites/{code}": {
         parameters: {
             query?: never;
             header?: never;
@@ -2441,38 +2481,42 @@ export interface paths {
         };
         get?: never;
         put?: never;
+        post?: never;
         /**
-         * Apply a referral code
-         * @description Apply a referral code to your account (only works if you haven't been referred yet)
+         * Revoke invite link
+         * @description Revoke an invite code so it can no longer be used (owner or admin)
          */
-        post: operations["apply-referral-code"];
-        delete?: never;
+        delete: operations["revoke-group-invite"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/referrals/stats": {
+    "/v1/user/groups/{id}/members": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Get referral statistics
-         * @description Returns detailed statistics about referrals and unlocked features
+         * Add member to group
+         * @description Add a member to an existing group (owner or admin)
          */
-        get: operations["get-referral-stats"];
-        put?: never;
-        post?: never;
-        delete?: never;
+        post: operations["add-group-member"];
+        /**
+         * Remove member from group
+         * @description Remove a member from an existing group (owner or admin, or self). Only the owner can remove admins, and the owner has to hand over ownership before leaving
+         */
+        delete: operations["remove-group-member"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/referrals/unlock": {
+    "/v1/user/groups/{id}/members/{userId}/role": {
         parameters: {
             query?: never;
             header?: never;
@@ -2480,59 +2524,63 @@ export interface paths {
             cookie?: never;
         };
         get?: never;
-        put?: never;
         /**
-         * Unlock a feature
-         * @description Use a referral unlock to activate a premium feature
+         * Set member role
+         * @description Make a member an admin or a plain member (owner only). Making someone the owner transfers ownership and leaves the previous owner as an admin
          */
-        post: operations["unlock-feature"];
+        put: operations["set-group-member-role"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/reports/comment/{commentId}": {
+    "/v1/user/groups/{id}/notifications": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Report a comment
-         * @description Report a comment for inappropriate content, spam, harassment, or other violations
+         * Get group notification settings
+         * @description Retrieve the caller's notification settings for a group
          */
-        post: operations["report-comment"];
+        get: operations["get-group-notifications"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
-        patch?: never;
+        /**
+         * Update group notification settings
+         * @description Update the caller's notification settings for a group (partial update supported)
+         */
+        patch: operations["update-group-notifications"];
         trace?: never;
     };
-    "/v1/user/reports/post/{postId}": {
+    "/v1/user/groups/{id}/requests": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Report a post
-         * @description Report a post for inappropriate content, spam, harassment, or other violations
+         * Get join requests
+         * @description List pending requests to join the group (owner or admin)
          */
-        post: operations["report-post"];
+        get: operations["get-group-join-requests"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/rewards/redeem": {
+    "/v1/user/groups/{id}/requests/{userId}": {
         parameters: {
             query?: never;
             header?: never;
@@ -2542,101 +2590,101 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * Redeem a kudos reward
-         * @description Redeem a reward using accumulated kudos from sending encouragements and congratulations
+         * Approve join request
+         * @description Approve a pending join request, adding the user as a member (owner or admin)
          */
-        post: operations["redeem-reward"];
-        delete?: never;
+        post: operations["approve-group-join-request"];
+        /**
+         * Deny join request
+         * @description Deny a pending join request (owner or admin)
+         */
+        delete: operations["deny-group-join-request"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/rings/history": {
+    "/v1/user/login": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Get ring history
-         * @description Returns ring state history for the specified number of days (default 7, max 30)
+         * Login with token
+         * @description Authenticate user with existing token
          */
-        get: operations["get-rings-history"];
-        put?: never;
-        post?: never;
+        post: operations["login-token"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/rings/reward": {
+    "/v1/user/notifications": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Claim daily ring reward
-         * @description Claims the daily reward when all three rings are closed
+         * Get notifications
+         * @description Retrieve notifications for the authenticated user with pagination
          */
-        post: operations["claim-ring-reward"];
+        get: operations["get-notifications"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/rings/today": {
+    "/v1/user/notifications/{id}": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /**
-         * Get today's ring state and score
-         * @description Returns the current day's ring progress, productivity score, streak, and reward availability
-         */
-        get: operations["get-rings-today"];
+        get?: never;
         put?: never;
         post?: never;
-        delete?: never;
+        /**
+         * Delete notification
+         * @description Delete a specific notification
+         */
+        delete: operations["delete-notification"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/settings": {
+    "/v1/user/notifications/read": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /**
-         * Get user settings
-         * @description Retrieve all settings for the authenticated user
-         */
-        get: operations["get-user-settings"];
+        get?: never;
         put?: never;
         post?: never;
         delete?: never;
         options?: never;
         head?: never;
         /**
-         * Update user settings
-         * @description Update settings for the authenticated user (partial updates supported)
+         * Mark notifications as read
+         * @description Mark specific notifications as read
          */
-        patch: operations["update-user-settings"];
+        patch: operations["mark-notifications-read"];
         trace?: never;
     };
-    "/v1/user/settings/personalization": {
+    "/v1/user/notifications/read-all": {
         parameters: {
             query?: never;
             header?: never;
@@ -2650,33 +2698,37 @@ export interface paths {
         options?: never;
         head?: never;
         /**
-         * Update personalization settings
-         * @description Turn personalization on or off, pause it until a given time, or opt in to letting friends be prompted when you are stuck. An omitted field is left unchanged; enabling also clears any active pause, but never turns struggle sharing on.
+         * Mark all notifications as read
+         * @description Mark all notifications as read for the authenticated user
          */
-        patch: operations["update-personalization-settings"];
+        patch: operations["mark-all-notifications-read"];
         trace?: never;
     };
-    "/v1/user/settings/personalization/data": {
+    "/v1/user/posts": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
+        /**
+         * Get all posts
+         * @description Retrieve all posts
+         */
+        get: operations["get-posts"];
         put?: never;
-        post?: never;
         /**
-         * Delete personalization data
-         * @description Erase everything Kindred has learned about the authenticated user and turn personalization off, so it is not rebuilt overnight.
+         * Create a new post
+         * @description Create a new post with the provided details
          */
-        delete: operations["delete-personalization-data"];
+        post: operations["create-post"];
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/settings/personalization/export": {
+    "/v1/user/posts/{id}": {
         parameters: {
             query?: never;
             header?: never;
@@ -2684,19 +2736,27 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Export personalization data
-         * @description Download everything Kindred has learned about the authenticated user. Prompt text is excluded; the structured evidence behind every fact is not.
+         * Get post by ID
+         * @description Retrieve a specific post by its ID
          */
-        get: operations["export-personalization-data"];
+        get: operations["get-post"];
         put?: never;
         post?: never;
-        delete?: never;
+        /**
+         * Delete post
+         * @description Delete an existing post
+         */
+        delete: operations["delete-post"];
         options?: never;
         head?: never;
-        patch?: never;
+        /**
+         * Update post
+         * @description Update an existing post
+         */
+        patch: operations["update-post"];
         trace?: never;
     };
-    "/v1/user/settings/personalization/stated": {
+    "/v1/user/posts/{postId}/comment": {
         parameters: {
             query?: never;
             header?: never;
@@ -2704,19 +2764,19 @@ export interface paths {
             cookie?: never;
         };
         get?: never;
+        put?: never;
         /**
-         * Save stated preferences
-         * @description Persist the answers collected during onboarding. These always win over what the nightly worker observes.
+         * Add comment to post
+         * @description Add a comment to an existing post
          */
-        put: operations["save-stated-preferences"];
-        post?: never;
+        post: operations["add-comment"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/settings/personalization/stated/{key}": {
+    "/v1/user/posts/{postId}/comment/{commentId}": {
         parameters: {
             query?: never;
             header?: never;
@@ -2726,37 +2786,41 @@ export interface paths {
         get?: never;
         put?: never;
         post?: never;
-        delete?: never;
+        /**
+         * Delete comment from post
+         * @description Delete comment from an existing post
+         */
+        delete: operations["delete-comment"];
         options?: never;
         head?: never;
         /**
-         * Correct one stated preference
-         * @description Change a single onboarding answer from the settings screen.
+         * Edit comment
+         * @description Edit the text and mentions of your own comment
          */
-        patch: operations["update-stated-preference"];
+        patch: operations["edit-comment"];
         trace?: never;
     };
-    "/v1/user/tagged-tasks": {
+    "/v1/user/posts/{postId}/comment/{commentId}/approve": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Get pending tagged tasks
-         * @description Tasks the signed-in user has been tagged in and not yet responded to
+         * Approve comment
+         * @description Publish a pending comment a restricted user left on your post
          */
-        get: operations["get-pending-tagged-tasks"];
-        put?: never;
-        post?: never;
+        post: operations["approve-comment"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tagged-tasks/{id}/respond": {
+    "/v1/user/posts/{postId}/comment/{commentId}/reaction": {
         parameters: {
             query?: never;
             header?: never;
@@ -2766,17 +2830,17 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * Respond to a task tag
-         * @description Watch, copy, or untag yourself from a task you were tagged in
+         * React to a comment
+         * @description Adds or removes an emoji reaction on a comment
          */
-        post: operations["respond-to-task-tag"];
+        post: operations["toggle-comment-reaction"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tags": {
+    "/v1/user/posts/{postId}/comments": {
         parameters: {
             query?: never;
             header?: never;
@@ -2784,10 +2848,10 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get user tags
-         * @description Retrieve the distinct list of category tags for the authenticated user
+         * Get comments
+         * @description Page through a post's root comments newest first, or through one thread's replies oldest first
          */
-        get: operations["get-user-tags"];
+        get: operations["get-comments"];
         put?: never;
         post?: never;
         delete?: never;
@@ -2796,131 +2860,127 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/": {
+    "/v1/user/posts/{postId}/reaction": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Get tasks by user
-         * @description Retrieve tasks for a specific user with optional sorting
+         * React to a post
+         * @description Adds a reaction to a post
          */
-        get: operations["get-tasks-by-user"];
-        put?: never;
-        post?: never;
+        post: operations["add-reaction"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/{category}": {
+    "/v1/user/posts/blueprint/{blueprintId}": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Create a new task
-         * @description Create a new task in a specific category
+         * Get posts by blueprint
+         * @description Retrieve all posts associated with a specific blueprint
          */
-        post: operations["create-task"];
+        get: operations["get-posts-by-blueprint"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/{category}/{id}": {
+    "/v1/user/posts/friends": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
-        post?: never;
         /**
-         * Delete task
-         * @description Delete a task record
+         * Get friends posts
+         * @description Retrieve posts from user's friends, ordered chronologically
          */
-        delete: operations["delete-task"];
+        get: operations["get-friends-posts"];
+        put?: never;
+        post?: never;
+        delete?: never;
         options?: never;
         head?: never;
-        /**
-         * Update task
-         * @description Update a task record
-         */
-        patch: operations["update-task"];
+        patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/{category}/{id}/checklist": {
+    "/v1/user/posts/groups": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Update task checklist
-         * @description Update the checklist field of a task
+         * Get user groups
+         * @description Retrieve all groups where user is creator or member
          */
-        post: operations["update-task-checklist"];
+        get: operations["get-user-groups"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/{category}/{id}/move": {
+    "/v1/user/profiles/{id}": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Move task to another category
-         * @description Move a task from its current category to a different category. The task lands at the top of the target category, and any recurring template is re-pointed so future instances generate in the target.
+         * Get profile by ID
+         * @description Retrieve a specific user profile by its ID
          */
-        post: operations["move-task"];
+        get: operations["get-profile"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/{category}/{id}/notes": {
+    "/v1/user/profiles/autocomplete": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Update task notes
-         * @description Update the notes field of a task
+         * Autocomplete profiles
+         * @description Get autocomplete suggestions for user profiles
          */
-        post: operations["update-task-notes"];
+        get: operations["autocomplete-profiles"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/{category}/{id}/progress": {
+    "/v1/user/profiles/find-by-phone": {
         parameters: {
             query?: never;
             header?: never;
@@ -2930,77 +2990,77 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * Log progress on a task
-         * @description Records a Sessions progress entry (Log Progress) for a task without completing or archiving it
+         * Find users by phone numbers
+         * @description Efficiently find users matching any of the provided phone numbers using a single database query
          */
-        post: operations["log-progress"];
+        post: operations["find-users-by-phone-numbers"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/{category}/{id}/tags": {
+    "/v1/user/profiles/search": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
+        /**
+         * Search profiles
+         * @description Search for user profiles by query string
+         */
+        get: operations["search-profiles"];
         put?: never;
         post?: never;
         delete?: never;
         options?: never;
         head?: never;
-        /**
-         * Update task tags
-         * @description Replace the set of friends tagged on a task
-         */
-        patch: operations["update-task-tags"];
+        patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/{id}/progress": {
+    "/v1/user/pushtoken": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Get task progress history
-         * @description Retrieve the Sessions progress-log history for a task
+         * Update push token
+         * @description Update user's push notification token
          */
-        get: operations["get-task-progress"];
-        put?: never;
-        post?: never;
+        post: operations["update-push-token"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/active/{category}/{id}": {
+    "/v1/user/referrals": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Activate/deactivate task
-         * @description Change the active status of a task
+         * Get referral information
+         * @description Returns the current user's referral code, unlocks, and referred users
          */
-        post: operations["activate-task"];
+        get: operations["get-referral-info"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/bulk/complete": {
+    "/v1/user/referrals/apply": {
         parameters: {
             query?: never;
             header?: never;
@@ -3010,37 +3070,37 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * Bulk complete tasks
-         * @description Mark multiple tasks as completed in a single request
+         * Apply a referral code
+         * @description Apply a referral code to your account (only works if you haven't been referred yet)
          */
-        post: operations["bulk-complete-tasks"];
+        post: operations["apply-referral-code"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/bulk/delete": {
+    "/v1/user/referrals/stats": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Bulk delete tasks
-         * @description Delete multiple tasks in a single request
+         * Get referral statistics
+         * @description Returns detailed statistics about referrals and unlocked features
          */
-        post: operations["bulk-delete-tasks"];
+        get: operations["get-referral-stats"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/complete/{category}/{id}": {
+    "/v1/user/referrals/unlock": {
         parameters: {
             query?: never;
             header?: never;
@@ -3050,57 +3110,57 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * Complete task
-         * @description Mark a task as completed
+         * Unlock a feature
+         * @description Use a referral unlock to activate a premium feature
          */
-        post: operations["complete-task"];
+        post: operations["unlock-feature"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/completed": {
+    "/v1/user/reports/comment/{commentId}": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Get completed tasks
-         * @description Retrieve all completed tasks for the logged in user
+         * Report a comment
+         * @description Report a comment for inappropriate content, spam, harassment, or other violations
          */
-        get: operations["get-completed-tasks"];
-        put?: never;
-        post?: never;
+        post: operations["report-comment"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/completed/date": {
+    "/v1/user/reports/post/{postId}": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Get completed tasks by date
-         * @description Retrieve completed tasks for a specific date
+         * Report a post
+         * @description Report a post for inappropriate content, spam, harassment, or other violations
          */
-        get: operations["get-completed-tasks-by-date"];
-        put?: never;
-        post?: never;
+        post: operations["report-post"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/log": {
+    "/v1/user/rewards/redeem": {
         parameters: {
             query?: never;
             header?: never;
@@ -3110,37 +3170,37 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * Log completed tasks
-         * @description Create and immediately complete tasks in the workspace's Logged category. Used by the end-of-day review card for work that was done but never tracked.
+         * Redeem a kudos reward
+         * @description Redeem a reward using accumulated kudos from sending encouragements and congratulations
          */
-        post: operations["log-tasks"];
+        post: operations["redeem-reward"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/natural-language": {
+    "/v1/user/rings/history": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Create tasks from natural language
-         * @description Process natural language text to create multiple tasks and categories using AI
+         * Get ring history
+         * @description Returns ring state history for the specified number of days (default 7, max 30)
          */
-        post: operations["create-task-natural-language"];
+        get: operations["get-rings-history"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/natural-language/confirm": {
+    "/v1/user/rings/reward": {
         parameters: {
             query?: never;
             header?: never;
@@ -3150,77 +3210,61 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * Create tasks from preview payload
-         * @description Create tasks and categories using a previously generated preview payload
+         * Claim daily ring reward
+         * @description Claims the daily reward when all three rings are closed
          */
-        post: operations["confirm-task-natural-language"];
+        post: operations["claim-ring-reward"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/natural-language/edit": {
+    "/v1/user/rings/today": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Edit tasks using natural language
-         * @description Use AI to find and edit one or more tasks based on a natural language instruction
+         * Get today's ring state and score
+         * @description Returns the current day's ring progress, productivity score, streak, and reward availability
          */
-        post: operations["edit-tasks-natural-language"];
+        get: operations["get-rings-today"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/natural-language/image-preview": {
+    "/v1/user/settings": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Preview tasks extracted from an image
-         * @description Process an image (e.g. a photo of a whiteboard or to-do list) and return a preview without creating tasks. Use /confirm with the returned payload to create them.
+         * Get user settings
+         * @description Retrieve all settings for the authenticated user
          */
-        post: operations["preview-task-from-image"];
+        get: operations["get-user-settings"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
-        patch?: never;
-        trace?: never;
-    };
-    "/v1/user/tasks/natural-language/intent": {
-        parameters: {
-            query?: never;
-            header?: never;
-            path?: never;
-            cookie?: never;
-        };
-        get?: never;
-        put?: never;
         /**
-         * Unified natural language intent router
-         * @description Decompose a natural language utterance into create/edit/delete operations. Edit ops are applied immediately; create and delete payloads are returned for frontend confirmation.
+         * Update user settings
+         * @description Update settings for the authenticated user (partial updates supported)
          */
-        post: operations["intent-task-natural-language"];
-        delete?: never;
-        options?: never;
-        head?: never;
-        patch?: never;
+        patch: operations["update-user-settings"];
         trace?: never;
     };
-    "/v1/user/tasks/natural-language/preview": {
+    "/v1/user/settings/personalization": {
         parameters: {
             query?: never;
             header?: never;
@@ -3229,18 +3273,18 @@ export interface paths {
         };
         get?: never;
         put?: never;
-        /**
-         * Preview tasks from natural language
-         * @description Process natural language text and return a preview without creating tasks
-         */
-        post: operations["preview-task-natural-language"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
-        patch?: never;
+        /**
+         * Update personalization settings
+         * @description Turn personalization on or off, pause it until a given time, or opt in to letting friends be prompted when you are stuck. An omitted field is left unchanged; enabling also clears any active pause, but never turns struggle sharing on.
+         */
+        patch: operations["update-personalization-settings"];
         trace?: never;
     };
-    "/v1/user/tasks/natural-language/query": {
+    "/v1/user/settings/personalization/data": {
         parameters: {
             query?: never;
             header?: never;
@@ -3249,38 +3293,38 @@ export interface paths {
         };
         get?: never;
         put?: never;
+        post?: never;
         /**
-         * Query tasks using natural language
-         * @description Convert natural language to structured filters and return matching tasks using AI
+         * Delete personalization data
+         * @description Erase everything Kindred has learned about the authenticated user and turn personalization off, so it is not rebuilt overnight.
          */
-        post: operations["query-tasks-natural-language"];
-        delete?: never;
+        delete: operations["delete-personalization-data"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/query": {
+    "/v1/user/settings/personalization/export": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Query tasks with filters
-         * @description Query tasks for the authenticated user with advanced filtering options
+         * Export personalization data
+         * @description Download everything Kindred has learned about the authenticated user. Prompt text is excluded; the structured evidence behind every fact is not.
          */
-        post: operations["query-tasks-by-user"];
+        get: operations["export-personalization-data"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/suggest": {
+    "/v1/user/settings/personalization/stated": {
         parameters: {
             query?: never;
             header?: never;
@@ -3288,67 +3332,59 @@ export interface paths {
             cookie?: never;
         };
         get?: never;
-        put?: never;
         /**
-         * Suggest fields for a task being typed
-         * @description Infer category, priority and difficulty from partial task text. Additive: returns an empty suggestion instead of an error when AI is unavailable, and consumes no credits.
+         * Save stated preferences
+         * @description Persist the answers collected during onboarding. These always win over what the nightly worker observes.
          */
-        post: operations["suggest-task-fields"];
+        put: operations["save-stated-preferences"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/template/{id}": {
+    "/v1/user/settings/personalization/stated/{key}": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /**
-         * Get template by ID
-         * @description Retrieve a template by its ID
-         */
-        get: operations["get-template-by-id"];
+        get?: never;
         put?: never;
-        /**
-         * Create task from template
-         * @description Create a new task based on a template
-         */
-        post: operations["create-task-from-template"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         /**
-         * Update template task
-         * @description Update a template task for recurring tasks
+         * Correct one stated preference
+         * @description Change a single onboarding answer from the settings screen.
          */
-        patch: operations["update-template"];
+        patch: operations["update-stated-preference"];
         trace?: never;
     };
-    "/v1/user/tasks/template/{id}/reset": {
+    "/v1/user/tagged-tasks": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Reset template metrics
-         * @description Reset streak, completion, and missed counts for a recurring task template
+         * Get pending tagged tasks
+         * @description Tasks the signed-in user has been tagged in and not yet responded to
          */
-        post: operations["reset-template-metrics"];
+        get: operations["get-pending-tagged-tasks"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/template/{id}/undo-missed": {
+    "/v1/user/tagged-tasks/{id}/respond": {
         parameters: {
             query?: never;
             header?: never;
@@ -3358,17 +3394,17 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * Undo a missed recurring task
-         * @description Retroactively mark a recently missed recurring task as completed, restoring the streak. Must be used within 24 hours of the miss.
+         * Respond to a task tag
+         * @description Watch, copy, or untag yourself from a task you were tagged in
          */
-        post: operations["undo-missed-task"];
+        post: operations["respond-to-task-tag"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/template/old": {
+    "/v1/user/tags": {
         parameters: {
             query?: never;
             header?: never;
@@ -3376,10 +3412,10 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get tasks with old start times
-         * @description Get all tasks with start times older than one day
+         * Get user tags
+         * @description Retrieve the distinct list of category tags for the authenticated user
          */
-        get: operations["get-tasks-with-old-start-times"];
+        get: operations["get-user-tags"];
         put?: never;
         post?: never;
         delete?: never;
@@ -3388,7 +3424,7 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/template/pastDeadline": {
+    "/v1/user/tasks/": {
         parameters: {
             query?: never;
             header?: never;
@@ -3396,10 +3432,10 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get recurring tasks with past deadlines
-         * @description Get all recurring tasks that have past their deadlines
+         * Get tasks by user
+         * @description Retrieve tasks for a specific user with optional sorting
          */
-        get: operations["get-recurring-tasks-with-past-deadlines"];
+        get: operations["get-tasks-by-user"];
         put?: never;
         post?: never;
         delete?: never;
@@ -3408,27 +3444,27 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/templates": {
+    "/v1/user/tasks/{category}": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Get user's template tasks with category names
-         * @description Retrieve all template tasks for the authenticated user with their category names
+         * Create a new task
+         * @description Create a new task in a specific category
          */
-        get: operations["get-user-templates"];
-        put?: never;
-        post?: never;
+        post: operations["create-task"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/tasks/working/{category}/{id}": {
+    "/v1/user/tasks/{category}/{id}": {
         parameters: {
             query?: never;
             header?: never;
@@ -3437,18 +3473,22 @@ export interface paths {
         };
         get?: never;
         put?: never;
+        post?: never;
         /**
-         * Start or stop working on a task
-         * @description Set the working state of a task (tracks when user started working)
+         * Delete task
+         * @description Delete a task record
          */
-        post: operations["start-working"];
-        delete?: never;
+        delete: operations["delete-task"];
         options?: never;
         head?: never;
-        patch?: never;
+        /**
+         * Update task
+         * @description Update a task record
+         */
+        patch: operations["update-task"];
         trace?: never;
     };
-    "/v1/user/timezone": {
+    "/v1/user/tasks/{category}/{id}/checklist": {
         parameters: {
             query?: never;
             header?: never;
@@ -3458,81 +3498,77 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * Update timezone
-         * @description Update the authenticated user's timezone
+         * Update task checklist
+         * @description Update the checklist field of a task
          */
-        post: operations["update-timezone"];
+        post: operations["update-task-checklist"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/waitlist": {
+    "/v1/user/tasks/{category}/{id}/move": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Get all waitlist entries
-         * @description Retrieve all waitlist entries (admin only)
+         * Move task to another category
+         * @description Move a task from its current category to a different category. The task lands at the top of the target category, and any recurring template is re-pointed so future instances generate in the target.
          */
-        get: operations["get-waitlists"];
-        put?: never;
-        post?: never;
+        post: operations["move-task"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/waitlist/{id}": {
+    "/v1/user/tasks/{category}/{id}/notes": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /**
-         * Get waitlist entry by ID
-         * @description Retrieve a specific waitlist entry by its ID
-         */
-        get: operations["get-waitlist"];
+        get?: never;
         put?: never;
-        post?: never;
         /**
-         * Delete waitlist entry
-         * @description Remove a waitlist entry by its ID
+         * Update task notes
+         * @description Update the notes field of a task
          */
-        delete: operations["delete-waitlist"];
+        post: operations["update-task-notes"];
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/workspaces": {
+    "/v1/user/tasks/{category}/{id}/progress": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Get user workspaces
-         * @description Retrieve all workspaces for the authenticated user
+         * Log progress on a task
+         * @description Records a Sessions progress entry (Log Progress) for a task without completing or archiving it
          */
-        get: operations["get-workspaces"];
-        put?: never;
-        post?: never;
+        post: operations["log-progress"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/user/workspaces/{name}": {
+    "/v1/user/tasks/{category}/{id}/tags": {
         parameters: {
             query?: never;
             header?: never;
@@ -3546,33 +3582,33 @@ export interface paths {
         options?: never;
         head?: never;
         /**
-         * Update workspace metadata
-         * @description Update icon and color for a workspace
+         * Update task tags
+         * @description Replace the set of friends tagged on a task
          */
-        patch: operations["update-workspace"];
+        patch: operations["update-task-tags"];
         trace?: never;
     };
-    "/v1/user/workspaces/{name}/push-enabled": {
+    "/v1/user/tasks/{id}/progress": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
+        /**
+         * Get task progress history
+         * @description Retrieve the Sessions progress-log history for a task
+         */
+        get: operations["get-task-progress"];
         put?: never;
         post?: never;
         delete?: never;
         options?: never;
         head?: never;
-        /**
-         * Set push-to-calendar for a workspace
-         * @description Flips push_enabled on every calendar-integrated category in the workspace.
-         */
-        patch: operations["set-workspace-push-enabled"];
+        patch?: never;
         trace?: never;
     };
-    "/v1/users/batch": {
+    "/v1/user/tasks/active/{category}/{id}": {
         parameters: {
             query?: never;
             header?: never;
@@ -3582,17 +3618,17 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * Get users by IDs
-         * @description Resolves a list of user IDs to public profile references in a single query.
+         * Activate/deactivate task
+         * @description Change the active status of a task
          */
-        post: operations["get-users-by-ids"];
+        post: operations["activate-task"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/waitlist": {
+    "/v1/user/tasks/bulk/complete": {
         parameters: {
             query?: never;
             header?: never;
@@ -3602,17 +3638,17 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * Create a new waitlist entry
-         * @description Add a new email to the waitlist
+         * Bulk complete tasks
+         * @description Mark multiple tasks as completed in a single request
          */
-        post: operations["create-waitlist"];
+        post: operations["bulk-complete-tasks"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/v1/webhooks/revenuecat": {
+    "/v1/user/tasks/bulk/delete": {
         parameters: {
             query?: never;
             header?: never;
@@ -3622,28 +3658,48 @@ export interface paths {
         get?: never;
         put?: never;
         /**
-         * RevenueCat Webhook
-         * @description Receives subscription lifecycle events from RevenueCat
+         * Bulk delete tasks
+         * @description Delete multiple tasks in a single request
          */
-        post: operations["revenuecat-webhook"];
+        post: operations["bulk-delete-tasks"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/welcome": {
+    "/v1/user/tasks/complete/{category}/{id}": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Welcome endpoint
-         * @description Returns a welcome message
+         * Complete task
+         * @description Mark a task as completed
          */
-        get: operations["get-welcome"];
+        post: operations["complete-task"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/completed": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Get completed tasks
+         * @description Retrieve all completed tasks for the logged in user
+         */
+        get: operations["get-completed-tasks"];
         put?: never;
         post?: never;
         delete?: never;
@@ -3652,1259 +3708,1529 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-}
-export type webhooks = Record<string, never>;
-export interface components {
-    schemas: {
-        AcceptConnectionOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/AcceptConnectionOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Connection request accepted successfully */
-            message: string;
-        };
-        AcceptTermsOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/AcceptTermsOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Terms accepted successfully */
-            message: string;
-            terms_accepted_at: string;
-            terms_version: string;
-        };
-        AcceptTermsRequest: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/AcceptTermsRequest.json
-             */
-            readonly $schema?: string;
-            /**
-             * @description Version of terms being accepted
-             * @example 1.0
-             */
-            terms_version: string;
+    "/v1/user/tasks/completed/date": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        ActivateTaskOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ActivateTaskOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Task activation status updated successfully */
-            message: string;
+        /**
+         * Get completed tasks by date
+         * @description Retrieve completed tasks for a specific date
+         */
+        get: operations["get-completed-tasks-by-date"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/log": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        ActivityDay: {
-            /** Format: int64 */
-            count: number;
-            /** Format: int64 */
-            day: number;
-            /** Format: int64 */
-            level: number;
+        get?: never;
+        put?: never;
+        /**
+         * Log completed tasks
+         * @description Create and immediately complete tasks in the workspace's Logged category. Used by the end-of-day review card for work that was done but never tracked.
+         */
+        post: operations["log-tasks"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/natural-language": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        ActivityDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ActivityDocument.json
-             */
-            readonly $schema?: string;
-            _id: string;
-            days: components["schemas"]["ActivityDay"][];
-            /** Format: date-time */
-            lastUpdated: string;
-            /** Format: int64 */
-            month: number;
-            /** Format: int64 */
-            totalCount: number;
-            user: string;
-            /** Format: int64 */
-            year: number;
+        get?: never;
+        put?: never;
+        /**
+         * Create tasks from natural language
+         * @description Process natural language text to create multiple tasks and categories using AI
+         */
+        post: operations["create-task-natural-language"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/natural-language/confirm": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AddCommentOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/AddCommentOutputBody.json
-             */
-            readonly $schema?: string;
-            comment: components["schemas"]["CommentDocumentAPI"];
-            /** @description Describes the friendship score bump with the post owner so the client can render feedback */
-            friendshipDelta?: components["schemas"]["Delta"];
-            /** @example Comment added successfully */
-            message: string;
+        get?: never;
+        put?: never;
+        /**
+         * Create tasks from preview payload
+         * @description Create tasks and categories using a previously generated preview payload
+         */
+        post: operations["confirm-task-natural-language"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/natural-language/edit": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AddCommentParams: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/AddCommentParams.json
-             */
-            readonly $schema?: string;
-            content: string;
-            mentions?: components["schemas"]["MentionInput"][];
-            parentId?: string;
+        get?: never;
+        put?: never;
+        /**
+         * Edit tasks using natural language
+         * @description Use AI to find and edit one or more tasks based on a natural language instruction
+         */
+        post: operations["edit-tasks-natural-language"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/natural-language/image-preview": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AddMemberOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/AddMemberOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Member added successfully */
-            message: string;
+        get?: never;
+        put?: never;
+        /**
+         * Preview tasks extracted from an image
+         * @description Process an image (e.g. a photo of a whiteboard or to-do list) and return a preview without creating tasks. Use /confirm with the returned payload to create them.
+         */
+        post: operations["preview-task-from-image"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/natural-language/intent": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AddMemberParams: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/AddMemberParams.json
-             */
-            readonly $schema?: string;
-            userId: string;
-        };
-        AddReactionOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/AddReactionOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example true */
-            added: boolean;
-            /** @example Reaction added successfully */
-            message: string;
+        get?: never;
+        put?: never;
+        /**
+         * Unified natural language intent router
+         * @description Decompose a natural language utterance into create/edit/delete operations. Edit ops are applied immediately; create and delete payloads are returned for frontend confirmation.
+         */
+        post: operations["intent-task-natural-language"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/natural-language/preview": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AddReactionParams: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/AddReactionParams.json
-             */
-            readonly $schema?: string;
-            emoji: string;
+        get?: never;
+        put?: never;
+        /**
+         * Preview tasks from natural language
+         * @description Process natural language text and return a preview without creating tasks
+         */
+        post: operations["preview-task-natural-language"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/natural-language/query": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsAttention: {
-            tasks: components["schemas"]["AnalyticsAttentionTask"][];
+        get?: never;
+        put?: never;
+        /**
+         * Query tasks using natural language
+         * @description Convert natural language to structured filters and return matching tasks using AI
+         */
+        post: operations["query-tasks-natural-language"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/query": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsAttentionTask: {
-            category: string;
-            categoryId: string;
-            /** Format: int64 */
-            daysOpen: number;
-            deadline?: string;
-            id: string;
-            reasons: string[];
-            title: string;
-            workspace: string;
+        get?: never;
+        put?: never;
+        /**
+         * Query tasks with filters
+         * @description Query tasks for the authenticated user with advanced filtering options
+         */
+        post: operations["query-tasks-by-user"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/suggest": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsBestTime: {
-            cells: components["schemas"]["AnalyticsBestTimeCell"][];
-            /** Format: int64 */
-            maxCount: number;
-            takeaway: string;
+        get?: never;
+        put?: never;
+        /**
+         * Suggest fields for a task being typed
+         * @description Infer category, priority and difficulty from partial task text. Additive: returns an empty suggestion instead of an error when AI is unavailable, and consumes no credits.
+         */
+        post: operations["suggest-task-fields"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/template/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsBestTimeCell: {
-            /** Format: int64 */
-            count: number;
-            /** Format: int64 */
-            hour: number;
-            /** Format: int64 */
-            level: number;
-            /** Format: int64 */
-            weekday: number;
+        /**
+         * Get template by ID
+         * @description Retrieve a template by its ID
+         */
+        get: operations["get-template-by-id"];
+        put?: never;
+        /**
+         * Create task from template
+         * @description Create a new task based on a template
+         */
+        post: operations["create-task-from-template"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        /**
+         * Update template task
+         * @description Update a template task for recurring tasks
+         */
+        patch: operations["update-template"];
+        trace?: never;
+    };
+    "/v1/user/tasks/template/{id}/reset": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsCategoryHealth: {
-            rows: components["schemas"]["AnalyticsCategoryHealthRow"][];
+        get?: never;
+        put?: never;
+        /**
+         * Reset template metrics
+         * @description Reset streak, completion, and missed counts for a recurring task template
+         */
+        post: operations["reset-template-metrics"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/template/{id}/undo-missed": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsCategoryHealthRow: {
-            categoryId: string;
-            color: string;
-            /** Format: int64 */
-            done: number;
-            /** Format: int64 */
-            kudos: number;
-            name: string;
-            /** Format: int64 */
-            onTimePct: number;
-            sparkline: number[];
-            status: string;
-            workspace: string;
+        get?: never;
+        put?: never;
+        /**
+         * Undo a missed recurring task
+         * @description Retroactively mark a recently missed recurring task as completed, restoring the streak. Must be used within 24 hours of the miss.
+         */
+        post: operations["undo-missed-task"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/template/old": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsCategoryShare: {
-            overTime: components["schemas"]["AnalyticsShareBand"][];
-            slices: components["schemas"]["AnalyticsShareSlice"][];
-            takeaway: string;
+        /**
+         * Get tasks with old start times
+         * @description Get all tasks with start times older than one day
+         */
+        get: operations["get-tasks-with-old-start-times"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/template/pastDeadline": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsHabitRow: {
-            /** Format: int64 */
-            completed: number;
-            dots: boolean[];
-            frequency: string;
-            nextDueAt?: string;
-            rhythmLabel: string;
-            status: string;
-            templateId: string;
-            title: string;
-            /** Format: int64 */
-            total: number;
-        };
-        AnalyticsHabits: {
-            rows: components["schemas"]["AnalyticsHabitRow"][];
-            takeaway: string;
+        /**
+         * Get recurring tasks with past deadlines
+         * @description Get all recurring tasks that have past their deadlines
+         */
+        get: operations["get-recurring-tasks-with-past-deadlines"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/templates": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsHeatmap: {
-            days: components["schemas"]["AnalyticsHeatmapDay"][];
-            /** Format: int64 */
-            maxCount: number;
-            takeaway: string;
-            /** Format: int64 */
-            total: number;
+        /**
+         * Get user's template tasks with category names
+         * @description Retrieve all template tasks for the authenticated user with their category names
+         */
+        get: operations["get-user-templates"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/tasks/working/{category}/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsHeatmapDay: {
-            /** Format: int64 */
-            count: number;
-            date: string;
-            /** Format: int64 */
-            level: number;
+        get?: never;
+        put?: never;
+        /**
+         * Start or stop working on a task
+         * @description Set the working state of a task (tracks when user started working)
+         */
+        post: operations["start-working"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/timezone": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsKudosEffect: {
-            hasComparison: boolean;
-            takeaway: string;
-            /** Format: int64 */
-            withCount: number;
-            /** Format: double */
-            withKudosMedianHours: number;
-            /** Format: int64 */
-            withoutCount: number;
-            /** Format: double */
-            withoutKudosMedianHours: number;
+        get?: never;
+        put?: never;
+        /**
+         * Update timezone
+         * @description Update the authenticated user's timezone
+         */
+        post: operations["update-timezone"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/waitlist": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsLayout: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/AnalyticsLayout.json
-             */
-            readonly $schema?: string;
-            hidden: string[];
-            order: string[];
+        /**
+         * Get all waitlist entries
+         * @description Retrieve all waitlist entries (admin only)
+         */
+        get: operations["get-waitlists"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/waitlist/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsLegendItem: {
-            categoryId: string;
-            color: string;
-            name: string;
+        /**
+         * Get waitlist entry by ID
+         * @description Retrieve a specific waitlist entry by its ID
+         */
+        get: operations["get-waitlist"];
+        put?: never;
+        post?: never;
+        /**
+         * Delete waitlist entry
+         * @description Remove a waitlist entry by its ID
+         */
+        delete: operations["delete-waitlist"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/workspaces": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsProgress: {
-            bucketUnit: string;
-            buckets: components["schemas"]["AnalyticsProgressBucket"][];
-            /** Format: double */
-            delta: number;
-            legend: components["schemas"]["AnalyticsLegendItem"][];
-            /** Format: int64 */
-            prevTotal: number;
-            takeaway: string;
-            /** Format: int64 */
-            total: number;
+        /**
+         * Get user workspaces
+         * @description Retrieve all workspaces for the authenticated user
+         */
+        get: operations["get-workspaces"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/user/workspaces/{name}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsProgressBucket: {
-            date: string;
-            label: string;
-            segments: components["schemas"]["AnalyticsProgressSegment"][];
-            /** Format: int64 */
-            total: number;
+        get?: never;
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        /**
+         * Update workspace metadata
+         * @description Update icon and color for a workspace
+         */
+        patch: operations["update-workspace"];
+        trace?: never;
+    };
+    "/v1/user/workspaces/{name}/push-enabled": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsProgressSegment: {
-            categoryId: string;
-            color: string;
-            /** Format: int64 */
-            count: number;
-            name: string;
+        get?: never;
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        /**
+         * Set push-to-calendar for a workspace
+         * @description Flips push_enabled on every calendar-integrated category in the workspace.
+         */
+        patch: operations["set-workspace-push-enabled"];
+        trace?: never;
+    };
+    "/v1/users/batch": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsResponse: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/AnalyticsResponse.json
-             */
-            readonly $schema?: string;
-            attention: components["schemas"]["AnalyticsAttention"];
-            bestTime: components["schemas"]["AnalyticsBestTime"];
-            categoryFilter?: string;
-            categoryHealth: components["schemas"]["AnalyticsCategoryHealth"];
-            categoryShare: components["schemas"]["AnalyticsCategoryShare"];
-            /** Format: date-time */
-            generatedAt: string;
-            habits: components["schemas"]["AnalyticsHabits"];
-            heatmap: components["schemas"]["AnalyticsHeatmap"];
-            kudosEffect: components["schemas"]["AnalyticsKudosEffect"];
-            progress: components["schemas"]["AnalyticsProgress"];
-            range: string;
-            signals: components["schemas"]["AnalyticsSignals"];
-            supportCoverage: components["schemas"]["AnalyticsSupportCoverage"];
-            topSupporters: components["schemas"]["AnalyticsSupporter"][];
-            workspaceFilter?: string;
-            workspaceHealth: components["schemas"]["AnalyticsWorkspaceHealth"];
-        };
-        AnalyticsShareBand: {
-            label: string;
-            slices: components["schemas"]["AnalyticsShareSlice"][];
+        get?: never;
+        put?: never;
+        /**
+         * Get users by IDs
+         * @description Resolves a list of user IDs to public profile references in a single query.
+         */
+        post: operations["get-users-by-ids"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/waitlist": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsShareSlice: {
-            categoryId: string;
-            color: string;
-            /** Format: int64 */
-            count: number;
-            name: string;
-            /** Format: double */
-            pct: number;
+        get?: never;
+        put?: never;
+        /**
+         * Create a new waitlist entry
+         * @description Add a new email to the waitlist
+         */
+        post: operations["create-waitlist"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/webhooks/revenuecat": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsSignal: {
-            /** Format: double */
-            delta: number;
-            deltaLabel: string;
-            direction: string;
-            label: string;
-            /** Format: double */
-            rawValue: number;
-            value: string;
+        get?: never;
+        put?: never;
+        /**
+         * RevenueCat Webhook
+         * @description Receives subscription lifecycle events from RevenueCat
+         */
+        post: operations["revenuecat-webhook"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/welcome": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        AnalyticsSignals: {
-            momentum: components["schemas"]["AnalyticsSignal"];
-            support: components["schemas"]["AnalyticsSignal"];
-            timing: components["schemas"]["AnalyticsSignal"];
+        /**
+         * Welcome endpoint
+         * @description Returns a welcome message
+         */
+        get: operations["get-welcome"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+}
+export type webhooks = Record<string, never>;
+export interface components {
+    schemas: {
+        AcceptConnectionOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/AcceptConnectionOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Connection request accepted successfully */
+            message: string;
         };
-        AnalyticsSupportCoverage: {
-            /** Format: int64 */
-            pct: number;
-            /** Format: int64 */
-            supported: number;
-            takeaway: string;
-            /** Format: int64 */
-            total: number;
+        AcceptTermsOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/AcceptTermsOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Terms accepted successfully */
+            message: string;
+            terms_accepted_at: string;
+            terms_version: string;
         };
-        AnalyticsSupporter: {
-            /** Format: int64 */
-            count: number;
-            icon: string;
-            id: string;
-            name: string;
+        AcceptTermsRequest: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/AcceptTermsRequest.json
+             */
+            readonly $schema?: string;
+            /**
+             * @description Version of terms being accepted
+             * @example 1.0
+             */
+            terms_version: string;
         };
-        AnalyticsWorkspaceHealth: {
-            rows: components["schemas"]["AnalyticsWorkspaceHealthRow"][];
+        ActivateTaskOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ActivateTaskOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Task activation status updated successfully */
+            message: string;
         };
-        AnalyticsWorkspaceHealthRow: {
+        ActivityDay: {
             /** Format: int64 */
-            done: number;
+            count: number;
             /** Format: int64 */
-            kudos: number;
+            day: number;
             /** Format: int64 */
-            onTimePct: number;
-            status: string;
-            workspace: string;
+            level: number;
         };
-        ApplyReferralCodeInputBody: {
+        ActivityDocument: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ApplyReferralCodeInputBody.json
+             * @example https://example.com/schemas/ActivityDocument.json
              */
             readonly $schema?: string;
-            referralCode: string;
+            _id: string;
+            days: components["schemas"]["ActivityDay"][];
+            /** Format: date-time */
+            lastUpdated: string;
+            /** Format: int64 */
+            month: number;
+            /** Format: int64 */
+            totalCount: number;
+            user: string;
+            /** Format: int64 */
+            year: number;
         };
-        ApplyReferralCodeOutputBody: {
+        AddCommentOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ApplyReferralCodeOutputBody.json
+             * @example https://example.com/schemas/AddCommentOutputBody.json
              */
             readonly $schema?: string;
+            comment: components["schemas"]["CommentDocumentAPI"];
+            /** @description Describes the friendship score bump with the post owner so the client can render feedback */
+            friendshipDelta?: components["schemas"]["Delta"];
+            /** @example Comment added successfully */
             message: string;
-            referrer?: components["schemas"]["ReferrerInfo"];
-            success: boolean;
         };
-        BlockUserOutputBody: {
+        AddCommentParams: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/BlockUserOutputBody.json
+             * @example https://example.com/schemas/AddCommentParams.json
              */
             readonly $schema?: string;
-            /** @example User blocked successfully */
-            message: string;
+            content: string;
+            mentions?: components["schemas"]["MentionInput"][];
+            parentId?: string;
         };
-        BlueprintCategoryGroup: {
-            /** @description List of blueprints in this category */
-            blueprints: components["schemas"]["BlueprintDocument"][];
+        AddMemberOutputBody: {
             /**
-             * @description Category name (maps from aggregation _id)
-             * @example productivity
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/AddMemberOutputBody.json
              */
-            category: string;
+            readonly $schema?: string;
+            /** @example Member added successfully */
+            message: string;
+        };
+        AddMemberParams: {
             /**
-             * Format: int64
-             * @description Number of blueprints in this category
-             * @example 5
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/AddMemberParams.json
              */
-            count: number;
+            readonly $schema?: string;
+            userId: string;
         };
-        BlueprintDocument: {
+        AddReactionOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/BlueprintDocument.json
+             * @example https://example.com/schemas/AddReactionOutputBody.json
              */
             readonly $schema?: string;
+            /** @example true */
+            added: boolean;
+            /** @example Reaction added successfully */
+            message: string;
+        };
+        AddReactionParams: {
             /**
-             * @description Banner image URL
-             * @example https://example.com/banner.jpg
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/AddReactionParams.json
              */
-            banner: string;
-            /**
-             * @description Categories associated with the blueprint
-             * @example [
-             *       {
-             *         "id": "507f1f77bcf86cd799439011",
-             *         "lastEdited": "2023-01-01T00:00:00Z",
-             *         "name": "Productivity",
-             *         "user": "507f1f77bcf86cd799439012",
-             *         "workspaceName": "Personal"
-             *       }
-             *     ]
-             */
-            categories: components["schemas"]["CategoryDocument"][];
-            /**
-             * @description Category of the blueprint
-             * @example productivity
-             */
-            category: string;
-            /**
-             * @description Description of the blueprint
-             * @example A comprehensive morning routine
-             */
-            description: string;
-            /**
-             * @description Expected duration
-             * @example 30m
-             */
-            duration: string;
-            /**
-             * @description Unique identifier for the blueprint
-             * @example 507f1f77bcf86cd799439011
-             */
-            id: string;
-            /**
-             * @description Name of the blueprint
-             * @example Morning Routine
-             */
-            name: string;
-            /** @description Owner information */
-            owner: components["schemas"]["UserExtendedReference"];
-            /**
-             * @description List of subscriber user IDs
-             * @example [
-             *       "507f1f77bcf86cd799439012"
-             *     ]
-             */
-            subscribers: string[];
-            /**
-             * Format: int64
-             * @description Number of subscribers
-             * @example 42
-             */
-            subscribersCount: number;
-            /**
-             * @description Tags associated with the blueprint
-             * @example [
-             *       "productivity",
-             *       "morning"
-             *     ]
-             */
-            tags: string[];
-            /**
-             * Format: date-time
-             * @description Creation timestamp
-             * @example 2023-01-01T00:00:00Z
-             */
-            timestamp: string;
+            readonly $schema?: string;
+            emoji: string;
         };
-        BlueprintDocumentWithoutSubscribers: {
-            /**
-             * @description Banner image URL
-             * @example https://example.com/banner.jpg
-             */
-            banner: string;
-            /**
-             * @description Categories associated with the blueprint
-             * @example [
-             *       {
-             *         "id": "507f1f77bcf86cd799439011",
-             *         "name": "Productivity"
-             *       }
-             *     ]
-             */
-            categories: components["schemas"]["CategoryDocument"][];
-            /**
-             * @description Category of the blueprint
-             * @example productivity
-             */
+        AnalyticsAttention: {
+            tasks: components["schemas"]["AnalyticsAttentionTask"][];
+        };
+        AnalyticsAttentionTask: {
             category: string;
-            /**
-             * @description Description of the blueprint
-             * @example A comprehensive morning routine
-             */
-            description: string;
-            /**
-             * @description Expected duration
-             * @example 30m
-             */
-            duration: string;
-            /**
-             * @description Unique identifier for the blueprint
-             * @example 507f1f77bcf86cd799439011
-             */
+            categoryId: string;
+            /** Format: int64 */
+            daysOpen: number;
+            deadline?: string;
             id: string;
-            /**
-             * @description Name of the blueprint
-             * @example Morning Routine
-             */
+            reasons: string[];
+            title: string;
+            workspace: string;
+        };
+        AnalyticsBestTime: {
+            cells: components["schemas"]["AnalyticsBestTimeCell"][];
+            /** Format: int64 */
+            maxCount: number;
+            takeaway: string;
+        };
+        AnalyticsBestTimeCell: {
+            /** Format: int64 */
+            count: number;
+            /** Format: int64 */
+            hour: number;
+            /** Format: int64 */
+            level: number;
+            /** Format: int64 */
+            weekday: number;
+        };
+        AnalyticsCategoryHealth: {
+            rows: components["schemas"]["AnalyticsCategoryHealthRow"][];
+        };
+        AnalyticsCategoryHealthRow: {
+            categoryId: string;
+            color: string;
+            /** Format: int64 */
+            done: number;
+            /** Format: int64 */
+            kudos: number;
             name: string;
-            /** @description Owner information */
-            owner: components["schemas"]["UserExtendedReference"];
-            /**
-             * Format: int64
-             * @description Number of subscribers
-             * @example 42
-             */
-            subscribersCount: number;
-            /**
-             * @description Tags associated with the blueprint
-             * @example [
-             *       "productivity",
-             *       "morning"
-             *     ]
-             */
-            tags: string[];
-            /**
-             * Format: date-time
-             * @description Creation timestamp
-             * @example 2023-01-01T00:00:00Z
-             */
-            timestamp: string;
+            /** Format: int64 */
+            onTimePct: number;
+            sparkline: number[];
+            status: string;
+            workspace: string;
         };
-        BulkCompleteTaskInputBody: {
+        AnalyticsCategoryShare: {
+            overTime: components["schemas"]["AnalyticsShareBand"][];
+            slices: components["schemas"]["AnalyticsShareSlice"][];
+            takeaway: string;
+        };
+        AnalyticsHabitRow: {
+            /** Format: int64 */
+            completed: number;
+            dots: boolean[];
+            frequency: string;
+            nextDueAt?: string;
+            rhythmLabel: string;
+            status: string;
+            templateId: string;
+            title: string;
+            /** Format: int64 */
+            total: number;
+        };
+        AnalyticsHabits: {
+            rows: components["schemas"]["AnalyticsHabitRow"][];
+            takeaway: string;
+        };
+        AnalyticsHeatmap: {
+            days: components["schemas"]["AnalyticsHeatmapDay"][];
+            /** Format: int64 */
+            maxCount: number;
+            takeaway: string;
+            /** Format: int64 */
+            total: number;
+        };
+        AnalyticsHeatmapDay: {
+            /** Format: int64 */
+            count: number;
+            date: string;
+            /** Format: int64 */
+            level: number;
+        };
+        AnalyticsKudosEffect: {
+            hasComparison: boolean;
+            takeaway: string;
+            /** Format: int64 */
+            withCount: number;
+            /** Format: double */
+            withKudosMedianHours: number;
+            /** Format: int64 */
+            withoutCount: number;
+            /** Format: double */
+            withoutKudosMedianHours: number;
+        };
+        AnalyticsLayout: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/BulkCompleteTaskInputBody.json
+             * @example https://example.com/schemas/AnalyticsLayout.json
              */
             readonly $schema?: string;
-            /** @description Array of tasks to complete */
-            tasks: components["schemas"]["BulkCompleteTaskItem"][];
+            hidden: string[];
+            order: string[];
         };
-        BulkCompleteTaskItem: {
-            /**
-             * @description The ID of the category the task belongs to
-             * @example 507f1f77bcf86cd799439011
-             */
+        AnalyticsLegendItem: {
             categoryId: string;
-            /** @description The completion data for the task */
-            completeData: components["schemas"]["CompleteTaskDocument"];
-            /**
-             * @description The ID of the task to complete
-             * @example 507f1f77bcf86cd799439011
-             */
-            taskId: string;
+            color: string;
+            name: string;
         };
-        BulkCompleteTaskOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/BulkCompleteTaskOutputBody.json
-             */
-            readonly $schema?: string;
-            /**
-             * Format: int64
-             * @description The user's current streak count
-             * @example 5
-             */
-            currentStreak: number;
-            /** @description Array of task IDs that failed to complete */
-            failedTaskIds?: string[];
-            /** @example Bulk task completion completed */
-            message: string;
-            /**
-             * @description Indicates if the user's streak increased
-             * @example true
-             */
-            streakChanged: boolean;
-            /**
-             * Format: double
-             * @description Total tasks completed by user
-             * @example 42
-             */
-            tasksComplete: number;
-            /**
-             * Format: int64
-             * @description Number of tasks successfully completed
-             * @example 5
-             */
-            totalCompleted: number;
-            /**
-             * Format: int64
-             * @description Number of tasks that failed to complete
-             * @example 0
-             */
-            totalFailed: number;
+        AnalyticsProgress: {
+            bucketUnit: string;
+            buckets: components["schemas"]["AnalyticsProgressBucket"][];
+            /** Format: double */
+            delta: number;
+            legend: components["schemas"]["AnalyticsLegendItem"][];
+            /** Format: int64 */
+            prevTotal: number;
+            takeaway: string;
+            /** Format: int64 */
+            total: number;
         };
-        BulkDeleteTaskInputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/BulkDeleteTaskInputBody.json
-             */
-            readonly $schema?: string;
-            /** @description Array of tasks to delete */
-            tasks: components["schemas"]["BulkDeleteTaskItem"][];
+        AnalyticsProgressBucket: {
+            date: string;
+            label: string;
+            segments: components["schemas"]["AnalyticsProgressSegment"][];
+            /** Format: int64 */
+            total: number;
         };
-        BulkDeleteTaskItem: {
-            /**
-             * @description The ID of the category the task belongs to
-             * @example 507f1f77bcf86cd799439011
-             */
+        AnalyticsProgressSegment: {
             categoryId: string;
-            /**
-             * @description Optional. If true, also delete the recurring template. Defaults to false.
-             * @example false
-             */
-            deleteRecurring?: boolean;
-            /**
-             * @description The ID of the task to delete
-             * @example 507f1f77bcf86cd799439011
-             */
-            taskId: string;
+            color: string;
+            /** Format: int64 */
+            count: number;
+            name: string;
         };
-        BulkDeleteTaskOutputBody: {
+        AnalyticsResponse: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/BulkDeleteTaskOutputBody.json
+             * @example https://example.com/schemas/AnalyticsResponse.json
              */
             readonly $schema?: string;
-            /** @description Array of task IDs that failed to delete */
-            failedTaskIds?: string[];
-            /** @example Bulk task deletion completed */
-            message: string;
-            /**
-             * Format: int64
-             * @description Number of tasks successfully deleted
-             * @example 5
-             */
-            totalDeleted: number;
-            /**
-             * Format: int64
-             * @description Number of tasks that failed to delete
-             * @example 0
-             */
-            totalFailed: number;
-        };
-        CalendarConnection: {
-            /** Format: date-time */
-            created_at: string;
-            health_message?: string;
-            health_status: string;
-            id: string;
-            is_primary: boolean;
-            /** Format: date-time */
-            last_heartbeat: string;
-            /** Format: date-time */
-            last_sync: string;
-            make_public: boolean;
-            provider: string;
-            provider_account_id: string;
-            scopes: string[];
-            setup_complete: boolean;
+            attention: components["schemas"]["AnalyticsAttention"];
+            bestTime: components["schemas"]["AnalyticsBestTime"];
+            categoryFilter?: string;
+            categoryHealth: components["schemas"]["AnalyticsCategoryHealth"];
+            categoryShare: components["schemas"]["AnalyticsCategoryShare"];
             /** Format: date-time */
-            updated_at: string;
-            user_id: string;
-            watch_channels?: components["schemas"]["WatchChannel"][];
+            generatedAt: string;
+            habits: components["schemas"]["AnalyticsHabits"];
+            heatmap: components["schemas"]["AnalyticsHeatmap"];
+            kudosEffect: components["schemas"]["AnalyticsKudosEffect"];
+            progress: components["schemas"]["AnalyticsProgress"];
+            range: string;
+            signals: components["schemas"]["AnalyticsSignals"];
+            supportCoverage: components["schemas"]["AnalyticsSupportCoverage"];
+            topSupporters: components["schemas"]["AnalyticsSupporter"][];
+            workspaceFilter?: string;
+            workspaceHealth: components["schemas"]["AnalyticsWorkspaceHealth"];
         };
-        CalendarEventDTO: {
-            attendees: string[];
-            calendar_id: string;
-            calendar_name: string;
-            description: string;
-            end_time: string;
-            id: string;
-            is_all_day: boolean;
-            location: string;
-            start_time: string;
-            status: string;
-            summary: string;
+        AnalyticsShareBand: {
+            label: string;
+            slices: components["schemas"]["AnalyticsShareSlice"][];
         };
-        CalendarInfo: {
-            access_role: string;
-            description: string;
-            id: string;
-            is_primary: boolean;
+        AnalyticsShareSlice: {
+            categoryId: string;
+            color: string;
+            /** Format: int64 */
+            count: number;
             name: string;
+            /** Format: double */
+            pct: number;
         };
-        CategoryDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CategoryDocument.json
-             */
-            readonly $schema?: string;
-            blueprintId?: string;
-            id: string;
-            integration?: string;
-            isBlueprint?: boolean;
-            /** Format: date-time */
-            lastEdited: string;
-            name: string;
-            push_enabled?: boolean;
-            tags?: string[];
-            tasks: components["schemas"]["TaskDocument"][];
-            user: string;
-            workspaceName: string;
+        AnalyticsSignal: {
+            /** Format: double */
+            delta: number;
+            deltaLabel: string;
+            direction: string;
+            label: string;
+            /** Format: double */
+            rawValue: number;
+            value: string;
         };
-        CategoryExtendedReference: {
-            blueprintId?: string;
-            id: string;
-            isBlueprint?: boolean;
-            name: string;
+        AnalyticsSignals: {
+            momentum: components["schemas"]["AnalyticsSignal"];
+            support: components["schemas"]["AnalyticsSignal"];
+            timing: components["schemas"]["AnalyticsSignal"];
         };
-        CategoryMetadata: {
-            /** @description Category ID */
+        AnalyticsSupportCoverage: {
+            /** Format: int64 */
+            pct: number;
+            /** Format: int64 */
+            supported: number;
+            takeaway: string;
+            /** Format: int64 */
+            total: number;
+        };
+        AnalyticsSupporter: {
+            /** Format: int64 */
+            count: number;
+            icon: string;
             id: string;
-            /** @description Category name */
             name: string;
-            /** @description Workspace name */
-            workspaceName: string;
         };
-        CategoryTaskPairLocal: {
-            categoryId: string;
-            categoryName?: string;
-            task: components["schemas"]["CreateTaskParams"];
+        AnalyticsWorkspaceHealth: {
+            rows: components["schemas"]["AnalyticsWorkspaceHealthRow"][];
         };
-        ChecklistItem: {
-            completed: boolean;
-            content: string;
+        AnalyticsWorkspaceHealthRow: {
             /** Format: int64 */
-            order: number;
+            done: number;
+            /** Format: int64 */
+            kudos: number;
+            /** Format: int64 */
+            onTimePct: number;
+            status: string;
+            workspace: string;
         };
-        ClaimRewardResponseBody: {
+        ApplyReferralCodeInputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ClaimRewardResponseBody.json
+             * @example https://example.com/schemas/ApplyReferralCodeInputBody.json
              */
             readonly $schema?: string;
-            /** Format: int64 */
-            amount: number;
-            credit_type: string;
-        };
-        CommentDocumentAPI: {
-            content: string;
-            id: string;
-            mentions?: components["schemas"]["MentionReference"][];
-            metadata: components["schemas"]["CommentMetadata"];
-            parentId?: string;
-            user: components["schemas"]["UserExtendedReference"];
-        };
-        CommentMetadata: {
-            /** Format: date-time */
-            createdAt: string;
-            isDeleted: boolean;
-            /** Format: date-time */
-            lastEdited: string;
+            referralCode: string;
         };
-        CompleteTaskDocument: {
+        ApplyReferralCodeOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CompleteTaskDocument.json
+             * @example https://example.com/schemas/ApplyReferralCodeOutputBody.json
              */
             readonly $schema?: string;
-            timeCompleted: string;
-            timeTaken: string;
+            message: string;
+            referrer?: components["schemas"]["ReferrerInfo"];
+            success: boolean;
         };
-        CompleteTaskOutputBody: {
+        ApproveCommentOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CompleteTaskOutputBody.json
+             * @example https://example.com/schemas/ApproveCommentOutputBody.json
              */
             readonly $schema?: string;
-            /**
-             * Format: int64
-             * @description The user's current streak count
-             * @example 5
-             */
-            currentStreak: number;
-            /** @example Task completed successfully */
+            comment: components["schemas"]["CommentDocumentAPI"];
+            /** @example Comment approved successfully */
             message: string;
-            /** @description If this was a flex task and more instances remain, the next task instance */
-            nextFlexTask?: components["schemas"]["NextFlexTaskDTO"];
-            /** @description Describes the Do ring increment triggered by this completion so the client can render feedback */
-            ringDelta?: components["schemas"]["RingDelta"];
-            /**
-             * @description Indicates if the user's streak increased
-             * @example true
-             */
-            streakChanged: boolean;
-            /**
-             * Format: double
-             * @description Total tasks completed by user
-             * @example 42
-             */
-            tasksComplete: number;
         };
-        ConfirmImageUploadInputBody: {
+        AudienceInput: {
+            /** @description Group IDs, for the groups audience */
+            groups?: string[];
+            /** @description Friend list IDs, for the lists audience */
+            lists?: string[];
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ConfirmImageUploadInputBody.json
+             * @description Who can see the post
+             * @enum {string}
              */
-            readonly $schema?: string;
-            /** @example https://kindred.nyc3.digitaloceanspaces.com/profiles/507f1f77bcf86cd799439011/uuid.jpg */
-            public_url: string;
+            type: "public" | "friends" | "close_friends" | "groups" | "users" | "lists" | "only_me";
+            /** @description Friend IDs, for the users audience */
+            users?: string[];
         };
-        ConfirmImageUploadOutputBody: {
+        BlockUserOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ConfirmImageUploadOutputBody.json
+             * @example https://example.com/schemas/BlockUserOutputBody.json
              */
             readonly $schema?: string;
-            /** @example Image upload confirmed successfully */
+            /** @example User blocked successfully */
             message: string;
         };
-        ConfirmTaskNaturalLanguageInputBody: {
+        BlueprintCategoryGroup: {
+            /** @description List of blueprints in this category */
+            blueprints: components["schemas"]["BlueprintDocument"][];
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ConfirmTaskNaturalLanguageInputBody.json
+             * @description Category name (maps from aggregation _id)
+             * @example productivity
              */
-            readonly $schema?: string;
-            /** @description New categories to create with their tasks */
-            categories: components["schemas"]["NewCategoryWithTasksLocal"][];
-            /** @description Tasks to create in existing categories */
-            tasks: components["schemas"]["CategoryTaskPairLocal"][];
+            category: string;
+            /**
+             * Format: int64
+             * @description Number of blueprints in this category
+             * @example 5
+             */
+            count: number;
         };
-        CongratulationDocument: {
+        BlueprintDocument: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CongratulationDocument.json
+             * @example https://example.com/schemas/BlueprintDocument.json
              */
             readonly $schema?: string;
             /**
-             * @description Category name
-             * @example Work
+             * @description Banner image URL
+             * @example https://example.com/banner.jpg
              */
-            categoryName: string;
+            banner: string;
             /**
-             * Format: int64
-             * @description Video duration in milliseconds (video type only)
-             * @example 15000
+             * @description Categories associated with the blueprint
+             * @example [
+             *       {
+             *         "id": "507f1f77bcf86cd799439011",
+             *         "lastEdited": "2023-01-01T00:00:00Z",
+             *         "name": "Productivity",
+             *         "user": "507f1f77bcf86cd799439012",
+             *         "workspaceName": "Personal"
+             *       }
+             *     ]
              */
-            durationMs?: number;
+            categories: components["schemas"]["CategoryDocument"][];
             /**
-             * @description Unique identifier for the congratulation
-             * @example 507f1f77bcf86cd799439011
+             * @description Category of the blueprint
+             * @example productivity
              */
-            id: string;
+            category: string;
             /**
-             * @description Congratulation message
-             * @example Congratulations on completing your task!
+             * @description Description of the blueprint
+             * @example A comprehensive morning routine
              */
-            message: string;
+            description: string;
             /**
-             * Format: date-time
-             * @description When the receiver reacted
+             * @description Expected duration
+             * @example 30m
              */
-            reactedAt?: string;
+            duration: string;
             /**
-             * @description Receiver's emoji reaction
-             * @example 🙌
+             * @description Unique identifier for the blueprint
+             * @example 507f1f77bcf86cd799439011
              */
-            reaction?: string;
+            id: string;
             /**
-             * @description Whether the congratulation has been read
-             * @example false
+             * @description Name of the blueprint
+             * @example Morning Routine
              */
-            read: boolean;
+            name: string;
+            /** @description Owner information */
+            owner: components["schemas"]["UserExtendedReference"];
             /**
-             * @description Receiver user ID
-             * @example 507f1f77bcf86cd799439012
+             * @description List of subscriber user IDs
+             * @example [
+             *       "507f1f77bcf86cd799439012"
+             *     ]
              */
-            receiver: string;
-            /** @description Receiver's profile info (sent queries only) */
-            receiverInfo?: components["schemas"]["CongratulationSender"];
-            /** @description Sender information */
-            sender: components["schemas"]["CongratulationSender"];
+            subscribers: string[];
             /**
-             * @description Task name
-             * @example Complete project proposal
+             * Format: int64
+             * @description Number of subscribers
+             * @example 42
              */
-            taskName: string;
+            subscribersCount: number;
             /**
-             * @description Video thumbnail URL (video type only)
-             * @example https://example.com/thumb.jpg
+             * @description Tags associated with the blueprint
+             * @example [
+             *       "productivity",
+             *       "morning"
+             *     ]
              */
-            thumbnailUrl?: string;
+            tags: string[];
             /**
              * Format: date-time
              * @description Creation timestamp
              * @example 2023-01-01T00:00:00Z
              */
             timestamp: string;
+        };
+        BlueprintDocumentWithoutSubscribers: {
             /**
-             * @description Type of congratulation (message, image, or video)
-             * @example message
+             * @description Banner image URL
+             * @example https://example.com/banner.jpg
              */
-            type: string;
-        };
-        CongratulationReactionParams: {
+            banner: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CongratulationReactionParams.json
+             * @description Categories associated with the blueprint
+             * @example [
+             *       {
+             *         "id": "507f1f77bcf86cd799439011",
+             *         "name": "Productivity"
+             *       }
+             *     ]
              */
-            readonly $schema?: string;
+            categories: components["schemas"]["CategoryDocument"][];
             /**
-             * @description Reaction emoji (one of the curated kudos reactions)
-             * @example 🙌
+             * @description Category of the blueprint
+             * @example productivity
              */
-            emoji: string;
-        };
-        CongratulationSender: {
+            category: string;
             /**
-             * @description Sender's user ID
+             * @description Description of the blueprint
+             * @example A comprehensive morning routine
+             */
+            description: string;
+            /**
+             * @description Expected duration
+             * @example 30m
+             */
+            duration: string;
+            /**
+             * @description Unique identifier for the blueprint
              * @example 507f1f77bcf86cd799439011
              */
             id: string;
             /**
-             * @description Sender's name
-             * @example John Doe
+             * @description Name of the blueprint
+             * @example Morning Routine
              */
             name: string;
+            /** @description Owner information */
+            owner: components["schemas"]["UserExtendedReference"];
             /**
-             * @description Sender's profile picture URL
-             * @example https://example.com/avatar.jpg
+             * Format: int64
+             * @description Number of subscribers
+             * @example 42
              */
-            picture: string;
+            subscribersCount: number;
+            /**
+             * @description Tags associated with the blueprint
+             * @example [
+             *       "productivity",
+             *       "morning"
+             *     ]
+             */
+            tags: string[];
+            /**
+             * Format: date-time
+             * @description Creation timestamp
+             * @example 2023-01-01T00:00:00Z
+             */
+            timestamp: string;
         };
-        ConnectGoogleOutputBody: {
+        BulkCompleteTaskInputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ConnectGoogleOutputBody.json
+             * @example https://example.com/schemas/BulkCompleteTaskInputBody.json
              */
             readonly $schema?: string;
-            /** @description Google OAuth consent URL */
-            auth_url: string;
+            /** @description Array of tasks to complete */
+            tasks: components["schemas"]["BulkCompleteTaskItem"][];
         };
-        ConnectionDocument: {
+        BulkCompleteTaskItem: {
+            /**
+             * @description The ID of the category the task belongs to
+             * @example 507f1f77bcf86cd799439011
+             */
+            categoryId: string;
+            /** @description The completion data for the task */
+            completeData: components["schemas"]["CompleteTaskDocument"];
+            /**
+             * @description The ID of the task to complete
+             * @example 507f1f77bcf86cd799439011
+             */
+            taskId: string;
+        };
+        BulkCompleteTaskOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ConnectionDocument.json
+             * @example https://example.com/schemas/BulkCompleteTaskOutputBody.json
              */
             readonly $schema?: string;
             /**
-             * Format: date-time
-             * @description When friendship was confirmed
+             * Format: int64
+             * @description The user's current streak count
+             * @example 5
              */
-            accepted_at?: string;
+            currentStreak: number;
+            /** @description Array of task IDs that failed to complete */
+            failedTaskIds?: string[];
+            /** @example Bulk task completion completed */
+            message: string;
             /**
-             * Format: date-time
-             * @description When relationship was created
+             * @description Indicates if the user's streak increased
+             * @example true
              */
-            created_at: string;
-            /**
-             * @description Relationship ID
-             * @example 507f1f77bcf86cd799439011
-             */
-            id: string;
-            /** @description Receiver user ID */
-            receiver_id: string;
-            /** @description Connection requester information */
-            requester: components["schemas"]["ConnectionUser"];
-            /** @description Relationship status */
-            status: string;
-            /** @description Array of user IDs (always sorted) */
-            users: string[];
-        };
-        ConnectionUser: {
-            /**
-             * @description User ID
-             * @example 507f1f77bcf86cd799439011
-             */
-            _id: string;
+            streakChanged: boolean;
             /**
-             * @description User handle
-             * @example johndoe
+             * Format: double
+             * @description Total tasks completed by user
+             * @example 42
              */
-            handle: string;
+            tasksComplete: number;
             /**
-             * @description User name
-             * @example John Doe
+             * Format: int64
+             * @description Number of tasks successfully completed
+             * @example 5
              */
-            name: string;
+            totalCompleted: number;
             /**
-             * @description Profile picture URL
-             * @example https://example.com/avatar.jpg
+             * Format: int64
+             * @description Number of tasks that failed to complete
+             * @example 0
              */
-            picture: string;
+            totalFailed: number;
         };
-        CreateBlueprintParams: {
+        BulkDeleteTaskInputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CreateBlueprintParams.json
+             * @example https://example.com/schemas/BulkDeleteTaskInputBody.json
              */
             readonly $schema?: string;
+            /** @description Array of tasks to delete */
+            tasks: components["schemas"]["BulkDeleteTaskItem"][];
+        };
+        BulkDeleteTaskItem: {
             /**
-             * @description Banner image URL for the blueprint
-             * @example https://example.com/banner.jpg
+             * @description The ID of the category the task belongs to
+             * @example 507f1f77bcf86cd799439011
              */
-            banner: string;
+            categoryId: string;
             /**
-             * @description Categories associated with the blueprint
-             * @example [
-             *       {
-             *         "id": "507f1f77bcf86cd799439011",
-             *         "lastEdited": "2023-01-01T00:00:00Z",
-             *         "name": "Productivity",
-             *         "user": "507f1f77bcf86cd799439012",
-             *         "workspaceName": "Personal"
-             *       }
-             *     ]
+             * @description Optional. If true, also delete the recurring template. Defaults to false.
+             * @example false
              */
-            categories: components["schemas"]["CategoryDocument"][];
+            deleteRecurring?: boolean;
             /**
-             * @description Category of the blueprint
-             * @example productivity
+             * @description The ID of the task to delete
+             * @example 507f1f77bcf86cd799439011
              */
-            category: string;
+            taskId: string;
+        };
+        BulkDeleteTaskOutputBody: {
             /**
-             * @description Description of the blueprint
-             * @example A comprehensive morning routine to start your day right
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/BulkDeleteTaskOutputBody.json
              */
-            description: string;
+            readonly $schema?: string;
+            /** @description Array of task IDs that failed to delete */
+            failedTaskIds?: string[];
+            /** @example Bulk task deletion completed */
+            message: string;
             /**
-             * @description Expected duration to complete the blueprint
-             * @example 30m
+             * Format: int64
+             * @description Number of tasks successfully deleted
+             * @example 5
              */
-            duration: string;
+            totalDeleted: number;
             /**
-             * @description Name of the blueprint
-             * @example Morning Routine
+             * Format: int64
+             * @description Number of tasks that failed to delete
+             * @example 0
              */
+            totalFailed: number;
+        };
+        CalendarConnection: {
+            /** Format: date-time */
+            created_at: string;
+            health_message?: string;
+            health_status: string;
+            id: string;
+            is_primary: boolean;
+            /** Format: date-time */
+            last_heartbeat: string;
+            /** Format: date-time */
+            last_sync: string;
+            make_public: boolean;
+            provider: string;
+            provider_account_id: string;
+            scopes: string[];
+            setup_complete: boolean;
+            /** Format: date-time */
+            updated_at: string;
+            user_id: string;
+            watch_channels?: components["schemas"]["WatchChannel"][];
+        };
+        CalendarEventDTO: {
+            attendees: string[];
+            calendar_id: string;
+            calendar_name: string;
+            description: string;
+            end_time: string;
+            id: string;
+            is_all_day: boolean;
+            location: string;
+            start_time: string;
+            status: string;
+            summary: string;
+        };
+        CalendarInfo: {
+            access_role: string;
+            description: string;
+            id: string;
+            is_primary: boolean;
             name: string;
-            /**
-             * @description Tags associated with the blueprint
-             * @example [
-             *       "productivity",
-             *       "morning"
-             *     ]
-             */
-            tags: string[];
         };
-        CreateCategoryParams: {
+        CategoryDocument: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CreateCategoryParams.json
+             * @example https://example.com/schemas/CategoryDocument.json
              */
             readonly $schema?: string;
-            color?: string;
-            icon?: string;
+            blueprintId?: string;
+            challengeId?: string;
+            id: string;
+            integration?: string;
+            isBlueprint?: boolean;
+            /** Format: date-time */
+            lastEdited: string;
             name: string;
+            push_enabled?: boolean;
             tags?: string[];
+            tasks: components["schemas"]["TaskDocument"][];
+            user: string;
             workspaceName: string;
         };
-        CreateCongratulationOutputBody: {
+        CategoryExtendedReference: {
+            blueprintId?: string;
+            id: string;
+            isBlueprint?: boolean;
+            name: string;
+        };
+        CategoryMetadata: {
+            /** @description Category ID */
+            id: string;
+            /** @description Category name */
+            name: string;
+            /** @description Workspace name */
+            workspaceName: string;
+        };
+        CategoryTaskPairLocal: {
+            categoryId: string;
+            categoryName?: string;
+            task: components["schemas"]["CreateTaskParams"];
+        };
+        ChallengeGoal: {
+            /** @description Completions needed every period instead of a total */
+            flex?: components["schemas"]["FlexDetails"];
+            /**
+             * Format: int64
+             * @description Total completions over the challenge
+             */
+            target?: number;
+        };
+        ChallengeMessageOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CreateCongratulationOutputBody.json
+             * @example https://example.com/schemas/ChallengeMessageOutputBody.json
              */
             readonly $schema?: string;
+            /** @example Left challenge */
+            message: string;
+        };
+        ChallengeStanding: {
+            /** Format: int64 */
+            completions: number;
+            /** Format: date-time */
+            finishedAt?: string;
             /**
-             * @description Category name
-             * @example Work
+             * Format: int64
+             * @description Periods the flex target was met in; always 0 for total goals
              */
-            categoryName: string;
+            periodsMet: number;
             /**
-             * Format: int64
-             * @description Video duration in milliseconds (video type only)
-             * @example 15000
+             * Format: double
+             * @description Share of the goal reached, from 0 to 1
              */
-            durationMs?: number;
-            /** @description Describes the friendship score bump with the receiver so the client can render feedback */
-            friendshipDelta?: components["schemas"]["Delta"];
+            progress: number;
+            /** Format: int64 */
+            rank: number;
+            user: components["schemas"]["UserExtendedReference"];
+        };
+        ChecklistItem: {
+            completed: boolean;
+            content: string;
+            /** Format: int64 */
+            order: number;
+        };
+        ClaimRewardResponseBody: {
             /**
-             * @description Unique identifier for the congratulation
-             * @example 507f1f77bcf86cd799439011
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ClaimRewardResponseBody.json
              */
+            readonly $schema?: string;
+            /** Format: int64 */
+            amount: number;
+            credit_type: string;
+        };
+        CommentDocumentAPI: {
+            content: string;
             id: string;
+            mentions?: components["schemas"]["MentionReference"][];
+            metadata: components["schemas"]["CommentMetadata"];
+            parentId?: string;
+            /** @description Waiting for the post's author to approve it; until then only they and the commenter see it */
+            pending?: boolean;
+            /** @description User IDs per emoji */
+            reactions?: {
+                [key: string]: string[];
+            };
             /**
-             * @description Congratulation message
-             * @example Congratulations on completing your task!
+             * Format: int64
+             * @description Replies in this thread (root comments only)
              */
-            message: string;
+            replyCount?: number;
+            /** @description Root comment of the thread this reply belongs to */
+            threadId?: string;
+            user: components["schemas"]["UserExtendedReference"];
+        };
+        CommentMetadata: {
+            /** Format: date-time */
+            createdAt: string;
+            isDeleted: boolean;
+            /** Format: date-time */
+            lastEdited: string;
+        };
+        CompleteTaskDocument: {
             /**
-             * Format: date-time
-             * @description When the receiver reacted
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/CompleteTaskDocument.json
              */
-            reactedAt?: string;
-            /**
-             * @description Receiver's emoji reaction
-             * @example 🙌
-             */
-            reaction?: string;
-            /**
-             * @description Whether the congratulation has been read
-             * @example false
-             */
-            read: boolean;
-            /**
-             * @description Receiver user ID
-             * @example 507f1f77bcf86cd799439012
-             */
-            receiver: string;
-            /** @description Receiver's profile info (sent queries only) */
-            receiverInfo?: components["schemas"]["CongratulationSender"];
-            /** @description Describes the Share ring increment triggered by this congratulation so the client can render feedback */
-            ringDelta?: components["schemas"]["RingDelta"];
-            /** @description Sender information */
-            sender: components["schemas"]["CongratulationSender"];
-            /**
-             * @description Task name
-             * @example Complete project proposal
-             */
-            taskName: string;
-            /**
-             * @description Video thumbnail URL (video type only)
-             * @example https://example.com/thumb.jpg
-             */
-            thumbnailUrl?: string;
-            /**
-             * Format: date-time
-             * @description Creation timestamp
-             * @example 2023-01-01T00:00:00Z
-             */
-            timestamp: string;
-            /**
-             * @description Type of congratulation (message, image, or video)
-             * @example message
-             */
-            type: string;
+            readonly $schema?: string;
+            timeCompleted: string;
+            timeTaken: string;
         };
-        CreateCongratulationParams: {
+        CompleteTaskOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CreateCongratulationParams.json
+             * @example https://example.com/schemas/CompleteTaskOutputBody.json
              */
             readonly $schema?: string;
-            /**
-             * @description Category name
-             * @example Work
-             */
-            categoryName: string;
             /**
              * Format: int64
-             * @description Video duration in milliseconds, max 30000 (required for video type)
-             * @example 15000
-             */
-            durationMs?: number;
-            /**
-             * @description Congratulation message
-             * @example Congratulations on completing your task!
+             * @description The user's current streak count
+             * @example 5
              */
+            currentStreak: number;
+            /** @example Task completed successfully */
             message: string;
+            /** @description If this was a flex task and more instances remain, the next task instance */
+            nextFlexTask?: components["schemas"]["NextFlexTaskDTO"];
+            /** @description Describes the Do ring increment triggered by this completion so the client can render feedback */
+            ringDelta?: components["schemas"]["RingDelta"];
             /**
-             * @description Optional post ID associated with the congratulation
-             * @example 507f1f77bcf86cd799439013
-             */
-            postId?: string;
-            /** @description If true, the sender is anonymized to everyone except the receiver */
-            private?: boolean;
-            /**
-             * @description Receiver user ID
-             * @example 507f1f77bcf86cd799439012
-             */
-            receiver: string;
-            /**
-             * @description Task name
-             * @example Complete project proposal
+             * @description Indicates if the user's streak increased
+             * @example true
              */
-            taskName: string;
+            streakChanged: boolean;
             /**
-             * @description Video thumbnail URL (required for video type)
-             * @example https://example.com/thumb.jpg
+             * Format: double
+             * @description Total tasks completed by user
+             * @example 42
              */
-            thumbnailUrl?: string;
+            tasksComplete: number;
+        };
+        ConfirmImageUploadInputBody: {
             /**
-             * @description Type of congratulation (message, image, or video)
-             * @example message
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ConfirmImageUploadInputBody.json
              */
-            type: string;
+            readonly $schema?: string;
+            /** @example https://kindred.nyc3.digitaloceanspaces.com/profiles/507f1f77bcf86cd799439011/uuid.jpg */
+            public_url: string;
         };
-        CreateConnectionParams: {
+        ConfirmImageUploadOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CreateConnectionParams.json
+             * @example https://example.com/schemas/ConfirmImageUploadOutputBody.json
              */
             readonly $schema?: string;
+            /** @example Image upload confirmed successfully */
+            message: string;
+        };
+        ConfirmTaskNaturalLanguageInputBody: {
             /**
-             * @description Receiver user ID
-             * @example 507f1f77bcf86cd799439012
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ConfirmTaskNaturalLanguageInputBody.json
              */
-            receiver_id: string;
+            readonly $schema?: string;
+            /** @description New categories to create with their tasks */
+            categories: components["schemas"]["NewCategoryWithTasksLocal"][];
+            /** @description Tasks to create in existing categories */
+            tasks: components["schemas"]["CategoryTaskPairLocal"][];
         };
-        CreateEncouragementOutputBody: {
+        CongratulationDocument: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CreateEncouragementOutputBody.json
+             * @example https://example.com/schemas/CongratulationDocument.json
              */
             readonly $schema?: string;
             /**
              * @description Category name
              * @example Work
              */
-            categoryName?: string;
+            categoryName: string;
             /**
              * Format: int64
              * @description Video duration in milliseconds (video type only)
              * @example 15000
              */
             durationMs?: number;
-            /** @description Describes the friendship score bump with the receiver so the client can render feedback */
-            friendshipDelta?: components["schemas"]["Delta"];
             /**
-             * @description Unique identifier for the encouragement
+             * @description Unique identifier for the congratulation
              * @example 507f1f77bcf86cd799439011
              */
             id: string;
             /**
-             * @description Encouragement message
-             * @example Great job on completing your task!
+             * @description Congratulation message
+             * @example Congratulations on completing your task!
              */
             message: string;
             /**
@@ -4914,11 +5240,11 @@ export interface components {
             reactedAt?: string;
             /**
              * @description Receiver's emoji reaction
-             * @example ❤️
+             * @example 🙌
              */
             reaction?: string;
             /**
-             * @description Whether the encouragement has been read
+             * @description Whether the congratulation has been read
              * @example false
              */
             read: boolean;
@@ -4928,26 +5254,14 @@ export interface components {
              */
             receiver: string;
             /** @description Receiver's profile info (sent queries only) */
-            receiverInfo?: components["schemas"]["EncouragementSender"];
-            /** @description Describes the Share ring increment triggered by this encouragement so the client can render feedback */
-            ringDelta?: components["schemas"]["RingDelta"];
-            /**
-             * @description Scope of encouragement (task or profile)
-             * @example task
-             */
-            scope: string;
+            receiverInfo?: components["schemas"]["CongratulationSender"];
             /** @description Sender information */
-            sender: components["schemas"]["EncouragementSender"];
-            /**
-             * @description Task ID being encouraged
-             * @example 507f1f77bcf86cd799439013
-             */
-            taskId?: string;
+            sender: components["schemas"]["CongratulationSender"];
             /**
              * @description Task name
              * @example Complete project proposal
              */
-            taskName?: string;
+            taskName: string;
             /**
              * @description Video thumbnail URL (video type only)
              * @example https://example.com/thumb.jpg
@@ -4960,592 +5274,377 @@ export interface components {
              */
             timestamp: string;
             /**
-             * @description Type of encouragement (message or image)
+             * @description Type of congratulation (message, image, or video)
              * @example message
              */
             type: string;
         };
-        CreateEncouragementParams: {
+        CongratulationReactionParams: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CreateEncouragementParams.json
+             * @example https://example.com/schemas/CongratulationReactionParams.json
              */
             readonly $schema?: string;
             /**
-             * @description Category name (required for task scope)
-             * @example Work
+             * @description Reaction emoji (one of the curated kudos reactions)
+             * @example 🙌
              */
-            categoryName?: string;
+            emoji: string;
+        };
+        CongratulationSender: {
             /**
-             * Format: int64
-             * @description Video duration in milliseconds, max 30000 (required for video type)
-             * @example 15000
+             * @description Sender's user ID
+             * @example 507f1f77bcf86cd799439011
              */
-            durationMs?: number;
+            id: string;
             /**
-             * @description Encouragement message
-             * @example Great job on completing your task!
+             * @description Sender's name
+             * @example John Doe
              */
-            message: string;
+            name: string;
             /**
-             * @description Receiver user ID
-             * @example 507f1f77bcf86cd799439012
+             * @description Sender's profile picture URL
+             * @example https://example.com/avatar.jpg
              */
-            receiver: string;
+            picture: string;
+        };
+        ConnectGoogleOutputBody: {
             /**
-             * @description Scope of encouragement (task or profile)
-             * @example task
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ConnectGoogleOutputBody.json
              */
-            scope: string;
+            readonly $schema?: string;
+            /** @description Google OAuth consent URL */
+            auth_url: string;
+        };
+        ConnectionDocument: {
             /**
-             * @description Task ID (required for task scope)
-             * @example 507f1f77bcf86cd799439013
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ConnectionDocument.json
              */
-            taskId?: string;
+            readonly $schema?: string;
             /**
-             * @description Task name (required for task scope)
-             * @example Complete project proposal
+             * Format: date-time
+             * @description When friendship was confirmed
              */
-            taskName?: string;
+            accepted_at?: string;
             /**
-             * @description Video thumbnail URL (required for video type)
-             * @example https://example.com/thumb.jpg
+             * Format: date-time
+             * @description When relationship was created
              */
-            thumbnailUrl?: string;
+            created_at: string;
             /**
-             * @description Type of encouragement (message, image, or video)
-             * @example message
+             * @description Relationship ID
+             * @example 507f1f77bcf86cd799439011
              */
-            type: string;
+            id: string;
+            /** @description Receiver user ID */
+            receiver_id: string;
+            /** @description Connection requester information */
+            requester: components["schemas"]["ConnectionUser"];
+            /** @description Relationship status */
+            status: string;
+            /** @description Array of user IDs (always sorted) */
+            users: string[];
         };
-        CreateGroupParams: {
+        ConnectionUser: {
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CreateGroupParams.json
+             * @description User ID
+             * @example 507f1f77bcf86cd799439011
              */
-            readonly $schema?: string;
-            members?: string[];
-            name: string;
-        };
-        CreatePostOutputBody: {
+            _id: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CreatePostOutputBody.json
+             * @description User handle
+             * @example johndoe
              */
-            readonly $schema?: string;
-            _id: string;
-            blueprint?: components["schemas"]["EnhancedBlueprintReference"];
-            caption: string;
-            category?: components["schemas"]["CategoryExtendedReference"];
-            comments: components["schemas"]["CommentDocumentAPI"][];
-            dual?: string;
-            groups?: string[];
-            images: string[];
-            kudos?: components["schemas"]["PostKudos"][];
-            media: components["schemas"]["MediaItem"][];
-            metadata: components["schemas"]["PostMetadata"];
-            reactions: {
-                [key: string]: string[];
-            };
-            /** @description Describes the Share ring increment triggered by this post so the client can render feedback */
-            ringDelta?: components["schemas"]["RingDelta"];
-            size?: components["schemas"]["ImageSize"];
-            song?: components["schemas"]["Song"];
-            taggedUsers?: components["schemas"]["MentionReference"][];
-            task?: components["schemas"]["PostTaskExtendedReference"];
-            user: components["schemas"]["UserExtendedReference"];
-        };
-        CreatePostOutputUserStats: {
-            /** Format: int64 */
-            points: number;
-            /** Format: int64 */
-            posts_made: number;
+            handle: string;
+            /**
+             * @description User name
+             * @example John Doe
+             */
+            name: string;
+            /**
+             * @description Profile picture URL
+             * @example https://example.com/avatar.jpg
+             */
+            picture: string;
         };
-        CreatePostParams: {
+        ControlUserOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CreatePostParams.json
+             * @example https://example.com/schemas/ControlUserOutputBody.json
              */
             readonly $schema?: string;
-            blueprintId?: string;
-            blueprintIsPublic?: boolean;
-            caption: string;
-            dual?: string;
-            groups?: string[];
-            images: string[];
-            isPublic: boolean;
-            media?: components["schemas"]["MediaItemInput"][];
-            size?: components["schemas"]["ImageSize"];
-            song?: components["schemas"]["Song"];
-            /** @description Tagged user references as [{id, handle}]; malformed entries are dropped, never rejected */
-            taggedUsers?: unknown;
-            task?: components["schemas"]["PostTaskExtendedReference"];
+            /** @example User muted successfully */
+            message: string;
         };
-        CreateTaskNaturalLanguageInputBody: {
+        CreateBlueprintParams: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CreateTaskNaturalLanguageInputBody.json
+             * @example https://example.com/schemas/CreateBlueprintParams.json
              */
             readonly $schema?: string;
             /**
-             * @description Natural language description of tasks to create
-             * @example Buy groceries tomorrow at 3pm, finish project report by Friday
+             * @description Banner image URL for the blueprint
+             * @example https://example.com/banner.jpg
              */
-            text: string;
+            banner: string;
             /**
-             * @description User's timezone (IANA format). Defaults to America/New_York if not provided
-             * @example America/New_York
+             * @description Categories associated with the blueprint
+             * @example [
+             *       {
+             *         "id": "507f1f77bcf86cd799439011",
+             *         "lastEdited": "2023-01-01T00:00:00Z",
+             *         "name": "Productivity",
+             *         "user": "507f1f77bcf86cd799439012",
+             *         "workspaceName": "Personal"
+             *       }
+             *     ]
              */
-            timezone?: string;
-        };
-        CreateTaskNaturalLanguageOutputBody: {
+            categories: components["schemas"]["CategoryDocument"][];
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CreateTaskNaturalLanguageOutputBody.json
+             * @description Category of the blueprint
+             * @example productivity
              */
-            readonly $schema?: string;
+            category: string;
             /**
-             * Format: int64
-             * @description Number of new categories created
+             * @description Description of the blueprint
+             * @example A comprehensive morning routine to start your day right
              */
-            categoriesCreated: number;
-            /** @example Successfully created 3 tasks in 2 categories */
-            message: string;
-            /** @description List of newly created categories with metadata */
-            newCategories: components["schemas"]["CategoryMetadata"][];
-            /** @description List of created tasks */
-            tasks: components["schemas"]["TaskDocument"][];
+            description: string;
             /**
-             * Format: int64
-             * @description Total number of tasks created
+             * @description Expected duration to complete the blueprint
+             * @example 30m
              */
-            tasksCreated: number;
+            duration: string;
+            /**
+             * @description Name of the blueprint
+             * @example Morning Routine
+             */
+            name: string;
+            /**
+             * @description Tags associated with the blueprint
+             * @example [
+             *       "productivity",
+             *       "morning"
+             *     ]
+             */
+            tags: string[];
         };
-        CreateTaskOutputBody: {
+        CreateCategoryParams: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CreateTaskOutputBody.json
+             * @example https://example.com/schemas/CreateCategoryParams.json
              */
             readonly $schema?: string;
-            active: boolean;
-            blueprintId?: string;
-            categoryID?: string;
-            checklist?: components["schemas"]["ChecklistItem"][];
-            completionType?: string;
-            content: string;
-            /** Format: date-time */
-            deadline?: string;
-            /** Format: int64 */
-            durationSeconds?: number;
-            encouragements?: components["schemas"]["TaskKudos"][];
-            flexInfo?: components["schemas"]["FlexInstanceInfo"];
-            id: string;
-            integration?: string;
-            /** Format: date-time */
-            lastEdited: string;
-            notes?: string;
-            posted: boolean;
-            /** Format: int64 */
-            priority: number;
-            public: boolean;
-            pushed_calendar_id?: string;
-            pushed_event_etag?: string;
-            pushed_event_id?: string;
-            recurDetails?: components["schemas"]["RecurDetails"];
-            recurFrequency?: string;
-            recurType?: string;
-            recurring: boolean;
-            reminders?: components["schemas"]["Reminder"][];
-            /** Format: int64 */
-            rescheduleCount?: number;
-            /** @description Describes the Plan ring increment triggered by this creation so the client can render feedback */
-            ringDelta?: components["schemas"]["RingDelta"];
-            sessionNote?: string;
-            sessionPhoto?: string;
-            sessionTrackable?: boolean;
-            source?: string;
-            /** Format: date-time */
-            startDate: string;
-            /** Format: date-time */
-            startTime?: string;
-            /** Format: date-time */
-            startedAt?: string;
-            taggedUsers?: components["schemas"]["TaggedTaskUser"][];
-            taskId?: string;
-            templateID?: string;
-            /** Format: date-time */
-            timeCompleted?: string;
-            timeTaken?: string;
-            /** Format: date-time */
-            timestamp: string;
-            userID?: string;
-            /** Format: double */
-            value: number;
-            /** Format: date-time */
-            workingOnSince?: string;
+            color?: string;
+            icon?: string;
+            name: string;
+            tags?: string[];
+            workspaceName: string;
         };
-        CreateTaskParams: {
+        CreateChallengeParams: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CreateTaskParams.json
+             * @example https://example.com/schemas/CreateChallengeParams.json
              */
             readonly $schema?: string;
-            active?: boolean;
-            checklist?: components["schemas"]["ChecklistItem"][];
-            content: string;
-            /** Format: date-time */
-            deadline?: string;
-            integration?: string;
-            notes?: string;
-            /** Format: int64 */
-            priority: number;
-            public: boolean;
-            recurDetails?: components["schemas"]["RecurDetails"];
-            recurFrequency?: string;
-            recurring: boolean;
-            reminders?: components["schemas"]["Reminder"][];
-            sessionTrackable?: boolean;
+            description?: string;
             /** Format: date-time */
+            endDate: string;
+            goal: components["schemas"]["ChallengeGoal"];
+            /**
+             * Format: date-time
+             * @description Defaults to now
+             */
             startDate?: string;
-            /** Format: date-time */
-            startTime?: string;
-            taggedUserIds?: string[];
-            /** Format: double */
-            value: number;
+            /** @example 30 workouts in March */
+            title: string;
         };
-        CreateWaitlistParams: {
+        CreateCongratulationOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/CreateWaitlistParams.json
+             * @example https://example.com/schemas/CreateCongratulationOutputBody.json
              */
             readonly $schema?: string;
             /**
-             * @description User email address
-             * @example user@example.com
+             * @description Category name
+             * @example Work
              */
-            email: string;
+            categoryName: string;
             /**
-             * @description User full name
-             * @example John Doe
+             * Format: int64
+             * @description Video duration in milliseconds (video type only)
+             * @example 15000
              */
-            name: string;
-        };
-        DashboardConfiguration: {
-            google_calendar: boolean;
-            jump_back_in: boolean;
-            kudos: boolean;
-            recent_workspaces: boolean;
-            recently_completed: boolean;
-            stats: boolean;
-            upcoming: boolean;
-        };
-        DeleteAccountOutputBody: {
+            durationMs?: number;
+            /** @description Describes the friendship score bump with the receiver so the client can render feedback */
+            friendshipDelta?: components["schemas"]["Delta"];
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DeleteAccountOutputBody.json
+             * @description Unique identifier for the congratulation
+             * @example 507f1f77bcf86cd799439011
              */
-            readonly $schema?: string;
-            /** @example Account deleted successfully */
-            message: string;
-        };
-        DeleteBlueprintOutputBody: {
+            id: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DeleteBlueprintOutputBody.json
+             * @description Congratulation message
+             * @example Congratulations on completing your task!
              */
-            readonly $schema?: string;
-            /** @example Blueprint deleted successfully */
-            message: string;
-        };
-        DeleteCategoryOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DeleteCategoryOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Category deleted successfully */
             message: string;
-        };
-        DeleteCommentOutputBody: {
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DeleteCommentOutputBody.json
+             * Format: date-time
+             * @description When the receiver reacted
              */
-            readonly $schema?: string;
-            message: string;
-        };
-        DeleteCongratulationOutputBody: {
+            reactedAt?: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DeleteCongratulationOutputBody.json
+             * @description Receiver's emoji reaction
+             * @example 🙌
              */
-            readonly $schema?: string;
-            /** @example Congratulation deleted successfully */
-            message: string;
-        };
-        DeleteConnectionOutputBody: {
+            reaction?: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DeleteConnectionOutputBody.json
+             * @description Whether the congratulation has been read
+             * @example false
              */
-            readonly $schema?: string;
-            /** @example Connection deleted successfully */
-            message: string;
-        };
-        DeleteEncouragementOutputBody: {
+            read: boolean;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DeleteEncouragementOutputBody.json
+             * @description Receiver user ID
+             * @example 507f1f77bcf86cd799439012
              */
-            readonly $schema?: string;
-            /** @example Encouragement deleted successfully */
-            message: string;
-        };
-        DeleteGroupOutputBody: {
+            receiver: string;
+            /** @description Receiver's profile info (sent queries only) */
+            receiverInfo?: components["schemas"]["CongratulationSender"];
+            /** @description Describes the Share ring increment triggered by this congratulation so the client can render feedback */
+            ringDelta?: components["schemas"]["RingDelta"];
+            /** @description Sender information */
+            sender: components["schemas"]["CongratulationSender"];
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DeleteGroupOutputBody.json
+             * @description Task name
+             * @example Complete project proposal
              */
-            readonly $schema?: string;
-            /** @example Group deleted successfully */
-            message: string;
-        };
-        DeleteNotificationOutputBody: {
+            taskName: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DeleteNotificationOutputBody.json
+             * @description Video thumbnail URL (video type only)
+             * @example https://example.com/thumb.jpg
              */
-            readonly $schema?: string;
-            /** @example Notification deleted successfully */
-            message: string;
-        };
-        DeletePersonalizationOutputBody: {
+            thumbnailUrl?: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DeletePersonalizationOutputBody.json
+             * Format: date-time
+             * @description Creation timestamp
+             * @example 2023-01-01T00:00:00Z
              */
-            readonly $schema?: string;
+            timestamp: string;
             /**
-             * Format: int64
-             * @description Number of facts removed
+             * @description Type of congratulation (message, image, or video)
+             * @example message
              */
-            deletedCount: number;
-            /** @description Always false — deleting turns personalization off so the next nightly run does not rebuild what was erased */
-            enabled: boolean;
-            /** @example Personalization data deleted */
-            message: string;
+            type: string;
         };
-        DeletePostOutputBody: {
+        CreateCongratulationParams: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DeletePostOutputBody.json
+             * @example https://example.com/schemas/CreateCongratulationParams.json
              */
             readonly $schema?: string;
-            /** @example Post deleted successfully */
-            message: string;
-        };
-        DeleteProfileOutputBody: {
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DeleteProfileOutputBody.json
+             * @description Category name
+             * @example Work
              */
-            readonly $schema?: string;
-            /** @example Profile deleted successfully */
-            message: string;
-        };
-        DeleteTaskOutputBody: {
+            categoryName: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DeleteTaskOutputBody.json
+             * Format: int64
+             * @description Video duration in milliseconds, max 30000 (required for video type)
+             * @example 15000
              */
-            readonly $schema?: string;
-            /** @example Task deleted successfully */
-            message: string;
-        };
-        DeleteWaitlistOutputBody: {
+            durationMs?: number;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DeleteWaitlistOutputBody.json
+             * @description Congratulation message
+             * @example Congratulations on completing your task!
              */
-            readonly $schema?: string;
-            /** @example Waitlist entry deleted successfully */
             message: string;
-            /** @example success */
-            status: string;
-        };
-        DeleteWorkspaceOutputBody: {
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DeleteWorkspaceOutputBody.json
+             * @description Optional post ID associated with the congratulation
+             * @example 507f1f77bcf86cd799439013
              */
-            readonly $schema?: string;
-            /** @example Workspace deleted successfully */
-            message: string;
-        };
-        Delta: {
+            postId?: string;
+            /** @description If true, the sender is anonymized to everyone except the receiver */
+            private?: boolean;
             /**
-             * Format: int64
-             * @description Points this action added
-             * @example 3
+             * @description Receiver user ID
+             * @example 507f1f77bcf86cd799439012
              */
-            delta: number;
+            receiver: string;
             /**
-             * @description Whether this bump crossed into a new level
-             * @example false
+             * @description Task name
+             * @example Complete project proposal
              */
-            leveledUp: boolean;
+            taskName: string;
             /**
-             * Format: int64
-             * @description Pair friendship score after the bump
-             * @example 28
+             * @description Video thumbnail URL (required for video type)
+             * @example https://example.com/thumb.jpg
              */
-            score: number;
-        };
-        DisconnectOutputBody: {
+            thumbnailUrl?: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DisconnectOutputBody.json
+             * @description Type of congratulation (message, image, or video)
+             * @example message
              */
-            readonly $schema?: string;
-            message: string;
-            success: boolean;
+            type: string;
         };
-        DismissCardOutputBody: {
+        CreateConnectionParams: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DismissCardOutputBody.json
+             * @example https://example.com/schemas/CreateConnectionParams.json
              */
             readonly $schema?: string;
-            /** @example Card dismissed */
-            message: string;
+            /**
+             * @description Receiver user ID
+             * @example 507f1f77bcf86cd799439012
+             */
+            receiver_id: string;
         };
-        DismissCardParams: {
+        CreateEncouragementOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/DismissCardParams.json
+             * @example https://example.com/schemas/CreateEncouragementOutputBody.json
              */
             readonly $schema?: string;
             /**
-             * @description ID of the card to dismiss
-             * @example kudos-665f...
+             * @description Category name
+             * @example Work
              */
-            cardId: string;
-        };
-        DisplaySettings: {
-            content_filter: boolean;
-            friend_activity_feed: boolean;
-            near_deadlines_widget: boolean;
-            recent_workspaces: boolean;
-            show_task_details: boolean;
-        };
-        EditResultResponse: {
+            categoryName?: string;
             /**
              * Format: int64
-             * @description Total number of tasks/templates edited
+             * @description Video duration in milliseconds (video type only)
+             * @example 15000
              */
-            editedCount: number;
-            /** @description Edited regular tasks */
-            tasks: components["schemas"]["TaskDocument"][];
-            /** @description Edited recurring template tasks */
-            templates: components["schemas"]["TemplateTaskDocument"][];
-        };
-        EditTasksNaturalLanguageInputBody: {
+            durationMs?: number;
+            /** @description Describes the friendship score bump with the receiver so the client can render feedback */
+            friendshipDelta?: components["schemas"]["Delta"];
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/EditTasksNaturalLanguageInputBody.json
+             * @description Unique identifier for the encouragement
+             * @example 507f1f77bcf86cd799439011
              */
-            readonly $schema?: string;
+            id: string;
             /**
-             * @description Natural language instruction for editing tasks
-             * @example move my dentist appointment to next Friday
+             * @description Encouragement message
+             * @example Great job on completing your task!
              */
-            text: string;
+            message: string;
             /**
-             * @description User's timezone (IANA format). Defaults to America/New_York if not provided
-             * @example America/New_York
+             * Format: date-time
+             * @description When the receiver reacted
              */
-            timezone?: string;
-        };
-        EditTasksNaturalLanguageOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/EditTasksNaturalLanguageOutputBody.json
-             */
-            readonly $schema?: string;
-            /**
-             * Format: int64
-             * @description Total number of tasks/templates that were edited
-             */
-            editedCount: number;
-            /** @example Successfully edited 2 tasks */
-            message: string;
-            /** @description List of edited regular tasks with their updated state */
-            tasks: components["schemas"]["TaskDocument"][];
-            /** @description List of edited recurring template tasks with their updated state */
-            templates: components["schemas"]["TemplateTaskDocument"][];
-        };
-        EncouragementDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/EncouragementDocument.json
-             */
-            readonly $schema?: string;
-            /**
-             * @description Category name
-             * @example Work
-             */
-            categoryName?: string;
-            /**
-             * Format: int64
-             * @description Video duration in milliseconds (video type only)
-             * @example 15000
-             */
-            durationMs?: number;
-            /**
-             * @description Unique identifier for the encouragement
-             * @example 507f1f77bcf86cd799439011
-             */
-            id: string;
-            /**
-             * @description Encouragement message
-             * @example Great job on completing your task!
-             */
-            message: string;
-            /**
-             * Format: date-time
-             * @description When the receiver reacted
-             */
-            reactedAt?: string;
+            reactedAt?: string;
             /**
              * @description Receiver's emoji reaction
              * @example ❤️
@@ -5563,6 +5662,8 @@ export interface components {
             receiver: string;
             /** @description Receiver's profile info (sent queries only) */
             receiverInfo?: components["schemas"]["EncouragementSender"];
+            /** @description Describes the Share ring increment triggered by this encouragement so the client can render feedback */
+            ringDelta?: components["schemas"]["RingDelta"];
             /**
              * @description Scope of encouragement (task or profile)
              * @example task
@@ -5597,3156 +5698,5709 @@ export interface components {
              */
             type: string;
         };
-        EncouragementReactionParams: {
+        CreateEncouragementParams: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/EncouragementReactionParams.json
+             * @example https://example.com/schemas/CreateEncouragementParams.json
              */
             readonly $schema?: string;
             /**
-             * @description Reaction emoji (one of the curated kudos reactions)
-             * @example ❤️
+             * @description Category name (required for task scope)
+             * @example Work
              */
-            emoji: string;
-        };
-        EncouragementSender: {
+            categoryName?: string;
             /**
-             * @description Sender's user ID
-             * @example 507f1f77bcf86cd799439011
+             * Format: int64
+             * @description Video duration in milliseconds, max 30000 (required for video type)
+             * @example 15000
              */
-            id: string;
+            durationMs?: number;
             /**
-             * @description Sender's name
-             * @example John Doe
+             * @description Encouragement message
+             * @example Great job on completing your task!
              */
-            name: string;
+            message: string;
             /**
-             * @description Sender's profile picture URL
-             * @example https://example.com/avatar.jpg
+             * @description Receiver user ID
+             * @example 507f1f77bcf86cd799439012
              */
-            picture: string;
-        };
-        EnhancedBlueprintReference: {
-            id: string;
-            isPublic: boolean;
-        };
-        ErrorDetail: {
-            /** @description Where the error occurred, e.g. 'body.items[3].tags' or 'path.thing-id' */
-            location?: string;
-            /** @description Error message text */
-            message?: string;
-            /** @description The value at the given location */
-            value?: unknown;
-        };
-        ErrorModel: {
+            receiver: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ErrorModel.json
+             * @description Scope of encouragement (task or profile)
+             * @example task
              */
-            readonly $schema?: string;
+            scope: string;
             /**
-             * @description A human-readable explanation specific to this occurrence of the problem.
-             * @example Property foo is required but is missing.
+             * @description Task ID (required for task scope)
+             * @example 507f1f77bcf86cd799439013
              */
-            detail?: string;
-            /** @description Optional list of individual error details */
-            errors?: components["schemas"]["ErrorDetail"][];
+            taskId?: string;
             /**
-             * Format: uri
-             * @description A URI reference that identifies the specific occurrence of the problem.
-             * @example https://example.com/error-log/abc123
+             * @description Task name (required for task scope)
+             * @example Complete project proposal
              */
-            instance?: string;
+            taskName?: string;
             /**
-             * Format: int64
-             * @description HTTP status code
-             * @example 400
+             * @description Video thumbnail URL (required for video type)
+             * @example https://example.com/thumb.jpg
              */
-            status?: number;
+            thumbnailUrl?: string;
             /**
-             * @description A short, human-readable summary of the problem type. This value should not change between occurrences of the error.
-             * @example Bad Request
+             * @description Type of encouragement (message, image, or video)
+             * @example message
              */
-            title?: string;
+            type: string;
+        };
+        CreateFriendListParams: {
             /**
              * Format: uri
-             * @description A URI reference to human-readable documentation for the error.
-             * @default about:blank
-             * @example https://example.com/errors/example
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/CreateFriendListParams.json
              */
-            type: string;
+            readonly $schema?: string;
+            /** @description Friend IDs; anyone who isn't a friend is dropped */
+            members?: string[];
+            /** @example Gym crew */
+            name: string;
         };
-        ExportPersonalizationResponse: {
+        CreateGroupParams: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ExportPersonalizationResponse.json
+             * @example https://example.com/schemas/CreateGroupParams.json
+             */
+            readonly $schema?: string;
+            members?: string[];
+            name: string;
+        };
+        CreateInviteParams: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/CreateInviteParams.json
              */
             readonly $schema?: string;
             /**
              * Format: int64
-             * @description Number of facts in the export
+             * @description How long the link works; defaults to three days
+             * @example 72
              */
-            count: number;
-            /** @description Everything currently held about this user */
-            facts: components["schemas"]["PersonalizationFact"][];
-            /** @description Consent state at export time */
-            settings: components["schemas"]["PersonalizationSettings"];
-            /** @description The account this export belongs to */
-            userId: string;
-        };
-        FeatureDefinition: {
-            description: string;
-            icon?: string;
-            id: string;
-            name: string;
-            /** Format: int64 */
-            requiredReferrals: number;
-        };
-        FeedItem: {
-            /** @description Post data (only present if type is 'post') */
-            post?: components["schemas"]["PostDocumentAPI"];
-            /** @description Rings closed data (only present if type is 'rings_closed') */
-            ringsClosed?: components["schemas"]["FeedRingsClosedData"];
-            /** @description Task data (only present if type is 'task') */
-            task?: components["schemas"]["FeedTaskData"];
-            /** @description Type of feed item: 'post', 'task', or 'rings_closed' */
-            type: string;
+            expiresInHours?: number;
         };
-        FeedRingsClosedData: {
-            content: string;
-            id: string;
-            timestamp: string;
-            /** @description User who closed their rings */
+        CreatePostOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/CreatePostOutputBody.json
+             */
+            readonly $schema?: string;
+            _id: string;
+            blueprint?: components["schemas"]["EnhancedBlueprintReference"];
+            caption: string;
+            category?: components["schemas"]["CategoryExtendedReference"];
+            comments: components["schemas"]["CommentDocumentAPI"][];
+            dual?: string;
+            groups?: string[];
+            images: string[];
+            kudos?: components["schemas"]["PostKudos"][];
+            media: components["schemas"]["MediaItem"][];
+            metadata: components["schemas"]["PostMetadata"];
+            reactions: {
+                [key: string]: string[];
+            };
+            /** @description Describes the Share ring increment triggered by this post so the client can render feedback */
+            ringDelta?: components["schemas"]["RingDelta"];
+            size?: components["schemas"]["ImageSize"];
+            song?: components["schemas"]["Song"];
+            taggedUsers?: components["schemas"]["MentionReference"][];
+            task?: components["schemas"]["PostTaskExtendedReference"];
             user: components["schemas"]["UserExtendedReference"];
         };
-        FeedTaskData: {
-            categoryId: string;
-            categoryName: string;
-            content: string;
-            id: string;
+        CreatePostOutputUserStats: {
             /** Format: int64 */
-            priority: number;
-            public: boolean;
-            timestamp: string;
-            /** @description User who created the task */
-            user: components["schemas"]["UserExtendedReference"];
-            /** Format: double */
-            value: number;
-            workspaceName: string;
+            points: number;
+            /** Format: int64 */
+            posts_made: number;
         };
-        FindUsersByPhoneNumbersInputBody: {
+        CreatePostParams: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/FindUsersByPhoneNumbersInputBody.json
+             * @example https://example.com/schemas/CreatePostParams.json
              */
             readonly $schema?: string;
-            /** @description List of phone numbers to search for */
-            numbers: string[];
-        };
-        FlexDetails: {
-            period: string;
-            /** Format: int64 */
-            target: number;
+            /** @description Who can see the post. Omitted, it comes from groups, then the author's default audience, then isPublic */
+            audience?: components["schemas"]["AudienceInput"];
+            blueprintId?: string;
+            blueprintIsPublic?: boolean;
+            caption: string;
+            dual?: string;
+            groups?: string[];
+            images: string[];
+            isPublic: boolean;
+            media?: components["schemas"]["MediaItemInput"][];
+            size?: components["schemas"]["ImageSize"];
+            song?: components["schemas"]["Song"];
+            /** @description Tagged user references as [{id, handle}]; malformed entries are dropped, never rejected */
+            taggedUsers?: unknown;
+            task?: components["schemas"]["PostTaskExtendedReference"];
         };
-        FlexInstanceInfo: {
-            /** Format: int64 */
-            instanceNumber: number;
-            period: string;
-            /** Format: int64 */
-            target: number;
-        };
-        FlexTemplateState: {
-            /** Format: int64 */
-            completedInPeriod: number;
-            /** Format: date-time */
-            cooldownUntil?: string;
-            period: string;
-            /** Format: date-time */
-            periodStart?: string;
-            /** Format: int64 */
-            target: number;
-        };
-        ForYouCard: {
-            /** @description Present iff displayMode == full */
-            body?: string;
-            ctas: components["schemas"]["ForYouCta"][];
-            /** @description Tap-target for the entire card */
-            deepLink: string;
-            displayMode: string;
-            iconKind: string;
-            id: string;
-            /** @description Structured value rows for weekly_recap cards */
-            metrics?: components["schemas"]["ForYouMetric"][];
-            /** Format: int64 */
-            priority: number;
-            subject?: components["schemas"]["ForYouSubject"];
-            title: string;
-            type: string;
-        };
-        ForYouCta: {
-            action: components["schemas"]["ForYouCtaAction"];
+        CreateTaskNaturalLanguageInputBody: {
             /**
-             * @description primary | secondary
-             * @example primary
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/CreateTaskNaturalLanguageInputBody.json
              */
-            kind: string;
-            /** @example Send one back */
-            label: string;
-        };
-        ForYouCtaAction: {
-            /** @example /(logged-in)/(tabs)/(task)/kudos */
-            href?: string;
-            postId?: string;
-            reaction?: string;
-            referenceId?: string;
-            targetUserId?: string;
-            taskId?: string;
+            readonly $schema?: string;
             /**
-             * @description navigate | send_kudos | send_encouragement | react | dismiss
-             * @example navigate
+             * @description Natural language description of tasks to create
+             * @example Buy groceries tomorrow at 3pm, finish project report by Friday
              */
-            type: string;
+            text: string;
+            /**
+             * @description User's timezone (IANA format). Defaults to America/New_York if not provided
+             * @example America/New_York
+             */
+            timezone?: string;
         };
-        ForYouFeed: {
+        CreateTaskNaturalLanguageOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ForYouFeed.json
+             * @example https://example.com/schemas/CreateTaskNaturalLanguageOutputBody.json
              */
             readonly $schema?: string;
-            sections: components["schemas"]["ForYouSection"][];
             /**
              * Format: int64
-             * @description Number of catch_up cards driving the tab dot
+             * @description Number of new categories created
              */
-            unreadCount: number;
-        };
-        ForYouMetric: {
-            /** @example Kudos received */
-            label: string;
+            categoriesCreated: number;
+            /** @example Successfully created 3 tasks in 2 categories */
+            message: string;
+            /** @description List of newly created categories with metadata */
+            newCategories: components["schemas"]["CategoryMetadata"][];
+            /** @description List of created tasks */
+            tasks: components["schemas"]["TaskDocument"][];
             /**
              * Format: int64
-             * @example 5
+             * @description Total number of tasks created
              */
-            value: number;
+            tasksCreated: number;
         };
-        ForYouSection: {
-            cards: components["schemas"]["ForYouCard"][];
+        CreateTaskOutputBody: {
             /**
-             * @description catch_up | suggested
-             * @example catch_up
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/CreateTaskOutputBody.json
              */
+            readonly $schema?: string;
+            active: boolean;
+            blueprintId?: string;
+            categoryID?: string;
+            checklist?: components["schemas"]["ChecklistItem"][];
+            completionType?: string;
+            content: string;
+            /** Format: date-time */
+            deadline?: string;
+            /** Format: int64 */
+            durationSeconds?: number;
+            encouragements?: components["schemas"]["TaskKudos"][];
+            flexInfo?: components["schemas"]["FlexInstanceInfo"];
             id: string;
-            /** @example Catch up */
-            title: string;
-        };
-        ForYouSubject: {
-            /** @example https://example.com/avatar.jpg */
-            avatarUrl?: string;
-            /** @example Sarah */
-            displayName: string;
-            /** @example 507f1f77bcf86cd799439011 */
-            userId: string;
+            integration?: string;
+            /** Format: date-time */
+            lastEdited: string;
+            notes?: string;
+            posted: boolean;
+            /** Format: int64 */
+            priority: number;
+            public: boolean;
+            pushed_calendar_id?: string;
+            pushed_event_etag?: string;
+            pushed_event_id?: string;
+            recurDetails?: components["schemas"]["RecurDetails"];
+            recurFrequency?: string;
+            recurType?: string;
+            recurring: boolean;
+            reminders?: components["schemas"]["Reminder"][];
+            /** Format: int64 */
+            rescheduleCount?: number;
+            /** @description Describes the Plan ring increment triggered by this creation so the client can render feedback */
+            ringDelta?: components["schemas"]["RingDelta"];
+            sessionNote?: string;
+            sessionPhoto?: string;
+            sessionTrackable?: boolean;
+            source?: string;
+            /** Format: date-time */
+            startDate: string;
+            /** Format: date-time */
+            startTime?: string;
+            /** Format: date-time */
+            startedAt?: string;
+            taggedUsers?: components["schemas"]["TaggedTaskUser"][];
+            taskId?: string;
+            templateID?: string;
+            /** Format: date-time */
+            timeCompleted?: string;
+            timeTaken?: string;
+            /** Format: date-time */
+            timestamp: string;
+            userID?: string;
+            /** Format: double */
+            value: number;
+            /** Format: date-time */
+            workingOnSince?: string;
         };
-        FriendReference: {
-            /**
-             * @description User ID
-             * @example 507f1f77bcf86cd799439011
-             */
-            _id: string;
-            /**
-             * @description User display name
-             * @example John Doe
-             */
-            display_name: string;
-            /**
-             * Format: int64
-             * @description Available encouragements to send
-             * @example 3
-             */
-            encouragements: number;
-            /**
-             * @description User handle
-             * @example johndoe
-             */
-            handle: string;
+        CreateTaskParams: {
             /**
-             * Format: int64
-             * @description Number of posts made this week
-             * @example 2
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/CreateTaskParams.json
              */
-            posts_this_week: number;
+            readonly $schema?: string;
+            active?: boolean;
+            checklist?: components["schemas"]["ChecklistItem"][];
+            content: string;
+            /** Format: date-time */
+            deadline?: string;
+            integration?: string;
+            notes?: string;
+            /** Format: int64 */
+            priority: number;
+            public: boolean;
+            recurDetails?: components["schemas"]["RecurDetails"];
+            recurFrequency?: string;
+            recurring: boolean;
+            reminders?: components["schemas"]["Reminder"][];
+            sessionTrackable?: boolean;
+            /** Format: date-time */
+            startDate?: string;
+            /** Format: date-time */
+            startTime?: string;
+            taggedListIds?: string[];
+            taggedUserIds?: string[];
+            /** Format: double */
+            value: number;
+        };
+        CreateWaitlistParams: {
             /**
-             * @description Profile picture URL
-             * @example https://example.com/avatar.jpg
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/CreateWaitlistParams.json
              */
-            profile_picture: string;
+            readonly $schema?: string;
             /**
-             * Format: int64
-             * @description Current streak
-             * @example 5
+             * @description User email address
+             * @example user@example.com
              */
-            streak: number;
+            email: string;
             /**
-             * Format: int64
-             * @description Total tasks completed
-             * @example 12
+             * @description User full name
+             * @example John Doe
              */
-            tasks_complete: number;
+            name: string;
         };
-        GenerateAndCreateBlueprintParams: {
+        DashboardConfiguration: {
+            google_calendar: boolean;
+            jump_back_in: boolean;
+            kudos: boolean;
+            recent_workspaces: boolean;
+            recently_completed: boolean;
+            stats: boolean;
+            upcoming: boolean;
+        };
+        DeleteAccountOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GenerateAndCreateBlueprintParams.json
+             * @example https://example.com/schemas/DeleteAccountOutputBody.json
              */
             readonly $schema?: string;
-            /**
-             * @description Description of what the blueprint should contain
-             * @example Morning routine for productivity
-             */
-            description: string;
+            /** @example Account deleted successfully */
+            message: string;
         };
-        GenerateImageUploadURLOutputBody: {
+        DeleteBlueprintOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GenerateImageUploadURLOutputBody.json
+             * @example https://example.com/schemas/DeleteBlueprintOutputBody.json
              */
             readonly $schema?: string;
-            /** @example profiles/507f1f77bcf86cd799439011/uuid.jpg */
-            key: string;
-            /** @example Upload URL generated successfully */
+            /** @example Blueprint deleted successfully */
             message: string;
-            /** @example https://kindred.nyc3.digitaloceanspaces.com/profiles/507f1f77bcf86cd799439011/uuid.jpg */
-            public_url: string;
-            /** @example https://presigned-upload-url... */
-            upload_url: string;
         };
-        GetAvailableFeaturesOutputBody: {
+        DeleteCategoryOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetAvailableFeaturesOutputBody.json
+             * @example https://example.com/schemas/DeleteCategoryOutputBody.json
              */
             readonly $schema?: string;
-            features: components["schemas"]["FeatureDefinition"][];
+            /** @example Category deleted successfully */
+            message: string;
         };
-        GetCompletedTasksByDateOutputBody: {
+        DeleteCommentOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetCompletedTasksByDateOutputBody.json
+             * @example https://example.com/schemas/DeleteCommentOutputBody.json
              */
             readonly $schema?: string;
-            tasks: components["schemas"]["TaskDocument"][];
+            message: string;
         };
-        GetCompletedTasksOutputBody: {
+        DeleteCongratulationOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetCompletedTasksOutputBody.json
+             * @example https://example.com/schemas/DeleteCongratulationOutputBody.json
              */
             readonly $schema?: string;
-            /**
-             * Format: int64
-             * @description Tasks per page
-             */
-            limit: number;
-            /**
-             * Format: int64
-             * @description Current page number
-             */
-            page: number;
-            /** @description List of completed tasks */
-            tasks: components["schemas"]["TaskDocument"][];
-            /**
-             * Format: int64
-             * @description Total number of completed tasks
-             */
-            total: number;
-            /**
-             * Format: int64
-             * @description Total number of pages
-             */
-            totalPages: number;
+            /** @example Congratulation deleted successfully */
+            message: string;
         };
-        GetConnectionsOutputBody: {
+        DeleteConnectionOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetConnectionsOutputBody.json
+             * @example https://example.com/schemas/DeleteConnectionOutputBody.json
              */
             readonly $schema?: string;
-            connections: components["schemas"]["CalendarConnection"][];
+            /** @example Connection deleted successfully */
+            message: string;
         };
-        GetEventsOutputBody: {
+        DeleteDraftOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetEventsOutputBody.json
+             * @example https://example.com/schemas/DeleteDraftOutputBody.json
              */
             readonly $schema?: string;
-            events: components["schemas"]["CalendarEventDTO"][];
+            /** @example Draft deleted successfully */
+            message: string;
         };
-        GetFeedOutputBody: {
+        DeleteEncouragementOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetFeedOutputBody.json
+             * @example https://example.com/schemas/DeleteEncouragementOutputBody.json
              */
             readonly $schema?: string;
-            /** @description Whether there are more feed items to fetch */
-            hasMore: boolean;
-            /** @description Mixed feed items containing posts and tasks */
-            items: components["schemas"]["FeedItem"][];
+            /** @example Encouragement deleted successfully */
+            message: string;
+        };
+        DeleteFriendListOutputBody: {
             /**
-             * Format: int64
-             * @description Offset for the next page
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/DeleteFriendListOutputBody.json
              */
-            nextOffset: number;
+            readonly $schema?: string;
+            /** @example Friend list deleted successfully */
+            message: string;
+        };
+        DeleteGroupOutputBody: {
             /**
-             * Format: int64
-             * @description Total number of feed items available
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/DeleteGroupOutputBody.json
              */
-            total: number;
+            readonly $schema?: string;
+            /** @example Group deleted successfully */
+            message: string;
         };
-        GetFriendsPostsOutputBody: {
+        DeleteNotificationOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetFriendsPostsOutputBody.json
+             * @example https://example.com/schemas/DeleteNotificationOutputBody.json
              */
             readonly $schema?: string;
-            /** @description Whether there are more posts to fetch */
-            hasMore: boolean;
+            /** @example Notification deleted successfully */
+            message: string;
+        };
+        DeletePersonalizationOutputBody: {
             /**
-             * Format: int64
-             * @description Offset for the next page
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/DeletePersonalizationOutputBody.json
              */
-            nextOffset: number;
-            posts: components["schemas"]["PostDocumentAPI"][];
+            readonly $schema?: string;
             /**
              * Format: int64
-             * @description Total number of posts available
+             * @description Number of facts removed
              */
-            total: number;
+            deletedCount: number;
+            /** @description Always false — deleting turns personalization off so the next nightly run does not rebuild what was erased */
+            enabled: boolean;
+            /** @example Personalization data deleted */
+            message: string;
         };
-        GetGroupsOutputBody: {
+        DeletePostOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetGroupsOutputBody.json
+             * @example https://example.com/schemas/DeletePostOutputBody.json
              */
             readonly $schema?: string;
-            groups: components["schemas"]["GroupDocumentAPI"][];
+            /** @example Post deleted successfully */
+            message: string;
         };
-        GetHistoryResponseBody: {
+        DeleteProfileOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetHistoryResponseBody.json
+             * @example https://example.com/schemas/DeleteProfileOutputBody.json
              */
             readonly $schema?: string;
-            history: components["schemas"]["RingState"][];
-            /** Format: int64 */
-            score: number;
-            /** Format: int64 */
-            streak: number;
+            /** @example Profile deleted successfully */
+            message: string;
         };
-        GetNotificationsOutputBody: {
+        DeleteTaskOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetNotificationsOutputBody.json
+             * @example https://example.com/schemas/DeleteTaskOutputBody.json
              */
             readonly $schema?: string;
-            notifications: components["schemas"]["NotificationDocument"][];
-            /** Format: int64 */
-            total: number;
-            /** Format: int64 */
-            unread_count: number;
+            /** @example Task deleted successfully */
+            message: string;
         };
-        GetPostsByBlueprintOutputBody: {
+        DeleteWaitlistOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetPostsByBlueprintOutputBody.json
+             * @example https://example.com/schemas/DeleteWaitlistOutputBody.json
              */
             readonly $schema?: string;
-            posts: components["schemas"]["PostDocumentAPI"][];
+            /** @example Waitlist entry deleted successfully */
+            message: string;
+            /** @example success */
+            status: string;
         };
-        GetPostsOutputBody: {
+        DeleteWorkspaceOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetPostsOutputBody.json
+             * @example https://example.com/schemas/DeleteWorkspaceOutputBody.json
              */
             readonly $schema?: string;
-            /** @description Whether there are more posts to fetch */
-            hasMore: boolean;
+            /** @example Workspace deleted successfully */
+            message: string;
+        };
+        Delta: {
             /**
              * Format: int64
-             * @description Offset for the next page
+             * @description Points this action added
+             * @example 3
              */
-            nextOffset: number;
-            posts: components["schemas"]["PostDocumentAPI"][];
+            delta: number;
+            /**
+             * @description Whether this bump crossed into a new level
+             * @example false
+             */
+            leveledUp: boolean;
             /**
              * Format: int64
-             * @description Total number of posts available
+             * @description Pair friendship score after the bump
+             * @example 28
              */
-            total: number;
+            score: number;
         };
-        GetReferralStatsOutputBody: {
+        DisconnectOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetReferralStatsOutputBody.json
+             * @example https://example.com/schemas/DisconnectOutputBody.json
              */
             readonly $schema?: string;
-            /** Format: int64 */
-            activeReferrals: number;
-            referredUsers: components["schemas"]["ReferredUserInfo"][];
-            /** Format: int64 */
-            totalReferrals: number;
-            unlockedFeatures: components["schemas"]["UnlockedFeature"][];
-            /** Format: int64 */
-            unlocksRemaining: number;
+            message: string;
+            success: boolean;
         };
-        GetReportsOutputBody: {
+        DismissCardOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetReportsOutputBody.json
+             * @example https://example.com/schemas/DismissCardOutputBody.json
              */
             readonly $schema?: string;
-            reports: components["schemas"]["ReportDocumentAPI"][];
-            /** Format: int64 */
-            total: number;
+            /** @example Card dismissed */
+            message: string;
         };
-        GetTaskProgressOutputBody: {
+        DismissCardParams: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetTaskProgressOutputBody.json
+             * @example https://example.com/schemas/DismissCardParams.json
              */
             readonly $schema?: string;
-            /** @description Progress-log entries for this task, most recent first */
-            entries: components["schemas"]["TaskDocument"][];
-            /**
-             * Format: int64
-             * @description Number of sessions logged
-             * @example 3
-             */
-            totalCount: number;
             /**
-             * Format: int64
-             * @description Total duration logged across all sessions, in seconds
-             * @example 4320
+             * @description ID of the card to dismiss
+             * @example kudos-665f...
              */
-            totalSeconds: number;
+            cardId: string;
         };
-        GetTodayResponseBody: {
+        DisplaySettings: {
+            content_filter: boolean;
+            friend_activity_feed: boolean;
+            near_deadlines_widget: boolean;
+            recent_workspaces: boolean;
+            show_task_details: boolean;
+        };
+        DraftContent: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetTodayResponseBody.json
+             * @example https://example.com/schemas/DraftContent.json
              */
             readonly $schema?: string;
-            /** Format: int64 */
-            current_streak: number;
-            /** Format: int64 */
-            productivity_score: number;
-            reward_available: boolean;
-            ring_state: components["schemas"]["RingState"];
+            audience?: components["schemas"]["AudienceInput"];
+            blueprintId?: string;
+            blueprintIsPublic?: boolean;
+            caption: string;
+            dual?: string;
+            groups?: string[];
+            images?: string[];
+            isPublic: boolean;
+            media?: components["schemas"]["MediaItemInput"][];
+            size?: components["schemas"]["ImageSize"];
+            song?: components["schemas"]["Song"];
+            taggedUsers?: components["schemas"]["MentionInput"][];
+            task?: components["schemas"]["PostTaskExtendedReference"];
         };
-        GetUserGroupsOutputBody: {
+        EditCommentOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetUserGroupsOutputBody.json
+             * @example https://example.com/schemas/EditCommentOutputBody.json
              */
             readonly $schema?: string;
-            groups: components["schemas"]["GroupDocumentAPI"][];
+            comment: components["schemas"]["CommentDocumentAPI"];
+            /** @example Comment updated successfully */
+            message: string;
         };
-        GetUserPostsOutputBody: {
+        EditCommentParams: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetUserPostsOutputBody.json
+             * @example https://example.com/schemas/EditCommentParams.json
              */
             readonly $schema?: string;
-            /** @description Whether there are more posts to fetch */
-            hasMore: boolean;
-            /**
-             * Format: int64
-             * @description Offset for the next page
-             */
-            nextOffset: number;
-            posts: components["schemas"]["PostDocumentAPI"][];
+            content: string;
+            mentions?: components["schemas"]["MentionInput"][];
+        };
+        EditResultResponse: {
             /**
              * Format: int64
-             * @description Total number of posts available
+             * @description Total number of tasks/templates edited
              */
-            total: number;
+            editedCount: number;
+            /** @description Edited regular tasks */
+            tasks: components["schemas"]["TaskDocument"][];
+            /** @description Edited recurring template tasks */
+            templates: components["schemas"]["TemplateTaskDocument"][];
         };
-        GetUserTagsOutputBody: {
+        EditTasksNaturalLanguageInputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetUserTagsOutputBody.json
+             * @example https://example.com/schemas/EditTasksNaturalLanguageInputBody.json
              */
             readonly $schema?: string;
-            tags: string[];
-        };
-        GetUserTemplatesOutputBody: {
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetUserTemplatesOutputBody.json
+             * @description Natural language instruction for editing tasks
+             * @example move my dentist appointment to next Friday
              */
-            readonly $schema?: string;
-            templates: components["schemas"]["TemplateWithCategory"][];
-        };
-        GetUsersByIDsInputBody: {
+            text: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetUsersByIDsInputBody.json
+             * @description User's timezone (IANA format). Defaults to America/New_York if not provided
+             * @example America/New_York
              */
-            readonly $schema?: string;
-            /** @description List of user IDs to resolve to profile references */
-            userIds: string[];
+            timezone?: string;
         };
-        GetUsersByIDsOutputBody: {
+        EditTasksNaturalLanguageOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GetUsersByIDsOutputBody.json
+             * @example https://example.com/schemas/EditTasksNaturalLanguageOutputBody.json
              */
             readonly $schema?: string;
-            users: components["schemas"]["UserExtendedReference"][];
-        };
-        GroupDocumentAPI: {
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/GroupDocumentAPI.json
+             * Format: int64
+             * @description Total number of tasks/templates that were edited
              */
-            readonly $schema?: string;
-            _id: string;
-            creator: string;
-            members: components["schemas"]["UserExtendedReference"][];
-            metadata: components["schemas"]["GroupMetadata"];
-            name: string;
-        };
-        GroupMetadata: {
-            /** Format: date-time */
-            createdAt: string;
-            isDeleted: boolean;
-            /** Format: date-time */
-            updatedAt: string;
+            editedCount: number;
+            /** @example Successfully edited 2 tasks */
+            message: string;
+            /** @description List of edited regular tasks with their updated state */
+            tasks: components["schemas"]["TaskDocument"][];
+            /** @description List of edited recurring template tasks with their updated state */
+            templates: components["schemas"]["TemplateTaskDocument"][];
         };
-        HealthOutputBody: {
+        EncouragementDocument: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/HealthOutputBody.json
+             * @example https://example.com/schemas/EncouragementDocument.json
              */
             readonly $schema?: string;
-            /** @example ok */
-            status: string;
-        };
-        ImageSize: {
-            /** Format: int64 */
-            bytes: number;
-            /** Format: int64 */
-            height: number;
-            /** Format: int64 */
-            width: number;
-        };
-        IntentOpResponse: {
-            /** @description Populated for create ops — preview payload to pass to /confirm */
-            createPreview?: components["schemas"]["MultiTaskOutputLocal"];
-            /** @description Populated for delete ops — tasks matching the delete query */
-            deleteTasks?: components["schemas"]["TaskDocument"][];
-            /** @description Populated for edit ops — tasks already updated server-side */
-            editResult?: components["schemas"]["EditResultResponse"];
-            /** @description Operation type: 'create', 'edit', or 'delete' */
-            type: string;
-        };
-        IntentTaskNaturalLanguageInputBody: {
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/IntentTaskNaturalLanguageInputBody.json
+             * @description Category name
+             * @example Work
              */
-            readonly $schema?: string;
+            categoryName?: string;
             /**
-             * @description Natural language instruction (may contain create, edit, and/or delete operations)
-             * @example delete my grocery tasks and add a dentist appointment tomorrow
+             * Format: int64
+             * @description Video duration in milliseconds (video type only)
+             * @example 15000
              */
-            text: string;
+            durationMs?: number;
             /**
-             * @description User's timezone (IANA format). Defaults to America/New_York if not provided
-             * @example America/New_York
+             * @description Unique identifier for the encouragement
+             * @example 507f1f77bcf86cd799439011
              */
-            timezone?: string;
-        };
-        IntentTaskNaturalLanguageOutputBody: {
+            id: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/IntentTaskNaturalLanguageOutputBody.json
+             * @description Encouragement message
+             * @example Great job on completing your task!
              */
-            readonly $schema?: string;
-            /** @description Ordered list of decomposed operations. Edits are already applied; deletes and creates need frontend confirmation. */
-            ops: components["schemas"]["IntentOpResponse"][];
-        };
-        KudosRewards: {
+            message: string;
             /**
-             * Format: int64
-             * @description Number of congratulations sent (for rewards tracking)
+             * Format: date-time
+             * @description When the receiver reacted
              */
-            congratulations: number;
+            reactedAt?: string;
             /**
-             * Format: int64
-             * @description Number of encouragements sent (for rewards tracking)
+             * @description Receiver's emoji reaction
+             * @example ❤️
              */
-            encouragements: number;
-        };
-        KudosSender: {
-            handle: string;
-            icon: string;
-            id: string;
-            name: string;
-        };
-        ListCalendarsOutputBody: {
+            reaction?: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ListCalendarsOutputBody.json
+             * @description Whether the encouragement has been read
+             * @example false
              */
-            readonly $schema?: string;
-            calendars: components["schemas"]["CalendarInfo"][];
-        };
-        LogProgressDocument: {
+            read: boolean;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/LogProgressDocument.json
+             * @description Receiver user ID
+             * @example 507f1f77bcf86cd799439012
              */
-            readonly $schema?: string;
+            receiver: string;
+            /** @description Receiver's profile info (sent queries only) */
+            receiverInfo?: components["schemas"]["EncouragementSender"];
             /**
-             * Format: int64
-             * @description Duration of this work session, in seconds
-             * @example 1800
+             * @description Scope of encouragement (task or profile)
+             * @example task
              */
-            durationSeconds: number;
-            /** @description Optional note about this session */
-            note?: string;
-            /** @description Optional photo URL for this session */
-            photo?: string;
-        };
-        LogProgressOutputBody: {
+            scope: string;
+            /** @description Sender information */
+            sender: components["schemas"]["EncouragementSender"];
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/LogProgressOutputBody.json
+             * @description Task ID being encouraged
+             * @example 507f1f77bcf86cd799439013
              */
-            readonly $schema?: string;
-            /** @description The created progress-log record */
-            entry: components["schemas"]["TaskDocument"];
-            /** @example Progress logged successfully */
-            message: string;
-            /** @description Describes the Do ring increment triggered by this log, if any (capped at one per task per day) */
-            ringDelta?: components["schemas"]["RingDelta"];
-        };
-        LogTaskEntry: {
+            taskId?: string;
             /**
-             * @description What the user got done
-             * @example Went to the gym
+             * @description Task name
+             * @example Complete project proposal
              */
-            content: string;
-        };
-        LogTasksInputBody: {
+            taskName?: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/LogTasksInputBody.json
+             * @description Video thumbnail URL (video type only)
+             * @example https://example.com/thumb.jpg
              */
-            readonly $schema?: string;
-            /** @description Things the user did today */
-            tasks: components["schemas"]["LogTaskEntry"][];
+            thumbnailUrl?: string;
             /**
-             * @description Workspace whose Logged category receives the tasks
-             * @example Personal
+             * Format: date-time
+             * @description Creation timestamp
+             * @example 2023-01-01T00:00:00Z
              */
-            workspaceName: string;
+            timestamp: string;
+            /**
+             * @description Type of encouragement (message or image)
+             * @example message
+             */
+            type: string;
         };
-        LogTasksOutputBody: {
+        EncouragementReactionParams: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/LogTasksOutputBody.json
+             * @example https://example.com/schemas/EncouragementReactionParams.json
              */
             readonly $schema?: string;
             /**
-             * Format: int64
-             * @description The user's current streak count
-             * @example 5
-             */
-            currentStreak: number;
-            /** @description Indices of entries that failed */
-            failedIndices?: number[];
-            /** @example Tasks logged */
-            message: string;
-            /**
-             * Format: int64
-             * @description Number of entries created and completed
-             * @example 3
+             * @description Reaction emoji (one of the curated kudos reactions)
+             * @example ❤️
              */
-            tasksLogged: number;
+            emoji: string;
         };
-        LoginRequest: {
+        EncouragementSender: {
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/LoginRequest.json
+             * @description Sender's user ID
+             * @example 507f1f77bcf86cd799439011
              */
-            readonly $schema?: string;
-            email: string;
-            password: string;
-        };
-        LoginRequestApple: {
+            id: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/LoginRequestApple.json
+             * @description Sender's name
+             * @example John Doe
              */
-            readonly $schema?: string;
-            apple_id: string;
-            id_token?: string;
-        };
-        LoginRequestGoogle: {
+            name: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/LoginRequestGoogle.json
+             * @description Sender's profile picture URL
+             * @example https://example.com/avatar.jpg
              */
-            readonly $schema?: string;
-            email?: string;
-            google_id: string;
-            id_token?: string;
+            picture: string;
         };
-        LoginRequestPhone: {
+        EnhancedBlueprintReference: {
+            id: string;
+            isPublic: boolean;
+        };
+        ErrorDetail: {
+            /** @description Where the error occurred, e.g. 'body.items[3].tags' or 'path.thing-id' */
+            location?: string;
+            /** @description Error message text */
+            message?: string;
+            /** @description The value at the given location */
+            value?: unknown;
+        };
+        ErrorModel: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/LoginRequestPhone.json
+             * @example https://example.com/schemas/ErrorModel.json
              */
             readonly $schema?: string;
-            password: string;
-            phone_number: string;
-        };
-        LoginWithOTPRequest: {
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/LoginWithOTPRequest.json
+             * @description A human-readable explanation specific to this occurrence of the problem.
+             * @example Property foo is required but is missing.
              */
-            readonly $schema?: string;
-            code: string;
-            phone_number: string;
-        };
-        LogoutOutputBody: {
+            detail?: string;
+            /** @description Optional list of individual error details */
+            errors?: components["schemas"]["ErrorDetail"][];
             /**
              * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/LogoutOutputBody.json
+             * @description A URI reference that identifies the specific occurrence of the problem.
+             * @example https://example.com/error-log/abc123
              */
-            readonly $schema?: string;
-            /** @example Logout Successful */
-            message: string;
-        };
-        MarkAllNotificationsReadOutputBody: {
+            instance?: string;
+            /**
+             * Format: int64
+             * @description HTTP status code
+             * @example 400
+             */
+            status?: number;
+            /**
+             * @description A short, human-readable summary of the problem type. This value should not change between occurrences of the error.
+             * @example Bad Request
+             */
+            title?: string;
             /**
              * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/MarkAllNotificationsReadOutputBody.json
+             * @description A URI reference to human-readable documentation for the error.
+             * @default about:blank
+             * @example https://example.com/errors/example
              */
-            readonly $schema?: string;
-            /** @example All notifications marked as read successfully */
-            message: string;
+            type: string;
         };
-        MarkCongratulationsReadOutputBody: {
+        ExportPersonalizationResponse: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/MarkCongratulationsReadOutputBody.json
+             * @example https://example.com/schemas/ExportPersonalizationResponse.json
              */
             readonly $schema?: string;
             /**
              * Format: int64
-             * @description Number of congratulations marked as read
-             * @example 2
+             * @description Number of facts in the export
              */
             count: number;
-            /** @example Congratulations marked as read successfully */
-            message: string;
+            /** @description Everything currently held about this user */
+            facts: components["schemas"]["PersonalizationFact"][];
+            /** @description Consent state at export time */
+            settings: components["schemas"]["PersonalizationSettings"];
+            /** @description The account this export belongs to */
+            userId: string;
         };
-        MarkCongratulationsReadParams: {
+        FeatureDefinition: {
+            description: string;
+            icon?: string;
+            id: string;
+            name: string;
+            /** Format: int64 */
+            requiredReferrals: number;
+        };
+        FeedItem: {
+            /** @description Post data (only present if type is 'post') */
+            post?: components["schemas"]["PostDocumentAPI"];
+            /** @description Rings closed data (only present if type is 'rings_closed') */
+            ringsClosed?: components["schemas"]["FeedRingsClosedData"];
+            /** @description Why a ranked item landed where it did (only with debug=true) */
+            score?: components["schemas"]["FeedScore"];
+            /** @description Task data (only present if type is 'task') */
+            task?: components["schemas"]["FeedTaskData"];
+            /** @description Type of feed item: 'post', 'task', or 'rings_closed' */
+            type: string;
+        };
+        FeedRingsClosedData: {
+            content: string;
+            id: string;
+            timestamp: string;
+            /** @description User who closed their rings */
+            user: components["schemas"]["UserExtendedReference"];
+        };
+        FeedScore: {
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/MarkCongratulationsReadParams.json
+             * Format: double
+             * @description How much the author's encouragement lands with the viewer
              */
-            readonly $schema?: string;
-            /** @description List of congratulation IDs to mark as read */
-            id: string[];
-        };
-        MarkEncouragementsReadBody: {
+            affinity: number;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/MarkEncouragementsReadBody.json
+             * Format: double
+             * @description Reactions and comments on the post
              */
-            readonly $schema?: string;
-            /** @description List of encouragement IDs to mark as read */
-            id: string[];
-        };
-        MarkEncouragementsReadOutputBody: {
+            engagement: number;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/MarkEncouragementsReadOutputBody.json
+             * Format: double
+             * @description Pair friendship level with the author
              */
-            readonly $schema?: string;
+            friendship: number;
             /**
-             * Format: int64
-             * @description Number of encouragements marked as read
-             * @example 2
+             * Format: double
+             * @description Kudos recorded on the post
              */
-            count: number;
-            /** @example Encouragements marked as read successfully */
-            message: string;
-        };
-        MarkNotificationsReadBody: {
+            kudos: number;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/MarkNotificationsReadBody.json
+             * Format: double
+             * @description Decays with age from the start of the session
              */
-            readonly $schema?: string;
-            /** @description List of notification IDs to mark as read */
-            id: string[];
+            recency: number;
+            /**
+             * Format: double
+             * @description Penalty for an item the viewer saw in an earlier session; zero or negative
+             */
+            seen: number;
+            /** Format: double */
+            total: number;
         };
-        MarkNotificationsReadOutputBody: {
+        FeedTaskData: {
+            categoryId: string;
+            categoryName: string;
+            /** @description When a friend completed the task; absent for open tasks offered for encouragement */
+            completedAt?: string;
+            content: string;
+            id: string;
+            /** Format: int64 */
+            priority: number;
+            public: boolean;
+            timestamp: string;
+            /** @description User who created the task */
+            user: components["schemas"]["UserExtendedReference"];
+            /** Format: double */
+            value: number;
+            workspaceName: string;
+        };
+        FindUsersByPhoneNumbersInputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/MarkNotificationsReadOutputBody.json
+             * @example https://example.com/schemas/FindUsersByPhoneNumbersInputBody.json
              */
             readonly $schema?: string;
-            /**
-             * Format: int64
-             * @description Number of notifications marked as read
-             * @example 2
-             */
-            count: number;
-            /** @example Notifications marked as read successfully */
-            message: string;
+            /** @description List of phone numbers to search for */
+            numbers: string[];
         };
-        MediaItem: {
-            /** Format: int64 */
-            bytes?: number;
-            /** Format: int64 */
-            durationMs?: number;
-            /** Format: int64 */
-            height: number;
-            thumbnailUrl?: string;
-            type: string;
-            url: string;
+        FlexDetails: {
+            period: string;
             /** Format: int64 */
-            width: number;
+            target: number;
         };
-        MediaItemInput: {
+        FlexInstanceInfo: {
             /** Format: int64 */
-            bytes?: number;
+            instanceNumber: number;
+            period: string;
             /** Format: int64 */
-            durationMs?: number;
+            target: number;
+        };
+        FlexTemplateState: {
             /** Format: int64 */
-            height: number;
-            thumbnailUrl?: string;
-            type: string;
-            url: string;
+            completedInPeriod: number;
+            /** Format: date-time */
+            cooldownUntil?: string;
+            period: string;
+            /** Format: date-time */
+            periodStart?: string;
             /** Format: int64 */
-            width: number;
+            target: number;
         };
-        MentionInput: {
-            handle: string;
+        ForYouCard: {
+            /** @description Present iff displayMode == full */
+            body?: string;
+            ctas: components["schemas"]["ForYouCta"][];
+            /** @description Tap-target for the entire card */
+            deepLink: string;
+            displayMode: string;
+            iconKind: string;
             id: string;
+            /** @description Structured value rows for weekly_recap cards */
+            metrics?: components["schemas"]["ForYouMetric"][];
+            /** Format: int64 */
+            priority: number;
+            subject?: components["schemas"]["ForYouSubject"];
+            title: string;
+            type: string;
         };
-        MentionReference: {
-            handle: string;
-            icon?: string;
-            id: string;
-            name?: string;
-        };
-        MoveTaskInputBody: {
+        ForYouCta: {
+            action: components["schemas"]["ForYouCtaAction"];
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/MoveTaskInputBody.json
+             * @description primary | secondary
+             * @example primary
              */
-            readonly $schema?: string;
+            kind: string;
+            /** @example Send one back */
+            label: string;
+        };
+        ForYouCtaAction: {
+            /** @example /(logged-in)/(tabs)/(task)/kudos */
+            href?: string;
+            postId?: string;
+            reaction?: string;
+            referenceId?: string;
+            targetUserId?: string;
+            taskId?: string;
             /**
-             * @description Destination category ID
-             * @example 507f1f77bcf86cd799439012
+             * @description navigate | send_kudos | send_encouragement | react | dismiss
+             * @example navigate
              */
-            targetCategoryID: string;
+            type: string;
         };
-        MoveTaskOutputBody: {
+        ForYouFeed: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/MoveTaskOutputBody.json
+             * @example https://example.com/schemas/ForYouFeed.json
              */
             readonly $schema?: string;
-            /** @example Task moved successfully */
-            message: string;
-        };
-        MultiTaskOutputLocal: {
-            categories: components["schemas"]["NewCategoryWithTasksLocal"][];
-            tasks: components["schemas"]["CategoryTaskPairLocal"][];
-        };
-        NewCategoryWithTasksLocal: {
-            name: string;
-            tasks: components["schemas"]["CreateTaskParams"][];
-            workspaceName: string;
-        };
-        NextFlexTaskDTO: {
-            categoryId: string;
-            task: components["schemas"]["TaskDocument"];
-        };
-        NotificationDocument: {
-            content: string;
-            id: string;
-            notificationType: string;
-            read: boolean;
-            receiver: string;
-            reference_id: string;
-            /** @description Optional thumbnail image URL for the notification */
-            thumbnail?: string;
-            /** Format: date-time */
-            time: string;
-            user: components["schemas"]["UserReference"];
-        };
-        NotificationSettings: {
-            checkin_frequency: string;
-            comments: boolean;
-            congratulations: boolean;
-            encouragements: boolean;
-            friend_activity: boolean;
-            friend_posts: boolean;
-            friend_requests: boolean;
-            near_deadlines: boolean;
-            reactions: boolean;
-        };
-        PendingTaggedTask: {
-            checklist?: components["schemas"]["ChecklistItem"][];
-            content: string;
-            /** Format: date-time */
-            deadline?: string;
-            notes?: string;
-            /** Format: int64 */
-            priority: number;
-            recurDetails?: components["schemas"]["RecurDetails"];
-            recurFrequency?: string;
-            recurring: boolean;
-            tagger: components["schemas"]["TaggerInfo"];
-            taskId: string;
-            /** Format: date-time */
-            timestamp: string;
-            /** Format: double */
-            value: number;
-        };
-        PersonalizationFact: {
-            /**
-             * Format: double
-             * @description 0..1; stated answers are always 1
-             */
-            confidence: number;
-            /** Format: date-time */
-            createdAt: string;
-            /** @description The numbers the fact was computed from */
-            evidence: {
-                [key: string]: unknown;
-            };
+            sections: components["schemas"]["ForYouSection"][];
             /**
-             * Format: date-time
-             * @description When this expires on its own. Absent for stated answers, which never expire.
+             * Format: int64
+             * @description Number of catch_up cards driving the tab dot
              */
-            expiresAt?: string;
-            /** @description Stable slug, e.g. peak-hours */
-            key: string;
-            /** @description rhythm|reliability|pacing|focus|style|theme|social */
-            kind: string;
-            /** @description derived (observed by the worker) or stated (the user told us) */
-            source: string;
-            /** Format: date-time */
-            updatedAt: string;
+            unreadCount: number;
         };
-        PersonalizationSettings: {
+        ForYouMetric: {
+            /** @example Kudos received */
+            label: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/PersonalizationSettings.json
+             * Format: int64
+             * @example 5
              */
-            readonly $schema?: string;
-            enabled: boolean;
-            /** Format: date-time */
-            pausedUntil?: string;
-            shareStruggles: boolean;
+            value: number;
         };
-        PostDocumentAPI: {
+        ForYouSection: {
+            cards: components["schemas"]["ForYouCard"][];
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/PostDocumentAPI.json
+             * @description catch_up | suggested
+             * @example catch_up
              */
-            readonly $schema?: string;
-            _id: string;
-            blueprint?: components["schemas"]["EnhancedBlueprintReference"];
-            caption: string;
-            category?: components["schemas"]["CategoryExtendedReference"];
-            comments: components["schemas"]["CommentDocumentAPI"][];
-            dual?: string;
-            groups?: string[];
-            images: string[];
-            kudos?: components["schemas"]["PostKudos"][];
-            media: components["schemas"]["MediaItem"][];
-            metadata: components["schemas"]["PostMetadata"];
-            reactions: {
-                [key: string]: string[];
-            };
-            size?: components["schemas"]["ImageSize"];
-            song?: components["schemas"]["Song"];
-            taggedUsers?: components["schemas"]["MentionReference"][];
-            task?: components["schemas"]["PostTaskExtendedReference"];
-            user: components["schemas"]["UserExtendedReference"];
-        };
-        PostKudos: {
-            congratulationId: string;
-            /** Format: int64 */
-            durationMs?: number;
-            message: string;
-            private?: boolean;
-            sender: components["schemas"]["KudosSender"];
-            thumbnailUrl?: string;
-            /** Format: date-time */
-            timestamp: string;
-            type: string;
-        };
-        PostMetadata: {
-            /** Format: date-time */
-            createdAt: string;
-            isDeleted: boolean;
-            isEdited: boolean;
-            isPublic: boolean;
-            /** Format: date-time */
-            updatedAt: string;
-        };
-        PostTaskExtendedReference: {
-            blueprintId?: string;
-            category: components["schemas"]["CategoryExtendedReference"];
-            content: string;
             id: string;
-            status?: string;
+            /** @example Catch up */
+            title: string;
         };
-        PreviewTaskFromImageInputBody: {
+        ForYouSubject: {
+            /** @example https://example.com/avatar.jpg */
+            avatarUrl?: string;
+            /** @example Sarah */
+            displayName: string;
+            /** @example 507f1f77bcf86cd799439011 */
+            userId: string;
+        };
+        FriendListDocument: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/PreviewTaskFromImageInputBody.json
+             * @example https://example.com/schemas/FriendListDocument.json
              */
             readonly $schema?: string;
-            /** @description Base64-encoded image (no data URL prefix) to extract tasks from */
-            image: string;
-            /**
-             * @description MIME type of the image (e.g. image/png, image/jpeg). Defaults to image/jpeg if not provided
-             * @example image/png
-             */
-            mimeType?: string;
+            /** @description Whether this is the built-in close friends list */
+            builtIn: boolean;
             /**
-             * @description User's timezone (IANA format). Defaults to America/New_York if not provided
-             * @example America/New_York
+             * @description List ID, or close_friends for the built-in list
+             * @example close_friends
              */
-            timezone?: string;
+            id: string;
+            /** @description Friends on the list */
+            members: components["schemas"]["ConnectionUser"][];
+            /** @example Close friends */
+            name: string;
         };
-        PreviewTaskFromImageOutputBody: {
+        FriendListSettings: {
+            /** @description The friends who may be prompted to send you kudos */
+            kudosPool?: string;
+            /** @description Only notify me about posts from friends on this list */
+            postNotifications?: string;
+        };
+        FriendReference: {
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/PreviewTaskFromImageOutputBody.json
+             * @description User ID
+             * @example 507f1f77bcf86cd799439011
              */
-            readonly $schema?: string;
-            /** @description New categories and their tasks proposed by AI */
-            categories: components["schemas"]["NewCategoryWithTasksLocal"][];
-            /** @description Tasks proposed for existing categories */
-            tasks: components["schemas"]["CategoryTaskPairLocal"][];
-        };
-        PreviewTaskNaturalLanguageInputBody: {
+            _id: string;
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/PreviewTaskNaturalLanguageInputBody.json
+             * @description User display name
+             * @example John Doe
              */
-            readonly $schema?: string;
+            display_name: string;
             /**
-             * @description Natural language description of tasks to preview
-             * @example Buy groceries tomorrow at 3pm, finish project report by Friday
+             * Format: int64
+             * @description Available encouragements to send
+             * @example 3
              */
-            text: string;
+            encouragements: number;
             /**
-             * @description User's timezone (IANA format). Defaults to America/New_York if not provided
-             * @example America/New_York
+             * @description User handle
+             * @example johndoe
              */
-            timezone?: string;
+            handle: string;
+            /**
+             * Format: int64
+             * @description Number of posts made this week
+             * @example 2
+             */
+            posts_this_week: number;
+            /**
+             * @description Profile picture URL
+             * @example https://example.com/avatar.jpg
+             */
+            profile_picture: string;
+            /**
+             * Format: int64
+             * @description Current streak
+             * @example 5
+             */
+            streak: number;
+            /**
+             * Format: int64
+             * @description Total tasks completed
+             * @example 12
+             */
+            tasks_complete: number;
         };
-        PreviewTaskNaturalLanguageOutputBody: {
+        GenerateAndCreateBlueprintParams: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/PreviewTaskNaturalLanguageOutputBody.json
+             * @example https://example.com/schemas/GenerateAndCreateBlueprintParams.json
              */
             readonly $schema?: string;
-            /** @description New categories and their tasks proposed by AI */
-            categories: components["schemas"]["NewCategoryWithTasksLocal"][];
-            /** @description Tasks proposed for existing categories */
-            tasks: components["schemas"]["CategoryTaskPairLocal"][];
+            /**
+             * @description Description of what the blueprint should contain
+             * @example Morning routine for productivity
+             */
+            description: string;
         };
-        ProcessAndUploadImageInputBody: {
+        GenerateImageUploadURLOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ProcessAndUploadImageInputBody.json
+             * @example https://example.com/schemas/GenerateImageUploadURLOutputBody.json
              */
             readonly $schema?: string;
-            /** @example image/jpeg */
-            content_type: string;
-            image_data: string;
+            /** @example profiles/507f1f77bcf86cd799439011/uuid.jpg */
+            key: string;
+            /** @example Upload URL generated successfully */
+            message: string;
+            /** @example https://kindred.nyc3.digitaloceanspaces.com/profiles/507f1f77bcf86cd799439011/uuid.jpg */
+            public_url: string;
+            /** @example https://presigned-upload-url... */
+            upload_url: string;
         };
-        ProcessAndUploadImageOutputBody: {
+        GetAvailableFeaturesOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ProcessAndUploadImageOutputBody.json
+             * @example https://example.com/schemas/GetAvailableFeaturesOutputBody.json
              */
             readonly $schema?: string;
-            /** @example webp */
-            format: string;
-            /**
-             * Format: int64
-             * @example 600
-             */
-            height: number;
-            /** @example Image processed and uploaded successfully */
-            message: string;
-            /** @example 2024-01-01T12:00:00Z */
-            processed_at: string;
-            /** @example https://cdn.kindredtodo.com/profiles/507f1f77bcf86cd799439011/uuid.webp */
-            public_url: string;
+            features: components["schemas"]["FeatureDefinition"][];
+        };
+        GetChallengesOutputBody: {
             /**
-             * Format: int64
-             * @example 123456
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/GetChallengesOutputBody.json
              */
-            size: number;
+            readonly $schema?: string;
+            challenges: components["schemas"]["GroupChallengeAPI"][];
+        };
+        GetCommentsOutputBody: {
             /**
-             * Format: int64
-             * @example 800
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/GetCommentsOutputBody.json
              */
-            width: number;
+            readonly $schema?: string;
+            comments: components["schemas"]["CommentDocumentAPI"][] | null;
+            hasMore: boolean;
+            /** @description Pass as cursor to fetch the next page */
+            nextCursor?: string;
         };
-        ProfileDocument: {
+        GetCompletedTasksByDateOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ProfileDocument.json
+             * @example https://example.com/schemas/GetCompletedTasksByDateOutputBody.json
              */
             readonly $schema?: string;
-            completed_tasks?: components["schemas"]["TaskDocument"][];
-            display_name: string;
-            friends: string[];
-            handle: string;
-            id: string;
-            /** Format: int64 */
-            points: number;
-            /** Format: int64 */
-            posts_made: number;
-            /** Format: int64 */
-            productivity_score: number;
-            profile_picture: string;
-            relationship?: components["schemas"]["RelationshipInfo"];
-            ring_state?: components["schemas"]["RingState"];
-            song?: components["schemas"]["Song"];
-            /** Format: int64 */
-            streak: number;
-            tasks?: components["schemas"]["TaskDocument"][];
-            /** Format: int64 */
-            tasks_complete: number;
+            tasks: components["schemas"]["TaskDocument"][];
         };
-        QueryTasksNaturalLanguageInputBody: {
+        GetCompletedTasksOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/QueryTasksNaturalLanguageInputBody.json
+             * @example https://example.com/schemas/GetCompletedTasksOutputBody.json
              */
             readonly $schema?: string;
             /**
-             * @description Natural language query to filter tasks
-             * @example high priority tasks due this week
+             * Format: int64
+             * @description Tasks per page
              */
-            text: string;
+            limit: number;
             /**
-             * @description User's timezone (IANA format). Defaults to America/New_York if not provided
-             * @example America/New_York
+             * Format: int64
+             * @description Current page number
              */
-            timezone?: string;
+            page: number;
+            /** @description List of completed tasks */
+            tasks: components["schemas"]["TaskDocument"][];
+            /**
+             * Format: int64
+             * @description Total number of completed tasks
+             */
+            total: number;
+            /**
+             * Format: int64
+             * @description Total number of pages
+             */
+            totalPages: number;
         };
-        QueryTasksNaturalLanguageOutputBody: {
+        GetConnectionsOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/QueryTasksNaturalLanguageOutputBody.json
+             * @example https://example.com/schemas/GetConnectionsOutputBody.json
              */
             readonly $schema?: string;
-            /** @description The structured query generated from natural language */
-            query: components["schemas"]["TaskQueryFilters"];
-            /** @description Matching tasks */
-            tasks: components["schemas"]["TaskDocument"][];
+            connections: components["schemas"]["CalendarConnection"][];
         };
-        ReactToCongratulationOutputBody: {
+        GetDraftsOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ReactToCongratulationOutputBody.json
+             * @example https://example.com/schemas/GetDraftsOutputBody.json
              */
             readonly $schema?: string;
-            /** @example Reaction added successfully */
-            message: string;
+            drafts: components["schemas"]["PostDraft"][] | null;
+        };
+        GetEventsOutputBody: {
             /**
-             * @description Reaction state after the toggle (null when removed)
-             * @example 🙌
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/GetEventsOutputBody.json
              */
-            reaction: string;
+            readonly $schema?: string;
+            events: components["schemas"]["CalendarEventDTO"][];
         };
-        ReactToEncouragementOutputBody: {
+        GetFeedOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ReactToEncouragementOutputBody.json
+             * @example https://example.com/schemas/GetFeedOutputBody.json
              */
             readonly $schema?: string;
-            /** @example Reaction added successfully */
-            message: string;
+            /** @description Whether there are more feed items to fetch */
+            hasMore: boolean;
+            /** @description Mixed feed items containing posts and tasks */
+            items: components["schemas"]["FeedItem"][];
+            /** @description Opaque cursor for the next page; absent when hasMore is false */
+            nextCursor?: string;
+        };
+        GetFriendsPostsOutputBody: {
             /**
-             * @description Reaction state after the toggle (null when removed)
-             * @example ❤️
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/GetFriendsPostsOutputBody.json
              */
-            reaction: string;
+            readonly $schema?: string;
+            /** @description Whether there are more posts to fetch */
+            hasMore: boolean;
+            /** @description Opaque cursor for the next page; absent when hasMore is false */
+            nextCursor?: string;
+            posts: components["schemas"]["PostDocumentAPI"][];
         };
-        RecordInteractionOutputBody: {
+        GetGroupActivityOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/RecordInteractionOutputBody.json
+             * @example https://example.com/schemas/GetGroupActivityOutputBody.json
              */
             readonly $schema?: string;
-            /** @example Interaction recorded */
-            message: string;
+            items: components["schemas"]["GroupActivityItem"][];
+            /** @description Cursor for the next page; empty on the last page */
+            nextBefore?: string;
         };
-        RecordInteractionParams: {
+        GetGroupsOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/RecordInteractionParams.json
+             * @example https://example.com/schemas/GetGroupsOutputBody.json
              */
             readonly $schema?: string;
+            groups: components["schemas"]["GroupDocumentAPI"][];
+        };
+        GetHistoryResponseBody: {
             /**
-             * @description Card type the user interacted with
-             * @example kudos_received
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/GetHistoryResponseBody.json
              */
-            cardType: string;
-        };
-        RecurDetails: {
-            behavior?: string;
-            daysOfMonth?: number[];
-            daysOfWeek?: number[];
+            readonly $schema?: string;
+            history: components["schemas"]["RingState"][];
             /** Format: int64 */
-            every?: number;
-            flex?: components["schemas"]["FlexDetails"];
-            months?: number[];
-            reminders?: string[];
+            score: number;
+            /** Format: int64 */
+            streak: number;
         };
-        RedeemRewardRequest: {
+        GetInvitesOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/RedeemRewardRequest.json
+             * @example https://example.com/schemas/GetInvitesOutputBody.json
              */
             readonly $schema?: string;
+            invites: components["schemas"]["GroupInvite"][];
+        };
+        GetJoinRequestsOutputBody: {
             /**
-             * @description Type of kudos to use for redemption
-             * @enum {string}
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/GetJoinRequestsOutputBody.json
              */
-            kudosType: "encouragements" | "congratulations";
+            readonly $schema?: string;
+            requests: components["schemas"]["GroupJoinRequestAPI"][];
+        };
+        GetNotificationsOutputBody: {
             /**
-             * @description Type of reward to redeem
-             * @enum {string}
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/GetNotificationsOutputBody.json
              */
-            rewardType: "voice" | "naturalLanguage" | "group" | "integration" | "analytics";
+            readonly $schema?: string;
+            notifications: components["schemas"]["NotificationDocument"][];
+            /** Format: int64 */
+            total: number;
+            /** Format: int64 */
+            unread_count: number;
         };
-        RedeemRewardResponse: {
+        GetPostsByBlueprintOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/RedeemRewardResponse.json
+             * @example https://example.com/schemas/GetPostsByBlueprintOutputBody.json
              */
             readonly $schema?: string;
+            posts: components["schemas"]["PostDocumentAPI"][];
+        };
+        GetPostsOutputBody: {
             /**
-             * Format: int64
-             * @description Number of credits received (if applicable)
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/GetPostsOutputBody.json
              */
-            creditsReceived?: number;
+            readonly $schema?: string;
+            /** @description Whether there are more posts to fetch */
+            hasMore: boolean;
             /**
              * Format: int64
-             * @description Remaining kudos after redemption
+             * @description Offset for the next page
              */
-            kudosRemaining: number;
-            /** @description Success message */
-            message: string;
+            nextOffset: number;
+            posts: components["schemas"]["PostDocumentAPI"][];
+            /**
+             * Format: int64
+             * @description Total number of posts available
+             */
+            total: number;
         };
-        ReferralDocument: {
+        GetReferralStatsOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ReferralDocument.json
+             * @example https://example.com/schemas/GetReferralStatsOutputBody.json
              */
             readonly $schema?: string;
-            id: string;
-            metadata: components["schemas"]["ReferralMetadata"];
-            referralCode: string;
-            referredBy?: string;
+            /** Format: int64 */
+            activeReferrals: number;
             referredUsers: components["schemas"]["ReferredUserInfo"][];
+            /** Format: int64 */
+            totalReferrals: number;
             unlockedFeatures: components["schemas"]["UnlockedFeature"][];
             /** Format: int64 */
             unlocksRemaining: number;
-            userId: string;
         };
-        ReferralMetadata: {
-            /** Format: date-time */
-            createdAt: string;
-            /** Format: date-time */
-            lastReferralAt?: string;
+        GetReportsOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/GetReportsOutputBody.json
+             */
+            readonly $schema?: string;
+            reports: components["schemas"]["ReportDocumentAPI"][];
             /** Format: int64 */
-            totalReferred: number;
-            /** Format: date-time */
-            updatedAt: string;
-        };
-        ReferredUserInfo: {
-            displayName: string;
-            handle: string;
-            /** Format: date-time */
-            joinedAt: string;
-            rewardGranted: boolean;
-            userId: string;
-        };
-        ReferrerInfo: {
-            displayName: string;
-            handle: string;
-            id: string;
-            profilePicture: string;
+            total: number;
         };
-        RefreshTokenOutputBody: {
+        GetTaskProgressOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/RefreshTokenOutputBody.json
+             * @example https://example.com/schemas/GetTaskProgressOutputBody.json
              */
             readonly $schema?: string;
-            /** @example Tokens refreshed successfully */
-            message: string;
+            /** @description Progress-log entries for this task, most recent first */
+            entries: components["schemas"]["TaskDocument"][];
+            /**
+             * Format: int64
+             * @description Number of sessions logged
+             * @example 3
+             */
+            totalCount: number;
+            /**
+             * Format: int64
+             * @description Total duration logged across all sessions, in seconds
+             * @example 4320
+             */
+            totalSeconds: number;
         };
-        RegisterRequest: {
+        GetTodayResponseBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/RegisterRequest.json
+             * @example https://example.com/schemas/GetTodayResponseBody.json
              */
             readonly $schema?: string;
-            display_name: string;
-            email: string;
-            handle: string;
-            password: string;
-            phone: string;
-            profile_picture: string;
-            timezone?: string;
+            /** Format: int64 */
+            current_streak: number;
+            /** Format: int64 */
+            productivity_score: number;
+            reward_available: boolean;
+            ring_state: components["schemas"]["RingState"];
         };
-        RegisterRequestApple: {
+        GetUserGroupsOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/RegisterRequestApple.json
+             * @example https://example.com/schemas/GetUserGroupsOutputBody.json
              */
             readonly $schema?: string;
-            apple_id: string;
-            display_name: string;
-            email: string;
-            handle: string;
-            id_token?: string;
-            profile_picture: string;
+            groups: components["schemas"]["GroupDocumentAPI"][];
         };
-        RegisterRequestGoogle: {
+        GetUserPostsOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/RegisterRequestGoogle.json
+             * @example https://example.com/schemas/GetUserPostsOutputBody.json
              */
             readonly $schema?: string;
-            display_name: string;
-            email: string;
-            google_id: string;
-            handle: string;
-            id_token?: string;
-            profile_picture: string;
-        };
-        RelationshipInfo: {
-            request_id?: string;
+            /** @description Whether there are more posts to fetch */
+            hasMore: boolean;
             /**
              * Format: int64
-             * @description Friendship score for this pair (0 unless friends)
+             * @description Offset for the next page
              */
-            score: number;
-            status: string;
-        };
-        Reminder: {
-            afterDeadline: boolean;
-            afterStart: boolean;
-            beforeDeadline: boolean;
-            beforeStart: boolean;
-            customMessage?: string;
-            sent: boolean;
-            sound?: string;
-            /** Format: date-time */
-            triggerTime: string;
-            type: string;
-            vibration: boolean;
-        };
-        RemoveMemberOutputBody: {
+            nextOffset: number;
+            posts: components["schemas"]["PostDocumentAPI"][];
             /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/RemoveMemberOutputBody.json
+             * Format: int64
+             * @description Total number of posts available
              */
-            readonly $schema?: string;
-            /** @example Member removed successfully */
-            message: string;
+            total: number;
         };
-        RemoveMemberParams: {
+        GetUserTagsOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/RemoveMemberParams.json
+             * @example https://example.com/schemas/GetUserTagsOutputBody.json
              */
             readonly $schema?: string;
-            userId: string;
+            tags: string[];
         };
-        RenameWorkspaceInputBody: {
+        GetUserTemplatesOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/RenameWorkspaceInputBody.json
+             * @example https://example.com/schemas/GetUserTemplatesOutputBody.json
              */
             readonly $schema?: string;
-            /** @example new-workspace */
-            newName: string;
+            templates: components["schemas"]["TemplateWithCategory"][];
         };
-        RenameWorkspaceOutputBody: {
+        GetUsersByIDsInputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/RenameWorkspaceOutputBody.json
+             * @example https://example.com/schemas/GetUsersByIDsInputBody.json
              */
             readonly $schema?: string;
-            /** @example Workspace renamed successfully */
-            message: string;
+            /** @description List of user IDs to resolve to profile references */
+            userIds: string[];
         };
-        ReportCommentOutputBody: {
+        GetUsersByIDsOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ReportCommentOutputBody.json
+             * @example https://example.com/schemas/GetUsersByIDsOutputBody.json
              */
             readonly $schema?: string;
-            /** @example Report submitted successfully */
-            message: string;
-            report_id: string;
+            users: components["schemas"]["UserExtendedReference"][];
         };
-        ReportCommentRequest: {
+        GroupActivityItem: {
+            /** Format: date-time */
+            at: string;
+            /** @description The post's caption or what the member did */
+            content: string;
+            /** @description The post, ring state or completed task ID */
+            id: string;
+            images?: string[];
+            /** @enum {string} */
+            type: "post" | "rings_closed" | "task_completed";
+            user: components["schemas"]["UserExtendedReference"];
+        };
+        GroupChallengeAPI: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ReportCommentRequest.json
+             * @example https://example.com/schemas/GroupChallengeAPI.json
              */
             readonly $schema?: string;
-            /**
-             * @description Optional additional details
-             * @example This comment is spam
-             */
+            createdBy: string;
             description?: string;
-            /**
-             * @description Reason for reporting
-             * @example spam
-             */
-            reason: string;
-        };
-        ReportDocumentAPI: {
-            _id: string;
-            content_id: string;
-            content_owner_id: string;
-            content_type: string;
             /** Format: date-time */
-            created_at: string;
-            description?: string;
-            reason: string;
-            reporter_id: string;
+            endDate: string;
+            goal: components["schemas"]["ChallengeGoal"];
+            groupId: string;
+            id: string;
+            /** @description Whether the caller is taking part */
+            joined: boolean;
+            leaderboard: components["schemas"]["ChallengeStanding"][];
             /** Format: date-time */
-            reviewed_at?: string;
-            status: string;
+            startDate: string;
+            title: string;
+            /**
+             * Format: int64
+             * @description Periods the challenge spans, for flex goals
+             */
+            totalPeriods?: number;
         };
-        ReportPostOutputBody: {
+        GroupDocumentAPI: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ReportPostOutputBody.json
+             * @example https://example.com/schemas/GroupDocumentAPI.json
              */
             readonly $schema?: string;
-            /** @example Report submitted successfully */
-            message: string;
-            report_id: string;
+            _id: string;
+            admins: string[];
+            /** @description The group's owner */
+            creator: string;
+            members: components["schemas"]["UserExtendedReference"][];
+            metadata: components["schemas"]["GroupMetadata"];
+            name: string;
+            /** @description Whether joining through an invite link needs an owner or admin to approve */
+            requireApproval: boolean;
         };
-        ReportPostRequest: {
+        GroupInvite: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ReportPostRequest.json
+             * @example https://example.com/schemas/GroupInvite.json
              */
             readonly $schema?: string;
-            /**
-             * @description Optional additional details
-             * @example This post contains offensive content
-             */
-            description?: string;
-            /**
-             * @description Reason for reporting
-             * @example inappropriate
-             */
-            reason: string;
+            /** @example K7PQ2M4X */
+            code: string;
+            /** Format: date-time */
+            createdAt: string;
+            createdBy: string;
+            /** Format: date-time */
+            expiresAt: string;
         };
-        ResetTemplateMetricsOutputBody: {
+        GroupJoinRequestAPI: {
+            /** Format: date-time */
+            requestedAt: string;
+            user: components["schemas"]["UserExtendedReference"];
+        };
+        GroupMetadata: {
+            /** Format: date-time */
+            createdAt: string;
+            isDeleted: boolean;
+            /** Format: date-time */
+            updatedAt: string;
+        };
+        GroupNotificationSettings: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/ResetTemplateMetricsOutputBody.json
+             * @example https://example.com/schemas/GroupNotificationSettings.json
              */
             readonly $schema?: string;
-            /** @example Template metrics reset successfully */
-            message: string;
+            /** @description Members closing their rings or finishing shared tasks */
+            activity: boolean;
+            /** @description New join requests; only sent to owners and admins */
+            joinRequests: boolean;
+            /** @description Posts shared with the group */
+            posts: boolean;
         };
-        RespondToTaskTagInputBody: {
+        HealthOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/RespondToTaskTagInputBody.json
-             */
-            readonly $schema?: string;
-            /** @enum {string} */
-            status: "watching" | "copied" | "untagged";
-        };
-        RespondToTaskTagOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/RespondToTaskTagOutputBody.json
+             * @example https://example.com/schemas/HealthOutputBody.json
              */
             readonly $schema?: string;
-            /** @example Response recorded */
-            message: string;
-        };
-        RevenueCatEvent: {
-            app_user_id: string;
-            currency: string;
-            entitlement_ids: string[];
-            environment: string;
-            /** Format: int64 */
-            expiration_at_ms: number;
-            id: string;
-            is_family_share: boolean;
-            original_app_user_id: string;
-            original_transaction_id: string;
-            period_type: string;
-            /** Format: double */
-            price_in_purchased_currency: number;
-            product_id: string;
-            /** Format: int64 */
-            purchased_at_ms: number;
-            store: string;
-            type: string;
+            /** @example ok */
+            status: string;
         };
-        RingDelta: {
-            all_closed: boolean;
-            /** Format: int64 */
-            current: number;
-            just_closed: boolean;
-            just_closed_all: boolean;
-            /** Format: int64 */
-            previous: number;
-            ring: string;
+        ImageSize: {
             /** Format: int64 */
-            target: number;
-        };
-        RingProgress: {
-            closed: boolean;
+            bytes: number;
             /** Format: int64 */
-            current: number;
+            height: number;
             /** Format: int64 */
-            target: number;
+            width: number;
         };
-        RingState: {
-            all_closed: boolean;
-            /** Format: date-time */
-            created_at: string;
-            /** Format: date-time */
-            date: string;
-            do: components["schemas"]["RingProgress"];
-            id: string;
-            plan: components["schemas"]["RingProgress"];
-            /** Format: int64 */
-            reward_amount?: number;
-            reward_claimed: boolean;
-            reward_type?: string;
-            share: components["schemas"]["RingProgress"];
-            /** Format: date-time */
-            updated_at: string;
-            user_id: string;
+        IntentOpResponse: {
+            /** @description Populated for create ops — preview payload to pass to /confirm */
+            createPreview?: components["schemas"]["MultiTaskOutputLocal"];
+            /** @description Populated for delete ops — tasks matching the delete query */
+            deleteTasks?: components["schemas"]["TaskDocument"][];
+            /** @description Populated for edit ops — tasks already updated server-side */
+            editResult?: components["schemas"]["EditResultResponse"];
+            /** @description Operation type: 'create', 'edit', or 'delete' */
+            type: string;
         };
-        SafeUser: {
+        IntentTaskNaturalLanguageInputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/SafeUser.json
+             * @example https://example.com/schemas/IntentTaskNaturalLanguageInputBody.json
              */
             readonly $schema?: string;
-            _id: string;
-            categories: components["schemas"]["CategoryDocument"][];
-            /** Format: int64 */
-            congratulations: number;
-            credits: components["schemas"]["UserCredits"];
-            display_name: string;
-            /** Format: int64 */
-            encouragements: number;
-            /** Format: date-time */
-            first_all_rings_closed_at?: string;
-            friends: string[];
-            handle: string;
-            kudosRewards: components["schemas"]["KudosRewards"];
-            /** Format: int64 */
-            points: number;
-            /** Format: int64 */
-            posts_made: number;
-            profile_picture: string;
-            recent_activity: components["schemas"]["ActivityDocument"][];
-            settings: components["schemas"]["UserSettings"];
-            song?: components["schemas"]["Song"];
-            /** Format: int64 */
-            streak: number;
-            streakEligible: boolean;
-            subscription: components["schemas"]["Subscription"];
-            /** Format: double */
-            tasks_complete: number;
-            /** Format: date-time */
-            terms_accepted_at?: string;
-            terms_version?: string;
+            /**
+             * @description Natural language instruction (may contain create, edit, and/or delete operations)
+             * @example delete my grocery tasks and add a dentist appointment tomorrow
+             */
+            text: string;
+            /**
+             * @description User's timezone (IANA format). Defaults to America/New_York if not provided
+             * @example America/New_York
+             */
             timezone?: string;
         };
-        SaveStatedPreferencesOutputBody: {
+        IntentTaskNaturalLanguageOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/SaveStatedPreferencesOutputBody.json
+             * @example https://example.com/schemas/IntentTaskNaturalLanguageOutputBody.json
              */
             readonly $schema?: string;
-            /** @description The user_memory keys written */
-            keys: string[];
-            /** @example Preferences saved */
-            message: string;
+            /** @description Ordered list of decomposed operations. Edits are already applied; deletes and creates need frontend confirmation. */
+            ops: components["schemas"]["IntentOpResponse"][];
         };
-        SendBeakCongratulationParams: {
+        JoinGroupOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/SendBeakCongratulationParams.json
+             * @example https://example.com/schemas/JoinGroupOutputBody.json
              */
             readonly $schema?: string;
+            groupId: string;
+            groupName: string;
             /**
-             * @description Category name
-             * @example My Tasks
+             * @description requested when the group approves new members first
+             * @enum {string}
              */
-            categoryName: string;
-            /** @description Whether to grant welcome credits to the user */
-            grantCredits?: boolean;
+            status: "joined" | "requested";
+        };
+        JoinRequestOutputBody: {
             /**
-             * @description Congratulation message
-             * @example welcome! you just completed your first task!
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/JoinRequestOutputBody.json
              */
+            readonly $schema?: string;
+            /** @example Join request approved */
             message: string;
+        };
+        KudosRewards: {
             /**
-             * @description Task name
-             * @example Start my morning routine
+             * Format: int64
+             * @description Number of congratulations sent (for rewards tracking)
              */
-            taskName: string;
+            congratulations: number;
+            /**
+             * Format: int64
+             * @description Number of encouragements sent (for rewards tracking)
+             */
+            encouragements: number;
         };
-        SendBeakCongratulationResult: {
+        KudosSender: {
+            handle: string;
+            icon: string;
+            id: string;
+            name: string;
+        };
+        ListCalendarsOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/SendBeakCongratulationResult.json
+             * @example https://example.com/schemas/ListCalendarsOutputBody.json
              */
             readonly $schema?: string;
-            /** @description Credits granted to the user by type */
-            creditsGranted?: {
-                [key: string]: number;
-            };
-            /** @example Congratulation sent successfully */
-            message: string;
+            calendars: components["schemas"]["CalendarInfo"][];
         };
-        SendOTPOutputBody: {
+        LogProgressDocument: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/SendOTPOutputBody.json
+             * @example https://example.com/schemas/LogProgressDocument.json
              */
             readonly $schema?: string;
-            /** @example OTP sent successfully */
-            message: string;
-            verification_id?: string;
+            /**
+             * Format: int64
+             * @description Duration of this work session, in seconds
+             * @example 1800
+             */
+            durationSeconds: number;
+            /** @description Optional note about this session */
+            note?: string;
+            /** @description Optional photo URL for this session */
+            photo?: string;
         };
-        SendOTPRequest: {
+        LogProgressOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/SendOTPRequest.json
+             * @example https://example.com/schemas/LogProgressOutputBody.json
              */
             readonly $schema?: string;
-            phone_number: string;
+            /** @description The created progress-log record */
+            entry: components["schemas"]["TaskDocument"];
+            /** @example Progress logged successfully */
+            message: string;
+            /** @description Describes the Do ring increment triggered by this log, if any (capped at one per task per day) */
+            ringDelta?: components["schemas"]["RingDelta"];
         };
-        SetWorkspacePushEnabledInputBody: {
+        LogTaskEntry: {
+            /**
+             * @description What the user got done
+             * @example Went to the gym
+             */
+            content: string;
+        };
+        LogTasksInputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/SetWorkspacePushEnabledInputBody.json
+             * @example https://example.com/schemas/LogTasksInputBody.json
              */
             readonly $schema?: string;
-            push_enabled: boolean;
+            /** @description Things the user did today */
+            tasks: components["schemas"]["LogTaskEntry"][];
+            /**
+             * @description Workspace whose Logged category receives the tasks
+             * @example Personal
+             */
+            workspaceName: string;
         };
-        SetWorkspacePushEnabledOutputBody: {
+        LogTasksOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/SetWorkspacePushEnabledOutputBody.json
+             * @example https://example.com/schemas/LogTasksOutputBody.json
              */
             readonly $schema?: string;
-            /** @example Workspace push setting updated */
+            /**
+             * Format: int64
+             * @description The user's current streak count
+             * @example 5
+             */
+            currentStreak: number;
+            /** @description Indices of entries that failed */
+            failedIndices?: number[];
+            /** @example Tasks logged */
             message: string;
             /**
              * Format: int64
-             * @example 2
+             * @description Number of entries created and completed
+             * @example 3
              */
-            modified: number;
+            tasksLogged: number;
         };
-        SetupWorkspacesInputBody: {
+        LoginRequest: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/SetupWorkspacesInputBody.json
+             * @example https://example.com/schemas/LoginRequest.json
              */
             readonly $schema?: string;
-            calendar_ids: string[];
-            make_public: boolean;
-            merge_into_one: boolean;
-            /** @description Calendar IDs (subset of calendar_ids) that should also receive Kindred tasks pushed back to Google. Default empty (push disabled). */
-            push_enabled_calendar_ids?: string[];
+            email: string;
+            password: string;
         };
-        SetupWorkspacesOutputBody: {
+        LoginRequestApple: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/SetupWorkspacesOutputBody.json
+             * @example https://example.com/schemas/LoginRequestApple.json
              */
             readonly $schema?: string;
-            message: string;
-            success: boolean;
-        };
-        Song: {
-            appleMusicUrl?: string;
-            artist: string;
-            artworkUrl?: string;
-            /** Format: int64 */
-            id: number;
-            previewUrl: string;
-            title: string;
+            apple_id: string;
+            id_token?: string;
         };
-        StartWorkingOutputBody: {
+        LoginRequestGoogle: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/StartWorkingOutputBody.json
+             * @example https://example.com/schemas/LoginRequestGoogle.json
              */
             readonly $schema?: string;
-            /** @example Started working on task */
-            message: string;
+            email?: string;
+            google_id: string;
+            id_token?: string;
         };
-        StatedPreferencesRequest: {
+        LoginRequestPhone: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/StatedPreferencesRequest.json
+             * @example https://example.com/schemas/LoginRequestPhone.json
              */
             readonly $schema?: string;
+            password: string;
+            phone_number: string;
+        };
+        LoginWithOTPRequest: {
             /**
-             * @description How often they want to be nudged; matches the check-in frequency vocabulary
-             * @enum {string}
-             */
-            nudgeFrequency?: "none" | "occasionally" | "regularly" | "frequently";
-            /**
-             * @description When they say they focus best
-             * @enum {string}
-             */
-            peakHours?: "morning" | "afternoon" | "evening" | "night";
-            /**
-             * @description How much structure they want up front
-             * @enum {string}
-             */
-            planningStyle?: "detailed" | "flexible" | "spontaneous";
-            /**
-             * @description How the assistant should talk to them
-             * @enum {string}
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/LoginWithOTPRequest.json
              */
-            tone?: "encouraging" | "direct" | "playful";
+            readonly $schema?: string;
+            code: string;
+            phone_number: string;
         };
-        SubscribeToBlueprintOutputBody: {
+        LogoutOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/SubscribeToBlueprintOutputBody.json
+             * @example https://example.com/schemas/LogoutOutputBody.json
              */
             readonly $schema?: string;
-            /** @example Subscribed to blueprint successfully */
+            /** @example Logout Successful */
             message: string;
         };
-        Subscription: {
-            /** Format: date-time */
-            canceledAt?: string;
-            /** Format: date-time */
-            endDate?: string;
-            provider?: string;
-            /** Format: date-time */
-            renewalDate?: string;
-            /** Format: date-time */
-            startDate: string;
-            status: string;
-            subscriptionId?: string;
-            tier: string;
-        };
-        SubscriptionWebhookOutputBody: {
+        MarkAllNotificationsReadOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/SubscriptionWebhookOutputBody.json
+             * @example https://example.com/schemas/MarkAllNotificationsReadOutputBody.json
              */
             readonly $schema?: string;
-            status: string;
+            /** @example All notifications marked as read successfully */
+            message: string;
         };
-        SuggestTaskFieldsInputBody: {
+        MarkCongratulationsReadOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/SuggestTaskFieldsInputBody.json
+             * @example https://example.com/schemas/MarkCongratulationsReadOutputBody.json
              */
             readonly $schema?: string;
             /**
-             * @description The task text the user is typing
-             * @example gym tomorrow 7-8am every weekday
+             * Format: int64
+             * @description Number of congratulations marked as read
+             * @example 2
              */
-            text: string;
+            count: number;
+            /** @example Congratulations marked as read successfully */
+            message: string;
+        };
+        MarkCongratulationsReadParams: {
             /**
-             * @description User's timezone (IANA format). Defaults to America/New_York if not provided
-             * @example America/New_York
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/MarkCongratulationsReadParams.json
              */
-            timezone?: string;
+            readonly $schema?: string;
+            /** @description List of congratulation IDs to mark as read */
+            id: string[];
         };
-        SuggestTaskFieldsOutputBody: {
+        MarkEncouragementsReadBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/SuggestTaskFieldsOutputBody.json
+             * @example https://example.com/schemas/MarkEncouragementsReadBody.json
+             */
+            readonly $schema?: string;
+            /** @description List of encouragement IDs to mark as read */
+            id: string[];
+        };
+        MarkEncouragementsReadOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/MarkEncouragementsReadOutputBody.json
              */
             readonly $schema?: string;
-            /** @description Suggested existing category id; absent when there is no confident suggestion */
-            categoryId?: string;
             /**
              * Format: int64
-             * @description Suggested priority (1-3); absent when there is no confident suggestion
+             * @description Number of encouragements marked as read
+             * @example 2
              */
-            priority?: number;
+            count: number;
+            /** @example Encouragements marked as read successfully */
+            message: string;
+        };
+        MarkNotificationsReadBody: {
             /**
-             * Format: double
-             * @description Suggested difficulty (1-5); absent when there is no confident suggestion
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/MarkNotificationsReadBody.json
              */
-            value?: number;
+            readonly $schema?: string;
+            /** @description List of notification IDs to mark as read */
+            id: string[];
         };
-        SyncEventsOutputBody: {
+        MarkNotificationsReadOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/SyncEventsOutputBody.json
+             * @example https://example.com/schemas/MarkNotificationsReadOutputBody.json
              */
             readonly $schema?: string;
-            categories_synced: {
-                [key: string]: number;
-            };
+            /**
+             * Format: int64
+             * @description Number of notifications marked as read
+             * @example 2
+             */
+            count: number;
+            /** @example Notifications marked as read successfully */
+            message: string;
+        };
+        MediaItem: {
             /** Format: int64 */
-            events_total: number;
+            bytes?: number;
             /** Format: int64 */
-            tasks_created: number;
+            durationMs?: number;
             /** Format: int64 */
-            tasks_deleted: number;
+            height: number;
+            thumbnailUrl?: string;
+            type: string;
+            url: string;
             /** Format: int64 */
-            tasks_skipped: number;
-            workspace_name: string;
+            width: number;
         };
-        TaggedTaskUser: {
-            display_name: string;
+        MediaItemInput: {
+            /** Format: int64 */
+            bytes?: number;
+            /** Format: int64 */
+            durationMs?: number;
+            /** Format: int64 */
+            height: number;
+            thumbnailUrl?: string;
+            type: string;
+            url: string;
+            /** Format: int64 */
+            width: number;
+        };
+        MentionInput: {
             handle: string;
             id: string;
-            profile_picture: string;
-            status: string;
         };
-        TaggerInfo: {
-            display_name: string;
+        MentionReference: {
             handle: string;
+            icon?: string;
             id: string;
-            profile_picture: string;
+            name?: string;
         };
-        TaskDocument: {
+        MoveTaskInputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/TaskDocument.json
+             * @example https://example.com/schemas/MoveTaskInputBody.json
              */
             readonly $schema?: string;
-            active: boolean;
-            blueprintId?: string;
-            categoryID?: string;
+            /**
+             * @description Destination category ID
+             * @example 507f1f77bcf86cd799439012
+             */
+            targetCategoryID: string;
+        };
+        MoveTaskOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/MoveTaskOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Task moved successfully */
+            message: string;
+        };
+        MultiTaskOutputLocal: {
+            categories: components["schemas"]["NewCategoryWithTasksLocal"][];
+            tasks: components["schemas"]["CategoryTaskPairLocal"][];
+        };
+        NewCategoryWithTasksLocal: {
+            name: string;
+            tasks: components["schemas"]["CreateTaskParams"][];
+            workspaceName: string;
+        };
+        NextFlexTaskDTO: {
+            categoryId: string;
+            task: components["schemas"]["TaskDocument"];
+        };
+        NotificationDocument: {
+            content: string;
+            id: string;
+            notificationType: string;
+            read: boolean;
+            receiver: string;
+            reference_id: string;
+            /** @description Optional thumbnail image URL for the notification */
+            thumbnail?: string;
+            /** Format: date-time */
+            time: string;
+            user: components["schemas"]["UserReference"];
+        };
+        NotificationSettings: {
+            checkin_frequency: string;
+            comments: boolean;
+            congratulations: boolean;
+            encouragements: boolean;
+            friend_activity: boolean;
+            friend_posts: boolean;
+            friend_requests: boolean;
+            near_deadlines: boolean;
+            reactions: boolean;
+        };
+        PendingTaggedTask: {
             checklist?: components["schemas"]["ChecklistItem"][];
-            completionType?: string;
             content: string;
             /** Format: date-time */
             deadline?: string;
-            /** Format: int64 */
-            durationSeconds?: number;
-            encouragements?: components["schemas"]["TaskKudos"][];
-            flexInfo?: components["schemas"]["FlexInstanceInfo"];
-            id: string;
-            integration?: string;
-            /** Format: date-time */
-            lastEdited: string;
             notes?: string;
-            posted: boolean;
             /** Format: int64 */
             priority: number;
-            public: boolean;
-            pushed_calendar_id?: string;
-            pushed_event_etag?: string;
-            pushed_event_id?: string;
             recurDetails?: components["schemas"]["RecurDetails"];
             recurFrequency?: string;
-            recurType?: string;
             recurring: boolean;
-            reminders?: components["schemas"]["Reminder"][];
-            /** Format: int64 */
-            rescheduleCount?: number;
-            sessionNote?: string;
-            sessionPhoto?: string;
-            sessionTrackable?: boolean;
-            source?: string;
-            /** Format: date-time */
-            startDate: string;
-            /** Format: date-time */
-            startTime?: string;
-            /** Format: date-time */
-            startedAt?: string;
-            taggedUsers?: components["schemas"]["TaggedTaskUser"][];
-            taskId?: string;
-            templateID?: string;
-            /** Format: date-time */
-            timeCompleted?: string;
-            timeTaken?: string;
+            tagger: components["schemas"]["TaggerInfo"];
+            taskId: string;
             /** Format: date-time */
             timestamp: string;
-            userID?: string;
             /** Format: double */
             value: number;
-            /** Format: date-time */
-            workingOnSince?: string;
         };
-        TaskKudos: {
-            /** Format: int64 */
-            durationMs?: number;
-            encouragementId: string;
-            message: string;
-            sender: components["schemas"]["KudosSender"];
-            thumbnailUrl?: string;
+        PersonalizationFact: {
+            /**
+             * Format: double
+             * @description 0..1; stated answers are always 1
+             */
+            confidence: number;
             /** Format: date-time */
-            timestamp: string;
-            type: string;
+            createdAt: string;
+            /** @description The numbers the fact was computed from */
+            evidence: {
+                [key: string]: unknown;
+            };
+            /**
+             * Format: date-time
+             * @description When this expires on its own. Absent for stated answers, which never expire.
+             */
+            expiresAt?: string;
+            /** @description Stable slug, e.g. peak-hours */
+            key: string;
+            /** @description rhythm|reliability|pacing|focus|style|theme|social */
+            kind: string;
+            /** @description derived (observed by the worker) or stated (the user told us) */
+            source: string;
+            /** Format: date-time */
+            updatedAt: string;
         };
-        TaskQueryFilters: {
+        PersonalizationSettings: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/TaskQueryFilters.json
+             * @example https://example.com/schemas/PersonalizationSettings.json
              */
             readonly $schema?: string;
-            /** @description Filter by active status */
-            active?: boolean;
-            /** @description Filter by category IDs */
-            categoryIds?: string[];
-            /**
-             * Format: date-time
-             * @description Filter tasks with deadline on or after this time (ISO8601)
-             */
-            deadlineFrom?: string;
+            enabled: boolean;
+            /** Format: date-time */
+            pausedUntil?: string;
+            shareStruggles: boolean;
+        };
+        PostAudience: {
+            /** @description Groups whose members can see the post, for the groups audience */
+            groups?: string[];
+            /** @description The author's friend lists whose members can see the post, for the lists audience */
+            lists?: string[];
             /**
-             * Format: date-time
-             * @description Filter tasks with deadline on or before this time (ISO8601)
+             * @description Who can see the post
+             * @enum {string}
              */
-            deadlineTo?: string;
-            /** @description Filter tasks that have (true) or don't have (false) a deadline */
-            hasDeadline?: boolean;
-            /** @description Filter tasks that have (true) or don't have (false) a start date */
-            hasStartTime?: boolean;
-            /** @description Filter by priority values (1=low, 2=medium, 3=high) */
-            priorities?: number[];
+            type: "public" | "friends" | "close_friends" | "groups" | "users" | "lists" | "only_me";
+            /** @description Friends who can see the post, for the users audience */
+            users?: string[];
+        };
+        PostDocumentAPI: {
             /**
-             * @description Sort field: timestamp, priority, value, or deadline
-             * @example timestamp
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/PostDocumentAPI.json
              */
-            sortBy?: string;
+            readonly $schema?: string;
+            _id: string;
+            /** @description Who can see the post; which groups, users or lists are only sent to the author */
+            audience: components["schemas"]["PostAudience"];
+            blueprint?: components["schemas"]["EnhancedBlueprintReference"];
+            caption: string;
+            category?: components["schemas"]["CategoryExtendedReference"];
             /**
              * Format: int64
-             * @description Sort direction: 1 (ascending) or -1 (descending)
-             * @example -1
-             */
-            sortDir?: number;
-            /**
-             * Format: date-time
-             * @description Filter tasks with start date on or after this time (ISO8601)
+             * @description Total comments on the post, replies included
              */
-            startTimeFrom?: string;
-            /**
-             * Format: date-time
-             * @description Filter tasks with start date on or before this time (ISO8601)
-             */
-            startTimeTo?: string;
+            commentCount: number;
+            comments: components["schemas"]["CommentDocumentAPI"][];
+            dual?: string;
+            groups?: string[];
+            images: string[];
+            kudos?: components["schemas"]["PostKudos"][];
+            media: components["schemas"]["MediaItem"][];
+            metadata: components["schemas"]["PostMetadata"];
+            reactions: {
+                [key: string]: string[];
+            };
+            size?: components["schemas"]["ImageSize"];
+            song?: components["schemas"]["Song"];
+            taggedUsers?: components["schemas"]["MentionReference"][];
+            task?: components["schemas"]["PostTaskExtendedReference"];
+            user: components["schemas"]["UserExtendedReference"];
         };
-        TemplateTaskDocument: {
+        PostDraft: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/TemplateTaskDocument.json
+             * @example https://example.com/schemas/PostDraft.json
              */
             readonly $schema?: string;
-            blueprintId?: string;
-            categoryID: string;
-            checklist?: components["schemas"]["ChecklistItem"][];
-            completionDates?: string[];
-            content: string;
+            content: components["schemas"]["DraftContent"];
             /** Format: date-time */
-            deadline?: string;
-            flexState?: components["schemas"]["FlexTemplateState"];
-            /** Format: int64 */
-            highestStreak: number;
+            createdAt: string;
+            /** @description Why the last scheduled publish failed */
+            error?: string;
             id: string;
+            /** @description The post, once published */
+            postId?: string;
+            /**
+             * Format: date-time
+             * @description When a scheduled draft will be published
+             */
+            publishAt?: string;
             /** Format: date-time */
-            lastEdited: string;
-            /** Format: date-time */
-            lastGenerated: string;
-            /** Format: date-time */
-            lastMissedAt?: string;
+            publishedAt?: string;
+            /** @enum {string} */
+            status: "draft" | "scheduled" | "publishing" | "published";
             /** Format: date-time */
-            nextGenerated: string;
-            notes?: string;
-            /** Format: int64 */
-            previousStreak?: number;
+            updatedAt: string;
+        };
+        PostKudos: {
+            congratulationId: string;
             /** Format: int64 */
-            priority: number;
-            public: boolean;
-            recurDetails: components["schemas"]["RecurDetails"];
-            recurFrequency?: string;
-            recurType: string;
-            reminders?: components["schemas"]["Reminder"][];
+            durationMs?: number;
+            message: string;
+            private?: boolean;
+            sender: components["schemas"]["KudosSender"];
+            thumbnailUrl?: string;
             /** Format: date-time */
-            startDate?: string;
+            timestamp: string;
+            type: string;
+        };
+        PostMetadata: {
             /** Format: date-time */
-            startTime?: string;
-            /** Format: int64 */
-            streak: number;
-            taggedUsers?: components["schemas"]["TaggedTaskUser"][];
-            /** Format: int64 */
-            timesCompleted: number;
-            /** Format: int64 */
-            timesGenerated: number;
-            /** Format: int64 */
-            timesMissed: number;
-            userID: string;
-            /** Format: double */
-            value: number;
+            createdAt: string;
+            isDeleted: boolean;
+            isEdited: boolean;
+            isPublic: boolean;
+            /** Format: date-time */
+            updatedAt: string;
         };
-        TemplateWithCategory: {
+        PostTaskExtendedReference: {
             blueprintId?: string;
-            categoryID: string;
-            categoryName: string;
-            checklist?: components["schemas"]["ChecklistItem"][];
-            completionDates?: string[];
+            category: components["schemas"]["CategoryExtendedReference"];
             content: string;
-            /** Format: date-time */
-            deadline?: string;
-            flexState?: components["schemas"]["FlexTemplateState"];
-            /** Format: int64 */
-            highestStreak: number;
             id: string;
-            /** Format: date-time */
-            lastEdited: string;
-            /** Format: date-time */
-            lastGenerated: string;
-            /** Format: date-time */
-            lastMissedAt?: string;
-            /** Format: date-time */
-            nextGenerated: string;
-            notes?: string;
-            /** Format: int64 */
-            previousStreak?: number;
-            /** Format: int64 */
-            priority: number;
-            public: boolean;
-            recurDetails: components["schemas"]["RecurDetails"];
-            recurFrequency?: string;
-            recurType: string;
-            reminders?: components["schemas"]["Reminder"][];
-            /** Format: date-time */
-            startDate?: string;
-            /** Format: date-time */
-            startTime?: string;
-            /** Format: int64 */
-            streak: number;
-            taggedUsers?: components["schemas"]["TaggedTaskUser"][];
-            /** Format: int64 */
-            timesCompleted: number;
-            /** Format: int64 */
-            timesGenerated: number;
-            /** Format: int64 */
-            timesMissed: number;
-            userID: string;
-            /** Format: double */
-            value: number;
+            status?: string;
         };
-        TestOutputBody: {
+        PreviewTaskFromImageInputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/TestOutputBody.json
+             * @example https://example.com/schemas/PreviewTaskFromImageInputBody.json
              */
             readonly $schema?: string;
-            /** @example Authorized! */
-            message: string;
+            /** @description Base64-encoded image (no data URL prefix) to extract tasks from */
+            image: string;
+            /**
+             * @description MIME type of the image (e.g. image/png, image/jpeg). Defaults to image/jpeg if not provided
+             * @example image/png
+             */
+            mimeType?: string;
+            /**
+             * @description User's timezone (IANA format). Defaults to America/New_York if not provided
+             * @example America/New_York
+             */
+            timezone?: string;
         };
-        UnblockUserOutputBody: {
+        PreviewTaskFromImageOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UnblockUserOutputBody.json
+             * @example https://example.com/schemas/PreviewTaskFromImageOutputBody.json
              */
             readonly $schema?: string;
-            /** @example User unblocked successfully */
-            message: string;
+            /** @description New categories and their tasks proposed by AI */
+            categories: components["schemas"]["NewCategoryWithTasksLocal"][];
+            /** @description Tasks proposed for existing categories */
+            tasks: components["schemas"]["CategoryTaskPairLocal"][];
         };
-        UndoMissedTaskOutputBody: {
+        PreviewTaskNaturalLanguageInputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UndoMissedTaskOutputBody.json
+             * @example https://example.com/schemas/PreviewTaskNaturalLanguageInputBody.json
+             */
+            readonly $schema?: string;
+            /**
+             * @description Natural language description of tasks to preview
+             * @example Buy groceries tomorrow at 3pm, finish project report by Friday
+             */
+            text: string;
+            /**
+             * @description User's timezone (IANA format). Defaults to America/New_York if not provided
+             * @example America/New_York
+             */
+            timezone?: string;
+        };
+        PreviewTaskNaturalLanguageOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/PreviewTaskNaturalLanguageOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @description New categories and their tasks proposed by AI */
+            categories: components["schemas"]["NewCategoryWithTasksLocal"][];
+            /** @description Tasks proposed for existing categories */
+            tasks: components["schemas"]["CategoryTaskPairLocal"][];
+        };
+        PrivacySettings: {
+            defaultAudience: components["schemas"]["PostAudience"];
+        };
+        ProcessAndUploadImageInputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ProcessAndUploadImageInputBody.json
+             */
+            readonly $schema?: string;
+            /** @example image/jpeg */
+            content_type: string;
+            image_data: string;
+        };
+        ProcessAndUploadImageOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ProcessAndUploadImageOutputBody.json
              */
             readonly $schema?: string;
+            /** @example webp */
+            format: string;
             /**
              * Format: int64
-             * @description Current highest streak
-             * @example 6
+             * @example 600
              */
-            highestStreak: number;
-            /** @example Missed task successfully marked as completed */
+            height: number;
+            /** @example Image processed and uploaded successfully */
             message: string;
+            /** @example 2024-01-01T12:00:00Z */
+            processed_at: string;
+            /** @example https://cdn.kindredtodo.com/profiles/507f1f77bcf86cd799439011/uuid.webp */
+            public_url: string;
             /**
              * Format: int64
-             * @description Restored streak value
-             * @example 6
+             * @example 123456
              */
-            streak: number;
+            size: number;
+            /**
+             * Format: int64
+             * @example 800
+             */
+            width: number;
         };
-        UnlockFeatureInputBody: {
+        ProfileDocument: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UnlockFeatureInputBody.json
+             * @example https://example.com/schemas/ProfileDocument.json
              */
             readonly $schema?: string;
-            featureId: string;
+            completed_tasks?: components["schemas"]["TaskDocument"][];
+            display_name: string;
+            friends: string[];
+            handle: string;
+            id: string;
+            /** Format: int64 */
+            points: number;
+            /** Format: int64 */
+            posts_made: number;
+            /** Format: int64 */
+            productivity_score: number;
+            profile_picture: string;
+            relationship?: components["schemas"]["RelationshipInfo"];
+            ring_state?: components["schemas"]["RingState"];
+            song?: components["schemas"]["Song"];
+            /** Format: int64 */
+            streak: number;
+            tasks?: components["schemas"]["TaskDocument"][];
+            /** Format: int64 */
+            tasks_complete: number;
         };
-        UnlockFeatureOutputBody: {
+        QueryTasksNaturalLanguageInputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UnlockFeatureOutputBody.json
+             * @example https://example.com/schemas/QueryTasksNaturalLanguageInputBody.json
              */
             readonly $schema?: string;
-            feature: components["schemas"]["UnlockedFeature"];
-            success: boolean;
-            /** Format: int64 */
-            unlocksRemaining: number;
-        };
-        UnlockedFeature: {
-            active: boolean;
-            /** Format: date-time */
-            expiresAt?: string;
-            featureId: string;
-            featureName: string;
-            /** Format: date-time */
-            unlockedAt: string;
-            unlockedBy: string;
+            /**
+             * @description Natural language query to filter tasks
+             * @example high priority tasks due this week
+             */
+            text: string;
+            /**
+             * @description User's timezone (IANA format). Defaults to America/New_York if not provided
+             * @example America/New_York
+             */
+            timezone?: string;
         };
-        UnsubscribeFromBlueprintOutputBody: {
+        QueryTasksNaturalLanguageOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UnsubscribeFromBlueprintOutputBody.json
+             * @example https://example.com/schemas/QueryTasksNaturalLanguageOutputBody.json
              */
             readonly $schema?: string;
-            /** @example Unsubscribed from blueprint successfully */
-            message: string;
+            /** @description The structured query generated from natural language */
+            query: components["schemas"]["TaskQueryFilters"];
+            /** @description Matching tasks */
+            tasks: components["schemas"]["TaskDocument"][];
         };
-        UpdateBlueprintDocument: {
+        ReactToCongratulationOutputBody: {
             /**
              * Format: uri
              * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateBlueprintDocument.json
+             * @example https://example.com/schemas/ReactToCongratulationOutputBody.json
              */
             readonly $schema?: string;
+            /** @example Reaction added successfully */
+            message: string;
             /**
-             * @description New banner image URL
-             * @example https://example.com/new-banner.jpg
+             * @description Reaction state after the toggle (null when removed)
+             * @example 🙌
              */
-            banner?: string;
+            reaction: string;
+        };
+        ReactToEncouragementOutputBody: {
             /**
-             * @description Updated categories associated with the blueprint
-             * @example [
-             *       {
-             *         "id": "507f1f77bcf86cd799439011",
-             *         "lastEdited": "2023-01-01T00:00:00Z",
-             *         "name": "Productivity",
-             *         "user": "507f1f77bcf86cd799439012",
-             *         "workspaceName": "Personal"
-             *       }
-             *     ]
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ReactToEncouragementOutputBody.json
              */
-            categories?: components["schemas"]["CategoryDocument"][];
+            readonly $schema?: string;
+            /** @example Reaction added successfully */
+            message: string;
             /**
-             * @description Updated category of the blueprint
-             * @example productivity
+             * @description Reaction state after the toggle (null when removed)
+             * @example ❤️
              */
-            category?: string;
+            reaction: string;
+        };
+        RecordFeedImpressionsInputBody: {
             /**
-             * @description New description
-             * @example An updated comprehensive morning routine
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RecordFeedImpressionsInputBody.json
              */
-            description?: string;
+            readonly $schema?: string;
+            /** @description IDs of feed items the viewer saw: posts, ring closures or tasks */
+            items: string[];
+        };
+        RecordFeedImpressionsOutputBody: {
             /**
-             * @description Updated expected duration
-             * @example 45m
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RecordFeedImpressionsOutputBody.json
              */
-            duration?: string;
+            readonly $schema?: string;
+            /** @example Impressions recorded */
+            message: string;
+        };
+        RecordInteractionOutputBody: {
             /**
-             * @description New name for the blueprint
-             * @example Updated Morning Routine
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RecordInteractionOutputBody.json
              */
-            name?: string;
+            readonly $schema?: string;
+            /** @example Interaction recorded */
+            message: string;
+        };
+        RecordInteractionParams: {
             /**
-             * @description Updated subscriber list
-             * @example [
-             *       "507f1f77bcf86cd799439012"
-             *     ]
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RecordInteractionParams.json
              */
-            subscribers?: string[];
+            readonly $schema?: string;
             /**
-             * @description Updated tags
-             * @example [
-             *       "productivity",
-             *       "morning",
-             *       "health"
-             *     ]
+             * @description Card type the user interacted with
+             * @example kudos_received
              */
-            tags?: string[];
+            cardType: string;
+        };
+        RecurDetails: {
+            behavior?: string;
+            daysOfMonth?: number[];
+            daysOfWeek?: number[];
+            /** Format: int64 */
+            every?: number;
+            flex?: components["schemas"]["FlexDetails"];
+            months?: number[];
+            reminders?: string[];
+        };
+        RedeemRewardRequest: {
             /**
-             * Format: date-time
-             * @description Update timestamp
-             * @example 2023-01-02T00:00:00Z
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RedeemRewardRequest.json
              */
-            timestamp?: string;
+            readonly $schema?: string;
+            /**
+             * @description Type of kudos to use for redemption
+             * @enum {string}
+             */
+            kudosType: "encouragements" | "congratulations";
+            /**
+             * @description Type of reward to redeem
+             * @enum {string}
+             */
+            rewardType: "voice" | "naturalLanguage" | "group" | "integration" | "analytics";
+        };
+        RedeemRewardResponse: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RedeemRewardResponse.json
+             */
+            readonly $schema?: string;
+            /**
+             * Format: int64
+             * @description Number of credits received (if applicable)
+             */
+            creditsReceived?: number;
+            /**
+             * Format: int64
+             * @description Remaining kudos after redemption
+             */
+            kudosRemaining: number;
+            /** @description Success message */
+            message: string;
+        };
+        ReferralDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ReferralDocument.json
+             */
+            readonly $schema?: string;
+            id: string;
+            metadata: components["schemas"]["ReferralMetadata"];
+            referralCode: string;
+            referredBy?: string;
+            referredUsers: components["schemas"]["ReferredUserInfo"][];
+            unlockedFeatures: components["schemas"]["UnlockedFeature"][];
+            /** Format: int64 */
+            unlocksRemaining: number;
+            userId: string;
+        };
+        ReferralMetadata: {
+            /** Format: date-time */
+            createdAt: string;
+            /** Format: date-time */
+            lastReferralAt?: string;
+            /** Format: int64 */
+            totalReferred: number;
+            /** Format: date-time */
+            updatedAt: string;
+        };
+        ReferredUserInfo: {
+            displayName: string;
+            handle: string;
+            /** Format: date-time */
+            joinedAt: string;
+            rewardGranted: boolean;
+            userId: string;
+        };
+        ReferrerInfo: {
+            displayName: string;
+            handle: string;
+            id: string;
+            profilePicture: string;
+        };
+        RefreshTokenOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RefreshTokenOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Tokens refreshed successfully */
+            message: string;
+        };
+        RegisterRequest: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RegisterRequest.json
+             */
+            readonly $schema?: string;
+            display_name: string;
+            email: string;
+            handle: string;
+            password: string;
+            phone: string;
+            profile_picture: string;
+            timezone?: string;
+        };
+        RegisterRequestApple: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RegisterRequestApple.json
+             */
+            readonly $schema?: string;
+            apple_id: string;
+            display_name: string;
+            email: string;
+            handle: string;
+            id_token?: string;
+            profile_picture: string;
+        };
+        RegisterRequestGoogle: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RegisterRequestGoogle.json
+             */
+            readonly $schema?: string;
+            display_name: string;
+            email: string;
+            google_id: string;
+            handle: string;
+            id_token?: string;
+            profile_picture: string;
+        };
+        RelationshipInfo: {
+            request_id?: string;
+            /**
+             * Format: int64
+             * @description Friendship score for this pair (0 unless friends)
+             */
+            score: number;
+            status: string;
+        };
+        Reminder: {
+            afterDeadline: boolean;
+            afterStart: boolean;
+            beforeDeadline: boolean;
+            beforeStart: boolean;
+            customMessage?: string;
+            sent: boolean;
+            sound?: string;
+            /** Format: date-time */
+            triggerTime: string;
+            type: string;
+            vibration: boolean;
+        };
+        RemoveMemberOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RemoveMemberOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Member removed successfully */
+            message: string;
+        };
+        RemoveMemberParams: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RemoveMemberParams.json
+             */
+            readonly $schema?: string;
+            userId: string;
+        };
+        RenameWorkspaceInputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RenameWorkspaceInputBody.json
+             */
+            readonly $schema?: string;
+            /** @example new-workspace */
+            newName: string;
+        };
+        RenameWorkspaceOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RenameWorkspaceOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Workspace renamed successfully */
+            message: string;
+        };
+        ReportCommentOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ReportCommentOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Report submitted successfully */
+            message: string;
+            report_id: string;
+        };
+        ReportCommentRequest: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ReportCommentRequest.json
+             */
+            readonly $schema?: string;
+            /**
+             * @description Optional additional details
+             * @example This comment is spam
+             */
+            description?: string;
+            /**
+             * @description Reason for reporting
+             * @example spam
+             */
+            reason: string;
+        };
+        ReportDocumentAPI: {
+            _id: string;
+            content_id: string;
+            content_owner_id: string;
+            content_type: string;
+            /** Format: date-time */
+            created_at: string;
+            description?: string;
+            reason: string;
+            reporter_id: string;
+            /** Format: date-time */
+            reviewed_at?: string;
+            status: string;
+        };
+        ReportPostOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ReportPostOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Report submitted successfully */
+            message: string;
+            report_id: string;
+        };
+        ReportPostRequest: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ReportPostRequest.json
+             */
+            readonly $schema?: string;
+            /**
+             * @description Optional additional details
+             * @example This post contains offensive content
+             */
+            description?: string;
+            /**
+             * @description Reason for reporting
+             * @example inappropriate
+             */
+            reason: string;
+        };
+        ResetTemplateMetricsOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ResetTemplateMetricsOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Template metrics reset successfully */
+            message: string;
+        };
+        RespondToTaskTagInputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RespondToTaskTagInputBody.json
+             */
+            readonly $schema?: string;
+            /** @enum {string} */
+            status: "watching" | "copied" | "untagged";
+        };
+        RespondToTaskTagOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RespondToTaskTagOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Response recorded */
+            message: string;
+        };
+        RevenueCatEvent: {
+            app_user_id: string;
+            currency: string;
+            entitlement_ids: string[];
+            environment: string;
+            /** Format: int64 */
+            expiration_at_ms: number;
+            id: string;
+            is_family_share: boolean;
+            original_app_user_id: string;
+            original_transaction_id: string;
+            period_type: string;
+            /** Format: double */
+            price_in_purchased_currency: number;
+            product_id: string;
+            /** Format: int64 */
+            purchased_at_ms: number;
+            store: string;
+            type: string;
+        };
+        RevokeInviteOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/RevokeInviteOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Invite revoked successfully */
+            message: string;
+        };
+        RingDelta: {
+            all_closed: boolean;
+            /** Format: int64 */
+            current: number;
+            just_closed: boolean;
+            just_closed_all: boolean;
+            /** Format: int64 */
+            previous: number;
+            ring: string;
+            /** Format: int64 */
+            target: number;
+        };
+        RingProgress: {
+            closed: boolean;
+            /** Format: int64 */
+            current: number;
+            /** Format: int64 */
+            target: number;
+        };
+        RingState: {
+            all_closed: boolean;
+            /** Format: date-time */
+            created_at: string;
+            /** Format: date-time */
+            date: string;
+            do: components["schemas"]["RingProgress"];
+            id: string;
+            plan: components["schemas"]["RingProgress"];
+            /** Format: int64 */
+            reward_amount?: number;
+            reward_claimed: boolean;
+            reward_type?: string;
+            share: components["schemas"]["RingProgress"];
+            /** Format: date-time */
+            updated_at: string;
+            user_id: string;
+        };
+        SafeUser: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SafeUser.json
+             */
+            readonly $schema?: string;
+            _id: string;
+            categories: components["schemas"]["CategoryDocument"][];
+            /** Format: int64 */
+            congratulations: number;
+            credits: components["schemas"]["UserCredits"];
+            display_name: string;
+            /** Format: int64 */
+            encouragements: number;
+            /** Format: date-time */
+            first_all_rings_closed_at?: string;
+            friends: string[];
+            handle: string;
+            kudosRewards: components["schemas"]["KudosRewards"];
+            /** Format: int64 */
+            points: number;
+            /** Format: int64 */
+            posts_made: number;
+            profile_picture: string;
+            recent_activity: components["schemas"]["ActivityDocument"][];
+            settings: components["schemas"]["UserSettings"];
+            song?: components["schemas"]["Song"];
+            /** Format: int64 */
+            streak: number;
+            streakEligible: boolean;
+            subscription: components["schemas"]["Subscription"];
+            /** Format: double */
+            tasks_complete: number;
+            /** Format: date-time */
+            terms_accepted_at?: string;
+            terms_version?: string;
+            timezone?: string;
+        };
+        SaveStatedPreferencesOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SaveStatedPreferencesOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @description The user_memory keys written */
+            keys: string[];
+            /** @example Preferences saved */
+            message: string;
+        };
+        ScheduleDraftInputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/ScheduleDraftInputBody.json
+             */
+            readonly $schema?: string;
+            /**
+             * Format: date-time
+             * @description When to publish; must be in the future and within 30 days
+             */
+            publishAt: string;
+        };
+        SendBeakCongratulationParams: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SendBeakCongratulationParams.json
+             */
+            readonly $schema?: string;
+            /**
+             * @description Category name
+             * @example My Tasks
+             */
+            categoryName: string;
+            /** @description Whether to grant welcome credits to the user */
+            grantCredits?: boolean;
+            /**
+             * @description Congratulation message
+             * @example welcome! you just completed your first task!
+             */
+            message: string;
+            /**
+             * @description Task name
+             * @example Start my morning routine
+             */
+            taskName: string;
+        };
+        SendBeakCongratulationResult: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SendBeakCongratulationResult.json
+             */
+            readonly $schema?: string;
+            /** @description Credits granted to the user by type */
+            creditsGranted?: {
+                [key: string]: number;
+            };
+            /** @example Congratulation sent successfully */
+            message: string;
+        };
+        SendOTPOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SendOTPOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example OTP sent successfully */
+            message: string;
+            verification_id?: string;
+        };
+        SendOTPRequest: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SendOTPRequest.json
+             */
+            readonly $schema?: string;
+            phone_number: string;
+        };
+        SetMemberRoleParams: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SetMemberRoleParams.json
+             */
+            readonly $schema?: string;
+            /**
+             * @description New role; making someone the owner hands over ownership and makes you an admin
+             * @enum {string}
+             */
+            role: "owner" | "admin" | "member";
+        };
+        SetWorkspacePushEnabledInputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SetWorkspacePushEnabledInputBody.json
+             */
+            readonly $schema?: string;
+            push_enabled: boolean;
+        };
+        SetWorkspacePushEnabledOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SetWorkspacePushEnabledOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Workspace push setting updated */
+            message: string;
+            /**
+             * Format: int64
+             * @example 2
+             */
+            modified: number;
+        };
+        SetupWorkspacesInputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SetupWorkspacesInputBody.json
+             */
+            readonly $schema?: string;
+            calendar_ids: string[];
+            make_public: boolean;
+            merge_into_one: boolean;
+            /** @description Calendar IDs (subset of calendar_ids) that should also receive Kindred tasks pushed back to Google. Default empty (push disabled). */
+            push_enabled_calendar_ids?: string[];
+        };
+        SetupWorkspacesOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SetupWorkspacesOutputBody.json
+             */
+            readonly $schema?: string;
+            message: string;
+            success: boolean;
+        };
+        Song: {
+            appleMusicUrl?: string;
+            artist: string;
+            artworkUrl?: string;
+            /** Format: int64 */
+            id: number;
+            previewUrl: string;
+            title: string;
+        };
+        StartWorkingOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/StartWorkingOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Started working on task */
+            message: string;
+        };
+        StatedPreferencesRequest: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/StatedPreferencesRequest.json
+             */
+            readonly $schema?: string;
+            /**
+             * @description How often they want to be nudged; matches the check-in frequency vocabulary
+             * @enum {string}
+             */
+            nudgeFrequency?: "none" | "occasionally" | "regularly" | "frequently";
+            /**
+             * @description When they say they focus best
+             * @enum {string}
+             */
+            peakHours?: "morning" | "afternoon" | "evening" | "night";
+            /**
+             * @description How much structure they want up front
+             * @enum {string}
+             */
+            planningStyle?: "detailed" | "flexible" | "spontaneous";
+            /**
+             * @description How the assistant should talk to them
+             * @enum {string}
+             */
+            tone?: "encouraging" | "direct" | "playful";
+        };
+        SubscribeToBlueprintOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SubscribeToBlueprintOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Subscribed to blueprint successfully */
+            message: string;
+        };
+        Subscription: {
+            /** Format: date-time */
+            canceledAt?: string;
+            /** Format: date-time */
+            endDate?: string;
+            provider?: string;
+            /** Format: date-time */
+            renewalDate?: string;
+            /** Format: date-time */
+            startDate: string;
+            status: string;
+            subscriptionId?: string;
+            tier: string;
+        };
+        SubscriptionWebhookOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SubscriptionWebhookOutputBody.json
+             */
+            readonly $schema?: string;
+            status: string;
+        };
+        SuggestTaskFieldsInputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SuggestTaskFieldsInputBody.json
+             */
+            readonly $schema?: string;
+            /**
+             * @description The task text the user is typing
+             * @example gym tomorrow 7-8am every weekday
+             */
+            text: string;
+            /**
+             * @description User's timezone (IANA format). Defaults to America/New_York if not provided
+             * @example America/New_York
+             */
+            timezone?: string;
+        };
+        SuggestTaskFieldsOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SuggestTaskFieldsOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @description Suggested existing category id; absent when there is no confident suggestion */
+            categoryId?: string;
+            /**
+             * Format: int64
+             * @description Suggested priority (1-3); absent when there is no confident suggestion
+             */
+            priority?: number;
+            /**
+             * Format: double
+             * @description Suggested difficulty (1-5); absent when there is no confident suggestion
+             */
+            value?: number;
+        };
+        SyncEventsOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/SyncEventsOutputBody.json
+             */
+            readonly $schema?: string;
+            categories_synced: {
+                [key: string]: number;
+            };
+            /** Format: int64 */
+            events_total: number;
+            /** Format: int64 */
+            tasks_created: number;
+            /** Format: int64 */
+            tasks_deleted: number;
+            /** Format: int64 */
+            tasks_skipped: number;
+            workspace_name: string;
+        };
+        TaggedTaskUser: {
+            display_name: string;
+            handle: string;
+            id: string;
+            profile_picture: string;
+            status: string;
+        };
+        TaggerInfo: {
+            display_name: string;
+            handle: string;
+            id: string;
+            profile_picture: string;
+        };
+        TaskDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/TaskDocument.json
+             */
+            readonly $schema?: string;
+            active: boolean;
+            blueprintId?: string;
+            categoryID?: string;
+            /** @description ChallengeID links a task to the group challenge it counts toward. */
+            challengeId?: string;
+            checklist?: components["schemas"]["ChecklistItem"][];
+            completionType?: string;
+            content: string;
+            /** Format: date-time */
+            deadline?: string;
+            /** Format: int64 */
+            durationSeconds?: number;
+            encouragements?: components["schemas"]["TaskKudos"][];
+            flexInfo?: components["schemas"]["FlexInstanceInfo"];
+            id: string;
+            integration?: string;
+            /** Format: date-time */
+            lastEdited: string;
+            notes?: string;
+            posted: boolean;
+            /** Format: int64 */
+            priority: number;
+            public: boolean;
+            pushed_calendar_id?: string;
+            pushed_event_etag?: string;
+            pushed_event_id?: string;
+            recurDetails?: components["schemas"]["RecurDetails"];
+            recurFrequency?: string;
+            recurType?: string;
+            recurring: boolean;
+            reminders?: components["schemas"]["Reminder"][];
+            /** Format: int64 */
+            rescheduleCount?: number;
+            sessionNote?: string;
+            sessionPhoto?: string;
+            sessionTrackable?: boolean;
+            source?: string;
+            /** Format: date-time */
+            startDate: string;
+            /** Format: date-time */
+            startTime?: string;
+            /** Format: date-time */
+            startedAt?: string;
+            taggedUsers?: components["schemas"]["TaggedTaskUser"][];
+            taskId?: string;
+            templateID?: string;
+            /** Format: date-time */
+            timeCompleted?: string;
+            timeTaken?: string;
+            /** Format: date-time */
+            timestamp: string;
+            userID?: string;
+            /** Format: double */
+            value: number;
+            /** Format: date-time */
+            workingOnSince?: string;
+        };
+        TaskKudos: {
+            /** Format: int64 */
+            durationMs?: number;
+            encouragementId: string;
+            message: string;
+            sender: components["schemas"]["KudosSender"];
+            thumbnailUrl?: string;
+            /** Format: date-time */
+            timestamp: string;
+            type: string;
+        };
+        TaskQueryFilters: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/TaskQueryFilters.json
+             */
+            readonly $schema?: string;
+            /** @description Filter by active status */
+            active?: boolean;
+            /** @description Filter by category IDs */
+            categoryIds?: string[];
+            /**
+             * Format: date-time
+             * @description Filter tasks with deadline on or after this time (ISO8601)
+             */
+            deadlineFrom?: string;
+            /**
+             * Format: date-time
+             * @description Filter tasks with deadline on or before this time (ISO8601)
+             */
+            deadlineTo?: string;
+            /** @description Filter tasks that have (true) or don't have (false) a deadline */
+            hasDeadline?: boolean;
+            /** @description Filter tasks that have (true) or don't have (false) a start date */
+            hasStartTime?: boolean;
+            /** @description Filter by priority values (1=low, 2=medium, 3=high) */
+            priorities?: number[];
+            /**
+             * @description Sort field: timestamp, priority, value, or deadline
+             * @example timestamp
+             */
+            sortBy?: string;
+            /**
+             * Format: int64
+             * @description Sort direction: 1 (ascending) or -1 (descending)
+             * @example -1
+             */
+            sortDir?: number;
+            /**
+             * Format: date-time
+             * @description Filter tasks with start date on or after this time (ISO8601)
+             */
+            startTimeFrom?: string;
+            /**
+             * Format: date-time
+             * @description Filter tasks with start date on or before this time (ISO8601)
+             */
+            startTimeTo?: string;
+        };
+        TemplateTaskDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/TemplateTaskDocument.json
+             */
+            readonly $schema?: string;
+            blueprintId?: string;
+            categoryID: string;
+            challengeId?: string;
+            checklist?: components["schemas"]["ChecklistItem"][];
+            completionDates?: string[];
+            content: string;
+            /** Format: date-time */
+            deadline?: string;
+            flexState?: components["schemas"]["FlexTemplateState"];
+            /** Format: int64 */
+            highestStreak: number;
+            id: string;
+            /** Format: date-time */
+            lastEdited: string;
+            /** Format: date-time */
+            lastGenerated: string;
+            /** Format: date-time */
+            lastMissedAt?: string;
+            /** Format: date-time */
+            nextGenerated: string;
+            notes?: string;
+            /** Format: int64 */
+            previousStreak?: number;
+            /** Format: int64 */
+            priority: number;
+            public: boolean;
+            recurDetails: components["schemas"]["RecurDetails"];
+            recurFrequency?: string;
+            recurType: string;
+            reminders?: components["schemas"]["Reminder"][];
+            /** Format: date-time */
+            startDate?: string;
+            /** Format: date-time */
+            startTime?: string;
+            /** Format: int64 */
+            streak: number;
+            taggedUsers?: components["schemas"]["TaggedTaskUser"][];
+            /** Format: int64 */
+            timesCompleted: number;
+            /** Format: int64 */
+            timesGenerated: number;
+            /** Format: int64 */
+            timesMissed: number;
+            userID: string;
+            /** Format: double */
+            value: number;
+        };
+        TemplateWithCategory: {
+            blueprintId?: string;
+            categoryID: string;
+            categoryName: string;
+            checklist?: components["schemas"]["ChecklistItem"][];
+            completionDates?: string[];
+            content: string;
+            /** Format: date-time */
+            deadline?: string;
+            flexState?: components["schemas"]["FlexTemplateState"];
+            /** Format: int64 */
+            highestStreak: number;
+            id: string;
+            /** Format: date-time */
+            lastEdited: string;
+            /** Format: date-time */
+            lastGenerated: string;
+            /** Format: date-time */
+            lastMissedAt?: string;
+            /** Format: date-time */
+            nextGenerated: string;
+            notes?: string;
+            /** Format: int64 */
+            previousStreak?: number;
+            /** Format: int64 */
+            priority: number;
+            public: boolean;
+            recurDetails: components["schemas"]["RecurDetails"];
+            recurFrequency?: string;
+            recurType: string;
+            reminders?: components["schemas"]["Reminder"][];
+            /** Format: date-time */
+            startDate?: string;
+            /** Format: date-time */
+            startTime?: string;
+            /** Format: int64 */
+            streak: number;
+            taggedUsers?: components["schemas"]["TaggedTaskUser"][];
+            /** Format: int64 */
+            timesCompleted: number;
+            /** Format: int64 */
+            timesGenerated: number;
+            /** Format: int64 */
+            timesMissed: number;
+            userID: string;
+            /** Format: double */
+            value: number;
+        };
+        TestOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/TestOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Authorized! */
+            message: string;
+        };
+        UnblockUserOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UnblockUserOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example User unblocked successfully */
+            message: string;
+        };
+        UndoMissedTaskOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UndoMissedTaskOutputBody.json
+             */
+            readonly $schema?: string;
+            /**
+             * Format: int64
+             * @description Current highest streak
+             * @example 6
+             */
+            highestStreak: number;
+            /** @example Missed task successfully marked as completed */
+            message: string;
+            /**
+             * Format: int64
+             * @description Restored streak value
+             * @example 6
+             */
+            streak: number;
+        };
+        UnlockFeatureInputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UnlockFeatureInputBody.json
+             */
+            readonly $schema?: string;
+            featureId: string;
+        };
+        UnlockFeatureOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UnlockFeatureOutputBody.json
+             */
+            readonly $schema?: string;
+            feature: components["schemas"]["UnlockedFeature"];
+            success: boolean;
+            /** Format: int64 */
+            unlocksRemaining: number;
+        };
+        UnlockedFeature: {
+            active: boolean;
+            /** Format: date-time */
+            expiresAt?: string;
+            featureId: string;
+            featureName: string;
+            /** Format: date-time */
+            unlockedAt: string;
+            unlockedBy: string;
+        };
+        UnsubscribeFromBlueprintOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UnsubscribeFromBlueprintOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Unsubscribed from blueprint successfully */
+            message: string;
+        };
+        UpdateBlueprintDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateBlueprintDocument.json
+             */
+            readonly $schema?: string;
+            /**
+             * @description New banner image URL
+             * @example https://example.com/new-banner.jpg
+             */
+            banner?: string;
+            /**
+             * @description Updated categories associated with the blueprint
+             * @example [
+             *       {
+             *         "id": "507f1f77bcf86cd799439011",
+             *         "lastEdited": "2023-01-01T00:00:00Z",
+             *         "name": "Productivity",
+             *         "user": "507f1f77bcf86cd799439012",
+             *         "workspaceName": "Personal"
+             *       }
+             *     ]
+             */
+            categories?: components["schemas"]["CategoryDocument"][];
+            /**
+             * @description Updated category of the blueprint
+             * @example productivity
+             */
+            category?: string;
+            /**
+             * @description New description
+             * @example An updated comprehensive morning routine
+             */
+            description?: string;
+            /**
+             * @description Updated expected duration
+             * @example 45m
+             */
+            duration?: string;
+            /**
+             * @description New name for the blueprint
+             * @example Updated Morning Routine
+             */
+            name?: string;
+            /**
+             * @description Updated subscriber list
+             * @example [
+             *       "507f1f77bcf86cd799439012"
+             *     ]
+             */
+            subscribers?: string[];
+            /**
+             * @description Updated tags
+             * @example [
+             *       "productivity",
+             *       "morning",
+             *       "health"
+             *     ]
+             */
+            tags?: string[];
+            /**
+             * Format: date-time
+             * @description Update timestamp
+             * @example 2023-01-02T00:00:00Z
+             */
+            timestamp?: string;
+        };
+        UpdateBlueprintOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateBlueprintOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Blueprint updated successfully */
+            message: string;
+        };
+        UpdateCategoryDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateCategoryDocument.json
+             */
+            readonly $schema?: string;
+            blueprintId?: string;
+            isBlueprint?: boolean;
+            name?: string;
+            tags?: string[];
+        };
+        UpdateCategoryOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateCategoryOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Category updated successfully */
+            message: string;
+        };
+        UpdateCloseFriendsInputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateCloseFriendsInputBody.json
+             */
+            readonly $schema?: string;
+            /** @description IDs of the friends on the list; anyone who isn't a friend is dropped */
+            users: string[];
+        };
+        UpdateCongratulationDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateCongratulationDocument.json
+             */
+            readonly $schema?: string;
+            /**
+             * @description New category name
+             * @example Updated Work
+             */
+            categoryName?: string;
+            /**
+             * @description New message
+             * @example Updated congratulation message
+             */
+            message?: string;
+            /**
+             * @description Read status
+             * @example true
+             */
+            read?: boolean;
+            /**
+             * @description New task name
+             * @example Updated task name
+             */
+            taskName?: string;
+            /**
+             * Format: date-time
+             * @description Update timestamp
+             * @example 2023-01-02T00:00:00Z
+             */
+            timestamp?: string;
+        };
+        UpdateCongratulationOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateCongratulationOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Congratulation updated successfully */
+            message: string;
+        };
+        UpdateConnectionDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateConnectionDocument.json
+             */
+            readonly $schema?: string;
+            /** Format: date-time */
+            accepted_at?: string;
+            status?: string;
+        };
+        UpdateConnectionOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateConnectionOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Connection updated successfully */
+            message: string;
+        };
+        UpdateEncouragementDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateEncouragementDocument.json
+             */
+            readonly $schema?: string;
+            /**
+             * @description New category name
+             * @example Updated Work
+             */
+            categoryName?: string;
+            /**
+             * @description New message
+             * @example Updated encouragement message
+             */
+            message?: string;
+            /**
+             * @description Read status
+             * @example true
+             */
+            read?: boolean;
+            /**
+             * @description New task name
+             * @example Updated task name
+             */
+            taskName?: string;
+            /**
+             * Format: date-time
+             * @description Update timestamp
+             * @example 2023-01-02T00:00:00Z
+             */
+            timestamp?: string;
+        };
+        UpdateEncouragementOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateEncouragementOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Encouragement updated successfully */
+            message: string;
+        };
+        UpdateFriendListParams: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateFriendListParams.json
+             */
+            readonly $schema?: string;
+            /** @description Replacement member list; anyone who isn't a friend is dropped */
+            members?: string[];
+            /** @description New name; the built-in list can't be renamed */
+            name?: string;
+        };
+        UpdateGroupNotificationsParams: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateGroupNotificationsParams.json
+             */
+            readonly $schema?: string;
+            activity?: boolean;
+            joinRequests?: boolean;
+            posts?: boolean;
+        };
+        UpdateGroupOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateGroupOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Group updated successfully */
+            message: string;
+        };
+        UpdateGroupParams: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateGroupParams.json
+             */
+            readonly $schema?: string;
+            name?: string;
+            /** @description Whether joining through an invite link needs an owner or admin to approve */
+            requireApproval?: boolean;
+        };
+        UpdatePersonalizationRequest: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdatePersonalizationRequest.json
+             */
+            readonly $schema?: string;
+            /** @description False turns personalization off; the worker stops deriving anything for this user. Setting it true also clears any pause. */
+            enabled?: boolean;
+            /**
+             * Format: date-time
+             * @description Pause personalization until this instant. The worker skips the user while it is in the future.
+             */
+            pausedUntil?: string;
+            /** @description Opt in to letting close friends be prompted when you are stuck — a stalled task, a streak about to break. Defaults to false and stays false unless this is sent as true. Achievement-based kudos prompts do not depend on it. */
+            shareStruggles?: boolean;
+        };
+        UpdatePostOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdatePostOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Post updated successfully */
+            message: string;
+        };
+        UpdatePostParams: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdatePostParams.json
+             */
+            readonly $schema?: string;
+            /** @description New audience; viewers who lose access stop seeing the post everywhere */
+            audience?: components["schemas"]["AudienceInput"];
+            caption?: string;
+            isPublic?: boolean;
+            size?: components["schemas"]["ImageSize"];
+            /** @description Tagged user references as [{id, handle}]; malformed entries are dropped, never rejected */
+            taggedUsers?: unknown[];
+        };
+        UpdateProfileDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateProfileDocument.json
+             */
+            readonly $schema?: string;
+            display_name?: string;
+            handle?: string;
+            profile_picture?: string;
+            song?: components["schemas"]["Song"];
+        };
+        UpdateProfileOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateProfileOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Profile updated successfully */
+            message: string;
+        };
+        UpdatePushTokenOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdatePushTokenOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Push Token Updated Successfully */
+            message: string;
+        };
+        UpdatePushTokenRequest: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdatePushTokenRequest.json
+             */
+            readonly $schema?: string;
+            push_token: string;
+        };
+        UpdateStatedFactInputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateStatedFactInputBody.json
+             */
+            readonly $schema?: string;
+            /** @description The new answer; valid values depend on the key */
+            value: string;
+        };
+        UpdateStatedFactOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateStatedFactOutputBody.json
+             */
+            readonly $schema?: string;
+            key: string;
+            /** @example Preference updated */
+            message: string;
+            value: string;
+        };
+        UpdateTaskChecklistDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTaskChecklistDocument.json
+             */
+            readonly $schema?: string;
+            checklist: components["schemas"]["ChecklistItem"][];
+        };
+        UpdateTaskChecklistOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTaskChecklistOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Task checklist updated successfully */
+            message: string;
+        };
+        UpdateTaskDeadlineDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTaskDeadlineDocument.json
+             */
+            readonly $schema?: string;
+            /** Format: date-time */
+            deadline?: string;
+        };
+        UpdateTaskDeadlineOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTaskDeadlineOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Task deadline updated successfully */
+            message: string;
+        };
+        UpdateTaskDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTaskDocument.json
+             */
+            readonly $schema?: string;
+            active?: boolean;
+            blueprintId?: string;
+            checklist?: components["schemas"]["ChecklistItem"][];
+            content: string;
+            /** Format: date-time */
+            deadline?: string;
+            generateTemplate?: boolean;
+            integration?: string;
+            notes?: string;
+            /** Format: int64 */
+            priority: number;
+            public: boolean;
+            recurDetails: components["schemas"]["RecurDetails"];
+            recurFrequency?: string;
+            recurType?: string;
+            recurring: boolean;
+            reminders?: components["schemas"]["Reminder"][];
+            sessionTrackable?: boolean;
+            /** Format: date-time */
+            startDate?: string;
+            /** Format: date-time */
+            startTime?: string;
+            templateID?: string;
+            /** Format: double */
+            value: number;
+        };
+        UpdateTaskNotesDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTaskNotesDocument.json
+             */
+            readonly $schema?: string;
+            notes: string;
+        };
+        UpdateTaskNotesOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTaskNotesOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Task notes updated successfully */
+            message: string;
+        };
+        UpdateTaskOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTaskOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Task updated successfully */
+            message: string;
+        };
+        UpdateTaskReminderDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTaskReminderDocument.json
+             */
+            readonly $schema?: string;
+            reminders?: components["schemas"]["Reminder"][];
+        };
+        UpdateTaskReminderOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTaskReminderOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Task reminders updated successfully */
+            message: string;
+        };
+        UpdateTaskStartDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTaskStartDocument.json
+             */
+            readonly $schema?: string;
+            /** Format: date-time */
+            startDate?: string;
+            /** Format: date-time */
+            startTime?: string;
+        };
+        UpdateTaskStartOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTaskStartOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Task start date/time updated successfully */
+            message: string;
+        };
+        UpdateTaskTagsInputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTaskTagsInputBody.json
+             */
+            readonly $schema?: string;
+            /** @description Friend lists whose members to tag; close_friends names the built-in list */
+            taggedListIds?: string[];
+            taggedUserIds: string[];
+        };
+        UpdateTaskTagsOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTaskTagsOutputBody.json
+             */
+            readonly $schema?: string;
+            taggedUsers: components["schemas"]["TaggedTaskUser"][];
+        };
+        UpdateTemplateDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTemplateDocument.json
+             */
+            readonly $schema?: string;
+            checklist?: components["schemas"]["ChecklistItem"][];
+            content?: string;
+            /** Format: date-time */
+            deadline?: string;
+            notes?: string;
+            /** Format: int64 */
+            priority?: number;
+            public?: boolean;
+            recurDetails?: components["schemas"]["RecurDetails"];
+            recurFrequency?: string;
+            recurType?: string;
+            reminders?: components["schemas"]["Reminder"][];
+            /** Format: date-time */
+            startDate?: string;
+            /** Format: date-time */
+            startTime?: string;
+            /** Format: double */
+            value?: number;
+        };
+        UpdateTemplateOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTemplateOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Template task updated successfully */
+            message: string;
+        };
+        UpdateTimezoneInputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTimezoneInputBody.json
+             */
+            readonly $schema?: string;
+            /**
+             * @description The IANA timezone identifier
+             * @example America/New_York
+             */
+            timezone: string;
+        };
+        UpdateTimezoneOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateTimezoneOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Timezone updated successfully */
+            message: string;
+        };
+        UpdateUserSettingsOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateUserSettingsOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Settings updated successfully */
+            message: string;
+        };
+        UpdateWorkspaceOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateWorkspaceOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Workspace updated successfully */
+            message: string;
+        };
+        UpdateWorkspaceParams: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UpdateWorkspaceParams.json
+             */
+            readonly $schema?: string;
+            color?: string;
+            icon?: string;
+        };
+        UserCredits: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UserCredits.json
+             */
+            readonly $schema?: string;
+            /**
+             * Format: int64
+             * @description Analytics access credits
+             */
+            analytics: number;
+            /**
+             * Format: int64
+             * @description Blueprint creation credits
+             */
+            blueprint: number;
+            /**
+             * Format: int64
+             * @description Group creation credits
+             */
+            group: number;
+            /**
+             * Format: int64
+             * @description Natural language task creation credits
+             */
+            naturalLanguage: number;
+            /**
+             * Format: int64
+             * @description Voice task creation credits
+             */
+            voice: number;
+        };
+        UserExtendedReference: {
+            /**
+             * @description User ID
+             * @example 507f1f77bcf86cd799439011
+             */
+            _id: string;
+            /**
+             * @description User display name
+             * @example John Doe
+             */
+            display_name: string;
+            /**
+             * @description User handle
+             * @example johndoe
+             */
+            handle: string;
+            /**
+             * @description Profile picture URL
+             * @example https://example.com/avatar.jpg
+             */
+            profile_picture: string;
+        };
+        UserExtendedReferenceWithPhone: {
+            /**
+             * @description User ID
+             * @example 507f1f77bcf86cd799439011
+             */
+            _id: string;
+            /**
+             * @description User display name
+             * @example John Doe
+             */
+            display_name: string;
+            /**
+             * @description User handle
+             * @example johndoe
+             */
+            handle: string;
+            /**
+             * @description User phone number
+             * @example +1234567890
+             */
+            phone: string;
+            /**
+             * @description Profile picture URL
+             * @example https://example.com/avatar.jpg
+             */
+            profile_picture: string;
+        };
+        UserReference: {
+            display_name: string;
+            handle: string;
+            id: string;
+            profile_picture: string;
+        };
+        UserSettings: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/UserSettings.json
+             */
+            readonly $schema?: string;
+            dashboard_configuration: components["schemas"]["DashboardConfiguration"];
+            display: components["schemas"]["DisplaySettings"];
+            lists?: components["schemas"]["FriendListSettings"];
+            notifications: components["schemas"]["NotificationSettings"];
+            personalization?: components["schemas"]["PersonalizationSettings"];
+            privacy?: components["schemas"]["PrivacySettings"];
+        };
+        VerifyOTPOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/VerifyOTPOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example OTP verified successfully */
+            message: string;
+            status?: string;
+            valid: boolean;
+        };
+        VerifyOTPRequest: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/VerifyOTPRequest.json
+             */
+            readonly $schema?: string;
+            code: string;
+            phone_number: string;
+        };
+        WaitlistDocument: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/WaitlistDocument.json
+             */
+            readonly $schema?: string;
+            /**
+             * @description User email address
+             * @example user@example.com
+             */
+            email: string;
+            /**
+             * @description Unique waitlist entry identifier
+             * @example 507f1f77bcf86cd799439011
+             */
+            id: string;
+            /**
+             * @description User full name
+             * @example John Doe
+             */
+            name: string;
+            /**
+             * Format: date-time
+             * @description Entry creation timestamp
+             * @example 2023-01-01T00:00:00Z
+             */
+            timestamp: string;
+        };
+        WatchChannel: {
+            calendar_id: string;
+            channel_id: string;
+            /** Format: date-time */
+            created_at: string;
+            /** Format: date-time */
+            expiration: string;
+            resource_id: string;
+        };
+        WebhookEventBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/WebhookEventBody.json
+             */
+            readonly $schema?: string;
+            api_version: string;
+            event: components["schemas"]["RevenueCatEvent"];
+        };
+        WebhookOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/WebhookOutputBody.json
+             */
+            readonly $schema?: string;
+            success: boolean;
+        };
+        WelcomeOutputBody: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example https://example.com/schemas/WelcomeOutputBody.json
+             */
+            readonly $schema?: string;
+            /** @example Welcome to Kindred! */
+            message: string;
+        };
+        WorkspaceResult: {
+            categories: components["schemas"]["CategoryDocument"][];
+            color?: string;
+            icon?: string;
+            name: string;
+        };
+    };
+    responses: never;
+    parameters: never;
+    requestBodies: never;
+    headers: never;
+    pathItems: never;
+}
+export type $defs = Record<string, never>;
+export interface operations {
+    "get-activities": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ActivityDocument"][];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    "get-activity": {
+        parameters: {
+            query?: {
+                /** @example 2024 */
+                year?: number;
+            };
+            header?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ActivityDocument"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    "get-activity-by-user-and-period": {
+        parameters: {
+            query?: {
+                /** @example 2024 */
+                year?: number;
+                /** @example 12 */
+                month?: number;
+            };
+            header?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                userID: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ActivityDocument"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    "get-recent-activity": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                userID: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ActivityDocument"][];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    "get-activity-by-user-and-year": {
+        parameters: {
+            query?: {
+                /** @example 2024 */
+                year?: number;
+            };
+            header?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                userID: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ActivityDocument"][];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    "get-reports": {
+        parameters: {
+            query?: {
+                /**
+                 * @description Filter by status (pending, reviewed, resolved)
+                 * @example pending
+                 */
+                status?: string;
+                /**
+                 * @description Number of reports to return
+                 * @example 50
+                 */
+                limit?: number;
+                /**
+                 * @description Offset for pagination
+                 * @example 0
+                 */
+                offset?: number;
+            };
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+            };
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["GetReportsOutputBody"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    "get-presigned-url": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @example profile-pictures/user123.jpg */
+                key: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": unknown;
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    "create-presigned-url": {
+        parameters: {
+            query: {
+                /** @example image/jpeg */
+                fileType: string;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": unknown;
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    login: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["LoginRequest"];
+            };
+        };
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    access_token?: string;
+                    refresh_token?: string;
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SafeUser"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    "login-apple": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["LoginRequestApple"];
+            };
+        };
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    access_token?: string;
+                    refresh_token?: string;
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SafeUser"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    "login-google": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["LoginRequestGoogle"];
+            };
+        };
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    access_token?: string;
+                    refresh_token?: string;
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SafeUser"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    "login-otp": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["LoginWithOTPRequest"];
+            };
+        };
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    access_token?: string;
+                    refresh_token?: string;
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SafeUser"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    "login-phone": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["LoginRequestPhone"];
+            };
+        };
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    access_token?: string;
+                    refresh_token?: string;
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SafeUser"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    logout: {
+        parameters: {
+            query?: never;
+            header: {
+                Authorization: string;
+            };
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["LogoutOutputBody"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    "refresh-token": {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description Refresh token to exchange for new tokens */
+                refresh_token: string;
+            };
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    access_token?: string;
+                    refresh_token?: string;
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["RefreshTokenOutputBody"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    register: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["RegisterRequest"];
+            };
+        };
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    access_token?: string;
+                    refresh_token?: string;
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SafeUser"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    "register-apple": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["RegisterRequestApple"];
+            };
+        };
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    access_token?: string;
+                    refresh_token?: string;
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SafeUser"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    "register-google": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["RegisterRequestGoogle"];
+            };
+        };
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    access_token?: string;
+                    refresh_token?: string;
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SafeUser"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateBlueprintOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateBlueprintOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Blueprint updated successfully */
-            message: string;
+    };
+    "send-otp": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        UpdateCategoryDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateCategoryDocument.json
-             */
-            readonly $schema?: string;
-            blueprintId?: string;
-            isBlueprint?: boolean;
-            name?: string;
-            tags?: string[];
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["SendOTPRequest"];
+            };
         };
-        UpdateCategoryOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateCategoryOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Category updated successfully */
-            message: string;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SendOTPOutputBody"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateCongratulationDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateCongratulationDocument.json
-             */
-            readonly $schema?: string;
-            /**
-             * @description New category name
-             * @example Updated Work
-             */
-            categoryName?: string;
-            /**
-             * @description New message
-             * @example Updated congratulation message
-             */
-            message?: string;
-            /**
-             * @description Read status
-             * @example true
-             */
-            read?: boolean;
-            /**
-             * @description New task name
-             * @example Updated task name
-             */
-            taskName?: string;
-            /**
-             * Format: date-time
-             * @description Update timestamp
-             * @example 2023-01-02T00:00:00Z
-             */
-            timestamp?: string;
+    };
+    "verify-otp": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        UpdateCongratulationOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateCongratulationOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Congratulation updated successfully */
-            message: string;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["VerifyOTPRequest"];
+            };
         };
-        UpdateConnectionDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateConnectionDocument.json
-             */
-            readonly $schema?: string;
-            /** Format: date-time */
-            accepted_at?: string;
-            status?: string;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["VerifyOTPOutputBody"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateConnectionOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateConnectionOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Connection updated successfully */
-            message: string;
+    };
+    "get-blueprints": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        UpdateEncouragementDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateEncouragementDocument.json
-             */
-            readonly $schema?: string;
-            /**
-             * @description New category name
-             * @example Updated Work
-             */
-            categoryName?: string;
-            /**
-             * @description New message
-             * @example Updated encouragement message
-             */
-            message?: string;
-            /**
-             * @description Read status
-             * @example true
-             */
-            read?: boolean;
-            /**
-             * @description New task name
-             * @example Updated task name
-             */
-            taskName?: string;
-            /**
-             * Format: date-time
-             * @description Update timestamp
-             * @example 2023-01-02T00:00:00Z
-             */
-            timestamp?: string;
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["BlueprintDocument"][];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateEncouragementOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateEncouragementOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Encouragement updated successfully */
-            message: string;
+    };
+    "get-blueprint": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["BlueprintDocument"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateGroupOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateGroupOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Group updated successfully */
-            message: string;
+    };
+    "autocomplete-blueprints": {
+        parameters: {
+            query?: {
+                /** @example morn */
+                query?: string;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        UpdateGroupParams: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateGroupParams.json
-             */
-            readonly $schema?: string;
-            name?: string;
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["BlueprintDocument"][];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdatePersonalizationRequest: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdatePersonalizationRequest.json
-             */
-            readonly $schema?: string;
-            /** @description False turns personalization off; the worker stops deriving anything for this user. Setting it true also clears any pause. */
-            enabled?: boolean;
-            /**
-             * Format: date-time
-             * @description Pause personalization until this instant. The worker skips the user while it is in the future.
-             */
-            pausedUntil?: string;
-            /** @description Opt in to letting close friends be prompted when you are stuck — a stalled task, a streak about to break. Defaults to false and stays false unless this is sent as true. Achievement-based kudos prompts do not depend on it. */
-            shareStruggles?: boolean;
+    };
+    "get-blueprints-by-category": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        UpdatePostOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdatePostOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Post updated successfully */
-            message: string;
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["BlueprintCategoryGroup"][];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdatePostParams: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdatePostParams.json
-             */
-            readonly $schema?: string;
-            caption?: string;
-            isPublic?: boolean;
-            size?: components["schemas"]["ImageSize"];
-            /** @description Tagged user references as [{id, handle}]; malformed entries are dropped, never rejected */
-            taggedUsers?: unknown[];
+    };
+    "search-blueprints": {
+        parameters: {
+            query?: {
+                /** @example morning routine */
+                query?: string;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        UpdateProfileDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateProfileDocument.json
-             */
-            readonly $schema?: string;
-            display_name?: string;
-            handle?: string;
-            profile_picture?: string;
-            song?: components["schemas"]["Song"];
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["BlueprintDocument"][];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateProfileOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateProfileOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Profile updated successfully */
-            message: string;
+    };
+    "google-calendar-oauth-callback": {
+        parameters: {
+            query: {
+                code: string;
+                state: string;
+                error?: string;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        UpdatePushTokenOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdatePushTokenOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Push Token Updated Successfully */
-            message: string;
+        requestBody?: never;
+        responses: {
+            /** @description No Content */
+            204: {
+                headers: {
+                    Location?: string;
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdatePushTokenRequest: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdatePushTokenRequest.json
-             */
-            readonly $schema?: string;
-            push_token: string;
+    };
+    "calendar-webhook": {
+        parameters: {
+            query?: never;
+            header?: {
+                "X-Goog-Channel-ID"?: string;
+                "X-Goog-Resource-ID"?: string;
+                "X-Goog-Resource-State"?: string;
+                "X-Goog-Resource-URI"?: string;
+                "X-Goog-Message-Number"?: string;
+                "X-Goog-Channel-Token"?: string;
+            };
+            path: {
+                connection_id: string;
+            };
+            cookie?: never;
         };
-        UpdateStatedFactInputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateStatedFactInputBody.json
-             */
-            readonly $schema?: string;
-            /** @description The new answer; valid values depend on the key */
-            value: string;
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["WebhookOutputBody"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateStatedFactOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateStatedFactOutputBody.json
-             */
-            readonly $schema?: string;
-            key: string;
-            /** @example Preference updated */
-            message: string;
-            value: string;
+    };
+    "get-categories": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        UpdateTaskChecklistDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTaskChecklistDocument.json
-             */
-            readonly $schema?: string;
-            checklist: components["schemas"]["ChecklistItem"][];
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["CategoryDocument"][];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateTaskChecklistOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTaskChecklistOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Task checklist updated successfully */
-            message: string;
+    };
+    "get-category": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
+            cookie?: never;
         };
-        UpdateTaskDeadlineDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTaskDeadlineDocument.json
-             */
-            readonly $schema?: string;
-            /** Format: date-time */
-            deadline?: string;
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["CategoryDocument"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateTaskDeadlineOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTaskDeadlineOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Task deadline updated successfully */
-            message: string;
+    };
+    "get-health": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        UpdateTaskDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTaskDocument.json
-             */
-            readonly $schema?: string;
-            active?: boolean;
-            blueprintId?: string;
-            checklist?: components["schemas"]["ChecklistItem"][];
-            content: string;
-            /** Format: date-time */
-            deadline?: string;
-            generateTemplate?: boolean;
-            integration?: string;
-            notes?: string;
-            /** Format: int64 */
-            priority: number;
-            public: boolean;
-            recurDetails: components["schemas"]["RecurDetails"];
-            recurFrequency?: string;
-            recurType?: string;
-            recurring: boolean;
-            reminders?: components["schemas"]["Reminder"][];
-            sessionTrackable?: boolean;
-            /** Format: date-time */
-            startDate?: string;
-            /** Format: date-time */
-            startTime?: string;
-            templateID?: string;
-            /** Format: double */
-            value: number;
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["HealthOutputBody"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateTaskNotesDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTaskNotesDocument.json
-             */
-            readonly $schema?: string;
-            notes: string;
+    };
+    "get-profiles": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        UpdateTaskNotesOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTaskNotesOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Task notes updated successfully */
-            message: string;
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ProfileDocument"][];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateTaskOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTaskOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Task updated successfully */
-            message: string;
+    };
+    "delete-profile": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
+            cookie?: never;
         };
-        UpdateTaskReminderDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTaskReminderDocument.json
-             */
-            readonly $schema?: string;
-            reminders?: components["schemas"]["Reminder"][];
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["DeleteProfileOutputBody"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateTaskReminderOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTaskReminderOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Task reminders updated successfully */
-            message: string;
+    };
+    "update-profile": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
+            cookie?: never;
         };
-        UpdateTaskStartDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTaskStartDocument.json
-             */
-            readonly $schema?: string;
-            /** Format: date-time */
-            startDate?: string;
-            /** Format: date-time */
-            startTime?: string;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["UpdateProfileDocument"];
+            };
         };
-        UpdateTaskStartOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTaskStartOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Task start date/time updated successfully */
-            message: string;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["UpdateProfileOutputBody"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateTaskTagsInputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTaskTagsInputBody.json
-             */
-            readonly $schema?: string;
-            taggedUserIds: string[];
+    };
+    "get-profile-by-email": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @example user@example.com */
+                email: string;
+            };
+            cookie?: never;
         };
-        UpdateTaskTagsOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTaskTagsOutputBody.json
-             */
-            readonly $schema?: string;
-            taggedUsers: components["schemas"]["TaggedTaskUser"][];
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ProfileDocument"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateTemplateDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTemplateDocument.json
-             */
-            readonly $schema?: string;
-            checklist?: components["schemas"]["ChecklistItem"][];
-            content?: string;
-            /** Format: date-time */
-            deadline?: string;
-            notes?: string;
-            /** Format: int64 */
-            priority?: number;
-            public?: boolean;
-            recurDetails?: components["schemas"]["RecurDetails"];
-            recurFrequency?: string;
-            recurType?: string;
-            reminders?: components["schemas"]["Reminder"][];
-            /** Format: date-time */
-            startDate?: string;
-            /** Format: date-time */
-            startTime?: string;
-            /** Format: double */
-            value?: number;
+    };
+    "get-profile-by-phone": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @example +1234567890 */
+                phone: string;
+            };
+            cookie?: never;
         };
-        UpdateTemplateOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTemplateOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Template task updated successfully */
-            message: string;
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ProfileDocument"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateTimezoneInputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTimezoneInputBody.json
-             */
-            readonly $schema?: string;
-            /**
-             * @description The IANA timezone identifier
-             * @example America/New_York
-             */
-            timezone: string;
+    };
+    "get-suggested-users": {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
+            path?: never;
+            cookie?: never;
         };
-        UpdateTimezoneOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateTimezoneOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Timezone updated successfully */
-            message: string;
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["UserExtendedReference"][];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateUserSettingsOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateUserSettingsOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Settings updated successfully */
-            message: string;
+    };
+    "get-available-features": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["GetAvailableFeaturesOutputBody"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UpdateWorkspaceOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateWorkspaceOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Workspace updated successfully */
-            message: string;
+    };
+    "get-tasks": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        UpdateWorkspaceParams: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UpdateWorkspaceParams.json
-             */
-            readonly $schema?: string;
-            color?: string;
-            icon?: string;
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["TaskDocument"][];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UserCredits: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UserCredits.json
-             */
-            readonly $schema?: string;
-            /**
-             * Format: int64
-             * @description Analytics access credits
-             */
-            analytics: number;
-            /**
-             * Format: int64
-             * @description Blueprint creation credits
-             */
-            blueprint: number;
-            /**
-             * Format: int64
-             * @description Group creation credits
-             */
-            group: number;
-            /**
-             * Format: int64
-             * @description Natural language task creation credits
-             */
-            naturalLanguage: number;
-            /**
-             * Format: int64
-             * @description Voice task creation credits
-             */
-            voice: number;
+    };
+    "get-task": {
+        parameters: {
+            query?: never;
+            header: {
+                Authorization: string;
+            };
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
+            cookie?: never;
         };
-        UserExtendedReference: {
-            /**
-             * @description User ID
-             * @example 507f1f77bcf86cd799439011
-             */
-            _id: string;
-            /**
-             * @description User display name
-             * @example John Doe
-             */
-            display_name: string;
-            /**
-             * @description User handle
-             * @example johndoe
-             */
-            handle: string;
-            /**
-             * @description Profile picture URL
-             * @example https://example.com/avatar.jpg
-             */
-            profile_picture: string;
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["TaskDocument"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UserExtendedReferenceWithPhone: {
-            /**
-             * @description User ID
-             * @example 507f1f77bcf86cd799439011
-             */
-            _id: string;
-            /**
-             * @description User display name
-             * @example John Doe
-             */
-            display_name: string;
-            /**
-             * @description User handle
-             * @example johndoe
-             */
-            handle: string;
-            /**
-             * @description User phone number
-             * @example +1234567890
-             */
-            phone: string;
-            /**
-             * @description Profile picture URL
-             * @example https://example.com/avatar.jpg
-             */
-            profile_picture: string;
+    };
+    "get-active-tasks": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
+            cookie?: never;
         };
-        UserReference: {
-            display_name: string;
-            handle: string;
-            id: string;
-            profile_picture: string;
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["TaskDocument"][];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        UserSettings: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/UserSettings.json
-             */
-            readonly $schema?: string;
-            dashboard_configuration: components["schemas"]["DashboardConfiguration"];
-            display: components["schemas"]["DisplaySettings"];
-            notifications: components["schemas"]["NotificationSettings"];
-            personalization?: components["schemas"]["PersonalizationSettings"];
+    };
+    "confirm-image-upload": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @example profile */
+                resource_type: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                resource_id: string;
+            };
+            cookie?: never;
         };
-        VerifyOTPOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/VerifyOTPOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example OTP verified successfully */
-            message: string;
-            status?: string;
-            valid: boolean;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["ConfirmImageUploadInputBody"];
+            };
         };
-        VerifyOTPRequest: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/VerifyOTPRequest.json
-             */
-            readonly $schema?: string;
-            code: string;
-            phone_number: string;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ConfirmImageUploadOutputBody"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        WaitlistDocument: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/WaitlistDocument.json
-             */
-            readonly $schema?: string;
-            /**
-             * @description User email address
-             * @example user@example.com
-             */
-            email: string;
-            /**
-             * @description Unique waitlist entry identifier
-             * @example 507f1f77bcf86cd799439011
-             */
-            id: string;
-            /**
-             * @description User full name
-             * @example John Doe
-             */
-            name: string;
-            /**
-             * Format: date-time
-             * @description Entry creation timestamp
-             * @example 2023-01-01T00:00:00Z
-             */
-            timestamp: string;
+    };
+    "process-and-upload-image": {
+        parameters: {
+            query?: {
+                /** @example medium */
+                variant?: string;
+            };
+            header?: never;
+            path: {
+                /** @example profile */
+                resource_type: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                resource_id: string;
+            };
+            cookie?: never;
         };
-        WatchChannel: {
-            calendar_id: string;
-            channel_id: string;
-            /** Format: date-time */
-            created_at: string;
-            /** Format: date-time */
-            expiration: string;
-            resource_id: string;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["ProcessAndUploadImageInputBody"];
+            };
         };
-        WebhookEventBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/WebhookEventBody.json
-             */
-            readonly $schema?: string;
-            api_version: string;
-            event: components["schemas"]["RevenueCatEvent"];
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ProcessAndUploadImageOutputBody"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        WebhookOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/WebhookOutputBody.json
-             */
-            readonly $schema?: string;
-            success: boolean;
+    };
+    "generate-image-upload-url": {
+        parameters: {
+            query: {
+                /** @example image/jpeg */
+                file_type: string;
+            };
+            header?: never;
+            path: {
+                /** @example profile */
+                resource_type: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                resource_id: string;
+            };
+            cookie?: never;
         };
-        WelcomeOutputBody: {
-            /**
-             * Format: uri
-             * @description A URL to the JSON Schema for this object.
-             * @example https://example.com/schemas/WelcomeOutputBody.json
-             */
-            readonly $schema?: string;
-            /** @example Welcome to Kindred! */
-            message: string;
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["GenerateImageUploadURLOutputBody"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
-        WorkspaceResult: {
-            categories: components["schemas"]["CategoryDocument"][];
-            color?: string;
-            icon?: string;
-            name: string;
+    };
+    "auth-test": {
+        parameters: {
+            query?: never;
+            header: {
+                Authorization: string;
+            };
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["TestOutputBody"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
         };
     };
-    responses: never;
-    parameters: never;
-    requestBodies: never;
-    headers: never;
-    pathItems: never;
-}
-export type $defs = Record<string, never>;
-export interface operations {
-    "get-activities": {
+    "auth-test-slash": {
         parameters: {
             query?: never;
-            header?: never;
+            header: {
+                Authorization: string;
+            };
             path?: never;
             cookie?: never;
         };
@@ -8758,7 +11412,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ActivityDocument"][];
+                    "application/json": components["schemas"]["TestOutputBody"];
                 };
             };
             /** @description Error */
@@ -8772,16 +11426,20 @@ export interface operations {
             };
         };
     };
-    "get-activity": {
+    "get-user-posts": {
         parameters: {
             query?: {
-                /** @example 2024 */
-                year?: number;
+                /** @description Number of posts to return (default: 18) */
+                limit?: number;
+                /** @description Number of posts to skip (default: 0) */
+                offset?: number;
+            };
+            header: {
+                Authorization: string;
             };
-            header?: never;
             path: {
                 /** @example 507f1f77bcf86cd799439011 */
-                id: string;
+                userId: string;
             };
             cookie?: never;
         };
@@ -8793,7 +11451,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ActivityDocument"];
+                    "application/json": components["schemas"]["GetUserPostsOutputBody"];
                 };
             };
             /** @description Error */
@@ -8807,19 +11465,50 @@ export interface operations {
             };
         };
     };
-    "get-activity-by-user-and-period": {
+    "accept-terms": {
         parameters: {
-            query?: {
-                /** @example 2024 */
-                year?: number;
-                /** @example 12 */
-                month?: number;
+            query?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
             };
-            header?: never;
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                userID: string;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["AcceptTermsRequest"];
+            };
+        };
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["AcceptTermsOutputBody"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    "delete-account": {
+        parameters: {
+            query?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
             };
+            path?: never;
             cookie?: never;
         };
         requestBody?: never;
@@ -8830,7 +11519,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ActivityDocument"];
+                    "application/json": components["schemas"]["DeleteAccountOutputBody"];
                 };
             };
             /** @description Error */
@@ -8844,14 +11533,18 @@ export interface operations {
             };
         };
     };
-    "get-recent-activity": {
+    "get-analytics": {
         parameters: {
-            query?: never;
-            header?: never;
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                userID: string;
+            query?: {
+                /** @description Time range: week, month, or sixmonth */
+                range?: "week" | "month" | "sixmonth";
+                /** @description Workspace name filter (optional; empty = all workspaces) */
+                workspace?: string;
+                /** @description Category ID filter (optional; empty = all categories) */
+                category?: string;
             };
+            header?: never;
+            path?: never;
             cookie?: never;
         };
         requestBody?: never;
@@ -8862,7 +11555,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ActivityDocument"][];
+                    "application/json": components["schemas"]["AnalyticsResponse"];
                 };
             };
             /** @description Error */
@@ -8876,17 +11569,11 @@ export interface operations {
             };
         };
     };
-    "get-activity-by-user-and-year": {
+    "get-analytics-layout": {
         parameters: {
-            query?: {
-                /** @example 2024 */
-                year?: number;
-            };
+            query?: never;
             header?: never;
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                userID: string;
-            };
+            path?: never;
             cookie?: never;
         };
         requestBody?: never;
@@ -8897,7 +11584,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ActivityDocument"][];
+                    "application/json": components["schemas"]["AnalyticsLayout"];
                 };
             };
             /** @description Error */
@@ -8911,33 +11598,18 @@ export interface operations {
             };
         };
     };
-    "get-reports": {
+    "update-analytics-layout": {
         parameters: {
-            query?: {
-                /**
-                 * @description Filter by status (pending, reviewed, resolved)
-                 * @example pending
-                 */
-                status?: string;
-                /**
-                 * @description Number of reports to return
-                 * @example 50
-                 */
-                limit?: number;
-                /**
-                 * @description Offset for pagination
-                 * @example 0
-                 */
-                offset?: number;
-            };
-            header: {
-                /** @description Bearer token for authentication */
-                Authorization: string;
-            };
+            query?: never;
+            header?: never;
             path?: never;
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["AnalyticsLayout"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -8945,7 +11617,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["GetReportsOutputBody"];
+                    "application/json": components["schemas"]["AnalyticsLayout"];
                 };
             };
             /** @description Error */
@@ -8959,17 +11631,23 @@ export interface operations {
             };
         };
     };
-    "get-presigned-url": {
+    "create-blueprint": {
         parameters: {
             query?: never;
-            header?: never;
-            path: {
-                /** @example profile-pictures/user123.jpg */
-                key: string;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
+            path?: never;
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["CreateBlueprintParams"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -8977,7 +11655,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": unknown;
+                    "application/json": components["schemas"]["BlueprintDocument"];
                 };
             };
             /** @description Error */
@@ -8991,14 +11669,19 @@ export interface operations {
             };
         };
     };
-    "create-presigned-url": {
+    "delete-blueprint": {
         parameters: {
-            query: {
-                /** @example image/jpeg */
-                fileType: string;
+            query?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
             };
-            header?: never;
-            path?: never;
             cookie?: never;
         };
         requestBody?: never;
@@ -9009,7 +11692,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": unknown;
+                    "application/json": components["schemas"]["DeleteBlueprintOutputBody"];
                 };
             };
             /** @description Error */
@@ -9023,28 +11706,34 @@ export interface operations {
             };
         };
     };
-    login: {
+    "update-blueprint": {
         parameters: {
             query?: never;
-            header?: never;
-            path?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["LoginRequest"];
+                "application/json": components["schemas"]["UpdateBlueprintDocument"];
             };
         };
         responses: {
             /** @description OK */
             200: {
                 headers: {
-                    access_token?: string;
-                    refresh_token?: string;
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SafeUser"];
+                    "application/json": components["schemas"]["UpdateBlueprintOutputBody"];
                 };
             };
             /** @description Error */
@@ -9058,28 +11747,30 @@ export interface operations {
             };
         };
     };
-    "login-apple": {
+    "subscribe-to-blueprint": {
         parameters: {
             query?: never;
-            header?: never;
-            path?: never;
-            cookie?: never;
-        };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["LoginRequestApple"];
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
+            cookie?: never;
         };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
                 headers: {
-                    access_token?: string;
-                    refresh_token?: string;
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SafeUser"];
+                    "application/json": components["schemas"]["SubscribeToBlueprintOutputBody"];
                 };
             };
             /** @description Error */
@@ -9093,28 +11784,30 @@ export interface operations {
             };
         };
     };
-    "login-google": {
+    "unsubscribe-from-blueprint": {
         parameters: {
             query?: never;
-            header?: never;
-            path?: never;
-            cookie?: never;
-        };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["LoginRequestGoogle"];
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
+            cookie?: never;
         };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
                 headers: {
-                    access_token?: string;
-                    refresh_token?: string;
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SafeUser"];
+                    "application/json": components["schemas"]["UnsubscribeFromBlueprintOutputBody"];
                 };
             };
             /** @description Error */
@@ -9128,28 +11821,28 @@ export interface operations {
             };
         };
     };
-    "login-otp": {
+    "get-blueprints-by-creator": {
         parameters: {
             query?: never;
-            header?: never;
-            path?: never;
-            cookie?: never;
-        };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["LoginWithOTPRequest"];
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+            };
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                creatorId: string;
             };
+            cookie?: never;
         };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
                 headers: {
-                    access_token?: string;
-                    refresh_token?: string;
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SafeUser"];
+                    "application/json": components["schemas"]["BlueprintDocumentWithoutSubscribers"][];
                 };
             };
             /** @description Error */
@@ -9163,28 +11856,31 @@ export interface operations {
             };
         };
     };
-    "login-phone": {
+    "generate-and-create-blueprint": {
         parameters: {
             query?: never;
-            header?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
             path?: never;
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["LoginRequestPhone"];
+                "application/json": components["schemas"]["GenerateAndCreateBlueprintParams"];
             };
         };
         responses: {
             /** @description OK */
             200: {
                 headers: {
-                    access_token?: string;
-                    refresh_token?: string;
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SafeUser"];
+                    "application/json": components["schemas"]["BlueprintDocument"];
                 };
             };
             /** @description Error */
@@ -9198,11 +11894,14 @@ export interface operations {
             };
         };
     };
-    logout: {
+    "get-user-subscribed-blueprints": {
         parameters: {
             query?: never;
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
             path?: never;
             cookie?: never;
@@ -9215,7 +11914,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["LogoutOutputBody"];
+                    "application/json": components["schemas"]["BlueprintDocumentWithoutSubscribers"][];
                 };
             };
             /** @description Error */
@@ -9229,13 +11928,10 @@ export interface operations {
             };
         };
     };
-    "refresh-token": {
+    "connect-google-calendar": {
         parameters: {
             query?: never;
-            header: {
-                /** @description Refresh token to exchange for new tokens */
-                refresh_token: string;
-            };
+            header?: never;
             path?: never;
             cookie?: never;
         };
@@ -9244,12 +11940,10 @@ export interface operations {
             /** @description OK */
             200: {
                 headers: {
-                    access_token?: string;
-                    refresh_token?: string;
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["RefreshTokenOutputBody"];
+                    "application/json": components["schemas"]["ConnectGoogleOutputBody"];
                 };
             };
             /** @description Error */
@@ -9263,28 +11957,22 @@ export interface operations {
             };
         };
     };
-    register: {
+    "get-calendar-connections": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["RegisterRequest"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
                 headers: {
-                    access_token?: string;
-                    refresh_token?: string;
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SafeUser"];
+                    "application/json": components["schemas"]["GetConnectionsOutputBody"];
                 };
             };
             /** @description Error */
@@ -9297,29 +11985,25 @@ export interface operations {
                 };
             };
         };
-    };
-    "register-apple": {
-        parameters: {
-            query?: never;
-            header?: never;
-            path?: never;
-            cookie?: never;
-        };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["RegisterRequestApple"];
+    };
+    "disconnect-calendar": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                connectionId: string;
             };
+            cookie?: never;
         };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
                 headers: {
-                    access_token?: string;
-                    refresh_token?: string;
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SafeUser"];
+                    "application/json": components["schemas"]["DisconnectOutputBody"];
                 };
             };
             /** @description Error */
@@ -9333,28 +12017,24 @@ export interface operations {
             };
         };
     };
-    "register-google": {
+    "list-connection-calendars": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
-            cookie?: never;
-        };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["RegisterRequestGoogle"];
+            path: {
+                connectionId: string;
             };
+            cookie?: never;
         };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
                 headers: {
-                    access_token?: string;
-                    refresh_token?: string;
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SafeUser"];
+                    "application/json": components["schemas"]["ListCalendarsOutputBody"];
                 };
             };
             /** @description Error */
@@ -9368,18 +12048,21 @@ export interface operations {
             };
         };
     };
-    "send-otp": {
+    "get-calendar-events": {
         parameters: {
-            query?: never;
+            query?: {
+                /** @description Start date in RFC3339 format (defaults to 2 days ago) */
+                start?: string;
+                /** @description End date in RFC3339 format (defaults to 2 days from now) */
+                end?: string;
+            };
             header?: never;
-            path?: never;
-            cookie?: never;
-        };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["SendOTPRequest"];
+            path: {
+                connectionId: string;
             };
+            cookie?: never;
         };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -9387,7 +12070,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SendOTPOutputBody"];
+                    "application/json": components["schemas"]["GetEventsOutputBody"];
                 };
             };
             /** @description Error */
@@ -9401,16 +12084,18 @@ export interface operations {
             };
         };
     };
-    "verify-otp": {
+    "setup-calendar-workspaces": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                connectionId: string;
+            };
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["VerifyOTPRequest"];
+                "application/json": components["schemas"]["SetupWorkspacesInputBody"];
             };
         };
         responses: {
@@ -9420,7 +12105,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["VerifyOTPOutputBody"];
+                    "application/json": components["schemas"]["SetupWorkspacesOutputBody"];
                 };
             };
             /** @description Error */
@@ -9434,11 +12119,18 @@ export interface operations {
             };
         };
     };
-    "get-blueprints": {
+    "sync-calendar-events": {
         parameters: {
-            query?: never;
+            query?: {
+                /** @description Start date in RFC3339 format (defaults to 2 days ago) */
+                start?: string;
+                /** @description End date in RFC3339 format (defaults to 2 days from now) */
+                end?: string;
+            };
             header?: never;
-            path?: never;
+            path: {
+                connectionId: string;
+            };
             cookie?: never;
         };
         requestBody?: never;
@@ -9449,7 +12141,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["BlueprintDocument"][];
+                    "application/json": components["schemas"]["SyncEventsOutputBody"];
                 };
             };
             /** @description Error */
@@ -9463,17 +12155,20 @@ export interface operations {
             };
         };
     };
-    "get-blueprint": {
+    "create-category": {
         parameters: {
             query?: never;
-            header?: never;
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
+            header: {
+                Authorization: string;
             };
+            path?: never;
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["CreateCategoryParams"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -9481,7 +12176,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["BlueprintDocument"];
+                    "application/json": components["schemas"]["CategoryDocument"];
                 };
             };
             /** @description Error */
@@ -9495,14 +12190,14 @@ export interface operations {
             };
         };
     };
-    "autocomplete-blueprints": {
+    "get-categories-by-user": {
         parameters: {
-            query?: {
-                /** @example morn */
-                query?: string;
-            };
+            query?: never;
             header?: never;
-            path?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
             cookie?: never;
         };
         requestBody?: never;
@@ -9513,7 +12208,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["BlueprintDocument"][];
+                    "application/json": components["schemas"]["WorkspaceResult"][];
                 };
             };
             /** @description Error */
@@ -9527,11 +12222,16 @@ export interface operations {
             };
         };
     };
-    "get-blueprints-by-category": {
+    "delete-category": {
         parameters: {
             query?: never;
-            header?: never;
-            path?: never;
+            header: {
+                Authorization: string;
+            };
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
             cookie?: never;
         };
         requestBody?: never;
@@ -9542,7 +12242,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["BlueprintCategoryGroup"][];
+                    "application/json": components["schemas"]["DeleteCategoryOutputBody"];
                 };
             };
             /** @description Error */
@@ -9556,17 +12256,23 @@ export interface operations {
             };
         };
     };
-    "search-blueprints": {
+    "update-category": {
         parameters: {
-            query?: {
-                /** @example morning routine */
-                query?: string;
+            query?: never;
+            header: {
+                Authorization: string;
+            };
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
             };
-            header?: never;
-            path?: never;
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["UpdateCategoryDocument"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -9574,7 +12280,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["BlueprintDocument"][];
+                    "application/json": components["schemas"]["UpdateCategoryOutputBody"];
                 };
             };
             /** @description Error */
@@ -9588,26 +12294,28 @@ export interface operations {
             };
         };
     };
-    "google-calendar-oauth-callback": {
+    "delete-workspace": {
         parameters: {
-            query: {
-                code: string;
-                state: string;
-                error?: string;
+            query?: never;
+            header: {
+                Authorization: string;
+            };
+            path: {
+                /** @example workspace1 */
+                name: string;
             };
-            header?: never;
-            path?: never;
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description No Content */
-            204: {
+            /** @description OK */
+            200: {
                 headers: {
-                    Location?: string;
                     [name: string]: unknown;
                 };
-                content?: never;
+                content: {
+                    "application/json": components["schemas"]["DeleteWorkspaceOutputBody"];
+                };
             };
             /** @description Error */
             default: {
@@ -9620,23 +12328,23 @@ export interface operations {
             };
         };
     };
-    "calendar-webhook": {
+    "rename-workspace": {
         parameters: {
             query?: never;
-            header?: {
-                "X-Goog-Channel-ID"?: string;
-                "X-Goog-Resource-ID"?: string;
-                "X-Goog-Resource-State"?: string;
-                "X-Goog-Resource-URI"?: string;
-                "X-Goog-Message-Number"?: string;
-                "X-Goog-Channel-Token"?: string;
+            header: {
+                Authorization: string;
             };
             path: {
-                connection_id: string;
+                /** @example old-workspace */
+                oldName: string;
             };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["RenameWorkspaceInputBody"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -9644,7 +12352,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["WebhookOutputBody"];
+                    "application/json": components["schemas"]["RenameWorkspaceOutputBody"];
                 };
             };
             /** @description Error */
@@ -9658,14 +12366,25 @@ export interface operations {
             };
         };
     };
-    "get-categories": {
+    "update-task-deadline": {
         parameters: {
             query?: never;
-            header?: never;
-            path?: never;
+            header: {
+                Authorization: string;
+            };
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                category: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["UpdateTaskDeadlineDocument"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -9673,7 +12392,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["CategoryDocument"][];
+                    "application/json": components["schemas"]["UpdateTaskDeadlineOutputBody"];
                 };
             };
             /** @description Error */
@@ -9687,17 +12406,25 @@ export interface operations {
             };
         };
     };
-    "get-category": {
+    "update-task-reminder": {
         parameters: {
             query?: never;
-            header?: never;
+            header: {
+                Authorization: string;
+            };
             path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                category: string;
                 /** @example 507f1f77bcf86cd799439011 */
                 id: string;
             };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["UpdateTaskReminderDocument"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -9705,7 +12432,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["CategoryDocument"];
+                    "application/json": components["schemas"]["UpdateTaskReminderOutputBody"];
                 };
             };
             /** @description Error */
@@ -9719,14 +12446,25 @@ export interface operations {
             };
         };
     };
-    "get-health": {
+    "update-task-start": {
         parameters: {
             query?: never;
-            header?: never;
-            path?: never;
+            header: {
+                Authorization: string;
+            };
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                category: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["UpdateTaskStartDocument"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -9734,7 +12472,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["HealthOutputBody"];
+                    "application/json": components["schemas"]["UpdateTaskStartOutputBody"];
                 };
             };
             /** @description Error */
@@ -9748,10 +12486,15 @@ export interface operations {
             };
         };
     };
-    "get-profiles": {
+    "get-congratulations": {
         parameters: {
             query?: never;
-            header?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
             path?: never;
             cookie?: never;
         };
@@ -9763,7 +12506,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ProfileDocument"][];
+                    "application/json": components["schemas"]["CongratulationDocument"][];
                 };
             };
             /** @description Error */
@@ -9777,17 +12520,23 @@ export interface operations {
             };
         };
     };
-    "delete-profile": {
+    "create-congratulation": {
         parameters: {
             query?: never;
-            header?: never;
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
+            path?: never;
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["CreateCongratulationParams"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -9795,7 +12544,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["DeleteProfileOutputBody"];
+                    "application/json": components["schemas"]["CreateCongratulationOutputBody"];
                 };
             };
             /** @description Error */
@@ -9809,21 +12558,22 @@ export interface operations {
             };
         };
     };
-    "update-profile": {
+    "get-congratulation": {
         parameters: {
             query?: never;
-            header?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
             path: {
                 /** @example 507f1f77bcf86cd799439011 */
                 id: string;
             };
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["UpdateProfileDocument"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -9831,7 +12581,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UpdateProfileOutputBody"];
+                    "application/json": components["schemas"]["CongratulationDocument"];
                 };
             };
             /** @description Error */
@@ -9845,13 +12595,18 @@ export interface operations {
             };
         };
     };
-    "get-profile-by-email": {
+    "delete-congratulation": {
         parameters: {
             query?: never;
-            header?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
             path: {
-                /** @example user@example.com */
-                email: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
             };
             cookie?: never;
         };
@@ -9863,7 +12618,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ProfileDocument"];
+                    "application/json": components["schemas"]["DeleteCongratulationOutputBody"];
                 };
             };
             /** @description Error */
@@ -9877,17 +12632,26 @@ export interface operations {
             };
         };
     };
-    "get-profile-by-phone": {
+    "update-congratulation": {
         parameters: {
             query?: never;
-            header?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
             path: {
-                /** @example +1234567890 */
-                phone: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
             };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["UpdateCongratulationDocument"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -9895,7 +12659,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ProfileDocument"];
+                    "application/json": components["schemas"]["UpdateCongratulationOutputBody"];
                 };
             };
             /** @description Error */
@@ -9909,7 +12673,7 @@ export interface operations {
             };
         };
     };
-    "get-suggested-users": {
+    "react-to-congratulation": {
         parameters: {
             query?: never;
             header: {
@@ -9918,10 +12682,17 @@ export interface operations {
                 /** @description Refresh token for authentication */
                 refresh_token: string;
             };
-            path?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["CongratulationReactionParams"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -9929,7 +12700,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UserExtendedReference"][];
+                    "application/json": components["schemas"]["ReactToCongratulationOutputBody"];
                 };
             };
             /** @description Error */
@@ -9943,14 +12714,23 @@ export interface operations {
             };
         };
     };
-    "get-available-features": {
+    "send-beak-congratulation": {
         parameters: {
             query?: never;
-            header?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
             path?: never;
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["SendBeakCongratulationParams"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -9958,7 +12738,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["GetAvailableFeaturesOutputBody"];
+                    "application/json": components["schemas"]["SendBeakCongratulationResult"];
                 };
             };
             /** @description Error */
@@ -9972,14 +12752,23 @@ export interface operations {
             };
         };
     };
-    "get-tasks": {
+    "mark-congratulations-read": {
         parameters: {
             query?: never;
-            header?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
             path?: never;
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["MarkCongratulationsReadParams"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -9987,7 +12776,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["TaskDocument"][];
+                    "application/json": components["schemas"]["MarkCongratulationsReadOutputBody"];
                 };
             };
             /** @description Error */
@@ -10001,16 +12790,16 @@ export interface operations {
             };
         };
     };
-    "get-task": {
+    "get-sent-congratulations": {
         parameters: {
             query?: never;
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
-            };
+            path?: never;
             cookie?: never;
         };
         requestBody?: never;
@@ -10021,7 +12810,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["TaskDocument"];
+                    "application/json": components["schemas"]["CongratulationDocument"][];
                 };
             };
             /** @description Error */
@@ -10035,14 +12824,16 @@ export interface operations {
             };
         };
     };
-    "get-active-tasks": {
+    "get-connections": {
         parameters: {
             query?: never;
-            header?: never;
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
+            path?: never;
             cookie?: never;
         };
         requestBody?: never;
@@ -10053,7 +12844,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["TaskDocument"][];
+                    "application/json": components["schemas"]["ConnectionDocument"][];
                 };
             };
             /** @description Error */
@@ -10067,21 +12858,21 @@ export interface operations {
             };
         };
     };
-    "confirm-image-upload": {
+    "create-connection": {
         parameters: {
             query?: never;
-            header?: never;
-            path: {
-                /** @example profile */
-                resource_type: string;
-                /** @example 507f1f77bcf86cd799439011 */
-                resource_id: string;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
+            path?: never;
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["ConfirmImageUploadInputBody"];
+                "application/json": components["schemas"]["CreateConnectionParams"];
             };
         };
         responses: {
@@ -10091,7 +12882,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ConfirmImageUploadOutputBody"];
+                    "application/json": components["schemas"]["ConnectionDocument"];
                 };
             };
             /** @description Error */
@@ -10105,26 +12896,22 @@ export interface operations {
             };
         };
     };
-    "process-and-upload-image": {
+    "get-connection": {
         parameters: {
-            query?: {
-                /** @example medium */
-                variant?: string;
+            query?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
-            header?: never;
             path: {
-                /** @example profile */
-                resource_type: string;
                 /** @example 507f1f77bcf86cd799439011 */
-                resource_id: string;
+                id: string;
             };
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["ProcessAndUploadImageInputBody"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -10132,7 +12919,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ProcessAndUploadImageOutputBody"];
+                    "application/json": components["schemas"]["ConnectionDocument"];
                 };
             };
             /** @description Error */
@@ -10146,18 +12933,18 @@ export interface operations {
             };
         };
     };
-    "generate-image-upload-url": {
+    "delete-connection": {
         parameters: {
-            query: {
-                /** @example image/jpeg */
-                file_type: string;
+            query?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
-            header?: never;
             path: {
-                /** @example profile */
-                resource_type: string;
                 /** @example 507f1f77bcf86cd799439011 */
-                resource_id: string;
+                id: string;
             };
             cookie?: never;
         };
@@ -10169,7 +12956,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["GenerateImageUploadURLOutputBody"];
+                    "application/json": components["schemas"]["DeleteConnectionOutputBody"];
                 };
             };
             /** @description Error */
@@ -10183,16 +12970,26 @@ export interface operations {
             };
         };
     };
-    "auth-test": {
+    "update-connection": {
         parameters: {
             query?: never;
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
             };
-            path?: never;
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["UpdateConnectionDocument"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -10200,7 +12997,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["TestOutputBody"];
+                    "application/json": components["schemas"]["UpdateConnectionOutputBody"];
                 };
             };
             /** @description Error */
@@ -10214,13 +13011,19 @@ export interface operations {
             };
         };
     };
-    "auth-test-slash": {
+    "accept-connection": {
         parameters: {
             query?: never;
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
             };
-            path?: never;
             cookie?: never;
         };
         requestBody?: never;
@@ -10231,7 +13034,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["TestOutputBody"];
+                    "application/json": components["schemas"]["AcceptConnectionOutputBody"];
                 };
             };
             /** @description Error */
@@ -10245,19 +13048,20 @@ export interface operations {
             };
         };
     };
-    "get-user-posts": {
+    "block-user": {
         parameters: {
-            query?: {
-                /** @description Number of posts to return (default: 18) */
-                limit?: number;
-                /** @description Number of posts to skip (default: 0) */
-                offset?: number;
-            };
+            query?: never;
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
             path: {
-                /** @example 507f1f77bcf86cd799439011 */
+                /**
+                 * @description User ID to block
+                 * @example 507f1f77bcf86cd799439011
+                 */
                 userId: string;
             };
             cookie?: never;
@@ -10270,7 +13074,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["GetUserPostsOutputBody"];
+                    "application/json": components["schemas"]["BlockUserOutputBody"];
                 };
             };
             /** @description Error */
@@ -10284,21 +13088,25 @@ export interface operations {
             };
         };
     };
-    "accept-terms": {
+    "unblock-user": {
         parameters: {
             query?: never;
             header: {
                 /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
-            path?: never;
-            cookie?: never;
-        };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["AcceptTermsRequest"];
+            path: {
+                /**
+                 * @description User ID to unblock
+                 * @example 507f1f77bcf86cd799439011
+                 */
+                userId: string;
             };
+            cookie?: never;
         };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -10306,7 +13114,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["AcceptTermsOutputBody"];
+                    "application/json": components["schemas"]["UnblockUserOutputBody"];
                 };
             };
             /** @description Error */
@@ -10320,12 +13128,14 @@ export interface operations {
             };
         };
     };
-    "delete-account": {
+    "get-blocked-users": {
         parameters: {
             query?: never;
             header: {
                 /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
             path?: never;
             cookie?: never;
@@ -10338,7 +13148,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["DeleteAccountOutputBody"];
+                    "application/json": components["schemas"]["ConnectionUser"][];
                 };
             };
             /** @description Error */
@@ -10352,18 +13162,22 @@ export interface operations {
             };
         };
     };
-    "get-analytics": {
+    "mute-user": {
         parameters: {
-            query?: {
-                /** @description Time range: week, month, or sixmonth */
-                range?: "week" | "month" | "sixmonth";
-                /** @description Workspace name filter (optional; empty = all workspaces) */
-                workspace?: string;
-                /** @description Category ID filter (optional; empty = all categories) */
-                category?: string;
+            query?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
+            path: {
+                /**
+                 * @description User ID to apply the setting to
+                 * @example 507f1f77bcf86cd799439011
+                 */
+                userId: string;
             };
-            header?: never;
-            path?: never;
             cookie?: never;
         };
         requestBody?: never;
@@ -10374,7 +13188,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["AnalyticsResponse"];
+                    "application/json": components["schemas"]["ControlUserOutputBody"];
                 };
             };
             /** @description Error */
@@ -10388,11 +13202,22 @@ export interface operations {
             };
         };
     };
-    "get-analytics-layout": {
+    "unmute-user": {
         parameters: {
             query?: never;
-            header?: never;
-            path?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
+            path: {
+                /**
+                 * @description User ID to apply the setting to
+                 * @example 507f1f77bcf86cd799439011
+                 */
+                userId: string;
+            };
             cookie?: never;
         };
         requestBody?: never;
@@ -10403,7 +13228,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["AnalyticsLayout"];
+                    "application/json": components["schemas"]["ControlUserOutputBody"];
                 };
             };
             /** @description Error */
@@ -10417,18 +13242,19 @@ export interface operations {
             };
         };
     };
-    "update-analytics-layout": {
+    "get-muted-users": {
         parameters: {
             query?: never;
-            header?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
             path?: never;
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["AnalyticsLayout"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -10436,7 +13262,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["AnalyticsLayout"];
+                    "application/json": components["schemas"]["ConnectionUser"][];
                 };
             };
             /** @description Error */
@@ -10450,7 +13276,7 @@ export interface operations {
             };
         };
     };
-    "create-blueprint": {
+    "restrict-user": {
         parameters: {
             query?: never;
             header: {
@@ -10459,14 +13285,16 @@ export interface operations {
                 /** @description Refresh token for authentication */
                 refresh_token: string;
             };
-            path?: never;
-            cookie?: never;
-        };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["CreateBlueprintParams"];
+            path: {
+                /**
+                 * @description User ID to apply the setting to
+                 * @example 507f1f77bcf86cd799439011
+                 */
+                userId: string;
             };
+            cookie?: never;
         };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -10474,7 +13302,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["BlueprintDocument"];
+                    "application/json": components["schemas"]["ControlUserOutputBody"];
                 };
             };
             /** @description Error */
@@ -10488,7 +13316,7 @@ export interface operations {
             };
         };
     };
-    "delete-blueprint": {
+    "unrestrict-user": {
         parameters: {
             query?: never;
             header: {
@@ -10498,8 +13326,11 @@ export interface operations {
                 refresh_token: string;
             };
             path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
+                /**
+                 * @description User ID to apply the setting to
+                 * @example 507f1f77bcf86cd799439011
+                 */
+                userId: string;
             };
             cookie?: never;
         };
@@ -10511,7 +13342,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["DeleteBlueprintOutputBody"];
+                    "application/json": components["schemas"]["ControlUserOutputBody"];
                 };
             };
             /** @description Error */
@@ -10525,7 +13356,7 @@ export interface operations {
             };
         };
     };
-    "update-blueprint": {
+    "get-restricted-users": {
         parameters: {
             query?: never;
             header: {
@@ -10534,17 +13365,10 @@ export interface operations {
                 /** @description Refresh token for authentication */
                 refresh_token: string;
             };
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
-            };
+            path?: never;
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["UpdateBlueprintDocument"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -10552,7 +13376,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UpdateBlueprintOutputBody"];
+                    "application/json": components["schemas"]["ConnectionUser"][];
                 };
             };
             /** @description Error */
@@ -10566,7 +13390,7 @@ export interface operations {
             };
         };
     };
-    "subscribe-to-blueprint": {
+    "hide-activity-from-user": {
         parameters: {
             query?: never;
             header: {
@@ -10576,8 +13400,11 @@ export interface operations {
                 refresh_token: string;
             };
             path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
+                /**
+                 * @description User ID to apply the setting to
+                 * @example 507f1f77bcf86cd799439011
+                 */
+                userId: string;
             };
             cookie?: never;
         };
@@ -10589,7 +13416,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SubscribeToBlueprintOutputBody"];
+                    "application/json": components["schemas"]["ControlUserOutputBody"];
                 };
             };
             /** @description Error */
@@ -10603,7 +13430,7 @@ export interface operations {
             };
         };
     };
-    "unsubscribe-from-blueprint": {
+    "unhide-activity-from-user": {
         parameters: {
             query?: never;
             header: {
@@ -10613,8 +13440,11 @@ export interface operations {
                 refresh_token: string;
             };
             path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
+                /**
+                 * @description User ID to apply the setting to
+                 * @example 507f1f77bcf86cd799439011
+                 */
+                userId: string;
             };
             cookie?: never;
         };
@@ -10626,7 +13456,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UnsubscribeFromBlueprintOutputBody"];
+                    "application/json": components["schemas"]["ControlUserOutputBody"];
                 };
             };
             /** @description Error */
@@ -10640,17 +13470,16 @@ export interface operations {
             };
         };
     };
-    "get-blueprints-by-creator": {
+    "get-hidden-from-users": {
         parameters: {
             query?: never;
             header: {
                 /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                creatorId: string;
-            };
+            path?: never;
             cookie?: never;
         };
         requestBody?: never;
@@ -10661,7 +13490,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["BlueprintDocumentWithoutSubscribers"][];
+                    "application/json": components["schemas"]["ConnectionUser"][];
                 };
             };
             /** @description Error */
@@ -10675,7 +13504,7 @@ export interface operations {
             };
         };
     };
-    "generate-and-create-blueprint": {
+    "get-close-friends": {
         parameters: {
             query?: never;
             header: {
@@ -10687,11 +13516,7 @@ export interface operations {
             path?: never;
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["GenerateAndCreateBlueprintParams"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -10699,7 +13524,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["BlueprintDocument"];
+                    "application/json": components["schemas"]["ConnectionUser"][];
                 };
             };
             /** @description Error */
@@ -10713,7 +13538,7 @@ export interface operations {
             };
         };
     };
-    "get-user-subscribed-blueprints": {
+    "update-close-friends": {
         parameters: {
             query?: never;
             header: {
@@ -10725,7 +13550,11 @@ export interface operations {
             path?: never;
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["UpdateCloseFriendsInputBody"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -10733,7 +13562,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["BlueprintDocumentWithoutSubscribers"][];
+                    "application/json": components["schemas"]["ConnectionUser"][];
                 };
             };
             /** @description Error */
@@ -10747,10 +13576,15 @@ export interface operations {
             };
         };
     };
-    "connect-google-calendar": {
+    "get-friend-lists": {
         parameters: {
             query?: never;
-            header?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
             path?: never;
             cookie?: never;
         };
@@ -10762,7 +13596,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ConnectGoogleOutputBody"];
+                    "application/json": components["schemas"]["FriendListDocument"][];
                 };
             };
             /** @description Error */
@@ -10776,14 +13610,23 @@ export interface operations {
             };
         };
     };
-    "get-calendar-connections": {
+    "create-friend-list": {
         parameters: {
             query?: never;
-            header?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
             path?: never;
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["CreateFriendListParams"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -10791,7 +13634,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["GetConnectionsOutputBody"];
+                    "application/json": components["schemas"]["FriendListDocument"];
                 };
             };
             /** @description Error */
@@ -10805,16 +13648,29 @@ export interface operations {
             };
         };
     };
-    "disconnect-calendar": {
+    "update-friend-list": {
         parameters: {
             query?: never;
-            header?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
             path: {
-                connectionId: string;
+                /**
+                 * @description List ID, or close_friends for the built-in list
+                 * @example close_friends
+                 */
+                listId: string;
             };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["UpdateFriendListParams"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -10822,7 +13678,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["DisconnectOutputBody"];
+                    "application/json": components["schemas"]["FriendListDocument"];
                 };
             };
             /** @description Error */
@@ -10836,12 +13692,21 @@ export interface operations {
             };
         };
     };
-    "list-connection-calendars": {
+    "delete-friend-list": {
         parameters: {
             query?: never;
-            header?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
             path: {
-                connectionId: string;
+                /**
+                 * @description List ID
+                 * @example 507f1f77bcf86cd799439011
+                 */
+                listId: string;
             };
             cookie?: never;
         };
@@ -10853,7 +13718,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ListCalendarsOutputBody"];
+                    "application/json": components["schemas"]["DeleteFriendListOutputBody"];
                 };
             };
             /** @description Error */
@@ -10867,18 +13732,16 @@ export interface operations {
             };
         };
     };
-    "get-calendar-events": {
+    "get-friends": {
         parameters: {
-            query?: {
-                /** @description Start date in RFC3339 format (defaults to 2 days ago) */
-                start?: string;
-                /** @description End date in RFC3339 format (defaults to 2 days from now) */
-                end?: string;
-            };
-            header?: never;
-            path: {
-                connectionId: string;
+            query?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
+            path?: never;
             cookie?: never;
         };
         requestBody?: never;
@@ -10889,7 +13752,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["GetEventsOutputBody"];
+                    "application/json": components["schemas"]["FriendReference"][];
                 };
             };
             /** @description Error */
@@ -10903,20 +13766,25 @@ export interface operations {
             };
         };
     };
-    "setup-calendar-workspaces": {
+    "get-friends-by-user": {
         parameters: {
             query?: never;
-            header?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
+            };
             path: {
-                connectionId: string;
+                /**
+                 * @description User ID whose friends should be returned
+                 * @example 507f1f77bcf86cd799439011
+                 */
+                userId: string;
             };
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["SetupWorkspacesInputBody"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -10924,7 +13792,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SetupWorkspacesOutputBody"];
+                    "application/json": components["schemas"]["FriendReference"][];
                 };
             };
             /** @description Error */
@@ -10938,18 +13806,16 @@ export interface operations {
             };
         };
     };
-    "sync-calendar-events": {
+    "get-connections-by-receiver": {
         parameters: {
-            query?: {
-                /** @description Start date in RFC3339 format (defaults to 2 days ago) */
-                start?: string;
-                /** @description End date in RFC3339 format (defaults to 2 days from now) */
-                end?: string;
-            };
-            header?: never;
-            path: {
-                connectionId: string;
+            query?: never;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
+            path?: never;
             cookie?: never;
         };
         requestBody?: never;
@@ -10960,7 +13826,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SyncEventsOutputBody"];
+                    "application/json": components["schemas"]["ConnectionDocument"][];
                 };
             };
             /** @description Error */
@@ -10974,20 +13840,22 @@ export interface operations {
             };
         };
     };
-    "create-category": {
+    "get-connections-by-requester": {
         parameters: {
             query?: never;
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
-            path?: never;
-            cookie?: never;
-        };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["CreateCategoryParams"];
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
             };
+            cookie?: never;
         };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -10995,7 +13863,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["CategoryDocument"];
+                    "application/json": components["schemas"]["ConnectionDocument"][];
                 };
             };
             /** @description Error */
@@ -11009,14 +13877,14 @@ export interface operations {
             };
         };
     };
-    "get-categories-by-user": {
+    "get-user-credits": {
         parameters: {
             query?: never;
-            header?: never;
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
+            header: {
+                /** @description Bearer token for authentication */
+                Authorization: string;
             };
+            path?: never;
             cookie?: never;
         };
         requestBody?: never;
@@ -11027,7 +13895,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["WorkspaceResult"][];
+                    "application/json": components["schemas"]["UserCredits"];
                 };
             };
             /** @description Error */
@@ -11041,16 +13909,16 @@ export interface operations {
             };
         };
     };
-    "delete-category": {
+    "get-encouragements": {
         parameters: {
             query?: never;
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
-            };
+            path?: never;
             cookie?: never;
         };
         requestBody?: never;
@@ -11061,7 +13929,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["DeleteCategoryOutputBody"];
+                    "application/json": components["schemas"]["EncouragementDocument"][];
                 };
             };
             /** @description Error */
@@ -11075,21 +13943,21 @@ export interface operations {
             };
         };
     };
-    "update-category": {
+    "create-encouragement": {
         parameters: {
             query?: never;
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
-            };
+            path?: never;
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["UpdateCategoryDocument"];
+                "application/json": components["schemas"]["CreateEncouragementParams"];
             };
         };
         responses: {
@@ -11099,7 +13967,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UpdateCategoryOutputBody"];
+                    "application/json": components["schemas"]["CreateEncouragementOutputBody"];
                 };
             };
             /** @description Error */
@@ -11113,15 +13981,18 @@ export interface operations {
             };
         };
     };
-    "delete-workspace": {
+    "get-encouragement": {
         parameters: {
             query?: never;
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
             path: {
-                /** @example workspace1 */
-                name: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
             };
             cookie?: never;
         };
@@ -11133,7 +14004,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["DeleteWorkspaceOutputBody"];
+                    "application/json": components["schemas"]["EncouragementDocument"];
                 };
             };
             /** @description Error */
@@ -11147,23 +14018,22 @@ export interface operations {
             };
         };
     };
-    "rename-workspace": {
+    "delete-encouragement": {
         parameters: {
             query?: never;
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
             path: {
-                /** @example old-workspace */
-                oldName: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
             };
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["RenameWorkspaceInputBody"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -11171,7 +14041,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["RenameWorkspaceOutputBody"];
+                    "application/json": components["schemas"]["DeleteEncouragementOutputBody"];
                 };
             };
             /** @description Error */
@@ -11185,15 +14055,16 @@ export interface operations {
             };
         };
     };
-    "update-task-deadline": {
+    "update-encouragement": {
         parameters: {
             query?: never;
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
             path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                category: string;
                 /** @example 507f1f77bcf86cd799439011 */
                 id: string;
             };
@@ -11201,7 +14072,7 @@ export interface operations {
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["UpdateTaskDeadlineDocument"];
+                "application/json": components["schemas"]["UpdateEncouragementDocument"];
             };
         };
         responses: {
@@ -11211,7 +14082,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UpdateTaskDeadlineOutputBody"];
+                    "application/json": components["schemas"]["UpdateEncouragementOutputBody"];
                 };
             };
             /** @description Error */
@@ -11225,15 +14096,16 @@ export interface operations {
             };
         };
     };
-    "update-task-reminder": {
+    "react-to-encouragement": {
         parameters: {
             query?: never;
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
             path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                category: string;
                 /** @example 507f1f77bcf86cd799439011 */
                 id: string;
             };
@@ -11241,7 +14113,7 @@ export interface operations {
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["UpdateTaskReminderDocument"];
+                "application/json": components["schemas"]["EncouragementReactionParams"];
             };
         };
         responses: {
@@ -11251,7 +14123,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UpdateTaskReminderOutputBody"];
+                    "application/json": components["schemas"]["ReactToEncouragementOutputBody"];
                 };
             };
             /** @description Error */
@@ -11265,23 +14137,21 @@ export interface operations {
             };
         };
     };
-    "update-task-start": {
+    "mark-encouragements-read": {
         parameters: {
             query?: never;
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                category: string;
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
-            };
+            path?: never;
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["UpdateTaskStartDocument"];
+                "application/json": components["schemas"]["MarkEncouragementsReadBody"];
             };
         };
         responses: {
@@ -11291,7 +14161,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UpdateTaskStartOutputBody"];
+                    "application/json": components["schemas"]["MarkEncouragementsReadOutputBody"];
                 };
             };
             /** @description Error */
@@ -11305,7 +14175,7 @@ export interface operations {
             };
         };
     };
-    "get-congratulations": {
+    "get-sent-encouragements": {
         parameters: {
             query?: never;
             header: {
@@ -11325,7 +14195,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["CongratulationDocument"][];
+                    "application/json": components["schemas"]["EncouragementDocument"][];
                 };
             };
             /** @description Error */
@@ -11339,23 +14209,25 @@ export interface operations {
             };
         };
     };
-    "create-congratulation": {
+    "get-feed": {
         parameters: {
-            query?: never;
+            query?: {
+                /** @description Number of feed items to return (default: 20) */
+                limit?: number;
+                /** @description nextCursor from the previous page; omit for the first page */
+                cursor?: string;
+                /** @description Ordering for a new session; later pages keep the cursor's mode */
+                mode?: "chronological" | "ranked";
+                /** @description Include each ranked item's score breakdown */
+                debug?: boolean;
+            };
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path?: never;
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["CreateCongratulationParams"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -11363,7 +14235,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["CreateCongratulationOutputBody"];
+                    "application/json": components["schemas"]["GetFeedOutputBody"];
                 };
             };
             /** @description Error */
@@ -11377,22 +14249,20 @@ export interface operations {
             };
         };
     };
-    "get-congratulation": {
+    "record-feed-impressions": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
-            };
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
             };
+            path?: never;
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["RecordFeedImpressionsInputBody"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -11400,7 +14270,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["CongratulationDocument"];
+                    "application/json": components["schemas"]["RecordFeedImpressionsOutputBody"];
                 };
             };
             /** @description Error */
@@ -11414,19 +14284,18 @@ export interface operations {
             };
         };
     };
-    "delete-congratulation": {
+    "get-for-you": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
+                /** @description Bearer token */
                 Authorization: string;
-                /** @description Refresh token for authentication */
+                /** @description Refresh token */
                 refresh_token: string;
+                /** @description IANA timezone, e.g. America/New_York */
+                "X-Timezone"?: string;
             };
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
-            };
+            path?: never;
             cookie?: never;
         };
         requestBody?: never;
@@ -11437,7 +14306,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["DeleteCongratulationOutputBody"];
+                    "application/json": components["schemas"]["ForYouFeed"];
                 };
             };
             /** @description Error */
@@ -11451,24 +14320,19 @@ export interface operations {
             };
         };
     };
-    "update-congratulation": {
+    "dismiss-for-you-card": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
                 refresh_token: string;
             };
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
-            };
+            path?: never;
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["UpdateCongratulationDocument"];
+                "application/json": components["schemas"]["DismissCardParams"];
             };
         };
         responses: {
@@ -11478,7 +14342,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UpdateCongratulationOutputBody"];
+                    "application/json": components["schemas"]["DismissCardOutputBody"];
                 };
             };
             /** @description Error */
@@ -11492,24 +14356,19 @@ export interface operations {
             };
         };
     };
-    "react-to-congratulation": {
+    "record-for-you-interaction": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
                 refresh_token: string;
             };
-            path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
-            };
+            path?: never;
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["CongratulationReactionParams"];
+                "application/json": components["schemas"]["RecordInteractionParams"];
             };
         };
         responses: {
@@ -11519,7 +14378,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ReactToCongratulationOutputBody"];
+                    "application/json": components["schemas"]["RecordInteractionOutputBody"];
                 };
             };
             /** @description Error */
@@ -11533,23 +14392,16 @@ export interface operations {
             };
         };
     };
-    "send-beak-congratulation": {
+    "get-groups": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path?: never;
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["SendBeakCongratulationParams"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -11557,7 +14409,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SendBeakCongratulationResult"];
+                    "application/json": components["schemas"]["GetGroupsOutputBody"];
                 };
             };
             /** @description Error */
@@ -11571,21 +14423,18 @@ export interface operations {
             };
         };
     };
-    "mark-congratulations-read": {
+    "create-group": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path?: never;
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["MarkCongratulationsReadParams"];
+                "application/json": components["schemas"]["CreateGroupParams"];
             };
         };
         responses: {
@@ -11595,7 +14444,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["MarkCongratulationsReadOutputBody"];
+                    "application/json": components["schemas"]["GroupDocumentAPI"];
                 };
             };
             /** @description Error */
@@ -11609,16 +14458,16 @@ export interface operations {
             };
         };
     };
-    "get-sent-congratulations": {
+    "join-group": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
-            path?: never;
+            path: {
+                /** @example K7PQ2M4X */
+                code: string;
+            };
             cookie?: never;
         };
         requestBody?: never;
@@ -11629,7 +14478,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["CongratulationDocument"][];
+                    "application/json": components["schemas"]["JoinGroupOutputBody"];
                 };
             };
             /** @description Error */
@@ -11643,16 +14492,16 @@ export interface operations {
             };
         };
     };
-    "get-connections": {
+    "get-group": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
-            path?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
             cookie?: never;
         };
         requestBody?: never;
@@ -11663,7 +14512,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ConnectionDocument"][];
+                    "application/json": components["schemas"]["GroupDocumentAPI"];
                 };
             };
             /** @description Error */
@@ -11677,23 +14526,19 @@ export interface operations {
             };
         };
     };
-    "create-connection": {
+    "delete-group": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
-            path?: never;
-            cookie?: never;
-        };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["CreateConnectionParams"];
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
             };
+            cookie?: never;
         };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -11701,7 +14546,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ConnectionDocument"];
+                    "application/json": components["schemas"]["DeleteGroupOutputBody"];
                 };
             };
             /** @description Error */
@@ -11715,14 +14560,11 @@ export interface operations {
             };
         };
     };
-    "get-connection": {
+    "update-group": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path: {
                 /** @example 507f1f77bcf86cd799439011 */
@@ -11730,7 +14572,11 @@ export interface operations {
             };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["UpdateGroupParams"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -11738,7 +14584,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ConnectionDocument"];
+                    "application/json": components["schemas"]["UpdateGroupOutputBody"];
                 };
             };
             /** @description Error */
@@ -11752,14 +14598,15 @@ export interface operations {
             };
         };
     };
-    "delete-connection": {
+    "get-group-activity": {
         parameters: {
-            query?: never;
+            query?: {
+                /** @description RFC3339 timestamp; only activity before it is returned. Pass the previous page's nextBefore. */
+                before?: string;
+                limit?: number;
+            };
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path: {
                 /** @example 507f1f77bcf86cd799439011 */
@@ -11775,7 +14622,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["DeleteConnectionOutputBody"];
+                    "application/json": components["schemas"]["GetGroupActivityOutputBody"];
                 };
             };
             /** @description Error */
@@ -11789,14 +14636,11 @@ export interface operations {
             };
         };
     };
-    "update-connection": {
+    "get-group-challenges": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path: {
                 /** @example 507f1f77bcf86cd799439011 */
@@ -11804,11 +14648,7 @@ export interface operations {
             };
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["UpdateConnectionDocument"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -11816,7 +14656,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UpdateConnectionOutputBody"];
+                    "application/json": components["schemas"]["GetChallengesOutputBody"];
                 };
             };
             /** @description Error */
@@ -11830,14 +14670,11 @@ export interface operations {
             };
         };
     };
-    "accept-connection": {
+    "create-group-challenge": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path: {
                 /** @example 507f1f77bcf86cd799439011 */
@@ -11845,7 +14682,11 @@ export interface operations {
             };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["CreateChallengeParams"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -11853,7 +14694,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["AcceptConnectionOutputBody"];
+                    "application/json": components["schemas"]["GroupChallengeAPI"];
                 };
             };
             /** @description Error */
@@ -11867,21 +14708,17 @@ export interface operations {
             };
         };
     };
-    "block-user": {
+    "get-group-challenge": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path: {
-                /**
-                 * @description User ID to block
-                 * @example 507f1f77bcf86cd799439011
-                 */
-                userId: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                challengeId: string;
             };
             cookie?: never;
         };
@@ -11893,7 +14730,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["BlockUserOutputBody"];
+                    "application/json": components["schemas"]["GroupChallengeAPI"];
                 };
             };
             /** @description Error */
@@ -11907,21 +14744,17 @@ export interface operations {
             };
         };
     };
-    "unblock-user": {
+    "delete-group-challenge": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path: {
-                /**
-                 * @description User ID to unblock
-                 * @example 507f1f77bcf86cd799439011
-                 */
-                userId: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                challengeId: string;
             };
             cookie?: never;
         };
@@ -11933,7 +14766,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UnblockUserOutputBody"];
+                    "application/json": components["schemas"]["ChallengeMessageOutputBody"];
                 };
             };
             /** @description Error */
@@ -11947,16 +14780,18 @@ export interface operations {
             };
         };
     };
-    "get-blocked-users": {
+    "join-group-challenge": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
-            path?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                challengeId: string;
+            };
             cookie?: never;
         };
         requestBody?: never;
@@ -11967,7 +14802,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ConnectionUser"][];
+                    "application/json": components["schemas"]["GroupChallengeAPI"];
                 };
             };
             /** @description Error */
@@ -11981,16 +14816,18 @@ export interface operations {
             };
         };
     };
-    "get-friends": {
+    "leave-group-challenge": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
-            path?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                challengeId: string;
+            };
             cookie?: never;
         };
         requestBody?: never;
@@ -12001,7 +14838,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["FriendReference"][];
+                    "application/json": components["schemas"]["ChallengeMessageOutputBody"];
                 };
             };
             /** @description Error */
@@ -12015,21 +14852,15 @@ export interface operations {
             };
         };
     };
-    "get-friends-by-user": {
+    "get-group-invites": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path: {
-                /**
-                 * @description User ID whose friends should be returned
-                 * @example 507f1f77bcf86cd799439011
-                 */
-                userId: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
             };
             cookie?: never;
         };
@@ -12041,7 +14872,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["FriendReference"][];
+                    "application/json": components["schemas"]["GetInvitesOutputBody"];
                 };
             };
             /** @description Error */
@@ -12055,19 +14886,23 @@ export interface operations {
             };
         };
     };
-    "get-connections-by-receiver": {
+    "create-group-invite": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
-            path?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["CreateInviteParams"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -12075,7 +14910,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ConnectionDocument"][];
+                    "application/json": components["schemas"]["GroupInvite"];
                 };
             };
             /** @description Error */
@@ -12089,18 +14924,17 @@ export interface operations {
             };
         };
     };
-    "get-connections-by-requester": {
+    "revoke-group-invite": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path: {
                 /** @example 507f1f77bcf86cd799439011 */
                 id: string;
+                /** @example K7PQ2M4X */
+                code: string;
             };
             cookie?: never;
         };
@@ -12112,7 +14946,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ConnectionDocument"][];
+                    "application/json": components["schemas"]["RevokeInviteOutputBody"];
                 };
             };
             /** @description Error */
@@ -12126,17 +14960,23 @@ export interface operations {
             };
         };
     };
-    "get-user-credits": {
+    "add-group-member": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
             };
-            path?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["AddMemberParams"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -12144,7 +14984,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UserCredits"];
+                    "application/json": components["schemas"]["AddMemberOutputBody"];
                 };
             };
             /** @description Error */
@@ -12158,19 +14998,23 @@ export interface operations {
             };
         };
     };
-    "get-encouragements": {
+    "remove-group-member": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
-            path?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["RemoveMemberParams"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -12178,7 +15022,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["EncouragementDocument"][];
+                    "application/json": components["schemas"]["RemoveMemberOutputBody"];
                 };
             };
             /** @description Error */
@@ -12192,21 +15036,23 @@ export interface operations {
             };
         };
     };
-    "create-encouragement": {
+    "set-group-member-role": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
-            path?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                userId: string;
+            };
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["CreateEncouragementParams"];
+                "application/json": components["schemas"]["SetMemberRoleParams"];
             };
         };
         responses: {
@@ -12216,7 +15062,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["CreateEncouragementOutputBody"];
+                    "application/json": components["schemas"]["GroupDocumentAPI"];
                 };
             };
             /** @description Error */
@@ -12230,14 +15076,11 @@ export interface operations {
             };
         };
     };
-    "get-encouragement": {
+    "get-group-notifications": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path: {
                 /** @example 507f1f77bcf86cd799439011 */
@@ -12253,7 +15096,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["EncouragementDocument"];
+                    "application/json": components["schemas"]["GroupNotificationSettings"];
                 };
             };
             /** @description Error */
@@ -12267,14 +15110,11 @@ export interface operations {
             };
         };
     };
-    "delete-encouragement": {
+    "update-group-notifications": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path: {
                 /** @example 507f1f77bcf86cd799439011 */
@@ -12282,7 +15122,11 @@ export interface operations {
             };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["UpdateGroupNotificationsParams"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -12290,7 +15134,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["DeleteEncouragementOutputBody"];
+                    "application/json": components["schemas"]["GroupNotificationSettings"];
                 };
             };
             /** @description Error */
@@ -12304,14 +15148,11 @@ export interface operations {
             };
         };
     };
-    "update-encouragement": {
+    "get-group-join-requests": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path: {
                 /** @example 507f1f77bcf86cd799439011 */
@@ -12319,11 +15160,7 @@ export interface operations {
             };
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["UpdateEncouragementDocument"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -12331,7 +15168,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UpdateEncouragementOutputBody"];
+                    "application/json": components["schemas"]["GetJoinRequestsOutputBody"];
                 };
             };
             /** @description Error */
@@ -12345,26 +15182,21 @@ export interface operations {
             };
         };
     };
-    "react-to-encouragement": {
+    "approve-group-join-request": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path: {
                 /** @example 507f1f77bcf86cd799439011 */
                 id: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                userId: string;
             };
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["EncouragementReactionParams"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -12372,7 +15204,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ReactToEncouragementOutputBody"];
+                    "application/json": components["schemas"]["JoinRequestOutputBody"];
                 };
             };
             /** @description Error */
@@ -12386,23 +15218,21 @@ export interface operations {
             };
         };
     };
-    "mark-encouragements-read": {
+    "deny-group-join-request": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
-            path?: never;
-            cookie?: never;
-        };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["MarkEncouragementsReadBody"];
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+                /** @example 507f1f77bcf86cd799439011 */
+                userId: string;
             };
+            cookie?: never;
         };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -12410,7 +15240,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["MarkEncouragementsReadOutputBody"];
+                    "application/json": components["schemas"]["JoinRequestOutputBody"];
                 };
             };
             /** @description Error */
@@ -12424,14 +15254,11 @@ export interface operations {
             };
         };
     };
-    "get-sent-encouragements": {
+    "login-token": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path?: never;
             cookie?: never;
@@ -12441,10 +15268,12 @@ export interface operations {
             /** @description OK */
             200: {
                 headers: {
+                    access_token?: string;
+                    refresh_token?: string;
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["EncouragementDocument"][];
+                    "application/json": components["schemas"]["SafeUser"];
                 };
             };
             /** @description Error */
@@ -12458,16 +15287,25 @@ export interface operations {
             };
         };
     };
-    "get-feed": {
+    "get-notifications": {
         parameters: {
             query?: {
-                /** @description Number of feed items to return (default: 20) */
+                /**
+                 * @description Maximum number of notifications to return (default: 20, max: 100)
+                 * @example 20
+                 */
                 limit?: number;
-                /** @description Number of feed items to skip (default: 0) */
-                offset?: number;
+                /**
+                 * @description Number of notifications to skip for pagination
+                 * @example 0
+                 */
+                skip?: number;
             };
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
+                refresh_token: string;
             };
             path?: never;
             cookie?: never;
@@ -12480,7 +15318,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["GetFeedOutputBody"];
+                    "application/json": components["schemas"]["GetNotificationsOutputBody"];
                 };
             };
             /** @description Error */
@@ -12494,18 +15332,19 @@ export interface operations {
             };
         };
     };
-    "get-for-you": {
+    "delete-notification": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token */
+                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token */
+                /** @description Refresh token for authentication */
                 refresh_token: string;
-                /** @description IANA timezone, e.g. America/New_York */
-                "X-Timezone"?: string;
             };
-            path?: never;
+            path: {
+                /** @example 507f1f77bcf86cd799439011 */
+                id: string;
+            };
             cookie?: never;
         };
         requestBody?: never;
@@ -12516,7 +15355,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ForYouFeed"];
+                    "application/json": components["schemas"]["DeleteNotificationOutputBody"];
                 };
             };
             /** @description Error */
@@ -12530,11 +15369,13 @@ export interface operations {
             };
         };
     };
-    "dismiss-for-you-card": {
+    "mark-notifications-read": {
         parameters: {
             query?: never;
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
                 refresh_token: string;
             };
             path?: never;
@@ -12542,7 +15383,7 @@ export interface operations {
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["DismissCardParams"];
+                "application/json": components["schemas"]["MarkNotificationsReadBody"];
             };
         };
         responses: {
@@ -12552,7 +15393,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["DismissCardOutputBody"];
+                    "application/json": components["schemas"]["MarkNotificationsReadOutputBody"];
                 };
             };
             /** @description Error */
@@ -12566,21 +15407,19 @@ export interface operations {
             };
         };
     };
-    "record-for-you-interaction": {
+    "mark-all-notifications-read": {
         parameters: {
             query?: never;
             header: {
+                /** @description Bearer token for authentication */
                 Authorization: string;
+                /** @description Refresh token for authentication */
                 refresh_token: string;
             };
             path?: never;
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["RecordInteractionParams"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -12588,7 +15427,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["RecordInteractionOutputBody"];
+                    "application/json": components["schemas"]["MarkAllNotificationsReadOutputBody"];
                 };
             };
             /** @description Error */
@@ -12602,9 +15441,14 @@ export interface operations {
             };
         };
     };
-    "get-groups": {
+    "get-posts": {
         parameters: {
-            query?: never;
+            query?: {
+                /** @description Number of posts to return (default: 8) */
+                limit?: number;
+                /** @description Number of posts to skip (default: 0) */
+                offset?: number;
+            };
             header: {
                 Authorization: string;
             };
@@ -12619,7 +15463,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["GetGroupsOutputBody"];
+                    "application/json": components["schemas"]["GetPostsOutputBody"];
                 };
             };
             /** @description Error */
@@ -12633,7 +15477,7 @@ export interface operations {
             };
         };
     };
-    "create-group": {
+    "create-post": {
         parameters: {
             query?: never;
             header: {
@@ -12644,17 +15488,18 @@ export interface operations {
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["CreateGroupParams"];
+                "application/json": components["schemas"]["CreatePostParams"];
             };
         };
         responses: {
             /** @description OK */
             200: {
                 headers: {
+                    UserStats?: components["schemas"]["CreatePostOutputUserStats"];
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["GroupDocumentAPI"];
+                    "application/json": components["schemas"]["CreatePostOutputBody"];
                 };
             };
             /** @description Error */
@@ -12668,7 +15513,7 @@ export interface operations {
             };
         };
     };
-    "get-group": {
+    "get-post": {
         parameters: {
             query?: never;
             header: {
@@ -12688,7 +15533,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["GroupDocumentAPI"];
+                    "application/json": components["schemas"]["PostDocumentAPI"];
                 };
             };
             /** @description Error */
@@ -12702,7 +15547,7 @@ export interface operations {
             };
         };
     };
-    "delete-group": {
+    "delete-post": {
         parameters: {
             query?: never;
             header: {
@@ -12722,7 +15567,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["DeleteGroupOutputBody"];
+                    "application/json": components["schemas"]["DeletePostOutputBody"];
                 };
             };
             /** @description Error */
@@ -12736,7 +15581,7 @@ export interface operations {
             };
         };
     };
-    "update-group": {
+    "update-post": {
         parameters: {
             query?: never;
             header: {
@@ -12750,7 +15595,7 @@ export interface operations {
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["UpdateGroupParams"];
+                "application/json": components["schemas"]["UpdatePostParams"];
             };
         };
         responses: {
@@ -12760,7 +15605,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UpdateGroupOutputBody"];
+                    "application/json": components["schemas"]["UpdatePostOutputBody"];
                 };
             };
             /** @description Error */
@@ -12774,7 +15619,7 @@ export interface operations {
             };
         };
     };
-    "add-group-member": {
+    "add-comment": {
         parameters: {
             query?: never;
             header: {
@@ -12782,13 +15627,13 @@ export interface operations {
             };
             path: {
                 /** @example 507f1f77bcf86cd799439011 */
-                id: string;
+                postId: string;
             };
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["AddMemberParams"];
+                "application/json": components["schemas"]["AddCommentParams"];
             };
         };
         responses: {
@@ -12798,7 +15643,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["AddMemberOutputBody"];
+                    "application/json": components["schemas"]["AddCommentOutputBody"];
                 };
             };
             /** @description Error */
@@ -12812,23 +15657,21 @@ export interface operations {
             };
         };
     };
-    "remove-group-member": {
+    "delete-comment": {
         parameters: {
             query?: never;
             header: {
                 Authorization: string;
             };
             path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
+                /** @description Post ID */
+                postId: string;
+                /** @description Comment ID */
+                commentId: string;
             };
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["RemoveMemberParams"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -12836,7 +15679,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["RemoveMemberOutputBody"];
+                    "application/json": components["schemas"]["DeleteCommentOutputBody"];
                 };
             };
             /** @description Error */
@@ -12850,26 +15693,33 @@ export interface operations {
             };
         };
     };
-    "login-token": {
+    "edit-comment": {
         parameters: {
             query?: never;
             header: {
                 Authorization: string;
             };
-            path?: never;
+            path: {
+                /** @description Post ID */
+                postId: string;
+                /** @description Comment ID */
+                commentId: string;
+            };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["EditCommentParams"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
                 headers: {
-                    access_token?: string;
-                    refresh_token?: string;
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SafeUser"];
+                    "application/json": components["schemas"]["EditCommentOutputBody"];
                 };
             };
             /** @description Error */
@@ -12883,27 +15733,18 @@ export interface operations {
             };
         };
     };
-    "get-notifications": {
+    "approve-comment": {
         parameters: {
-            query?: {
-                /**
-                 * @description Maximum number of notifications to return (default: 20, max: 100)
-                 * @example 20
-                 */
-                limit?: number;
-                /**
-                 * @description Number of notifications to skip for pagination
-                 * @example 0
-                 */
-                skip?: number;
-            };
+            query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
-            path?: never;
+            path: {
+                /** @description Post ID */
+                postId: string;
+                /** @description Comment ID */
+                commentId: string;
+            };
             cookie?: never;
         };
         requestBody?: never;
@@ -12914,7 +15755,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["GetNotificationsOutputBody"];
+                    "application/json": components["schemas"]["ApproveCommentOutputBody"];
                 };
             };
             /** @description Error */
@@ -12928,18 +15769,22 @@ export interface operations {
             };
         };
     };
-    "delete-notification": {
+    "get-comments": {
         parameters: {
-            query?: never;
+            query?: {
+                /** @description Root comment ID; when set, returns that thread's replies instead of root comments */
+                thread?: string;
+                /** @description Maximum number of comments to return */
+                limit?: number;
+                /** @description Opaque cursor from the previous page's nextCursor */
+                cursor?: string;
+            };
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                id: string;
+                /** @description Post ID */
+                postId: string;
             };
             cookie?: never;
         };
@@ -12951,7 +15796,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["DeleteNotificationOutputBody"];
+                    "application/json": components["schemas"]["GetCommentsOutputBody"];
                 };
             };
             /** @description Error */
@@ -12965,21 +15810,23 @@ export interface operations {
             };
         };
     };
-    "mark-notifications-read": {
+    "toggle-comment-reaction": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
-            path?: never;
+            path: {
+                /** @description Post ID */
+                postId: string;
+                /** @description Comment ID */
+                commentId: string;
+            };
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["MarkNotificationsReadBody"];
+                "application/json": components["schemas"]["AddReactionParams"];
             };
         };
         responses: {
@@ -12989,7 +15836,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["MarkNotificationsReadOutputBody"];
+                    "application/json": components["schemas"]["AddReactionOutputBody"];
                 };
             };
             /** @description Error */
@@ -13003,19 +15850,20 @@ export interface operations {
             };
         };
     };
-    "mark-all-notifications-read": {
+    "create-draft": {
         parameters: {
             query?: never;
             header: {
-                /** @description Bearer token for authentication */
                 Authorization: string;
-                /** @description Refresh token for authentication */
-                refresh_token: string;
             };
             path?: never;
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["DraftContent"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -13023,7 +15871,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["MarkAllNotificationsReadOutputBody"];
+                    "application/json": components["schemas"]["PostDraft"];
                 };
             };
             /** @description Error */
@@ -13037,14 +15885,9 @@ export interface operations {
             };
         };
     };
-    "get-posts": {
+    "get-drafts": {
         parameters: {
-            query?: {
-                /** @description Number of posts to return (default: 8) */
-                limit?: number;
-                /** @description Number of posts to skip (default: 0) */
-                offset?: number;
-            };
+            query?: never;
             header: {
                 Authorization: string;
             };
@@ -13059,7 +15902,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["GetPostsOutputBody"];
+                    "application/json": components["schemas"]["GetDraftsOutputBody"];
                 };
             };
             /** @description Error */
@@ -13073,29 +15916,27 @@ export interface operations {
             };
         };
     };
-    "create-post": {
+    "get-draft": {
         parameters: {
             query?: never;
             header: {
                 Authorization: string;
             };
-            path?: never;
-            cookie?: never;
-        };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["CreatePostParams"];
+            path: {
+                /** @description Draft ID */
+                id: string;
             };
+            cookie?: never;
         };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
                 headers: {
-                    UserStats?: components["schemas"]["CreatePostOutputUserStats"];
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["CreatePostOutputBody"];
+                    "application/json": components["schemas"]["PostDraft"];
                 };
             };
             /** @description Error */
@@ -13109,19 +15950,23 @@ export interface operations {
             };
         };
     };
-    "get-post": {
+    "update-draft": {
         parameters: {
             query?: never;
             header: {
                 Authorization: string;
             };
             path: {
-                /** @example 507f1f77bcf86cd799439011 */
+                /** @description Draft ID */
                 id: string;
             };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["DraftContent"];
+            };
+        };
         responses: {
             /** @description OK */
             200: {
@@ -13129,7 +15974,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["PostDocumentAPI"];
+                    "application/json": components["schemas"]["PostDraft"];
                 };
             };
             /** @description Error */
@@ -13143,14 +15988,14 @@ export interface operations {
             };
         };
     };
-    "delete-post": {
+    "delete-draft": {
         parameters: {
             query?: never;
             header: {
                 Authorization: string;
             };
             path: {
-                /** @example 507f1f77bcf86cd799439011 */
+                /** @description Draft ID */
                 id: string;
             };
             cookie?: never;
@@ -13163,7 +16008,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["DeletePostOutputBody"];
+                    "application/json": components["schemas"]["DeleteDraftOutputBody"];
                 };
             };
             /** @description Error */
@@ -13177,21 +16022,21 @@ export interface operations {
             };
         };
     };
-    "update-post": {
+    "schedule-draft": {
         parameters: {
             query?: never;
             header: {
                 Authorization: string;
             };
             path: {
-                /** @example 507f1f77bcf86cd799439011 */
+                /** @description Draft ID */
                 id: string;
             };
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["UpdatePostParams"];
+                "application/json": components["schemas"]["ScheduleDraftInputBody"];
             };
         };
         responses: {
@@ -13201,7 +16046,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["UpdatePostOutputBody"];
+                    "application/json": components["schemas"]["PostDraft"];
                 };
             };
             /** @description Error */
@@ -13215,23 +16060,19 @@ export interface operations {
             };
         };
     };
-    "add-comment": {
+    "unschedule-draft": {
         parameters: {
             query?: never;
             header: {
                 Authorization: string;
             };
             path: {
-                /** @example 507f1f77bcf86cd799439011 */
-                postId: string;
+                /** @description Draft ID */
+                id: string;
             };
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["AddCommentParams"];
-            };
-        };
+        requestBody?: never;
         responses: {
             /** @description OK */
             200: {
@@ -13239,7 +16080,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["AddCommentOutputBody"];
+                    "application/json": components["schemas"]["PostDraft"];
                 };
             };
             /** @description Error */
@@ -13253,17 +16094,15 @@ export interface operations {
             };
         };
     };
-    "delete-comment": {
+    "publish-draft": {
         parameters: {
             query?: never;
             header: {
                 Authorization: string;
             };
             path: {
-                /** @description Post ID */
-                postId: string;
-                /** @description Comment ID */
-                commentId: string;
+                /** @description Draft ID */
+                id: string;
             };
             cookie?: never;
         };
@@ -13272,10 +16111,11 @@ export interface operations {
             /** @description OK */
             200: {
                 headers: {
+                    UserStats?: components["schemas"]["CreatePostOutputUserStats"];
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["DeleteCommentOutputBody"];
+                    "application/json": components["schemas"]["CreatePostOutputBody"];
                 };
             };
             /** @description Error */
@@ -13366,8 +16206,8 @@ export interface operations {
             query?: {
                 /** @description Number of posts to return (default: 8) */
                 limit?: number;
-                /** @description Number of posts to skip (default: 0) */
-                offset?: number;
+                /** @description nextCursor from the previous page; omit for the first page */
+                cursor?: string;
             };
             header: {
                 Authorization: string;
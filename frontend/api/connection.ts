@@ -1,5 +1,6 @@
 import client from "@/api/client";
 import { withAuthHeaders } from "./utils";
+import type { components } from "./generated/types";
 
 export interface BlockedUser {
     _id: string;
@@ -8,6 +9,10 @@ export interface BlockedUser {
     picture?: string;
 }
 
+export type CloseFriend = BlockedUser;
+
+export type FriendList = components["schemas"]["FriendListDocument"];
+
 /**
  * Block a user
  * @param userId - ID of the user to block
@@ -55,6 +60,249 @@ export const getBlockedUsers = async (): Promise<BlockedUser[]> => {
     return data as any;
 };
 
+/**
+ * Mute a user: their posts, tasks and ring closures stay out of your feed
+ * @param userId - ID of the user to mute
+ */
+export const muteUser = async (userId: string): Promise<{ message: string }> => {
+    const { data, error } = await client.POST("/v1/user/connections/mute/{userId}", {
+        params: withAuthHeaders({ path: { userId } }),
+    });
+
+    if (error) {
+        throw new Error(`Failed to mute user: ${JSON.stringify(error)}`);
+    }
+
+    return data as any;
+};
+
+/**
+ * Unmute a user
+ * @param userId - ID of the user to unmute
+ */
+export const unmuteUser = async (userId: string): Promise<{ message: string }> => {
+    const { data, error } = await client.DELETE("/v1/user/connections/mute/{userId}", {
+        params: withAuthHeaders({ path: { userId } }),
+    });
+
+    if (error) {
+        throw new Error(`Failed to unmute user: ${JSON.stringify(error)}`);
+    }
+
+    return data as any;
+};
+
+/**
+ * Get list of muted users
+ */
+export const getMutedUsers = async (): Promise<BlockedUser[]> => {
+    const { data, error } = await client.GET("/v1/user/connections/muted", {
+        params: withAuthHeaders(),
+    });
+
+    if (error) {
+        throw new Error(`Failed to get muted users: ${JSON.stringify(error)}`);
+    }
+
+    return data as any;
+};
+
+/**
+ * Restrict a user: their comments on your posts wait for your approval
+ * @param userId - ID of the user to restrict
+ */
+export const restrictUser = async (userId: string): Promise<{ message: string }> => {
+    const { data, error } = await client.POST("/v1/user/connections/restrict/{userId}", {
+        params: withAuthHeaders({ path: { userId } }),
+    });
+
+    if (error) {
+        throw new Error(`Failed to restrict user: ${JSON.stringify(error)}`);
+    }
+
+    return data as any;
+};
+
+/**
+ * Unrestrict a user
+ * @param userId - ID of the user to unrestrict
+ */
+export const unrestrictUser = async (userId: string): Promise<{ message: string }> => {
+    const { data, error } = await client.DELETE("/v1/user/connections/restrict/{userId}", {
+        params: withAuthHeaders({ path: { userId } }),
+    });
+
+    if (error) {
+        throw new Error(`Failed to unrestrict user: ${JSON.stringify(error)}`);
+    }
+
+    return data as any;
+};
+
+/**
+ * Get list of restricted users
+ */
+export const getRestrictedUsers = async (): Promise<BlockedUser[]> => {
+    const { data, error } = await client.GET("/v1/user/connections/restricted", {
+        params: withAuthHeaders(),
+    });
+
+    if (error) {
+        throw new Error(`Failed to get restricted users: ${JSON.stringify(error)}`);
+    }
+
+    return data as any;
+};
+
+/**
+ * Hide your posts, public tasks and ring closures from a user without unfriending them
+ * @param userId - ID of the user to hide your activity from
+ */
+export const hideActivityFromUser = async (userId: string): Promise<{ message: string }> => {
+    const { data, error } = await client.POST("/v1/user/connections/hide/{userId}", {
+        params: withAuthHeaders({ path: { userId } }),
+    });
+
+    if (error) {
+        throw new Error(`Failed to hide activity from user: ${JSON.stringify(error)}`);
+    }
+
+    return data as any;
+};
+
+/**
+ * Stop hiding your activity from a user
+ * @param userId - ID of the user to show your activity to again
+ */
+export const unhideActivityFromUser = async (userId: string): Promise<{ message: string }> => {
+    const { data, error } = await client.DELETE("/v1/user/connections/hide/{userId}", {
+        params: withAuthHeaders({ path: { userId } }),
+    });
+
+    if (error) {
+        throw new Error(`Failed to unhide activity from user: ${JSON.stringify(error)}`);
+    }
+
+    return data as any;
+};
+
+/**
+ * Get list of users you hide your activity from
+ */
+export const getHiddenFromUsers = async (): Promise<BlockedUser[]> => {
+    const { data, error } = await client.GET("/v1/user/connections/hidden", {
+        params: withAuthHeaders(),
+    });
+
+    if (error) {
+        throw new Error(`Failed to get hidden-from users: ${JSON.stringify(error)}`);
+    }
+
+    return data as any;
+};
+
+/**
+ * Get the friends who see your close-friends posts
+ */
+export const getCloseFriends = async (): Promise<CloseFriend[]> => {
+    const { data, error } = await client.GET("/v1/user/connections/close-friends", {
+        params: withAuthHeaders(),
+    });
+
+    if (error) {
+        throw new Error(`Failed to get close friends: ${JSON.stringify(error)}`);
+    }
+
+    return data as any;
+};
+
+/**
+ * Replace your close friends list. Anyone who isn't a friend is dropped.
+ * @param userIds - IDs of the friends on the list
+ */
+export const updateCloseFriends = async (userIds: string[]): Promise<CloseFriend[]> => {
+    const { data, error } = await client.PUT("/v1/user/connections/close-friends", {
+        params: withAuthHeaders(),
+        body: { users: userIds },
+    });
+
+    if (error) {
+        throw new Error(`Failed to update close friends: ${JSON.stringify(error)}`);
+    }
+
+    return data as any;
+};
+
+/**
+ * Get your close friends list followed by your custom friend lists
+ */
+export const getFriendLists = async (): Promise<FriendList[]> => {
+    const { data, error } = await client.GET("/v1/user/connections/lists", {
+        params: withAuthHeaders(),
+    });
+
+    if (error) {
+        throw new Error(`Failed to get friend lists: ${JSON.stringify(error)}`);
+    }
+
+    return data as any;
+};
+
+/**
+ * Create a named friend list. Anyone who isn't a friend is dropped.
+ * @param name - List name
+ * @param memberIds - IDs of the friends on the list
+ */
+export const createFriendList = async (name: string, memberIds: string[] = []): Promise<FriendList> => {
+    const { data, error } = await client.POST("/v1/user/connections/lists", {
+        params: withAuthHeaders(),
+        body: { name, members: memberIds },
+    });
+
+    if (error) {
+        throw new Error(`Failed to create friend list: ${JSON.stringify(error)}`);
+    }
+
+    return data as any;
+};
+
+/**
+ * Rename a friend list or replace its members
+ * @param listId - List ID, or "close_friends" for the built-in list
+ * @param changes - Fields to change; omitted fields are left alone
+ */
+export const updateFriendList = async (
+    listId: string,
+    changes: { name?: string; members?: string[] }
+): Promise<FriendList> => {
+    const { data, error } = await client.PATCH("/v1/user/connections/lists/{listId}", {
+        params: withAuthHeaders({ path: { listId } }),
+        body: changes,
+    });
+
+    if (error) {
+        throw new Error(`Failed to update friend list: ${JSON.stringify(error)}`);
+    }
+
+    return data as any;
+};
+
+/**
+ * Delete one of your custom friend lists
+ * @param listId - List ID
+ */
+export const deleteFriendList = async (listId: string): Promise<{ message: string }> => {
+    const { data, error } = await client.DELETE("/v1/user/connections/lists/{listId}", {
+        params: withAuthHeaders({ path: { listId } }),
+    });
+
+    if (error) {
+        throw new Error(`Failed to delete friend list: ${JSON.stringify(error)}`);
+    }
+
+    return data as any;
+};
+
 /**
  * Get list of friends
  */
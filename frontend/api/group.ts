@@ -7,6 +7,15 @@ const logger = createLogger('GroupAPI');
 
 // Types
 export type GroupDocument = components["schemas"]["GroupDocumentAPI"];
+export type GroupRole = components["schemas"]["SetMemberRoleParams"]["role"];
+export type GroupInvite = components["schemas"]["GroupInvite"];
+export type GroupJoinRequest = components["schemas"]["GroupJoinRequestAPI"];
+export type GroupNotificationSettings = components["schemas"]["GroupNotificationSettings"];
+export type GroupActivityItem = components["schemas"]["GroupActivityItem"];
+export type JoinGroupResult = components["schemas"]["JoinGroupOutputBody"];
+export type GroupChallenge = components["schemas"]["GroupChallengeAPI"];
+export type ChallengeStanding = components["schemas"]["ChallengeStanding"];
+export type CreateChallengeParams = Omit<components["schemas"]["CreateChallengeParams"], "$schema">;
 
 export interface CreateGroupParams {
     name: string;
@@ -73,7 +82,7 @@ export const createGroup = async (params: CreateGroupParams): Promise<GroupDocum
 };
 
 /**
- * Update a group (creator only)
+ * Update a group (owner or admin)
  */
 export const updateGroup = async (groupId: string, name: string): Promise<void> => {
     const { error } = await client.PATCH("/v1/user/groups/{id}", {
@@ -90,7 +99,7 @@ export const updateGroup = async (groupId: string, name: string): Promise<void>
 };
 
 /**
- * Delete a group (creator only)
+ * Delete a group (owner only)
  */
 export const deleteGroup = async (groupId: string): Promise<void> => {
     const { error } = await client.DELETE("/v1/user/groups/{id}", {
@@ -104,3 +113,331 @@ export const deleteGroup = async (groupId: string): Promise<void> => {
         throw new Error(`Failed to delete group: ${JSON.stringify(error)}`);
     }
 };
+
+/**
+ * Turn approval of invite-link joins on or off (owner or admin)
+ */
+export const setGroupRequiresApproval = async (groupId: string, requireApproval: boolean): Promise<void> => {
+    const { error } = await client.PATCH("/v1/user/groups/{id}", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId },
+        },
+        body: { requireApproval },
+    });
+
+    if (error) {
+        throw new Error(`Failed to update group: ${JSON.stringify(error)}`);
+    }
+};
+
+/**
+ * Change a member's role (owner only). Passing "owner" hands over ownership.
+ */
+export const setGroupMemberRole = async (groupId: string, userId: string, role: GroupRole): Promise<GroupDocument> => {
+    const { data, error } = await client.PUT("/v1/user/groups/{id}/members/{userId}/role", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId, userId },
+        },
+        body: { role },
+    });
+
+    if (error) {
+        throw new Error(`Failed to change member role: ${JSON.stringify(error)}`);
+    }
+
+    return data as unknown as GroupDocument;
+};
+
+/**
+ * Create an invite link code (owner or admin). Defaults to three days.
+ */
+export const createGroupInvite = async (groupId: string, expiresInHours?: number): Promise<GroupInvite> => {
+    const { data, error } = await client.POST("/v1/user/groups/{id}/invites", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId },
+        },
+        body: { expiresInHours },
+    });
+
+    if (error) {
+        throw new Error(`Failed to create invite: ${JSON.stringify(error)}`);
+    }
+
+    return data as unknown as GroupInvite;
+};
+
+/**
+ * Get the group's unexpired invite codes (owner or admin)
+ */
+export const getGroupInvites = async (groupId: string): Promise<GroupInvite[]> => {
+    const { data, error } = await client.GET("/v1/user/groups/{id}/invites", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId },
+        },
+    });
+
+    if (error) {
+        throw new Error(`Failed to fetch invites: ${JSON.stringify(error)}`);
+    }
+
+    return data?.invites || [];
+};
+
+/**
+ * Revoke an invite code (owner or admin)
+ */
+export const revokeGroupInvite = async (groupId: string, code: string): Promise<void> => {
+    const { error } = await client.DELETE("/v1/user/groups/{id}/invites/{code}", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId, code },
+        },
+    });
+
+    if (error) {
+        throw new Error(`Failed to revoke invite: ${JSON.stringify(error)}`);
+    }
+};
+
+/**
+ * Join a group with an invite code. Resolves with status "requested" when the
+ * group approves new members first.
+ */
+export const joinGroup = async (code: string): Promise<JoinGroupResult> => {
+    const { data, error } = await client.POST("/v1/user/groups/join/{code}", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { code },
+        },
+    });
+
+    if (error) {
+        throw new Error(`Failed to join group: ${JSON.stringify(error)}`);
+    }
+
+    return data as unknown as JoinGroupResult;
+};
+
+/**
+ * Get pending join requests (owner or admin)
+ */
+export const getGroupJoinRequests = async (groupId: string): Promise<GroupJoinRequest[]> => {
+    const { data, error } = await client.GET("/v1/user/groups/{id}/requests", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId },
+        },
+    });
+
+    if (error) {
+        throw new Error(`Failed to fetch join requests: ${JSON.stringify(error)}`);
+    }
+
+    return data?.requests || [];
+};
+
+/**
+ * Approve a pending join request (owner or admin)
+ */
+export const approveGroupJoinRequest = async (groupId: string, userId: string): Promise<void> => {
+    const { error } = await client.POST("/v1/user/groups/{id}/requests/{userId}", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId, userId },
+        },
+    });
+
+    if (error) {
+        throw new Error(`Failed to approve join request: ${JSON.stringify(error)}`);
+    }
+};
+
+/**
+ * Deny a pending join request (owner or admin)
+ */
+export const denyGroupJoinRequest = async (groupId: string, userId: string): Promise<void> => {
+    const { error } = await client.DELETE("/v1/user/groups/{id}/requests/{userId}", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId, userId },
+        },
+    });
+
+    if (error) {
+        throw new Error(`Failed to deny join request: ${JSON.stringify(error)}`);
+    }
+};
+
+/**
+ * Get the current user's notification settings for a group
+ */
+export const getGroupNotificationSettings = async (groupId: string): Promise<GroupNotificationSettings> => {
+    const { data, error } = await client.GET("/v1/user/groups/{id}/notifications", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId },
+        },
+    });
+
+    if (error) {
+        throw new Error(`Failed to fetch group notification settings: ${JSON.stringify(error)}`);
+    }
+
+    return data as unknown as GroupNotificationSettings;
+};
+
+/**
+ * Update the current user's notification settings for a group (partial)
+ */
+export const updateGroupNotificationSettings = async (
+    groupId: string,
+    settings: Partial<Omit<GroupNotificationSettings, "$schema">>
+): Promise<GroupNotificationSettings> => {
+    const { data, error } = await client.PATCH("/v1/user/groups/{id}/notifications", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId },
+        },
+        body: settings,
+    });
+
+    if (error) {
+        throw new Error(`Failed to update group notification settings: ${JSON.stringify(error)}`);
+    }
+
+    return data as unknown as GroupNotificationSettings;
+};
+
+/**
+ * Get a page of the group's activity stream, newest first. Pass the previous
+ * page's nextBefore to load older activity.
+ */
+export const getGroupActivity = async (
+    groupId: string,
+    before?: string,
+    limit: number = 20
+): Promise<{ items: GroupActivityItem[]; nextBefore?: string }> => {
+    const { data, error } = await client.GET("/v1/user/groups/{id}/activity", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId },
+            query: { before, limit },
+        },
+    });
+
+    if (error) {
+        throw new Error(`Failed to fetch group activity: ${JSON.stringify(error)}`);
+    }
+
+    return { items: data?.items || [], nextBefore: data?.nextBefore };
+};
+
+/**
+ * Create a challenge. The goal is either a total number of completions or a
+ * flex-style target per period (owner only).
+ */
+export const createGroupChallenge = async (
+    groupId: string,
+    params: CreateChallengeParams
+): Promise<GroupChallenge> => {
+    const { data, error } = await client.POST("/v1/user/groups/{id}/challenges", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId },
+        },
+        body: params,
+    });
+
+    if (error) {
+        throw new Error(`Failed to create challenge: ${JSON.stringify(error)}`);
+    }
+
+    return data as unknown as GroupChallenge;
+};
+
+/**
+ * Get the group's challenges with their leaderboards, newest first.
+ */
+export const getGroupChallenges = async (groupId: string): Promise<GroupChallenge[]> => {
+    const { data, error } = await client.GET("/v1/user/groups/{id}/challenges", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId },
+        },
+    });
+
+    if (error) {
+        throw new Error(`Failed to fetch challenges: ${JSON.stringify(error)}`);
+    }
+
+    return data?.challenges || [];
+};
+
+export const getGroupChallenge = async (groupId: string, challengeId: string): Promise<GroupChallenge> => {
+    const { data, error } = await client.GET("/v1/user/groups/{id}/challenges/{challengeId}", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId, challengeId },
+        },
+    });
+
+    if (error) {
+        throw new Error(`Failed to fetch challenge: ${JSON.stringify(error)}`);
+    }
+
+    return data as unknown as GroupChallenge;
+};
+
+/**
+ * Delete a challenge and its task from every participant's workspace (owner only).
+ */
+export const deleteGroupChallenge = async (groupId: string, challengeId: string): Promise<void> => {
+    const { error } = await client.DELETE("/v1/user/groups/{id}/challenges/{challengeId}", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId, challengeId },
+        },
+    });
+
+    if (error) {
+        throw new Error(`Failed to delete challenge: ${JSON.stringify(error)}`);
+    }
+};
+
+/**
+ * Opt into a challenge. Its task shows up in a workspace named after the group.
+ */
+export const joinGroupChallenge = async (groupId: string, challengeId: string): Promise<GroupChallenge> => {
+    const { data, error } = await client.POST("/v1/user/groups/{id}/challenges/{challengeId}/participants", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId, challengeId },
+        },
+    });
+
+    if (error) {
+        throw new Error(`Failed to join challenge: ${JSON.stringify(error)}`);
+    }
+
+    return data as unknown as GroupChallenge;
+};
+
+/**
+ * Leave a challenge and remove its task from the caller's workspace.
+ */
+export const leaveGroupChallenge = async (groupId: string, challengeId: string): Promise<void> => {
+    const { error } = await client.DELETE("/v1/user/groups/{id}/challenges/{challengeId}/participants", {
+        params: {
+            ...withAuthHeaders({}),
+            path: { id: groupId, challengeId },
+        },
+    });
+
+    if (error) {
+        throw new Error(`Failed to leave challenge: ${JSON.stringify(error)}`);
+    }
+};
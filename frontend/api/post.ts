@@ -12,9 +12,13 @@ type PostDocumentAPI = components["schemas"]["PostDocumentAPI"];
 type CreatePostParams = components["schemas"]["CreatePostParams"];
 type CommentDocument = components["schemas"]["CommentDocument"];
 type CommentDocumentAPI = components["schemas"]["CommentDocumentAPI"];
+type DraftContent = components["schemas"]["DraftContent"];
+type PostDraft = components["schemas"]["PostDraft"];
+type AudienceInput = components["schemas"]["AudienceInput"];
 
 // Export Post type for use in other files
 export type Post = PostDocumentAPI;
+export type { DraftContent, PostDraft, AudienceInput };
 
 /**
  * Create a new post
@@ -26,6 +30,7 @@ export type Post = PostDocumentAPI;
  * @param size
  * @param groups - Array of group IDs to share the post with
  * @param dual - Optional front-facing camera image URL for dual camera posts
+ * @param audience - Who can see the post; omitted, the server uses groups, then the user's default
  */
 export const createPost = async (
     images: string[],
@@ -39,6 +44,7 @@ export const createPost = async (
     taggedUsers?: Array<{ id: string; handle: string }>,
     media?: import("./media").MediaItem[],
     song?: components["schemas"]["Song"],
+    audience?: AudienceInput,
 ): Promise<{
     post: PostDocumentAPI;
     userStats: { posts_made: number; points: number } | null;
@@ -58,6 +64,7 @@ export const createPost = async (
             dual,
             taggedUsers,
             song,
+            audience,
         },
     });
 
@@ -111,15 +118,24 @@ export const getAllPosts = async (limit: number = 8, offset: number = 0): Promis
     };
 };
 
+/**
+ * Response type for cursor-paginated posts
+ */
+export interface CursorPostsResponse {
+    posts: PostDocumentAPI[];
+    hasMore: boolean;
+    nextCursor?: string;
+}
+
 /**
  * Get friends posts (chronologically ordered) with pagination
  * @param limit - Number of posts to return (default: 8)
- * @param offset - Number of posts to skip (default: 0)
+ * @param cursor - nextCursor from the previous page; omit for the first page
  */
-export const getFriendsPosts = async (limit: number = 8, offset: number = 0): Promise<PaginatedPostsResponse> => {
+export const getFriendsPosts = async (limit: number = 8, cursor?: string): Promise<CursorPostsResponse> => {
     const { data, error } = await client.GET("/v1/user/posts/friends", {
         params: withAuthHeaders({
-            query: { limit, offset }
+            query: { limit, cursor }
         }),
     });
 
@@ -127,22 +143,17 @@ export const getFriendsPosts = async (limit: number = 8, offset: number = 0): Pr
         throw new Error(`Failed to get friends posts: ${JSON.stringify(error)}`);
     }
 
-    // @ts-ignore - OpenAPI types not yet regenerated with pagination fields
     return {
         posts: data?.posts || [],
-        // @ts-ignore
-        total: data?.total || 0,
-        // @ts-ignore
         hasMore: data?.hasMore || false,
-        // @ts-ignore
-        nextOffset: data?.nextOffset || 0,
+        nextCursor: data?.nextCursor,
     };
 };
 
 /**
  * Get unified feed (posts and activities from friends)
  * @param limit - Number of feed items to return (default: 20)
- * @param offset - Number of feed items to skip (default: 0)
+ * @param cursor - nextCursor from the previous page; omit for the first page
  */
 export interface FeedTask {
     id: string;
@@ -160,6 +171,7 @@ export interface FeedTask {
         display_name: string;
         profile_picture: string;
     };
+    completedAt?: string;
 }
 
 export interface FeedRingsClosed {
@@ -183,16 +195,22 @@ export interface FeedItem {
 
 export interface PaginatedFeedResponse {
     items: FeedItem[];
-    total: number;
     hasMore: boolean;
-    nextOffset: number;
+    nextCursor?: string;
 }
 
-export const getFeed = async (limit: number = 20, offset: number = 0): Promise<PaginatedFeedResponse> => {
-    // @ts-ignore - New endpoint not yet in OpenAPI spec
+// "ranked" orders a session's newest items by friendship, engagement and
+// what you've already seen; the mode sticks to the cursor after page one.
+export type FeedMode = "chronological" | "ranked";
+
+export const getFeed = async (
+    limit: number = 20,
+    cursor?: string,
+    mode: FeedMode = "chronological"
+): Promise<PaginatedFeedResponse> => {
     const { data, error } = await client.GET("/v1/user/feed", {
         params: withAuthHeaders({
-            query: { limit, offset }
+            query: { limit, cursor, mode }
         }),
     });
 
@@ -200,19 +218,29 @@ export const getFeed = async (limit: number = 20, offset: number = 0): Promise<P
         throw new Error(`Failed to get feed: ${JSON.stringify(error)}`);
     }
 
-    // @ts-ignore - OpenAPI types not yet regenerated
     return {
-        // @ts-ignore
+        // @ts-ignore - generated FeedItem post shape differs from Post
         items: data?.items || [],
-        // @ts-ignore
-        total: data?.total || 0,
-        // @ts-ignore
         hasMore: data?.hasMore || false,
-        // @ts-ignore
-        nextOffset: data?.nextOffset || 0,
+        nextCursor: data?.nextCursor,
     };
 };
 
+/**
+ * Record feed items the user has seen, so the ranked feed can demote them
+ * @param items - IDs of posts, ring closures or tasks (at most 100)
+ */
+export const recordFeedImpressions = async (items: string[]): Promise<void> => {
+    const { error } = await client.POST("/v1/user/feed/impressions", {
+        params: withAuthHeaders({}),
+        body: { items },
+    });
+
+    if (error) {
+        throw new Error(`Failed to record feed impressions: ${JSON.stringify(error)}`);
+    }
+};
+
 /**
  * Get post by ID
  * @param postId
@@ -327,6 +355,108 @@ export const deleteComment = async (postId: string, commentId: string): Promise<
     }
 };
 
+/**
+ * Edit your own comment
+ * @param postId
+ * @param commentId
+ * @param content
+ * @param mentions
+ */
+export const editComment = async (
+    postId: string,
+    commentId: string,
+    content: string,
+    mentions?: Array<{ id: string; handle: string }>
+): Promise<CommentDocumentAPI> => {
+    const { data, error } = await client.PATCH("/v1/user/posts/{postId}/comment/{commentId}", {
+        params: withAuthHeaders({ path: { postId, commentId } }),
+        body: {
+            content,
+            mentions,
+        },
+    });
+
+    if (error) {
+        throw new Error(`Failed to edit comment: ${JSON.stringify(error)}`);
+    }
+
+    return data.comment;
+};
+
+/**
+ * Approve a pending comment a restricted user left on your post
+ * @param postId
+ * @param commentId
+ */
+export const approveComment = async (postId: string, commentId: string): Promise<CommentDocumentAPI> => {
+    const { data, error } = await client.POST("/v1/user/posts/{postId}/comment/{commentId}/approve", {
+        params: withAuthHeaders({ path: { postId, commentId } }),
+    });
+
+    if (error) {
+        throw new Error(`Failed to approve comment: ${JSON.stringify(error)}`);
+    }
+
+    return data.comment;
+};
+
+/**
+ * Get one page of comments: root comments newest first, or the replies in
+ * one thread oldest first when threadId is given
+ * @param postId
+ * @param threadId - root comment whose replies to load
+ * @param cursor - nextCursor from the previous page
+ * @param limit
+ */
+export const getComments = async (
+    postId: string,
+    threadId?: string,
+    cursor?: string,
+    limit: number = 20
+): Promise<{ comments: CommentDocumentAPI[]; nextCursor?: string; hasMore: boolean }> => {
+    const { data, error } = await client.GET("/v1/user/posts/{postId}/comments", {
+        params: withAuthHeaders({
+            path: { postId },
+            query: { thread: threadId, cursor, limit },
+        }),
+    });
+
+    if (error) {
+        throw new Error(`Failed to get comments: ${JSON.stringify(error)}`);
+    }
+
+    return {
+        comments: data.comments || [],
+        nextCursor: data.nextCursor,
+        hasMore: data.hasMore,
+    };
+};
+
+/**
+ * Toggle reaction on a comment
+ * @param postId
+ * @param commentId
+ * @param emoji
+ */
+export const toggleCommentReaction = async (
+    postId: string,
+    commentId: string,
+    emoji: string
+): Promise<{ added: boolean; message: string }> => {
+    const { data, error } = await client.POST("/v1/user/posts/{postId}/comment/{commentId}/reaction", {
+        params: withAuthHeaders({ path: { postId, commentId } }),
+        body: {
+            emoji: emoji.trim(),
+        },
+    });
+
+    if (error) {
+        throw new Error(`Failed to toggle comment reaction: ${JSON.stringify(error)}`);
+    }
+
+    return data;
+};
+
 /**
  * Toggle reaction on post
  * @param postId
@@ -453,6 +583,161 @@ export const updatePost = async (
     }
 };
 
+/**
+ * Change who can see a post
+ * @param postId
+ * @param audience - viewers who lose access stop seeing the post everywhere
+ */
+export const updatePostAudience = async (postId: string, audience: AudienceInput): Promise<void> => {
+    const { error } = await client.PATCH("/v1/user/posts/{id}", {
+        params: withAuthHeaders({ path: { id: postId } }),
+        body: { audience },
+    });
+
+    if (error) {
+        throw new Error(`Failed to update post audience: ${JSON.stringify(error)}`);
+    }
+};
+
+
+/**
+ * Save a new draft
+ * @param content - the post-to-be; only the caption is needed until it is scheduled or published
+ */
+export const createDraft = async (content: DraftContent): Promise<PostDraft> => {
+    const { data, error } = await client.POST("/v1/user/drafts", {
+        params: withAuthHeaders({}),
+        body: content,
+    });
+
+    if (error) {
+        throw new Error(`Failed to save draft: ${JSON.stringify(error)}`);
+    }
+
+    return data;
+};
+
+/**
+ * Get unpublished and scheduled drafts, most recently edited first
+ */
+export const getDrafts = async (): Promise<PostDraft[]> => {
+    const { data, error } = await client.GET("/v1/user/drafts", {
+        params: withAuthHeaders({}),
+    });
+
+    if (error) {
+        throw new Error(`Failed to get drafts: ${JSON.stringify(error)}`);
+    }
+
+    return data.drafts || [];
+};
+
+/**
+ * Get a single draft
+ * @param draftId
+ */
+export const getDraft = async (draftId: string): Promise<PostDraft> => {
+    const { data, error } = await client.GET("/v1/user/drafts/{id}", {
+        params: withAuthHeaders({ path: { id: draftId } }),
+    });
+
+    if (error) {
+        throw new Error(`Failed to get draft: ${JSON.stringify(error)}`);
+    }
+
+    return data;
+};
+
+/**
+ * Replace a draft's content
+ * @param draftId
+ * @param content
+ */
+export const updateDraft = async (draftId: string, content: DraftContent): Promise<PostDraft> => {
+    const { data, error } = await client.PUT("/v1/user/drafts/{id}", {
+        params: withAuthHeaders({ path: { id: draftId } }),
+        body: content,
+    });
+
+    if (error) {
+        throw new Error(`Failed to update draft: ${JSON.stringify(error)}`);
+    }
+
+    return data;
+};
+
+/**
+ * Discard a draft
+ * @param draftId
+ */
+export const deleteDraft = async (draftId: string): Promise<void> => {
+    const { error } = await client.DELETE("/v1/user/drafts/{id}", {
+        params: withAuthHeaders({ path: { id: draftId } }),
+    });
+
+    if (error) {
+        throw new Error(`Failed to delete draft: ${JSON.stringify(error)}`);
+    }
+};
+
+/**
+ * Publish a draft automatically at the given time
+ * @param draftId
+ * @param publishAt - must be in the future and within 30 days
+ */
+export const scheduleDraft = async (draftId: string, publishAt: Date): Promise<PostDraft> => {
+    const { data, error } = await client.PUT("/v1/user/drafts/{id}/schedule", {
+        params: withAuthHeaders({ path: { id: draftId } }),
+        body: { publishAt: publishAt.toISOString() },
+    });
+
+    if (error) {
+        throw new Error(`Failed to schedule draft: ${JSON.stringify(error)}`);
+    }
+
+    return data;
+};
+
+/**
+ * Cancel a draft's scheduled publish, keeping the draft
+ * @param draftId
+ */
+export const unscheduleDraft = async (draftId: string): Promise<PostDraft> => {
+    const { data, error } = await client.DELETE("/v1/user/drafts/{id}/schedule", {
+        params: withAuthHeaders({ path: { id: draftId } }),
+    });
+
+    if (error) {
+        throw new Error(`Failed to unschedule draft: ${JSON.stringify(error)}`);
+    }
+
+    return data;
+};
+
+/**
+ * Publish a draft now
+ * @param draftId
+ */
+export const publishDraft = async (draftId: string): Promise<{
+    post: PostDocumentAPI;
+    userStats: { posts_made: number; points: number } | null;
+    ringDelta?: RingDelta;
+}> => {
+    const { data, error } = await client.POST("/v1/user/drafts/{id}/publish", {
+        params: withAuthHeaders({ path: { id: draftId } }),
+    });
+
+    if (error) {
+        throw new Error(`Failed to publish draft: ${JSON.stringify(error)}`);
+    }
+
+    const body = (data as any).body || data;
+    return {
+        post: body,
+        userStats: (data as any).user_stats || null,
+        ringDelta: body?.ringDelta,
+    };
+};
 
 export const createPostToBackend = async (
     images: string[],
@@ -466,13 +751,14 @@ export const createPostToBackend = async (
     taggedUsers?: Array<{ id: string; handle: string }>,
     media?: import("./media").MediaItem[],
     song?: components["schemas"]["Song"],
+    audience?: AudienceInput,
 ): Promise<{
     post: PostDocumentAPI;
     userStats: { posts_made: number; points: number } | null;
     ringDelta?: RingDelta;
 }> => {
     try {
-        const result = await createPost(images, caption, taskReference, blueprintId, isPublic, size, groups, dual, taggedUsers, media, song);
+        const result = await createPost(images, caption, taskReference, blueprintId, isPublic, size, groups, dual, taggedUsers, media, song, audience);
         return result;
     } catch (error) {
         logger.error("Failed to create post to backend", error);
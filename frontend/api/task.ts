@@ -902,10 +902,11 @@ export const moveTaskAPI = async (
 export const updateTaskTagsAPI = async (
     categoryId: string,
     taskId: string,
-    taggedUserIds: string[]
+    taggedUserIds: string[],
+    taggedListIds?: string[]
 ): Promise<{ taggedUsers: TaggedTaskUser[] }> => {
     try {
-        return await request("PATCH", `/user/tasks/${categoryId}/${taskId}/tags`, { taggedUserIds });
+        return await request("PATCH", `/user/tasks/${categoryId}/${taskId}/tags`, { taggedUserIds, taggedListIds });
     } catch (error) {
         logger.error("Error updating task tags", error);
         throw new Error("Failed to update task tags. Please try again later.");
@@ -34,7 +34,15 @@ import {
     FlatList,
 } from "react-native";
 import { FlashList } from "@shopify/flash-list";
-import { getAllPosts, getFriendsPosts, getPostsByBlueprint, getFeed, type FeedItem } from "@/api/post";
+import {
+    getAllPosts,
+    getFriendsPosts,
+    getPostsByBlueprint,
+    getFeed,
+    recordFeedImpressions,
+    type FeedItem,
+    type FeedMode,
+} from "@/api/post";
 import { getUserSubscribedBlueprints } from "@/api/blueprint";
 import { showToast } from "@/utils/showToast";
 import NotificationBadge from "@/components/NotificationBadge";
@@ -122,7 +130,7 @@ export default function Feed() {
     const [loading, setLoading] = useState(true);
     const [initialLoading, setInitialLoading] = useState(true);
     const [lastUpdated, setLastUpdated] = useState<Date | null>(null);
-    const [sortOrder, setSortOrder] = useState<"newest" | "oldest">("newest");
+    const [feedMode] = useState<FeedMode>("ranked");
 
     // Hidden/blocked state for immediate UX feedback
     const [hiddenPostIds, setHiddenPostIds] = useState<Set<string>>(new Set());
@@ -130,6 +138,7 @@ export default function Feed() {
 
     // Pagination state
     const [offset, setOffset] = useState(0);
+    const [cursor, setCursor] = useState<string | undefined>(undefined);
     const [hasMore, setHasMore] = useState(true);
     const [loadingMore, setLoadingMore] = useState(false);
 
@@ -211,17 +220,18 @@ export default function Feed() {
 
                 if (currentFeedId === "feed") {
                     // Use the new unified feed endpoint
-                    const feedResult = await getFeed(20, 0);
+                    const feedResult = await getFeed(20, undefined, feedMode);
                     setFeedItems(feedResult.items);
                     setPosts([]); // Clear posts state — feed tab uses feedItems exclusively
-                    setOffset(feedResult.nextOffset);
+                    setOffset(feedResult.items.length);
+                    setCursor(feedResult.nextCursor);
                     setHasMore(feedResult.hasMore);
                     setLastUpdated(new Date());
                     setLoading(false);
                     setInitialLoading(false);
                     return;
                 } else if (currentFeedId === "friends") {
-                    result = await getFriendsPosts(8, 0);
+                    result = await getFriendsPosts(8);
                 } else if (currentFeedId.startsWith("blueprint-")) {
                     // Extract blueprint ID from feed ID
                     const blueprintId = currentFeedId.replace("blueprint-", "");
@@ -246,7 +256,8 @@ export default function Feed() {
 
                 setPosts(result.posts);
                 setFeedItems([]); // Clear feed items for non-feed views
-                setOffset(result.nextOffset);
+                setOffset("nextOffset" in result ? result.nextOffset : result.posts.length);
+                setCursor("nextCursor" in result ? result.nextCursor : undefined);
                 setHasMore(result.hasMore);
                 setLastUpdated(new Date());
             } catch (error) {
@@ -260,7 +271,7 @@ export default function Feed() {
                 setInitialLoading(false);
             }
         },
-        [currentFeed.id, currentFeed.name]
+        [currentFeed.id, currentFeed.name, feedMode]
     );
 
     // Load more posts when scrolling to the end
@@ -275,10 +286,11 @@ export default function Feed() {
             let result;
 
             if (currentFeedId === "feed") {
-                const feedResult = await getFeed(20, offset);
+                const feedResult = await getFeed(20, cursor);
                 // Append new feed items
                 setFeedItems((prev) => [...prev, ...feedResult.items]);
-                setOffset(feedResult.nextOffset);
+                setOffset(offset + feedResult.items.length);
+                setCursor(feedResult.nextCursor);
                 setHasMore(feedResult.hasMore);
                 setLoadingMore(false);
                 capture(AnalyticsEvents.FEED_SCROLLED, {
@@ -286,7 +298,7 @@ export default function Feed() {
                 });
                 return;
             } else if (currentFeedId === "friends") {
-                result = await getFriendsPosts(8, offset);
+                result = await getFriendsPosts(8, cursor);
             } else if (currentFeedId.startsWith("blueprint-")) {
                 // Blueprint posts don't support pagination
                 setLoadingMore(false);
@@ -301,7 +313,8 @@ export default function Feed() {
 
             // Append new posts to existing posts
             setPosts((prevPosts) => [...prevPosts, ...result.posts]);
-            setOffset(result.nextOffset);
+            setOffset("nextOffset" in result ? result.nextOffset : offset + result.posts.length);
+            setCursor("nextCursor" in result ? result.nextCursor : undefined);
             setHasMore(result.hasMore);
             capture(AnalyticsEvents.FEED_SCROLLED, {
                 page: offset,
@@ -312,7 +325,7 @@ export default function Feed() {
         } finally {
             setLoadingMore(false);
         }
-    }, [loadingMore, hasMore, loading, currentFeed.id, offset, capture]);
+    }, [loadingMore, hasMore, loading, currentFeed.id, offset, cursor, capture]);
 
     // Callbacks for hiding posts and blocking users from feed
     const handleHidePost = useCallback((postId: string) => {
@@ -511,6 +524,7 @@ export default function Feed() {
                         priority={task.priority}
                         value={task.value}
                         user={task.user}
+                        completedAt={task.completedAt}
                     />
                 );
             } else if (item.type === "rings_closed" && item.ringsClosed) {
@@ -551,6 +565,7 @@ export default function Feed() {
                         time={postTime}
                         reactions={postReactions}
                         comments={post.comments}
+                        commentCount={post.commentCount}
                         kudos={post.kudos}
                         category={post.task?.category?.name}
                         taskName={post.task?.content}
@@ -593,6 +608,7 @@ export default function Feed() {
                     taskStatus={post.task?.status}
                     reactions={postReactions}
                     comments={post.comments || []}
+                    commentCount={post.commentCount}
                     kudos={post.kudos}
                     images={post.images || []}
                     media={post.media}
@@ -608,12 +624,40 @@ export default function Feed() {
         [refreshSinglePost, calculatePostTime, transformReactions, hiddenPostIds, handleHidePost, handleBlockUser, handleDismissHidden]
     );
 
+    // Feed items seen on screen, batched up for the ranked feed's impressions.
+    const seenItemIds = useRef(new Set<string>());
+    const pendingImpressions = useRef<string[]>([]);
+    const flushImpressions = useRef(() => {
+        const batch = pendingImpressions.current.splice(0, 100);
+        if (batch.length > 0) {
+            recordFeedImpressions(batch).catch((error) => console.warn("Failed to record impressions:", error));
+        }
+    }).current;
+    useEffect(() => {
+        const timer = setInterval(flushImpressions, 10000);
+        return () => {
+            clearInterval(timer);
+            flushImpressions();
+        };
+    }, [flushImpressions]);
+
     // Track the primary on-screen post so its tagged song can autoplay.
     const viewabilityConfig = useRef({ itemVisiblePercentThreshold: 60 }).current;
     const onViewableItemsChanged = useRef(({ viewableItems }: { viewableItems: Array<{ item: any }> }) => {
         const firstPost = viewableItems.find((v) => v.item && (v.item._id || (v.item.type === "post" && v.item.post)));
         const it: any = firstPost?.item;
         feedActivePost.set(it?._id ?? it?.post?._id ?? null);
+
+        for (const { item } of viewableItems) {
+            const id: string | undefined = item?.post?._id ?? item?.ringsClosed?.id ?? item?.task?.id;
+            if (id && !seenItemIds.current.has(id)) {
+                seenItemIds.current.add(id);
+                pendingImpressions.current.push(id);
+            }
+        }
+        if (pendingImpressions.current.length >= 20) {
+            flushImpressions();
+        }
     }).current;
 
     const renderHeader = useCallback(() => {
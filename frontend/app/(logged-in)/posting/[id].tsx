@@ -172,6 +172,7 @@ export default function PostDetail() {
                             : []
                     }
                     comments={post.comments || []}
+                    commentCount={post.commentCount}
                     kudos={post.kudos}
                     song={post.song}
                     images={post.images || []}
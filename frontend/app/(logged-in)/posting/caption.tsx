@@ -9,7 +9,7 @@ import PrimaryButton from "@/components/inputs/PrimaryButton";
 import MentionTextInput from "@/components/inputs/MentionTextInput";
 import TaggedUsersChips, { TaggedUser } from "@/components/inputs/TaggedUsersChips";
 import { formatHandle } from "@/utils/handle";
-import { createPostToBackend } from "@/api/post";
+import { createPostToBackend, createDraft, scheduleDraft, DraftContent } from "@/api/post";
 import { uploadImageSmart, uploadVideo, ImageUploadResult } from "@/api/upload";
 import type { MediaItem } from "@/api/media";
 import { ObjectId } from "bson";
@@ -29,6 +29,7 @@ import { MusicNote, CaretRight } from "phosphor-react-native";
 import { getEncouragementsByTask } from "@/api/encouragement";
 import type { MentionCandidate } from "@/hooks/useFriendsForMention";
 import type { Href } from "expo-router";
+import DateTimePicker from "@react-native-community/datetimepicker";
 
 export default function Caption() {
     const insets = useSafeAreaInsets();
@@ -40,6 +41,9 @@ export default function Caption() {
     const dualPhoto = params.dualPhoto ? (params.dualPhoto as string) : null;
     const [data, setData] = useState({ caption: "" });
     const [isPosting, setIsPosting] = useState(false);
+    const [isSavingDraft, setIsSavingDraft] = useState(false);
+    const [scheduling, setScheduling] = useState(false);
+    const [publishAt, setPublishAt] = useState(() => new Date(Date.now() + 60 * 60 * 1000));
     const [songPickerOpen, setSongPickerOpen] = useState(false);
     const taskInfo = params.taskInfo ? JSON.parse(params.taskInfo as string) : null;
 
@@ -151,46 +155,65 @@ export default function Caption() {
 
         return { media, sizeInfo, dualUrl };
     };
+    const showError = (message: string) => {
+        setAlertTitle("Error");
+        setAlertMessage(message);
+        setAlertButtons([{ text: "OK", style: "default" }]);
+        setAlertVisible(true);
+    };
+
+    // Uploads the picked media and assembles everything the post will carry, so
+    // posting now and saving a draft send the same content.
+    const preparePost = async () => {
+        const uploadResult = await uploadMedia(hasActualPhotos ? photos : [], mediaTypesByUri, dualPhoto);
+        const taskReference = taskInfo
+            ? {
+                  id: taskInfo.id,
+                  content: taskInfo.name,
+                  category: {
+                      id: taskInfo.category,
+                      name: taskInfo.categoryName || "Unknown Category",
+                  },
+                  status: (taskInfo.status as "completed" | "in_progress") || "completed",
+              }
+            : undefined;
+
+        return {
+            images: uploadResult.media.filter((m) => m.type === "image").map((m) => m.url),
+            media: uploadResult.media,
+            size: uploadResult.sizeInfo,
+            dual: uploadResult.dualUrl,
+            task: taskReference,
+            groups: getGroupIds(),
+            isPublic: taskInfo?.public ?? false,
+            taggedUsers: taggedUsers.map((u) => ({ id: u.id, handle: u.handle })),
+            song: song ?? undefined,
+        };
+    };
+
     const handlePost = async () => {
         if (!data.caption.trim()) {
-            setAlertTitle("Error");
-            setAlertMessage("Please add a caption");
-            setAlertButtons([{ text: "OK", style: "default" }]);
-            setAlertVisible(true);
+            showError("Please add a caption");
             return;
         }
 
         setIsPosting(true);
 
         try {
-            const uploadResult = await uploadMedia(hasActualPhotos ? photos : [], mediaTypesByUri, dualPhoto);
-            const imageUrls = uploadResult.media.filter((m) => m.type === "image").map((m) => m.url);
-            const taskReference = taskInfo
-                ? {
-                      id: taskInfo.id,
-                      content: taskInfo.name,
-                      category: {
-                          id: taskInfo.category,
-                          name: taskInfo.categoryName || "Unknown Category",
-                      },
-                      status: (taskInfo.status as "completed" | "in_progress") || "completed",
-                  }
-                : undefined;
-
-            const selectedGroups = getGroupIds();
+            const prepared = await preparePost();
 
             const result = await createPostToBackend(
-                imageUrls,
+                prepared.images,
                 data.caption,
-                taskReference,
+                prepared.task,
                 undefined,
-                taskInfo?.public ?? false,
-                uploadResult.sizeInfo,
-                selectedGroups,
-                uploadResult.dualUrl,
-                taggedUsers.map((u) => ({ id: u.id, handle: u.handle })),
-                uploadResult.media,
-                song ?? undefined,
+                prepared.isPublic,
+                prepared.size,
+                prepared.groups,
+                prepared.dual,
+                prepared.taggedUsers,
+                prepared.media,
+                prepared.song,
             );
 
             // Update user stats locally if available
@@ -219,15 +242,51 @@ export default function Caption() {
                 errorMessage = error.message;
             }
 
-            setAlertTitle("Error");
-            setAlertMessage(errorMessage);
-            setAlertButtons([{ text: "OK", style: "default" }]);
-            setAlertVisible(true);
+            showError(errorMessage);
         } finally {
             setIsPosting(false);
         }
     };
 
+    // Saves the post as a draft, scheduling it when a publish time is given. The
+    // server publishes scheduled drafts, so the app doesn't need to be open.
+    const handleSaveDraft = async (scheduleAt?: Date) => {
+        if (scheduleAt && !data.caption.trim()) {
+            showError("Please add a caption");
+            return;
+        }
+
+        setIsSavingDraft(true);
+
+        try {
+            const prepared = await preparePost();
+            const content: DraftContent = { ...prepared, caption: data.caption };
+
+            const draft = await createDraft(content);
+            if (scheduleAt) {
+                await scheduleDraft(draft.id, scheduleAt);
+            }
+
+            capture(AnalyticsEvents.POST_DRAFT_SAVED, {
+                scheduled: !!scheduleAt,
+            });
+
+            setAlertTitle(scheduleAt ? "Post scheduled" : "Draft saved");
+            setAlertMessage(
+                scheduleAt
+                    ? `Your post will go out ${scheduleAt.toLocaleString()}.`
+                    : "You can finish this post later from any device."
+            );
+            setAlertButtons([{ text: "OK", style: "default", onPress: () => router.dismissAll() }]);
+            setAlertVisible(true);
+        } catch (error) {
+            console.error("Error saving draft:", error);
+            showError(error instanceof Error ? error.message : "Failed to save draft. Please try again.");
+        } finally {
+            setIsSavingDraft(false);
+        }
+    };
+
     return (
         <ThemedView style={{ flex: 1 }}>
             <KeyboardAvoidingView
@@ -400,11 +459,49 @@ export default function Caption() {
                                 <Ionicons name="chevron-forward" size={16} color={ThemedColor.primary} />
                             </View>
                         </TouchableOpacity>
+                        {scheduling && (
+                            <View style={{ alignItems: "center", gap: 8 }}>
+                                <DateTimePicker
+                                    value={publishAt}
+                                    minimumDate={new Date()}
+                                    maximumDate={new Date(Date.now() + 30 * 24 * 60 * 60 * 1000)}
+                                    onChange={(event, selectedDate) => {
+                                        if (selectedDate) {
+                                            setPublishAt(selectedDate);
+                                        }
+                                    }}
+                                    testID="schedule-post-picker"
+                                    mode="datetime"
+                                />
+                                <PrimaryButton
+                                    title={isSavingDraft ? "Scheduling..." : `Schedule for ${publishAt.toLocaleString()}`}
+                                    onPress={() => handleSaveDraft(publishAt)}
+                                    disabled={isSavingDraft || isPosting}
+                                    secondary
+                                />
+                            </View>
+                        )}
                         <PrimaryButton
                             title={isPosting ? "Posting..." : "Post"}
                             onPress={handlePost}
-                            disabled={isPosting}
+                            disabled={isPosting || isSavingDraft}
                         />
+                        <View style={{ flexDirection: "row", gap: 12 }}>
+                            <PrimaryButton
+                                title={isSavingDraft && !scheduling ? "Saving..." : "Save draft"}
+                                onPress={() => handleSaveDraft()}
+                                disabled={isPosting || isSavingDraft}
+                                style={{ flex: 1 }}
+                                ghost
+                            />
+                            <PrimaryButton
+                                title={scheduling ? "Cancel schedule" : "Schedule"}
+                                onPress={() => setScheduling((prev) => !prev)}
+                                disabled={isPosting || isSavingDraft}
+                                style={{ flex: 1 }}
+                                ghost
+                            />
+                        </View>
                     </View>
                 </ScrollView>
             </KeyboardAvoidingView>
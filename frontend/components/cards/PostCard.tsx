@@ -65,6 +65,8 @@ type Props = {
     dual?: string;
     id?: string;
     comments?: CommentProps[];
+    // Total comments on the post; `comments` is only the latest few.
+    commentCount?: number;
     kudos?: PostKudos[];
     category?: string;
     taskName?: string;
@@ -93,6 +95,7 @@ const PostCard = React.memo(({
     media,
     dual,
     comments,
+    commentCount,
     kudos = [],
     category,
     taskName,
@@ -108,6 +111,7 @@ const PostCard = React.memo(({
     const [modalIndex, setModalIndex] = useState(0);
     const [congratulateModalVisible, setCongratulateModalVisible] = useState(false);
     const [currentComments, setCurrentComments] = useState(comments || []);
+    const [currentCommentCount, setCurrentCommentCount] = useState(commentCount ?? comments?.length ?? 0);
     const [localReactions, setLocalReactions] = useState<SlackReaction[]>(reactions);
     const [imageHeight, setImageHeight] = useState<number>(512);
     const [isBottomSheetOpen, setIsBottomSheetOpen] = useState(false);
@@ -170,6 +174,10 @@ const PostCard = React.memo(({
         setCurrentComments(comments || []);
     }, [comments]);
 
+    useEffect(() => {
+        setCurrentCommentCount(commentCount ?? comments?.length ?? 0);
+    }, [commentCount, comments]);
+
     // Calculate image height when images or size data changes
     useEffect(() => {
         if (hasMediaContent) {
@@ -276,6 +284,11 @@ const PostCard = React.memo(({
 
     const handleCommentAdded = (newComment: any) => {
         setCurrentComments((prevComments) => [...(prevComments || []), newComment]);
+        setCurrentCommentCount((count) => count + 1);
+    };
+
+    const handleCommentDeleted = (_commentId: string, removed: number) => {
+        setCurrentCommentCount((count) => Math.max(0, count - removed));
     };
 
     const hasUserReacted = (emoji: string): boolean => {
@@ -652,7 +665,7 @@ const PostCard = React.memo(({
                         onCongratulatePress={handleCongratulatePress}
                         onOpenComments={handleOpenComments}
                         onKudosPress={() => setCongratulatorsSheetVisible(true)}
-                        commentCount={currentComments.length}
+                        commentCount={currentCommentCount}
                         onLongPressReaction={handleLongPressReaction}
                     />
                 </View>
@@ -717,6 +730,8 @@ const PostCard = React.memo(({
                         ref={bottomSheetModalRef}
                         onClose={handleClose}
                         onCommentAdded={handleCommentAdded}
+                        onCommentDeleted={handleCommentDeleted}
+                        commentCount={currentCommentCount}
                         currentUserId={user?._id}
                         postOwnerId={userId}
                     />
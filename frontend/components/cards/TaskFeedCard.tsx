@@ -34,6 +34,8 @@ type TaskFeedCardProps = {
         display_name: string;
         profile_picture: string;
     };
+    // Set when a friend finished the task; such cards can't be encouraged.
+    completedAt?: string;
 };
 
 const TaskFeedCard = React.memo(({
@@ -45,6 +47,7 @@ const TaskFeedCard = React.memo(({
     priority,
     value,
     user,
+    completedAt,
 }: TaskFeedCardProps) => {
     const ThemedColor = useThemeColor();
     const { user: currentUser } = useAuth();
@@ -53,7 +56,7 @@ const TaskFeedCard = React.memo(({
     // Calculate time ago
     const timeAgo = useMemo(() => {
         const now = new Date();
-        const taskTime = new Date(timestamp);
+        const taskTime = new Date(completedAt || timestamp);
         const diffMs = now.getTime() - taskTime.getTime();
         const diffMinutes = Math.floor(diffMs / (1000 * 60));
         const diffHours = Math.floor(diffMs / (1000 * 60 * 60));
@@ -65,7 +68,7 @@ const TaskFeedCard = React.memo(({
         if (diffDays < 7) return `${diffDays}d`;
         const weeks = Math.floor(diffDays / 7);
         return `${weeks}w`;
-    }, [timestamp]);
+    }, [timestamp, completedAt]);
 
     const handleUserPress = useCallback(async () => {
         try {
@@ -284,24 +287,26 @@ const TaskFeedCard = React.memo(({
                 {/* Action label + Encourage */}
                 <View style={styles.footerRow}>
                     <ThemedText type="caption" style={styles.actionLabel}>
-                        Added a new task
+                        {completedAt ? "Completed a task" : "Added a new task"}
                     </ThemedText>
-                    <TouchableOpacity
-                        style={[
-                            styles.encourageButton,
-                            (!currentUser?._id || isOwnTask) && { opacity: 0.5 },
-                        ]}
-                        onPress={handleEncouragePress}
-                        disabled={!currentUser?._id || isOwnTask}>
-                        <SparkleIcon size={20} color={ThemedColor.primary} />
-                        <ThemedText style={styles.encourageText}>
-                            {!currentUser?._id
-                                ? "Login"
-                                : isOwnTask
-                                  ? "Your Task"
-                                  : "Encourage"}
-                        </ThemedText>
-                    </TouchableOpacity>
+                    {!completedAt && (
+                        <TouchableOpacity
+                            style={[
+                                styles.encourageButton,
+                                (!currentUser?._id || isOwnTask) && { opacity: 0.5 },
+                            ]}
+                            onPress={handleEncouragePress}
+                            disabled={!currentUser?._id || isOwnTask}>
+                            <SparkleIcon size={20} color={ThemedColor.primary} />
+                            <ThemedText style={styles.encourageText}>
+                                {!currentUser?._id
+                                    ? "Login"
+                                    : isOwnTask
+                                      ? "Your Task"
+                                      : "Encourage"}
+                            </ThemedText>
+                        </TouchableOpacity>
+                    )}
                 </View>
 
                 {/* Encourage Modal */}
@@ -8,7 +8,7 @@ import SendButton from "./SendButton";
 import CommentInput from "./CommentInput";
 import { useThemeColor } from "@/hooks/useThemeColor";
 import { BottomSheetView, BottomSheetModal, BottomSheetFlatList } from "@gorhom/bottom-sheet";
-import { addComment, deleteComment } from "@/api/post";
+import { addComment, deleteComment, editComment, getComments, toggleCommentReaction } from "@/api/post";
 import { showToast } from "@/utils/showToast";
 import { friendshipFeedback } from "@/utils/friendship";
 import { useQueryClient } from "@tanstack/react-query";
@@ -27,7 +27,11 @@ export type CommentProps = {
     };
     content: string;
     parentId?: string;
+    // Root comment of the thread this reply belongs to; unset on roots.
+    threadId?: string;
     mentions?: Array<{ id: string; handle: string }>;
+    reactions?: Record<string, string[]>;
+    replyCount?: number;
     metadata: {
         createdAt: string;
         isDeleted: boolean;
@@ -45,17 +49,39 @@ export type PopupProp = {
     ref: React.RefObject<BottomSheetModal>;
     onClose: () => void;
     onCommentAdded?: (comment: CommentProps) => void;
-    onCommentDeleted?: (commentId: string) => void;
+    // removed counts the comment plus any replies deleted with it
+    onCommentDeleted?: (commentId: string, removed: number) => void;
+    // Total comments on the post; `comments` is only the latest few.
+    commentCount?: number;
     currentUserId?: string;
     postOwnerId?: string;
 };
 
+const LIKE_EMOJI = "❤️";
+
+// withReplies returns commentId plus every comment replying beneath it.
+const withReplies = (commentId: string, comments: CommentProps[]): Set<string> => {
+    const removed = new Set([commentId]);
+    let grew = true;
+    while (grew) {
+        grew = false;
+        for (const c of comments) {
+            if (!removed.has(c.id) && c.parentId && removed.has(c.parentId)) {
+                removed.add(c.id);
+                grew = true;
+            }
+        }
+    }
+    return removed;
+};
+
 const Comment = ({
     comments,
     kudos,
     postId,
     onCommentAdded,
     onCommentDeleted,
+    commentCount,
     currentUserId,
     postOwnerId,
     onClose,
@@ -76,6 +102,10 @@ const Comment = ({
     const [localComments, setLocalComments] = useState<CommentProps[]>(comments || []);
     const [autoFocusInput, setAutoFocusInput] = useState(false);
     const [deletingComments, setDeletingComments] = useState<Set<string>>(new Set());
+    const [editingComment, setEditingComment] = useState<CommentProps | null>(null);
+    const [rootCursor, setRootCursor] = useState<string | undefined>(undefined);
+    const [hasMoreRoots, setHasMoreRoots] = useState(false);
+    const [loadingMore, setLoadingMore] = useState(false);
 
     // Alert state
     const [alertVisible, setAlertVisible] = useState(false);
@@ -88,12 +118,55 @@ const Comment = ({
         setLocalComments(comments || []);
     }, [comments]);
 
+    // The post only carries a preview of its latest comments, so page the
+    // real thread in from the server: roots first, then the replies of any
+    // root that has them.
+    const loadRoots = useCallback(
+        async (cursor?: string) => {
+            if (!postId) return;
+            const page = await getComments(postId, undefined, cursor);
+            const threads = await Promise.all(
+                page.comments
+                    .filter((root) => (root.replyCount ?? 0) > 0)
+                    .map((root) => getComments(postId, root.id, undefined, 50).then((t) => t.comments))
+            );
+            const loaded = [...page.comments, ...threads.flat()] as CommentProps[];
+            setLocalComments((prev) => {
+                const byId = new Map(prev.map((c) => [c.id, c]));
+                loaded.forEach((c) => byId.set(c.id, c));
+                return Array.from(byId.values());
+            });
+            setRootCursor(page.nextCursor);
+            setHasMoreRoots(page.hasMore);
+        },
+        [postId]
+    );
+
+    useEffect(() => {
+        loadRoots().catch((error) => console.error("Failed to load comments:", error));
+    }, [loadRoots]);
+
+    const handleEndReached = useCallback(async () => {
+        if (!hasMoreRoots || loadingMore) return;
+        setLoadingMore(true);
+        try {
+            await loadRoots(rootCursor);
+        } catch (error) {
+            console.error("Failed to load more comments:", error);
+        } finally {
+            setLoadingMore(false);
+        }
+    }, [hasMoreRoots, loadingMore, loadRoots, rootCursor]);
+
     // helper function to find the root parent of any comment
     const findRootParent = (commentId: string, commentsArray: CommentProps[]): string => {
         const comment = commentsArray.find((c) => c.id === commentId);
         if (!comment || !comment.parentId) {
             return commentId;
         }
+        if (comment.threadId) {
+            return comment.threadId;
+        }
         return findRootParent(comment.parentId, commentsArray);
     };
 
@@ -209,11 +282,38 @@ const Comment = ({
         return currentUserId === commentUserId || currentUserId === postOwnerId;
     };
 
+    // edits are author-only, unlike deletes which the post owner can also do
+    const canEditComment = (commentUserId: string): boolean => !!currentUserId && currentUserId === commentUserId;
+
+    const hasLiked = (comment: CommentProps): boolean =>
+        !!currentUserId && (comment.reactions?.[LIKE_EMOJI] ?? []).includes(currentUserId);
+
     // handles the submitting comment
     const handleSubmitComment = async () => {
         if (!commentText.trim()) return;
         setIsSubmitting(true);
 
+        if (editingComment) {
+            try {
+                const updated = await editComment(
+                    postId,
+                    editingComment.id,
+                    commentText.trim(),
+                    pickerMentions.length > 0 ? pickerMentions : editingComment.mentions
+                );
+                setLocalComments((prev) => prev.map((c) => (c.id === updated.id ? { ...c, ...updated } : c)));
+                setCommentText("");
+                setEditingComment(null);
+                setPickerMentions([]);
+            } catch (error) {
+                console.error("Failed to edit comment:", error);
+                showToast("Failed to edit comment", "danger");
+            } finally {
+                setIsSubmitting(false);
+            }
+            return;
+        }
+
         try {
             // Use the root parent ID for proper threading
             const parentId = replyingTo?.id;
@@ -283,6 +383,7 @@ const Comment = ({
         (commentId: string, userName: string) => {
             const rootParentId = findRootParent(commentId, localComments);
 
+            setEditingComment(null);
             setReplyingTo({
                 id: rootParentId,
                 name: userName,
@@ -318,11 +419,12 @@ const Comment = ({
                     try {
                         await deleteComment(postId, commentId);
 
-                        // Remove from local state
-                        setLocalComments((prev) => prev.filter((c) => c.id !== commentId));
+                        // The server deletes every reply beneath the comment too
+                        const removed = withReplies(commentId, localComments);
+                        setLocalComments((prev) => prev.filter((c) => !removed.has(c.id)));
 
                         if (onCommentDeleted) {
-                            onCommentDeleted(commentId);
+                            onCommentDeleted(commentId, removed.size);
                         }
 
                         capture(AnalyticsEvents.COMMENT_DELETED, {});
@@ -331,9 +433,10 @@ const Comment = ({
                         console.error("Failed to delete comment:", error);
 
                         if (error.message && error.message.includes("Comment not found")) {
-                            setLocalComments((prev) => prev.filter((c) => c.id !== commentId));
+                            const removed = withReplies(commentId, localComments);
+                            setLocalComments((prev) => prev.filter((c) => !removed.has(c.id)));
                             if (onCommentDeleted) {
-                                onCommentDeleted(commentId);
+                                onCommentDeleted(commentId, removed.size);
                             }
                             showToast("Comment deleted", "success");
                         } else {
@@ -351,25 +454,65 @@ const Comment = ({
         setAlertVisible(true);
     };
 
-    // handles the long press on a comment
-    const handleLongPress = (commentId: string, commentUserId: string) => {
-        if (!canDeleteComment(commentUserId)) {
-            return;
+    // toggles the current user's like, updating optimistically
+    const handleToggleLike = async (comment: CommentProps) => {
+        if (!currentUserId || !postId) return;
+        const apply = (liked: boolean) =>
+            setLocalComments((prev) =>
+                prev.map((c) => {
+                    if (c.id !== comment.id) return c;
+                    const ids = (c.reactions?.[LIKE_EMOJI] ?? []).filter((id) => id !== currentUserId);
+                    return { ...c, reactions: { ...c.reactions, [LIKE_EMOJI]: liked ? [...ids, currentUserId] : ids } };
+                })
+            );
+
+        const liked = hasLiked(comment);
+        apply(!liked);
+        try {
+            await toggleCommentReaction(postId, comment.id, LIKE_EMOJI);
+        } catch (error) {
+            console.error("Failed to react to comment:", error);
+            apply(liked);
+            showToast("Failed to react to comment", "danger");
         }
+    };
 
-        setAlertTitle("Comment Options");
-        setAlertMessage("");
-        setAlertButtons([
+    const handleStartEdit = (comment: CommentProps) => {
+        setReplyingTo(null);
+        setEditingComment(comment);
+        setCommentText(comment.content);
+        setAutoFocusInput(true);
+    };
+
+    // handles the long press on a comment
+    const handleLongPress = (comment: CommentProps) => {
+        const buttons: AlertButton[] = [
             {
+                text: hasLiked(comment) ? "Unlike" : "Like",
+                onPress: () => handleToggleLike(comment),
+            },
+        ];
+        if (canEditComment(comment.user._id)) {
+            buttons.push({
+                text: "Edit Comment",
+                onPress: () => handleStartEdit(comment),
+            });
+        }
+        if (canDeleteComment(comment.user._id)) {
+            buttons.push({
                 text: "Delete Comment",
                 style: "destructive",
-                onPress: () => handleDeleteComment(commentId, commentUserId),
-            },
-            {
-                text: "Cancel",
-                style: "cancel",
-            },
-        ]);
+                onPress: () => handleDeleteComment(comment.id, comment.user._id),
+            });
+        }
+        buttons.push({
+            text: "Cancel",
+            style: "cancel",
+        });
+
+        setAlertTitle("Comment Options");
+        setAlertMessage("");
+        setAlertButtons(buttons);
         setAlertVisible(true);
     };
 
@@ -396,8 +539,10 @@ const Comment = ({
                 );
             }
 
-            const canDelete = canDeleteComment(comment.user._id);
             const isDeleting = deletingComments.has(comment.id);
+            const likes = comment.reactions?.[LIKE_EMOJI]?.length ?? 0;
+            const edited =
+                new Date(comment.metadata.lastEdited).getTime() > new Date(comment.metadata.createdAt).getTime();
 
             return (
                 <TouchableOpacity
@@ -406,9 +551,7 @@ const Comment = ({
                         comment.parentId && styles.replyComment,
                         isDeleting && styles.deletingComment,
                     ]}
-                    onLongPress={
-                        canDelete && !isDeleting ? () => handleLongPress(comment.id, comment.user._id) : undefined
-                    }
+                    onLongPress={currentUserId && !isDeleting ? () => handleLongPress(comment) : undefined}
                     onPress={() => {
                         onClose();
                         router.push(`/account/${comment.user._id}`);
@@ -423,11 +566,16 @@ const Comment = ({
                         id={comment.id}
                         onReply={!isDeleting ? handleReply : undefined}
                     />
+                    {(likes > 0 || edited) && (
+                        <ThemedText style={styles.commentMeta}>
+                            {[likes > 0 ? `${LIKE_EMOJI} ${likes}` : "", edited ? "edited" : ""].filter(Boolean).join(" · ")}
+                        </ThemedText>
+                    )}
                     {isDeleting && <ThemedText style={styles.deletingText}>Deleting...</ThemedText>}
                 </TouchableOpacity>
             );
         },
-        [deletingComments, currentUserId, postOwnerId, handleReply, onClose, getTimeAgo]
+        [deletingComments, localComments, currentUserId, postOwnerId, handleReply, onClose, getTimeAgo]
     );
 
     // Empty list component
@@ -445,12 +593,16 @@ const Comment = ({
     return (
         <BottomSheetView style={styles.modalContainer}>
             <View style={styles.header}>
-                <ThemedText style={styles.commentsTitle}>Comments ({sortedComments?.length || 0})</ThemedText>
+                <ThemedText style={styles.commentsTitle}>
+                    Comments ({Math.max(commentCount ?? 0, sortedComments?.length || 0)})
+                </ThemedText>
             </View>
             <BottomSheetFlatList
                 data={feedItems}
                 renderItem={renderCommentItem}
                 keyExtractor={(item) => item.id}
+                onEndReached={handleEndReached}
+                onEndReachedThreshold={0.5}
                 ListEmptyComponent={ListEmptyComponent}
                 contentContainerStyle={styles.contentContainer}
                 showsVerticalScrollIndicator={false}
@@ -466,7 +618,13 @@ const Comment = ({
                 <View style={styles.inputRow}>
                     <CommentInput
                         autoFocus={autoFocusInput}
-                        placeHolder={replyingTo ? `Reply to ${replyingTo.name}...` : "Leave a comment"}
+                        placeHolder={
+                            editingComment
+                                ? "Edit your comment"
+                                : replyingTo
+                                  ? `Reply to ${replyingTo.name}...`
+                                  : "Leave a comment"
+                        }
                         onChangeText={setCommentText}
                         onSubmit={handleSubmitComment}
                         value={commentText}
@@ -528,6 +686,11 @@ const stylesheet = (ThemedColor: any) =>
         deletingComment: {
             opacity: 0.5,
         },
+        commentMeta: {
+            fontSize: 12,
+            color: ThemedColor.caption,
+            marginTop: 4,
+        },
         deletingText: {
             fontSize: 12,
             color: ThemedColor.caption,
@@ -55,6 +55,7 @@ export const AnalyticsEvents = {
     FEED_SCROLLED: "feed_scrolled",
     FEED_FILTER_CHANGED: "feed_filter_changed",
     POST_CREATED: "post_created",
+    POST_DRAFT_SAVED: "post_draft_saved",
     POST_VIEWED: "post_viewed",
     POST_DELETED: "post_deleted",
     POST_UPDATED: "post_updated",